@@ -0,0 +1,234 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArrStackDefaults are shared by every config generated from an ArrStack.
+// Settings on an individual app take precedence.
+type ArrStackDefaults struct {
+	// Quality is the quality preference of Radarr and Sonarr.
+	// +optional
+	Quality *VideoQualitySpec `json:"quality,omitempty"`
+
+	// Authentication is applied to every app.
+	// +optional
+	Authentication *AuthenticationSpec `json:"authentication,omitempty"`
+
+	// Reconciliation is the sync behavior of every generated config.
+	// +optional
+	Reconciliation *ReconciliationSpec `json:"reconciliation,omitempty"`
+}
+
+// ArrStackProwlarrSpec declares the Prowlarr of an ArrStack
+type ArrStackProwlarrSpec struct {
+	// Connection specifies how to connect to Prowlarr.
+	// +kubebuilder:validation:Required
+	Connection ConnectionSpec `json:"connection"`
+
+	// Indexers to configure in Prowlarr.
+	// +optional
+	Indexers []ProwlarrIndexer `json:"indexers,omitempty"`
+
+	// AppProfiles configures app profiles referenced by the indexers.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	AppProfiles []ProwlarrAppProfile `json:"appProfiles,omitempty"`
+
+	// Proxies configures indexer proxies (FlareSolverr, HTTP, SOCKS).
+	// +optional
+	Proxies []IndexerProxy `json:"proxies,omitempty"`
+
+	// IndexerHealth disables failing indexers until a cooldown ends.
+	// +optional
+	IndexerHealth *IndexerHealthSpec `json:"indexerHealth,omitempty"`
+}
+
+// ArrStackAppSpec declares Radarr or Sonarr in an ArrStack
+type ArrStackAppSpec struct {
+	// Connection specifies how to connect to the app.
+	// +kubebuilder:validation:Required
+	Connection ConnectionSpec `json:"connection"`
+
+	// Quality overrides defaults.quality.
+	// +optional
+	Quality *VideoQualitySpec `json:"quality,omitempty"`
+
+	// Category is the download client category (label for Deluge) of the app.
+	// It is declared in every client of the download stack.
+	// Defaults to the app name (radarr or sonarr).
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`
+	Category string `json:"category,omitempty"`
+
+	// RootFolders configures root folder paths.
+	// +optional
+	RootFolders []string `json:"rootFolders,omitempty"`
+
+	// RemotePathMappings maps download client paths to local paths.
+	// +optional
+	RemotePathMappings []RemotePathMappingSpec `json:"remotePathMappings,omitempty"`
+}
+
+// ArrStackLidarrSpec declares Lidarr in an ArrStack
+type ArrStackLidarrSpec struct {
+	// Connection specifies how to connect to Lidarr.
+	// +kubebuilder:validation:Required
+	Connection ConnectionSpec `json:"connection"`
+
+	// Quality defines audio quality preferences.
+	// +optional
+	Quality *AudioQualitySpec `json:"quality,omitempty"`
+
+	// Category is the download client category (label for Deluge) of Lidarr.
+	// It is declared in every client of the download stack.
+	// Defaults to lidarr.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`
+	Category string `json:"category,omitempty"`
+
+	// RootFolders configures root folder paths.
+	// +optional
+	RootFolders []LidarrRootFolder `json:"rootFolders,omitempty"`
+
+	// RemotePathMappings maps download client paths to local paths.
+	// +optional
+	RemotePathMappings []RemotePathMappingSpec `json:"remotePathMappings,omitempty"`
+}
+
+// GlobalNotificationSpec is a notification added to every app of an ArrStack.
+// Events selects a preset that is translated to each app's own event flags;
+// flags set on the notification itself take precedence.
+type GlobalNotificationSpec struct {
+	NotificationSpec `json:",inline"`
+
+	// Events selects what is notified in every app: all, library (grabs,
+	// imports, upgrades, renames, additions and deletions) or health (health
+	// issues, failures, application updates and manual interaction).
+	// +optional
+	// +kubebuilder:validation:Enum=all;library;health
+	// +kubebuilder:default=all
+	Events string `json:"events,omitempty"`
+}
+
+// ArrStackSpec declares a whole *arr stack. The controller generates a
+// ProwlarrConfig, RadarrConfig, SonarrConfig, LidarrConfig and
+// DownloadStackConfig from it, wired to each other.
+type ArrStackSpec struct {
+	// Defaults are shared by the generated configs.
+	// +optional
+	Defaults *ArrStackDefaults `json:"defaults,omitempty"`
+
+	// Prowlarr is generated as the ProwlarrConfig {name}-prowlarr. Radarr and
+	// Sonarr get their indexers from it.
+	// +optional
+	Prowlarr *ArrStackProwlarrSpec `json:"prowlarr,omitempty"`
+
+	// Radarr is generated as the RadarrConfig {name}-radarr.
+	// +optional
+	Radarr *ArrStackAppSpec `json:"radarr,omitempty"`
+
+	// Sonarr is generated as the SonarrConfig {name}-sonarr.
+	// +optional
+	Sonarr *ArrStackAppSpec `json:"sonarr,omitempty"`
+
+	// Lidarr is generated as the LidarrConfig {name}-lidarr.
+	// +optional
+	Lidarr *ArrStackLidarrSpec `json:"lidarr,omitempty"`
+
+	// DownloadStack is generated as the DownloadStackConfig {name}-downloads.
+	// Each of its download clients is added to Radarr, Sonarr and Lidarr, and
+	// their categories are declared in it.
+	// +optional
+	DownloadStack *DownloadStackConfigSpec `json:"downloadStack,omitempty"`
+
+	// GlobalNotifications are added to Radarr, Sonarr and Lidarr, with the
+	// event flags each app supports.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	GlobalNotifications []GlobalNotificationSpec `json:"globalNotifications,omitempty"`
+}
+
+// ArrStackComponentStatus is the state of a config generated by an ArrStack
+type ArrStackComponentStatus struct {
+	// Kind is the config kind (e.g., RadarrConfig)
+	Kind string `json:"kind"`
+
+	// Name is the config name
+	Name string `json:"name"`
+
+	// Ready mirrors the config's Ready condition
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// Message is the message of the config's Ready condition
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ArrStackStatus defines the observed state of ArrStack
+type ArrStackStatus struct {
+	// Conditions represent the latest observations
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Components lists the generated configs
+	// +optional
+	Components []ArrStackComponentStatus `json:"components,omitempty"`
+
+	// ObservedGeneration is the last observed generation
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ArrStack declares Prowlarr, Radarr, Sonarr, Lidarr and the download stack in
+// one resource and generates the individual configs from it
+type ArrStack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec declares the stack
+	Spec ArrStackSpec `json:"spec,omitempty"`
+
+	// Status defines the observed state
+	// +optional
+	Status ArrStackStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ArrStackList contains a list of ArrStack
+type ArrStackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArrStack `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ArrStack{}, &ArrStackList{})
+}
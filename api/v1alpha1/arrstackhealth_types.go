@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArrStackHealthSpec defines which *arr configs are rolled up
+type ArrStackHealthSpec struct {
+	// Selector limits the rollup to configs with matching labels.
+	// When omitted, every *arr config in the namespace is included.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// AppHealthSummary is the health of a single managed app
+type AppHealthSummary struct {
+	// Kind is the config kind (e.g., RadarrConfig)
+	Kind string `json:"kind"`
+
+	// Name is the config name
+	Name string `json:"name"`
+
+	// Connected indicates whether the operator can reach the app
+	// +optional
+	Connected bool `json:"connected,omitempty"`
+
+	// Healthy indicates the app is connected and reports no error-level issues
+	// +optional
+	Healthy bool `json:"healthy,omitempty"`
+
+	// ErrorCount is the number of error-level issues reported by the app
+	// +optional
+	ErrorCount int `json:"errorCount,omitempty"`
+
+	// WarningCount is the number of warning-level issues reported by the app
+	// +optional
+	WarningCount int `json:"warningCount,omitempty"`
+
+	// LastCheck is when the app's health was last checked
+	// +optional
+	LastCheck *metav1.Time `json:"lastCheck,omitempty"`
+}
+
+// ArrStackHealthStatus defines the aggregated health of the stack
+type ArrStackHealthStatus struct {
+	// Conditions represent the latest observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Healthy is true when every app is connected and has no error-level issues
+	// +optional
+	Healthy bool `json:"healthy,omitempty"`
+
+	// AppCount is the number of apps in the rollup
+	// +optional
+	AppCount int `json:"appCount,omitempty"`
+
+	// UnhealthyCount is the number of apps that are disconnected or report errors
+	// +optional
+	UnhealthyCount int `json:"unhealthyCount,omitempty"`
+
+	// ErrorCount is the total number of error-level issues across apps
+	// +optional
+	ErrorCount int `json:"errorCount,omitempty"`
+
+	// WarningCount is the total number of warning-level issues across apps
+	// +optional
+	WarningCount int `json:"warningCount,omitempty"`
+
+	// Apps lists per-app health, ordered by kind and name
+	// +optional
+	Apps []AppHealthSummary `json:"apps,omitempty"`
+
+	// LastUpdated is when the rollup last changed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// ObservedGeneration is the last observed generation
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Apps",type=integer,JSONPath=`.status.appCount`
+// +kubebuilder:printcolumn:name="Unhealthy",type=integer,JSONPath=`.status.unhealthyCount`
+// +kubebuilder:printcolumn:name="Errors",type=integer,JSONPath=`.status.errorCount`
+// +kubebuilder:printcolumn:name="Warnings",type=integer,JSONPath=`.status.warningCount`
+// +kubebuilder:printcolumn:name="Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="Healthy")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ArrStackHealth rolls up the health of every managed *arr app in its namespace
+type ArrStackHealth struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines which configs are included
+	// +optional
+	Spec ArrStackHealthSpec `json:"spec,omitempty"`
+
+	// Status defines the observed state
+	// +optional
+	Status ArrStackHealthStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ArrStackHealthList contains a list of ArrStackHealth
+type ArrStackHealthList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArrStackHealth `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ArrStackHealth{}, &ArrStackHealthList{})
+}
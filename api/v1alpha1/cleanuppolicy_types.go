@@ -0,0 +1,208 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CleanupTargetReference identifies the config whose library a CleanupPolicy cleans up
+type CleanupTargetReference struct {
+	// Kind is the kind of the config.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=RadarrConfig;SonarrConfig
+	Kind string `json:"kind"`
+
+	// Name is the name of the config in the same namespace.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// CleanupRuleType selects which items a cleanup rule matches and what it does with them
+// +kubebuilder:validation:Enum=WatchedUnmonitored;Unavailable;CutoffMet
+type CleanupRuleType string
+
+const (
+	// CleanupRuleWatchedUnmonitored deletes unmonitored items that were watched
+	CleanupRuleWatchedUnmonitored CleanupRuleType = "WatchedUnmonitored"
+	// CleanupRuleUnavailable deletes monitored items that stay without files
+	CleanupRuleUnavailable CleanupRuleType = "Unavailable"
+	// CleanupRuleCutoffMet unmonitors items whose files meet the quality cutoff
+	CleanupRuleCutoffMet CleanupRuleType = "CutoffMet"
+)
+
+// CleanupRule defines one cleanup rule
+type CleanupRule struct {
+	// Name identifies the rule in status.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Type selects the rule:
+	// - WatchedUnmonitored: deletes unmonitored items last watched at least
+	//   days ago. Requires spec.tautulliRef.
+	// - Unavailable: deletes monitored, released items that still have no
+	//   files days after they were added.
+	// - CutoffMet: unmonitors items whose files meet the quality profile
+	//   cutoff. For Sonarr only ended series with every episode downloaded match.
+	// +kubebuilder:validation:Required
+	Type CleanupRuleType `json:"type"`
+
+	// Days is the age threshold of WatchedUnmonitored and Unavailable rules.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Days int32 `json:"days,omitempty"`
+
+	// DeleteFiles also deletes the files of deleted items.
+	// +optional
+	DeleteFiles bool `json:"deleteFiles,omitempty"`
+
+	// AddImportExclusion keeps import lists from adding deleted items again.
+	// +optional
+	AddImportExclusion bool `json:"addImportExclusion,omitempty"`
+}
+
+// CleanupPolicySpec defines the desired state of CleanupPolicy
+type CleanupPolicySpec struct {
+	// TargetRef references the RadarrConfig or SonarrConfig whose library is cleaned up.
+	// Its connection is used to reach the app.
+	// +kubebuilder:validation:Required
+	TargetRef CleanupTargetReference `json:"targetRef"`
+
+	// TautulliRef references a TautulliConfig in the same namespace whose
+	// Tautulli provides watch history. Items are matched by title and year.
+	// +optional
+	TautulliRef *LocalObjectReference `json:"tautulliRef,omitempty"`
+
+	// Rules are evaluated in order; each item is handled by the first rule it matches.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Rules []CleanupRule `json:"rules"`
+
+	// ExcludeTags protects items carrying any of these tags.
+	// +optional
+	ExcludeTags []string `json:"excludeTags,omitempty"`
+
+	// DryRun reports the matching items in status without changing them.
+	// +optional
+	// +kubebuilder:default=true
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// MaxDeletionsPerRun caps the items deleted per run, oldest first.
+	// Further matches are deferred to the next run. Unmonitoring is not capped.
+	// +optional
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	MaxDeletionsPerRun *int32 `json:"maxDeletionsPerRun,omitempty"`
+
+	// Interval between runs.
+	// +optional
+	// +kubebuilder:default="24h"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Suspend pauses the policy.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// CleanupCandidate is an item matched by a cleanup rule
+type CleanupCandidate struct {
+	// Rule is the name of the matching rule.
+	Rule string `json:"rule"`
+
+	// ID is the movie or series ID in the app.
+	ID int `json:"id"`
+
+	// Title is the movie or series title.
+	Title string `json:"title"`
+
+	// Action is delete or unmonitor.
+	Action string `json:"action"`
+
+	// Deferred is set for deletions held back by maxDeletionsPerRun.
+	// +optional
+	Deferred bool `json:"deferred,omitempty"`
+}
+
+// CleanupPolicyStatus defines the observed state of CleanupPolicy
+type CleanupPolicyStatus struct {
+	// Conditions represent the latest observations of the CleanupPolicy's state.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastRun is when the rules were last evaluated.
+	// +optional
+	LastRun *metav1.Time `json:"lastRun,omitempty"`
+
+	// Matched is the number of items the last run matched.
+	// +optional
+	Matched int32 `json:"matched,omitempty"`
+
+	// Deleted is the number of items the last run deleted.
+	// +optional
+	Deleted int32 `json:"deleted,omitempty"`
+
+	// Unmonitored is the number of items the last run unmonitored.
+	// +optional
+	Unmonitored int32 `json:"unmonitored,omitempty"`
+
+	// Deferred is the number of deletions the last run held back.
+	// +optional
+	Deferred int32 `json:"deferred,omitempty"`
+
+	// Candidates lists the first matched items of the last run.
+	// +optional
+	Candidates []CleanupCandidate `json:"candidates,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetRef.name`
+// +kubebuilder:printcolumn:name="Dry Run",type=boolean,JSONPath=`.spec.dryRun`
+// +kubebuilder:printcolumn:name="Matched",type=integer,JSONPath=`.status.matched`
+// +kubebuilder:printcolumn:name="Deleted",type=integer,JSONPath=`.status.deleted`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CleanupPolicy deletes or unmonitors items of a Radarr or Sonarr library by rule
+type CleanupPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the cleanup rules.
+	// +kubebuilder:validation:Required
+	Spec CleanupPolicySpec `json:"spec"`
+
+	// Status defines the observed state of CleanupPolicy.
+	// +optional
+	Status CleanupPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CleanupPolicyList contains a list of CleanupPolicy
+type CleanupPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CleanupPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CleanupPolicy{}, &CleanupPolicyList{})
+}
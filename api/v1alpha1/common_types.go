@@ -17,9 +17,23 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"strconv"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ProtectAnnotation, set to "true", keeps the operator from deleting anything in
+// the service when the config is deleted: only the finalizer is removed, and the
+// managed resources stay as they are. It guards live configuration against an
+// accidental kubectl delete.
+const ProtectAnnotation = "nebularr.arr.rinzler.cloud/protect"
+
+// IsDeleteProtected reports whether obj carries ProtectAnnotation set to true
+func IsDeleteProtected(obj metav1.Object) bool {
+	protected, err := strconv.ParseBool(obj.GetAnnotations()[ProtectAnnotation])
+	return err == nil && protected
+}
+
 // =============================================================================
 // Connection Types
 // =============================================================================
@@ -38,18 +52,160 @@ type ConnectionSpec struct {
 
 	// ConfigPath is the path to config.xml for API key auto-discovery.
 	// Only used if APIKeySecretRef is not specified.
-	// Defaults to /{app}-config/config.xml
+	// Defaults to /{app}-config/config.xml for the file strategy,
+	// /config/config.xml for exec and config.xml (relative to the claim) for pvc.
 	// +optional
 	ConfigPath string `json:"configPath,omitempty"`
 
+	// APIKeyDiscovery configures how config.xml is read when APIKeySecretRef
+	// is not specified. The discovered key is cached in a Secret named
+	// {name}-{app}-api-key, owned by this resource.
+	// +optional
+	APIKeyDiscovery *APIKeyDiscoverySpec `json:"apiKeyDiscovery,omitempty"`
+
 	// InsecureSkipVerify disables TLS certificate verification.
 	// +optional
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 
-	// Timeout specifies the connection timeout.
+	// ExtraHeaders are sent with every request to the service, for reverse
+	// proxies that authenticate in front of it (e.g., Authelia or Traefik forward-auth).
+	// +optional
+	ExtraHeaders []HeaderSecretRef `json:"extraHeaders,omitempty"`
+
+	// ClientCertSecretRef references a Secret holding a client certificate
+	// presented to services behind mutual TLS.
+	// +optional
+	ClientCertSecretRef *TLSSecretRef `json:"clientCertSecretRef,omitempty"`
+
+	// Timeout bounds each request to the service. Raise it for large
+	// instances whose lists take longer than the default to return.
 	// +optional
 	// +kubebuilder:default="30s"
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Deployment identifies the Deployment running the service. While it rolls
+	// out, an unreachable service sets Ready to Unknown with reason Pending
+	// instead of failing the reconcile. Defaults to a Deployment owning this resource.
+	// +optional
+	Deployment *DeploymentSelector `json:"deployment,omitempty"`
+
+	// Cluster points the Kubernetes lookups of this connection at another
+	// cluster: the referenced Secrets, API key discovery and the Deployment
+	// are read there instead of next to this resource.
+	// +optional
+	Cluster *ClusterTargetSpec `json:"cluster,omitempty"`
+}
+
+// ClusterTargetSpec selects the cluster and namespace an app runs in when it is
+// not the cluster of the resource (e.g., a management cluster configuring apps
+// on other clusters)
+type ClusterTargetSpec struct {
+	// KubeconfigSecretRef references the kubeconfig of the target cluster.
+	// +kubebuilder:validation:Required
+	KubeconfigSecretRef KubeconfigSecretRef `json:"kubeconfigSecretRef"`
+
+	// Namespace in the target cluster holding the referenced Secrets, pods and
+	// Deployment. Defaults to the namespace of this resource.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// KubeconfigSecretRef references a kubeconfig in a Secret in the same namespace
+type KubeconfigSecretRef struct {
+	// Name is the name of the Secret.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key is the key holding the kubeconfig.
+	// +optional
+	// +kubebuilder:default="kubeconfig"
+	Key string `json:"key,omitempty"`
+}
+
+// DeploymentSelector selects a Deployment in the same namespace by name or labels
+type DeploymentSelector struct {
+	// Name is the name of the Deployment.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// MatchLabels selects the Deployment by its labels when Name is not set.
+	// Exactly one Deployment must match.
+	// +optional
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+}
+
+// HeaderSecretRef sets an HTTP header to a value read from a Secret
+type HeaderSecretRef struct {
+	// Name is the header name (e.g., Proxy-Authorization).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// SecretKeyRef selects the header value.
+	// +kubebuilder:validation:Required
+	SecretKeyRef SecretKeySelector `json:"secretKeyRef"`
+}
+
+// TLSSecretRef references a client certificate and key in a Secret.
+// The defaults match the keys of a kubernetes.io/tls Secret.
+type TLSSecretRef struct {
+	// Name is the name of the Secret.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// CertKey is the key for the PEM-encoded certificate.
+	// +optional
+	// +kubebuilder:default="tls.crt"
+	CertKey string `json:"certKey,omitempty"`
+
+	// KeyKey is the key for the PEM-encoded private key.
+	// +optional
+	// +kubebuilder:default="tls.key"
+	KeyKey string `json:"keyKey,omitempty"`
+
+	// CAKey is the key for a PEM-encoded CA bundle used to verify the service.
+	// The system roots are used if the key is not present in the Secret.
+	// +optional
+	// +kubebuilder:default="ca.crt"
+	CAKey string `json:"caKey,omitempty"`
+}
+
+// APIKeyDiscoverySpec configures API key auto-discovery from the app's config.xml
+type APIKeyDiscoverySpec struct {
+	// Strategy selects how config.xml is read:
+	// file reads ConfigPath from a volume mounted into the operator pod,
+	// exec runs cat in the app's pod, and pvc mounts the app's config
+	// PersistentVolumeClaim in a short-lived Job.
+	// +optional
+	// +kubebuilder:validation:Enum=file;exec;pvc
+	// +kubebuilder:default=file
+	Strategy string `json:"strategy,omitempty"`
+
+	// PodSelector selects the app pod to exec into (exec strategy).
+	// +optional
+	PodSelector map[string]string `json:"podSelector,omitempty"`
+
+	// Container is the container to exec into (exec strategy).
+	// Defaults to the pod's first container.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// ClaimName is the PersistentVolumeClaim holding the app's config (pvc strategy).
+	// +optional
+	ClaimName string `json:"claimName,omitempty"`
+
+	// RefreshInterval is how often config.xml is re-read to pick up a rotated key.
+	// Between reads the cached Secret is used.
+	// +optional
+	// +kubebuilder:default="5m"
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// Bootstrap generates an API key for a fresh install. If config.xml does not
+	// exist yet, the operator writes one containing only the generated key, which
+	// the app adopts on first start. An existing config.xml is never modified.
+	// Supported by the file (requires a writable mount) and pvc strategies.
+	// +optional
+	Bootstrap bool `json:"bootstrap,omitempty"`
 }
 
 // SecretKeySelector selects a key from a Kubernetes Secret
@@ -96,7 +252,7 @@ type LocalObjectReference struct {
 type VideoQualitySpec struct {
 	// Preset is a built-in quality configuration.
 	// See PRESETS.md for available presets.
-	// Valid values: uhd-hdr, uhd-sdr, fhd-quality, fhd-streaming, hd, balanced, any, storage-optimized
+	// Valid values: 4k-hdr, 4k-sdr, 1080p-quality, 1080p-streaming, 720p, balanced, any, storage-optimized
 	// If not specified, defaults to "balanced".
 	// +optional
 	Preset string `json:"preset,omitempty"`
@@ -118,6 +274,14 @@ type VideoQualitySpec struct {
 	// +optional
 	RejectAdditional []string `json:"rejectAdditional,omitempty"`
 
+	// CloneFrom names an existing, unmanaged quality profile to build the managed
+	// profile from. Its quality order, groups and custom format scores are kept;
+	// the preset's allowed qualities, cutoff and scores are applied on top.
+	// The profile is read again whenever the managed profile is written.
+	// +optional
+	// +kubebuilder:validation:MaxLength=128
+	CloneFrom string `json:"cloneFrom,omitempty"`
+
 	// --- Full manual control (overrides preset entirely if specified) ---
 
 	// Tiers defines quality tiers in order of preference.
@@ -138,6 +302,51 @@ type VideoQualitySpec struct {
 	RejectedFormats []string `json:"rejectedFormats,omitempty"`
 }
 
+// NamedVideoQualitySpec defines an additional named video quality profile
+type NamedVideoQualitySpec struct {
+	// Name identifies the profile. It is created as "nebularr-<config>-<name>",
+	// and import lists can reference it by this short name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Preset is a built-in quality configuration (defaults to "balanced").
+	// +optional
+	Preset string `json:"preset,omitempty"`
+
+	// Exclude removes formats/features from the preset.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+
+	// PreferAdditional adds formats to the preferred list.
+	// +optional
+	PreferAdditional []string `json:"preferAdditional,omitempty"`
+
+	// RejectAdditional adds formats to the rejected list.
+	// +optional
+	RejectAdditional []string `json:"rejectAdditional,omitempty"`
+}
+
+// LanguageSpec defines the language preferences of Radarr/Sonarr
+type LanguageSpec struct {
+	// Profile is the preferred language: "Original" (the title's original
+	// language), "Any", or a language name as shown in the app (e.g. "French",
+	// "Portuguese (Brazil)"). Radarr sets it on the generated quality profiles,
+	// which default to "Original". Sonarr v4 profiles have no language, so
+	// Sonarr scores it through a custom format instead.
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// PreferOriginal adds a custom format matching releases in the title's
+	// original language and scores it in the generated quality profiles.
+	// +optional
+	PreferOriginal bool `json:"preferOriginal,omitempty"`
+
+	// Score is the score of the language custom formats (default 100).
+	// +optional
+	Score *int `json:"score,omitempty"`
+}
+
 // VideoQualityTier represents a resolution + source combination
 type VideoQualityTier struct {
 	// Resolution: 2160, 1080, 720, 480 (without 'p' suffix)
@@ -195,9 +404,10 @@ type DownloadClientSpec struct {
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
-	// URL is the client URL (e.g., http://qbittorrent:8080)
-	// +kubebuilder:validation:Required
-	URL string `json:"url"`
+	// URL is the client URL (e.g., http://qbittorrent:8080).
+	// Required unless DownloadClientRef is set.
+	// +optional
+	URL string `json:"url,omitempty"`
 
 	// Type is the client type. If not specified, inferred from Name.
 	// +optional
@@ -208,10 +418,46 @@ type DownloadClientSpec struct {
 	// +optional
 	CredentialsSecretRef *CredentialsSecretRef `json:"credentialsSecretRef,omitempty"`
 
-	// Category for downloads. Defaults to app name (e.g., "radarr").
+	// APIKeySecretRef references the API key of a SABnzbd client.
+	// +optional
+	APIKeySecretRef *SecretKeySelector `json:"apiKeySecretRef,omitempty"`
+
+	// PasswordSecretRef references the password of a Deluge client, which
+	// authenticates with a password alone.
+	// +optional
+	PasswordSecretRef *SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// Category for downloads. Without one, the only category (or Deluge label)
+	// of a client referenced through downloadClientRef is used; otherwise
+	// downloads are not categorized.
 	// +optional
 	Category string `json:"category,omitempty"`
 
+	// DownloadClientRef derives URL, Type, the credential Secret references and
+	// Category from a client of a DownloadStackConfig, so credential rotations only
+	// touch the DownloadStackConfig. Values set on this entry take precedence.
+	// +optional
+	DownloadClientRef *DownloadClientRef `json:"downloadClientRef,omitempty"`
+
+	// DownloadStackRef references the DownloadStackConfig managing this client.
+	// Category is then checked against the categories (or Deluge labels) it
+	// declares and a mismatch is reported as the CategoryContract condition.
+	// +optional
+	DownloadStackRef *LocalObjectReference `json:"downloadStackRef,omitempty"`
+
+	// DownloadStackInstance selects a named instance of the DownloadStackConfig
+	// (spec.<client>Instances[].name) for the category check. Empty selects
+	// the unnamed client (e.g. spec.qbittorrent).
+	// +optional
+	DownloadStackInstance string `json:"downloadStackInstance,omitempty"`
+
+	// DependsOn references a DownloadStackConfig that must report Ready before
+	// this client is created or updated. Until then the client is held back and
+	// the wait is reported as the DependenciesReady condition, so the app does
+	// not fail its connection test while the stack is still starting.
+	// +optional
+	DependsOn *LocalObjectReference `json:"dependsOn,omitempty"`
+
 	// Priority affects client selection (higher = preferred).
 	// +optional
 	// +kubebuilder:validation:Minimum=1
@@ -233,6 +479,28 @@ type DownloadClientSpec struct {
 	// +optional
 	// +kubebuilder:default=true
 	RemoveFailedDownloads *bool `json:"removeFailedDownloads,omitempty"`
+
+	// Pause stops managing this download client: it is kept as it is in the
+	// app, neither created, updated nor deleted, until Pause is cleared.
+	// +optional
+	Pause bool `json:"pause,omitempty"`
+}
+
+// DownloadClientRef points a download client at a client of a DownloadStackConfig
+type DownloadClientRef struct {
+	// Name is the DownloadStackConfig, in the same namespace.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Type selects the client of the DownloadStackConfig (e.g. spec.qbittorrent).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=qbittorrent;transmission;deluge;rtorrent;nzbget;sabnzbd
+	Type string `json:"type"`
+
+	// Instance selects a named instance (spec.<client>Instances[].name).
+	// Empty selects the unnamed client.
+	// +optional
+	Instance string `json:"instance,omitempty"`
 }
 
 // =============================================================================
@@ -241,6 +509,8 @@ type DownloadClientSpec struct {
 
 // RemotePathMappingSpec maps download client paths to local paths.
 // This is needed when the download client and *arr app see the same files at different paths.
+// Mappings are matched by host and remote path. Once any mapping is declared,
+// mappings in the app that are not declared are removed.
 type RemotePathMappingSpec struct {
 	// Host is the download client hostname.
 	// Must match the host configured in the download client.
@@ -273,6 +543,20 @@ type IndexersSpec struct {
 	// Mutually exclusive with ProwlarrRef.
 	// +optional
 	Direct []DirectIndexer `json:"direct,omitempty"`
+
+	// Preset assigns priority bands and seed criteria to direct indexers by type and tags.
+	// private-first: private trackers, then usenet, then public trackers.
+	// usenet-first: usenet, then private trackers, then public trackers.
+	// Indexers with a priority other than the default (25) keep their own.
+	// +optional
+	// +kubebuilder:validation:Enum=private-first;usenet-first
+	Preset string `json:"preset,omitempty"`
+
+	// VerifyOnApply runs the app's indexer test after direct indexers are created or
+	// updated. Indexers that fail are disabled and reported in status.indexers.
+	// Supported by Radarr and Sonarr.
+	// +optional
+	VerifyOnApply bool `json:"verifyOnApply,omitempty"`
 }
 
 // ProwlarrRef references a Prowlarr instance for indexer management
@@ -294,6 +578,14 @@ type ProwlarrRef struct {
 	// Exclude filters out specific Prowlarr indexers.
 	// +optional
 	Exclude []string `json:"exclude,omitempty"`
+
+	// OutOfPolicyAction controls what happens to indexers Prowlarr syncs into this app
+	// that don't match Include/Exclude.
+	// disable: turn off RSS and search, remove: delete from the app, ignore: report only.
+	// +optional
+	// +kubebuilder:validation:Enum=disable;remove;ignore
+	// +kubebuilder:default=disable
+	OutOfPolicyAction string `json:"outOfPolicyAction,omitempty"`
 }
 
 // DirectIndexer defines an indexer configured directly
@@ -328,6 +620,15 @@ type DirectIndexer struct {
 	// +optional
 	// +kubebuilder:default=true
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// Tags classify the indexer for the indexers preset (e.g., "private").
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Pause stops managing this indexer: it is kept as it is in the app,
+	// neither created, updated nor deleted, until Pause is cleared.
+	// +optional
+	Pause bool `json:"pause,omitempty"`
 }
 
 // =============================================================================
@@ -338,7 +639,8 @@ type DirectIndexer struct {
 type NamingSpec struct {
 	// Preset is a built-in naming configuration.
 	// +optional
-	// +kubebuilder:validation:Enum=plex-friendly;jellyfin-friendly;kodi-friendly;detailed;minimal;scene
+	// plex-music and jellyfin-music apply to Lidarr only, audiobookshelf to Readarr only.
+	// +kubebuilder:validation:Enum=plex-friendly;jellyfin-friendly;kodi-friendly;detailed;minimal;scene;plex-music;jellyfin-music;audiobookshelf
 	// +kubebuilder:default=plex-friendly
 	Preset string `json:"preset,omitempty"`
 
@@ -363,14 +665,66 @@ type NamingSpec struct {
 
 // ReconciliationSpec configures reconciliation behavior
 type ReconciliationSpec struct {
-	// Interval between reconciliations.
+	// Interval between reconciliations. Defaults to 5m, or 30m for
+	// DownloadStackConfig where download client settings rarely drift.
+	// The operator adds a small random jitter (--requeue-jitter).
 	// +optional
-	// +kubebuilder:default="5m"
 	Interval *metav1.Duration `json:"interval,omitempty"`
 
 	// Suspend pauses reconciliation.
 	// +optional
 	Suspend bool `json:"suspend,omitempty"`
+
+	// ApplyWindow restricts when changes are applied. Drift is still detected on
+	// every reconcile, but outside the window changes are held back and reported
+	// through the PendingChanges condition.
+	// Honored by *arrConfig resources and DownloadStackConfig (Gluetun changes and
+	// Deployment restarts); ignored by BazarrConfig.
+	// +optional
+	ApplyWindow *ApplyWindowSpec `json:"applyWindow,omitempty"`
+
+	// PreflightPaths looks each root folder up through the app's filesystem API
+	// before it is created. Root folders the app can't see are skipped and
+	// reported through the PathsVerified condition, instead of failing creation
+	// with a validation error.
+	// Honored by RadarrConfig, SonarrConfig and LidarrConfig.
+	// +optional
+	PreflightPaths bool `json:"preflightPaths,omitempty"`
+
+	// ReadinessConfigMap names a ConfigMap, in the config's namespace, that the
+	// operator creates once the configuration has been fully applied. It is
+	// updated on every later apply and never withdrawn. Dependent workloads can
+	// mount it as an optional volume and wait in an init container for its
+	// "applied" key, so they never start against an unconfigured app.
+	// Honored by *arrConfig resources.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=253
+	ReadinessConfigMap string `json:"readinessConfigMap,omitempty"`
+}
+
+// ApplyWindowSpec defines maintenance windows during which changes may be applied
+type ApplyWindowSpec struct {
+	// Windows lists the maintenance windows. Changes are applied while any window is open.
+	// +kubebuilder:validation:MinItems=1
+	Windows []MaintenanceWindow `json:"windows"`
+
+	// Timezone is the IANA time zone the schedules are evaluated in (e.g., "Europe/Berlin").
+	// +optional
+	// +kubebuilder:default="UTC"
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// MaintenanceWindow is a recurring window opening on a cron schedule
+type MaintenanceWindow struct {
+	// Schedule is a five-field cron expression (minute hour day-of-month month day-of-week)
+	// for when the window opens (e.g., "0 2 * * 1-5" for 02:00 on weekdays).
+	// +kubebuilder:validation:Required
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open (e.g., "2h").
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
 }
 
 // =============================================================================
@@ -399,17 +753,28 @@ type ProwlarrRegistration struct {
 	// +optional
 	SyncedIndexers []string `json:"syncedIndexers,omitempty"`
 
+	// OutOfPolicyIndexers lists indexers synced from Prowlarr that violate the
+	// prowlarrRef include/exclude filters.
+	// +optional
+	OutOfPolicyIndexers []string `json:"outOfPolicyIndexers,omitempty"`
+
 	// Message provides status details or error information.
 	// +optional
 	Message string `json:"message,omitempty"`
 }
 
-// ManagedResources tracks created resources
+// ManagedResources tracks created resources by their IDs in the app. The IDs are
+// looked up after each apply and sorted ascending.
 type ManagedResources struct {
 	// QualityProfileID is the managed quality profile ID.
 	// +optional
 	QualityProfileID *int `json:"qualityProfileId,omitempty"`
 
+	// QualityProfileIDs are the IDs of every managed quality profile, including
+	// the one in QualityProfileID.
+	// +optional
+	QualityProfileIDs []int `json:"qualityProfileIds,omitempty"`
+
 	// CustomFormatIDs are the managed custom format IDs.
 	// +optional
 	CustomFormatIDs []int `json:"customFormatIds,omitempty"`
@@ -435,6 +800,291 @@ type ManagedResources struct {
 	NotificationIDs []int `json:"notificationIds,omitempty"`
 }
 
+// RawRequestSpec is an API request sent verbatim to the app, for settings the
+// operator doesn't model yet. Each entry is re-sent only when it changes.
+type RawRequestSpec struct {
+	// Name identifies this entry in status and conditions.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Method is the HTTP method.
+	// +optional
+	// +kubebuilder:validation:Enum=PUT;POST;DELETE
+	// +kubebuilder:default=PUT
+	Method string `json:"method,omitempty"`
+
+	// Path is the API path relative to the app URL (e.g., /api/v3/config/ui/1).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path"`
+
+	// Body is the JSON request body.
+	// +optional
+	Body string `json:"body,omitempty"`
+
+	// Revision can be changed to force the request to be sent again
+	// even though method, path and body are unchanged.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+}
+
+// RawRequestStatus records the last successfully sent version of a raw request
+type RawRequestStatus struct {
+	// Name is the raw request entry name
+	Name string `json:"name"`
+
+	// Hash of method, path, body and revision when last sent successfully
+	Hash string `json:"hash"`
+
+	// LastApplied is when the request was last sent successfully
+	// +optional
+	LastApplied *metav1.Time `json:"lastApplied,omitempty"`
+}
+
+// MediaServerHooksSpec registers media server library refresh notifications.
+// The operator creates the matching connection in the *arr app and tests it
+// through the app, so status reflects the full *arr -> media server path.
+type MediaServerHooksSpec struct {
+	// Plex configures a Plex Media Server connection.
+	// +optional
+	Plex *PlexHookSpec `json:"plex,omitempty"`
+
+	// Jellyfin configures a Jellyfin (or Emby) connection.
+	// +optional
+	Jellyfin *JellyfinHookSpec `json:"jellyfin,omitempty"`
+}
+
+// PlexHookSpec configures a Plex Media Server library refresh hook
+type PlexHookSpec struct {
+	// URL is the Plex server URL as reachable from the *arr app (e.g., http://plex:32400).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// TokenSecretRef references the Secret containing the Plex token (X-Plex-Token).
+	// +kubebuilder:validation:Required
+	TokenSecretRef SecretKeySelector `json:"tokenSecretRef"`
+
+	// UpdateLibrary refreshes the library on import, upgrade, rename and delete.
+	// +optional
+	// +kubebuilder:default=true
+	UpdateLibrary *bool `json:"updateLibrary,omitempty"`
+}
+
+// JellyfinHookSpec configures a Jellyfin (or Emby) library refresh hook
+type JellyfinHookSpec struct {
+	// URL is the Jellyfin server URL as reachable from the *arr app (e.g., http://jellyfin:8096).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// APIKeySecretRef references the Secret containing a Jellyfin API key.
+	// +kubebuilder:validation:Required
+	APIKeySecretRef SecretKeySelector `json:"apiKeySecretRef"`
+
+	// UpdateLibrary refreshes the library on import, upgrade, rename and delete.
+	// +optional
+	// +kubebuilder:default=true
+	UpdateLibrary *bool `json:"updateLibrary,omitempty"`
+
+	// Notify sends a notification to Jellyfin clients on import.
+	// +optional
+	Notify *bool `json:"notify,omitempty"`
+}
+
+// MediaServerStatus reports the state of a media server hook
+type MediaServerStatus struct {
+	// Type is the media server type: plex or jellyfin
+	Type string `json:"type"`
+
+	// URL is the configured media server URL
+	URL string `json:"url"`
+
+	// Connected indicates the *arr app successfully tested the connection
+	Connected bool `json:"connected"`
+
+	// Message contains the test error, if any
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastChecked is when the connection was last tested
+	// +optional
+	LastChecked *metav1.Time `json:"lastChecked,omitempty"`
+}
+
+// NotificationStatus reports the connection test result of a notification
+type NotificationStatus struct {
+	// Name is the notification name from the spec
+	Name string `json:"name"`
+
+	// Verified indicates the *arr app's connection test succeeded
+	Verified bool `json:"verified"`
+
+	// Message contains the test error, if any
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastChecked is when the notification was last tested
+	// +optional
+	LastChecked *metav1.Time `json:"lastChecked,omitempty"`
+
+	// ObservedGeneration is the config generation the test ran against.
+	// Passing notifications are only re-tested when the generation changes.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// IndexerStatus reports the connection test result of a direct indexer
+type IndexerStatus struct {
+	// Name is the indexer name from the spec
+	Name string `json:"name"`
+
+	// Verified indicates the *arr app's indexer test succeeded
+	Verified bool `json:"verified"`
+
+	// Disabled indicates the operator disabled the indexer after a failed test
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Message contains the test error, if any
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastChecked is when the indexer was last tested
+	// +optional
+	LastChecked *metav1.Time `json:"lastChecked,omitempty"`
+
+	// ObservedGeneration is the config generation the test ran against.
+	// Passing indexers are only re-tested when the generation changes.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// UnrealizedFeature is a requested feature that could not be applied,
+// e.g. because the connected app version does not support it.
+type UnrealizedFeature struct {
+	// Feature identifies what was requested (e.g., "resolution:2160p").
+	Feature string `json:"feature"`
+
+	// Reason explains why it could not be applied.
+	Reason string `json:"reason"`
+}
+
+// InvalidField is a spec value the operator rejected, addressed by its JSON path.
+type InvalidField struct {
+	// Path is the JSON path of the rejected value (e.g., "spec.indexers.direct[0].categories[1]").
+	Path string `json:"path"`
+
+	// Value is the rejected value as written in the spec.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Message explains why the value was rejected.
+	Message string `json:"message"`
+}
+
+// ResidualChange is a change still found after a reconcile, addressed by resource.
+type ResidualChange struct {
+	// Action is the change still needed: create, update or delete.
+	// +kubebuilder:validation:Enum=create;update;delete
+	Action string `json:"action"`
+
+	// ResourceType is the kind of resource (e.g., "QualityProfile").
+	ResourceType string `json:"resourceType"`
+
+	// Name is the resource name in the app.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// MaintenanceSpec defines recurring housekeeping the operator runs in the app.
+type MaintenanceSpec struct {
+	// Interval between maintenance runs. Defaults to 6h.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// ClearBlocklistOlderThan removes blocklist entries older than this (e.g. 720h),
+	// so releases that failed once can be grabbed again.
+	// +optional
+	ClearBlocklistOlderThan *metav1.Duration `json:"clearBlocklistOlderThan,omitempty"`
+
+	// BlocklistPatterns removes blocklist entries whose release title matches one of
+	// these regular expressions (case-insensitive), whatever their age.
+	// +optional
+	BlocklistPatterns []string `json:"blocklistPatterns,omitempty"`
+}
+
+// MaintenanceStatus reports the last maintenance run.
+type MaintenanceStatus struct {
+	// LastRun is when maintenance last completed.
+	// +optional
+	LastRun *metav1.Time `json:"lastRun,omitempty"`
+
+	// BlocklistEntries is the number of blocklist entries left after the last run.
+	// +optional
+	BlocklistEntries int32 `json:"blocklistEntries,omitempty"`
+
+	// BlocklistRemoved is the number of entries the last run removed.
+	// +optional
+	BlocklistRemoved int32 `json:"blocklistRemoved,omitempty"`
+
+	// BlocklistRemovedTotal is the number of entries removed across all runs.
+	// +optional
+	BlocklistRemovedTotal int64 `json:"blocklistRemovedTotal,omitempty"`
+
+	// Error is why the last attempt failed; it is retried on the next reconcile.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// CompiledSummary counts the resources in the compiled configuration.
+type CompiledSummary struct {
+	// QualityProfiles is the number of managed quality profiles.
+	// +optional
+	QualityProfiles int `json:"qualityProfiles,omitempty"`
+
+	// CustomFormats is the number of managed custom formats.
+	// +optional
+	CustomFormats int `json:"customFormats,omitempty"`
+
+	// DownloadClients is the number of managed download clients.
+	// +optional
+	DownloadClients int `json:"downloadClients,omitempty"`
+
+	// Indexers is the number of managed indexers.
+	// +optional
+	Indexers int `json:"indexers,omitempty"`
+
+	// RootFolders is the number of managed root folders.
+	// +optional
+	RootFolders int `json:"rootFolders,omitempty"`
+
+	// ImportLists is the number of managed import lists.
+	// +optional
+	ImportLists int `json:"importLists,omitempty"`
+
+	// Notifications is the number of managed notifications.
+	// +optional
+	Notifications int `json:"notifications,omitempty"`
+
+	// DelayProfiles is the number of managed delay profiles.
+	// +optional
+	DelayProfiles int `json:"delayProfiles,omitempty"`
+
+	// AutoTags is the number of managed auto-tagging rules.
+	// +optional
+	AutoTags int `json:"autoTags,omitempty"`
+
+	// ReleaseProfiles is the number of managed release profiles.
+	// +optional
+	ReleaseProfiles int `json:"releaseProfiles,omitempty"`
+
+	// Applications is the number of apps Prowlarr syncs indexers to (Prowlarr only).
+	// +optional
+	Applications int `json:"applications,omitempty"`
+}
+
 // PolicyStatus is common status for all policies
 type PolicyStatus struct {
 	// Conditions represent the latest observations.
@@ -500,6 +1150,28 @@ type HealthIssueStatus struct {
 // Import List Types
 // =============================================================================
 
+// ImportListOptionsSpec defines the global import list options of Radarr and
+// Sonarr (Settings > Import Lists > Options). Unset fields keep the app's value.
+type ImportListOptionsSpec struct {
+	// CleanLibraryLevel is what happens to library items no longer on any import list.
+	// Both apps: disabled, logOnly, keepAndUnmonitor.
+	// Radarr only: removeAndKeep, removeAndDelete. Sonarr only: keepAndTag.
+	// +optional
+	// +kubebuilder:validation:Enum=disabled;logOnly;keepAndUnmonitor;keepAndTag;removeAndKeep;removeAndDelete
+	CleanLibraryLevel string `json:"cleanLibraryLevel,omitempty"`
+
+	// CleanLibraryTag is the tag given to series dropped from the lists with
+	// cleanLibraryLevel keepAndTag. Sonarr only.
+	// +optional
+	CleanLibraryTag string `json:"cleanLibraryTag,omitempty"`
+
+	// SyncIntervalHours is how often the lists are synced. Radarr only, and
+	// only Radarr versions before v5 expose it.
+	// +optional
+	// +kubebuilder:validation:Minimum=6
+	SyncIntervalHours int `json:"syncIntervalHours,omitempty"`
+}
+
 // ImportListSpec defines an import list configuration for Radarr/Sonarr/Lidarr
 type ImportListSpec struct {
 	// Name is the display name for this import list.
@@ -510,10 +1182,15 @@ type ImportListSpec struct {
 	// For Radarr: IMDbListImport, TraktListImport, TraktPopularImport, TraktUserImport,
 	//             PlexImport, RadarrImport, TMDbListImport, TMDbPopularImport, etc.
 	// For Sonarr: SonarrImport, TraktListImport, TraktPopularImport, PlexImport,
-	//             ImdbImport, etc.
+	//             PlexRssImport, CustomImport, ImdbImport, etc.
 	// For Lidarr: SpotifyFollowedArtists, SpotifyPlaylist, LastFmUser, etc.
-	// +kubebuilder:validation:Required
-	Type string `json:"type"`
+	// For Readarr: GoodreadsListImportList, GoodreadsOwnedBooks, GoodreadsBookshelf,
+	//              LazyLibrarianImport, ReadarrImport, etc.
+	// Required unless one of the typed list settings (plexWatchlist, plexRss,
+	// traktList, custom, goodreadsList, goodreadsOwnedBooks, lazyLibrarian) is
+	// set, which implies the type.
+	// +optional
+	Type string `json:"type,omitempty"`
 
 	// Enabled enables/disables this import list.
 	// +optional
@@ -531,8 +1208,9 @@ type ImportListSpec struct {
 	SearchOnAdd *bool `json:"searchOnAdd,omitempty"`
 
 	// QualityProfile is the name of the quality profile to use.
-	// +kubebuilder:validation:Required
-	QualityProfile string `json:"qualityProfile"`
+	// Required unless a SonarrConfig sets seriesDefaults.qualityProfile.
+	// +optional
+	QualityProfile string `json:"qualityProfile,omitempty"`
 
 	// RootFolder is the root folder path for items from this list.
 	// +kubebuilder:validation:Required
@@ -555,20 +1233,22 @@ type ImportListSpec struct {
 	// --- Sonarr-specific fields ---
 
 	// SeriesType specifies the series type. Sonarr only.
+	// Unset uses seriesDefaults.seriesType, then standard.
 	// +optional
 	// +kubebuilder:validation:Enum=standard;daily;anime
-	// +kubebuilder:default=standard
 	SeriesType string `json:"seriesType,omitempty"`
 
 	// SeasonFolder enables season folders. Sonarr only.
+	// Unset uses seriesDefaults.seasonFolder, then true.
 	// +optional
-	// +kubebuilder:default=true
 	SeasonFolder *bool `json:"seasonFolder,omitempty"`
 
-	// ShouldMonitor specifies what to monitor. Sonarr only.
+	// ShouldMonitor specifies what to monitor. Sonarr and Readarr only.
+	// Sonarr: all, future, missing, existing, firstSeason, latestSeason, pilot or none;
+	// unset uses seriesDefaults.monitor, then all.
+	// Readarr: entireAuthor, specificBook or none; unset monitors the entire author.
 	// +optional
-	// +kubebuilder:validation:Enum=all;future;missing;existing;firstSeason;latestSeason;pilot;none
-	// +kubebuilder:default=all
+	// +kubebuilder:validation:Enum=all;future;missing;existing;firstSeason;latestSeason;pilot;none;entireAuthor;specificBook
 	ShouldMonitor string `json:"shouldMonitor,omitempty"`
 
 	// --- Type-specific settings ---
@@ -587,6 +1267,124 @@ type ImportListSpec struct {
 	// Values from this secret override Settings.
 	// +optional
 	SettingsSecretRef *SecretKeySelector `json:"settingsSecretRef,omitempty"`
+
+	// --- Typed list settings (Sonarr only, at most one) ---
+
+	// PlexWatchlist configures a Plex Watchlist list (PlexImport).
+	// +optional
+	PlexWatchlist *PlexWatchlistImportSpec `json:"plexWatchlist,omitempty"`
+
+	// PlexRSS configures a Plex Watchlist RSS list (PlexRssImport).
+	// +optional
+	PlexRSS *PlexRSSImportSpec `json:"plexRss,omitempty"`
+
+	// TraktList configures a Trakt user list (TraktListImport).
+	// +optional
+	TraktList *TraktListImportSpec `json:"traktList,omitempty"`
+
+	// Custom configures a custom list of TVDB IDs (CustomImport).
+	// +optional
+	Custom *CustomImportListSpec `json:"custom,omitempty"`
+
+	// --- Typed list settings (Readarr only, at most one) ---
+
+	// GoodreadsList configures a public Goodreads list (GoodreadsListImportList).
+	// +optional
+	GoodreadsList *GoodreadsListImportSpec `json:"goodreadsList,omitempty"`
+
+	// GoodreadsOwnedBooks configures the owned books of a Goodreads account (GoodreadsOwnedBooks).
+	// +optional
+	GoodreadsOwnedBooks *GoodreadsOwnedBooksImportSpec `json:"goodreadsOwnedBooks,omitempty"`
+
+	// LazyLibrarian configures the wanted books of a LazyLibrarian instance (LazyLibrarianImport).
+	// +optional
+	LazyLibrarian *LazyLibrarianImportSpec `json:"lazyLibrarian,omitempty"`
+}
+
+// PlexWatchlistImportSpec imports the watchlist of a Plex account
+type PlexWatchlistImportSpec struct {
+	// AccessTokenSecretRef references the Secret containing the Plex token (X-Plex-Token).
+	// +kubebuilder:validation:Required
+	AccessTokenSecretRef SecretKeySelector `json:"accessTokenSecretRef"`
+}
+
+// PlexRSSImportSpec imports a Plex watchlist through its RSS feed.
+// The feed URL is shown under Watchlist > RSS in Plex.
+type PlexRSSImportSpec struct {
+	// URL is the RSS feed URL of the watchlist.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+}
+
+// TraktListImportSpec imports a list of a Trakt user
+type TraktListImportSpec struct {
+	// Username is the Trakt user owning the list.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username"`
+
+	// ListName is the list slug as shown in its URL (e.g., "watchlist" or "my-shows").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ListName string `json:"listName"`
+
+	// AccessTokenSecretRef references the Secret containing the Trakt OAuth access token.
+	// +kubebuilder:validation:Required
+	AccessTokenSecretRef SecretKeySelector `json:"accessTokenSecretRef"`
+
+	// RefreshTokenSecretRef references the Secret containing the Trakt OAuth refresh token.
+	// +optional
+	RefreshTokenSecretRef *SecretKeySelector `json:"refreshTokenSecretRef,omitempty"`
+
+	// Limit is the maximum number of series to import.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Limit *int `json:"limit,omitempty"`
+
+	// AdditionalParameters are appended to the Trakt API request (e.g., "&genres=drama").
+	// +optional
+	AdditionalParameters string `json:"additionalParameters,omitempty"`
+}
+
+// GoodreadsListImportSpec imports the books of a public Goodreads list
+type GoodreadsListImportSpec struct {
+	// ListID is the numeric ID at the start of the list URL
+	// (e.g., 1 for https://www.goodreads.com/list/show/1.Best_Books_Ever).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	ListID int `json:"listId"`
+}
+
+// GoodreadsOwnedBooksImportSpec imports the books a Goodreads account owns.
+// Goodreads only grants access through an OAuth session, so the session is
+// taken from a Secret holding the values Readarr stores after signing in.
+type GoodreadsOwnedBooksImportSpec struct {
+	// SessionSecretRef references a Secret with the keys accessToken,
+	// accessTokenSecret, userId and userName of a signed-in Goodreads session.
+	// +kubebuilder:validation:Required
+	SessionSecretRef LocalObjectReference `json:"sessionSecretRef"`
+}
+
+// LazyLibrarianImportSpec imports the wanted books of a LazyLibrarian instance
+type LazyLibrarianImportSpec struct {
+	// URL is the LazyLibrarian base URL.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// APIKeySecretRef references the Secret containing the LazyLibrarian API key.
+	// +kubebuilder:validation:Required
+	APIKeySecretRef SecretKeySelector `json:"apiKeySecretRef"`
+}
+
+// CustomImportListSpec imports series from a URL returning a JSON array of
+// objects with a tvdbId
+type CustomImportListSpec struct {
+	// URL returns the list.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
 }
 
 // =============================================================================
@@ -647,6 +1445,38 @@ type MediaManagementSpec struct {
 	AllowFingerprinting string `json:"allowFingerprinting,omitempty"`
 }
 
+// =============================================================================
+// UI Types
+// =============================================================================
+
+// UISpec defines the UI settings of Radarr and Sonarr (Settings > UI).
+// Unset fields keep the app's value.
+type UISpec struct {
+	// FirstDayOfWeek is the first day of the calendar week.
+	// +optional
+	// +kubebuilder:validation:Enum=sunday;monday
+	FirstDayOfWeek string `json:"firstDayOfWeek,omitempty"`
+
+	// TimeFormat is the clock used for times: 12h (5:30pm) or 24h (17:30).
+	// +optional
+	// +kubebuilder:validation:Enum="12h";"24h"
+	TimeFormat string `json:"timeFormat,omitempty"`
+
+	// Theme is the color theme. auto follows the browser.
+	// +optional
+	// +kubebuilder:validation:Enum=auto;light;dark
+	Theme string `json:"theme,omitempty"`
+
+	// UILanguage is the language of the UI, by name (e.g., "German").
+	// +optional
+	UILanguage string `json:"uiLanguage,omitempty"`
+
+	// MovieRuntimeFormat is how movie runtimes are shown. Radarr only.
+	// +optional
+	// +kubebuilder:validation:Enum=hoursMinutes;minutes
+	MovieRuntimeFormat string `json:"movieRuntimeFormat,omitempty"`
+}
+
 // =============================================================================
 // Authentication Types
 // =============================================================================
@@ -750,6 +1580,83 @@ type CustomFormatSpecificationSpec struct {
 	Value string `json:"value"`
 }
 
+// =============================================================================
+// Auto Tagging Types
+// =============================================================================
+
+// AutoTagSpec defines an auto-tagging rule (Radarr/Sonarr).
+// Movies or series matching the specifications get the rule's tags.
+type AutoTagSpec struct {
+	// Name is the name of this rule. It is prefixed with the config name in the app.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Tags are applied to every item matching the specifications.
+	// Missing tags are created.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Tags []string `json:"tags"`
+
+	// RemoveTagsAutomatically removes the tags again from items that stop matching.
+	// +optional
+	// +kubebuilder:default=false
+	RemoveTagsAutomatically *bool `json:"removeTagsAutomatically,omitempty"`
+
+	// Specifications define the conditions an item must meet. Required
+	// specifications must all match; otherwise any one of them is enough.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Specifications []AutoTagSpecificationSpec `json:"specifications"`
+}
+
+// AutoTagSpecificationSpec defines a single condition of an auto-tagging rule.
+type AutoTagSpecificationSpec struct {
+	// Name is the display name for this specification.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Type is the specification implementation type.
+	// Common types:
+	//   - GenreSpecification: Match any of Values
+	//   - RootFolderSpecification: Match the root folder path in Value
+	//   - OriginalLanguageSpecification: Match the language ID in Value
+	//   - QualityProfileSpecification: Match the quality profile ID in Value
+	//   - StatusSpecification: Match the status ID in Value
+	//   - YearSpecification: Match years between Min and Max
+	//   - MonitoredSpecification: Match monitored items (no value)
+	//   - SeriesTypeSpecification: Match the series type ID in Value (Sonarr only)
+	// Use the /api/v3/autotagging/schema endpoint to discover all available types.
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// Negate inverts the match logic.
+	// +optional
+	// +kubebuilder:default=false
+	Negate *bool `json:"negate,omitempty"`
+
+	// Required makes this specification mandatory for the rule to match.
+	// +optional
+	// +kubebuilder:default=false
+	Required *bool `json:"required,omitempty"`
+
+	// Value is the value of single-value specifications.
+	// Numeric values are sent as numbers.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Values lists the values of list specifications such as GenreSpecification.
+	// +optional
+	Values []string `json:"values,omitempty"`
+
+	// Min is the lower bound of range specifications such as YearSpecification.
+	// +optional
+	Min *int `json:"min,omitempty"`
+
+	// Max is the upper bound of range specifications such as YearSpecification.
+	// +optional
+	Max *int `json:"max,omitempty"`
+}
+
 // =============================================================================
 // Delay Profile Types
 // =============================================================================
@@ -811,24 +1718,62 @@ type DelayProfileSpec struct {
 	MinimumCustomFormatScore int `json:"minimumCustomFormatScore,omitempty"`
 
 	// Tags restricts this delay profile to items with matching tags.
-	// If empty, the profile applies to all items.
+	// Tags that don't exist yet are created. If empty, the profile configures
+	// the app's default delay profile, which applies to all other items.
 	// +optional
 	Tags []string `json:"tags,omitempty"`
 
 	// Order determines the priority of this profile (lower = higher priority).
 	// If not specified, profiles are ordered by their position in the array.
+	// The default (untagged) profile is always evaluated last.
 	// +optional
 	// +kubebuilder:validation:Minimum=1
 	Order *int `json:"order,omitempty"`
 }
 
 // =============================================================================
-// Release Profile Types (Sonarr only)
+// Quality Definition Types (Radarr/Sonarr only)
+// =============================================================================
+
+// QualityDefinitionSpec sets the size limits for a single quality.
+// Sizes are in megabytes per minute of runtime, matching the *arr UI sliders
+// and the values published by the TRaSH guides.
+type QualityDefinitionSpec struct {
+	// Quality is the quality name as shown in the app (e.g., "Bluray-1080p", "WEBDL-2160p").
+	// +kubebuilder:validation:Required
+	Quality string `json:"quality"`
+
+	// Title overrides the display title of the quality.
+	// +optional
+	Title string `json:"title,omitempty"`
+
+	// MinSize is the minimum size in MB per minute.
+	// If not specified, the current value is left unchanged.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	MinSize string `json:"minSize,omitempty"`
+
+	// MaxSize is the maximum size in MB per minute, or "unlimited".
+	// If not specified, the current value is left unchanged.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?|unlimited)$`
+	MaxSize string `json:"maxSize,omitempty"`
+
+	// PreferredSize is the preferred size in MB per minute, or "unlimited".
+	// If not specified, the current value is left unchanged.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?|unlimited)$`
+	PreferredSize string `json:"preferredSize,omitempty"`
+}
+
+// =============================================================================
+// Release Profile Types (Sonarr/Lidarr)
 // =============================================================================
 
 // ReleaseProfileSpec defines a release profile for filtering and scoring releases.
 // Release profiles allow matching releases by title patterns and assigning scores,
-// or requiring/ignoring certain terms. This is Sonarr-specific functionality.
+// or requiring/ignoring certain terms. Used by Sonarr and Lidarr; Lidarr release
+// profiles have no name, so Name only identifies the profile in status and events.
 type ReleaseProfileSpec struct {
 	// Name is the display name for this release profile.
 	// +kubebuilder:validation:Required
@@ -867,8 +1812,8 @@ type ReleaseProfileSpec struct {
 	// +optional
 	IndexerID int `json:"indexerId,omitempty"`
 
-	// Tags restricts this release profile to series with matching tags.
-	// If empty, the profile applies to all series.
+	// Tags restricts this release profile to series (or artists) with matching tags.
+	// If empty, the profile applies to all series (or artists).
 	// +optional
 	Tags []string `json:"tags,omitempty"`
 }
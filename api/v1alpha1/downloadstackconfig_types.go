@@ -39,6 +39,10 @@ type GluetunSpec struct {
 	// +optional
 	Server *GluetunServerSpec `json:"server,omitempty"`
 
+	// WireGuard tunnel settings (only used when VPNType is wireguard)
+	// +optional
+	WireGuard *GluetunWireGuardSpec `json:"wireguard,omitempty"`
+
 	// Firewall settings
 	// +optional
 	Firewall *GluetunFirewallSpec `json:"firewall,omitempty"`
@@ -94,6 +98,43 @@ type GluetunServerSpec struct {
 	Hostnames []string `json:"hostnames,omitempty"`
 }
 
+// GluetunWireGuardSpec defines WireGuard tunnel settings.
+// Providers with generated configs (ProtonVPN, Mullvad, ...) need Addresses from the
+// config file; custom servers also need PublicKey and the endpoint.
+type GluetunWireGuardSpec struct {
+	// Addresses are the interface addresses in CIDR notation (e.g., ["10.2.0.2/32"])
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+
+	// MTU of the WireGuard interface (Gluetun defaults to 1320)
+	// +optional
+	// +kubebuilder:validation:Minimum=1280
+	// +kubebuilder:validation:Maximum=65535
+	MTU *int `json:"mtu,omitempty"`
+
+	// PresharedKeySecretRef for the peer preshared key
+	// +optional
+	PresharedKeySecretRef *SecretKeySelector `json:"presharedKeySecretRef,omitempty"`
+
+	// PublicKey is the server's public key (custom provider)
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// EndpointIP overrides the server IP address
+	// +optional
+	EndpointIP string `json:"endpointIp,omitempty"`
+
+	// EndpointPort overrides the server port
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	EndpointPort *int `json:"endpointPort,omitempty"`
+
+	// AllowedIPs routed through the tunnel (Gluetun defaults to all traffic)
+	// +optional
+	AllowedIPs []string `json:"allowedIps,omitempty"`
+}
+
 // GluetunFirewallSpec defines firewall settings
 type GluetunFirewallSpec struct {
 	// VPNInputPorts are ports to allow inbound on VPN interface
@@ -133,6 +174,29 @@ type GluetunDNSSpec struct {
 	// KeepNameserver keeps the existing nameserver
 	// +optional
 	KeepNameserver bool `json:"keepNameserver,omitempty"`
+
+	// BlockMalicious blocks malicious hostnames and IPs. Unset keeps Gluetun's default (on).
+	// +optional
+	BlockMalicious *bool `json:"blockMalicious,omitempty"`
+
+	// BlockAds blocks ad hostnames and IPs. Unset keeps Gluetun's default (off).
+	// +optional
+	BlockAds *bool `json:"blockAds,omitempty"`
+
+	// BlockSurveillance blocks surveillance hostnames and IPs. Unset keeps Gluetun's default (off).
+	// +optional
+	BlockSurveillance *bool `json:"blockSurveillance,omitempty"`
+
+	// Providers are the DoT providers to resolve through, in order
+	// (e.g., cloudflare, google, quad9). Unset keeps Gluetun's default (cloudflare).
+	// +optional
+	Providers []string `json:"providers,omitempty"`
+
+	// UpstreamAddresses are plain DNS servers (IP or IP:port, port 53 if omitted)
+	// to resolve through instead of the DoT providers, e.g. a resolver in the
+	// cluster. Cannot be combined with overTls or providers. Requires Gluetun v3.40 or later.
+	// +optional
+	UpstreamAddresses []string `json:"upstreamAddresses,omitempty"`
 }
 
 // GluetunIPv6Spec defines IPv6 settings
@@ -150,6 +214,34 @@ type GluetunLoggingSpec struct {
 	Level string `json:"level,omitempty"`
 }
 
+// =============================================================================
+// Default Tracker Types
+// =============================================================================
+
+// TrackerListSpec is a list of tracker announce URLs, given inline or read from
+// a ConfigMap so one list can be shared by several clients and stacks
+type TrackerListSpec struct {
+	// Trackers are announce URLs (e.g., udp://tracker.opentrackr.org:1337/announce)
+	// +optional
+	Trackers []string `json:"trackers,omitempty"`
+
+	// ConfigMapRef references a ConfigMap key holding announce URLs, one per
+	// line. Blank lines separate tiers. Its trackers follow Trackers.
+	// +optional
+	ConfigMapRef *ConfigMapKeySelector `json:"configMapRef,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap in the same namespace
+type ConfigMapKeySelector struct {
+	// Name is the name of the ConfigMap
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key is the key within the ConfigMap
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
+
 // =============================================================================
 // Transmission Types
 // =============================================================================
@@ -191,6 +283,92 @@ type TransmissionSpec struct {
 	// Blocklist settings
 	// +optional
 	Blocklist *TransmissionBlocklistSpec `json:"blocklist,omitempty"`
+
+	// SettingsFile also renders the settings into a settings.json Secret for the
+	// Deployment to mount, for settings Transmission only reads at start
+	// +optional
+	SettingsFile *TransmissionSettingsFileSpec `json:"settingsFile,omitempty"`
+
+	// TorrentPolicy labels, moves and removes existing torrents on every sync
+	// +optional
+	TorrentPolicy *TransmissionTorrentPolicySpec `json:"torrentPolicy,omitempty"`
+
+	// Trackers configures tracker settings
+	// +optional
+	Trackers *TransmissionTrackersSpec `json:"trackers,omitempty"`
+}
+
+// TransmissionTrackersSpec defines tracker settings
+type TransmissionTrackersSpec struct {
+	// DefaultTrackerList is added to every public torrent (Transmission 4.0+).
+	// An empty list clears the default trackers.
+	// +optional
+	DefaultTrackerList *TrackerListSpec `json:"defaultTrackerList,omitempty"`
+}
+
+// TransmissionTorrentPolicySpec is enforced on the torrent list on every sync
+type TransmissionTorrentPolicySpec struct {
+	// Categories label torrents by download directory and move finished
+	// torrents to a per-category path
+	// +optional
+	Categories []TransmissionTorrentCategorySpec `json:"categories,omitempty"`
+
+	// RemovalRules remove finished torrents that exceed a ratio or seeding time.
+	// The first rule matching a torrent applies.
+	// +optional
+	RemovalRules []TransmissionRemovalRule `json:"removalRules,omitempty"`
+
+	// DryRun reports what the policy would change without changing anything
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// TransmissionTorrentCategorySpec assigns torrents to a category
+type TransmissionTorrentCategorySpec struct {
+	// Name is the label applied to the category's torrents. Torrents already
+	// carrying it belong to the category wherever they are stored.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// DownloadDir assigns torrents stored in or under this directory to the category
+	// (e.g., /downloads/tv-sonarr)
+	// +optional
+	DownloadDir string `json:"downloadDir,omitempty"`
+
+	// CompleteDir is where finished torrents of the category are moved.
+	// Unset leaves them where they are.
+	// +optional
+	CompleteDir string `json:"completeDir,omitempty"`
+}
+
+// TransmissionRemovalRule removes finished torrents past a ratio or seeding time
+type TransmissionRemovalRule struct {
+	// Name identifies the rule in status
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Tracker is a regular expression matched against the torrent's announce URLs.
+	// Unset matches every tracker.
+	// +optional
+	Tracker string `json:"tracker,omitempty"`
+
+	// Category restricts the rule to torrents of a category from categories
+	// +optional
+	Category string `json:"category,omitempty"`
+
+	// RatioLimit removes torrents that reached this upload ratio (e.g., "2.0")
+	// +optional
+	RatioLimit string `json:"ratioLimit,omitempty"`
+
+	// SeedingTimeLimit removes torrents seeded for this many minutes
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	SeedingTimeLimit *int `json:"seedingTimeLimit,omitempty"`
+
+	// DeleteData also deletes the downloaded files
+	// +optional
+	DeleteData bool `json:"deleteData,omitempty"`
 }
 
 // TransmissionConnectionSpec defines how to connect to Transmission
@@ -363,6 +541,40 @@ type TransmissionSecuritySpec struct {
 	UTPEnabled *bool `json:"utpEnabled,omitempty"`
 }
 
+// TransmissionSettingsFileSpec defines the settings.json projection.
+// The Secret is named <config>-transmission-settings, key settings.json.
+type TransmissionSettingsFileSpec struct {
+	// RPCWhitelist lists the addresses allowed to use RPC (wildcards allowed, e.g. 192.168.*.*).
+	// Empty disables the whitelist.
+	// +optional
+	RPCWhitelist []string `json:"rpcWhitelist,omitempty"`
+
+	// RPCHostWhitelist lists the host names RPC answers to (DNS rebinding protection).
+	// Empty disables the whitelist.
+	// +optional
+	RPCHostWhitelist []string `json:"rpcHostWhitelist,omitempty"`
+
+	// ClusterWhitelist adds the cluster's pod networks (read from Node podCIDRs) and
+	// localhost to rpcWhitelist, and the Transmission Service's in-cluster names to
+	// rpcHostWhitelist, so apps in other namespaces are not rejected with 403 or 421.
+	// Transmission only matches wildcards, so a pod CIDR not ending on an octet
+	// boundary is split into the wildcards covering it (10.0.16.0/20 becomes
+	// 10.0.16.* to 10.0.31.*). Ranges of Nodes that leave are kept, so node churn
+	// doesn't change the file.
+	// +optional
+	ClusterWhitelist bool `json:"clusterWhitelist,omitempty"`
+
+	// RPCAuthenticationRequired sets rpc-authentication-required. When true, the
+	// connection credentials are written as rpc-username and rpc-password, so
+	// connection.credentialsSecretRef is required. Unset leaves Transmission's value.
+	// +optional
+	RPCAuthenticationRequired *bool `json:"rpcAuthenticationRequired,omitempty"`
+
+	// RestartOnChange restarts the Deployment when the rendered file changes
+	// +optional
+	RestartOnChange bool `json:"restartOnChange,omitempty"`
+}
+
 // TransmissionBlocklistSpec defines blocklist settings
 type TransmissionBlocklistSpec struct {
 	// Enabled enables blocklist
@@ -411,6 +623,93 @@ type QBittorrentSpec struct {
 	// BitTorrent protocol settings
 	// +optional
 	BitTorrent *QBittorrentBitTorrentSpec `json:"bittorrent,omitempty"`
+
+	// Torrents sets defaults applied to newly added torrents
+	// +optional
+	Torrents *QBittorrentTorrentDefaultsSpec `json:"torrents,omitempty"`
+
+	// Categories are created in qBittorrent if missing and their save paths kept
+	// in sync, so download clients can reference them
+	// +optional
+	Categories []QBittorrentCategorySpec `json:"categories,omitempty"`
+
+	// TrackerRules set share and upload limits per tracker. They are enforced
+	// on existing torrents on every sync and override the global seeding limits.
+	// +optional
+	TrackerRules []QBittorrentTrackerRule `json:"trackerRules,omitempty"`
+
+	// Trackers configures tracker settings
+	// +optional
+	Trackers *QBittorrentTrackersSpec `json:"trackers,omitempty"`
+}
+
+// QBittorrentTrackersSpec defines tracker settings
+type QBittorrentTrackersSpec struct {
+	// AddTrackers are automatically added to new downloads. An empty list
+	// turns "Automatically add these trackers to new downloads" off.
+	// +optional
+	AddTrackers *TrackerListSpec `json:"addTrackers,omitempty"`
+}
+
+// QBittorrentTrackerRule sets the limits of torrents from matching trackers
+type QBittorrentTrackerRule struct {
+	// Name identifies the rule in status
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Tracker is a regular expression matched against the torrent's current
+	// tracker URL (e.g., "privatehd\.example"). The first matching rule applies.
+	// +kubebuilder:validation:Required
+	Tracker string `json:"tracker"`
+
+	// RatioLimit is the seeding ratio limit (e.g., "2.0", "-1" for no limit).
+	// Unset uses the global limit.
+	// +optional
+	RatioLimit string `json:"ratioLimit,omitempty"`
+
+	// SeedingTimeLimit is the seeding time limit in minutes (-1 = no limit).
+	// Unset uses the global limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=-1
+	SeedingTimeLimit *int `json:"seedingTimeLimit,omitempty"`
+
+	// UploadLimit in KiB/s (0 = unlimited). Unset leaves the torrent's limit alone.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	UploadLimit *int `json:"uploadLimit,omitempty"`
+}
+
+// QBittorrentCategorySpec defines a torrent category
+type QBittorrentCategorySpec struct {
+	// Name is the category name
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// SavePath overrides the default save path for torrents in this category
+	// +optional
+	SavePath string `json:"savePath,omitempty"`
+}
+
+// QBittorrentTorrentDefaultsSpec defines per-torrent default behaviors.
+// Radarr/Sonarr category routing expects automatic torrent management enabled.
+type QBittorrentTorrentDefaultsSpec struct {
+	// AutoTMMEnabled enables Automatic Torrent Management, so a torrent's save path
+	// follows its category
+	// +optional
+	AutoTMMEnabled *bool `json:"autoTMMEnabled,omitempty"`
+
+	// TorrentContentLayout controls the folder layout of multi-file torrents
+	// +optional
+	// +kubebuilder:validation:Enum=Original;Subfolder;NoSubfolder
+	TorrentContentLayout string `json:"torrentContentLayout,omitempty"`
+
+	// StartPausedEnabled adds new torrents paused (stopped in qBittorrent 5)
+	// +optional
+	StartPausedEnabled *bool `json:"startPausedEnabled,omitempty"`
+
+	// Tags are created in qBittorrent if missing, so download clients can reference them
+	// +optional
+	Tags []string `json:"tags,omitempty"`
 }
 
 // QBittorrentConnectionSpec defines how to connect to qBittorrent
@@ -637,19 +936,56 @@ type DelugeSpec struct {
 	// Protocol settings (DHT, encryption, etc.)
 	// +optional
 	Protocol *DelugeProtocolSpec `json:"protocol,omitempty"`
+
+	// Labels are created in Deluge if missing, so download clients can reference
+	// them. The Label plugin is enabled when any are declared.
+	// +optional
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9_-]+$`
+	Labels []string `json:"labels,omitempty"`
 }
 
 // DelugeConnectionSpec defines how to connect to Deluge
 type DelugeConnectionSpec struct {
-	// URL to Deluge Web UI (e.g., http://localhost:8112)
-	// +kubebuilder:validation:Required
-	// +kubebuilder:default="http://localhost:8112"
-	URL string `json:"url"`
+	// URL to Deluge Web UI (e.g., http://localhost:8112).
+	// Leave empty and set Daemon to connect to the daemon directly.
+	// Defaults to http://localhost:8112 when Daemon is not set.
+	// +optional
+	URL string `json:"url,omitempty"`
 
 	// PasswordSecretRef references the password Secret for Deluge Web UI.
 	// Deluge Web UI uses a single password for authentication (default: "deluge").
 	// +optional
 	PasswordSecretRef *SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// Daemon configures the Deluge daemon RPC connection.
+	// Without URL, settings are applied directly over the daemon RPC (no Web UI needed).
+	// With URL, the daemon is added to the Web UI host list and the Web UI connects to it.
+	// +optional
+	Daemon *DelugeDaemonSpec `json:"daemon,omitempty"`
+}
+
+// DelugeDaemonSpec defines how to connect to the Deluge daemon RPC
+type DelugeDaemonSpec struct {
+	// Host is the daemon hostname or IP
+	// +kubebuilder:validation:Required
+	Host string `json:"host"`
+
+	// Port is the daemon RPC port
+	// +optional
+	// +kubebuilder:default=58846
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int `json:"port,omitempty"`
+
+	// Username selects the auth file entry to authenticate as.
+	// If empty, the first user other than "localclient" is used.
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// AuthSecretRef references a Secret key holding the daemon auth file
+	// (lines of "username:password:level"). Set Key to the auth file key (e.g., "auth").
+	// +kubebuilder:validation:Required
+	AuthSecretRef SecretKeySelector `json:"authSecretRef"`
 }
 
 // DelugeSpeedSpec defines speed limit settings
@@ -824,6 +1160,11 @@ type RTorrentSpec struct {
 	// Protocol settings
 	// +optional
 	Protocol *RTorrentProtocolSpec `json:"protocol,omitempty"`
+
+	// ConfigFile also renders the settings into an rtorrent.rc Secret for the
+	// Deployment to mount, for settings rTorrent can't change over XML-RPC
+	// +optional
+	ConfigFile *RTorrentConfigFileSpec `json:"configFile,omitempty"`
 }
 
 // RTorrentConnectionSpec defines how to connect to rTorrent
@@ -855,9 +1196,22 @@ type RTorrentDirectoriesSpec struct {
 	// +optional
 	Directory string `json:"directory,omitempty"`
 
-	// SessionDirectory is the session data directory
+	// SessionDirectory is the session data directory (applied through configFile only)
 	// +optional
 	SessionDirectory string `json:"sessionDirectory,omitempty"`
+
+	// WatchDirectory is scanned for .torrent files, which are loaded and started
+	// (applied through configFile only)
+	// +optional
+	WatchDirectory string `json:"watchDirectory,omitempty"`
+}
+
+// RTorrentConfigFileSpec defines the rtorrent.rc projection.
+// The Secret is named <config>-rtorrent-config, key rtorrent.rc.
+type RTorrentConfigFileSpec struct {
+	// RestartOnChange restarts the Deployment when the rendered file changes
+	// +optional
+	RestartOnChange bool `json:"restartOnChange,omitempty"`
 }
 
 // RTorrentSeedingSpec defines seeding limit settings
@@ -925,6 +1279,94 @@ type RTorrentProtocolSpec struct {
 	Encryption string `json:"encryption,omitempty"`
 }
 
+// =============================================================================
+// Flood Types
+// =============================================================================
+
+// FloodSpec defines Flood web UI configuration. Flood fronts a torrent client
+// (commonly rTorrent); the operator provisions its users and their connection
+// to the client through the Flood API.
+type FloodSpec struct {
+	// Connection settings
+	// +kubebuilder:validation:Required
+	Connection FloodConnectionSpec `json:"connection"`
+
+	// Client is the connection to the underlying torrent client, for the
+	// administrator and for users without their own
+	// +kubebuilder:validation:Required
+	Client FloodClientSpec `json:"client"`
+
+	// Users to provision besides the administrator. Users removed from this list
+	// are deleted from Flood on the next reconcile.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Users []FloodUserSpec `json:"users,omitempty"`
+}
+
+// FloodConnectionSpec defines how to connect to Flood
+type FloodConnectionSpec struct {
+	// URL to Flood (e.g., http://localhost:3000)
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// CredentialsSecretRef references the Flood administrator credentials.
+	// The administrator is created if Flood has no users yet.
+	// +kubebuilder:validation:Required
+	CredentialsSecretRef CredentialsSecretRef `json:"credentialsSecretRef"`
+}
+
+// FloodClientSpec defines how Flood connects to the torrent client
+type FloodClientSpec struct {
+	// Type of the torrent client
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=rtorrent;qbittorrent;transmission;deluge
+	Type string `json:"type"`
+
+	// URL of the qBittorrent WebUI or the Transmission RPC
+	// (e.g., http://localhost:8080, http://localhost:9091/transmission/rpc)
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Host of the rTorrent SCGI port or the Deluge daemon
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// Port of the rTorrent SCGI port or the Deluge daemon
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int `json:"port,omitempty"`
+
+	// Socket is the path of the rTorrent SCGI socket, instead of Host and Port
+	// +optional
+	Socket string `json:"socket,omitempty"`
+
+	// CredentialsSecretRef for qBittorrent, Transmission and Deluge
+	// +optional
+	CredentialsSecretRef *CredentialsSecretRef `json:"credentialsSecretRef,omitempty"`
+}
+
+// FloodUserSpec defines a Flood user
+type FloodUserSpec struct {
+	// Name is the username
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// PasswordSecretRef references the user's password.
+	// Set Key to the password key (e.g., "password").
+	// +kubebuilder:validation:Required
+	PasswordSecretRef SecretKeySelector `json:"passwordSecretRef"`
+
+	// Admin grants the user administrator access
+	// +optional
+	Admin bool `json:"admin,omitempty"`
+
+	// Client overrides spec.flood.client for this user
+	// +optional
+	Client *FloodClientSpec `json:"client,omitempty"`
+}
+
 // =============================================================================
 // SABnzbd Types
 // =============================================================================
@@ -954,6 +1396,10 @@ type SABnzbdSpec struct {
 	// Post-processing settings
 	// +optional
 	PostProcessing *SABnzbdPostProcessingSpec `json:"postProcessing,omitempty"`
+
+	// NewsServers references the NewsServerPolicies configured as SABnzbd servers
+	// +optional
+	NewsServers []NewsServerRef `json:"newsServers,omitempty"`
 }
 
 // SABnzbdConnectionSpec defines how to connect to SABnzbd
@@ -980,9 +1426,10 @@ type SABnzbdSpeedSpec struct {
 	// +kubebuilder:validation:Maximum=100
 	SpeedLimitPercentage int `json:"speedLimitPercentage,omitempty"`
 
-	// PauseDownloads pauses all downloads
+	// PauseDownloads pauses all downloads when true and resumes them when false.
+	// Unset leaves the queue as it is.
 	// +optional
-	PauseDownloads bool `json:"pauseDownloads,omitempty"`
+	PauseDownloads *bool `json:"pauseDownloads,omitempty"`
 }
 
 // SABnzbdDirectoriesSpec defines directory settings
@@ -1030,9 +1477,9 @@ type SABnzbdCategorySpec struct {
 
 // SABnzbdQueueSpec defines queue settings
 type SABnzbdQueueSpec struct {
-	// PreCheck enables pre-download check
+	// PreCheck enables pre-download check. Unset leaves SABnzbd's value.
 	// +optional
-	PreCheck bool `json:"preCheck,omitempty"`
+	PreCheck *bool `json:"preCheck,omitempty"`
 
 	// MaxRetries is the max number of retries per server
 	// +optional
@@ -1053,13 +1500,13 @@ type SABnzbdPostProcessingSpec struct {
 	// +optional
 	QuickCheck bool `json:"quickCheck,omitempty"`
 
-	// UnpackEnabled enables automatic unpacking
+	// UnpackEnabled enables automatic unpacking. Unset leaves SABnzbd's value.
 	// +optional
-	UnpackEnabled bool `json:"unpackEnabled,omitempty"`
+	UnpackEnabled *bool `json:"unpackEnabled,omitempty"`
 
-	// CleanupEnabled cleans up files after unpacking
+	// CleanupEnabled cleans up files after unpacking. Unset leaves SABnzbd's value.
 	// +optional
-	CleanupEnabled bool `json:"cleanupEnabled,omitempty"`
+	CleanupEnabled *bool `json:"cleanupEnabled,omitempty"`
 
 	// ScriptEnabled enables post-processing scripts
 	// +optional
@@ -1099,6 +1546,10 @@ type NZBGetSpec struct {
 	// Connections settings
 	// +optional
 	Connections *NZBGetConnectionsSpec `json:"connections,omitempty"`
+
+	// NewsServers references the NewsServerPolicies configured as NZBGet servers
+	// +optional
+	NewsServers []NewsServerRef `json:"newsServers,omitempty"`
 }
 
 // NZBGetConnectionSpec defines how to connect to NZBGet
@@ -1176,13 +1627,13 @@ type NZBGetCategorySpec struct {
 
 // NZBGetQueueSpec defines queue settings
 type NZBGetQueueSpec struct {
-	// FlushQueue writes queue to disk immediately
+	// FlushQueue writes queue to disk immediately. Unset leaves NZBGet's value.
 	// +optional
-	FlushQueue bool `json:"flushQueue,omitempty"`
+	FlushQueue *bool `json:"flushQueue,omitempty"`
 
-	// DupeCheck enables duplicate checking
+	// DupeCheck enables duplicate checking. Unset leaves NZBGet's value.
 	// +optional
-	DupeCheck bool `json:"dupeCheck,omitempty"`
+	DupeCheck *bool `json:"dupeCheck,omitempty"`
 
 	// PropagationDelay is the delay before downloading in seconds
 	// +optional
@@ -1241,6 +1692,70 @@ type NZBGetConnectionsSpec struct {
 	Decode *bool `json:"decode,omitempty"`
 }
 
+// =============================================================================
+// Named Download Client Instances
+// =============================================================================
+
+// TransmissionInstanceSpec is an additional, named transmission instance
+type TransmissionInstanceSpec struct {
+	// Name identifies the instance in status and in downloadStackInstance of *arr download clients
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	TransmissionSpec `json:",inline"`
+}
+
+// QBittorrentInstanceSpec is an additional, named qBittorrent instance
+type QBittorrentInstanceSpec struct {
+	// Name identifies the instance in status and in downloadStackInstance of *arr download clients
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	QBittorrentSpec `json:",inline"`
+}
+
+// DelugeInstanceSpec is an additional, named Deluge instance
+type DelugeInstanceSpec struct {
+	// Name identifies the instance in status and in downloadStackInstance of *arr download clients
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	DelugeSpec `json:",inline"`
+}
+
+// RTorrentInstanceSpec is an additional, named rTorrent instance
+type RTorrentInstanceSpec struct {
+	// Name identifies the instance in status and in downloadStackInstance of *arr download clients
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	RTorrentSpec `json:",inline"`
+}
+
+// SABnzbdInstanceSpec is an additional, named SABnzbd instance
+type SABnzbdInstanceSpec struct {
+	// Name identifies the instance in status and in downloadStackInstance of *arr download clients
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	SABnzbdSpec `json:",inline"`
+}
+
+// NZBGetInstanceSpec is an additional, named NZBGet instance
+type NZBGetInstanceSpec struct {
+	// Name identifies the instance in status and in downloadStackInstance of *arr download clients
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	NZBGetSpec `json:",inline"`
+}
+
 // =============================================================================
 // DownloadStackConfig
 // =============================================================================
@@ -1285,6 +1800,52 @@ type DownloadStackConfigSpec struct {
 	// +optional
 	NZBGet *NZBGetSpec `json:"nzbget,omitempty"`
 
+	// Flood configuration (applied via the Flood API).
+	// Flood is a web UI in front of a torrent client, not a download client itself.
+	// +optional
+	Flood *FloodSpec `json:"flood,omitempty"`
+
+	// Named instances, for running several clients of one type behind the same
+	// Gluetun (e.g. a 4K and a 1080p qBittorrent). They are configured like the
+	// unnamed client of their type, which they can be combined with.
+
+	// TransmissionInstances configures additional Transmission instances.
+	// settingsFile is only supported on spec.transmission.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	TransmissionInstances []TransmissionInstanceSpec `json:"transmissionInstances,omitempty"`
+
+	// QBittorrentInstances configures additional qBittorrent instances
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	QBittorrentInstances []QBittorrentInstanceSpec `json:"qbittorrentInstances,omitempty"`
+
+	// DelugeInstances configures additional Deluge instances
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	DelugeInstances []DelugeInstanceSpec `json:"delugeInstances,omitempty"`
+
+	// RTorrentInstances configures additional rTorrent instances
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	RTorrentInstances []RTorrentInstanceSpec `json:"rtorrentInstances,omitempty"`
+
+	// SABnzbdInstances configures additional SABnzbd instances
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	SABnzbdInstances []SABnzbdInstanceSpec `json:"sabnzbdInstances,omitempty"`
+
+	// NZBGetInstances configures additional NZBGet instances
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	NZBGetInstances []NZBGetInstanceSpec `json:"nzbgetInstances,omitempty"`
+
 	// RestartOnGluetunChange triggers Deployment restart when Gluetun config changes
 	// +kubebuilder:default=true
 	RestartOnGluetunChange bool `json:"restartOnGluetunChange,omitempty"`
@@ -1294,6 +1855,57 @@ type DownloadStackConfigSpec struct {
 	Reconciliation *ReconciliationSpec `json:"reconciliation,omitempty"`
 }
 
+// instanceSpec returns the unnamed spec when name is empty, otherwise the spec
+// of the named instance (nil if there is none)
+func instanceSpec[S, I any](unnamed *S, instances []I, name string, split func(*I) (string, *S)) *S {
+	if name == "" {
+		return unnamed
+	}
+	for i := range instances {
+		if n, spec := split(&instances[i]); n == name {
+			return spec
+		}
+	}
+	return nil
+}
+
+// TransmissionInstance returns spec.transmission when name is empty, otherwise
+// the named spec.transmissionInstances entry (nil if there is none)
+func (s *DownloadStackConfigSpec) TransmissionInstance(name string) *TransmissionSpec {
+	return instanceSpec(s.Transmission, s.TransmissionInstances, name,
+		func(in *TransmissionInstanceSpec) (string, *TransmissionSpec) { return in.Name, &in.TransmissionSpec })
+}
+
+// QBittorrentInstance returns spec.qbittorrent or the named instance, like TransmissionInstance
+func (s *DownloadStackConfigSpec) QBittorrentInstance(name string) *QBittorrentSpec {
+	return instanceSpec(s.QBittorrent, s.QBittorrentInstances, name,
+		func(in *QBittorrentInstanceSpec) (string, *QBittorrentSpec) { return in.Name, &in.QBittorrentSpec })
+}
+
+// DelugeInstance returns spec.deluge or the named instance, like TransmissionInstance
+func (s *DownloadStackConfigSpec) DelugeInstance(name string) *DelugeSpec {
+	return instanceSpec(s.Deluge, s.DelugeInstances, name,
+		func(in *DelugeInstanceSpec) (string, *DelugeSpec) { return in.Name, &in.DelugeSpec })
+}
+
+// RTorrentInstance returns spec.rtorrent or the named instance, like TransmissionInstance
+func (s *DownloadStackConfigSpec) RTorrentInstance(name string) *RTorrentSpec {
+	return instanceSpec(s.RTorrent, s.RTorrentInstances, name,
+		func(in *RTorrentInstanceSpec) (string, *RTorrentSpec) { return in.Name, &in.RTorrentSpec })
+}
+
+// SABnzbdInstance returns spec.sabnzbd or the named instance, like TransmissionInstance
+func (s *DownloadStackConfigSpec) SABnzbdInstance(name string) *SABnzbdSpec {
+	return instanceSpec(s.SABnzbd, s.SABnzbdInstances, name,
+		func(in *SABnzbdInstanceSpec) (string, *SABnzbdSpec) { return in.Name, &in.SABnzbdSpec })
+}
+
+// NZBGetInstance returns spec.nzbget or the named instance, like TransmissionInstance
+func (s *DownloadStackConfigSpec) NZBGetInstance(name string) *NZBGetSpec {
+	return instanceSpec(s.NZBGet, s.NZBGetInstances, name,
+		func(in *NZBGetInstanceSpec) (string, *NZBGetSpec) { return in.Name, &in.NZBGetSpec })
+}
+
 // DownloadStackConfigStatus defines the observed state of DownloadStackConfig
 type DownloadStackConfigStatus struct {
 	// Conditions represent the latest observations
@@ -1314,6 +1926,16 @@ type DownloadStackConfigStatus struct {
 	// +optional
 	TransmissionConnected bool `json:"transmissionConnected,omitempty"`
 
+	// TransmissionSettingsHash is the hash of the rendered Transmission settings.json
+	// +optional
+	TransmissionSettingsHash string `json:"transmissionSettingsHash,omitempty"`
+
+	// TransmissionClusterWhitelist lists the pod network wildcards clusterWhitelist
+	// has added to rpc-whitelist. Entries are only dropped when clusterWhitelist is
+	// turned off, so Nodes leaving don't restart Transmission.
+	// +optional
+	TransmissionClusterWhitelist []string `json:"transmissionClusterWhitelist,omitempty"`
+
 	// TransmissionVersion is the Transmission version
 	// +optional
 	TransmissionVersion string `json:"transmissionVersion,omitempty"`
@@ -1326,7 +1948,7 @@ type DownloadStackConfigStatus struct {
 	// +optional
 	QBittorrentVersion string `json:"qbittorrentVersion,omitempty"`
 
-	// DelugeConnected indicates if Deluge Web UI is reachable
+	// DelugeConnected indicates if Deluge (Web UI or daemon) is reachable
 	// +optional
 	DelugeConnected bool `json:"delugeConnected,omitempty"`
 
@@ -1338,10 +1960,34 @@ type DownloadStackConfigStatus struct {
 	// +optional
 	RTorrentConnected bool `json:"rtorrentConnected,omitempty"`
 
+	// RTorrentConfigHash is the hash of the rendered rTorrent rtorrent.rc
+	// +optional
+	RTorrentConfigHash string `json:"rtorrentConfigHash,omitempty"`
+
 	// RTorrentVersion is the rTorrent version
 	// +optional
 	RTorrentVersion string `json:"rtorrentVersion,omitempty"`
 
+	// FloodConnected indicates if the Flood API is reachable
+	// +optional
+	FloodConnected bool `json:"floodConnected,omitempty"`
+
+	// FloodUsers lists the Flood users managed by the operator.
+	// Users removed from spec are deleted from Flood on the next reconcile.
+	// +optional
+	FloodUsers []string `json:"floodUsers,omitempty"`
+
+	// FloodUserSecretHashes are salted HMACs of the Flood user passwords last
+	// applied, keyed by username. Flood never returns passwords, so they are
+	// used to detect rotations.
+	// +optional
+	FloodUserSecretHashes map[string]string `json:"floodUserSecretHashes,omitempty"`
+
+	// FloodConnection is the connection FloodUsers were provisioned through,
+	// kept to delete them once spec.flood is removed
+	// +optional
+	FloodConnection *FloodConnectionSpec `json:"floodConnection,omitempty"`
+
 	// SABnzbdConnected indicates if SABnzbd API is reachable
 	// +optional
 	SABnzbdConnected bool `json:"sabnzbdConnected,omitempty"`
@@ -1350,6 +1996,16 @@ type DownloadStackConfigStatus struct {
 	// +optional
 	SABnzbdVersion string `json:"sabnzbdVersion,omitempty"`
 
+	// SABnzbdCategories lists the SABnzbd categories managed by the operator.
+	// Categories removed from spec are deleted from SABnzbd on the next reconcile.
+	// +optional
+	SABnzbdCategories []string `json:"sabnzbdCategories,omitempty"`
+
+	// SABnzbdNewsServers lists the SABnzbd servers managed by the operator.
+	// Servers removed from newsServers are deleted from SABnzbd on the next reconcile.
+	// +optional
+	SABnzbdNewsServers []string `json:"sabnzbdNewsServers,omitempty"`
+
 	// NZBGetConnected indicates if NZBGet JSON-RPC is reachable
 	// +optional
 	NZBGetConnected bool `json:"nzbgetConnected,omitempty"`
@@ -1358,6 +2014,52 @@ type DownloadStackConfigStatus struct {
 	// +optional
 	NZBGetVersion string `json:"nzbgetVersion,omitempty"`
 
+	// NZBGetCategories lists the NZBGet categories managed by the operator.
+	// Categories removed from spec are deleted from NZBGet on the next reconcile.
+	// +optional
+	NZBGetCategories []string `json:"nzbgetCategories,omitempty"`
+
+	// NZBGetNewsServers lists the NZBGet servers managed by the operator.
+	// Servers removed from newsServers are deleted from NZBGet on the next reconcile.
+	// +optional
+	NZBGetNewsServers []string `json:"nzbgetNewsServers,omitempty"`
+
+	// NewsServerSecretHashes are salted HMACs of the news server credentials last
+	// applied, keyed by client and server (e.g. "sabnzbd/provider"). SABnzbd never
+	// returns passwords, so they are used to detect rotations.
+	// +optional
+	NewsServerSecretHashes map[string]string `json:"newsServerSecretHashes,omitempty"`
+
+	// Instances reports the named download client instances
+	// +optional
+	Instances []DownloadClientInstanceStatus `json:"instances,omitempty"`
+
+	// InvalidFields lists spec values that were rejected. While any are listed,
+	// download client settings are not applied.
+	// +optional
+	InvalidFields []InvalidField `json:"invalidFields,omitempty"`
+
+	// Unrealized lists spec fields the detected download client versions do not
+	// support. They are skipped instead of failing the sync.
+	// +optional
+	Unrealized []UnrealizedFeature `json:"unrealized,omitempty"`
+
+	// EffectiveSettings summarizes the settings read back from each torrent
+	// client after syncing, to verify convergence without opening the WebUIs.
+	// +listType=map
+	// +listMapKey=client
+	// +optional
+	EffectiveSettings []EffectiveClientSettings `json:"effectiveSettings,omitempty"`
+
+	// TrackerRules reports how many torrents each qBittorrent tracker rule matched
+	// and corrected in the last sync
+	// +optional
+	TrackerRules []TrackerRuleStatus `json:"trackerRules,omitempty"`
+
+	// TorrentPolicies reports what each Transmission torrent policy changed in the last sync
+	// +optional
+	TorrentPolicies []TorrentPolicyStatus `json:"torrentPolicies,omitempty"`
+
 	// LastReconcile is the timestamp of the last reconciliation
 	// +optional
 	LastReconcile *metav1.Time `json:"lastReconcile,omitempty"`
@@ -1367,6 +2069,113 @@ type DownloadStackConfigStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
+// DownloadClientInstanceStatus reports a named download client instance
+type DownloadClientInstanceStatus struct {
+	// Client is the download client type (e.g. qbittorrent)
+	Client string `json:"client"`
+
+	// Name is the instance name from the spec
+	Name string `json:"name"`
+
+	// Connected indicates if the instance is reachable
+	// +optional
+	Connected bool `json:"connected,omitempty"`
+
+	// Version is the client version
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Categories lists the SABnzbd or NZBGet categories managed by the operator
+	// +optional
+	Categories []string `json:"categories,omitempty"`
+
+	// NewsServers lists the SABnzbd or NZBGet servers managed by the operator
+	// +optional
+	NewsServers []string `json:"newsServers,omitempty"`
+}
+
+// TrackerRuleStatus reports the enforcement of a qBittorrent tracker rule
+type TrackerRuleStatus struct {
+	// Client is qbittorrent, or qbittorrent/<instance> for named instances
+	Client string `json:"client"`
+
+	// Name is the rule name from the spec
+	Name string `json:"name"`
+
+	// Matched is the number of torrents the rule applies to
+	Matched int `json:"matched"`
+
+	// Applied is the number of torrents whose limits were corrected
+	Applied int `json:"applied"`
+}
+
+// TorrentPolicyStatus reports the enforcement of a Transmission torrent policy
+type TorrentPolicyStatus struct {
+	// Client is transmission, or transmission/<instance> for named instances
+	Client string `json:"client"`
+
+	// Torrents is the number of torrents in the client
+	Torrents int `json:"torrents"`
+
+	// Labeled is the number of torrents that were given their category label
+	Labeled int `json:"labeled"`
+
+	// Moved is the number of finished torrents moved to their category's completeDir
+	Moved int `json:"moved"`
+
+	// Removed is the number of torrents removed by a removal rule
+	Removed int `json:"removed"`
+
+	// AwaitingImport is the number of finished torrents left alone because an
+	// *arr app referencing the stack hasn't imported them yet
+	// +optional
+	AwaitingImport int `json:"awaitingImport,omitempty"`
+
+	// DryRun is set when the counts are what the policy would have changed:
+	// with spec dryRun, outside the apply window, or while an *arr app
+	// referencing the stack is in observe mode
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// EffectiveClientSettings is a compact summary of the settings a download client
+// reports after syncing
+type EffectiveClientSettings struct {
+	// Client is the download client: transmission, qbittorrent or deluge.
+	// Named instances are reported as <client>/<instance>.
+	Client string `json:"client"`
+
+	// DownloadLimit is the global download limit in KB/s (0 = unlimited)
+	DownloadLimit int `json:"downloadLimit"`
+
+	// UploadLimit is the global upload limit in KB/s (0 = unlimited)
+	UploadLimit int `json:"uploadLimit"`
+
+	// AltSpeedEnabled reports whether alternative speed limits are active
+	// +optional
+	AltSpeedEnabled bool `json:"altSpeedEnabled,omitempty"`
+
+	// SavePath is where completed downloads are saved
+	// +optional
+	SavePath string `json:"savePath,omitempty"`
+
+	// IncompletePath is where downloads in progress are kept, if separate
+	// +optional
+	IncompletePath string `json:"incompletePath,omitempty"`
+
+	// ListenPort is the incoming peer port
+	// +optional
+	ListenPort int `json:"listenPort,omitempty"`
+
+	// DHT reports whether DHT is enabled
+	DHT bool `json:"dht"`
+
+	// PEX reports whether peer exchange is enabled.
+	// Not set for clients that don't report it.
+	// +optional
+	PEX *bool `json:"pex,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Deployment",type=string,JSONPath=`.spec.deploymentRef.name`
@@ -63,6 +63,10 @@ type LidarrNamingSpec struct {
 	// AlbumFolderFormat for album folders.
 	// +optional
 	AlbumFolderFormat string `json:"albumFolderFormat,omitempty"`
+
+	// MultiDiscTrackFormat for tracks of multi-disc albums (standardFormat is used for the others).
+	// +optional
+	MultiDiscTrackFormat string `json:"multiDiscTrackFormat,omitempty"`
 }
 
 // LidarrConfigSpec defines the desired configuration for Lidarr
@@ -131,6 +135,25 @@ type LidarrConfigSpec struct {
 	// +optional
 	DelayProfiles []DelayProfileSpec `json:"delayProfiles,omitempty"`
 
+	// ReleaseProfiles configures release filtering and preferred word scoring.
+	// Release profiles require or ignore terms in release titles (for example
+	// scene tags like "WEB" or "VINYL") and score releases by preferred terms.
+	// When set, the declared profiles replace all release profiles in Lidarr.
+	// +optional
+	ReleaseProfiles []ReleaseProfileSpec `json:"releaseProfiles,omitempty"`
+
+	// Raw lists API requests sent verbatim to the app for settings
+	// the operator doesn't model yet. Use with care: requests are not validated.
+	// +optional
+	Raw []RawRequestSpec `json:"raw,omitempty"`
+
+	// Observe makes the operator read-only towards the app: it reports the
+	// connection, health, version and drift against this spec in status, but
+	// never writes to the app. No finalizer is added, so deleting the config
+	// leaves the app untouched.
+	// +optional
+	Observe bool `json:"observe,omitempty"`
+
 	// Reconciliation configures sync behavior.
 	// +optional
 	Reconciliation *ReconciliationSpec `json:"reconciliation,omitempty"`
@@ -164,6 +187,51 @@ type LidarrConfigStatus struct {
 	// +optional
 	LastAppliedHash string `json:"lastAppliedHash,omitempty"`
 
+	// SecretHashes are salted HMACs of the credentials last applied, keyed by
+	// resource (e.g. "downloadClient/qbittorrent"). Used to detect rotations.
+	// +optional
+	SecretHashes map[string]string `json:"secretHashes,omitempty"`
+
+	// IRSchemaVersion is the IR schema version the recorded hashes were computed
+	// with. After an operator upgrade they are converted to the current schema
+	// before being compared.
+	// +optional
+	IRSchemaVersion string `json:"irSchemaVersion,omitempty"`
+
+	// CompiledSummary counts the resources in the compiled configuration.
+	// +optional
+	CompiledSummary *CompiledSummary `json:"compiledSummary,omitempty"`
+
+	// InvalidFields lists spec values that were rejected. While any are listed,
+	// nothing is applied.
+	// +optional
+	InvalidFields []InvalidField `json:"invalidFields,omitempty"`
+
+	// Converged reports whether the app matched the spec at the end of the last
+	// reconcile. After changes are applied, the app is read back and diffed
+	// again, so values the app accepted but stored differently show up here.
+	// +optional
+	Converged *bool `json:"converged,omitempty"`
+
+	// ResidualChanges lists the changes still found when Converged is false,
+	// up to 20.
+	// +optional
+	ResidualChanges []ResidualChange `json:"residualChanges,omitempty"`
+
+	// UnrealizedFeatures lists requested features that could not be applied
+	// (e.g., unsupported by the connected app version).
+	// +optional
+	UnrealizedFeatures []UnrealizedFeature `json:"unrealizedFeatures,omitempty"`
+
+	// PausedResources lists the spec entries with pause set, as
+	// "downloadclient/<name>" or "indexer/<name>". They are left as they are.
+	// +optional
+	PausedResources []string `json:"pausedResources,omitempty"`
+
+	// RawRequests records the raw requests last sent successfully.
+	// +optional
+	RawRequests []RawRequestStatus `json:"rawRequests,omitempty"`
+
 	// ProwlarrRegistration tracks registration with Prowlarr (Pull Model).
 	// +optional
 	ProwlarrRegistration *ProwlarrRegistration `json:"prowlarrRegistration,omitempty"`
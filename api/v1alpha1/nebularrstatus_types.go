@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NebularrStatusName is the name of the singleton NebularrStatus the operator maintains
+const NebularrStatusName = "nebularr"
+
+// ConfigKindCount counts the configs of one kind across the cluster
+type ConfigKindCount struct {
+	// Kind is the config kind (e.g., RadarrConfig)
+	Kind string `json:"kind"`
+
+	// Count is the number of configs of this kind
+	Count int `json:"count"`
+
+	// Ready is the number of configs whose Ready condition is True
+	// +optional
+	Ready int `json:"ready,omitempty"`
+
+	// Failing is the number of configs whose Ready condition is False
+	// +optional
+	Failing int `json:"failing,omitempty"`
+}
+
+// FailingConfig is a config whose Ready condition is False
+type FailingConfig struct {
+	// Kind is the config kind (e.g., SonarrConfig)
+	Kind string `json:"kind"`
+
+	// Namespace is the config namespace
+	Namespace string `json:"namespace"`
+
+	// Name is the config name
+	Name string `json:"name"`
+
+	// Reason is the reason of the Ready condition
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the message of the Ready condition
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Since is when the config stopped being ready
+	// +optional
+	Since *metav1.Time `json:"since,omitempty"`
+}
+
+// NebularrStatusStatus is the fleet overview
+type NebularrStatusStatus struct {
+	// Conditions represent the latest observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// OperatorVersion is the version of the running operator build
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// GoVersion is the Go version the operator was built with
+	// +optional
+	GoVersion string `json:"goVersion,omitempty"`
+
+	// ConfigCount is the total number of configs of every kind
+	// +optional
+	ConfigCount int `json:"configCount,omitempty"`
+
+	// FailingCount is the total number of failing configs
+	// +optional
+	FailingCount int `json:"failingCount,omitempty"`
+
+	// Configs counts configs by kind, ordered by kind
+	// +optional
+	Configs []ConfigKindCount `json:"configs,omitempty"`
+
+	// ManagedResources totals the compiled resources of every *arr config
+	// +optional
+	ManagedResources *CompiledSummary `json:"managedResources,omitempty"`
+
+	// FailingConfigs lists the failing configs, ordered by kind, namespace and name
+	// +optional
+	FailingConfigs []FailingConfig `json:"failingConfigs,omitempty"`
+
+	// LastUpdated is when the overview was last computed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'nebularr'",message="NebularrStatus is a singleton named nebularr"
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.operatorVersion`
+// +kubebuilder:printcolumn:name="Configs",type=integer,JSONPath=`.status.configCount`
+// +kubebuilder:printcolumn:name="Failing",type=integer,JSONPath=`.status.failingCount`
+// +kubebuilder:printcolumn:name="Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="Healthy")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NebularrStatus is the operator's cluster-wide overview of every config it manages.
+// The operator creates and maintains a single instance named nebularr.
+type NebularrStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Status defines the observed state
+	// +optional
+	Status NebularrStatusStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NebularrStatusList contains a list of NebularrStatus
+type NebularrStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NebularrStatus `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NebularrStatus{}, &NebularrStatusList{})
+}
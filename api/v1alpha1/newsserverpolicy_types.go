@@ -0,0 +1,106 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewsServerPolicySpec describes a usenet provider server
+type NewsServerPolicySpec struct {
+	// Host is the news server hostname (e.g., news.example.com)
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port is the news server port
+	// +optional
+	// +kubebuilder:default=563
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int `json:"port,omitempty"`
+
+	// UseTLS connects with TLS
+	// +optional
+	// +kubebuilder:default=true
+	UseTLS *bool `json:"useTLS,omitempty"`
+
+	// Connections is the maximum number of connections to the server
+	// +optional
+	// +kubebuilder:default=8
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Connections int `json:"connections,omitempty"`
+
+	// Tier orders the servers: 0 is the primary tier, higher tiers are only used
+	// for articles missing on lower ones (block accounts, fill servers).
+	// Rendered as the SABnzbd priority and the NZBGet level.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	Tier int `json:"tier,omitempty"`
+
+	// Retention is the provider's retention in days (0 = unlimited)
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Retention int `json:"retention,omitempty"`
+
+	// Enabled set to false keeps the server configured but unused
+	// +optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// CredentialsSecretRef references the Secret with the provider username and password
+	// +optional
+	CredentialsSecretRef *CredentialsSecretRef `json:"credentialsSecretRef,omitempty"`
+}
+
+// NewsServerRef references a NewsServerPolicy in the same namespace
+type NewsServerRef struct {
+	// Name is the NewsServerPolicy name. It is also the server name in the client.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Host",type=string,JSONPath=`.spec.host`
+// +kubebuilder:printcolumn:name="Port",type=integer,JSONPath=`.spec.port`
+// +kubebuilder:printcolumn:name="Tier",type=integer,JSONPath=`.spec.tier`
+// +kubebuilder:printcolumn:name="Connections",type=integer,JSONPath=`.spec.connections`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NewsServerPolicy declares a usenet provider once, for the SABnzbd and NZBGet
+// clients of DownloadStackConfigs that reference it in newsServers
+type NewsServerPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec describes the server
+	Spec NewsServerPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NewsServerPolicyList contains a list of NewsServerPolicy
+type NewsServerPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NewsServerPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NewsServerPolicy{}, &NewsServerPolicyList{})
+}
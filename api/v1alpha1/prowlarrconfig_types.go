@@ -42,19 +42,88 @@ type ProwlarrIndexer struct {
 	// +optional
 	APIKeySecretRef *SecretKeySelector `json:"apiKeySecretRef,omitempty"`
 
-	// Tags associate this indexer with proxies.
+	// NextAPIKeySecretRef is the key the tracker rotates to. While set, the
+	// indexer is tested with the current key when either key changes and every
+	// six hours, and switched to this one when the current key is rejected (401).
+	// +optional
+	NextAPIKeySecretRef *SecretKeySelector `json:"nextApiKeySecretRef,omitempty"`
+
+	// Tags associate this indexer with proxies and applications.
+	// An application with tags only receives indexers sharing one of them.
 	// +optional
 	Tags []string `json:"tags,omitempty"`
 
+	// AppProfile names the Prowlarr app profile deciding whether the indexer
+	// is used for RSS, automatic and interactive search. It can be one of
+	// spec.appProfiles or a profile that already exists in Prowlarr; other
+	// names are created with Prowlarr's defaults.
+	// If not specified, the indexer's current profile is left alone.
+	// +optional
+	AppProfile string `json:"appProfile,omitempty"`
+
 	// Priority (1-50).
 	// +optional
 	// +kubebuilder:default=25
 	Priority int `json:"priority,omitempty"`
 
+	// QueryLimit caps the searches Prowlarr sends to the indexer per
+	// LimitsUnit, as private trackers often require. 0 means unlimited.
+	// If not specified, the indexer's current limit is left alone.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	QueryLimit *int `json:"queryLimit,omitempty"`
+
+	// GrabLimit caps the releases grabbed from the indexer per LimitsUnit.
+	// 0 means unlimited. If not specified, the current limit is left alone.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	GrabLimit *int `json:"grabLimit,omitempty"`
+
+	// LimitsUnit is the period QueryLimit and GrabLimit apply to.
+	// If not specified, the indexer's current unit is left alone (Prowlarr defaults to day).
+	// +optional
+	// +kubebuilder:validation:Enum=day;hour
+	LimitsUnit string `json:"limitsUnit,omitempty"`
+
 	// Enabled enables/disables this indexer.
 	// +optional
 	// +kubebuilder:default=true
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// Pause stops managing this indexer: it is kept as it is in Prowlarr,
+	// neither created, updated nor deleted, until Pause is cleared.
+	// +optional
+	Pause bool `json:"pause,omitempty"`
+}
+
+// ProwlarrAppProfile defines an app profile in Prowlarr. Indexers reference
+// it by name through appProfile.
+type ProwlarrAppProfile struct {
+	// Name is the profile name in Prowlarr.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// EnableRss uses the indexer for RSS sync.
+	// +optional
+	// +kubebuilder:default=true
+	EnableRss *bool `json:"enableRss,omitempty"`
+
+	// EnableAutomaticSearch uses the indexer for searches the apps start on their own.
+	// +optional
+	// +kubebuilder:default=true
+	EnableAutomaticSearch *bool `json:"enableAutomaticSearch,omitempty"`
+
+	// EnableInteractiveSearch uses the indexer for searches started from the UI.
+	// +optional
+	// +kubebuilder:default=true
+	EnableInteractiveSearch *bool `json:"enableInteractiveSearch,omitempty"`
+
+	// MinimumSeeders is the minimum number of seeders a torrent release needs.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	MinimumSeeders *int `json:"minimumSeeders,omitempty"`
 }
 
 // IndexerProxy defines a proxy for indexer requests
@@ -90,6 +159,11 @@ type IndexerProxy struct {
 	// Tags to associate with indexers that should use this proxy.
 	// +optional
 	Tags []string `json:"tags,omitempty"`
+
+	// Pause stops managing this proxy: it is kept as it is in Prowlarr
+	// until Pause is cleared.
+	// +optional
+	Pause bool `json:"pause,omitempty"`
 }
 
 // ProwlarrApplication defines sync to a downstream app
@@ -126,6 +200,54 @@ type ProwlarrApplication struct {
 	// +kubebuilder:validation:Enum=disabled;addOnly;fullSync
 	// +kubebuilder:default=fullSync
 	SyncLevel string `json:"syncLevel,omitempty"`
+
+	// Tags restrict sync to indexers carrying at least one of these tags.
+	// If not specified, every indexer managed by the operator is synced.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// DownloadClient names a download client in the application. Indexers
+	// Prowlarr syncs to the application send their grabs to it, instead of
+	// the application picking one by protocol and priority.
+	// If not specified, the application's own assignment is left alone.
+	// +optional
+	DownloadClient string `json:"downloadClient,omitempty"`
+
+	// Pause stops managing this application: it is kept as it is in Prowlarr
+	// until Pause is cleared.
+	// +optional
+	Pause bool `json:"pause,omitempty"`
+}
+
+// IndexerHealthSpec configures failure tracking for Prowlarr indexers,
+// based on Prowlarr's indexer statistics
+type IndexerHealthSpec struct {
+	// AutoDisable disables indexers whose failure rate reaches FailureThreshold
+	// and re-enables them after Cooldown. When false, they are only reported.
+	// +optional
+	AutoDisable bool `json:"autoDisable,omitempty"`
+
+	// FailureThreshold is the failure rate, in percent of requests, at which
+	// an indexer is considered unhealthy.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=50
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+
+	// MinRequests is the number of requests needed before the failure rate is judged.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=10
+	MinRequests int `json:"minRequests,omitempty"`
+
+	// Window is how far back indexer statistics are read (default 24h).
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+
+	// Cooldown is how long a disabled indexer stays disabled (default 6h).
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
 }
 
 // ProwlarrConfigSpec defines the desired configuration for Prowlarr
@@ -139,6 +261,13 @@ type ProwlarrConfigSpec struct {
 	// +optional
 	Indexers []ProwlarrIndexer `json:"indexers,omitempty"`
 
+	// AppProfiles configures app profiles in Prowlarr, created or updated by
+	// name. Profiles removed from this list are left in Prowlarr.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	AppProfiles []ProwlarrAppProfile `json:"appProfiles,omitempty"`
+
 	// Proxies configures indexer proxies (e.g., FlareSolverr).
 	// +optional
 	Proxies []IndexerProxy `json:"proxies,omitempty"`
@@ -156,11 +285,92 @@ type ProwlarrConfigSpec struct {
 	// +optional
 	Authentication *AuthenticationSpec `json:"authentication,omitempty"`
 
+	// IndexerHealth reports indexers with a high failure rate and can
+	// disable them for a while.
+	// +optional
+	IndexerHealth *IndexerHealthSpec `json:"indexerHealth,omitempty"`
+
+	// Raw lists API requests sent verbatim to the app for settings
+	// the operator doesn't model yet. Use with care: requests are not validated.
+	// +optional
+	Raw []RawRequestSpec `json:"raw,omitempty"`
+
+	// Observe makes the operator read-only towards Prowlarr: it reports the
+	// connection, health, version and drift against this spec in status, but
+	// never writes to Prowlarr. No finalizer is added, so deleting the config
+	// leaves Prowlarr untouched.
+	// +optional
+	Observe bool `json:"observe,omitempty"`
+
 	// Reconciliation configures sync behavior.
 	// +optional
 	Reconciliation *ReconciliationSpec `json:"reconciliation,omitempty"`
 }
 
+// IndexerHealthStatus reports an indexer whose failure rate reached the threshold
+type IndexerHealthStatus struct {
+	// Name is the indexer name from the spec
+	Name string `json:"name"`
+
+	// Disabled indicates the operator disabled the indexer
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// FailureRate is the failure rate, in percent, when last evaluated
+	// +optional
+	FailureRate int `json:"failureRate,omitempty"`
+
+	// Requests is the number of requests the failure rate is based on
+	// +optional
+	Requests int `json:"requests,omitempty"`
+
+	// DisabledAt is when the operator disabled the indexer
+	// +optional
+	DisabledAt *metav1.Time `json:"disabledAt,omitempty"`
+
+	// ReenableAt is when the indexer will be re-enabled
+	// +optional
+	ReenableAt *metav1.Time `json:"reenableAt,omitempty"`
+
+	// ReenabledAt is when the indexer was last re-enabled. Only requests
+	// made since then count towards its failure rate.
+	// +optional
+	ReenabledAt *metav1.Time `json:"reenabledAt,omitempty"`
+}
+
+// Indexer API keys, as reported in IndexerKeyStatus
+const (
+	IndexerKeyCurrent = "current"
+	IndexerKeyNext    = "next"
+)
+
+// IndexerKeyStatus records which API key an indexer with a next key uses
+type IndexerKeyStatus struct {
+	// Name is the indexer name in Prowlarr (nebularr-{config}-{name})
+	Name string `json:"name"`
+
+	// Active is the key in use: current or next
+	// +kubebuilder:validation:Enum=current;next
+	Active string `json:"active"`
+
+	// KeyHash is a salted HMAC of the active key, so the choice survives the
+	// next key being promoted to the current one
+	// +optional
+	KeyHash string `json:"keyHash,omitempty"`
+
+	// SwitchedAt is when the operator switched to the next key
+	// +optional
+	SwitchedAt *metav1.Time `json:"switchedAt,omitempty"`
+
+	// TestedAt is when the current key was last tested
+	// +optional
+	TestedAt *metav1.Time `json:"testedAt,omitempty"`
+
+	// Message describes the last failed key test
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // ProwlarrConfigStatus defines the observed state of ProwlarrConfig
 type ProwlarrConfigStatus struct {
 	// Conditions represent the latest observations of the ProwlarrConfig's state.
@@ -193,13 +403,72 @@ type ProwlarrConfigStatus struct {
 	// +optional
 	ManagedApplications []int `json:"managedApplications,omitempty"`
 
+	// ManagedDownloadClients lists managed download client IDs.
+	// +optional
+	ManagedDownloadClients []int `json:"managedDownloadClients,omitempty"`
+
 	// LastAppliedHash is the hash of the last applied spec.
 	// +optional
 	LastAppliedHash string `json:"lastAppliedHash,omitempty"`
 
+	// SecretHashes are salted HMACs of the credentials last applied, keyed by
+	// resource (e.g. "downloadClient/qbittorrent"). Used to detect rotations.
+	// +optional
+	SecretHashes map[string]string `json:"secretHashes,omitempty"`
+
+	// IRSchemaVersion is the IR schema version the recorded hashes were computed
+	// with. After an operator upgrade they are converted to the current schema
+	// before being compared.
+	// +optional
+	IRSchemaVersion string `json:"irSchemaVersion,omitempty"`
+
+	// CompiledSummary counts the resources in the compiled configuration.
+	// +optional
+	CompiledSummary *CompiledSummary `json:"compiledSummary,omitempty"`
+
+	// InvalidFields lists spec values that were rejected. While any are listed,
+	// nothing is applied.
+	// +optional
+	InvalidFields []InvalidField `json:"invalidFields,omitempty"`
+
+	// Converged reports whether the app matched the spec at the end of the last
+	// reconcile. After changes are applied, the app is read back and diffed
+	// again, so values the app accepted but stored differently show up here.
+	// +optional
+	Converged *bool `json:"converged,omitempty"`
+
+	// ResidualChanges lists the changes still found when Converged is false,
+	// up to 20.
+	// +optional
+	ResidualChanges []ResidualChange `json:"residualChanges,omitempty"`
+
+	// UnrealizedFeatures lists requested features that could not be applied
+	// (e.g., unsupported by the connected app version).
+	// +optional
+	UnrealizedFeatures []UnrealizedFeature `json:"unrealizedFeatures,omitempty"`
+
+	// RawRequests records the raw requests last sent successfully.
+	// +optional
+	RawRequests []RawRequestStatus `json:"rawRequests,omitempty"`
+
 	// Health represents the app's health status from its internal health checks.
 	// +optional
 	Health *HealthStatus `json:"health,omitempty"`
+
+	// IndexerHealth lists indexers that failed too often, and those recently
+	// re-enabled, when spec.indexerHealth is set.
+	// +optional
+	IndexerHealth []IndexerHealthStatus `json:"indexerHealth,omitempty"`
+
+	// IndexerKeys lists the active API key of each indexer with a next key.
+	// +optional
+	IndexerKeys []IndexerKeyStatus `json:"indexerKeys,omitempty"`
+
+	// PausedResources lists the spec entries with pause set, as "indexer/<name>",
+	// "proxy/<name>", "application/<name>" or "downloadclient/<name>". They are
+	// left as they are.
+	// +optional
+	PausedResources []string `json:"pausedResources,omitempty"`
 }
 
 // +kubebuilder:object:root=true
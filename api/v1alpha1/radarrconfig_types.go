@@ -31,6 +31,17 @@ type RadarrConfigSpec struct {
 	// +optional
 	Quality *VideoQualitySpec `json:"quality,omitempty"`
 
+	// QualityProfiles adds named quality profiles alongside the default one from Quality.
+	// Import lists select one by setting qualityProfile to its name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	QualityProfiles []NamedVideoQualitySpec `json:"qualityProfiles,omitempty"`
+
+	// Language sets the preferred release language.
+	// +optional
+	Language *LanguageSpec `json:"language,omitempty"`
+
 	// DownloadClients configures download clients.
 	// +optional
 	DownloadClients []DownloadClientSpec `json:"downloadClients,omitempty"`
@@ -57,10 +68,23 @@ type RadarrConfigSpec struct {
 	// +optional
 	ImportLists []ImportListSpec `json:"importLists,omitempty"`
 
+	// ImportListOptions configures the global import list options, such as
+	// what happens to movies dropped from every list.
+	// +optional
+	ImportListOptions *ImportListOptionsSpec `json:"importListOptions,omitempty"`
+
 	// MediaManagement configures media management settings.
 	// +optional
 	MediaManagement *MediaManagementSpec `json:"mediaManagement,omitempty"`
 
+	// Collections configures monitoring settings of movie collections (Radarr v5+).
+	// +optional
+	Collections *CollectionsSpec `json:"collections,omitempty"`
+
+	// UI configures UI settings such as the calendar, time format and theme.
+	// +optional
+	UI *UISpec `json:"ui,omitempty"`
+
 	// Authentication configures authentication settings.
 	// +optional
 	Authentication *AuthenticationSpec `json:"authentication,omitempty"`
@@ -69,21 +93,80 @@ type RadarrConfigSpec struct {
 	// +optional
 	Notifications []NotificationSpec `json:"notifications,omitempty"`
 
+	// MediaServerHooks registers Plex/Jellyfin library refresh connections.
+	// +optional
+	MediaServerHooks *MediaServerHooksSpec `json:"mediaServerHooks,omitempty"`
+
 	// CustomFormats defines custom formats for fine-grained release quality control.
 	// Custom formats allow matching releases based on title patterns, sources, resolutions, etc.
 	// and assigning scores that affect quality profile decisions.
 	// +optional
 	CustomFormats []CustomFormatSpec `json:"customFormats,omitempty"`
 
+	// AutoTags defines rules that tag movies automatically (genre, root folder, etc.).
+	// +optional
+	AutoTags []AutoTagSpec `json:"autoTags,omitempty"`
+
+	// QualityDefinitions sets per-quality size limits (MB per minute).
+	// Only listed qualities are changed; all others keep their current limits.
+	// +optional
+	QualityDefinitions []QualityDefinitionSpec `json:"qualityDefinitions,omitempty"`
+
 	// DelayProfiles configures download delays for better release selection.
 	// Delay profiles allow waiting for preferred releases before downloading,
 	// with different delays for Usenet vs torrents and bypass conditions.
 	// +optional
 	DelayProfiles []DelayProfileSpec `json:"delayProfiles,omitempty"`
 
+	// Raw lists API requests sent verbatim to the app for settings
+	// the operator doesn't model yet. Use with care: requests are not validated.
+	// +optional
+	Raw []RawRequestSpec `json:"raw,omitempty"`
+
+	// Observe makes the operator read-only towards the app: it reports the
+	// connection, health, version and drift against this spec in status, but
+	// never writes to the app. No finalizer is added, so deleting the config
+	// leaves the app untouched.
+	// +optional
+	Observe bool `json:"observe,omitempty"`
+
 	// Reconciliation configures sync behavior.
 	// +optional
 	Reconciliation *ReconciliationSpec `json:"reconciliation,omitempty"`
+
+	// Maintenance configures recurring housekeeping, such as pruning the blocklist.
+	// +optional
+	Maintenance *MaintenanceSpec `json:"maintenance,omitempty"`
+}
+
+// CollectionsSpec defines the settings applied to Radarr movie collections.
+// Unset fields keep the value each collection already has.
+type CollectionsSpec struct {
+	// Titles limits these settings to the named collections (case-insensitive).
+	// If not specified, they apply to every collection.
+	// +optional
+	Titles []string `json:"titles,omitempty"`
+
+	// Monitored adds new movies of the collection automatically.
+	// +optional
+	Monitored *bool `json:"monitored,omitempty"`
+
+	// SearchOnAdd searches for movies when they are added from the collection.
+	// +optional
+	SearchOnAdd *bool `json:"searchOnAdd,omitempty"`
+
+	// MinimumAvailability of movies added from the collection.
+	// +optional
+	// +kubebuilder:validation:Enum=tba;announced;inCinemas;released
+	MinimumAvailability string `json:"minimumAvailability,omitempty"`
+
+	// QualityProfile is the name of the quality profile for movies added from the collection.
+	// +optional
+	QualityProfile string `json:"qualityProfile,omitempty"`
+
+	// RootFolder is the root folder path for movies added from the collection.
+	// +optional
+	RootFolder string `json:"rootFolder,omitempty"`
 }
 
 // RadarrConfigStatus defines the observed state of RadarrConfig
@@ -115,6 +198,51 @@ type RadarrConfigStatus struct {
 	// +optional
 	LastAppliedHash string `json:"lastAppliedHash,omitempty"`
 
+	// SecretHashes are salted HMACs of the credentials last applied, keyed by
+	// resource (e.g. "downloadClient/qbittorrent"). Used to detect rotations.
+	// +optional
+	SecretHashes map[string]string `json:"secretHashes,omitempty"`
+
+	// IRSchemaVersion is the IR schema version the recorded hashes were computed
+	// with. After an operator upgrade they are converted to the current schema
+	// before being compared.
+	// +optional
+	IRSchemaVersion string `json:"irSchemaVersion,omitempty"`
+
+	// CompiledSummary counts the resources in the compiled configuration.
+	// +optional
+	CompiledSummary *CompiledSummary `json:"compiledSummary,omitempty"`
+
+	// InvalidFields lists spec values that were rejected. While any are listed,
+	// nothing is applied.
+	// +optional
+	InvalidFields []InvalidField `json:"invalidFields,omitempty"`
+
+	// Converged reports whether the app matched the spec at the end of the last
+	// reconcile. After changes are applied, the app is read back and diffed
+	// again, so values the app accepted but stored differently show up here.
+	// +optional
+	Converged *bool `json:"converged,omitempty"`
+
+	// ResidualChanges lists the changes still found when Converged is false,
+	// up to 20.
+	// +optional
+	ResidualChanges []ResidualChange `json:"residualChanges,omitempty"`
+
+	// UnrealizedFeatures lists requested features that could not be applied
+	// (e.g., unsupported by the connected app version).
+	// +optional
+	UnrealizedFeatures []UnrealizedFeature `json:"unrealizedFeatures,omitempty"`
+
+	// PausedResources lists the spec entries with pause set, as
+	// "downloadclient/<name>" or "indexer/<name>". They are left as they are.
+	// +optional
+	PausedResources []string `json:"pausedResources,omitempty"`
+
+	// RawRequests records the raw requests last sent successfully.
+	// +optional
+	RawRequests []RawRequestStatus `json:"rawRequests,omitempty"`
+
 	// ProwlarrRegistration tracks registration with Prowlarr (Pull Model).
 	// +optional
 	ProwlarrRegistration *ProwlarrRegistration `json:"prowlarrRegistration,omitempty"`
@@ -122,6 +250,22 @@ type RadarrConfigStatus struct {
 	// Health represents the app's health status from its internal health checks.
 	// +optional
 	Health *HealthStatus `json:"health,omitempty"`
+
+	// MediaServers reports the connection state of configured media server hooks.
+	// +optional
+	MediaServers []MediaServerStatus `json:"mediaServers,omitempty"`
+
+	// Notifications reports the connection test result of each configured notification.
+	// +optional
+	Notifications []NotificationStatus `json:"notifications,omitempty"`
+
+	// Indexers reports the test result of each direct indexer when verifyOnApply is set.
+	// +optional
+	Indexers []IndexerStatus `json:"indexers,omitempty"`
+
+	// Maintenance reports the last run of spec.maintenance.
+	// +optional
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -162,6 +162,24 @@ type ReadarrConfigSpec struct {
 	// +optional
 	Notifications []NotificationSpec `json:"notifications,omitempty"`
 
+	// DelayProfiles configures download delays for better release selection.
+	// Delay profiles allow waiting for preferred releases before downloading,
+	// with different delays for Usenet vs torrents and bypass conditions.
+	// +optional
+	DelayProfiles []DelayProfileSpec `json:"delayProfiles,omitempty"`
+
+	// Raw lists API requests sent verbatim to the app for settings
+	// the operator doesn't model yet. Use with care: requests are not validated.
+	// +optional
+	Raw []RawRequestSpec `json:"raw,omitempty"`
+
+	// Observe makes the operator read-only towards the app: it reports the
+	// connection, health, version and drift against this spec in status, but
+	// never writes to the app. No finalizer is added, so deleting the config
+	// leaves the app untouched.
+	// +optional
+	Observe bool `json:"observe,omitempty"`
+
 	// Reconciliation configures sync behavior.
 	// +optional
 	Reconciliation *ReconciliationSpec `json:"reconciliation,omitempty"`
@@ -195,6 +213,40 @@ type ReadarrConfigStatus struct {
 	// +optional
 	LastAppliedHash string `json:"lastAppliedHash,omitempty"`
 
+	// CompiledSummary counts the resources in the compiled configuration.
+	// +optional
+	CompiledSummary *CompiledSummary `json:"compiledSummary,omitempty"`
+
+	// InvalidFields lists spec values that were rejected. While any are listed,
+	// nothing is applied.
+	// +optional
+	InvalidFields []InvalidField `json:"invalidFields,omitempty"`
+
+	// Converged reports whether the app matched the spec at the end of the last
+	// reconcile. After changes are applied, the app is read back and diffed
+	// again, so values the app accepted but stored differently show up here.
+	// +optional
+	Converged *bool `json:"converged,omitempty"`
+
+	// ResidualChanges lists the changes still found when Converged is false,
+	// up to 20.
+	// +optional
+	ResidualChanges []ResidualChange `json:"residualChanges,omitempty"`
+
+	// UnrealizedFeatures lists requested features that could not be applied
+	// (e.g., unsupported by the connected app version).
+	// +optional
+	UnrealizedFeatures []UnrealizedFeature `json:"unrealizedFeatures,omitempty"`
+
+	// PausedResources lists the spec entries with pause set, as
+	// "downloadclient/<name>" or "indexer/<name>". They are left as they are.
+	// +optional
+	PausedResources []string `json:"pausedResources,omitempty"`
+
+	// RawRequests records the raw requests last sent successfully.
+	// +optional
+	RawRequests []RawRequestStatus `json:"rawRequests,omitempty"`
+
 	// ProwlarrRegistration tracks registration with Prowlarr (Pull Model).
 	// +optional
 	ProwlarrRegistration *ProwlarrRegistration `json:"prowlarrRegistration,omitempty"`
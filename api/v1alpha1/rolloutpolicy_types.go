@@ -0,0 +1,182 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RolloutTargetRef identifies an *arr config in the same namespace
+type RolloutTargetRef struct {
+	// Kind is the config kind
+	// +kubebuilder:validation:Enum=RadarrConfig;SonarrConfig;LidarrConfig;ReadarrConfig
+	Kind string `json:"kind"`
+
+	// Name is the config name
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// RolloutWave is a group of configs that receive spec changes together
+type RolloutWave struct {
+	// Name identifies the wave in status and events (e.g., "canary").
+	// Defaults to wave-<index>.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Targets are the configs in this wave
+	// +kubebuilder:validation:MinItems=1
+	Targets []RolloutTargetRef `json:"targets"`
+}
+
+// RolloutPolicySpec defines how spec changes are rolled out across configs
+type RolloutPolicySpec struct {
+	// Waves are released in order. A wave receives its pending changes only after
+	// every earlier wave has applied its changes and stayed healthy for VerifyDelay.
+	// +kubebuilder:validation:MinItems=1
+	Waves []RolloutWave `json:"waves"`
+
+	// VerifyDelay is how long after applying a wave must stay healthy before the
+	// next wave is released.
+	// +optional
+	// +kubebuilder:default="5m"
+	VerifyDelay *metav1.Duration `json:"verifyDelay,omitempty"`
+
+	// Paused stops releasing changes. Configs that were already released keep reconciling.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// Rollout target phases
+const (
+	// RolloutTargetPending means a spec change is held back until the target's wave is released
+	RolloutTargetPending = "Pending"
+
+	// RolloutTargetApplying means the change was released and the config has not synced it yet
+	RolloutTargetApplying = "Applying"
+
+	// RolloutTargetVerifying means the change was applied and the verify delay has not passed
+	RolloutTargetVerifying = "Verifying"
+
+	// RolloutTargetHealthy means the released change is applied and the app is healthy
+	RolloutTargetHealthy = "Healthy"
+
+	// RolloutTargetUnhealthy means the app is disconnected, not ready or reports errors
+	RolloutTargetUnhealthy = "Unhealthy"
+
+	// RolloutTargetMissing means the referenced config does not exist
+	RolloutTargetMissing = "Missing"
+
+	// RolloutTargetConflict means the config is already rolled out by another RolloutPolicy
+	RolloutTargetConflict = "Conflict"
+)
+
+// RolloutTargetStatus is the rollout state of a single config
+type RolloutTargetStatus struct {
+	// Kind is the config kind
+	Kind string `json:"kind"`
+
+	// Name is the config name
+	Name string `json:"name"`
+
+	// Wave is the name of the wave the config belongs to
+	Wave string `json:"wave"`
+
+	// Phase is Pending, Applying, Verifying, Healthy, Unhealthy, Missing or Conflict
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// ReleasedGeneration is the latest config generation allowed to apply
+	// +optional
+	ReleasedGeneration int64 `json:"releasedGeneration,omitempty"`
+
+	// Message explains the phase
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// Rollout phases
+const (
+	// RolloutPhaseComplete means every released change is applied and healthy
+	RolloutPhaseComplete = "Complete"
+
+	// RolloutPhaseProgressing means a wave is applying or being verified
+	RolloutPhaseProgressing = "Progressing"
+
+	// RolloutPhasePaused means spec.paused is holding back pending changes
+	RolloutPhasePaused = "Paused"
+
+	// RolloutPhaseHalted means an unhealthy wave is holding back later waves
+	RolloutPhaseHalted = "Halted"
+)
+
+// RolloutPolicyStatus defines the observed state of a rollout
+type RolloutPolicyStatus struct {
+	// Conditions represent the latest observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase is Complete, Progressing, Paused or Halted
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// CurrentWave is the wave being rolled out or holding the rollout back
+	// +optional
+	CurrentWave string `json:"currentWave,omitempty"`
+
+	// Targets lists the rollout state of every config, in wave order
+	// +optional
+	Targets []RolloutTargetStatus `json:"targets,omitempty"`
+
+	// ObservedGeneration is the last observed generation
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Wave",type=string,JSONPath=`.status.currentWave`
+// +kubebuilder:printcolumn:name="Paused",type=boolean,JSONPath=`.spec.paused`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RolloutPolicy rolls out spec changes to a group of *arr configs in waves,
+// verifying health between waves
+type RolloutPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the waves
+	Spec RolloutPolicySpec `json:"spec,omitempty"`
+
+	// Status defines the observed state
+	// +optional
+	Status RolloutPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RolloutPolicyList contains a list of RolloutPolicy
+type RolloutPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RolloutPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RolloutPolicy{}, &RolloutPolicyList{})
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// =============================================================================
+// Tautulli-specific Types
+// =============================================================================
+
+// TautulliConnectionSpec defines connection to Tautulli's API
+type TautulliConnectionSpec struct {
+	// URL is the base URL to Tautulli (e.g., http://tautulli:8181).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// APIKeySecretRef references a Secret containing the Tautulli API key.
+	// +kubebuilder:validation:Required
+	APIKeySecretRef SecretKeySelector `json:"apiKeySecretRef"`
+
+	// Timeout bounds each request to Tautulli.
+	// +optional
+	// +kubebuilder:default="30s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// TautulliTrigger is a Tautulli notification action
+// +kubebuilder:validation:Enum=play;stop;pause;resume;change;buffer;error;watched;created;intdown;intup;extdown;extup;pmsupdate;concurrent;newdevice;plexpyupdate
+type TautulliTrigger string
+
+// TautulliNotifierSpec defines a Tautulli notification agent
+type TautulliNotifierSpec struct {
+	// Name is the friendly name of the notification agent. Agents are matched by it.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Agent is the notification agent type.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=discord;email;ifttt;join;mqtt;pushbullet;pushover;scripts;slack;telegram;webhook;zapier
+	Agent string `json:"agent"`
+
+	// Triggers are the actions that send a notification (e.g. play, watched, created).
+	// Actions not listed are turned off.
+	// +optional
+	Triggers []TautulliTrigger `json:"triggers,omitempty"`
+
+	// Settings contains agent-specific configuration, keyed by the agent's
+	// config field name without the agent prefix.
+	// Common examples:
+	//   discord: hook, username, include_poster
+	//   webhook: hook, method
+	//   telegram: bot_token, chat_id
+	// +optional
+	Settings map[string]string `json:"settings,omitempty"`
+
+	// SettingsSecretRef references a Secret containing sensitive settings.
+	// Secret keys should match the settings field names (e.g., hook, bot_token).
+	// Values from this secret override Settings.
+	// +optional
+	SettingsSecretRef *SecretKeySelector `json:"settingsSecretRef,omitempty"`
+}
+
+// TautulliNewsletterSpec defines a Tautulli recently added newsletter
+type TautulliNewsletterSpec struct {
+	// Name is the friendly name of the newsletter. Newsletters are matched by it.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Enabled controls whether the newsletter is sent on its schedule.
+	// +optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Schedule is the cron expression the newsletter is sent on.
+	// +optional
+	// +kubebuilder:default="0 0 * * 0"
+	Schedule string `json:"schedule,omitempty"`
+
+	// TimeFrameDays is how many days of recently added media the newsletter covers.
+	// +optional
+	// +kubebuilder:default=7
+	// +kubebuilder:validation:Minimum=1
+	TimeFrameDays int32 `json:"timeFrameDays,omitempty"`
+
+	// Libraries limits the newsletter to these library names. Empty includes
+	// all movie, show and music libraries.
+	// +optional
+	Libraries []string `json:"libraries,omitempty"`
+
+	// Notifier is the name of a notification agent of this TautulliConfig
+	// that is sent a link to each newsletter.
+	// +kubebuilder:validation:Required
+	Notifier string `json:"notifier"`
+
+	// Subject is the newsletter subject line. Tautulli text parameters such as
+	// {server_name} may be used.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+}
+
+// TautulliWatchStatisticsSpec enables collection of per-library watch statistics
+type TautulliWatchStatisticsSpec struct {
+	// UnwatchedDays is the window in days after which media nobody played counts as unwatched.
+	// +optional
+	// +kubebuilder:default=90
+	// +kubebuilder:validation:Minimum=1
+	UnwatchedDays int32 `json:"unwatchedDays,omitempty"`
+
+	// Libraries limits statistics to these library names. Empty includes all
+	// movie and show libraries.
+	// +optional
+	Libraries []string `json:"libraries,omitempty"`
+
+	// RefreshInterval is how often statistics are collected. Counting unwatched
+	// media pages through every item of a library, so keep it long on large servers.
+	// +optional
+	// +kubebuilder:default="6h"
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// TautulliConfigSpec defines the desired configuration for Tautulli
+type TautulliConfigSpec struct {
+	// Connection specifies how to connect to Tautulli's API.
+	// +kubebuilder:validation:Required
+	Connection TautulliConnectionSpec `json:"connection"`
+
+	// Notifiers configures notification agents. Agents created by this
+	// resource are removed again when dropped from the list.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Notifiers []TautulliNotifierSpec `json:"notifiers,omitempty"`
+
+	// Newsletters configures recently added newsletters. Newsletters created by
+	// this resource are removed again when dropped from the list.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Newsletters []TautulliNewsletterSpec `json:"newsletters,omitempty"`
+
+	// WatchStatistics publishes per-library watch statistics in status,
+	// for example to find media nobody watched in months.
+	// +optional
+	WatchStatistics *TautulliWatchStatisticsSpec `json:"watchStatistics,omitempty"`
+
+	// Reconciliation configures sync behavior.
+	// +optional
+	Reconciliation *ReconciliationSpec `json:"reconciliation,omitempty"`
+}
+
+// TautulliManagedItem records a notifier or newsletter created by the operator
+type TautulliManagedItem struct {
+	// Name is the friendly name.
+	Name string `json:"name"`
+
+	// ID is the Tautulli notifier or newsletter ID.
+	ID int `json:"id"`
+
+	// Hash identifies the settings last written, so unchanged items are not rewritten.
+	// +optional
+	Hash string `json:"hash,omitempty"`
+}
+
+// TautulliLibraryStatistics holds the watch statistics of one library
+type TautulliLibraryStatistics struct {
+	// Name is the library name.
+	Name string `json:"name"`
+
+	// SectionID is the Plex library section ID.
+	SectionID int `json:"sectionId"`
+
+	// Type is the library type (movie or show).
+	Type string `json:"type"`
+
+	// Items is the number of movies or shows in the library.
+	Items int `json:"items"`
+
+	// UnwatchedItems counts the items added before the unwatched window that
+	// nobody played within it.
+	UnwatchedItems int `json:"unwatchedItems"`
+
+	// Plays counts the plays within the unwatched window.
+	Plays int `json:"plays"`
+}
+
+// TautulliConfigStatus defines the observed state of TautulliConfig
+type TautulliConfigStatus struct {
+	// Conditions represent the latest observations of the TautulliConfig's state.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Connected indicates whether Tautulli is reachable.
+	// +optional
+	Connected bool `json:"connected,omitempty"`
+
+	// TautulliVersion is the detected Tautulli version.
+	// +optional
+	TautulliVersion string `json:"tautulliVersion,omitempty"`
+
+	// Notifiers lists the notification agents this resource manages.
+	// +optional
+	Notifiers []TautulliManagedItem `json:"notifiers,omitempty"`
+
+	// Newsletters lists the newsletters this resource manages.
+	// +optional
+	Newsletters []TautulliManagedItem `json:"newsletters,omitempty"`
+
+	// WatchStatistics holds the watch statistics of the selected libraries.
+	// +optional
+	WatchStatistics []TautulliLibraryStatistics `json:"watchStatistics,omitempty"`
+
+	// WatchStatisticsTime is when the watch statistics were collected.
+	// +optional
+	WatchStatisticsTime *metav1.Time `json:"watchStatisticsTime,omitempty"`
+
+	// LastReconcile is the timestamp of the last reconciliation.
+	// +optional
+	LastReconcile *metav1.Time `json:"lastReconcile,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Connected",type=boolean,JSONPath=`.status.connected`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.tautulliVersion`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TautulliConfig is the configuration for Tautulli Plex monitoring
+type TautulliConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired configuration for Tautulli.
+	// +kubebuilder:validation:Required
+	Spec TautulliConfigSpec `json:"spec"`
+
+	// Status defines the observed state of TautulliConfig.
+	// +optional
+	Status TautulliConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TautulliConfigList contains a list of TautulliConfig
+type TautulliConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TautulliConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TautulliConfig{}, &TautulliConfigList{})
+}
@@ -26,83 +26,73 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AudioQualitySpec) DeepCopyInto(out *AudioQualitySpec) {
+func (in *APIKeyDiscoverySpec) DeepCopyInto(out *APIKeyDiscoverySpec) {
 	*out = *in
-	if in.TemplateRef != nil {
-		in, out := &in.TemplateRef, &out.TemplateRef
-		*out = new(LocalObjectReference)
-		**out = **in
-	}
-	if in.Exclude != nil {
-		in, out := &in.Exclude, &out.Exclude
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.PreferAdditional != nil {
-		in, out := &in.PreferAdditional, &out.PreferAdditional
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Tiers != nil {
-		in, out := &in.Tiers, &out.Tiers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.PreferredFormats != nil {
-		in, out := &in.PreferredFormats, &out.PreferredFormats
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioQualitySpec.
-func (in *AudioQualitySpec) DeepCopy() *AudioQualitySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyDiscoverySpec.
+func (in *APIKeyDiscoverySpec) DeepCopy() *APIKeyDiscoverySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AudioQualitySpec)
+	out := new(APIKeyDiscoverySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
+func (in *AppHealthSummary) DeepCopyInto(out *AppHealthSummary) {
 	*out = *in
-	if in.PasswordSecretRef != nil {
-		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
+	if in.LastCheck != nil {
+		in, out := &in.LastCheck, &out.LastCheck
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
-func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppHealthSummary.
+func (in *AppHealthSummary) DeepCopy() *AppHealthSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthenticationSpec)
+	out := new(AppHealthSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BazarrAPIConnectionSpec) DeepCopyInto(out *BazarrAPIConnectionSpec) {
+func (in *ApplyWindowSpec) DeepCopyInto(out *ApplyWindowSpec) {
 	*out = *in
-	out.APIKeySecretRef = in.APIKeySecretRef
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrAPIConnectionSpec.
-func (in *BazarrAPIConnectionSpec) DeepCopy() *BazarrAPIConnectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyWindowSpec.
+func (in *ApplyWindowSpec) DeepCopy() *ApplyWindowSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BazarrAPIConnectionSpec)
+	out := new(ApplyWindowSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BazarrConfig) DeepCopyInto(out *BazarrConfig) {
+func (in *ArrStack) DeepCopyInto(out *ArrStack) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -110,18 +100,18 @@ func (in *BazarrConfig) DeepCopyInto(out *BazarrConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConfig.
-func (in *BazarrConfig) DeepCopy() *BazarrConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStack.
+func (in *ArrStack) DeepCopy() *ArrStack {
 	if in == nil {
 		return nil
 	}
-	out := new(BazarrConfig)
+	out := new(ArrStack)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BazarrConfig) DeepCopyObject() runtime.Object {
+func (in *ArrStack) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -129,71 +119,64 @@ func (in *BazarrConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BazarrConfigList) DeepCopyInto(out *BazarrConfigList) {
+func (in *ArrStackAppSpec) DeepCopyInto(out *ArrStackAppSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]BazarrConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Quality != nil {
+		in, out := &in.Quality, &out.Quality
+		*out = new(VideoQualitySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootFolders != nil {
+		in, out := &in.RootFolders, &out.RootFolders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemotePathMappings != nil {
+		in, out := &in.RemotePathMappings, &out.RemotePathMappings
+		*out = make([]RemotePathMappingSpec, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConfigList.
-func (in *BazarrConfigList) DeepCopy() *BazarrConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackAppSpec.
+func (in *ArrStackAppSpec) DeepCopy() *ArrStackAppSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BazarrConfigList)
+	out := new(ArrStackAppSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BazarrConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArrStackComponentStatus) DeepCopyInto(out *ArrStackComponentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackComponentStatus.
+func (in *ArrStackComponentStatus) DeepCopy() *ArrStackComponentStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ArrStackComponentStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BazarrConfigSpec) DeepCopyInto(out *BazarrConfigSpec) {
+func (in *ArrStackDefaults) DeepCopyInto(out *ArrStackDefaults) {
 	*out = *in
-	if in.Connection != nil {
-		in, out := &in.Connection, &out.Connection
-		*out = new(BazarrAPIConnectionSpec)
-		**out = **in
-	}
-	in.Sonarr.DeepCopyInto(&out.Sonarr)
-	in.Radarr.DeepCopyInto(&out.Radarr)
-	if in.LanguageProfiles != nil {
-		in, out := &in.LanguageProfiles, &out.LanguageProfiles
-		*out = make([]BazarrLanguageProfile, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Providers != nil {
-		in, out := &in.Providers, &out.Providers
-		*out = make([]BazarrProvider, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Quality != nil {
+		in, out := &in.Quality, &out.Quality
+		*out = new(VideoQualitySpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Authentication != nil {
 		in, out := &in.Authentication, &out.Authentication
 		*out = new(AuthenticationSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ConfigMapRef != nil {
-		in, out := &in.ConfigMapRef, &out.ConfigMapRef
-		*out = new(LocalObjectReference)
-		**out = **in
-	}
 	if in.Reconciliation != nil {
 		in, out := &in.Reconciliation, &out.Reconciliation
 		*out = new(ReconciliationSpec)
@@ -201,517 +184,693 @@ func (in *BazarrConfigSpec) DeepCopyInto(out *BazarrConfigSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConfigSpec.
-func (in *BazarrConfigSpec) DeepCopy() *BazarrConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackDefaults.
+func (in *ArrStackDefaults) DeepCopy() *ArrStackDefaults {
 	if in == nil {
 		return nil
 	}
-	out := new(BazarrConfigSpec)
+	out := new(ArrStackDefaults)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BazarrConfigStatus) DeepCopyInto(out *BazarrConfigStatus) {
+func (in *ArrStackHealth) DeepCopyInto(out *ArrStackHealth) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.LastReconcile != nil {
-		in, out := &in.LastReconcile, &out.LastReconcile
-		*out = (*in).DeepCopy()
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConfigStatus.
-func (in *BazarrConfigStatus) DeepCopy() *BazarrConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackHealth.
+func (in *ArrStackHealth) DeepCopy() *ArrStackHealth {
 	if in == nil {
 		return nil
 	}
-	out := new(BazarrConfigStatus)
+	out := new(ArrStackHealth)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArrStackHealth) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BazarrConnectionSpec) DeepCopyInto(out *BazarrConnectionSpec) {
+func (in *ArrStackHealthList) DeepCopyInto(out *ArrStackHealthList) {
 	*out = *in
-	if in.APIKeySecretRef != nil {
-		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArrStackHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConnectionSpec.
-func (in *BazarrConnectionSpec) DeepCopy() *BazarrConnectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackHealthList.
+func (in *ArrStackHealthList) DeepCopy() *ArrStackHealthList {
 	if in == nil {
 		return nil
 	}
-	out := new(BazarrConnectionSpec)
+	out := new(ArrStackHealthList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BazarrLanguage) DeepCopyInto(out *BazarrLanguage) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrLanguage.
-func (in *BazarrLanguage) DeepCopy() *BazarrLanguage {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArrStackHealthList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(BazarrLanguage)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BazarrLanguageProfile) DeepCopyInto(out *BazarrLanguageProfile) {
+func (in *ArrStackHealthSpec) DeepCopyInto(out *ArrStackHealthSpec) {
 	*out = *in
-	if in.Languages != nil {
-		in, out := &in.Languages, &out.Languages
-		*out = make([]BazarrLanguage, len(*in))
-		copy(*out, *in)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrLanguageProfile.
-func (in *BazarrLanguageProfile) DeepCopy() *BazarrLanguageProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackHealthSpec.
+func (in *ArrStackHealthSpec) DeepCopy() *ArrStackHealthSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BazarrLanguageProfile)
+	out := new(ArrStackHealthSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BazarrProvider) DeepCopyInto(out *BazarrProvider) {
+func (in *ArrStackHealthStatus) DeepCopyInto(out *ArrStackHealthStatus) {
 	*out = *in
-	if in.PasswordSecretRef != nil {
-		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.APIKeySecretRef != nil {
-		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = make([]AppHealthSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrProvider.
-func (in *BazarrProvider) DeepCopy() *BazarrProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackHealthStatus.
+func (in *ArrStackHealthStatus) DeepCopy() *ArrStackHealthStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BazarrProvider)
+	out := new(ArrStackHealthStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionSpec) DeepCopyInto(out *ConnectionSpec) {
+func (in *ArrStackLidarrSpec) DeepCopyInto(out *ArrStackLidarrSpec) {
 	*out = *in
-	if in.APIKeySecretRef != nil {
-		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Quality != nil {
+		in, out := &in.Quality, &out.Quality
+		*out = new(AudioQualitySpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(v1.Duration)
-		**out = **in
+	if in.RootFolders != nil {
+		in, out := &in.RootFolders, &out.RootFolders
+		*out = make([]LidarrRootFolder, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemotePathMappings != nil {
+		in, out := &in.RemotePathMappings, &out.RemotePathMappings
+		*out = make([]RemotePathMappingSpec, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSpec.
-func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackLidarrSpec.
+func (in *ArrStackLidarrSpec) DeepCopy() *ArrStackLidarrSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionSpec)
+	out := new(ArrStackLidarrSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CredentialsSecretRef) DeepCopyInto(out *CredentialsSecretRef) {
+func (in *ArrStackList) DeepCopyInto(out *ArrStackList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArrStack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsSecretRef.
-func (in *CredentialsSecretRef) DeepCopy() *CredentialsSecretRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackList.
+func (in *ArrStackList) DeepCopy() *ArrStackList {
 	if in == nil {
 		return nil
 	}
-	out := new(CredentialsSecretRef)
+	out := new(ArrStackList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArrStackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomFormatSpec) DeepCopyInto(out *CustomFormatSpec) {
+func (in *ArrStackProwlarrSpec) DeepCopyInto(out *ArrStackProwlarrSpec) {
 	*out = *in
-	if in.IncludeWhenRenaming != nil {
-		in, out := &in.IncludeWhenRenaming, &out.IncludeWhenRenaming
-		*out = new(bool)
-		**out = **in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Indexers != nil {
+		in, out := &in.Indexers, &out.Indexers
+		*out = make([]ProwlarrIndexer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Specifications != nil {
-		in, out := &in.Specifications, &out.Specifications
-		*out = make([]CustomFormatSpecificationSpec, len(*in))
+	if in.AppProfiles != nil {
+		in, out := &in.AppProfiles, &out.AppProfiles
+		*out = make([]ProwlarrAppProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Proxies != nil {
+		in, out := &in.Proxies, &out.Proxies
+		*out = make([]IndexerProxy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.IndexerHealth != nil {
+		in, out := &in.IndexerHealth, &out.IndexerHealth
+		*out = new(IndexerHealthSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomFormatSpec.
-func (in *CustomFormatSpec) DeepCopy() *CustomFormatSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackProwlarrSpec.
+func (in *ArrStackProwlarrSpec) DeepCopy() *ArrStackProwlarrSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomFormatSpec)
+	out := new(ArrStackProwlarrSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomFormatSpecificationSpec) DeepCopyInto(out *CustomFormatSpecificationSpec) {
+func (in *ArrStackSpec) DeepCopyInto(out *ArrStackSpec) {
 	*out = *in
-	if in.Negate != nil {
-		in, out := &in.Negate, &out.Negate
-		*out = new(bool)
-		**out = **in
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(ArrStackDefaults)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Required != nil {
-		in, out := &in.Required, &out.Required
-		*out = new(bool)
-		**out = **in
+	if in.Prowlarr != nil {
+		in, out := &in.Prowlarr, &out.Prowlarr
+		*out = new(ArrStackProwlarrSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Radarr != nil {
+		in, out := &in.Radarr, &out.Radarr
+		*out = new(ArrStackAppSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sonarr != nil {
+		in, out := &in.Sonarr, &out.Sonarr
+		*out = new(ArrStackAppSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lidarr != nil {
+		in, out := &in.Lidarr, &out.Lidarr
+		*out = new(ArrStackLidarrSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DownloadStack != nil {
+		in, out := &in.DownloadStack, &out.DownloadStack
+		*out = new(DownloadStackConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GlobalNotifications != nil {
+		in, out := &in.GlobalNotifications, &out.GlobalNotifications
+		*out = make([]GlobalNotificationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomFormatSpecificationSpec.
-func (in *CustomFormatSpecificationSpec) DeepCopy() *CustomFormatSpecificationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackSpec.
+func (in *ArrStackSpec) DeepCopy() *ArrStackSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomFormatSpecificationSpec)
+	out := new(ArrStackSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelayProfileSpec) DeepCopyInto(out *DelayProfileSpec) {
+func (in *ArrStackStatus) DeepCopyInto(out *ArrStackStatus) {
 	*out = *in
-	if in.EnableUsenet != nil {
-		in, out := &in.EnableUsenet, &out.EnableUsenet
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EnableTorrent != nil {
-		in, out := &in.EnableTorrent, &out.EnableTorrent
-		*out = new(bool)
-		**out = **in
-	}
-	if in.BypassIfHighestQuality != nil {
-		in, out := &in.BypassIfHighestQuality, &out.BypassIfHighestQuality
-		*out = new(bool)
-		**out = **in
-	}
-	if in.BypassIfAboveCustomFormatScore != nil {
-		in, out := &in.BypassIfAboveCustomFormatScore, &out.BypassIfAboveCustomFormatScore
-		*out = new(bool)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]string, len(*in))
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ArrStackComponentStatus, len(*in))
 		copy(*out, *in)
 	}
-	if in.Order != nil {
-		in, out := &in.Order, &out.Order
-		*out = new(int)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelayProfileSpec.
-func (in *DelayProfileSpec) DeepCopy() *DelayProfileSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArrStackStatus.
+func (in *ArrStackStatus) DeepCopy() *ArrStackStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DelayProfileSpec)
+	out := new(ArrStackStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelugeConnectionSpec) DeepCopyInto(out *DelugeConnectionSpec) {
+func (in *AudioQualitySpec) DeepCopyInto(out *AudioQualitySpec) {
 	*out = *in
-	if in.PasswordSecretRef != nil {
-		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
-		*out = new(SecretKeySelector)
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreferAdditional != nil {
+		in, out := &in.PreferAdditional, &out.PreferAdditional
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tiers != nil {
+		in, out := &in.Tiers, &out.Tiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreferredFormats != nil {
+		in, out := &in.PreferredFormats, &out.PreferredFormats
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeConnectionSpec.
-func (in *DelugeConnectionSpec) DeepCopy() *DelugeConnectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AudioQualitySpec.
+func (in *AudioQualitySpec) DeepCopy() *AudioQualitySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DelugeConnectionSpec)
+	out := new(AudioQualitySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelugeConnectionsSpec) DeepCopyInto(out *DelugeConnectionsSpec) {
+func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 	*out = *in
-	if in.ListenPorts != nil {
-		in, out := &in.ListenPorts, &out.ListenPorts
-		*out = make([]int, len(*in))
-		copy(*out, *in)
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeConnectionsSpec.
-func (in *DelugeConnectionsSpec) DeepCopy() *DelugeConnectionsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
+func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DelugeConnectionsSpec)
+	out := new(AuthenticationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelugeDirectoriesSpec) DeepCopyInto(out *DelugeDirectoriesSpec) {
+func (in *AutoTagSpec) DeepCopyInto(out *AutoTagSpec) {
 	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoveTagsAutomatically != nil {
+		in, out := &in.RemoveTagsAutomatically, &out.RemoveTagsAutomatically
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Specifications != nil {
+		in, out := &in.Specifications, &out.Specifications
+		*out = make([]AutoTagSpecificationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeDirectoriesSpec.
-func (in *DelugeDirectoriesSpec) DeepCopy() *DelugeDirectoriesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoTagSpec.
+func (in *AutoTagSpec) DeepCopy() *AutoTagSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DelugeDirectoriesSpec)
+	out := new(AutoTagSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelugeProtocolSpec) DeepCopyInto(out *DelugeProtocolSpec) {
+func (in *AutoTagSpecificationSpec) DeepCopyInto(out *AutoTagSpecificationSpec) {
 	*out = *in
-	if in.DHT != nil {
-		in, out := &in.DHT, &out.DHT
-		*out = new(bool)
-		**out = **in
-	}
-	if in.UPnP != nil {
-		in, out := &in.UPnP, &out.UPnP
+	if in.Negate != nil {
+		in, out := &in.Negate, &out.Negate
 		*out = new(bool)
 		**out = **in
 	}
-	if in.NATPMP != nil {
-		in, out := &in.NATPMP, &out.NATPMP
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LSD != nil {
-		in, out := &in.LSD, &out.LSD
-		*out = new(bool)
-		**out = **in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.ProtocolEncryption != nil {
-		in, out := &in.ProtocolEncryption, &out.ProtocolEncryption
-		*out = new(bool)
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int)
 		**out = **in
 	}
-	if in.EncryptionLevel != nil {
-		in, out := &in.EncryptionLevel, &out.EncryptionLevel
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
 		*out = new(int)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeProtocolSpec.
-func (in *DelugeProtocolSpec) DeepCopy() *DelugeProtocolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoTagSpecificationSpec.
+func (in *AutoTagSpecificationSpec) DeepCopy() *AutoTagSpecificationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DelugeProtocolSpec)
+	out := new(AutoTagSpecificationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelugeQueueSpec) DeepCopyInto(out *DelugeQueueSpec) {
+func (in *BazarrAPIConnectionSpec) DeepCopyInto(out *BazarrAPIConnectionSpec) {
 	*out = *in
+	out.APIKeySecretRef = in.APIKeySecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeQueueSpec.
-func (in *DelugeQueueSpec) DeepCopy() *DelugeQueueSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrAPIConnectionSpec.
+func (in *BazarrAPIConnectionSpec) DeepCopy() *BazarrAPIConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DelugeQueueSpec)
+	out := new(BazarrAPIConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelugeSeedingSpec) DeepCopyInto(out *DelugeSeedingSpec) {
+func (in *BazarrConfig) DeepCopyInto(out *BazarrConfig) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeSeedingSpec.
-func (in *DelugeSeedingSpec) DeepCopy() *DelugeSeedingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConfig.
+func (in *BazarrConfig) DeepCopy() *BazarrConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(DelugeSeedingSpec)
+	out := new(BazarrConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BazarrConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelugeSpec) DeepCopyInto(out *DelugeSpec) {
+func (in *BazarrConfigList) DeepCopyInto(out *BazarrConfigList) {
 	*out = *in
-	in.Connection.DeepCopyInto(&out.Connection)
-	if in.Speed != nil {
-		in, out := &in.Speed, &out.Speed
-		*out = new(DelugeSpeedSpec)
-		**out = **in
-	}
-	if in.Directories != nil {
-		in, out := &in.Directories, &out.Directories
-		*out = new(DelugeDirectoriesSpec)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BazarrConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Seeding != nil {
-		in, out := &in.Seeding, &out.Seeding
-		*out = new(DelugeSeedingSpec)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConfigList.
+func (in *BazarrConfigList) DeepCopy() *BazarrConfigList {
+	if in == nil {
+		return nil
 	}
-	if in.Queue != nil {
-		in, out := &in.Queue, &out.Queue
-		*out = new(DelugeQueueSpec)
+	out := new(BazarrConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BazarrConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BazarrConfigSpec) DeepCopyInto(out *BazarrConfigSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(BazarrAPIConnectionSpec)
 		**out = **in
 	}
-	if in.Connections != nil {
-		in, out := &in.Connections, &out.Connections
-		*out = new(DelugeConnectionsSpec)
+	in.Sonarr.DeepCopyInto(&out.Sonarr)
+	in.Radarr.DeepCopyInto(&out.Radarr)
+	if in.LanguageProfiles != nil {
+		in, out := &in.LanguageProfiles, &out.LanguageProfiles
+		*out = make([]BazarrLanguageProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]BazarrProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(DelugeProtocolSpec)
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.Reconciliation != nil {
+		in, out := &in.Reconciliation, &out.Reconciliation
+		*out = new(ReconciliationSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeSpec.
-func (in *DelugeSpec) DeepCopy() *DelugeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConfigSpec.
+func (in *BazarrConfigSpec) DeepCopy() *BazarrConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DelugeSpec)
+	out := new(BazarrConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelugeSpeedSpec) DeepCopyInto(out *DelugeSpeedSpec) {
+func (in *BazarrConfigStatus) DeepCopyInto(out *BazarrConfigStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastReconcile != nil {
+		in, out := &in.LastReconcile, &out.LastReconcile
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeSpeedSpec.
-func (in *DelugeSpeedSpec) DeepCopy() *DelugeSpeedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConfigStatus.
+func (in *BazarrConfigStatus) DeepCopy() *BazarrConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DelugeSpeedSpec)
+	out := new(BazarrConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectIndexer) DeepCopyInto(out *DirectIndexer) {
+func (in *BazarrConnectionSpec) DeepCopyInto(out *BazarrConnectionSpec) {
 	*out = *in
 	if in.APIKeySecretRef != nil {
 		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
 		*out = new(SecretKeySelector)
 		**out = **in
 	}
-	if in.Categories != nil {
-		in, out := &in.Categories, &out.Categories
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrConnectionSpec.
+func (in *BazarrConnectionSpec) DeepCopy() *BazarrConnectionSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(BazarrConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectIndexer.
-func (in *DirectIndexer) DeepCopy() *DirectIndexer {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BazarrLanguage) DeepCopyInto(out *BazarrLanguage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrLanguage.
+func (in *BazarrLanguage) DeepCopy() *BazarrLanguage {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectIndexer)
+	out := new(BazarrLanguage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DownloadClientSpec) DeepCopyInto(out *DownloadClientSpec) {
+func (in *BazarrLanguageProfile) DeepCopyInto(out *BazarrLanguageProfile) {
 	*out = *in
-	if in.CredentialsSecretRef != nil {
-		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
-		*out = new(CredentialsSecretRef)
-		**out = **in
+	if in.Languages != nil {
+		in, out := &in.Languages, &out.Languages
+		*out = make([]BazarrLanguage, len(*in))
+		copy(*out, *in)
 	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrLanguageProfile.
+func (in *BazarrLanguageProfile) DeepCopy() *BazarrLanguageProfile {
+	if in == nil {
+		return nil
 	}
-	if in.RemoveCompletedDownloads != nil {
-		in, out := &in.RemoveCompletedDownloads, &out.RemoveCompletedDownloads
-		*out = new(bool)
+	out := new(BazarrLanguageProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BazarrProvider) DeepCopyInto(out *BazarrProvider) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(SecretKeySelector)
 		**out = **in
 	}
-	if in.RemoveFailedDownloads != nil {
-		in, out := &in.RemoveFailedDownloads, &out.RemoveFailedDownloads
-		*out = new(bool)
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(SecretKeySelector)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadClientSpec.
-func (in *DownloadClientSpec) DeepCopy() *DownloadClientSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BazarrProvider.
+func (in *BazarrProvider) DeepCopy() *BazarrProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(DownloadClientSpec)
+	out := new(BazarrProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DownloadStackConfig) DeepCopyInto(out *DownloadStackConfig) {
+func (in *CleanupCandidate) DeepCopyInto(out *CleanupCandidate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupCandidate.
+func (in *CleanupCandidate) DeepCopy() *CleanupCandidate {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupCandidate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupPolicy) DeepCopyInto(out *CleanupPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -719,18 +878,18 @@ func (in *DownloadStackConfig) DeepCopyInto(out *DownloadStackConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadStackConfig.
-func (in *DownloadStackConfig) DeepCopy() *DownloadStackConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupPolicy.
+func (in *CleanupPolicy) DeepCopy() *CleanupPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(DownloadStackConfig)
+	out := new(CleanupPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DownloadStackConfig) DeepCopyObject() runtime.Object {
+func (in *CleanupPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -738,31 +897,31 @@ func (in *DownloadStackConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DownloadStackConfigList) DeepCopyInto(out *DownloadStackConfigList) {
+func (in *CleanupPolicyList) DeepCopyInto(out *CleanupPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DownloadStackConfig, len(*in))
+		*out = make([]CleanupPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadStackConfigList.
-func (in *DownloadStackConfigList) DeepCopy() *DownloadStackConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupPolicyList.
+func (in *CleanupPolicyList) DeepCopy() *CleanupPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(DownloadStackConfigList)
+	out := new(CleanupPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DownloadStackConfigList) DeepCopyObject() runtime.Object {
+func (in *CleanupPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -770,59 +929,53 @@ func (in *DownloadStackConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DownloadStackConfigSpec) DeepCopyInto(out *DownloadStackConfigSpec) {
+func (in *CleanupPolicySpec) DeepCopyInto(out *CleanupPolicySpec) {
 	*out = *in
-	out.DeploymentRef = in.DeploymentRef
-	in.Gluetun.DeepCopyInto(&out.Gluetun)
-	if in.Transmission != nil {
-		in, out := &in.Transmission, &out.Transmission
-		*out = new(TransmissionSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.QBittorrent != nil {
-		in, out := &in.QBittorrent, &out.QBittorrent
-		*out = new(QBittorrentSpec)
-		(*in).DeepCopyInto(*out)
+	out.TargetRef = in.TargetRef
+	if in.TautulliRef != nil {
+		in, out := &in.TautulliRef, &out.TautulliRef
+		*out = new(LocalObjectReference)
+		**out = **in
 	}
-	if in.Deluge != nil {
-		in, out := &in.Deluge, &out.Deluge
-		*out = new(DelugeSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]CleanupRule, len(*in))
+		copy(*out, *in)
 	}
-	if in.RTorrent != nil {
-		in, out := &in.RTorrent, &out.RTorrent
-		*out = new(RTorrentSpec)
-		(*in).DeepCopyInto(*out)
+	if in.ExcludeTags != nil {
+		in, out := &in.ExcludeTags, &out.ExcludeTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.SABnzbd != nil {
-		in, out := &in.SABnzbd, &out.SABnzbd
-		*out = new(SABnzbdSpec)
-		(*in).DeepCopyInto(*out)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
 	}
-	if in.NZBGet != nil {
-		in, out := &in.NZBGet, &out.NZBGet
-		*out = new(NZBGetSpec)
-		(*in).DeepCopyInto(*out)
+	if in.MaxDeletionsPerRun != nil {
+		in, out := &in.MaxDeletionsPerRun, &out.MaxDeletionsPerRun
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Reconciliation != nil {
-		in, out := &in.Reconciliation, &out.Reconciliation
-		*out = new(ReconciliationSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadStackConfigSpec.
-func (in *DownloadStackConfigSpec) DeepCopy() *DownloadStackConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupPolicySpec.
+func (in *CleanupPolicySpec) DeepCopy() *CleanupPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DownloadStackConfigSpec)
+	out := new(CleanupPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DownloadStackConfigStatus) DeepCopyInto(out *DownloadStackConfigStatus) {
+func (in *CleanupPolicyStatus) DeepCopyInto(out *CleanupPolicyStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -831,310 +984,301 @@ func (in *DownloadStackConfigStatus) DeepCopyInto(out *DownloadStackConfigStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.LastReconcile != nil {
-		in, out := &in.LastReconcile, &out.LastReconcile
+	if in.LastRun != nil {
+		in, out := &in.LastRun, &out.LastRun
 		*out = (*in).DeepCopy()
 	}
+	if in.Candidates != nil {
+		in, out := &in.Candidates, &out.Candidates
+		*out = make([]CleanupCandidate, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadStackConfigStatus.
-func (in *DownloadStackConfigStatus) DeepCopy() *DownloadStackConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupPolicyStatus.
+func (in *CleanupPolicyStatus) DeepCopy() *CleanupPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DownloadStackConfigStatus)
+	out := new(CleanupPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GluetunDNSSpec) DeepCopyInto(out *GluetunDNSSpec) {
+func (in *CleanupRule) DeepCopyInto(out *CleanupRule) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunDNSSpec.
-func (in *GluetunDNSSpec) DeepCopy() *GluetunDNSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupRule.
+func (in *CleanupRule) DeepCopy() *CleanupRule {
 	if in == nil {
 		return nil
 	}
-	out := new(GluetunDNSSpec)
+	out := new(CleanupRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GluetunFirewallSpec) DeepCopyInto(out *GluetunFirewallSpec) {
+func (in *CleanupTargetReference) DeepCopyInto(out *CleanupTargetReference) {
 	*out = *in
-	if in.VPNInputPorts != nil {
-		in, out := &in.VPNInputPorts, &out.VPNInputPorts
-		*out = make([]int, len(*in))
-		copy(*out, *in)
-	}
-	if in.InputPorts != nil {
-		in, out := &in.InputPorts, &out.InputPorts
-		*out = make([]int, len(*in))
-		copy(*out, *in)
-	}
-	if in.OutboundSubnets != nil {
-		in, out := &in.OutboundSubnets, &out.OutboundSubnets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunFirewallSpec.
-func (in *GluetunFirewallSpec) DeepCopy() *GluetunFirewallSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupTargetReference.
+func (in *CleanupTargetReference) DeepCopy() *CleanupTargetReference {
 	if in == nil {
 		return nil
 	}
-	out := new(GluetunFirewallSpec)
+	out := new(CleanupTargetReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GluetunIPv6Spec) DeepCopyInto(out *GluetunIPv6Spec) {
+func (in *ClusterTargetSpec) DeepCopyInto(out *ClusterTargetSpec) {
 	*out = *in
+	out.KubeconfigSecretRef = in.KubeconfigSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunIPv6Spec.
-func (in *GluetunIPv6Spec) DeepCopy() *GluetunIPv6Spec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTargetSpec.
+func (in *ClusterTargetSpec) DeepCopy() *ClusterTargetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GluetunIPv6Spec)
+	out := new(ClusterTargetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GluetunKillSwitchSpec) DeepCopyInto(out *GluetunKillSwitchSpec) {
+func (in *CollectionsSpec) DeepCopyInto(out *CollectionsSpec) {
 	*out = *in
+	if in.Titles != nil {
+		in, out := &in.Titles, &out.Titles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Monitored != nil {
+		in, out := &in.Monitored, &out.Monitored
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SearchOnAdd != nil {
+		in, out := &in.SearchOnAdd, &out.SearchOnAdd
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunKillSwitchSpec.
-func (in *GluetunKillSwitchSpec) DeepCopy() *GluetunKillSwitchSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionsSpec.
+func (in *CollectionsSpec) DeepCopy() *CollectionsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GluetunKillSwitchSpec)
+	out := new(CollectionsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GluetunLoggingSpec) DeepCopyInto(out *GluetunLoggingSpec) {
+func (in *CompiledSummary) DeepCopyInto(out *CompiledSummary) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunLoggingSpec.
-func (in *GluetunLoggingSpec) DeepCopy() *GluetunLoggingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompiledSummary.
+func (in *CompiledSummary) DeepCopy() *CompiledSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(GluetunLoggingSpec)
+	out := new(CompiledSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GluetunProviderSpec) DeepCopyInto(out *GluetunProviderSpec) {
+func (in *ConfigKindCount) DeepCopyInto(out *ConfigKindCount) {
 	*out = *in
-	if in.CredentialsSecretRef != nil {
-		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
-		*out = new(CredentialsSecretRef)
-		**out = **in
-	}
-	if in.PrivateKeySecretRef != nil {
-		in, out := &in.PrivateKeySecretRef, &out.PrivateKeySecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunProviderSpec.
-func (in *GluetunProviderSpec) DeepCopy() *GluetunProviderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigKindCount.
+func (in *ConfigKindCount) DeepCopy() *ConfigKindCount {
 	if in == nil {
 		return nil
 	}
-	out := new(GluetunProviderSpec)
+	out := new(ConfigKindCount)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GluetunServerSpec) DeepCopyInto(out *GluetunServerSpec) {
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
 	*out = *in
-	if in.Regions != nil {
-		in, out := &in.Regions, &out.Regions
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Countries != nil {
-		in, out := &in.Countries, &out.Countries
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Cities != nil {
-		in, out := &in.Cities, &out.Cities
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Hostnames != nil {
-		in, out := &in.Hostnames, &out.Hostnames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunServerSpec.
-func (in *GluetunServerSpec) DeepCopy() *GluetunServerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
 	if in == nil {
 		return nil
 	}
-	out := new(GluetunServerSpec)
+	out := new(ConfigMapKeySelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GluetunSpec) DeepCopyInto(out *GluetunSpec) {
+func (in *ConnectionSpec) DeepCopyInto(out *ConnectionSpec) {
 	*out = *in
-	in.Provider.DeepCopyInto(&out.Provider)
-	if in.Server != nil {
-		in, out := &in.Server, &out.Server
-		*out = new(GluetunServerSpec)
-		(*in).DeepCopyInto(*out)
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
 	}
-	if in.Firewall != nil {
-		in, out := &in.Firewall, &out.Firewall
-		*out = new(GluetunFirewallSpec)
+	if in.APIKeyDiscovery != nil {
+		in, out := &in.APIKeyDiscovery, &out.APIKeyDiscovery
+		*out = new(APIKeyDiscoverySpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.KillSwitch != nil {
-		in, out := &in.KillSwitch, &out.KillSwitch
-		*out = new(GluetunKillSwitchSpec)
-		**out = **in
+	if in.ExtraHeaders != nil {
+		in, out := &in.ExtraHeaders, &out.ExtraHeaders
+		*out = make([]HeaderSecretRef, len(*in))
+		copy(*out, *in)
 	}
-	if in.DNS != nil {
-		in, out := &in.DNS, &out.DNS
-		*out = new(GluetunDNSSpec)
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(TLSSecretRef)
 		**out = **in
 	}
-	if in.IPv6 != nil {
-		in, out := &in.IPv6, &out.IPv6
-		*out = new(GluetunIPv6Spec)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.Logging != nil {
-		in, out := &in.Logging, &out.Logging
-		*out = new(GluetunLoggingSpec)
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(DeploymentSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(ClusterTargetSpec)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunSpec.
-func (in *GluetunSpec) DeepCopy() *GluetunSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSpec.
+func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GluetunSpec)
+	out := new(ConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HealthIssueStatus) DeepCopyInto(out *HealthIssueStatus) {
+func (in *CredentialsSecretRef) DeepCopyInto(out *CredentialsSecretRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthIssueStatus.
-func (in *HealthIssueStatus) DeepCopy() *HealthIssueStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsSecretRef.
+func (in *CredentialsSecretRef) DeepCopy() *CredentialsSecretRef {
 	if in == nil {
 		return nil
 	}
-	out := new(HealthIssueStatus)
+	out := new(CredentialsSecretRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
+func (in *CustomFormatSpec) DeepCopyInto(out *CustomFormatSpec) {
 	*out = *in
-	if in.LastCheck != nil {
-		in, out := &in.LastCheck, &out.LastCheck
-		*out = (*in).DeepCopy()
+	if in.IncludeWhenRenaming != nil {
+		in, out := &in.IncludeWhenRenaming, &out.IncludeWhenRenaming
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Issues != nil {
-		in, out := &in.Issues, &out.Issues
-		*out = make([]HealthIssueStatus, len(*in))
-		copy(*out, *in)
+	if in.Specifications != nil {
+		in, out := &in.Specifications, &out.Specifications
+		*out = make([]CustomFormatSpecificationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthStatus.
-func (in *HealthStatus) DeepCopy() *HealthStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomFormatSpec.
+func (in *CustomFormatSpec) DeepCopy() *CustomFormatSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HealthStatus)
+	out := new(CustomFormatSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImportListSpec) DeepCopyInto(out *ImportListSpec) {
+func (in *CustomFormatSpecificationSpec) DeepCopyInto(out *CustomFormatSpecificationSpec) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EnableAuto != nil {
-		in, out := &in.EnableAuto, &out.EnableAuto
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SearchOnAdd != nil {
-		in, out := &in.SearchOnAdd, &out.SearchOnAdd
+	if in.Negate != nil {
+		in, out := &in.Negate, &out.Negate
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SeasonFolder != nil {
-		in, out := &in.SeasonFolder, &out.SeasonFolder
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Settings != nil {
-		in, out := &in.Settings, &out.Settings
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.SettingsSecretRef != nil {
-		in, out := &in.SettingsSecretRef, &out.SettingsSecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomFormatSpecificationSpec.
+func (in *CustomFormatSpecificationSpec) DeepCopy() *CustomFormatSpecificationSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(CustomFormatSpecificationSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportListSpec.
-func (in *ImportListSpec) DeepCopy() *ImportListSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomImportListSpec) DeepCopyInto(out *CustomImportListSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomImportListSpec.
+func (in *CustomImportListSpec) DeepCopy() *CustomImportListSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ImportListSpec)
+	out := new(CustomImportListSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IndexerProxy) DeepCopyInto(out *IndexerProxy) {
+func (in *DelayProfileSpec) DeepCopyInto(out *DelayProfileSpec) {
 	*out = *in
-	if in.CredentialsSecretRef != nil {
-		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
-		*out = new(CredentialsSecretRef)
+	if in.EnableUsenet != nil {
+		in, out := &in.EnableUsenet, &out.EnableUsenet
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableTorrent != nil {
+		in, out := &in.EnableTorrent, &out.EnableTorrent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BypassIfHighestQuality != nil {
+		in, out := &in.BypassIfHighestQuality, &out.BypassIfHighestQuality
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BypassIfAboveCustomFormatScore != nil {
+		in, out := &in.BypassIfAboveCustomFormatScore, &out.BypassIfAboveCustomFormatScore
+		*out = new(bool)
 		**out = **in
 	}
 	if in.Tags != nil {
@@ -1142,785 +1286,3956 @@ func (in *IndexerProxy) DeepCopyInto(out *IndexerProxy) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexerProxy.
-func (in *IndexerProxy) DeepCopy() *IndexerProxy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelayProfileSpec.
+func (in *DelayProfileSpec) DeepCopy() *DelayProfileSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IndexerProxy)
+	out := new(DelayProfileSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IndexersSpec) DeepCopyInto(out *IndexersSpec) {
+func (in *DelugeConnectionSpec) DeepCopyInto(out *DelugeConnectionSpec) {
 	*out = *in
-	if in.ProwlarrRef != nil {
-		in, out := &in.ProwlarrRef, &out.ProwlarrRef
-		*out = new(ProwlarrRef)
-		(*in).DeepCopyInto(*out)
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
 	}
-	if in.Direct != nil {
-		in, out := &in.Direct, &out.Direct
-		*out = make([]DirectIndexer, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Daemon != nil {
+		in, out := &in.Daemon, &out.Daemon
+		*out = new(DelugeDaemonSpec)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexersSpec.
-func (in *IndexersSpec) DeepCopy() *IndexersSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeConnectionSpec.
+func (in *DelugeConnectionSpec) DeepCopy() *DelugeConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IndexersSpec)
+	out := new(DelugeConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LidarrConfig) DeepCopyInto(out *LidarrConfig) {
+func (in *DelugeConnectionsSpec) DeepCopyInto(out *DelugeConnectionsSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.ListenPorts != nil {
+		in, out := &in.ListenPorts, &out.ListenPorts
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrConfig.
-func (in *LidarrConfig) DeepCopy() *LidarrConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeConnectionsSpec.
+func (in *DelugeConnectionsSpec) DeepCopy() *DelugeConnectionsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LidarrConfig)
+	out := new(DelugeConnectionsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LidarrConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LidarrConfigList) DeepCopyInto(out *LidarrConfigList) {
+func (in *DelugeDaemonSpec) DeepCopyInto(out *DelugeDaemonSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]LidarrConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.AuthSecretRef = in.AuthSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrConfigList.
-func (in *LidarrConfigList) DeepCopy() *LidarrConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeDaemonSpec.
+func (in *DelugeDaemonSpec) DeepCopy() *DelugeDaemonSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LidarrConfigList)
+	out := new(DelugeDaemonSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LidarrConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LidarrConfigSpec) DeepCopyInto(out *LidarrConfigSpec) {
+func (in *DelugeDirectoriesSpec) DeepCopyInto(out *DelugeDirectoriesSpec) {
 	*out = *in
-	in.Connection.DeepCopyInto(&out.Connection)
-	if in.Quality != nil {
-		in, out := &in.Quality, &out.Quality
-		*out = new(AudioQualitySpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = new(MetadataProfileSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DownloadClients != nil {
-		in, out := &in.DownloadClients, &out.DownloadClients
-		*out = make([]DownloadClientSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.RemotePathMappings != nil {
-		in, out := &in.RemotePathMappings, &out.RemotePathMappings
-		*out = make([]RemotePathMappingSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.Indexers != nil {
-		in, out := &in.Indexers, &out.Indexers
-		*out = new(IndexersSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Naming != nil {
-		in, out := &in.Naming, &out.Naming
-		*out = new(LidarrNamingSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RootFolders != nil {
-		in, out := &in.RootFolders, &out.RootFolders
-		*out = make([]LidarrRootFolder, len(*in))
-		copy(*out, *in)
-	}
-	if in.ImportLists != nil {
-		in, out := &in.ImportLists, &out.ImportLists
-		*out = make([]ImportListSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.MediaManagement != nil {
-		in, out := &in.MediaManagement, &out.MediaManagement
-		*out = new(MediaManagementSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(AuthenticationSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Notifications != nil {
-		in, out := &in.Notifications, &out.Notifications
-		*out = make([]NotificationSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.CustomFormats != nil {
-		in, out := &in.CustomFormats, &out.CustomFormats
-		*out = make([]CustomFormatSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.DelayProfiles != nil {
-		in, out := &in.DelayProfiles, &out.DelayProfiles
-		*out = make([]DelayProfileSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Reconciliation != nil {
-		in, out := &in.Reconciliation, &out.Reconciliation
-		*out = new(ReconciliationSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrConfigSpec.
-func (in *LidarrConfigSpec) DeepCopy() *LidarrConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeDirectoriesSpec.
+func (in *DelugeDirectoriesSpec) DeepCopy() *DelugeDirectoriesSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LidarrConfigSpec)
+	out := new(DelugeDirectoriesSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LidarrConfigStatus) DeepCopyInto(out *LidarrConfigStatus) {
+func (in *DelugeInstanceSpec) DeepCopyInto(out *DelugeInstanceSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.LastReconcile != nil {
-		in, out := &in.LastReconcile, &out.LastReconcile
-		*out = (*in).DeepCopy()
-	}
-	in.ManagedResources.DeepCopyInto(&out.ManagedResources)
-	if in.ProwlarrRegistration != nil {
-		in, out := &in.ProwlarrRegistration, &out.ProwlarrRegistration
-		*out = new(ProwlarrRegistration)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Health != nil {
-		in, out := &in.Health, &out.Health
-		*out = new(HealthStatus)
-		(*in).DeepCopyInto(*out)
-	}
+	in.DelugeSpec.DeepCopyInto(&out.DelugeSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrConfigStatus.
-func (in *LidarrConfigStatus) DeepCopy() *LidarrConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeInstanceSpec.
+func (in *DelugeInstanceSpec) DeepCopy() *DelugeInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LidarrConfigStatus)
+	out := new(DelugeInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LidarrNamingSpec) DeepCopyInto(out *LidarrNamingSpec) {
+func (in *DelugeProtocolSpec) DeepCopyInto(out *DelugeProtocolSpec) {
 	*out = *in
-	in.NamingSpec.DeepCopyInto(&out.NamingSpec)
+	if in.DHT != nil {
+		in, out := &in.DHT, &out.DHT
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UPnP != nil {
+		in, out := &in.UPnP, &out.UPnP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NATPMP != nil {
+		in, out := &in.NATPMP, &out.NATPMP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LSD != nil {
+		in, out := &in.LSD, &out.LSD
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProtocolEncryption != nil {
+		in, out := &in.ProtocolEncryption, &out.ProtocolEncryption
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EncryptionLevel != nil {
+		in, out := &in.EncryptionLevel, &out.EncryptionLevel
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrNamingSpec.
-func (in *LidarrNamingSpec) DeepCopy() *LidarrNamingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeProtocolSpec.
+func (in *DelugeProtocolSpec) DeepCopy() *DelugeProtocolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LidarrNamingSpec)
+	out := new(DelugeProtocolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LidarrRootFolder) DeepCopyInto(out *LidarrRootFolder) {
+func (in *DelugeQueueSpec) DeepCopyInto(out *DelugeQueueSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrRootFolder.
-func (in *LidarrRootFolder) DeepCopy() *LidarrRootFolder {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeQueueSpec.
+func (in *DelugeQueueSpec) DeepCopy() *DelugeQueueSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LidarrRootFolder)
+	out := new(DelugeQueueSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
+func (in *DelugeSeedingSpec) DeepCopyInto(out *DelugeSeedingSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalObjectReference.
-func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeSeedingSpec.
+func (in *DelugeSeedingSpec) DeepCopy() *DelugeSeedingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalObjectReference)
+	out := new(DelugeSeedingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedResources) DeepCopyInto(out *ManagedResources) {
+func (in *DelugeSpec) DeepCopyInto(out *DelugeSpec) {
 	*out = *in
-	if in.QualityProfileID != nil {
-		in, out := &in.QualityProfileID, &out.QualityProfileID
-		*out = new(int)
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(DelugeSpeedSpec)
 		**out = **in
 	}
-	if in.CustomFormatIDs != nil {
-		in, out := &in.CustomFormatIDs, &out.CustomFormatIDs
-		*out = make([]int, len(*in))
-		copy(*out, *in)
+	if in.Directories != nil {
+		in, out := &in.Directories, &out.Directories
+		*out = new(DelugeDirectoriesSpec)
+		**out = **in
 	}
-	if in.DownloadClientIDs != nil {
-		in, out := &in.DownloadClientIDs, &out.DownloadClientIDs
-		*out = make([]int, len(*in))
-		copy(*out, *in)
+	if in.Seeding != nil {
+		in, out := &in.Seeding, &out.Seeding
+		*out = new(DelugeSeedingSpec)
+		**out = **in
 	}
-	if in.IndexerIDs != nil {
-		in, out := &in.IndexerIDs, &out.IndexerIDs
-		*out = make([]int, len(*in))
-		copy(*out, *in)
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(DelugeQueueSpec)
+		**out = **in
 	}
-	if in.RootFolderIDs != nil {
-		in, out := &in.RootFolderIDs, &out.RootFolderIDs
-		*out = make([]int, len(*in))
-		copy(*out, *in)
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = new(DelugeConnectionsSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.RemotePathMappingIDs != nil {
-		in, out := &in.RemotePathMappingIDs, &out.RemotePathMappingIDs
-		*out = make([]int, len(*in))
-		copy(*out, *in)
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(DelugeProtocolSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.NotificationIDs != nil {
-		in, out := &in.NotificationIDs, &out.NotificationIDs
-		*out = make([]int, len(*in))
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResources.
-func (in *ManagedResources) DeepCopy() *ManagedResources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeSpec.
+func (in *DelugeSpec) DeepCopy() *DelugeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedResources)
+	out := new(DelugeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MediaManagementSpec) DeepCopyInto(out *MediaManagementSpec) {
+func (in *DelugeSpeedSpec) DeepCopyInto(out *DelugeSpeedSpec) {
 	*out = *in
-	if in.RecycleBinCleanupDays != nil {
-		in, out := &in.RecycleBinCleanupDays, &out.RecycleBinCleanupDays
-		*out = new(int)
-		**out = **in
-	}
-	if in.SetPermissions != nil {
-		in, out := &in.SetPermissions, &out.SetPermissions
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DeleteEmptyFolders != nil {
-		in, out := &in.DeleteEmptyFolders, &out.DeleteEmptyFolders
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CreateEmptyFolders != nil {
-		in, out := &in.CreateEmptyFolders, &out.CreateEmptyFolders
-		*out = new(bool)
-		**out = **in
-	}
-	if in.UseHardlinks != nil {
-		in, out := &in.UseHardlinks, &out.UseHardlinks
-		*out = new(bool)
-		**out = **in
-	}
-	if in.WatchLibraryForChanges != nil {
-		in, out := &in.WatchLibraryForChanges, &out.WatchLibraryForChanges
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediaManagementSpec.
-func (in *MediaManagementSpec) DeepCopy() *MediaManagementSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelugeSpeedSpec.
+func (in *DelugeSpeedSpec) DeepCopy() *DelugeSpeedSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MediaManagementSpec)
+	out := new(DelugeSpeedSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetadataProfileSpec) DeepCopyInto(out *MetadataProfileSpec) {
+func (in *DeploymentSelector) DeepCopyInto(out *DeploymentSelector) {
 	*out = *in
-	if in.PrimaryTypes != nil {
-		in, out := &in.PrimaryTypes, &out.PrimaryTypes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SecondaryTypes != nil {
-		in, out := &in.SecondaryTypes, &out.SecondaryTypes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ReleaseStatuses != nil {
-		in, out := &in.ReleaseStatuses, &out.ReleaseStatuses
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.MatchLabels != nil {
+		in, out := &in.MatchLabels, &out.MatchLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataProfileSpec.
-func (in *MetadataProfileSpec) DeepCopy() *MetadataProfileSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSelector.
+func (in *DeploymentSelector) DeepCopy() *DeploymentSelector {
 	if in == nil {
 		return nil
 	}
-	out := new(MetadataProfileSpec)
+	out := new(DeploymentSelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NZBGetCategorySpec) DeepCopyInto(out *NZBGetCategorySpec) {
+func (in *DirectIndexer) DeepCopyInto(out *DirectIndexer) {
 	*out = *in
-	if in.Unpack != nil {
-		in, out := &in.Unpack, &out.Unpack
-		*out = new(bool)
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(SecretKeySelector)
 		**out = **in
 	}
-	if in.Aliases != nil {
-		in, out := &in.Aliases, &out.Aliases
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetCategorySpec.
-func (in *NZBGetCategorySpec) DeepCopy() *NZBGetCategorySpec {
-	if in == nil {
-		return nil
-	}
-	out := new(NZBGetCategorySpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NZBGetConnectionSpec) DeepCopyInto(out *NZBGetConnectionSpec) {
-	*out = *in
-	if in.CredentialsSecretRef != nil {
-		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
-		*out = new(CredentialsSecretRef)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetConnectionSpec.
-func (in *NZBGetConnectionSpec) DeepCopy() *NZBGetConnectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectIndexer.
+func (in *DirectIndexer) DeepCopy() *DirectIndexer {
 	if in == nil {
 		return nil
 	}
-	out := new(NZBGetConnectionSpec)
+	out := new(DirectIndexer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NZBGetConnectionsSpec) DeepCopyInto(out *NZBGetConnectionsSpec) {
+func (in *DownloadClientInstanceStatus) DeepCopyInto(out *DownloadClientInstanceStatus) {
 	*out = *in
-	if in.Decode != nil {
-		in, out := &in.Decode, &out.Decode
-		*out = new(bool)
-		**out = **in
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NewsServers != nil {
+		in, out := &in.NewsServers, &out.NewsServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetConnectionsSpec.
-func (in *NZBGetConnectionsSpec) DeepCopy() *NZBGetConnectionsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadClientInstanceStatus.
+func (in *DownloadClientInstanceStatus) DeepCopy() *DownloadClientInstanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NZBGetConnectionsSpec)
+	out := new(DownloadClientInstanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NZBGetDirectoriesSpec) DeepCopyInto(out *NZBGetDirectoriesSpec) {
+func (in *DownloadClientRef) DeepCopyInto(out *DownloadClientRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetDirectoriesSpec.
-func (in *NZBGetDirectoriesSpec) DeepCopy() *NZBGetDirectoriesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadClientRef.
+func (in *DownloadClientRef) DeepCopy() *DownloadClientRef {
 	if in == nil {
 		return nil
 	}
-	out := new(NZBGetDirectoriesSpec)
+	out := new(DownloadClientRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NZBGetPostProcessingSpec) DeepCopyInto(out *NZBGetPostProcessingSpec) {
+func (in *DownloadClientSpec) DeepCopyInto(out *DownloadClientSpec) {
 	*out = *in
-	if in.ParRepair != nil {
-		in, out := &in.ParRepair, &out.ParRepair
-		*out = new(bool)
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(CredentialsSecretRef)
 		**out = **in
 	}
-	if in.Unpack != nil {
-		in, out := &in.Unpack, &out.Unpack
-		*out = new(bool)
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(SecretKeySelector)
 		**out = **in
 	}
-	if in.UnpackCleanupDisk != nil {
-		in, out := &in.UnpackCleanupDisk, &out.UnpackCleanupDisk
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.DownloadClientRef != nil {
+		in, out := &in.DownloadClientRef, &out.DownloadClientRef
+		*out = new(DownloadClientRef)
+		**out = **in
+	}
+	if in.DownloadStackRef != nil {
+		in, out := &in.DownloadStackRef, &out.DownloadStackRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.DirectUnpack != nil {
-		in, out := &in.DirectUnpack, &out.DirectUnpack
+	if in.RemoveCompletedDownloads != nil {
+		in, out := &in.RemoveCompletedDownloads, &out.RemoveCompletedDownloads
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ScriptOrder != nil {
-		in, out := &in.ScriptOrder, &out.ScriptOrder
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.RemoveFailedDownloads != nil {
+		in, out := &in.RemoveFailedDownloads, &out.RemoveFailedDownloads
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetPostProcessingSpec.
-func (in *NZBGetPostProcessingSpec) DeepCopy() *NZBGetPostProcessingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadClientSpec.
+func (in *DownloadClientSpec) DeepCopy() *DownloadClientSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NZBGetPostProcessingSpec)
+	out := new(DownloadClientSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NZBGetQueueSpec) DeepCopyInto(out *NZBGetQueueSpec) {
+func (in *DownloadStackConfig) DeepCopyInto(out *DownloadStackConfig) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetQueueSpec.
-func (in *NZBGetQueueSpec) DeepCopy() *NZBGetQueueSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadStackConfig.
+func (in *DownloadStackConfig) DeepCopy() *DownloadStackConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NZBGetQueueSpec)
+	out := new(DownloadStackConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DownloadStackConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NZBGetSpec) DeepCopyInto(out *NZBGetSpec) {
+func (in *DownloadStackConfigList) DeepCopyInto(out *DownloadStackConfigList) {
 	*out = *in
-	in.Connection.DeepCopyInto(&out.Connection)
-	if in.Speed != nil {
-		in, out := &in.Speed, &out.Speed
-		*out = new(NZBGetSpeedSpec)
-		**out = **in
-	}
-	if in.Directories != nil {
-		in, out := &in.Directories, &out.Directories
-		*out = new(NZBGetDirectoriesSpec)
-		**out = **in
-	}
-	if in.Categories != nil {
-		in, out := &in.Categories, &out.Categories
-		*out = make([]NZBGetCategorySpec, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DownloadStackConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Queue != nil {
-		in, out := &in.Queue, &out.Queue
-		*out = new(NZBGetQueueSpec)
-		**out = **in
-	}
-	if in.PostProcessing != nil {
-		in, out := &in.PostProcessing, &out.PostProcessing
-		*out = new(NZBGetPostProcessingSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Connections != nil {
-		in, out := &in.Connections, &out.Connections
-		*out = new(NZBGetConnectionsSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetSpec.
-func (in *NZBGetSpec) DeepCopy() *NZBGetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadStackConfigList.
+func (in *DownloadStackConfigList) DeepCopy() *DownloadStackConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(NZBGetSpec)
+	out := new(DownloadStackConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NZBGetSpeedSpec) DeepCopyInto(out *NZBGetSpeedSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetSpeedSpec.
-func (in *NZBGetSpeedSpec) DeepCopy() *NZBGetSpeedSpec {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DownloadStackConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(NZBGetSpeedSpec)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamingSpec) DeepCopyInto(out *NamingSpec) {
+func (in *DownloadStackConfigSpec) DeepCopyInto(out *DownloadStackConfigSpec) {
+	*out = *in
+	out.DeploymentRef = in.DeploymentRef
+	in.Gluetun.DeepCopyInto(&out.Gluetun)
+	if in.Transmission != nil {
+		in, out := &in.Transmission, &out.Transmission
+		*out = new(TransmissionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QBittorrent != nil {
+		in, out := &in.QBittorrent, &out.QBittorrent
+		*out = new(QBittorrentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Deluge != nil {
+		in, out := &in.Deluge, &out.Deluge
+		*out = new(DelugeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RTorrent != nil {
+		in, out := &in.RTorrent, &out.RTorrent
+		*out = new(RTorrentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SABnzbd != nil {
+		in, out := &in.SABnzbd, &out.SABnzbd
+		*out = new(SABnzbdSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NZBGet != nil {
+		in, out := &in.NZBGet, &out.NZBGet
+		*out = new(NZBGetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Flood != nil {
+		in, out := &in.Flood, &out.Flood
+		*out = new(FloodSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransmissionInstances != nil {
+		in, out := &in.TransmissionInstances, &out.TransmissionInstances
+		*out = make([]TransmissionInstanceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QBittorrentInstances != nil {
+		in, out := &in.QBittorrentInstances, &out.QBittorrentInstances
+		*out = make([]QBittorrentInstanceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DelugeInstances != nil {
+		in, out := &in.DelugeInstances, &out.DelugeInstances
+		*out = make([]DelugeInstanceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RTorrentInstances != nil {
+		in, out := &in.RTorrentInstances, &out.RTorrentInstances
+		*out = make([]RTorrentInstanceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SABnzbdInstances != nil {
+		in, out := &in.SABnzbdInstances, &out.SABnzbdInstances
+		*out = make([]SABnzbdInstanceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NZBGetInstances != nil {
+		in, out := &in.NZBGetInstances, &out.NZBGetInstances
+		*out = make([]NZBGetInstanceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Reconciliation != nil {
+		in, out := &in.Reconciliation, &out.Reconciliation
+		*out = new(ReconciliationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadStackConfigSpec.
+func (in *DownloadStackConfigSpec) DeepCopy() *DownloadStackConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DownloadStackConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DownloadStackConfigStatus) DeepCopyInto(out *DownloadStackConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransmissionClusterWhitelist != nil {
+		in, out := &in.TransmissionClusterWhitelist, &out.TransmissionClusterWhitelist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FloodUsers != nil {
+		in, out := &in.FloodUsers, &out.FloodUsers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FloodUserSecretHashes != nil {
+		in, out := &in.FloodUserSecretHashes, &out.FloodUserSecretHashes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FloodConnection != nil {
+		in, out := &in.FloodConnection, &out.FloodConnection
+		*out = new(FloodConnectionSpec)
+		**out = **in
+	}
+	if in.SABnzbdCategories != nil {
+		in, out := &in.SABnzbdCategories, &out.SABnzbdCategories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SABnzbdNewsServers != nil {
+		in, out := &in.SABnzbdNewsServers, &out.SABnzbdNewsServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NZBGetCategories != nil {
+		in, out := &in.NZBGetCategories, &out.NZBGetCategories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NZBGetNewsServers != nil {
+		in, out := &in.NZBGetNewsServers, &out.NZBGetNewsServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NewsServerSecretHashes != nil {
+		in, out := &in.NewsServerSecretHashes, &out.NewsServerSecretHashes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]DownloadClientInstanceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InvalidFields != nil {
+		in, out := &in.InvalidFields, &out.InvalidFields
+		*out = make([]InvalidField, len(*in))
+		copy(*out, *in)
+	}
+	if in.Unrealized != nil {
+		in, out := &in.Unrealized, &out.Unrealized
+		*out = make([]UnrealizedFeature, len(*in))
+		copy(*out, *in)
+	}
+	if in.EffectiveSettings != nil {
+		in, out := &in.EffectiveSettings, &out.EffectiveSettings
+		*out = make([]EffectiveClientSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TrackerRules != nil {
+		in, out := &in.TrackerRules, &out.TrackerRules
+		*out = make([]TrackerRuleStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.TorrentPolicies != nil {
+		in, out := &in.TorrentPolicies, &out.TorrentPolicies
+		*out = make([]TorrentPolicyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastReconcile != nil {
+		in, out := &in.LastReconcile, &out.LastReconcile
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadStackConfigStatus.
+func (in *DownloadStackConfigStatus) DeepCopy() *DownloadStackConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DownloadStackConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveClientSettings) DeepCopyInto(out *EffectiveClientSettings) {
+	*out = *in
+	if in.PEX != nil {
+		in, out := &in.PEX, &out.PEX
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveClientSettings.
+func (in *EffectiveClientSettings) DeepCopy() *EffectiveClientSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveClientSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailingConfig) DeepCopyInto(out *FailingConfig) {
+	*out = *in
+	if in.Since != nil {
+		in, out := &in.Since, &out.Since
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailingConfig.
+func (in *FailingConfig) DeepCopy() *FailingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FailingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloodClientSpec) DeepCopyInto(out *FloodClientSpec) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(CredentialsSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloodClientSpec.
+func (in *FloodClientSpec) DeepCopy() *FloodClientSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FloodClientSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloodConnectionSpec) DeepCopyInto(out *FloodConnectionSpec) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloodConnectionSpec.
+func (in *FloodConnectionSpec) DeepCopy() *FloodConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FloodConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloodSpec) DeepCopyInto(out *FloodSpec) {
+	*out = *in
+	out.Connection = in.Connection
+	in.Client.DeepCopyInto(&out.Client)
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]FloodUserSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloodSpec.
+func (in *FloodSpec) DeepCopy() *FloodSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FloodSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloodUserSpec) DeepCopyInto(out *FloodUserSpec) {
+	*out = *in
+	out.PasswordSecretRef = in.PasswordSecretRef
+	if in.Client != nil {
+		in, out := &in.Client, &out.Client
+		*out = new(FloodClientSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloodUserSpec.
+func (in *FloodUserSpec) DeepCopy() *FloodUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FloodUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalNotificationSpec) DeepCopyInto(out *GlobalNotificationSpec) {
+	*out = *in
+	in.NotificationSpec.DeepCopyInto(&out.NotificationSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalNotificationSpec.
+func (in *GlobalNotificationSpec) DeepCopy() *GlobalNotificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalNotificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GluetunDNSSpec) DeepCopyInto(out *GluetunDNSSpec) {
+	*out = *in
+	if in.BlockMalicious != nil {
+		in, out := &in.BlockMalicious, &out.BlockMalicious
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockAds != nil {
+		in, out := &in.BlockAds, &out.BlockAds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockSurveillance != nil {
+		in, out := &in.BlockSurveillance, &out.BlockSurveillance
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpstreamAddresses != nil {
+		in, out := &in.UpstreamAddresses, &out.UpstreamAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunDNSSpec.
+func (in *GluetunDNSSpec) DeepCopy() *GluetunDNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GluetunDNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GluetunFirewallSpec) DeepCopyInto(out *GluetunFirewallSpec) {
+	*out = *in
+	if in.VPNInputPorts != nil {
+		in, out := &in.VPNInputPorts, &out.VPNInputPorts
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.InputPorts != nil {
+		in, out := &in.InputPorts, &out.InputPorts
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutboundSubnets != nil {
+		in, out := &in.OutboundSubnets, &out.OutboundSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunFirewallSpec.
+func (in *GluetunFirewallSpec) DeepCopy() *GluetunFirewallSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GluetunFirewallSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GluetunIPv6Spec) DeepCopyInto(out *GluetunIPv6Spec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunIPv6Spec.
+func (in *GluetunIPv6Spec) DeepCopy() *GluetunIPv6Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(GluetunIPv6Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GluetunKillSwitchSpec) DeepCopyInto(out *GluetunKillSwitchSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunKillSwitchSpec.
+func (in *GluetunKillSwitchSpec) DeepCopy() *GluetunKillSwitchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GluetunKillSwitchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GluetunLoggingSpec) DeepCopyInto(out *GluetunLoggingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunLoggingSpec.
+func (in *GluetunLoggingSpec) DeepCopy() *GluetunLoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GluetunLoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GluetunProviderSpec) DeepCopyInto(out *GluetunProviderSpec) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(CredentialsSecretRef)
+		**out = **in
+	}
+	if in.PrivateKeySecretRef != nil {
+		in, out := &in.PrivateKeySecretRef, &out.PrivateKeySecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunProviderSpec.
+func (in *GluetunProviderSpec) DeepCopy() *GluetunProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GluetunProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GluetunServerSpec) DeepCopyInto(out *GluetunServerSpec) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Countries != nil {
+		in, out := &in.Countries, &out.Countries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cities != nil {
+		in, out := &in.Cities, &out.Cities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunServerSpec.
+func (in *GluetunServerSpec) DeepCopy() *GluetunServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GluetunServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GluetunSpec) DeepCopyInto(out *GluetunSpec) {
+	*out = *in
+	in.Provider.DeepCopyInto(&out.Provider)
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(GluetunServerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WireGuard != nil {
+		in, out := &in.WireGuard, &out.WireGuard
+		*out = new(GluetunWireGuardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(GluetunFirewallSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KillSwitch != nil {
+		in, out := &in.KillSwitch, &out.KillSwitch
+		*out = new(GluetunKillSwitchSpec)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(GluetunDNSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(GluetunIPv6Spec)
+		**out = **in
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(GluetunLoggingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunSpec.
+func (in *GluetunSpec) DeepCopy() *GluetunSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GluetunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GluetunWireGuardSpec) DeepCopyInto(out *GluetunWireGuardSpec) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int)
+		**out = **in
+	}
+	if in.PresharedKeySecretRef != nil {
+		in, out := &in.PresharedKeySecretRef, &out.PresharedKeySecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.EndpointPort != nil {
+		in, out := &in.EndpointPort, &out.EndpointPort
+		*out = new(int)
+		**out = **in
+	}
+	if in.AllowedIPs != nil {
+		in, out := &in.AllowedIPs, &out.AllowedIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GluetunWireGuardSpec.
+func (in *GluetunWireGuardSpec) DeepCopy() *GluetunWireGuardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GluetunWireGuardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoodreadsListImportSpec) DeepCopyInto(out *GoodreadsListImportSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoodreadsListImportSpec.
+func (in *GoodreadsListImportSpec) DeepCopy() *GoodreadsListImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoodreadsListImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoodreadsOwnedBooksImportSpec) DeepCopyInto(out *GoodreadsOwnedBooksImportSpec) {
+	*out = *in
+	out.SessionSecretRef = in.SessionSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoodreadsOwnedBooksImportSpec.
+func (in *GoodreadsOwnedBooksImportSpec) DeepCopy() *GoodreadsOwnedBooksImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoodreadsOwnedBooksImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderSecretRef) DeepCopyInto(out *HeaderSecretRef) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderSecretRef.
+func (in *HeaderSecretRef) DeepCopy() *HeaderSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthIssueStatus) DeepCopyInto(out *HealthIssueStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthIssueStatus.
+func (in *HealthIssueStatus) DeepCopy() *HealthIssueStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthIssueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
+	*out = *in
+	if in.LastCheck != nil {
+		in, out := &in.LastCheck, &out.LastCheck
+		*out = (*in).DeepCopy()
+	}
+	if in.Issues != nil {
+		in, out := &in.Issues, &out.Issues
+		*out = make([]HealthIssueStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthStatus.
+func (in *HealthStatus) DeepCopy() *HealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportListOptionsSpec) DeepCopyInto(out *ImportListOptionsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportListOptionsSpec.
+func (in *ImportListOptionsSpec) DeepCopy() *ImportListOptionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportListOptionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportListSpec) DeepCopyInto(out *ImportListSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableAuto != nil {
+		in, out := &in.EnableAuto, &out.EnableAuto
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SearchOnAdd != nil {
+		in, out := &in.SearchOnAdd, &out.SearchOnAdd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SeasonFolder != nil {
+		in, out := &in.SeasonFolder, &out.SeasonFolder
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SettingsSecretRef != nil {
+		in, out := &in.SettingsSecretRef, &out.SettingsSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.PlexWatchlist != nil {
+		in, out := &in.PlexWatchlist, &out.PlexWatchlist
+		*out = new(PlexWatchlistImportSpec)
+		**out = **in
+	}
+	if in.PlexRSS != nil {
+		in, out := &in.PlexRSS, &out.PlexRSS
+		*out = new(PlexRSSImportSpec)
+		**out = **in
+	}
+	if in.TraktList != nil {
+		in, out := &in.TraktList, &out.TraktList
+		*out = new(TraktListImportSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = new(CustomImportListSpec)
+		**out = **in
+	}
+	if in.GoodreadsList != nil {
+		in, out := &in.GoodreadsList, &out.GoodreadsList
+		*out = new(GoodreadsListImportSpec)
+		**out = **in
+	}
+	if in.GoodreadsOwnedBooks != nil {
+		in, out := &in.GoodreadsOwnedBooks, &out.GoodreadsOwnedBooks
+		*out = new(GoodreadsOwnedBooksImportSpec)
+		**out = **in
+	}
+	if in.LazyLibrarian != nil {
+		in, out := &in.LazyLibrarian, &out.LazyLibrarian
+		*out = new(LazyLibrarianImportSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportListSpec.
+func (in *ImportListSpec) DeepCopy() *ImportListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexerHealthSpec) DeepCopyInto(out *IndexerHealthSpec) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexerHealthSpec.
+func (in *IndexerHealthSpec) DeepCopy() *IndexerHealthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexerHealthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexerHealthStatus) DeepCopyInto(out *IndexerHealthStatus) {
+	*out = *in
+	if in.DisabledAt != nil {
+		in, out := &in.DisabledAt, &out.DisabledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ReenableAt != nil {
+		in, out := &in.ReenableAt, &out.ReenableAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ReenabledAt != nil {
+		in, out := &in.ReenabledAt, &out.ReenabledAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexerHealthStatus.
+func (in *IndexerHealthStatus) DeepCopy() *IndexerHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexerHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexerKeyStatus) DeepCopyInto(out *IndexerKeyStatus) {
+	*out = *in
+	if in.SwitchedAt != nil {
+		in, out := &in.SwitchedAt, &out.SwitchedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.TestedAt != nil {
+		in, out := &in.TestedAt, &out.TestedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexerKeyStatus.
+func (in *IndexerKeyStatus) DeepCopy() *IndexerKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexerKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexerProxy) DeepCopyInto(out *IndexerProxy) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(CredentialsSecretRef)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexerProxy.
+func (in *IndexerProxy) DeepCopy() *IndexerProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexerProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexerStatus) DeepCopyInto(out *IndexerStatus) {
+	*out = *in
+	if in.LastChecked != nil {
+		in, out := &in.LastChecked, &out.LastChecked
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexerStatus.
+func (in *IndexerStatus) DeepCopy() *IndexerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexersSpec) DeepCopyInto(out *IndexersSpec) {
+	*out = *in
+	if in.ProwlarrRef != nil {
+		in, out := &in.ProwlarrRef, &out.ProwlarrRef
+		*out = new(ProwlarrRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Direct != nil {
+		in, out := &in.Direct, &out.Direct
+		*out = make([]DirectIndexer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexersSpec.
+func (in *IndexersSpec) DeepCopy() *IndexersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidField) DeepCopyInto(out *InvalidField) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidField.
+func (in *InvalidField) DeepCopy() *InvalidField {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JellyfinHookSpec) DeepCopyInto(out *JellyfinHookSpec) {
+	*out = *in
+	out.APIKeySecretRef = in.APIKeySecretRef
+	if in.UpdateLibrary != nil {
+		in, out := &in.UpdateLibrary, &out.UpdateLibrary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Notify != nil {
+		in, out := &in.Notify, &out.Notify
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JellyfinHookSpec.
+func (in *JellyfinHookSpec) DeepCopy() *JellyfinHookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JellyfinHookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigSecretRef) DeepCopyInto(out *KubeconfigSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigSecretRef.
+func (in *KubeconfigSecretRef) DeepCopy() *KubeconfigSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LanguageSpec) DeepCopyInto(out *LanguageSpec) {
+	*out = *in
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LanguageSpec.
+func (in *LanguageSpec) DeepCopy() *LanguageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LanguageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LazyLibrarianImportSpec) DeepCopyInto(out *LazyLibrarianImportSpec) {
+	*out = *in
+	out.APIKeySecretRef = in.APIKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LazyLibrarianImportSpec.
+func (in *LazyLibrarianImportSpec) DeepCopy() *LazyLibrarianImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LazyLibrarianImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LidarrConfig) DeepCopyInto(out *LidarrConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrConfig.
+func (in *LidarrConfig) DeepCopy() *LidarrConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LidarrConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LidarrConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LidarrConfigList) DeepCopyInto(out *LidarrConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LidarrConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrConfigList.
+func (in *LidarrConfigList) DeepCopy() *LidarrConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(LidarrConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LidarrConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LidarrConfigSpec) DeepCopyInto(out *LidarrConfigSpec) {
+	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Quality != nil {
+		in, out := &in.Quality, &out.Quality
+		*out = new(AudioQualitySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(MetadataProfileSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DownloadClients != nil {
+		in, out := &in.DownloadClients, &out.DownloadClients
+		*out = make([]DownloadClientSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemotePathMappings != nil {
+		in, out := &in.RemotePathMappings, &out.RemotePathMappings
+		*out = make([]RemotePathMappingSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Indexers != nil {
+		in, out := &in.Indexers, &out.Indexers
+		*out = new(IndexersSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(LidarrNamingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootFolders != nil {
+		in, out := &in.RootFolders, &out.RootFolders
+		*out = make([]LidarrRootFolder, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportLists != nil {
+		in, out := &in.ImportLists, &out.ImportLists
+		*out = make([]ImportListSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MediaManagement != nil {
+		in, out := &in.MediaManagement, &out.MediaManagement
+		*out = new(MediaManagementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomFormats != nil {
+		in, out := &in.CustomFormats, &out.CustomFormats
+		*out = make([]CustomFormatSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DelayProfiles != nil {
+		in, out := &in.DelayProfiles, &out.DelayProfiles
+		*out = make([]DelayProfileSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReleaseProfiles != nil {
+		in, out := &in.ReleaseProfiles, &out.ReleaseProfiles
+		*out = make([]ReleaseProfileSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = make([]RawRequestSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reconciliation != nil {
+		in, out := &in.Reconciliation, &out.Reconciliation
+		*out = new(ReconciliationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrConfigSpec.
+func (in *LidarrConfigSpec) DeepCopy() *LidarrConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LidarrConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LidarrConfigStatus) DeepCopyInto(out *LidarrConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastReconcile != nil {
+		in, out := &in.LastReconcile, &out.LastReconcile
+		*out = (*in).DeepCopy()
+	}
+	in.ManagedResources.DeepCopyInto(&out.ManagedResources)
+	if in.SecretHashes != nil {
+		in, out := &in.SecretHashes, &out.SecretHashes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CompiledSummary != nil {
+		in, out := &in.CompiledSummary, &out.CompiledSummary
+		*out = new(CompiledSummary)
+		**out = **in
+	}
+	if in.InvalidFields != nil {
+		in, out := &in.InvalidFields, &out.InvalidFields
+		*out = make([]InvalidField, len(*in))
+		copy(*out, *in)
+	}
+	if in.Converged != nil {
+		in, out := &in.Converged, &out.Converged
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResidualChanges != nil {
+		in, out := &in.ResidualChanges, &out.ResidualChanges
+		*out = make([]ResidualChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnrealizedFeatures != nil {
+		in, out := &in.UnrealizedFeatures, &out.UnrealizedFeatures
+		*out = make([]UnrealizedFeature, len(*in))
+		copy(*out, *in)
+	}
+	if in.PausedResources != nil {
+		in, out := &in.PausedResources, &out.PausedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RawRequests != nil {
+		in, out := &in.RawRequests, &out.RawRequests
+		*out = make([]RawRequestStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProwlarrRegistration != nil {
+		in, out := &in.ProwlarrRegistration, &out.ProwlarrRegistration
+		*out = new(ProwlarrRegistration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(HealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrConfigStatus.
+func (in *LidarrConfigStatus) DeepCopy() *LidarrConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LidarrConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LidarrNamingSpec) DeepCopyInto(out *LidarrNamingSpec) {
+	*out = *in
+	in.NamingSpec.DeepCopyInto(&out.NamingSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrNamingSpec.
+func (in *LidarrNamingSpec) DeepCopy() *LidarrNamingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LidarrNamingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LidarrRootFolder) DeepCopyInto(out *LidarrRootFolder) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LidarrRootFolder.
+func (in *LidarrRootFolder) DeepCopy() *LidarrRootFolder {
+	if in == nil {
+		return nil
+	}
+	out := new(LidarrRootFolder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalObjectReference.
+func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceSpec) DeepCopyInto(out *MaintenanceSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ClearBlocklistOlderThan != nil {
+		in, out := &in.ClearBlocklistOlderThan, &out.ClearBlocklistOlderThan
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BlocklistPatterns != nil {
+		in, out := &in.BlocklistPatterns, &out.BlocklistPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceSpec.
+func (in *MaintenanceSpec) DeepCopy() *MaintenanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceStatus) DeepCopyInto(out *MaintenanceStatus) {
+	*out = *in
+	if in.LastRun != nil {
+		in, out := &in.LastRun, &out.LastRun
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceStatus.
+func (in *MaintenanceStatus) DeepCopy() *MaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedResources) DeepCopyInto(out *ManagedResources) {
+	*out = *in
+	if in.QualityProfileID != nil {
+		in, out := &in.QualityProfileID, &out.QualityProfileID
+		*out = new(int)
+		**out = **in
+	}
+	if in.QualityProfileIDs != nil {
+		in, out := &in.QualityProfileIDs, &out.QualityProfileIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomFormatIDs != nil {
+		in, out := &in.CustomFormatIDs, &out.CustomFormatIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.DownloadClientIDs != nil {
+		in, out := &in.DownloadClientIDs, &out.DownloadClientIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.IndexerIDs != nil {
+		in, out := &in.IndexerIDs, &out.IndexerIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.RootFolderIDs != nil {
+		in, out := &in.RootFolderIDs, &out.RootFolderIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemotePathMappingIDs != nil {
+		in, out := &in.RemotePathMappingIDs, &out.RemotePathMappingIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotificationIDs != nil {
+		in, out := &in.NotificationIDs, &out.NotificationIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResources.
+func (in *ManagedResources) DeepCopy() *ManagedResources {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediaManagementSpec) DeepCopyInto(out *MediaManagementSpec) {
+	*out = *in
+	if in.RecycleBinCleanupDays != nil {
+		in, out := &in.RecycleBinCleanupDays, &out.RecycleBinCleanupDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.SetPermissions != nil {
+		in, out := &in.SetPermissions, &out.SetPermissions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteEmptyFolders != nil {
+		in, out := &in.DeleteEmptyFolders, &out.DeleteEmptyFolders
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreateEmptyFolders != nil {
+		in, out := &in.CreateEmptyFolders, &out.CreateEmptyFolders
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseHardlinks != nil {
+		in, out := &in.UseHardlinks, &out.UseHardlinks
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WatchLibraryForChanges != nil {
+		in, out := &in.WatchLibraryForChanges, &out.WatchLibraryForChanges
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediaManagementSpec.
+func (in *MediaManagementSpec) DeepCopy() *MediaManagementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MediaManagementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediaServerHooksSpec) DeepCopyInto(out *MediaServerHooksSpec) {
+	*out = *in
+	if in.Plex != nil {
+		in, out := &in.Plex, &out.Plex
+		*out = new(PlexHookSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Jellyfin != nil {
+		in, out := &in.Jellyfin, &out.Jellyfin
+		*out = new(JellyfinHookSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediaServerHooksSpec.
+func (in *MediaServerHooksSpec) DeepCopy() *MediaServerHooksSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MediaServerHooksSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediaServerStatus) DeepCopyInto(out *MediaServerStatus) {
+	*out = *in
+	if in.LastChecked != nil {
+		in, out := &in.LastChecked, &out.LastChecked
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediaServerStatus.
+func (in *MediaServerStatus) DeepCopy() *MediaServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MediaServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataProfileSpec) DeepCopyInto(out *MetadataProfileSpec) {
+	*out = *in
+	if in.PrimaryTypes != nil {
+		in, out := &in.PrimaryTypes, &out.PrimaryTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecondaryTypes != nil {
+		in, out := &in.SecondaryTypes, &out.SecondaryTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReleaseStatuses != nil {
+		in, out := &in.ReleaseStatuses, &out.ReleaseStatuses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataProfileSpec.
+func (in *MetadataProfileSpec) DeepCopy() *MetadataProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NZBGetCategorySpec) DeepCopyInto(out *NZBGetCategorySpec) {
+	*out = *in
+	if in.Unpack != nil {
+		in, out := &in.Unpack, &out.Unpack
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetCategorySpec.
+func (in *NZBGetCategorySpec) DeepCopy() *NZBGetCategorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NZBGetCategorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NZBGetConnectionSpec) DeepCopyInto(out *NZBGetConnectionSpec) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(CredentialsSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetConnectionSpec.
+func (in *NZBGetConnectionSpec) DeepCopy() *NZBGetConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NZBGetConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NZBGetConnectionsSpec) DeepCopyInto(out *NZBGetConnectionsSpec) {
+	*out = *in
+	if in.Decode != nil {
+		in, out := &in.Decode, &out.Decode
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetConnectionsSpec.
+func (in *NZBGetConnectionsSpec) DeepCopy() *NZBGetConnectionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NZBGetConnectionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NZBGetDirectoriesSpec) DeepCopyInto(out *NZBGetDirectoriesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetDirectoriesSpec.
+func (in *NZBGetDirectoriesSpec) DeepCopy() *NZBGetDirectoriesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NZBGetDirectoriesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NZBGetInstanceSpec) DeepCopyInto(out *NZBGetInstanceSpec) {
+	*out = *in
+	in.NZBGetSpec.DeepCopyInto(&out.NZBGetSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetInstanceSpec.
+func (in *NZBGetInstanceSpec) DeepCopy() *NZBGetInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NZBGetInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NZBGetPostProcessingSpec) DeepCopyInto(out *NZBGetPostProcessingSpec) {
+	*out = *in
+	if in.ParRepair != nil {
+		in, out := &in.ParRepair, &out.ParRepair
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Unpack != nil {
+		in, out := &in.Unpack, &out.Unpack
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UnpackCleanupDisk != nil {
+		in, out := &in.UnpackCleanupDisk, &out.UnpackCleanupDisk
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DirectUnpack != nil {
+		in, out := &in.DirectUnpack, &out.DirectUnpack
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScriptOrder != nil {
+		in, out := &in.ScriptOrder, &out.ScriptOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetPostProcessingSpec.
+func (in *NZBGetPostProcessingSpec) DeepCopy() *NZBGetPostProcessingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NZBGetPostProcessingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NZBGetQueueSpec) DeepCopyInto(out *NZBGetQueueSpec) {
+	*out = *in
+	if in.FlushQueue != nil {
+		in, out := &in.FlushQueue, &out.FlushQueue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DupeCheck != nil {
+		in, out := &in.DupeCheck, &out.DupeCheck
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetQueueSpec.
+func (in *NZBGetQueueSpec) DeepCopy() *NZBGetQueueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NZBGetQueueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NZBGetSpec) DeepCopyInto(out *NZBGetSpec) {
+	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(NZBGetSpeedSpec)
+		**out = **in
+	}
+	if in.Directories != nil {
+		in, out := &in.Directories, &out.Directories
+		*out = new(NZBGetDirectoriesSpec)
+		**out = **in
+	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]NZBGetCategorySpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(NZBGetQueueSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostProcessing != nil {
+		in, out := &in.PostProcessing, &out.PostProcessing
+		*out = new(NZBGetPostProcessingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = new(NZBGetConnectionsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NewsServers != nil {
+		in, out := &in.NewsServers, &out.NewsServers
+		*out = make([]NewsServerRef, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetSpec.
+func (in *NZBGetSpec) DeepCopy() *NZBGetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NZBGetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NZBGetSpeedSpec) DeepCopyInto(out *NZBGetSpeedSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NZBGetSpeedSpec.
+func (in *NZBGetSpeedSpec) DeepCopy() *NZBGetSpeedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NZBGetSpeedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedVideoQualitySpec) DeepCopyInto(out *NamedVideoQualitySpec) {
+	*out = *in
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreferAdditional != nil {
+		in, out := &in.PreferAdditional, &out.PreferAdditional
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RejectAdditional != nil {
+		in, out := &in.RejectAdditional, &out.RejectAdditional
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedVideoQualitySpec.
+func (in *NamedVideoQualitySpec) DeepCopy() *NamedVideoQualitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedVideoQualitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamingSpec) DeepCopyInto(out *NamingSpec) {
+	*out = *in
+	if in.RenameMedia != nil {
+		in, out := &in.RenameMedia, &out.RenameMedia
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamingSpec.
+func (in *NamingSpec) DeepCopy() *NamingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NebularrStatus) DeepCopyInto(out *NebularrStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NebularrStatus.
+func (in *NebularrStatus) DeepCopy() *NebularrStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NebularrStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NebularrStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NebularrStatusList) DeepCopyInto(out *NebularrStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NebularrStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NebularrStatusList.
+func (in *NebularrStatusList) DeepCopy() *NebularrStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(NebularrStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NebularrStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NebularrStatusStatus) DeepCopyInto(out *NebularrStatusStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Configs != nil {
+		in, out := &in.Configs, &out.Configs
+		*out = make([]ConfigKindCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedResources != nil {
+		in, out := &in.ManagedResources, &out.ManagedResources
+		*out = new(CompiledSummary)
+		**out = **in
+	}
+	if in.FailingConfigs != nil {
+		in, out := &in.FailingConfigs, &out.FailingConfigs
+		*out = make([]FailingConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NebularrStatusStatus.
+func (in *NebularrStatusStatus) DeepCopy() *NebularrStatusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NebularrStatusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewsServerPolicy) DeepCopyInto(out *NewsServerPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewsServerPolicy.
+func (in *NewsServerPolicy) DeepCopy() *NewsServerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NewsServerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NewsServerPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewsServerPolicyList) DeepCopyInto(out *NewsServerPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NewsServerPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewsServerPolicyList.
+func (in *NewsServerPolicyList) DeepCopy() *NewsServerPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NewsServerPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NewsServerPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewsServerPolicySpec) DeepCopyInto(out *NewsServerPolicySpec) {
+	*out = *in
+	if in.UseTLS != nil {
+		in, out := &in.UseTLS, &out.UseTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(CredentialsSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewsServerPolicySpec.
+func (in *NewsServerPolicySpec) DeepCopy() *NewsServerPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NewsServerPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewsServerRef) DeepCopyInto(out *NewsServerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewsServerRef.
+func (in *NewsServerRef) DeepCopy() *NewsServerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(NewsServerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSpec) DeepCopyInto(out *NotificationSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnGrab != nil {
+		in, out := &in.OnGrab, &out.OnGrab
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnDownload != nil {
+		in, out := &in.OnDownload, &out.OnDownload
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnUpgrade != nil {
+		in, out := &in.OnUpgrade, &out.OnUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnRename != nil {
+		in, out := &in.OnRename, &out.OnRename
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnHealthIssue != nil {
+		in, out := &in.OnHealthIssue, &out.OnHealthIssue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnHealthRestored != nil {
+		in, out := &in.OnHealthRestored, &out.OnHealthRestored
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnApplicationUpdate != nil {
+		in, out := &in.OnApplicationUpdate, &out.OnApplicationUpdate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnManualInteractionRequired != nil {
+		in, out := &in.OnManualInteractionRequired, &out.OnManualInteractionRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludeHealthWarnings != nil {
+		in, out := &in.IncludeHealthWarnings, &out.IncludeHealthWarnings
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnMovieAdded != nil {
+		in, out := &in.OnMovieAdded, &out.OnMovieAdded
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnMovieDelete != nil {
+		in, out := &in.OnMovieDelete, &out.OnMovieDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnMovieFileDelete != nil {
+		in, out := &in.OnMovieFileDelete, &out.OnMovieFileDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnMovieFileDeleteForUpgrade != nil {
+		in, out := &in.OnMovieFileDeleteForUpgrade, &out.OnMovieFileDeleteForUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnSeriesAdd != nil {
+		in, out := &in.OnSeriesAdd, &out.OnSeriesAdd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnSeriesDelete != nil {
+		in, out := &in.OnSeriesDelete, &out.OnSeriesDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnEpisodeFileDelete != nil {
+		in, out := &in.OnEpisodeFileDelete, &out.OnEpisodeFileDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnEpisodeFileDeleteForUpgrade != nil {
+		in, out := &in.OnEpisodeFileDeleteForUpgrade, &out.OnEpisodeFileDeleteForUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnReleaseImport != nil {
+		in, out := &in.OnReleaseImport, &out.OnReleaseImport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnArtistAdd != nil {
+		in, out := &in.OnArtistAdd, &out.OnArtistAdd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnArtistDelete != nil {
+		in, out := &in.OnArtistDelete, &out.OnArtistDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnAlbumDelete != nil {
+		in, out := &in.OnAlbumDelete, &out.OnAlbumDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnTrackRetag != nil {
+		in, out := &in.OnTrackRetag, &out.OnTrackRetag
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnDownloadFailure != nil {
+		in, out := &in.OnDownloadFailure, &out.OnDownloadFailure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnImportFailure != nil {
+		in, out := &in.OnImportFailure, &out.OnImportFailure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SettingsSecretRef != nil {
+		in, out := &in.SettingsSecretRef, &out.SettingsSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSpec.
+func (in *NotificationSpec) DeepCopy() *NotificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationStatus) DeepCopyInto(out *NotificationStatus) {
+	*out = *in
+	if in.LastChecked != nil {
+		in, out := &in.LastChecked, &out.LastChecked
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationStatus.
+func (in *NotificationStatus) DeepCopy() *NotificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlexHookSpec) DeepCopyInto(out *PlexHookSpec) {
+	*out = *in
+	out.TokenSecretRef = in.TokenSecretRef
+	if in.UpdateLibrary != nil {
+		in, out := &in.UpdateLibrary, &out.UpdateLibrary
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlexHookSpec.
+func (in *PlexHookSpec) DeepCopy() *PlexHookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlexHookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlexRSSImportSpec) DeepCopyInto(out *PlexRSSImportSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlexRSSImportSpec.
+func (in *PlexRSSImportSpec) DeepCopy() *PlexRSSImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlexRSSImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlexWatchlistImportSpec) DeepCopyInto(out *PlexWatchlistImportSpec) {
+	*out = *in
+	out.AccessTokenSecretRef = in.AccessTokenSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlexWatchlistImportSpec.
+func (in *PlexWatchlistImportSpec) DeepCopy() *PlexWatchlistImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlexWatchlistImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
+func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreferredTermSpec) DeepCopyInto(out *PreferredTermSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferredTermSpec.
+func (in *PreferredTermSpec) DeepCopy() *PreferredTermSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferredTermSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProwlarrAppProfile) DeepCopyInto(out *ProwlarrAppProfile) {
+	*out = *in
+	if in.EnableRss != nil {
+		in, out := &in.EnableRss, &out.EnableRss
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableAutomaticSearch != nil {
+		in, out := &in.EnableAutomaticSearch, &out.EnableAutomaticSearch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableInteractiveSearch != nil {
+		in, out := &in.EnableInteractiveSearch, &out.EnableInteractiveSearch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinimumSeeders != nil {
+		in, out := &in.MinimumSeeders, &out.MinimumSeeders
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrAppProfile.
+func (in *ProwlarrAppProfile) DeepCopy() *ProwlarrAppProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ProwlarrAppProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProwlarrApplication) DeepCopyInto(out *ProwlarrApplication) {
+	*out = *in
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.SyncCategories != nil {
+		in, out := &in.SyncCategories, &out.SyncCategories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrApplication.
+func (in *ProwlarrApplication) DeepCopy() *ProwlarrApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(ProwlarrApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProwlarrConfig) DeepCopyInto(out *ProwlarrConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrConfig.
+func (in *ProwlarrConfig) DeepCopy() *ProwlarrConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProwlarrConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProwlarrConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProwlarrConfigList) DeepCopyInto(out *ProwlarrConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProwlarrConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrConfigList.
+func (in *ProwlarrConfigList) DeepCopy() *ProwlarrConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProwlarrConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProwlarrConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProwlarrConfigSpec) DeepCopyInto(out *ProwlarrConfigSpec) {
+	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Indexers != nil {
+		in, out := &in.Indexers, &out.Indexers
+		*out = make([]ProwlarrIndexer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppProfiles != nil {
+		in, out := &in.AppProfiles, &out.AppProfiles
+		*out = make([]ProwlarrAppProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Proxies != nil {
+		in, out := &in.Proxies, &out.Proxies
+		*out = make([]IndexerProxy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]ProwlarrApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DownloadClients != nil {
+		in, out := &in.DownloadClients, &out.DownloadClients
+		*out = make([]DownloadClientSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IndexerHealth != nil {
+		in, out := &in.IndexerHealth, &out.IndexerHealth
+		*out = new(IndexerHealthSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = make([]RawRequestSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reconciliation != nil {
+		in, out := &in.Reconciliation, &out.Reconciliation
+		*out = new(ReconciliationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrConfigSpec.
+func (in *ProwlarrConfigSpec) DeepCopy() *ProwlarrConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProwlarrConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProwlarrConfigStatus) DeepCopyInto(out *ProwlarrConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastReconcile != nil {
+		in, out := &in.LastReconcile, &out.LastReconcile
+		*out = (*in).DeepCopy()
+	}
+	if in.ManagedIndexers != nil {
+		in, out := &in.ManagedIndexers, &out.ManagedIndexers
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedProxies != nil {
+		in, out := &in.ManagedProxies, &out.ManagedProxies
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedApplications != nil {
+		in, out := &in.ManagedApplications, &out.ManagedApplications
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedDownloadClients != nil {
+		in, out := &in.ManagedDownloadClients, &out.ManagedDownloadClients
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretHashes != nil {
+		in, out := &in.SecretHashes, &out.SecretHashes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CompiledSummary != nil {
+		in, out := &in.CompiledSummary, &out.CompiledSummary
+		*out = new(CompiledSummary)
+		**out = **in
+	}
+	if in.InvalidFields != nil {
+		in, out := &in.InvalidFields, &out.InvalidFields
+		*out = make([]InvalidField, len(*in))
+		copy(*out, *in)
+	}
+	if in.Converged != nil {
+		in, out := &in.Converged, &out.Converged
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResidualChanges != nil {
+		in, out := &in.ResidualChanges, &out.ResidualChanges
+		*out = make([]ResidualChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnrealizedFeatures != nil {
+		in, out := &in.UnrealizedFeatures, &out.UnrealizedFeatures
+		*out = make([]UnrealizedFeature, len(*in))
+		copy(*out, *in)
+	}
+	if in.RawRequests != nil {
+		in, out := &in.RawRequests, &out.RawRequests
+		*out = make([]RawRequestStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(HealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IndexerHealth != nil {
+		in, out := &in.IndexerHealth, &out.IndexerHealth
+		*out = make([]IndexerHealthStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IndexerKeys != nil {
+		in, out := &in.IndexerKeys, &out.IndexerKeys
+		*out = make([]IndexerKeyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PausedResources != nil {
+		in, out := &in.PausedResources, &out.PausedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrConfigStatus.
+func (in *ProwlarrConfigStatus) DeepCopy() *ProwlarrConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProwlarrConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProwlarrIndexer) DeepCopyInto(out *ProwlarrIndexer) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.NextAPIKeySecretRef != nil {
+		in, out := &in.NextAPIKeySecretRef, &out.NextAPIKeySecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryLimit != nil {
+		in, out := &in.QueryLimit, &out.QueryLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.GrabLimit != nil {
+		in, out := &in.GrabLimit, &out.GrabLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrIndexer.
+func (in *ProwlarrIndexer) DeepCopy() *ProwlarrIndexer {
+	if in == nil {
+		return nil
+	}
+	out := new(ProwlarrIndexer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProwlarrRef) DeepCopyInto(out *ProwlarrRef) {
+	*out = *in
+	if in.AutoRegister != nil {
+		in, out := &in.AutoRegister, &out.AutoRegister
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrRef.
+func (in *ProwlarrRef) DeepCopy() *ProwlarrRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ProwlarrRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProwlarrRegistration) DeepCopyInto(out *ProwlarrRegistration) {
+	*out = *in
+	if in.ApplicationID != nil {
+		in, out := &in.ApplicationID, &out.ApplicationID
+		*out = new(int)
+		**out = **in
+	}
+	if in.LastSync != nil {
+		in, out := &in.LastSync, &out.LastSync
+		*out = (*in).DeepCopy()
+	}
+	if in.SyncedIndexers != nil {
+		in, out := &in.SyncedIndexers, &out.SyncedIndexers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutOfPolicyIndexers != nil {
+		in, out := &in.OutOfPolicyIndexers, &out.OutOfPolicyIndexers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrRegistration.
+func (in *ProwlarrRegistration) DeepCopy() *ProwlarrRegistration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProwlarrRegistration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentAltSpeedSpec) DeepCopyInto(out *QBittorrentAltSpeedSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentAltSpeedSpec.
+func (in *QBittorrentAltSpeedSpec) DeepCopy() *QBittorrentAltSpeedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentAltSpeedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentBitTorrentSpec) DeepCopyInto(out *QBittorrentBitTorrentSpec) {
+	*out = *in
+	if in.DHT != nil {
+		in, out := &in.DHT, &out.DHT
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PeX != nil {
+		in, out := &in.PeX, &out.PeX
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LSD != nil {
+		in, out := &in.LSD, &out.LSD
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentBitTorrentSpec.
+func (in *QBittorrentBitTorrentSpec) DeepCopy() *QBittorrentBitTorrentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentBitTorrentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentCategorySpec) DeepCopyInto(out *QBittorrentCategorySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentCategorySpec.
+func (in *QBittorrentCategorySpec) DeepCopy() *QBittorrentCategorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentCategorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentConnectionSpec) DeepCopyInto(out *QBittorrentConnectionSpec) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(CredentialsSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentConnectionSpec.
+func (in *QBittorrentConnectionSpec) DeepCopy() *QBittorrentConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentConnectionsSpec) DeepCopyInto(out *QBittorrentConnectionsSpec) {
+	*out = *in
+	if in.UPnPEnabled != nil {
+		in, out := &in.UPnPEnabled, &out.UPnPEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentConnectionsSpec.
+func (in *QBittorrentConnectionsSpec) DeepCopy() *QBittorrentConnectionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentConnectionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentDirectoriesSpec) DeepCopyInto(out *QBittorrentDirectoriesSpec) {
+	*out = *in
+	if in.CreateSubfolder != nil {
+		in, out := &in.CreateSubfolder, &out.CreateSubfolder
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AppendExtension != nil {
+		in, out := &in.AppendExtension, &out.AppendExtension
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentDirectoriesSpec.
+func (in *QBittorrentDirectoriesSpec) DeepCopy() *QBittorrentDirectoriesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentDirectoriesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentInstanceSpec) DeepCopyInto(out *QBittorrentInstanceSpec) {
+	*out = *in
+	in.QBittorrentSpec.DeepCopyInto(&out.QBittorrentSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentInstanceSpec.
+func (in *QBittorrentInstanceSpec) DeepCopy() *QBittorrentInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentQueueSpec) DeepCopyInto(out *QBittorrentQueueSpec) {
+	*out = *in
+	if in.QueueingEnabled != nil {
+		in, out := &in.QueueingEnabled, &out.QueueingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentQueueSpec.
+func (in *QBittorrentQueueSpec) DeepCopy() *QBittorrentQueueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentQueueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentSeedingSpec) DeepCopyInto(out *QBittorrentSeedingSpec) {
+	*out = *in
+	if in.MaxRatioAction != nil {
+		in, out := &in.MaxRatioAction, &out.MaxRatioAction
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentSeedingSpec.
+func (in *QBittorrentSeedingSpec) DeepCopy() *QBittorrentSeedingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentSeedingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentSpec) DeepCopyInto(out *QBittorrentSpec) {
+	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(QBittorrentSpeedSpec)
+		**out = **in
+	}
+	if in.AltSpeed != nil {
+		in, out := &in.AltSpeed, &out.AltSpeed
+		*out = new(QBittorrentAltSpeedSpec)
+		**out = **in
+	}
+	if in.Directories != nil {
+		in, out := &in.Directories, &out.Directories
+		*out = new(QBittorrentDirectoriesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Seeding != nil {
+		in, out := &in.Seeding, &out.Seeding
+		*out = new(QBittorrentSeedingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(QBittorrentQueueSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = new(QBittorrentConnectionsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BitTorrent != nil {
+		in, out := &in.BitTorrent, &out.BitTorrent
+		*out = new(QBittorrentBitTorrentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Torrents != nil {
+		in, out := &in.Torrents, &out.Torrents
+		*out = new(QBittorrentTorrentDefaultsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]QBittorrentCategorySpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrackerRules != nil {
+		in, out := &in.TrackerRules, &out.TrackerRules
+		*out = make([]QBittorrentTrackerRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Trackers != nil {
+		in, out := &in.Trackers, &out.Trackers
+		*out = new(QBittorrentTrackersSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentSpec.
+func (in *QBittorrentSpec) DeepCopy() *QBittorrentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentSpeedSpec) DeepCopyInto(out *QBittorrentSpeedSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentSpeedSpec.
+func (in *QBittorrentSpeedSpec) DeepCopy() *QBittorrentSpeedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentSpeedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentTorrentDefaultsSpec) DeepCopyInto(out *QBittorrentTorrentDefaultsSpec) {
+	*out = *in
+	if in.AutoTMMEnabled != nil {
+		in, out := &in.AutoTMMEnabled, &out.AutoTMMEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StartPausedEnabled != nil {
+		in, out := &in.StartPausedEnabled, &out.StartPausedEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentTorrentDefaultsSpec.
+func (in *QBittorrentTorrentDefaultsSpec) DeepCopy() *QBittorrentTorrentDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentTorrentDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentTrackerRule) DeepCopyInto(out *QBittorrentTrackerRule) {
+	*out = *in
+	if in.SeedingTimeLimit != nil {
+		in, out := &in.SeedingTimeLimit, &out.SeedingTimeLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.UploadLimit != nil {
+		in, out := &in.UploadLimit, &out.UploadLimit
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentTrackerRule.
+func (in *QBittorrentTrackerRule) DeepCopy() *QBittorrentTrackerRule {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentTrackerRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QBittorrentTrackersSpec) DeepCopyInto(out *QBittorrentTrackersSpec) {
+	*out = *in
+	if in.AddTrackers != nil {
+		in, out := &in.AddTrackers, &out.AddTrackers
+		*out = new(TrackerListSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentTrackersSpec.
+func (in *QBittorrentTrackersSpec) DeepCopy() *QBittorrentTrackersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QBittorrentTrackersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QualityDefinitionSpec) DeepCopyInto(out *QualityDefinitionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QualityDefinitionSpec.
+func (in *QualityDefinitionSpec) DeepCopy() *QualityDefinitionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QualityDefinitionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RTorrentConfigFileSpec) DeepCopyInto(out *RTorrentConfigFileSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentConfigFileSpec.
+func (in *RTorrentConfigFileSpec) DeepCopy() *RTorrentConfigFileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RTorrentConfigFileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RTorrentConnectionSpec) DeepCopyInto(out *RTorrentConnectionSpec) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(CredentialsSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentConnectionSpec.
+func (in *RTorrentConnectionSpec) DeepCopy() *RTorrentConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RTorrentConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RTorrentConnectionsSpec) DeepCopyInto(out *RTorrentConnectionsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentConnectionsSpec.
+func (in *RTorrentConnectionsSpec) DeepCopy() *RTorrentConnectionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RTorrentConnectionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RTorrentDirectoriesSpec) DeepCopyInto(out *RTorrentDirectoriesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentDirectoriesSpec.
+func (in *RTorrentDirectoriesSpec) DeepCopy() *RTorrentDirectoriesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RTorrentDirectoriesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RTorrentInstanceSpec) DeepCopyInto(out *RTorrentInstanceSpec) {
+	*out = *in
+	in.RTorrentSpec.DeepCopyInto(&out.RTorrentSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentInstanceSpec.
+func (in *RTorrentInstanceSpec) DeepCopy() *RTorrentInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RTorrentInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RTorrentProtocolSpec) DeepCopyInto(out *RTorrentProtocolSpec) {
+	*out = *in
+	if in.DHT != nil {
+		in, out := &in.DHT, &out.DHT
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PEX != nil {
+		in, out := &in.PEX, &out.PEX
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentProtocolSpec.
+func (in *RTorrentProtocolSpec) DeepCopy() *RTorrentProtocolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RTorrentProtocolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RTorrentSeedingSpec) DeepCopyInto(out *RTorrentSeedingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentSeedingSpec.
+func (in *RTorrentSeedingSpec) DeepCopy() *RTorrentSeedingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RTorrentSeedingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RTorrentSpec) DeepCopyInto(out *RTorrentSpec) {
+	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(RTorrentSpeedSpec)
+		**out = **in
+	}
+	if in.Directories != nil {
+		in, out := &in.Directories, &out.Directories
+		*out = new(RTorrentDirectoriesSpec)
+		**out = **in
+	}
+	if in.Seeding != nil {
+		in, out := &in.Seeding, &out.Seeding
+		*out = new(RTorrentSeedingSpec)
+		**out = **in
+	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = new(RTorrentConnectionsSpec)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(RTorrentProtocolSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigFile != nil {
+		in, out := &in.ConfigFile, &out.ConfigFile
+		*out = new(RTorrentConfigFileSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentSpec.
+func (in *RTorrentSpec) DeepCopy() *RTorrentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RTorrentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RTorrentSpeedSpec) DeepCopyInto(out *RTorrentSpeedSpec) {
 	*out = *in
-	if in.RenameMedia != nil {
-		in, out := &in.RenameMedia, &out.RenameMedia
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamingSpec.
-func (in *NamingSpec) DeepCopy() *NamingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentSpeedSpec.
+func (in *RTorrentSpeedSpec) DeepCopy() *RTorrentSpeedSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NamingSpec)
+	out := new(RTorrentSpeedSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationSpec) DeepCopyInto(out *NotificationSpec) {
+func (in *RadarrConfig) DeepCopyInto(out *RadarrConfig) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OnGrab != nil {
-		in, out := &in.OnGrab, &out.OnGrab
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RadarrConfig.
+func (in *RadarrConfig) DeepCopy() *RadarrConfig {
+	if in == nil {
+		return nil
 	}
-	if in.OnDownload != nil {
-		in, out := &in.OnDownload, &out.OnDownload
-		*out = new(bool)
-		**out = **in
+	out := new(RadarrConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RadarrConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.OnUpgrade != nil {
-		in, out := &in.OnUpgrade, &out.OnUpgrade
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RadarrConfigList) DeepCopyInto(out *RadarrConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RadarrConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.OnRename != nil {
-		in, out := &in.OnRename, &out.OnRename
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RadarrConfigList.
+func (in *RadarrConfigList) DeepCopy() *RadarrConfigList {
+	if in == nil {
+		return nil
 	}
-	if in.OnHealthIssue != nil {
-		in, out := &in.OnHealthIssue, &out.OnHealthIssue
-		*out = new(bool)
-		**out = **in
+	out := new(RadarrConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RadarrConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.OnHealthRestored != nil {
-		in, out := &in.OnHealthRestored, &out.OnHealthRestored
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RadarrConfigSpec) DeepCopyInto(out *RadarrConfigSpec) {
+	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Quality != nil {
+		in, out := &in.Quality, &out.Quality
+		*out = new(VideoQualitySpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OnApplicationUpdate != nil {
-		in, out := &in.OnApplicationUpdate, &out.OnApplicationUpdate
-		*out = new(bool)
-		**out = **in
+	if in.QualityProfiles != nil {
+		in, out := &in.QualityProfiles, &out.QualityProfiles
+		*out = make([]NamedVideoQualitySpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.OnManualInteractionRequired != nil {
-		in, out := &in.OnManualInteractionRequired, &out.OnManualInteractionRequired
-		*out = new(bool)
-		**out = **in
+	if in.Language != nil {
+		in, out := &in.Language, &out.Language
+		*out = new(LanguageSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.IncludeHealthWarnings != nil {
-		in, out := &in.IncludeHealthWarnings, &out.IncludeHealthWarnings
-		*out = new(bool)
-		**out = **in
+	if in.DownloadClients != nil {
+		in, out := &in.DownloadClients, &out.DownloadClients
+		*out = make([]DownloadClientSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.OnMovieAdded != nil {
-		in, out := &in.OnMovieAdded, &out.OnMovieAdded
-		*out = new(bool)
-		**out = **in
+	if in.RemotePathMappings != nil {
+		in, out := &in.RemotePathMappings, &out.RemotePathMappings
+		*out = make([]RemotePathMappingSpec, len(*in))
+		copy(*out, *in)
 	}
-	if in.OnMovieDelete != nil {
-		in, out := &in.OnMovieDelete, &out.OnMovieDelete
-		*out = new(bool)
-		**out = **in
+	if in.Indexers != nil {
+		in, out := &in.Indexers, &out.Indexers
+		*out = new(IndexersSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OnMovieFileDelete != nil {
-		in, out := &in.OnMovieFileDelete, &out.OnMovieFileDelete
-		*out = new(bool)
-		**out = **in
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(NamingSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OnMovieFileDeleteForUpgrade != nil {
-		in, out := &in.OnMovieFileDeleteForUpgrade, &out.OnMovieFileDeleteForUpgrade
-		*out = new(bool)
-		**out = **in
+	if in.RootFolders != nil {
+		in, out := &in.RootFolders, &out.RootFolders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.OnSeriesAdd != nil {
-		in, out := &in.OnSeriesAdd, &out.OnSeriesAdd
-		*out = new(bool)
-		**out = **in
+	if in.ImportLists != nil {
+		in, out := &in.ImportLists, &out.ImportLists
+		*out = make([]ImportListSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.OnSeriesDelete != nil {
-		in, out := &in.OnSeriesDelete, &out.OnSeriesDelete
-		*out = new(bool)
+	if in.ImportListOptions != nil {
+		in, out := &in.ImportListOptions, &out.ImportListOptions
+		*out = new(ImportListOptionsSpec)
 		**out = **in
 	}
-	if in.OnEpisodeFileDelete != nil {
-		in, out := &in.OnEpisodeFileDelete, &out.OnEpisodeFileDelete
-		*out = new(bool)
-		**out = **in
+	if in.MediaManagement != nil {
+		in, out := &in.MediaManagement, &out.MediaManagement
+		*out = new(MediaManagementSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OnEpisodeFileDeleteForUpgrade != nil {
-		in, out := &in.OnEpisodeFileDeleteForUpgrade, &out.OnEpisodeFileDeleteForUpgrade
-		*out = new(bool)
-		**out = **in
+	if in.Collections != nil {
+		in, out := &in.Collections, &out.Collections
+		*out = new(CollectionsSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OnReleaseImport != nil {
-		in, out := &in.OnReleaseImport, &out.OnReleaseImport
-		*out = new(bool)
+	if in.UI != nil {
+		in, out := &in.UI, &out.UI
+		*out = new(UISpec)
 		**out = **in
 	}
-	if in.OnArtistAdd != nil {
-		in, out := &in.OnArtistAdd, &out.OnArtistAdd
-		*out = new(bool)
-		**out = **in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OnArtistDelete != nil {
-		in, out := &in.OnArtistDelete, &out.OnArtistDelete
-		*out = new(bool)
-		**out = **in
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.OnAlbumDelete != nil {
-		in, out := &in.OnAlbumDelete, &out.OnAlbumDelete
-		*out = new(bool)
-		**out = **in
+	if in.MediaServerHooks != nil {
+		in, out := &in.MediaServerHooks, &out.MediaServerHooks
+		*out = new(MediaServerHooksSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OnTrackRetag != nil {
-		in, out := &in.OnTrackRetag, &out.OnTrackRetag
-		*out = new(bool)
-		**out = **in
+	if in.CustomFormats != nil {
+		in, out := &in.CustomFormats, &out.CustomFormats
+		*out = make([]CustomFormatSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.OnDownloadFailure != nil {
-		in, out := &in.OnDownloadFailure, &out.OnDownloadFailure
-		*out = new(bool)
-		**out = **in
+	if in.AutoTags != nil {
+		in, out := &in.AutoTags, &out.AutoTags
+		*out = make([]AutoTagSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.OnImportFailure != nil {
-		in, out := &in.OnImportFailure, &out.OnImportFailure
-		*out = new(bool)
-		**out = **in
+	if in.QualityDefinitions != nil {
+		in, out := &in.QualityDefinitions, &out.QualityDefinitions
+		*out = make([]QualityDefinitionSpec, len(*in))
+		copy(*out, *in)
 	}
-	if in.Settings != nil {
-		in, out := &in.Settings, &out.Settings
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.DelayProfiles != nil {
+		in, out := &in.DelayProfiles, &out.DelayProfiles
+		*out = make([]DelayProfileSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.SettingsSecretRef != nil {
-		in, out := &in.SettingsSecretRef, &out.SettingsSecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]string, len(*in))
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = make([]RawRequestSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.Reconciliation != nil {
+		in, out := &in.Reconciliation, &out.Reconciliation
+		*out = new(ReconciliationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSpec.
-func (in *NotificationSpec) DeepCopy() *NotificationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RadarrConfigSpec.
+func (in *RadarrConfigSpec) DeepCopy() *RadarrConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationSpec)
+	out := new(RadarrConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+func (in *RadarrConfigStatus) DeepCopyInto(out *RadarrConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1929,60 +5244,139 @@ func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastReconcile != nil {
+		in, out := &in.LastReconcile, &out.LastReconcile
+		*out = (*in).DeepCopy()
+	}
+	in.ManagedResources.DeepCopyInto(&out.ManagedResources)
+	if in.SecretHashes != nil {
+		in, out := &in.SecretHashes, &out.SecretHashes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CompiledSummary != nil {
+		in, out := &in.CompiledSummary, &out.CompiledSummary
+		*out = new(CompiledSummary)
+		**out = **in
+	}
+	if in.InvalidFields != nil {
+		in, out := &in.InvalidFields, &out.InvalidFields
+		*out = make([]InvalidField, len(*in))
+		copy(*out, *in)
+	}
+	if in.Converged != nil {
+		in, out := &in.Converged, &out.Converged
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResidualChanges != nil {
+		in, out := &in.ResidualChanges, &out.ResidualChanges
+		*out = make([]ResidualChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnrealizedFeatures != nil {
+		in, out := &in.UnrealizedFeatures, &out.UnrealizedFeatures
+		*out = make([]UnrealizedFeature, len(*in))
+		copy(*out, *in)
+	}
+	if in.PausedResources != nil {
+		in, out := &in.PausedResources, &out.PausedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RawRequests != nil {
+		in, out := &in.RawRequests, &out.RawRequests
+		*out = make([]RawRequestStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProwlarrRegistration != nil {
+		in, out := &in.ProwlarrRegistration, &out.ProwlarrRegistration
+		*out = new(ProwlarrRegistration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(HealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MediaServers != nil {
+		in, out := &in.MediaServers, &out.MediaServers
+		*out = make([]MediaServerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Indexers != nil {
+		in, out := &in.Indexers, &out.Indexers
+		*out = make([]IndexerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
-func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RadarrConfigStatus.
+func (in *RadarrConfigStatus) DeepCopy() *RadarrConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyStatus)
+	out := new(RadarrConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PreferredTermSpec) DeepCopyInto(out *PreferredTermSpec) {
+func (in *RawRequestSpec) DeepCopyInto(out *RawRequestSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferredTermSpec.
-func (in *PreferredTermSpec) DeepCopy() *PreferredTermSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawRequestSpec.
+func (in *RawRequestSpec) DeepCopy() *RawRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PreferredTermSpec)
+	out := new(RawRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProwlarrApplication) DeepCopyInto(out *ProwlarrApplication) {
+func (in *RawRequestStatus) DeepCopyInto(out *RawRequestStatus) {
 	*out = *in
-	if in.APIKeySecretRef != nil {
-		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
-	}
-	if in.SyncCategories != nil {
-		in, out := &in.SyncCategories, &out.SyncCategories
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.LastApplied != nil {
+		in, out := &in.LastApplied, &out.LastApplied
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrApplication.
-func (in *ProwlarrApplication) DeepCopy() *ProwlarrApplication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawRequestStatus.
+func (in *RawRequestStatus) DeepCopy() *RawRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProwlarrApplication)
+	out := new(RawRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProwlarrConfig) DeepCopyInto(out *ProwlarrConfig) {
+func (in *ReadarrConfig) DeepCopyInto(out *ReadarrConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1990,18 +5384,18 @@ func (in *ProwlarrConfig) DeepCopyInto(out *ProwlarrConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrConfig.
-func (in *ProwlarrConfig) DeepCopy() *ProwlarrConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrConfig.
+func (in *ReadarrConfig) DeepCopy() *ReadarrConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ProwlarrConfig)
+	out := new(ReadarrConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProwlarrConfig) DeepCopyObject() runtime.Object {
+func (in *ReadarrConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2009,31 +5403,31 @@ func (in *ProwlarrConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProwlarrConfigList) DeepCopyInto(out *ProwlarrConfigList) {
+func (in *ReadarrConfigList) DeepCopyInto(out *ReadarrConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProwlarrConfig, len(*in))
+		*out = make([]ReadarrConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrConfigList.
-func (in *ProwlarrConfigList) DeepCopy() *ProwlarrConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrConfigList.
+func (in *ReadarrConfigList) DeepCopy() *ReadarrConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProwlarrConfigList)
+	out := new(ReadarrConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProwlarrConfigList) DeepCopyObject() runtime.Object {
+func (in *ReadarrConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2041,41 +5435,81 @@ func (in *ProwlarrConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProwlarrConfigSpec) DeepCopyInto(out *ProwlarrConfigSpec) {
+func (in *ReadarrConfigSpec) DeepCopyInto(out *ReadarrConfigSpec) {
 	*out = *in
 	in.Connection.DeepCopyInto(&out.Connection)
-	if in.Indexers != nil {
-		in, out := &in.Indexers, &out.Indexers
-		*out = make([]ProwlarrIndexer, len(*in))
+	if in.Quality != nil {
+		in, out := &in.Quality, &out.Quality
+		*out = new(ReadarrQualitySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetadataProfile != nil {
+		in, out := &in.MetadataProfile, &out.MetadataProfile
+		*out = new(ReadarrMetadataProfileSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DownloadClients != nil {
+		in, out := &in.DownloadClients, &out.DownloadClients
+		*out = make([]DownloadClientSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Proxies != nil {
-		in, out := &in.Proxies, &out.Proxies
-		*out = make([]IndexerProxy, len(*in))
+	if in.RemotePathMappings != nil {
+		in, out := &in.RemotePathMappings, &out.RemotePathMappings
+		*out = make([]RemotePathMappingSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Indexers != nil {
+		in, out := &in.Indexers, &out.Indexers
+		*out = new(IndexersSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(ReadarrNamingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootFolders != nil {
+		in, out := &in.RootFolders, &out.RootFolders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportLists != nil {
+		in, out := &in.ImportLists, &out.ImportLists
+		*out = make([]ImportListSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Applications != nil {
-		in, out := &in.Applications, &out.Applications
-		*out = make([]ProwlarrApplication, len(*in))
+	if in.MediaManagement != nil {
+		in, out := &in.MediaManagement, &out.MediaManagement
+		*out = new(MediaManagementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.DownloadClients != nil {
-		in, out := &in.DownloadClients, &out.DownloadClients
-		*out = make([]DownloadClientSpec, len(*in))
+	if in.DelayProfiles != nil {
+		in, out := &in.DelayProfiles, &out.DelayProfiles
+		*out = make([]DelayProfileSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(AuthenticationSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = make([]RawRequestSpec, len(*in))
+		copy(*out, *in)
 	}
 	if in.Reconciliation != nil {
 		in, out := &in.Reconciliation, &out.Reconciliation
@@ -2084,18 +5518,18 @@ func (in *ProwlarrConfigSpec) DeepCopyInto(out *ProwlarrConfigSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrConfigSpec.
-func (in *ProwlarrConfigSpec) DeepCopy() *ProwlarrConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrConfigSpec.
+func (in *ReadarrConfigSpec) DeepCopy() *ReadarrConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProwlarrConfigSpec)
+	out := new(ReadarrConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProwlarrConfigStatus) DeepCopyInto(out *ProwlarrConfigStatus) {
+func (in *ReadarrConfigStatus) DeepCopyInto(out *ReadarrConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2108,21 +5542,49 @@ func (in *ProwlarrConfigStatus) DeepCopyInto(out *ProwlarrConfigStatus) {
 		in, out := &in.LastReconcile, &out.LastReconcile
 		*out = (*in).DeepCopy()
 	}
-	if in.ManagedIndexers != nil {
-		in, out := &in.ManagedIndexers, &out.ManagedIndexers
-		*out = make([]int, len(*in))
+	in.ManagedResources.DeepCopyInto(&out.ManagedResources)
+	if in.CompiledSummary != nil {
+		in, out := &in.CompiledSummary, &out.CompiledSummary
+		*out = new(CompiledSummary)
+		**out = **in
+	}
+	if in.InvalidFields != nil {
+		in, out := &in.InvalidFields, &out.InvalidFields
+		*out = make([]InvalidField, len(*in))
 		copy(*out, *in)
 	}
-	if in.ManagedProxies != nil {
-		in, out := &in.ManagedProxies, &out.ManagedProxies
-		*out = make([]int, len(*in))
+	if in.Converged != nil {
+		in, out := &in.Converged, &out.Converged
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResidualChanges != nil {
+		in, out := &in.ResidualChanges, &out.ResidualChanges
+		*out = make([]ResidualChange, len(*in))
 		copy(*out, *in)
 	}
-	if in.ManagedApplications != nil {
-		in, out := &in.ManagedApplications, &out.ManagedApplications
-		*out = make([]int, len(*in))
+	if in.UnrealizedFeatures != nil {
+		in, out := &in.UnrealizedFeatures, &out.UnrealizedFeatures
+		*out = make([]UnrealizedFeature, len(*in))
 		copy(*out, *in)
 	}
+	if in.PausedResources != nil {
+		in, out := &in.PausedResources, &out.PausedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RawRequests != nil {
+		in, out := &in.RawRequests, &out.RawRequests
+		*out = make([]RawRequestStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProwlarrRegistration != nil {
+		in, out := &in.ProwlarrRegistration, &out.ProwlarrRegistration
+		*out = new(ProwlarrRegistration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(HealthStatus)
@@ -2130,668 +5592,590 @@ func (in *ProwlarrConfigStatus) DeepCopyInto(out *ProwlarrConfigStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrConfigStatus.
-func (in *ProwlarrConfigStatus) DeepCopy() *ProwlarrConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrConfigStatus.
+func (in *ReadarrConfigStatus) DeepCopy() *ReadarrConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProwlarrConfigStatus)
+	out := new(ReadarrConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProwlarrIndexer) DeepCopyInto(out *ProwlarrIndexer) {
+func (in *ReadarrFormatSpec) DeepCopyInto(out *ReadarrFormatSpec) {
 	*out = *in
-	if in.Settings != nil {
-		in, out := &in.Settings, &out.Settings
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrFormatSpec.
+func (in *ReadarrFormatSpec) DeepCopy() *ReadarrFormatSpec {
+	if in == nil {
+		return nil
 	}
-	if in.APIKeySecretRef != nil {
-		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
-		*out = new(SecretKeySelector)
+	out := new(ReadarrFormatSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReadarrMetadataProfileSpec) DeepCopyInto(out *ReadarrMetadataProfileSpec) {
+	*out = *in
+	if in.SkipMissingDate != nil {
+		in, out := &in.SkipMissingDate, &out.SkipMissingDate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipMissingIsbn != nil {
+		in, out := &in.SkipMissingIsbn, &out.SkipMissingIsbn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipPartsAndSets != nil {
+		in, out := &in.SkipPartsAndSets, &out.SkipPartsAndSets
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
+	if in.SkipSeriesSecondary != nil {
+		in, out := &in.SkipSeriesSecondary, &out.SkipSeriesSecondary
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AllowedLanguages != nil {
+		in, out := &in.AllowedLanguages, &out.AllowedLanguages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrIndexer.
-func (in *ProwlarrIndexer) DeepCopy() *ProwlarrIndexer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrMetadataProfileSpec.
+func (in *ReadarrMetadataProfileSpec) DeepCopy() *ReadarrMetadataProfileSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProwlarrIndexer)
+	out := new(ReadarrMetadataProfileSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProwlarrRef) DeepCopyInto(out *ProwlarrRef) {
+func (in *ReadarrNamingSpec) DeepCopyInto(out *ReadarrNamingSpec) {
 	*out = *in
-	if in.AutoRegister != nil {
-		in, out := &in.AutoRegister, &out.AutoRegister
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Include != nil {
-		in, out := &in.Include, &out.Include
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Exclude != nil {
-		in, out := &in.Exclude, &out.Exclude
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.NamingSpec.DeepCopyInto(&out.NamingSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrRef.
-func (in *ProwlarrRef) DeepCopy() *ProwlarrRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrNamingSpec.
+func (in *ReadarrNamingSpec) DeepCopy() *ReadarrNamingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProwlarrRef)
+	out := new(ReadarrNamingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProwlarrRegistration) DeepCopyInto(out *ProwlarrRegistration) {
+func (in *ReadarrQualitySpec) DeepCopyInto(out *ReadarrQualitySpec) {
 	*out = *in
-	if in.ApplicationID != nil {
-		in, out := &in.ApplicationID, &out.ApplicationID
-		*out = new(int)
-		**out = **in
-	}
-	if in.LastSync != nil {
-		in, out := &in.LastSync, &out.LastSync
-		*out = (*in).DeepCopy()
-	}
-	if in.SyncedIndexers != nil {
-		in, out := &in.SyncedIndexers, &out.SyncedIndexers
-		*out = make([]string, len(*in))
+	if in.AllowedFormats != nil {
+		in, out := &in.AllowedFormats, &out.AllowedFormats
+		*out = make([]ReadarrFormatSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.UpgradeAllowed != nil {
+		in, out := &in.UpgradeAllowed, &out.UpgradeAllowed
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProwlarrRegistration.
-func (in *ProwlarrRegistration) DeepCopy() *ProwlarrRegistration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrQualitySpec.
+func (in *ReadarrQualitySpec) DeepCopy() *ReadarrQualitySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProwlarrRegistration)
+	out := new(ReadarrQualitySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QBittorrentAltSpeedSpec) DeepCopyInto(out *QBittorrentAltSpeedSpec) {
+func (in *ReconciliationSpec) DeepCopyInto(out *ReconciliationSpec) {
 	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ApplyWindow != nil {
+		in, out := &in.ApplyWindow, &out.ApplyWindow
+		*out = new(ApplyWindowSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentAltSpeedSpec.
-func (in *QBittorrentAltSpeedSpec) DeepCopy() *QBittorrentAltSpeedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconciliationSpec.
+func (in *ReconciliationSpec) DeepCopy() *ReconciliationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QBittorrentAltSpeedSpec)
+	out := new(ReconciliationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QBittorrentBitTorrentSpec) DeepCopyInto(out *QBittorrentBitTorrentSpec) {
+func (in *ReleaseProfileSpec) DeepCopyInto(out *ReleaseProfileSpec) {
 	*out = *in
-	if in.DHT != nil {
-		in, out := &in.DHT, &out.DHT
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PeX != nil {
-		in, out := &in.PeX, &out.PeX
-		*out = new(bool)
-		**out = **in
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.LSD != nil {
-		in, out := &in.LSD, &out.LSD
+	if in.Ignored != nil {
+		in, out := &in.Ignored, &out.Ignored
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Preferred != nil {
+		in, out := &in.Preferred, &out.Preferred
+		*out = make([]PreferredTermSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludePreferredWhenRenaming != nil {
+		in, out := &in.IncludePreferredWhenRenaming, &out.IncludePreferredWhenRenaming
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Encryption != nil {
-		in, out := &in.Encryption, &out.Encryption
-		*out = new(int)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentBitTorrentSpec.
-func (in *QBittorrentBitTorrentSpec) DeepCopy() *QBittorrentBitTorrentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseProfileSpec.
+func (in *ReleaseProfileSpec) DeepCopy() *ReleaseProfileSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QBittorrentBitTorrentSpec)
+	out := new(ReleaseProfileSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QBittorrentConnectionSpec) DeepCopyInto(out *QBittorrentConnectionSpec) {
+func (in *RemotePathMappingSpec) DeepCopyInto(out *RemotePathMappingSpec) {
 	*out = *in
-	if in.CredentialsSecretRef != nil {
-		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
-		*out = new(CredentialsSecretRef)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentConnectionSpec.
-func (in *QBittorrentConnectionSpec) DeepCopy() *QBittorrentConnectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemotePathMappingSpec.
+func (in *RemotePathMappingSpec) DeepCopy() *RemotePathMappingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QBittorrentConnectionSpec)
+	out := new(RemotePathMappingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QBittorrentConnectionsSpec) DeepCopyInto(out *QBittorrentConnectionsSpec) {
+func (in *ResidualChange) DeepCopyInto(out *ResidualChange) {
 	*out = *in
-	if in.UPnPEnabled != nil {
-		in, out := &in.UPnPEnabled, &out.UPnPEnabled
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentConnectionsSpec.
-func (in *QBittorrentConnectionsSpec) DeepCopy() *QBittorrentConnectionsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResidualChange.
+func (in *ResidualChange) DeepCopy() *ResidualChange {
 	if in == nil {
 		return nil
 	}
-	out := new(QBittorrentConnectionsSpec)
+	out := new(ResidualChange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QBittorrentDirectoriesSpec) DeepCopyInto(out *QBittorrentDirectoriesSpec) {
+func (in *RolloutPolicy) DeepCopyInto(out *RolloutPolicy) {
 	*out = *in
-	if in.CreateSubfolder != nil {
-		in, out := &in.CreateSubfolder, &out.CreateSubfolder
-		*out = new(bool)
-		**out = **in
-	}
-	if in.AppendExtension != nil {
-		in, out := &in.AppendExtension, &out.AppendExtension
-		*out = new(bool)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentDirectoriesSpec.
-func (in *QBittorrentDirectoriesSpec) DeepCopy() *QBittorrentDirectoriesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicy.
+func (in *RolloutPolicy) DeepCopy() *RolloutPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(QBittorrentDirectoriesSpec)
+	out := new(RolloutPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QBittorrentQueueSpec) DeepCopyInto(out *QBittorrentQueueSpec) {
+func (in *RolloutPolicyList) DeepCopyInto(out *RolloutPolicyList) {
 	*out = *in
-	if in.QueueingEnabled != nil {
-		in, out := &in.QueueingEnabled, &out.QueueingEnabled
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolloutPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentQueueSpec.
-func (in *QBittorrentQueueSpec) DeepCopy() *QBittorrentQueueSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicyList.
+func (in *RolloutPolicyList) DeepCopy() *RolloutPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(QBittorrentQueueSpec)
+	out := new(RolloutPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QBittorrentSeedingSpec) DeepCopyInto(out *QBittorrentSeedingSpec) {
+func (in *RolloutPolicySpec) DeepCopyInto(out *RolloutPolicySpec) {
 	*out = *in
-	if in.MaxRatioAction != nil {
-		in, out := &in.MaxRatioAction, &out.MaxRatioAction
-		*out = new(int)
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]RolloutWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VerifyDelay != nil {
+		in, out := &in.VerifyDelay, &out.VerifyDelay
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentSeedingSpec.
-func (in *QBittorrentSeedingSpec) DeepCopy() *QBittorrentSeedingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicySpec.
+func (in *RolloutPolicySpec) DeepCopy() *RolloutPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QBittorrentSeedingSpec)
+	out := new(RolloutPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QBittorrentSpec) DeepCopyInto(out *QBittorrentSpec) {
+func (in *RolloutPolicyStatus) DeepCopyInto(out *RolloutPolicyStatus) {
 	*out = *in
-	in.Connection.DeepCopyInto(&out.Connection)
-	if in.Speed != nil {
-		in, out := &in.Speed, &out.Speed
-		*out = new(QBittorrentSpeedSpec)
-		**out = **in
-	}
-	if in.AltSpeed != nil {
-		in, out := &in.AltSpeed, &out.AltSpeed
-		*out = new(QBittorrentAltSpeedSpec)
-		**out = **in
-	}
-	if in.Directories != nil {
-		in, out := &in.Directories, &out.Directories
-		*out = new(QBittorrentDirectoriesSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Seeding != nil {
-		in, out := &in.Seeding, &out.Seeding
-		*out = new(QBittorrentSeedingSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Queue != nil {
-		in, out := &in.Queue, &out.Queue
-		*out = new(QBittorrentQueueSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Connections != nil {
-		in, out := &in.Connections, &out.Connections
-		*out = new(QBittorrentConnectionsSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]RolloutTargetStatus, len(*in))
+		copy(*out, *in)
 	}
-	if in.BitTorrent != nil {
-		in, out := &in.BitTorrent, &out.BitTorrent
-		*out = new(QBittorrentBitTorrentSpec)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicyStatus.
+func (in *RolloutPolicyStatus) DeepCopy() *RolloutPolicyStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(RolloutPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentSpec.
-func (in *QBittorrentSpec) DeepCopy() *QBittorrentSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutTargetRef) DeepCopyInto(out *RolloutTargetRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutTargetRef.
+func (in *RolloutTargetRef) DeepCopy() *RolloutTargetRef {
 	if in == nil {
 		return nil
 	}
-	out := new(QBittorrentSpec)
+	out := new(RolloutTargetRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QBittorrentSpeedSpec) DeepCopyInto(out *QBittorrentSpeedSpec) {
+func (in *RolloutTargetStatus) DeepCopyInto(out *RolloutTargetStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QBittorrentSpeedSpec.
-func (in *QBittorrentSpeedSpec) DeepCopy() *QBittorrentSpeedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutTargetStatus.
+func (in *RolloutTargetStatus) DeepCopy() *RolloutTargetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(QBittorrentSpeedSpec)
+	out := new(RolloutTargetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RTorrentConnectionSpec) DeepCopyInto(out *RTorrentConnectionSpec) {
+func (in *RolloutWave) DeepCopyInto(out *RolloutWave) {
 	*out = *in
-	if in.CredentialsSecretRef != nil {
-		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
-		*out = new(CredentialsSecretRef)
-		**out = **in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]RolloutTargetRef, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentConnectionSpec.
-func (in *RTorrentConnectionSpec) DeepCopy() *RTorrentConnectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutWave.
+func (in *RolloutWave) DeepCopy() *RolloutWave {
 	if in == nil {
 		return nil
 	}
-	out := new(RTorrentConnectionSpec)
+	out := new(RolloutWave)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RTorrentConnectionsSpec) DeepCopyInto(out *RTorrentConnectionsSpec) {
+func (in *SABnzbdCategorySpec) DeepCopyInto(out *SABnzbdCategorySpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentConnectionsSpec.
-func (in *RTorrentConnectionsSpec) DeepCopy() *RTorrentConnectionsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdCategorySpec.
+func (in *SABnzbdCategorySpec) DeepCopy() *SABnzbdCategorySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RTorrentConnectionsSpec)
+	out := new(SABnzbdCategorySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RTorrentDirectoriesSpec) DeepCopyInto(out *RTorrentDirectoriesSpec) {
+func (in *SABnzbdConnectionSpec) DeepCopyInto(out *SABnzbdConnectionSpec) {
 	*out = *in
+	out.APIKeySecretRef = in.APIKeySecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentDirectoriesSpec.
-func (in *RTorrentDirectoriesSpec) DeepCopy() *RTorrentDirectoriesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdConnectionSpec.
+func (in *SABnzbdConnectionSpec) DeepCopy() *SABnzbdConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RTorrentDirectoriesSpec)
+	out := new(SABnzbdConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RTorrentProtocolSpec) DeepCopyInto(out *RTorrentProtocolSpec) {
+func (in *SABnzbdDirectoriesSpec) DeepCopyInto(out *SABnzbdDirectoriesSpec) {
 	*out = *in
-	if in.DHT != nil {
-		in, out := &in.DHT, &out.DHT
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PEX != nil {
-		in, out := &in.PEX, &out.PEX
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentProtocolSpec.
-func (in *RTorrentProtocolSpec) DeepCopy() *RTorrentProtocolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdDirectoriesSpec.
+func (in *SABnzbdDirectoriesSpec) DeepCopy() *SABnzbdDirectoriesSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RTorrentProtocolSpec)
+	out := new(SABnzbdDirectoriesSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RTorrentSeedingSpec) DeepCopyInto(out *RTorrentSeedingSpec) {
+func (in *SABnzbdInstanceSpec) DeepCopyInto(out *SABnzbdInstanceSpec) {
 	*out = *in
+	in.SABnzbdSpec.DeepCopyInto(&out.SABnzbdSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentSeedingSpec.
-func (in *RTorrentSeedingSpec) DeepCopy() *RTorrentSeedingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdInstanceSpec.
+func (in *SABnzbdInstanceSpec) DeepCopy() *SABnzbdInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RTorrentSeedingSpec)
+	out := new(SABnzbdInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RTorrentSpec) DeepCopyInto(out *RTorrentSpec) {
+func (in *SABnzbdPostProcessingSpec) DeepCopyInto(out *SABnzbdPostProcessingSpec) {
 	*out = *in
-	in.Connection.DeepCopyInto(&out.Connection)
-	if in.Speed != nil {
-		in, out := &in.Speed, &out.Speed
-		*out = new(RTorrentSpeedSpec)
-		**out = **in
-	}
-	if in.Directories != nil {
-		in, out := &in.Directories, &out.Directories
-		*out = new(RTorrentDirectoriesSpec)
-		**out = **in
-	}
-	if in.Seeding != nil {
-		in, out := &in.Seeding, &out.Seeding
-		*out = new(RTorrentSeedingSpec)
+	if in.UnpackEnabled != nil {
+		in, out := &in.UnpackEnabled, &out.UnpackEnabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Connections != nil {
-		in, out := &in.Connections, &out.Connections
-		*out = new(RTorrentConnectionsSpec)
+	if in.CleanupEnabled != nil {
+		in, out := &in.CleanupEnabled, &out.CleanupEnabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(RTorrentProtocolSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentSpec.
-func (in *RTorrentSpec) DeepCopy() *RTorrentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdPostProcessingSpec.
+func (in *SABnzbdPostProcessingSpec) DeepCopy() *SABnzbdPostProcessingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RTorrentSpec)
+	out := new(SABnzbdPostProcessingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RTorrentSpeedSpec) DeepCopyInto(out *RTorrentSpeedSpec) {
+func (in *SABnzbdQueueSpec) DeepCopyInto(out *SABnzbdQueueSpec) {
 	*out = *in
+	if in.PreCheck != nil {
+		in, out := &in.PreCheck, &out.PreCheck
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RTorrentSpeedSpec.
-func (in *RTorrentSpeedSpec) DeepCopy() *RTorrentSpeedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdQueueSpec.
+func (in *SABnzbdQueueSpec) DeepCopy() *SABnzbdQueueSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RTorrentSpeedSpec)
+	out := new(SABnzbdQueueSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RadarrConfig) DeepCopyInto(out *RadarrConfig) {
+func (in *SABnzbdSpec) DeepCopyInto(out *SABnzbdSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Connection = in.Connection
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(SABnzbdSpeedSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Directories != nil {
+		in, out := &in.Directories, &out.Directories
+		*out = new(SABnzbdDirectoriesSpec)
+		**out = **in
+	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]SABnzbdCategorySpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(SABnzbdQueueSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostProcessing != nil {
+		in, out := &in.PostProcessing, &out.PostProcessing
+		*out = new(SABnzbdPostProcessingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NewsServers != nil {
+		in, out := &in.NewsServers, &out.NewsServers
+		*out = make([]NewsServerRef, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RadarrConfig.
-func (in *RadarrConfig) DeepCopy() *RadarrConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdSpec.
+func (in *SABnzbdSpec) DeepCopy() *SABnzbdSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RadarrConfig)
+	out := new(SABnzbdSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RadarrConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RadarrConfigList) DeepCopyInto(out *RadarrConfigList) {
+func (in *SABnzbdSpeedSpec) DeepCopyInto(out *SABnzbdSpeedSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]RadarrConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.PauseDownloads != nil {
+		in, out := &in.PauseDownloads, &out.PauseDownloads
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RadarrConfigList.
-func (in *RadarrConfigList) DeepCopy() *RadarrConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdSpeedSpec.
+func (in *SABnzbdSpeedSpec) DeepCopy() *SABnzbdSpeedSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RadarrConfigList)
+	out := new(SABnzbdSpeedSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RadarrConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RadarrConfigSpec) DeepCopyInto(out *RadarrConfigSpec) {
-	*out = *in
-	in.Connection.DeepCopyInto(&out.Connection)
-	if in.Quality != nil {
-		in, out := &in.Quality, &out.Quality
-		*out = new(VideoQualitySpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DownloadClients != nil {
-		in, out := &in.DownloadClients, &out.DownloadClients
-		*out = make([]DownloadClientSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.RemotePathMappings != nil {
-		in, out := &in.RemotePathMappings, &out.RemotePathMappings
-		*out = make([]RemotePathMappingSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.Indexers != nil {
-		in, out := &in.Indexers, &out.Indexers
-		*out = new(IndexersSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Naming != nil {
-		in, out := &in.Naming, &out.Naming
-		*out = new(NamingSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RootFolders != nil {
-		in, out := &in.RootFolders, &out.RootFolders
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ImportLists != nil {
-		in, out := &in.ImportLists, &out.ImportLists
-		*out = make([]ImportListSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.MediaManagement != nil {
-		in, out := &in.MediaManagement, &out.MediaManagement
-		*out = new(MediaManagementSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(AuthenticationSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Notifications != nil {
-		in, out := &in.Notifications, &out.Notifications
-		*out = make([]NotificationSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.CustomFormats != nil {
-		in, out := &in.CustomFormats, &out.CustomFormats
-		*out = make([]CustomFormatSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.DelayProfiles != nil {
-		in, out := &in.DelayProfiles, &out.DelayProfiles
-		*out = make([]DelayProfileSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Reconciliation != nil {
-		in, out := &in.Reconciliation, &out.Reconciliation
-		*out = new(ReconciliationSpec)
-		(*in).DeepCopyInto(*out)
-	}
+func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
+	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RadarrConfigSpec.
-func (in *RadarrConfigSpec) DeepCopy() *RadarrConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
+func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
 	if in == nil {
 		return nil
 	}
-	out := new(RadarrConfigSpec)
+	out := new(SecretKeySelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RadarrConfigStatus) DeepCopyInto(out *RadarrConfigStatus) {
+func (in *SeriesDefaultsSpec) DeepCopyInto(out *SeriesDefaultsSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.LastReconcile != nil {
-		in, out := &in.LastReconcile, &out.LastReconcile
-		*out = (*in).DeepCopy()
-	}
-	in.ManagedResources.DeepCopyInto(&out.ManagedResources)
-	if in.ProwlarrRegistration != nil {
-		in, out := &in.ProwlarrRegistration, &out.ProwlarrRegistration
-		*out = new(ProwlarrRegistration)
-		(*in).DeepCopyInto(*out)
+	if in.SeasonFolder != nil {
+		in, out := &in.SeasonFolder, &out.SeasonFolder
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Health != nil {
-		in, out := &in.Health, &out.Health
-		*out = new(HealthStatus)
-		(*in).DeepCopyInto(*out)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RadarrConfigStatus.
-func (in *RadarrConfigStatus) DeepCopy() *RadarrConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeriesDefaultsSpec.
+func (in *SeriesDefaultsSpec) DeepCopy() *SeriesDefaultsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RadarrConfigStatus)
+	out := new(SeriesDefaultsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReadarrConfig) DeepCopyInto(out *ReadarrConfig) {
+func (in *SonarrConfig) DeepCopyInto(out *SonarrConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2799,18 +6183,18 @@ func (in *ReadarrConfig) DeepCopyInto(out *ReadarrConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrConfig.
-func (in *ReadarrConfig) DeepCopy() *ReadarrConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrConfig.
+func (in *SonarrConfig) DeepCopy() *SonarrConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ReadarrConfig)
+	out := new(SonarrConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReadarrConfig) DeepCopyObject() runtime.Object {
+func (in *SonarrConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2818,31 +6202,31 @@ func (in *ReadarrConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReadarrConfigList) DeepCopyInto(out *ReadarrConfigList) {
+func (in *SonarrConfigList) DeepCopyInto(out *SonarrConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ReadarrConfig, len(*in))
+		*out = make([]SonarrConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrConfigList.
-func (in *ReadarrConfigList) DeepCopy() *ReadarrConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrConfigList.
+func (in *SonarrConfigList) DeepCopy() *SonarrConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(ReadarrConfigList)
+	out := new(SonarrConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReadarrConfigList) DeepCopyObject() runtime.Object {
+func (in *SonarrConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2850,17 +6234,24 @@ func (in *ReadarrConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReadarrConfigSpec) DeepCopyInto(out *ReadarrConfigSpec) {
+func (in *SonarrConfigSpec) DeepCopyInto(out *SonarrConfigSpec) {
 	*out = *in
 	in.Connection.DeepCopyInto(&out.Connection)
 	if in.Quality != nil {
 		in, out := &in.Quality, &out.Quality
-		*out = new(ReadarrQualitySpec)
+		*out = new(VideoQualitySpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.MetadataProfile != nil {
-		in, out := &in.MetadataProfile, &out.MetadataProfile
-		*out = new(ReadarrMetadataProfileSpec)
+	if in.QualityProfiles != nil {
+		in, out := &in.QualityProfiles, &out.QualityProfiles
+		*out = make([]NamedVideoQualitySpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Language != nil {
+		in, out := &in.Language, &out.Language
+		*out = new(LanguageSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.DownloadClients != nil {
@@ -2882,7 +6273,7 @@ func (in *ReadarrConfigSpec) DeepCopyInto(out *ReadarrConfigSpec) {
 	}
 	if in.Naming != nil {
 		in, out := &in.Naming, &out.Naming
-		*out = new(ReadarrNamingSpec)
+		*out = new(SonarrNamingSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.RootFolders != nil {
@@ -2897,11 +6288,26 @@ func (in *ReadarrConfigSpec) DeepCopyInto(out *ReadarrConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ImportListOptions != nil {
+		in, out := &in.ImportListOptions, &out.ImportListOptions
+		*out = new(ImportListOptionsSpec)
+		**out = **in
+	}
+	if in.SeriesDefaults != nil {
+		in, out := &in.SeriesDefaults, &out.SeriesDefaults
+		*out = new(SeriesDefaultsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MediaManagement != nil {
 		in, out := &in.MediaManagement, &out.MediaManagement
 		*out = new(MediaManagementSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UI != nil {
+		in, out := &in.UI, &out.UI
+		*out = new(UISpec)
+		**out = **in
+	}
 	if in.Authentication != nil {
 		in, out := &in.Authentication, &out.Authentication
 		*out = new(AuthenticationSpec)
@@ -2914,25 +6320,73 @@ func (in *ReadarrConfigSpec) DeepCopyInto(out *ReadarrConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MediaServerHooks != nil {
+		in, out := &in.MediaServerHooks, &out.MediaServerHooks
+		*out = new(MediaServerHooksSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomFormats != nil {
+		in, out := &in.CustomFormats, &out.CustomFormats
+		*out = make([]CustomFormatSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AutoTags != nil {
+		in, out := &in.AutoTags, &out.AutoTags
+		*out = make([]AutoTagSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QualityDefinitions != nil {
+		in, out := &in.QualityDefinitions, &out.QualityDefinitions
+		*out = make([]QualityDefinitionSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.DelayProfiles != nil {
+		in, out := &in.DelayProfiles, &out.DelayProfiles
+		*out = make([]DelayProfileSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReleaseProfiles != nil {
+		in, out := &in.ReleaseProfiles, &out.ReleaseProfiles
+		*out = make([]ReleaseProfileSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = make([]RawRequestSpec, len(*in))
+		copy(*out, *in)
+	}
 	if in.Reconciliation != nil {
 		in, out := &in.Reconciliation, &out.Reconciliation
 		*out = new(ReconciliationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrConfigSpec.
-func (in *ReadarrConfigSpec) DeepCopy() *ReadarrConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrConfigSpec.
+func (in *SonarrConfigSpec) DeepCopy() *SonarrConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReadarrConfigSpec)
+	out := new(SonarrConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReadarrConfigStatus) DeepCopyInto(out *ReadarrConfigStatus) {
+func (in *SonarrConfigStatus) DeepCopyInto(out *SonarrConfigStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2946,557 +6400,483 @@ func (in *ReadarrConfigStatus) DeepCopyInto(out *ReadarrConfigStatus) {
 		*out = (*in).DeepCopy()
 	}
 	in.ManagedResources.DeepCopyInto(&out.ManagedResources)
-	if in.ProwlarrRegistration != nil {
-		in, out := &in.ProwlarrRegistration, &out.ProwlarrRegistration
-		*out = new(ProwlarrRegistration)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Health != nil {
-		in, out := &in.Health, &out.Health
-		*out = new(HealthStatus)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrConfigStatus.
-func (in *ReadarrConfigStatus) DeepCopy() *ReadarrConfigStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(ReadarrConfigStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReadarrFormatSpec) DeepCopyInto(out *ReadarrFormatSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrFormatSpec.
-func (in *ReadarrFormatSpec) DeepCopy() *ReadarrFormatSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(ReadarrFormatSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReadarrMetadataProfileSpec) DeepCopyInto(out *ReadarrMetadataProfileSpec) {
-	*out = *in
-	if in.SkipMissingDate != nil {
-		in, out := &in.SkipMissingDate, &out.SkipMissingDate
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SkipMissingIsbn != nil {
-		in, out := &in.SkipMissingIsbn, &out.SkipMissingIsbn
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SkipPartsAndSets != nil {
-		in, out := &in.SkipPartsAndSets, &out.SkipPartsAndSets
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SkipSeriesSecondary != nil {
-		in, out := &in.SkipSeriesSecondary, &out.SkipSeriesSecondary
-		*out = new(bool)
-		**out = **in
-	}
-	if in.AllowedLanguages != nil {
-		in, out := &in.AllowedLanguages, &out.AllowedLanguages
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrMetadataProfileSpec.
-func (in *ReadarrMetadataProfileSpec) DeepCopy() *ReadarrMetadataProfileSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(ReadarrMetadataProfileSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReadarrNamingSpec) DeepCopyInto(out *ReadarrNamingSpec) {
-	*out = *in
-	in.NamingSpec.DeepCopyInto(&out.NamingSpec)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrNamingSpec.
-func (in *ReadarrNamingSpec) DeepCopy() *ReadarrNamingSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(ReadarrNamingSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReadarrQualitySpec) DeepCopyInto(out *ReadarrQualitySpec) {
-	*out = *in
-	if in.AllowedFormats != nil {
-		in, out := &in.AllowedFormats, &out.AllowedFormats
-		*out = make([]ReadarrFormatSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.UpgradeAllowed != nil {
-		in, out := &in.UpgradeAllowed, &out.UpgradeAllowed
-		*out = new(bool)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadarrQualitySpec.
-func (in *ReadarrQualitySpec) DeepCopy() *ReadarrQualitySpec {
-	if in == nil {
-		return nil
-	}
-	out := new(ReadarrQualitySpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReconciliationSpec) DeepCopyInto(out *ReconciliationSpec) {
-	*out = *in
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(v1.Duration)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconciliationSpec.
-func (in *ReconciliationSpec) DeepCopy() *ReconciliationSpec {
-	if in == nil {
-		return nil
+	if in.SecretHashes != nil {
+		in, out := &in.SecretHashes, &out.SecretHashes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	out := new(ReconciliationSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReleaseProfileSpec) DeepCopyInto(out *ReleaseProfileSpec) {
-	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.CompiledSummary != nil {
+		in, out := &in.CompiledSummary, &out.CompiledSummary
+		*out = new(CompiledSummary)
 		**out = **in
 	}
-	if in.Required != nil {
-		in, out := &in.Required, &out.Required
-		*out = make([]string, len(*in))
+	if in.InvalidFields != nil {
+		in, out := &in.InvalidFields, &out.InvalidFields
+		*out = make([]InvalidField, len(*in))
 		copy(*out, *in)
 	}
-	if in.Ignored != nil {
-		in, out := &in.Ignored, &out.Ignored
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Converged != nil {
+		in, out := &in.Converged, &out.Converged
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Preferred != nil {
-		in, out := &in.Preferred, &out.Preferred
-		*out = make([]PreferredTermSpec, len(*in))
+	if in.ResidualChanges != nil {
+		in, out := &in.ResidualChanges, &out.ResidualChanges
+		*out = make([]ResidualChange, len(*in))
 		copy(*out, *in)
 	}
-	if in.IncludePreferredWhenRenaming != nil {
-		in, out := &in.IncludePreferredWhenRenaming, &out.IncludePreferredWhenRenaming
-		*out = new(bool)
-		**out = **in
+	if in.UnrealizedFeatures != nil {
+		in, out := &in.UnrealizedFeatures, &out.UnrealizedFeatures
+		*out = make([]UnrealizedFeature, len(*in))
+		copy(*out, *in)
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
+	if in.PausedResources != nil {
+		in, out := &in.PausedResources, &out.PausedResources
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RawRequests != nil {
+		in, out := &in.RawRequests, &out.RawRequests
+		*out = make([]RawRequestStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProwlarrRegistration != nil {
+		in, out := &in.ProwlarrRegistration, &out.ProwlarrRegistration
+		*out = new(ProwlarrRegistration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(HealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MediaServers != nil {
+		in, out := &in.MediaServers, &out.MediaServers
+		*out = make([]MediaServerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Indexers != nil {
+		in, out := &in.Indexers, &out.Indexers
+		*out = make([]IndexerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseProfileSpec.
-func (in *ReleaseProfileSpec) DeepCopy() *ReleaseProfileSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrConfigStatus.
+func (in *SonarrConfigStatus) DeepCopy() *SonarrConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReleaseProfileSpec)
+	out := new(SonarrConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RemotePathMappingSpec) DeepCopyInto(out *RemotePathMappingSpec) {
+func (in *SonarrNamingSpec) DeepCopyInto(out *SonarrNamingSpec) {
 	*out = *in
+	in.NamingSpec.DeepCopyInto(&out.NamingSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemotePathMappingSpec.
-func (in *RemotePathMappingSpec) DeepCopy() *RemotePathMappingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrNamingSpec.
+func (in *SonarrNamingSpec) DeepCopy() *SonarrNamingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RemotePathMappingSpec)
+	out := new(SonarrNamingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SABnzbdCategorySpec) DeepCopyInto(out *SABnzbdCategorySpec) {
+func (in *TLSSecretRef) DeepCopyInto(out *TLSSecretRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdCategorySpec.
-func (in *SABnzbdCategorySpec) DeepCopy() *SABnzbdCategorySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSecretRef.
+func (in *TLSSecretRef) DeepCopy() *TLSSecretRef {
 	if in == nil {
 		return nil
 	}
-	out := new(SABnzbdCategorySpec)
+	out := new(TLSSecretRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SABnzbdConnectionSpec) DeepCopyInto(out *SABnzbdConnectionSpec) {
+func (in *TautulliConfig) DeepCopyInto(out *TautulliConfig) {
 	*out = *in
-	out.APIKeySecretRef = in.APIKeySecretRef
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdConnectionSpec.
-func (in *SABnzbdConnectionSpec) DeepCopy() *SABnzbdConnectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliConfig.
+func (in *TautulliConfig) DeepCopy() *TautulliConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(SABnzbdConnectionSpec)
+	out := new(TautulliConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TautulliConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SABnzbdDirectoriesSpec) DeepCopyInto(out *SABnzbdDirectoriesSpec) {
+func (in *TautulliConfigList) DeepCopyInto(out *TautulliConfigList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TautulliConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdDirectoriesSpec.
-func (in *SABnzbdDirectoriesSpec) DeepCopy() *SABnzbdDirectoriesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliConfigList.
+func (in *TautulliConfigList) DeepCopy() *TautulliConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(SABnzbdDirectoriesSpec)
+	out := new(TautulliConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TautulliConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SABnzbdPostProcessingSpec) DeepCopyInto(out *SABnzbdPostProcessingSpec) {
+func (in *TautulliConfigSpec) DeepCopyInto(out *TautulliConfigSpec) {
 	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Notifiers != nil {
+		in, out := &in.Notifiers, &out.Notifiers
+		*out = make([]TautulliNotifierSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Newsletters != nil {
+		in, out := &in.Newsletters, &out.Newsletters
+		*out = make([]TautulliNewsletterSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WatchStatistics != nil {
+		in, out := &in.WatchStatistics, &out.WatchStatistics
+		*out = new(TautulliWatchStatisticsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reconciliation != nil {
+		in, out := &in.Reconciliation, &out.Reconciliation
+		*out = new(ReconciliationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdPostProcessingSpec.
-func (in *SABnzbdPostProcessingSpec) DeepCopy() *SABnzbdPostProcessingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliConfigSpec.
+func (in *TautulliConfigSpec) DeepCopy() *TautulliConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SABnzbdPostProcessingSpec)
+	out := new(TautulliConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SABnzbdQueueSpec) DeepCopyInto(out *SABnzbdQueueSpec) {
+func (in *TautulliConfigStatus) DeepCopyInto(out *TautulliConfigStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Notifiers != nil {
+		in, out := &in.Notifiers, &out.Notifiers
+		*out = make([]TautulliManagedItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.Newsletters != nil {
+		in, out := &in.Newsletters, &out.Newsletters
+		*out = make([]TautulliManagedItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.WatchStatistics != nil {
+		in, out := &in.WatchStatistics, &out.WatchStatistics
+		*out = make([]TautulliLibraryStatistics, len(*in))
+		copy(*out, *in)
+	}
+	if in.WatchStatisticsTime != nil {
+		in, out := &in.WatchStatisticsTime, &out.WatchStatisticsTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastReconcile != nil {
+		in, out := &in.LastReconcile, &out.LastReconcile
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdQueueSpec.
-func (in *SABnzbdQueueSpec) DeepCopy() *SABnzbdQueueSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliConfigStatus.
+func (in *TautulliConfigStatus) DeepCopy() *TautulliConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SABnzbdQueueSpec)
+	out := new(TautulliConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SABnzbdSpec) DeepCopyInto(out *SABnzbdSpec) {
+func (in *TautulliConnectionSpec) DeepCopyInto(out *TautulliConnectionSpec) {
 	*out = *in
-	out.Connection = in.Connection
-	if in.Speed != nil {
-		in, out := &in.Speed, &out.Speed
-		*out = new(SABnzbdSpeedSpec)
-		**out = **in
-	}
-	if in.Directories != nil {
-		in, out := &in.Directories, &out.Directories
-		*out = new(SABnzbdDirectoriesSpec)
-		**out = **in
-	}
-	if in.Categories != nil {
-		in, out := &in.Categories, &out.Categories
-		*out = make([]SABnzbdCategorySpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.Queue != nil {
-		in, out := &in.Queue, &out.Queue
-		*out = new(SABnzbdQueueSpec)
-		**out = **in
-	}
-	if in.PostProcessing != nil {
-		in, out := &in.PostProcessing, &out.PostProcessing
-		*out = new(SABnzbdPostProcessingSpec)
+	out.APIKeySecretRef = in.APIKeySecretRef
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdSpec.
-func (in *SABnzbdSpec) DeepCopy() *SABnzbdSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliConnectionSpec.
+func (in *TautulliConnectionSpec) DeepCopy() *TautulliConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SABnzbdSpec)
+	out := new(TautulliConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SABnzbdSpeedSpec) DeepCopyInto(out *SABnzbdSpeedSpec) {
+func (in *TautulliLibraryStatistics) DeepCopyInto(out *TautulliLibraryStatistics) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SABnzbdSpeedSpec.
-func (in *SABnzbdSpeedSpec) DeepCopy() *SABnzbdSpeedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliLibraryStatistics.
+func (in *TautulliLibraryStatistics) DeepCopy() *TautulliLibraryStatistics {
 	if in == nil {
 		return nil
 	}
-	out := new(SABnzbdSpeedSpec)
+	out := new(TautulliLibraryStatistics)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
+func (in *TautulliManagedItem) DeepCopyInto(out *TautulliManagedItem) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
-func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliManagedItem.
+func (in *TautulliManagedItem) DeepCopy() *TautulliManagedItem {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretKeySelector)
+	out := new(TautulliManagedItem)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SonarrConfig) DeepCopyInto(out *SonarrConfig) {
+func (in *TautulliNewsletterSpec) DeepCopyInto(out *TautulliNewsletterSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Libraries != nil {
+		in, out := &in.Libraries, &out.Libraries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrConfig.
-func (in *SonarrConfig) DeepCopy() *SonarrConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliNewsletterSpec.
+func (in *TautulliNewsletterSpec) DeepCopy() *TautulliNewsletterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SonarrConfig)
+	out := new(TautulliNewsletterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SonarrConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TautulliNotifierSpec) DeepCopyInto(out *TautulliNotifierSpec) {
+	*out = *in
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]TautulliTrigger, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SettingsSecretRef != nil {
+		in, out := &in.SettingsSecretRef, &out.SettingsSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliNotifierSpec.
+func (in *TautulliNotifierSpec) DeepCopy() *TautulliNotifierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TautulliNotifierSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SonarrConfigList) DeepCopyInto(out *SonarrConfigList) {
+func (in *TautulliWatchStatisticsSpec) DeepCopyInto(out *TautulliWatchStatisticsSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]SonarrConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Libraries != nil {
+		in, out := &in.Libraries, &out.Libraries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrConfigList.
-func (in *SonarrConfigList) DeepCopy() *SonarrConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TautulliWatchStatisticsSpec.
+func (in *TautulliWatchStatisticsSpec) DeepCopy() *TautulliWatchStatisticsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SonarrConfigList)
+	out := new(TautulliWatchStatisticsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SonarrConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TorrentPolicyStatus) DeepCopyInto(out *TorrentPolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TorrentPolicyStatus.
+func (in *TorrentPolicyStatus) DeepCopy() *TorrentPolicyStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(TorrentPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SonarrConfigSpec) DeepCopyInto(out *SonarrConfigSpec) {
+func (in *TrackerListSpec) DeepCopyInto(out *TrackerListSpec) {
 	*out = *in
-	in.Connection.DeepCopyInto(&out.Connection)
-	if in.Quality != nil {
-		in, out := &in.Quality, &out.Quality
-		*out = new(VideoQualitySpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DownloadClients != nil {
-		in, out := &in.DownloadClients, &out.DownloadClients
-		*out = make([]DownloadClientSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.RemotePathMappings != nil {
-		in, out := &in.RemotePathMappings, &out.RemotePathMappings
-		*out = make([]RemotePathMappingSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.Indexers != nil {
-		in, out := &in.Indexers, &out.Indexers
-		*out = new(IndexersSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Naming != nil {
-		in, out := &in.Naming, &out.Naming
-		*out = new(SonarrNamingSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RootFolders != nil {
-		in, out := &in.RootFolders, &out.RootFolders
+	if in.Trackers != nil {
+		in, out := &in.Trackers, &out.Trackers
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ImportLists != nil {
-		in, out := &in.ImportLists, &out.ImportLists
-		*out = make([]ImportListSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.MediaManagement != nil {
-		in, out := &in.MediaManagement, &out.MediaManagement
-		*out = new(MediaManagementSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(AuthenticationSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Notifications != nil {
-		in, out := &in.Notifications, &out.Notifications
-		*out = make([]NotificationSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.CustomFormats != nil {
-		in, out := &in.CustomFormats, &out.CustomFormats
-		*out = make([]CustomFormatSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.DelayProfiles != nil {
-		in, out := &in.DelayProfiles, &out.DelayProfiles
-		*out = make([]DelayProfileSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ReleaseProfiles != nil {
-		in, out := &in.ReleaseProfiles, &out.ReleaseProfiles
-		*out = make([]ReleaseProfileSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Reconciliation != nil {
-		in, out := &in.Reconciliation, &out.Reconciliation
-		*out = new(ReconciliationSpec)
-		(*in).DeepCopyInto(*out)
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrConfigSpec.
-func (in *SonarrConfigSpec) DeepCopy() *SonarrConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrackerListSpec.
+func (in *TrackerListSpec) DeepCopy() *TrackerListSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SonarrConfigSpec)
+	out := new(TrackerListSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SonarrConfigStatus) DeepCopyInto(out *SonarrConfigStatus) {
+func (in *TrackerRuleStatus) DeepCopyInto(out *TrackerRuleStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.LastReconcile != nil {
-		in, out := &in.LastReconcile, &out.LastReconcile
-		*out = (*in).DeepCopy()
-	}
-	in.ManagedResources.DeepCopyInto(&out.ManagedResources)
-	if in.ProwlarrRegistration != nil {
-		in, out := &in.ProwlarrRegistration, &out.ProwlarrRegistration
-		*out = new(ProwlarrRegistration)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Health != nil {
-		in, out := &in.Health, &out.Health
-		*out = new(HealthStatus)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrConfigStatus.
-func (in *SonarrConfigStatus) DeepCopy() *SonarrConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrackerRuleStatus.
+func (in *TrackerRuleStatus) DeepCopy() *TrackerRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SonarrConfigStatus)
+	out := new(TrackerRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SonarrNamingSpec) DeepCopyInto(out *SonarrNamingSpec) {
+func (in *TraktListImportSpec) DeepCopyInto(out *TraktListImportSpec) {
 	*out = *in
-	in.NamingSpec.DeepCopyInto(&out.NamingSpec)
+	out.AccessTokenSecretRef = in.AccessTokenSecretRef
+	if in.RefreshTokenSecretRef != nil {
+		in, out := &in.RefreshTokenSecretRef, &out.RefreshTokenSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarrNamingSpec.
-func (in *SonarrNamingSpec) DeepCopy() *SonarrNamingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraktListImportSpec.
+func (in *TraktListImportSpec) DeepCopy() *TraktListImportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SonarrNamingSpec)
+	out := new(TraktListImportSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3571,6 +6951,22 @@ func (in *TransmissionDirectoriesSpec) DeepCopy() *TransmissionDirectoriesSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransmissionInstanceSpec) DeepCopyInto(out *TransmissionInstanceSpec) {
+	*out = *in
+	in.TransmissionSpec.DeepCopyInto(&out.TransmissionSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransmissionInstanceSpec.
+func (in *TransmissionInstanceSpec) DeepCopy() *TransmissionInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransmissionInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransmissionPeersSpec) DeepCopyInto(out *TransmissionPeersSpec) {
 	*out = *in
@@ -3601,6 +6997,26 @@ func (in *TransmissionQueueSpec) DeepCopy() *TransmissionQueueSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransmissionRemovalRule) DeepCopyInto(out *TransmissionRemovalRule) {
+	*out = *in
+	if in.SeedingTimeLimit != nil {
+		in, out := &in.SeedingTimeLimit, &out.SeedingTimeLimit
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransmissionRemovalRule.
+func (in *TransmissionRemovalRule) DeepCopy() *TransmissionRemovalRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TransmissionRemovalRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransmissionSecuritySpec) DeepCopyInto(out *TransmissionSecuritySpec) {
 	*out = *in
@@ -3651,6 +7067,36 @@ func (in *TransmissionSeedingSpec) DeepCopy() *TransmissionSeedingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransmissionSettingsFileSpec) DeepCopyInto(out *TransmissionSettingsFileSpec) {
+	*out = *in
+	if in.RPCWhitelist != nil {
+		in, out := &in.RPCWhitelist, &out.RPCWhitelist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RPCHostWhitelist != nil {
+		in, out := &in.RPCHostWhitelist, &out.RPCHostWhitelist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RPCAuthenticationRequired != nil {
+		in, out := &in.RPCAuthenticationRequired, &out.RPCAuthenticationRequired
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransmissionSettingsFileSpec.
+func (in *TransmissionSettingsFileSpec) DeepCopy() *TransmissionSettingsFileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransmissionSettingsFileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransmissionSpec) DeepCopyInto(out *TransmissionSpec) {
 	*out = *in
@@ -3695,6 +7141,21 @@ func (in *TransmissionSpec) DeepCopyInto(out *TransmissionSpec) {
 		*out = new(TransmissionBlocklistSpec)
 		**out = **in
 	}
+	if in.SettingsFile != nil {
+		in, out := &in.SettingsFile, &out.SettingsFile
+		*out = new(TransmissionSettingsFileSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TorrentPolicy != nil {
+		in, out := &in.TorrentPolicy, &out.TorrentPolicy
+		*out = new(TransmissionTorrentPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Trackers != nil {
+		in, out := &in.Trackers, &out.Trackers
+		*out = new(TransmissionTrackersSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransmissionSpec.
@@ -3722,6 +7183,98 @@ func (in *TransmissionSpeedSpec) DeepCopy() *TransmissionSpeedSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransmissionTorrentCategorySpec) DeepCopyInto(out *TransmissionTorrentCategorySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransmissionTorrentCategorySpec.
+func (in *TransmissionTorrentCategorySpec) DeepCopy() *TransmissionTorrentCategorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransmissionTorrentCategorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransmissionTorrentPolicySpec) DeepCopyInto(out *TransmissionTorrentPolicySpec) {
+	*out = *in
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]TransmissionTorrentCategorySpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovalRules != nil {
+		in, out := &in.RemovalRules, &out.RemovalRules
+		*out = make([]TransmissionRemovalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransmissionTorrentPolicySpec.
+func (in *TransmissionTorrentPolicySpec) DeepCopy() *TransmissionTorrentPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransmissionTorrentPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransmissionTrackersSpec) DeepCopyInto(out *TransmissionTrackersSpec) {
+	*out = *in
+	if in.DefaultTrackerList != nil {
+		in, out := &in.DefaultTrackerList, &out.DefaultTrackerList
+		*out = new(TrackerListSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransmissionTrackersSpec.
+func (in *TransmissionTrackersSpec) DeepCopy() *TransmissionTrackersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransmissionTrackersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UISpec) DeepCopyInto(out *UISpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UISpec.
+func (in *UISpec) DeepCopy() *UISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnrealizedFeature) DeepCopyInto(out *UnrealizedFeature) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnrealizedFeature.
+func (in *UnrealizedFeature) DeepCopy() *UnrealizedFeature {
+	if in == nil {
+		return nil
+	}
+	out := new(UnrealizedFeature)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VideoQualitySpec) DeepCopyInto(out *VideoQualitySpec) {
 	*out = *in
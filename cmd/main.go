@@ -17,26 +17,38 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters/downloadstack"
 	"github.com/poiley/nebularr-operator/internal/controller"
+	"github.com/poiley/nebularr-operator/internal/metrics"
+	"github.com/poiley/nebularr-operator/internal/notify"
+	"github.com/poiley/nebularr-operator/internal/version"
+	webhookv1alpha1 "github.com/poiley/nebularr-operator/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -62,6 +74,22 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var tlsOpts []func(*tls.Config)
+	var maxConcurrentReconciles int
+	var controllerConcurrency string
+	var globalMaxConcurrentReconciles int
+	var shardNamespaceSelector string
+	var requeueJitter float64
+	var downloadStackInterval time.Duration
+	var irSnapshots bool
+	var logDiffs bool
+	var gluetunServersURL string
+	var grafanaDashboardNamespace string
+	var notificationSecret string
+	var secretKeySecret string
+	var notificationDriftThreshold, notificationFailureThreshold int
+	var controllerGroups string
+	var enableWebhooks bool
+	var webhookPort int
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -79,6 +107,48 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 1,
+		"Number of concurrent reconciles per controller.")
+	flag.StringVar(&controllerConcurrency, "controller-concurrency", "",
+		"Per-controller overrides of --max-concurrent-reconciles, e.g. \"radarrconfig=8,sonarrconfig=8\".")
+	flag.IntVar(&globalMaxConcurrentReconciles, "global-max-concurrent-reconciles", 0,
+		"Upper bound on concurrent reconciles across all controllers. 0 means no global limit.")
+	flag.StringVar(&shardNamespaceSelector, "shard-namespace-selector", "",
+		"Only reconcile resources in namespaces matching this label selector (e.g. \"nebularr.io/shard=a\"). "+
+			"Each shard elects its own leader, so run one Deployment per shard.")
+	flag.Float64Var(&requeueJitter, "requeue-jitter", 0.1,
+		"Stretch periodic requeues by a random fraction of their interval, up to this factor. 0 disables jitter.")
+	flag.DurationVar(&downloadStackInterval, "download-stack-requeue-interval", controller.DefaultDownloadStackRequeueInterval,
+		"Requeue interval for DownloadStackConfigs that do not set spec.reconciliation.interval.")
+	flag.StringVar(&gluetunServersURL, "gluetun-servers-url", "",
+		"Gluetun server list used to validate DownloadStackConfig server selections, e.g. "+
+			downloadstack.DefaultGluetunServersURL+". Empty disables the check.")
+	flag.BoolVar(&irSnapshots, "ir-snapshots", false,
+		"Snapshot the compiled IR of every *arr config and flag IR changes across operator upgrades. "+
+			"Without it, only configs annotated arr.rinzler.cloud/ir-snapshot=true are snapshotted.")
+	flag.BoolVar(&logDiffs, "log-diffs", false,
+		"Log the per-field diff (current vs desired) of every update, with API keys, passwords and tokens redacted.")
+	flag.StringVar(&grafanaDashboardNamespace, "grafana-dashboard-namespace", "",
+		"Create the packaged Grafana dashboard as a ConfigMap labeled grafana_dashboard=1 in this namespace. "+
+			"Empty disables it.")
+	flag.StringVar(&notificationSecret, "notification-secret", "",
+		"Send operator notifications to the Slack, Discord or webhook URLs in this Secret (namespace/name). "+
+			"Empty disables them.")
+	flag.StringVar(&secretKeySecret, "secret-key-secret", "",
+		"Key the credential hashes recorded in status with the key in this Secret (namespace/name), "+
+			"created with a random key if it doesn't exist. Empty uses a key that changes on every restart.")
+	flag.IntVar(&notificationDriftThreshold, "notification-drift-threshold", notify.DefaultDriftThreshold,
+		"Notify once drift has been corrected on this many reconciles in a row.")
+	flag.IntVar(&notificationFailureThreshold, "notification-failure-threshold", notify.DefaultFailureThreshold,
+		"Notify once applying changes has failed on this many reconciles in a row.")
+	flag.StringVar(&controllerGroups, "controllers", "all",
+		"Comma-separated controller groups to run: arr-configs, download-stack, policies, or all. "+
+			"Disabled groups need neither their CRDs nor their RBAC; policies requires arr-configs.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Serve the defaulting webhook that stores preset and client type defaults in *arr config specs, "+
+			"and the validating webhook that warns when a protected config is deleted. "+
+			"Needs a serving certificate (--webhook-cert-path) and the webhook configurations.")
+	flag.IntVar(&webhookPort, "webhook-port", 9443, "The port the webhook server listens on.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -87,6 +157,47 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if requeueJitter < 0 {
+		setupLog.Error(fmt.Errorf("must not be negative"), "invalid --requeue-jitter")
+		os.Exit(1)
+	}
+	controllerOpts := controller.ControllerOptions{
+		MaxConcurrentReconciles: maxConcurrentReconciles,
+		RequeueJitter:           requeueJitter,
+		DownloadStackInterval:   downloadStackInterval,
+		IRSnapshots:             irSnapshots,
+		LogDiffs:                logDiffs,
+	}
+	perController, err := controller.ParseControllerConcurrency(controllerConcurrency)
+	if err != nil {
+		setupLog.Error(err, "invalid --controller-concurrency")
+		os.Exit(1)
+	}
+	controllerOpts.PerController = perController
+	controllerOpts.Groups, err = controller.ParseControllerGroups(controllerGroups)
+	if err != nil {
+		setupLog.Error(err, "invalid --controllers")
+		os.Exit(1)
+	}
+	setupLog.Info("controller groups enabled", "groups", controllerOpts.Groups.String())
+	if globalMaxConcurrentReconciles > 0 {
+		controllerOpts.WorkerPool = controller.NewWorkerPool(globalMaxConcurrentReconciles)
+	}
+
+	leaderElectionID := "5c3de04d.rinzler.cloud"
+	if shardNamespaceSelector != "" {
+		selector, err := labels.Parse(shardNamespaceSelector)
+		if err != nil {
+			setupLog.Error(err, "invalid --shard-namespace-selector")
+			os.Exit(1)
+		}
+		controllerOpts.ShardSelector = selector
+		// Each shard elects its own leader so shards can run side by side
+		sum := sha256.Sum256([]byte(selector.String()))
+		leaderElectionID = fmt.Sprintf("%x.%s", sum[:4], leaderElectionID)
+		setupLog.Info("namespace sharding enabled", "selector", selector.String(), "leaderElectionID", leaderElectionID)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -105,6 +216,7 @@ func main() {
 	// Initial webhook TLS options
 	webhookTLSOpts := tlsOpts
 	webhookServerOptions := webhook.Options{
+		Port:    webhookPort,
 		TLSOpts: webhookTLSOpts,
 	}
 
@@ -160,7 +272,7 @@ func main() {
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "5c3de04d.rinzler.cloud",
+		LeaderElectionID:       leaderElectionID,
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -178,69 +290,205 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := (&controller.RadarrConfigReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("radarrconfig-controller"),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "RadarrConfig")
-		os.Exit(1)
+	if notificationSecret != "" {
+		secretRef, err := notify.ParseSecretRef(notificationSecret)
+		if err != nil {
+			setupLog.Error(err, "invalid --notification-secret")
+			os.Exit(1)
+		}
+		notifier := notify.NewDispatcher(mgr.GetClient(), secretRef)
+		notifier.DriftThreshold = notificationDriftThreshold
+		notifier.FailureThreshold = notificationFailureThreshold
+		if err := mgr.Add(notifier); err != nil {
+			setupLog.Error(err, "unable to set up operator notifications")
+			os.Exit(1)
+		}
+		controllerOpts.Notifier = notifier
+		setupLog.Info("operator notifications enabled", "secret", secretRef.String())
 	}
-	if err := (&controller.SonarrConfigReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("sonarrconfig-controller"),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "SonarrConfig")
-		os.Exit(1)
-	}
-	if err := (&controller.LidarrConfigReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("lidarrconfig-controller"),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "LidarrConfig")
-		os.Exit(1)
+
+	if secretKeySecret != "" {
+		secretRef, err := notify.ParseSecretRef(secretKeySecret)
+		if err != nil {
+			setupLog.Error(err, "invalid --secret-key-secret")
+			os.Exit(1)
+		}
+		// The manager's cache isn't running yet, so read and create the Secret directly
+		directClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+		if err != nil {
+			setupLog.Error(err, "unable to create client")
+			os.Exit(1)
+		}
+		if controllerOpts.SecretKey, err = controller.LoadSecretKey(context.Background(), directClient, secretRef); err != nil {
+			setupLog.Error(err, "unable to load secret key")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("no --secret-key-secret set; credentials are rewritten once after every restart")
+		if controllerOpts.SecretKey, err = controller.NewSecretKey(); err != nil {
+			setupLog.Error(err, "unable to generate secret key")
+			os.Exit(1)
+		}
 	}
-	if err := (&controller.ReadarrConfigReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("readarrconfig-controller"),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ReadarrConfig")
-		os.Exit(1)
+
+	// Serve a JSON apply summary for dashboards next to /metrics, behind the same authn/authz.
+	// It lists the *arr config kinds, which are only readable with the arr-configs group.
+	if metricsAddr != "0" && controllerOpts.Groups.Enabled(controller.ControllerGroupArrConfigs) {
+		controllerOpts.Summaries = controller.NewApplySummaries()
+		if err := mgr.AddMetricsServerExtraHandler(controller.ApplySummaryPath, &controller.ApplySummaryHandler{
+			Reader:    mgr.GetClient(),
+			Summaries: controllerOpts.Summaries,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up apply summary endpoint")
+			os.Exit(1)
+		}
 	}
-	if err := (&controller.ProwlarrConfigReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("prowlarrconfig-controller"),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ProwlarrConfig")
-		os.Exit(1)
+
+	var gluetunServers *downloadstack.GluetunServerCache
+	if gluetunServersURL != "" {
+		gluetunServers = downloadstack.NewGluetunServerCache(gluetunServersURL)
 	}
-	if err := (&controller.BazarrConfigReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "BazarrConfig")
-		os.Exit(1)
+
+	// Controllers are only set up when all their groups are enabled, so the
+	// operator needs neither the CRDs nor the RBAC of disabled groups
+	const (
+		arrConfigs    = controller.ControllerGroupArrConfigs
+		downloadStack = controller.ControllerGroupDownloadStack
+		policies      = controller.ControllerGroupPolicies
+	)
+	reconcilers := []struct {
+		name       string
+		groups     []string
+		reconciler interface{ SetupWithManager(ctrl.Manager) error }
+	}{
+		{"RadarrConfig", []string{arrConfigs}, &controller.RadarrConfigReconciler{
+			Client:   mgr.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("radarrconfig-controller"),
+			Options:  controllerOpts,
+		}},
+		{"SonarrConfig", []string{arrConfigs}, &controller.SonarrConfigReconciler{
+			Client:   mgr.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("sonarrconfig-controller"),
+			Options:  controllerOpts,
+		}},
+		{"LidarrConfig", []string{arrConfigs}, &controller.LidarrConfigReconciler{
+			Client:   mgr.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("lidarrconfig-controller"),
+			Options:  controllerOpts,
+		}},
+		{"ReadarrConfig", []string{arrConfigs}, &controller.ReadarrConfigReconciler{
+			Client:   mgr.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("readarrconfig-controller"),
+			Options:  controllerOpts,
+		}},
+		{"ProwlarrConfig", []string{arrConfigs}, &controller.ProwlarrConfigReconciler{
+			Client:   mgr.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("prowlarrconfig-controller"),
+			Options:  controllerOpts,
+		}},
+		{"BazarrConfig", []string{arrConfigs}, &controller.BazarrConfigReconciler{
+			Client:  mgr.GetClient(),
+			Scheme:  mgr.GetScheme(),
+			Options: controllerOpts,
+		}},
+		{"TautulliConfig", []string{arrConfigs}, &controller.TautulliConfigReconciler{
+			Client:  mgr.GetClient(),
+			Scheme:  mgr.GetScheme(),
+			Options: controllerOpts,
+		}},
+		{"CleanupPolicy", []string{policies}, &controller.CleanupPolicyReconciler{
+			Client:   mgr.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("cleanuppolicy-controller"),
+			Options:  controllerOpts,
+		}},
+		{"DownloadStackConfig", []string{downloadStack}, &controller.DownloadStackConfigReconciler{
+			Client:         mgr.GetClient(),
+			Scheme:         mgr.GetScheme(),
+			GluetunServers: gluetunServers,
+			Options:        controllerOpts,
+		}},
+		{"ProwlarrCoordinator", []string{arrConfigs}, &controller.ProwlarrCoordinatorReconciler{
+			Client:  mgr.GetClient(),
+			Scheme:  mgr.GetScheme(),
+			Options: controllerOpts,
+		}},
+		{"CategoryContract", []string{arrConfigs, downloadStack}, &controller.CategoryContractReconciler{
+			Client:  mgr.GetClient(),
+			Scheme:  mgr.GetScheme(),
+			Options: controllerOpts,
+		}},
+		{"ArrStackHealth", []string{arrConfigs}, &controller.ArrStackHealthReconciler{
+			Client:  mgr.GetClient(),
+			Scheme:  mgr.GetScheme(),
+			Options: controllerOpts,
+		}},
+		{"RolloutPolicy", []string{policies}, &controller.RolloutPolicyReconciler{
+			Client:   mgr.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("rolloutpolicy-controller"),
+			Options:  controllerOpts,
+		}},
+		{"ArrStack", []string{arrConfigs, downloadStack}, &controller.ArrStackReconciler{
+			Client:  mgr.GetClient(),
+			Scheme:  mgr.GetScheme(),
+			Options: controllerOpts,
+		}},
+		{"NebularrStatus", nil, &controller.NebularrStatusReconciler{
+			Client:  mgr.GetClient(),
+			Scheme:  mgr.GetScheme(),
+			Version: version.Get(),
+			Options: controllerOpts,
+		}},
 	}
-	if err := (&controller.DownloadStackConfigReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DownloadStackConfig")
-		os.Exit(1)
+	for _, r := range reconcilers {
+		if !controllerOpts.Groups.Enabled(r.groups...) {
+			setupLog.Info("controller disabled", "controller", r.name, "groups", r.groups)
+			continue
+		}
+		if err := r.reconciler.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", r.name)
+			os.Exit(1)
+		}
 	}
-	if err := (&controller.ProwlarrCoordinatorReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ProwlarrCoordinator")
-		os.Exit(1)
+	if enableWebhooks && controllerOpts.Groups.Enabled(controller.ControllerGroupArrConfigs) {
+		webhooks := []struct {
+			kind  string
+			setup func(ctrl.Manager) error
+		}{
+			{"RadarrConfig", webhookv1alpha1.SetupRadarrConfigWebhookWithManager},
+			{"SonarrConfig", webhookv1alpha1.SetupSonarrConfigWebhookWithManager},
+			{"LidarrConfig", webhookv1alpha1.SetupLidarrConfigWebhookWithManager},
+			{"ReadarrConfig", webhookv1alpha1.SetupReadarrConfigWebhookWithManager},
+			{"ProwlarrConfig", webhookv1alpha1.SetupProwlarrConfigWebhookWithManager},
+		}
+		for _, w := range webhooks {
+			if err := w.setup(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", w.kind)
+				os.Exit(1)
+			}
+		}
 	}
 	// +kubebuilder:scaffold:builder
 
+	if grafanaDashboardNamespace != "" {
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			if err := metrics.EnsureDashboardConfigMap(ctx, mgr.GetClient(), grafanaDashboardNamespace); err != nil {
+				// The dashboard is a convenience; don't take the operator down over it
+				setupLog.Error(err, "unable to create Grafana dashboard", "namespace", grafanaDashboardNamespace)
+			}
+			return nil
+		})); err != nil {
+			setupLog.Error(err, "unable to set up Grafana dashboard")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -250,7 +498,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	setupLog.Info("starting manager")
+	setupLog.Info("starting manager", "version", version.Get())
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
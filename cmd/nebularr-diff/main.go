@@ -0,0 +1,157 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	_ "github.com/poiley/nebularr-operator/internal/adapters/lidarr"
+	_ "github.com/poiley/nebularr-operator/internal/adapters/prowlarr"
+	_ "github.com/poiley/nebularr-operator/internal/adapters/radarr"
+	_ "github.com/poiley/nebularr-operator/internal/adapters/readarr"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	_ "github.com/poiley/nebularr-operator/internal/adapters/sonarr"
+	"github.com/poiley/nebularr-operator/internal/instancediff"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// Exit codes follow terraform's -detailed-exitcode convention, like nebularr-plan
+const (
+	exitOK      = 0
+	exitError   = 1
+	exitChanges = 2
+)
+
+// instance holds the flags describing one side of the comparison
+type instance struct {
+	url      string
+	apiKey   string
+	owner    string
+	label    string
+	insecure bool
+}
+
+func (i *instance) register(side string) {
+	flag.StringVar(&i.url, side+"-url", "", fmt.Sprintf("URL of instance %s, e.g. http://localhost:7878.", side))
+	flag.StringVar(&i.apiKey, side+"-api-key", "",
+		fmt.Sprintf("API key of instance %s (defaults to $NEBULARR_DIFF_%s_API_KEY).", side, strings.ToUpper(side)))
+	flag.StringVar(&i.owner, side+"-config", "",
+		fmt.Sprintf("namespace/name of the config managing instance %s; empty uses the shared nebularr-managed tag.", side))
+	flag.StringVar(&i.label, side+"-label", side, fmt.Sprintf("Name of instance %s in the output.", side))
+	flag.BoolVar(&i.insecure, side+"-insecure-skip-verify", false,
+		fmt.Sprintf("Skip TLS certificate verification for instance %s.", side))
+}
+
+// connection builds the ConnectionIR of an instance
+func (i *instance) connection(side string) (*irv1.ConnectionIR, error) {
+	if i.url == "" {
+		return nil, fmt.Errorf("-%s-url is required", side)
+	}
+	apiKey := i.apiKey
+	if apiKey == "" {
+		apiKey = os.Getenv("NEBULARR_DIFF_" + strings.ToUpper(side) + "_API_KEY")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("-%s-api-key or $NEBULARR_DIFF_%s_API_KEY is required", side, strings.ToUpper(side))
+	}
+
+	conn := &irv1.ConnectionIR{URL: i.url, APIKey: apiKey, InsecureSkipVerify: i.insecure}
+	if i.owner != "" {
+		namespace, name, ok := strings.Cut(i.owner, "/")
+		if !ok || namespace == "" || name == "" {
+			return nil, fmt.Errorf("-%s-config must be namespace/name, got %q", side, i.owner)
+		}
+		conn.OwnerTag = shared.ConfigOwnershipTag(namespace, name)
+	}
+	return conn, nil
+}
+
+func main() {
+	var app string
+	var from, to instance
+	var detailedExitCode bool
+	flag.StringVar(&app, "app", "", "App of both instances: radarr, sonarr, lidarr, readarr or prowlarr.")
+	from.register("a")
+	to.register("b")
+	flag.BoolVar(&detailedExitCode, "detailed-exitcode", false,
+		"Exit with 2 instead of 0 when the instances differ.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: nebularr-diff -app radarr -a-url URL -b-url URL [flags]\n\n"+
+			"Compares the configuration of two live instances of the same app, e.g. staging\n"+
+			"and production. Managed resources are shown as the changes promoting a to b\n"+
+			"would make; unmanaged resources are listed when missing or set differently.\n"+
+			"Nothing is applied.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if app == "" {
+		flag.Usage()
+		os.Exit(exitError)
+	}
+
+	differ, err := run(context.Background(), app, &from, &to, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitError)
+	}
+	if differ && detailedExitCode {
+		os.Exit(exitChanges)
+	}
+	os.Exit(exitOK)
+}
+
+// run snapshots both instances and reports whether they differ
+func run(ctx context.Context, app string, from, to *instance, out io.Writer) (bool, error) {
+	adapter, ok := adapters.Get(app)
+	if !ok {
+		return false, fmt.Errorf("unknown app %q", app)
+	}
+	fromConn, err := from.connection("a")
+	if err != nil {
+		return false, err
+	}
+	toConn, err := to.connection("b")
+	if err != nil {
+		return false, err
+	}
+
+	fromSnapshot, err := instancediff.Take(ctx, adapter, fromConn)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", from.label, err)
+	}
+	toSnapshot, err := instancediff.Take(ctx, adapter, toConn)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", to.label, err)
+	}
+
+	report, err := instancediff.Compare(adapter, fromSnapshot, toSnapshot)
+	if err != nil {
+		return false, err
+	}
+	title := fmt.Sprintf("%s: %s (%s) → %s (%s)", app, from.label, from.url, to.label, to.url)
+	if err := instancediff.Render(out, title, from.label, to.label, report); err != nil {
+		return false, err
+	}
+	return !report.IsEmpty(), nil
+}
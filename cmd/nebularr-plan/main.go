@@ -0,0 +1,334 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+	"github.com/poiley/nebularr-operator/internal/controller"
+	"github.com/poiley/nebularr-operator/internal/ir/conversion"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+	"github.com/poiley/nebularr-operator/internal/notify"
+	"github.com/poiley/nebularr-operator/internal/plan"
+)
+
+// Exit codes follow terraform's -detailed-exitcode convention
+const (
+	exitOK      = 0
+	exitError   = 1
+	exitChanges = 2
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(arrv1alpha1.AddToScheme(scheme))
+}
+
+func main() {
+	var filename string
+	var namespace string
+	var urlOverride string
+	var detailedExitCode bool
+	var secretKeySecret string
+	flag.StringVar(&filename, "f", "", "Path to a RadarrConfig/SonarrConfig YAML file (\"-\" reads stdin).")
+	flag.StringVar(&namespace, "n", "default", "Namespace used to resolve secrets when the manifest does not set one.")
+	flag.StringVar(&urlOverride, "url", "",
+		"Override spec.connection.url, e.g. http://localhost:7878 when using kubectl port-forward.")
+	flag.StringVar(&secretKeySecret, "secret-key-secret", "nebularr-system/nebularr-secret-key",
+		"The operator's --secret-key-secret (namespace/name). Without access to it, credential rotations are not planned.")
+	flag.BoolVar(&detailedExitCode, "detailed-exitcode", false,
+		"Exit with 2 instead of 0 when the plan contains changes.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: nebularr-plan -f config.yaml [flags]\n\n"+
+			"Shows the changes Nebularr would make to a live Radarr/Sonarr instance.\n"+
+			"Secrets are read through the current kubeconfig; nothing is applied.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if filename == "" {
+		flag.Usage()
+		os.Exit(exitError)
+	}
+
+	hasChanges, err := run(context.Background(), filename, namespace, urlOverride, secretKeySecret, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitError)
+	}
+	if hasChanges && detailedExitCode {
+		os.Exit(exitChanges)
+	}
+	os.Exit(exitOK)
+}
+
+// run plans every config in the file and reports whether any has changes
+func run(ctx context.Context, filename, namespace, urlOverride, secretKeySecret string, out io.Writer) (bool, error) {
+	objs, err := readConfigs(filename)
+	if err != nil {
+		return false, err
+	}
+	if len(objs) == 0 {
+		return false, fmt.Errorf("no RadarrConfig or SonarrConfig found in %s", filename)
+	}
+
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return false, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	k8sClient, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return false, fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+	helper := controller.NewReconcileHelper(k8sClient)
+	helper.RestConfig = cfg
+	c := compiler.New()
+	c.SecretKey = readSecretKey(ctx, k8sClient, secretKeySecret)
+
+	hasChanges := false
+	for i, obj := range objs {
+		if obj.GetObject().GetNamespace() == "" {
+			obj.GetObject().SetNamespace(namespace)
+		}
+
+		changes, unrealized, err := planConfig(ctx, helper, c, obj, urlOverride)
+		if err != nil {
+			return hasChanges, fmt.Errorf("%s %s: %w", kindOf(obj), obj.GetObject().GetName(), err)
+		}
+		hasChanges = hasChanges || !changes.IsEmpty()
+
+		if i > 0 {
+			_, _ = fmt.Fprintln(out)
+		}
+		title := fmt.Sprintf("%s %s/%s", kindOf(obj), obj.GetObject().GetNamespace(), obj.GetObject().GetName())
+		if err := plan.Render(out, title, changes, unrealized); err != nil {
+			return hasChanges, err
+		}
+	}
+	return hasChanges, nil
+}
+
+// planConfig compiles a config and diffs it against the live service
+func planConfig(
+	ctx context.Context,
+	helper *controller.ReconcileHelper,
+	c *compiler.Compiler,
+	config controller.ArrConfigObject,
+	urlOverride string,
+) (*adapters.ChangeSet, []irv1.UnrealizedFeature, error) {
+	appType := config.GetAppType()
+	adapter, ok := adapters.Get(appType)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s adapter not registered", appType)
+	}
+
+	scope, err := controller.ParseManageScope(config.GetObject())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clients, err := helper.ResolveDownloadClientRefs(ctx, config.GetObject().GetNamespace(), config.GetDownloadClients())
+	if err != nil {
+		return nil, nil, err
+	}
+	config.SetDownloadClients(clients)
+
+	secrets, err := helper.ResolveConfigSecrets(ctx, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	url := config.GetConnectionSpec().URL
+	if urlOverride != "" {
+		url = urlOverride
+		config.GetConnectionSpec().URL = urlOverride
+	}
+	obj := config.GetObject()
+	connIR := &irv1.ConnectionIR{
+		URL:      url,
+		APIKey:   secrets["apiKey"],
+		OwnerTag: shared.ConfigOwnershipTag(obj.GetNamespace(), obj.GetName()),
+	}
+
+	if _, err := adapter.Connect(ctx, connIR); err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to %s: %w", url, err)
+	}
+	caps, err := adapter.Discover(ctx, connIR)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to discover capabilities: %w", err)
+	}
+
+	recorded, err := borrowLiveState(ctx, helper.Client, config)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.SecretKey == nil {
+		// Fingerprints keyed differently from the operator's would all look rotated
+		recorded = nil
+	}
+
+	var desired *irv1.IR
+	switch obj := config.GetObject().(type) {
+	case *arrv1alpha1.RadarrConfig:
+		desired, err = c.CompileRadarrConfig(ctx, obj, secrets, caps)
+	case *arrv1alpha1.SonarrConfig:
+		desired, err = c.CompileSonarrConfig(ctx, obj, secrets, caps)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to compile: %w", err)
+	}
+
+	current, err := adapter.CurrentState(ctx, connIR)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get current state: %w", err)
+	}
+	scope.Restrict(desired)
+	scope.Restrict(current)
+	controller.RestoreSecretHashes(current, recorded)
+	changes, err := adapter.Diff(current, desired, caps)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to compute diff: %w", err)
+	}
+	return changes, desired.Unrealized, nil
+}
+
+// borrowLiveState copies the UID of the live config onto the one from the file
+// and returns the secret hashes recorded in its status, so credential rotations
+// are planned the way the operator would apply them. A config that doesn't
+// exist yet has nothing recorded, so no rotation is reported.
+func borrowLiveState(ctx context.Context, c client.Reader, config controller.ArrConfigObject) (map[string]string, error) {
+	obj := config.GetObject()
+	live := obj.DeepCopyObject().(client.Object)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get live config: %w", err)
+	}
+	obj.SetUID(live.GetUID())
+
+	var hashes map[string]string
+	var version string
+	switch l := live.(type) {
+	case *arrv1alpha1.RadarrConfig:
+		hashes, version = l.Status.SecretHashes, l.Status.IRSchemaVersion
+	case *arrv1alpha1.SonarrConfig:
+		hashes, version = l.Status.SecretHashes, l.Status.IRSchemaVersion
+	}
+	// Hashes the operator can't convert are ignored by it too
+	converted, err := conversion.SecretHashes(version, hashes)
+	if err != nil {
+		return nil, nil
+	}
+	return converted, nil
+}
+
+// readSecretKey reads the operator's secret key without creating it, so the
+// credentials are fingerprinted like the operator does. It returns nil when the
+// Secret can't be read.
+func readSecretKey(ctx context.Context, c client.Reader, ref string) []byte {
+	if ref == "" {
+		return nil
+	}
+	key, err := notify.ParseSecretRef(ref)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: invalid --secret-key-secret: %v\n", err)
+		return nil
+	}
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, key, secret); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: credential rotations are not planned: %v\n", err)
+		return nil
+	}
+	return secret.Data[controller.SecretKeyDataKey]
+}
+
+// readConfigs decodes all RadarrConfig and SonarrConfig documents in a file.
+// Other kinds are skipped so a whole kustomization output can be piped in.
+func readConfigs(filename string) ([]controller.ArrConfigObject, error) {
+	var r io.Reader = os.Stdin
+	if filename != "-" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+
+	var configs []controller.ArrConfigObject
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", filename, err)
+		}
+
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			// Empty documents and kinds outside the scheme are not plannable
+			continue
+		}
+		switch o := obj.(type) {
+		case *arrv1alpha1.RadarrConfig:
+			configs = append(configs, controller.RadarrConfigFetcher{}.Wrap(o))
+		case *arrv1alpha1.SonarrConfig:
+			configs = append(configs, controller.SonarrConfigFetcher{}.Wrap(o))
+		}
+	}
+	return configs, nil
+}
+
+// kindOf returns the Kind of a wrapped config for display
+func kindOf(config controller.ArrConfigObject) string {
+	switch config.GetObject().(type) {
+	case *arrv1alpha1.SonarrConfig:
+		return "SonarrConfig"
+	default:
+		return "RadarrConfig"
+	}
+}
@@ -4,6 +4,8 @@ package adapters
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
@@ -56,6 +58,126 @@ type HealthChecker interface {
 	GetHealth(ctx context.Context, conn *irv1.ConnectionIR) (*irv1.HealthStatus, error)
 }
 
+// NotificationTester is an optional interface for adapters that can ask the app
+// to test one of its notifications (e.g., to verify a media server connection end to end).
+type NotificationTester interface {
+	// TestNotification runs the app's connection test for the named notification
+	TestNotification(ctx context.Context, conn *irv1.ConnectionIR, name string) error
+}
+
+// IndexerTester is an optional interface for adapters that can ask the app to test
+// one of its indexers and disable it when the test fails (spec.indexers.verifyOnApply).
+type IndexerTester interface {
+	// TestIndexer runs the app's connection test for the named indexer
+	TestIndexer(ctx context.Context, conn *irv1.ConnectionIR, name string) error
+
+	// DisableIndexer turns off RSS, automatic and interactive search for the named indexer
+	DisableIndexer(ctx context.Context, conn *irv1.ConnectionIR, name string) error
+}
+
+// IndexerStatsReader is an optional interface for adapters that report per-indexer
+// request statistics (Prowlarr's indexerstats), used to disable failing indexers.
+type IndexerStatsReader interface {
+	// IndexerStats returns the request counts since the given time, keyed by indexer name
+	IndexerStats(ctx context.Context, conn *irv1.ConnectionIR, since time.Time) (map[string]IndexerStats, error)
+}
+
+// IndexerKeyTester is an optional interface for adapters that can test an indexer
+// with a given API key before it is applied, used to rotate indexer keys.
+type IndexerKeyTester interface {
+	// TestIndexerKey runs the app's connection test for idx using apiKey.
+	// The error wraps ErrUnauthorized when the indexer rejected the key.
+	TestIndexerKey(ctx context.Context, conn *irv1.ConnectionIR, idx irv1.ProwlarrIndexerIR, apiKey string) error
+}
+
+// ErrUnauthorized reports that a credential was rejected
+var ErrUnauthorized = errors.New("unauthorized")
+
+// PathChecker is an optional interface for adapters that can look paths up from
+// the app's point of view, used to preflight root folders
+// (spec.reconciliation.preflightPaths).
+type PathChecker interface {
+	// CheckPath returns an error wrapping ErrPathNotFound when the app can't see
+	// the directory
+	CheckPath(ctx context.Context, conn *irv1.ConnectionIR, path string) error
+}
+
+// ErrPathNotFound reports that the app can't see a directory
+var ErrPathNotFound = errors.New("path not found")
+
+// IndexerStats counts the requests an indexer served and how many of them failed
+type IndexerStats struct {
+	Requests int
+	Failures int
+}
+
+// RawRequester is an optional interface for adapters that can send arbitrary API
+// requests, used for settings the operator doesn't model yet (spec.raw).
+type RawRequester interface {
+	// SendRaw sends a request with a JSON body to a path relative to the app URL
+	SendRaw(ctx context.Context, conn *irv1.ConnectionIR, method, path string, body []byte) error
+}
+
+// Snapshotter is an optional interface for adapters that can export the raw API
+// bodies of their managed quality profiles and custom formats. The controller keeps
+// them as the last known good state of a config.
+type Snapshotter interface {
+	// Snapshot returns the raw bodies keyed by resource type, then by resource name
+	Snapshot(ctx context.Context, conn *irv1.ConnectionIR) (map[string]map[string]json.RawMessage, error)
+}
+
+// RefReader is an optional interface for adapters that can report the remote IDs
+// of the resources a config declares. The controller records them in status
+// after each apply, so tooling can reference resources by ID instead of by name.
+type RefReader interface {
+	// ManagedRefs picks the remote IDs of the resources declared in desired out of
+	// current, the state the adapter last read from the app
+	ManagedRefs(current, desired *irv1.IR) *ManagedRefs
+}
+
+// ManagedRefs are the remote IDs of a config's resources, sorted ascending.
+// Resources that don't exist in the app are left out.
+type ManagedRefs struct {
+	// QualityProfileID is the default quality profile, nil when it doesn't exist
+	QualityProfileID  *int
+	QualityProfileIDs []int
+
+	CustomFormatIDs      []int
+	DownloadClientIDs    []int
+	IndexerIDs           []int
+	RootFolderIDs        []int
+	RemotePathMappingIDs []int
+	NotificationIDs      []int
+
+	// Prowlarr
+	ProxyIDs       []int
+	ApplicationIDs []int
+}
+
+// QueueReader is an optional interface for adapters that can list the downloads
+// the app hasn't imported yet, so download client policies leave them alone.
+type QueueReader interface {
+	// QueuedDownloadIDs returns the upper-cased download IDs (torrent hashes) in the app's queue
+	QueuedDownloadIDs(ctx context.Context, conn *irv1.ConnectionIR) (map[string]bool, error)
+}
+
+// BlocklistEntry is a release the app won't grab again
+type BlocklistEntry struct {
+	ID          int
+	SourceTitle string
+	Date        time.Time
+}
+
+// BlocklistManager is an optional interface for adapters that can prune the app's
+// blocklist (spec.maintenance).
+type BlocklistManager interface {
+	// GetBlocklist returns every blocklist entry
+	GetBlocklist(ctx context.Context, conn *irv1.ConnectionIR) ([]BlocklistEntry, error)
+
+	// RemoveBlocklistEntry removes one entry, so the release can be grabbed again
+	RemoveBlocklistEntry(ctx context.Context, conn *irv1.ConnectionIR, id int) error
+}
+
 // ServiceInfo describes the connected service
 type ServiceInfo struct {
 	Version   string
@@ -66,6 +188,9 @@ type ServiceInfo struct {
 type Capabilities struct {
 	DiscoveredAt time.Time
 
+	// Version is the service version, when the adapter reads it (Radarr)
+	Version string
+
 	// Video/Quality capabilities (Radarr/Sonarr)
 	Resolutions       []string           // e.g., ["2160p", "1080p", "720p"]
 	Sources           []string           // e.g., ["bluray", "webdl", "hdtv"]
@@ -118,6 +243,20 @@ type ApplyResult struct {
 	Failed  int
 	Skipped int
 	Errors  []ApplyError
+
+	// Durations records how long each attempted change took, for latency metrics
+	Durations []ChangeDuration
+
+	// Diff is the change set the result belongs to, including changes held back
+	// outside the apply window. Set by the reconciler, not the adapter.
+	Diff *ChangeSet
+}
+
+// ChangeDuration is the time spent applying a single change
+type ChangeDuration struct {
+	ResourceType string
+	Action       string // create, update or delete
+	Duration     time.Duration
 }
 
 // Success returns true if all changes were applied successfully
@@ -151,10 +290,18 @@ const (
 	ResourceNamingConfig      = "NamingConfig"
 	ResourceMetadataProfile   = "MetadataProfile"   // Lidarr
 	ResourceApplication       = "Application"       // Prowlarr
+	ResourceAppProfile        = "AppProfile"        // Prowlarr
 	ResourceImportList        = "ImportList"        // Radarr/Sonarr/Lidarr
+	ResourceImportListOptions = "ImportListOptions" // Radarr/Sonarr
 	ResourceMediaManagement   = "MediaManagement"   // All apps
+	ResourceCollection        = "Collection"        // Radarr
 	ResourceAuthentication    = "Authentication"    // All apps
+	ResourceUIConfig          = "UIConfig"          // Radarr/Sonarr
 	ResourceRemotePathMapping = "RemotePathMapping" // All apps
 	ResourceNotification      = "Notification"      // All apps
-	ResourceDelayProfile      = "DelayProfile"      // Radarr/Sonarr
+	ResourceDelayProfile      = "DelayProfile"      // Radarr/Sonarr/Lidarr/Readarr
+	ResourceQualityDefinition = "QualityDefinition" // Radarr/Sonarr
+	ResourceAutoTag           = "AutoTag"           // Radarr/Sonarr
+	ResourceDelayProfileOrder = "DelayProfileOrder" // Radarr/Sonarr/Lidarr/Readarr
+	ResourceReleaseProfile    = "ReleaseProfile"    // Lidarr
 )
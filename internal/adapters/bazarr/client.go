@@ -12,6 +12,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 )
 
 // Client provides access to the Bazarr API
@@ -24,11 +26,9 @@ type Client struct {
 // NewClient creates a new Bazarr API client
 func NewClient(baseURL, apiKey string) *Client {
 	return &Client{
-		baseURL: strings.TrimSuffix(baseURL, "/"),
-		apiKey:  apiKey,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: httpclient.NewHTTPClient(httpclient.Config{BaseURL: baseURL, Timeout: 30 * time.Second}),
 	}
 }
 
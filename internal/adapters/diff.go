@@ -2,6 +2,8 @@
 package adapters
 
 import (
+	"strings"
+
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
@@ -13,9 +15,10 @@ func DiffVideoQualityProfiles(
 	profileID *int,
 	changes *ChangeSet,
 ) {
-	// No desired profile - delete current if exists
+	// No desired profile - delete current if exists. Adapters look the
+	// profile up by name when profileID is nil.
 	if desiredProfile == nil {
-		if currentProfile != nil && profileID != nil {
+		if currentProfile != nil && currentProfile.ProfileName != "" {
 			changes.Deletes = append(changes.Deletes, Change{
 				ResourceType: ResourceQualityProfile,
 				Name:         currentProfile.ProfileName,
@@ -35,10 +38,74 @@ func DiffVideoQualityProfiles(
 		return
 	}
 
-	// Both exist - skip update since quality profiles are complex to compare
-	// and re-applying the same profile is idempotent but noisy.
-	// The profile structure (with nested groups) makes comparison difficult,
-	// and Sonarr/Radarr will accept the same profile without issues.
+	if videoQualityProfileChanged(currentProfile, desiredProfile) {
+		changes.Updates = append(changes.Updates, Change{
+			ResourceType: ResourceQualityProfile,
+			Name:         desiredProfile.ProfileName,
+			ID:           profileID,
+			Payload:      desiredProfile,
+		})
+	}
+}
+
+// videoQualityProfileChanged compares the parts of a profile that read back the
+// way they were written. Tiers and the cutoff are not compared: the profile
+// structure (with nested groups) doesn't map back to tiers one to one, and
+// re-applying the same profile on every reconcile is idempotent but noisy.
+func videoQualityProfileChanged(current, desired *irv1.VideoQualityIR) bool {
+	if current.UpgradeAllowed != desired.UpgradeAllowed ||
+		current.MinimumCustomFormatScore != desired.MinimumCustomFormatScore {
+		return true
+	}
+	// A zero score leaves the app's own value in place
+	if desired.UpgradeUntilCustomFormatScore > 0 && current.UpgradeUntilCustomFormatScore != desired.UpgradeUntilCustomFormatScore {
+		return true
+	}
+	// Only formats the profile already lists can be compared; scores of formats
+	// that don't exist yet are set when the profile is next written
+	for name, score := range desired.FormatScores {
+		if currentScore, ok := current.FormatScores[name]; ok && currentScore != score {
+			return true
+		}
+	}
+	return profileLanguageChanged(current, desired)
+}
+
+// profileLanguageChanged compares the profile language (Radarr). Without a
+// language in the spec a profile goes back to "Original", unless it is cloned
+// and keeps the language of the profile it was cloned from. Sonarr profiles
+// have no language on either side.
+func profileLanguageChanged(current, desired *irv1.VideoQualityIR) bool {
+	switch {
+	case desired.Language != nil:
+		return current.Language == nil || current.Language.ID != desired.Language.ID
+	case current.Language != nil && desired.CloneFrom == "":
+		return current.Language.ID != irv1.LanguageOriginal
+	default:
+		return false
+	}
+}
+
+// DiffVideoQualityProfileSets computes changes for named video quality profiles, matching
+// current and desired profiles by name. Profiles that are no longer desired are left in
+// place, since movies or series may still be assigned to them. With no desired profiles,
+// as when a config is deleted, the current default profile (the first) is deleted.
+func DiffVideoQualityProfileSets(current, desired []*irv1.VideoQualityIR, changes *ChangeSet) {
+	if len(desired) == 0 {
+		if len(current) > 0 {
+			DiffVideoQualityProfiles(current[0], nil, nil, changes)
+		}
+		return
+	}
+
+	currentByName := make(map[string]*irv1.VideoQualityIR, len(current))
+	for _, profile := range current {
+		currentByName[profile.ProfileName] = profile
+	}
+
+	for _, profile := range desired {
+		DiffVideoQualityProfiles(currentByName[profile.ProfileName], profile, nil, changes)
+	}
 }
 
 // DiffQualityProfiles is an alias for DiffVideoQualityProfiles for backward compatibility.
@@ -133,6 +200,10 @@ func DiffDownloadClients(
 
 	// Find creates and updates
 	for name, desiredDC := range desiredMap {
+		if desiredDC.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		currentDC, exists := currentMap[name]
 		if !exists {
 			changes.Creates = append(changes.Creates, Change{
@@ -183,6 +254,10 @@ func DiffDownloadClientsWithIR(
 
 	// Find creates and updates
 	for name, desiredDC := range desiredMap {
+		if desiredDC.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		currentDC, exists := currentMap[name]
 		if !exists {
 			changes.Creates = append(changes.Creates, Change{
@@ -217,13 +292,21 @@ func DiffDownloadClientsWithIR(
 // DownloadClientsEqual compares two download clients to determine if they're equivalent.
 func DownloadClientsEqual(current, desired irv1.DownloadClientIR) bool {
 	return current.Name == desired.Name &&
-		current.Implementation == desired.Implementation &&
-		current.Host == desired.Host &&
+		ImplementationsEqual(current.Implementation, desired.Implementation) &&
+		strings.EqualFold(current.Host, desired.Host) &&
 		current.Port == desired.Port &&
 		current.UseTLS == desired.UseTLS &&
 		current.Category == desired.Category &&
 		current.Enable == desired.Enable &&
-		current.Priority == desired.Priority
+		IntEqualOrDefault(current.Priority, desired.Priority, DefaultDownloadClientPriority) &&
+		!SecretChanged(current.SecretHash, desired.SecretHash)
+}
+
+// SecretChanged reports whether credentials were rotated since they were last
+// applied. The apps never return secrets, so current is the hash recorded in
+// status; an empty current hash means nothing was recorded yet.
+func SecretChanged(current, desired string) bool {
+	return current != "" && current != desired
 }
 
 // DiffCustomFormats computes changes needed for custom formats.
@@ -309,11 +392,11 @@ func CustomFormatsEqual(current, desired *irv1.CustomFormatIR) bool {
 
 // FormatSpecsEqual compares two format specifications.
 func FormatSpecsEqual(current, desired irv1.FormatSpecIR) bool {
-	return current.Type == desired.Type &&
+	return ImplementationsEqual(current.Type, desired.Type) &&
 		current.Name == desired.Name &&
 		current.Negate == desired.Negate &&
 		current.Required == desired.Required &&
-		current.Value == desired.Value
+		FieldValuesEqual(current.Value, desired.Value)
 }
 
 // DiffIndexers computes changes needed for indexers.
@@ -336,6 +419,10 @@ func DiffIndexers(
 
 	// Find creates and updates
 	for name, desiredIdx := range desiredMap {
+		if desiredIdx.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		currentIdx, exists := currentMap[name]
 		if !exists {
 			changes.Creates = append(changes.Creates, Change{
@@ -386,6 +473,10 @@ func DiffIndexersWithIR(
 
 	// Find creates and updates
 	for name, desiredIdx := range desiredMap {
+		if desiredIdx.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		currentIdx, exists := currentMap[name]
 		if !exists {
 			changes.Creates = append(changes.Creates, Change{
@@ -420,13 +511,14 @@ func DiffIndexersWithIR(
 // IndexersEqual compares two indexers to determine if they're equivalent.
 func IndexersEqual(current, desired irv1.IndexerIR) bool {
 	return current.Name == desired.Name &&
-		current.Implementation == desired.Implementation &&
-		current.URL == desired.URL &&
+		ImplementationsEqual(current.Implementation, desired.Implementation) &&
+		URLsEqual(current.URL, desired.URL) &&
 		current.Enable == desired.Enable &&
-		current.Priority == desired.Priority &&
+		IntEqualOrDefault(current.Priority, desired.Priority, DefaultIndexerPriority) &&
 		current.EnableRss == desired.EnableRss &&
 		current.EnableAutomaticSearch == desired.EnableAutomaticSearch &&
-		current.EnableInteractiveSearch == desired.EnableInteractiveSearch
+		current.EnableInteractiveSearch == desired.EnableInteractiveSearch &&
+		!SecretChanged(current.SecretHash, desired.SecretHash)
 }
 
 // DiffRootFolders computes changes needed for root folders.
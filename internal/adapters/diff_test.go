@@ -0,0 +1,133 @@
+package adapters
+
+import (
+	"testing"
+
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestDiffVideoQualityProfileSets(t *testing.T) {
+	profile := func(name string, upgrade bool) *irv1.VideoQualityIR {
+		return &irv1.VideoQualityIR{
+			ProfileName:    name,
+			UpgradeAllowed: upgrade,
+			FormatScores:   map[string]int{"x265": 10},
+		}
+	}
+	names := func(changes []Change) []string {
+		var result []string
+		for _, c := range changes {
+			result = append(result, c.Name)
+		}
+		return result
+	}
+
+	current := []*irv1.VideoQualityIR{
+		profile("nebularr-movies", true),
+		profile("nebularr-movies-uhd", true),
+		profile("nebularr-movies-old", true),
+	}
+
+	// Unchanged profiles issue nothing; a removed additional profile is kept
+	changes := &ChangeSet{}
+	DiffVideoQualityProfileSets(current, []*irv1.VideoQualityIR{profile("nebularr-movies", true), profile("nebularr-movies-uhd", true)}, changes)
+	if !changes.IsEmpty() {
+		t.Fatalf("unchanged profiles: got %+v", changes)
+	}
+
+	// Additional profiles are updated and created like the default one
+	uhd := profile("nebularr-movies-uhd", false)
+	scored := profile("nebularr-movies", true)
+	scored.FormatScores = map[string]int{"x265": 50, "missing": 5}
+	changes = &ChangeSet{}
+	DiffVideoQualityProfileSets(current, []*irv1.VideoQualityIR{scored, uhd, profile("nebularr-movies-compact", true)}, changes)
+	if got := names(changes.Updates); len(got) != 2 || got[0] != "nebularr-movies" || got[1] != "nebularr-movies-uhd" {
+		t.Errorf("updates = %v", got)
+	}
+	if got := names(changes.Creates); len(got) != 1 || got[0] != "nebularr-movies-compact" {
+		t.Errorf("creates = %v", got)
+	}
+	if len(changes.Deletes) != 0 {
+		t.Errorf("deletes = %v", names(changes.Deletes))
+	}
+
+	// A score for a format the profile doesn't list yet is not a change
+	unknown := profile("nebularr-movies", true)
+	unknown.FormatScores = map[string]int{"x265": 10, "missing": 5}
+	changes = &ChangeSet{}
+	DiffVideoQualityProfileSets(current[:1], []*irv1.VideoQualityIR{unknown}, changes)
+	if !changes.IsEmpty() {
+		t.Errorf("unknown format score: got %+v", changes)
+	}
+
+	// Without desired profiles the default profile is deleted by name
+	changes = &ChangeSet{}
+	DiffVideoQualityProfileSets(current, nil, changes)
+	if got := names(changes.Deletes); len(got) != 1 || got[0] != "nebularr-movies" {
+		t.Errorf("deletes = %v", got)
+	}
+
+	// A default slot only holding custom formats has no profile to delete
+	changes = &ChangeSet{}
+	DiffVideoQualityProfileSets([]*irv1.VideoQualityIR{{}}, nil, changes)
+	if !changes.IsEmpty() {
+		t.Errorf("unnamed profile: got %+v", changes)
+	}
+}
+
+func TestProfileLanguageChanged(t *testing.T) {
+	lang := func(id int) *irv1.LanguageIR { return &irv1.LanguageIR{ID: id} }
+	tests := []struct {
+		name     string
+		current  *irv1.LanguageIR
+		desired  *irv1.LanguageIR
+		clone    string
+		expected bool
+	}{
+		{"same language", lang(2), lang(2), "", false},
+		{"changed language", lang(2), lang(4), "", true},
+		{"language removed from the spec", lang(2), nil, "", true},
+		{"already original", lang(irv1.LanguageOriginal), nil, "", false},
+		{"cloned profile keeps its language", lang(2), nil, "HD-1080p", false},
+		{"no profile language (Sonarr)", nil, nil, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := &irv1.VideoQualityIR{Language: tt.current}
+			desired := &irv1.VideoQualityIR{Language: tt.desired, CloneFrom: tt.clone}
+			if got := profileLanguageChanged(current, desired); got != tt.expected {
+				t.Errorf("profileLanguageChanged() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiffPausedEntries(t *testing.T) {
+	currentClients := []irv1.DownloadClientIR{
+		{ID: 3, Name: "nebularr-movies-qbit", Implementation: "qbittorrent", Host: "qbit", Port: 8080, Enable: true},
+	}
+	desiredClients := []irv1.DownloadClientIR{
+		{Name: "nebularr-movies-qbit", Implementation: "qbittorrent", Host: "qbit", Port: 9090, Enable: true, Paused: true},
+		{Name: "nebularr-movies-sab", Implementation: "sabnzbd", Host: "sab", Port: 8080, Enable: true, Paused: true},
+	}
+	currentIndexers := []irv1.IndexerIR{
+		{ID: 5, Name: "nebularr-movies-tracker", Implementation: "Torznab", URL: "http://tracker", Enable: true},
+	}
+	desiredIndexers := []irv1.IndexerIR{
+		{Name: "nebularr-movies-tracker", Implementation: "Torznab", URL: "http://tracker", Enable: false, Paused: true},
+	}
+
+	changes := &ChangeSet{}
+	DiffDownloadClientsWithIR(currentClients, desiredClients, changes)
+	DiffIndexersWithIR(currentIndexers, desiredIndexers, changes)
+	if len(changes.Creates)+len(changes.Updates)+len(changes.Deletes) != 0 {
+		t.Errorf("got %+v, want paused entries kept as they are", changes)
+	}
+
+	// Clearing pause manages the entry again
+	desiredClients[0].Paused = false
+	DiffDownloadClientsWithIR(currentClients, desiredClients[:1], changes)
+	if len(changes.Updates) != 1 || changes.Updates[0].Name != "nebularr-movies-qbit" {
+		t.Errorf("unpaused client: got %+v", changes)
+	}
+}
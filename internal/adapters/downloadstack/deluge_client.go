@@ -35,18 +35,48 @@ type DelugeClientInterface interface {
 
 	// AddLabel adds a new label
 	AddLabel(ctx context.Context, label string) error
+
+	// GetHosts gets the daemon hosts known to the Web UI
+	GetHosts(ctx context.Context) ([]DelugeHost, error)
+
+	// EnsureHost ensures the daemon host is in the Web UI host list and returns its ID
+	EnsureHost(ctx context.Context, host string, port int, username, password string) (string, error)
 }
 
 // Ensure DelugeClient implements the interface
 var _ DelugeClientInterface = (*DelugeClient)(nil)
 
-// DelugeClient is a client for Deluge JSON-RPC API (via Web UI)
+// DelugeClient is a client for Deluge, either via the Web UI JSON-RPC API
+// or directly via the daemon RPC protocol.
 type DelugeClient struct {
 	baseURL    string
 	httpClient *http.Client
 	password   string
 	loggedIn   bool
 	requestID  int64
+
+	// daemon is set when talking to the daemon directly (no Web UI)
+	daemon   *delugeDaemonConn
+	username string
+
+	// daemonHost is the daemon the Web UI should connect to (Web UI mode only)
+	daemonHost *delugeDaemonTarget
+}
+
+// delugeDaemonTarget identifies a Deluge daemon and its credentials
+type delugeDaemonTarget struct {
+	host     string
+	port     int
+	username string
+	password string
+}
+
+// DelugeHost is a daemon entry in the Web UI host list
+type DelugeHost struct {
+	ID       string
+	Host     string
+	Port     int
+	Username string
 }
 
 // DelugeRPCRequest is the request structure for Deluge JSON-RPC
@@ -141,12 +171,33 @@ type DelugeConfig struct {
 func NewDelugeClient(baseURL, password string) *DelugeClient {
 	jar, _ := cookiejar.New(nil)
 	return &DelugeClient{
-		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		password:   password,
+		httpClient: newHTTPClient(baseURL, jar),
+	}
+}
+
+// NewDelugeDaemonClient creates a client that connects directly to the Deluge daemon RPC.
+// Credentials come from the daemon's auth file (see ParseDelugeAuthFile).
+func NewDelugeDaemonClient(host string, port int, username, password string) *DelugeClient {
+	return &DelugeClient{
+		daemon:   newDelugeDaemonConn(host, port),
+		username: username,
+		password: password,
+	}
+}
+
+// SetDaemonHost configures the daemon the Web UI should connect to.
+// On login the host is added to the Web UI host list if missing, then connected.
+func (c *DelugeClient) SetDaemonHost(host string, port int, username, password string) {
+	if port == 0 {
+		port = DelugeDaemonDefaultPort
+	}
+	c.daemonHost = &delugeDaemonTarget{
+		host:     host,
+		port:     port,
+		username: username,
 		password: password,
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-			Jar:     jar,
-		},
 	}
 }
 
@@ -161,6 +212,10 @@ func (c *DelugeClient) request(ctx context.Context, method string, params ...int
 		params = []interface{}{}
 	}
 
+	if c.daemon != nil {
+		return c.daemonRequest(ctx, method, params)
+	}
+
 	rpcReq := DelugeRPCRequest{
 		ID:     c.nextID(),
 		Method: method,
@@ -203,12 +258,33 @@ func (c *DelugeClient) request(ctx context.Context, method string, params ...int
 	return &rpcResp, nil
 }
 
-// Login authenticates with Deluge Web UI
+// daemonRequest makes an RPC request directly to the daemon.
+// The result is re-encoded as JSON so callers can decode it the same way as Web UI responses.
+func (c *DelugeClient) daemonRequest(ctx context.Context, method string, params []interface{}) (*DelugeRPCResponse, error) {
+	id := c.nextID()
+	result, err := c.daemon.call(ctx, id, method, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode daemon result: %w", err)
+	}
+
+	return &DelugeRPCResponse{ID: id, Result: raw}, nil
+}
+
+// Login authenticates with Deluge (Web UI password or daemon auth file credentials)
 func (c *DelugeClient) Login(ctx context.Context) error {
 	if c.loggedIn {
 		return nil
 	}
 
+	if c.daemon != nil {
+		return c.daemonLogin(ctx)
+	}
+
 	resp, err := c.request(ctx, "auth.login", c.password)
 	if err != nil {
 		return fmt.Errorf("login request failed: %w", err)
@@ -224,32 +300,149 @@ func (c *DelugeClient) Login(ctx context.Context) error {
 	}
 
 	// Connect to the daemon
-	// First, get available hosts
-	hostsResp, err := c.request(ctx, "web.get_hosts")
-	if err != nil {
-		return fmt.Errorf("failed to get hosts: %w", err)
+	if c.daemonHost != nil {
+		// Make sure the configured daemon is in the host list, then connect to it
+		hostID, err := c.ensureHost(ctx, c.daemonHost)
+		if err != nil {
+			return err
+		}
+		if _, err := c.request(ctx, "web.connect", hostID); err != nil {
+			return fmt.Errorf("failed to connect Web UI to daemon %s:%d: %w", c.daemonHost.host, c.daemonHost.port, err)
+		}
+	} else {
+		// Connect to the first available host
+		hosts, err := c.getHosts(ctx)
+		if err != nil {
+			return err
+		}
+		if len(hosts) > 0 {
+			// Connection might already be established, ignore errors
+			_, _ = c.request(ctx, "web.connect", hosts[0].ID)
+		}
 	}
 
-	var hosts [][]interface{}
-	if err := json.Unmarshal(hostsResp.Result, &hosts); err != nil {
-		return fmt.Errorf("failed to parse hosts: %w", err)
+	c.loggedIn = true
+	return nil
+}
+
+// daemonLogin opens the daemon connection and authenticates with auth file credentials
+func (c *DelugeClient) daemonLogin(ctx context.Context) error {
+	if err := c.daemon.dial(ctx); err != nil {
+		return err
 	}
 
-	// Connect to the first available host
-	if len(hosts) > 0 {
-		hostID, ok := hosts[0][0].(string)
-		if ok {
-			_, err = c.request(ctx, "web.connect", hostID)
-			if err != nil {
-				// Connection might already be established, continue
-			}
-		}
+	// Deluge 2.x requires the client_version keyword argument
+	_, err := c.daemon.call(ctx, c.nextID(), "daemon.login",
+		[]interface{}{c.username, c.password},
+		map[string]interface{}{"client_version": delugeClientVersion})
+	if err != nil {
+		c.daemon.close()
+		return fmt.Errorf("daemon login failed: %w", err)
 	}
 
 	c.loggedIn = true
 	return nil
 }
 
+// Close closes the daemon connection (no-op for the Web UI)
+func (c *DelugeClient) Close() {
+	if c.daemon != nil {
+		c.daemon.close()
+		c.loggedIn = false
+	}
+}
+
+// GetHosts gets the daemon hosts known to the Web UI
+func (c *DelugeClient) GetHosts(ctx context.Context) ([]DelugeHost, error) {
+	if c.daemon != nil {
+		return nil, fmt.Errorf("host list is only available via the Web UI")
+	}
+	if err := c.ensureLoggedIn(ctx); err != nil {
+		return nil, err
+	}
+	return c.getHosts(ctx)
+}
+
+// getHosts fetches the Web UI host list (requires an authenticated session)
+func (c *DelugeClient) getHosts(ctx context.Context) ([]DelugeHost, error) {
+	resp, err := c.request(ctx, "web.get_hosts")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hosts: %w", err)
+	}
+
+	// Each host is [id, host, port, username]
+	var raw [][]interface{}
+	if err := json.Unmarshal(resp.Result, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse hosts: %w", err)
+	}
+
+	hosts := make([]DelugeHost, 0, len(raw))
+	for _, entry := range raw {
+		if len(entry) < 3 {
+			continue
+		}
+		h := DelugeHost{}
+		h.ID, _ = entry[0].(string)
+		h.Host, _ = entry[1].(string)
+		if port, ok := entry[2].(float64); ok {
+			h.Port = int(port)
+		}
+		if len(entry) > 3 {
+			h.Username, _ = entry[3].(string)
+		}
+		hosts = append(hosts, h)
+	}
+
+	return hosts, nil
+}
+
+// EnsureHost ensures the daemon host is in the Web UI host list and returns its ID
+func (c *DelugeClient) EnsureHost(ctx context.Context, host string, port int, username, password string) (string, error) {
+	if c.daemon != nil {
+		return "", fmt.Errorf("host list is only available via the Web UI")
+	}
+	if err := c.ensureLoggedIn(ctx); err != nil {
+		return "", err
+	}
+	if port == 0 {
+		port = DelugeDaemonDefaultPort
+	}
+	return c.ensureHost(ctx, &delugeDaemonTarget{host: host, port: port, username: username, password: password})
+}
+
+// ensureHost adds the target to the Web UI host list if missing (requires an authenticated session)
+func (c *DelugeClient) ensureHost(ctx context.Context, target *delugeDaemonTarget) (string, error) {
+	hosts, err := c.getHosts(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, h := range hosts {
+		if strings.EqualFold(h.Host, target.host) && h.Port == target.port && h.Username == target.username {
+			return h.ID, nil
+		}
+	}
+
+	resp, err := c.request(ctx, "web.add_host", target.host, target.port, target.username, target.password)
+	if err != nil {
+		return "", fmt.Errorf("failed to add host: %w", err)
+	}
+
+	// Result is [success, hostID or error message]
+	var result []interface{}
+	if err := json.Unmarshal(resp.Result, &result); err != nil || len(result) < 2 {
+		return "", fmt.Errorf("failed to parse add host response: %s", string(resp.Result))
+	}
+
+	success, _ := result[0].(bool)
+	detail, _ := result[1].(string)
+	if !success {
+		return "", fmt.Errorf("failed to add host %s:%d: %s", target.host, target.port, detail)
+	}
+
+	return detail, nil
+}
+
 // TestConnection tests the connection to Deluge
 func (c *DelugeClient) TestConnection(ctx context.Context) error {
 	if err := c.Login(ctx); err != nil {
@@ -344,6 +537,15 @@ func (c *DelugeClient) ensureLoggedIn(ctx context.Context) error {
 		return c.Login(ctx)
 	}
 
+	// Daemon sessions live as long as the connection
+	if c.daemon != nil {
+		if !c.daemon.connected() {
+			c.loggedIn = false
+			return c.Login(ctx)
+		}
+		return nil
+	}
+
 	// Check if session is still valid
 	resp, err := c.request(ctx, "auth.check_session")
 	if err != nil {
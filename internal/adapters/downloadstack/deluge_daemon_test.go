@@ -0,0 +1,121 @@
+package downloadstack
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestParseDelugeAuthFile(t *testing.T) {
+	const auth = "# Deluge auth file\n\nlocalclient:abc123:10\nnebularr:secret:10\nother:pw:5\nbroken\n"
+
+	tests := []struct {
+		name     string
+		contents string
+		username string
+		wantUser string
+		wantPass string
+		wantErr  bool
+	}{
+		{"first non-localclient user", auth, "", "nebularr", "secret", false},
+		{"named user", auth, "other", "other", "pw", false},
+		{"named localclient", auth, "localclient", "localclient", "abc123", false},
+		{"named user missing", auth, "missing", "", "", true},
+		{"localclient fallback", "localclient:abc123:10\n", "", "localclient", "abc123", false},
+		{"CRLF line endings", "nebularr:secret:10\r\n", "", "nebularr", "secret", false},
+		{"no users", "# empty\n\nbroken\n", "", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, pass, err := ParseDelugeAuthFile(tt.contents, tt.username)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDelugeAuthFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if user != tt.wantUser || pass != tt.wantPass {
+				t.Errorf("ParseDelugeAuthFile() = %q, %q, want %q, %q", user, pass, tt.wantUser, tt.wantPass)
+			}
+		})
+	}
+}
+
+// pipeDaemon connects a delugeDaemonConn to an in-memory daemon that answers
+// each request with replies(id, method)
+func pipeDaemon(t *testing.T, replies func(id int64, method string) []interface{}) *delugeDaemonConn {
+	client, server := net.Pipe()
+	t.Cleanup(func() { _ = client.Close(); _ = server.Close() })
+
+	daemon := &delugeDaemonConn{conn: server, reader: bufio.NewReader(server)}
+	go func() {
+		for {
+			msg, err := daemon.readMessage()
+			if err != nil {
+				return
+			}
+			request := msg.([]interface{})[0].([]interface{})
+			for _, reply := range replies(request[0].(int64), request[1].(string)) {
+				if err := daemon.writeMessage(reply); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	return &delugeDaemonConn{conn: client, reader: bufio.NewReader(client)}
+}
+
+func TestDelugeDaemonCall(t *testing.T) {
+	d := pipeDaemon(t, func(id int64, method string) []interface{} {
+		if method == "core.get_config_value" {
+			return []interface{}{
+				[]interface{}{int64(delugeRPCError), id, "InvalidKey", []interface{}{"no such key"}, map[string]interface{}{}, ""},
+			}
+		}
+		return []interface{}{
+			// Events and responses to other requests are skipped
+			[]interface{}{int64(delugeRPCEvent), "TorrentAddedEvent", []interface{}{}},
+			[]interface{}{int64(delugeRPCResponse), id + 1, "stale"},
+			[]interface{}{int64(delugeRPCResponse), id, []interface{}{"Label", "Blocklist"}},
+		}
+	})
+	ctx := context.Background()
+
+	result, err := d.call(ctx, 7, "core.get_enabled_plugins", nil, nil)
+	if err != nil {
+		t.Fatalf("call() error = %v", err)
+	}
+	if !reflect.DeepEqual(result, []interface{}{"Label", "Blocklist"}) {
+		t.Errorf("call() = %#v, want the plugins", result)
+	}
+
+	_, err = d.call(ctx, 8, "core.get_config_value", []interface{}{"missing"}, nil)
+	if err == nil || err.Error() != "RPC error: InvalidKey: no such key" {
+		t.Errorf("call() error = %v, want the daemon's exception", err)
+	}
+	if !d.connected() {
+		t.Error("an RPC error shouldn't drop the connection")
+	}
+}
+
+func TestDelugeDaemonCallResetsBrokenConnection(t *testing.T) {
+	client, server := net.Pipe()
+	t.Cleanup(func() { _ = client.Close() })
+	// The daemon goes away after reading the request
+	go func() {
+		daemon := &delugeDaemonConn{conn: server, reader: bufio.NewReader(server)}
+		_, _ = daemon.readMessage()
+		_ = server.Close()
+	}()
+	d := &delugeDaemonConn{conn: client, reader: bufio.NewReader(client)}
+
+	if _, err := d.call(context.Background(), 1, "daemon.info", nil, nil); err == nil {
+		t.Fatal("expected an error on a closed connection")
+	}
+	if d.connected() {
+		t.Error("a broken connection should be dropped so the next call reconnects")
+	}
+	if _, err := d.call(context.Background(), 2, "daemon.info", nil, nil); err == nil {
+		t.Error("expected an error when not connected")
+	}
+}
@@ -0,0 +1,75 @@
+package downloadstack
+
+import (
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// Client names used in DownloadStackConfigStatus.EffectiveSettings
+const (
+	ClientTransmission = "transmission"
+	ClientQBittorrent  = "qbittorrent"
+	ClientDeluge       = "deluge"
+)
+
+// TransmissionEffectiveSettings summarizes a Transmission session.
+// Speed limits that are switched off are reported as unlimited.
+func TransmissionEffectiveSettings(session *TransmissionSession) arrv1alpha1.EffectiveClientSettings {
+	pex := session.PexEnabled
+	settings := arrv1alpha1.EffectiveClientSettings{
+		Client:          ClientTransmission,
+		AltSpeedEnabled: session.AltSpeedEnabled,
+		SavePath:        session.DownloadDir,
+		ListenPort:      session.PeerPort,
+		DHT:             session.DhtEnabled,
+		PEX:             &pex,
+	}
+	if session.SpeedLimitDownEnabled {
+		settings.DownloadLimit = session.SpeedLimitDown
+	}
+	if session.SpeedLimitUpEnabled {
+		settings.UploadLimit = session.SpeedLimitUp
+	}
+	if session.IncompleteDirEnabled {
+		settings.IncompletePath = session.IncompleteDir
+	}
+	return settings
+}
+
+// QBittorrentEffectiveSettings summarizes qBittorrent preferences.
+// qBittorrent reports speed limits in bytes/s; they are converted to KB/s.
+func QBittorrentEffectiveSettings(prefs *QBittorrentPreferences) arrv1alpha1.EffectiveClientSettings {
+	pex := prefs.Pex
+	settings := arrv1alpha1.EffectiveClientSettings{
+		Client:        ClientQBittorrent,
+		DownloadLimit: max(prefs.DlLimit, 0) / 1024,
+		UploadLimit:   max(prefs.UpLimit, 0) / 1024,
+		SavePath:      prefs.SavePath,
+		ListenPort:    prefs.ListenPort,
+		DHT:           prefs.Dht,
+		PEX:           &pex,
+	}
+	if prefs.TempPathEnabled {
+		settings.IncompletePath = prefs.TempPath
+	}
+	return settings
+}
+
+// DelugeEffectiveSettings summarizes a Deluge core config.
+// Deluge uses -1 for unlimited speeds and does not report peer exchange.
+func DelugeEffectiveSettings(config *DelugeConfig) arrv1alpha1.EffectiveClientSettings {
+	settings := arrv1alpha1.EffectiveClientSettings{
+		Client:        ClientDeluge,
+		DownloadLimit: int(max(config.MaxDownloadSpeed, 0)),
+		UploadLimit:   int(max(config.MaxUploadSpeed, 0)),
+		SavePath:      config.DownloadLocation,
+		DHT:           config.DHTEnabled,
+	}
+	if config.MoveCompleted {
+		settings.SavePath = config.MoveCompletedPath
+		settings.IncompletePath = config.DownloadLocation
+	}
+	if len(config.ListenPorts) > 0 {
+		settings.ListenPort = config.ListenPorts[0]
+	}
+	return settings
+}
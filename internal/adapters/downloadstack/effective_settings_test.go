@@ -0,0 +1,69 @@
+package downloadstack
+
+import (
+	"reflect"
+	"testing"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+func TestEffectiveSettings(t *testing.T) {
+	enabled, disabled := true, false
+
+	tests := []struct {
+		name string
+		got  arrv1alpha1.EffectiveClientSettings
+		want arrv1alpha1.EffectiveClientSettings
+	}{
+		{
+			name: "transmission reports disabled limits as unlimited",
+			got: TransmissionEffectiveSettings(&TransmissionSession{
+				SpeedLimitDown: 5000, SpeedLimitDownEnabled: true,
+				SpeedLimitUp: 100, SpeedLimitUpEnabled: false,
+				AltSpeedEnabled: true,
+				DownloadDir:     "/downloads/complete",
+				IncompleteDir:   "/downloads/incomplete", IncompleteDirEnabled: true,
+				PeerPort: 51413, DhtEnabled: true, PexEnabled: true,
+			}),
+			want: arrv1alpha1.EffectiveClientSettings{
+				Client: ClientTransmission, DownloadLimit: 5000, AltSpeedEnabled: true,
+				SavePath: "/downloads/complete", IncompletePath: "/downloads/incomplete",
+				ListenPort: 51413, DHT: true, PEX: &enabled,
+			},
+		},
+		{
+			name: "qbittorrent converts bytes to KB and hides a disabled temp path",
+			got: QBittorrentEffectiveSettings(&QBittorrentPreferences{
+				DlLimit: 2048 * 1024, UpLimit: 0,
+				SavePath: "/downloads", TempPath: "/incomplete",
+				ListenPort: 6881, Dht: false, Pex: false,
+			}),
+			want: arrv1alpha1.EffectiveClientSettings{
+				Client: ClientQBittorrent, DownloadLimit: 2048,
+				SavePath: "/downloads", ListenPort: 6881, PEX: &disabled,
+			},
+		},
+		{
+			name: "deluge saves to the move-completed path",
+			got: DelugeEffectiveSettings(&DelugeConfig{
+				MaxDownloadSpeed: -1, MaxUploadSpeed: 500,
+				DownloadLocation: "/downloads/incomplete",
+				MoveCompleted:    true, MoveCompletedPath: "/downloads/complete",
+				ListenPorts: []int{6881, 6891}, DHTEnabled: true,
+			}),
+			want: arrv1alpha1.EffectiveClientSettings{
+				Client: ClientDeluge, UploadLimit: 500,
+				SavePath: "/downloads/complete", IncompletePath: "/downloads/incomplete",
+				ListenPort: 6881, DHT: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %+v, want %+v", tt.got, tt.want)
+			}
+		})
+	}
+}
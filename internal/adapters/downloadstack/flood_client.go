@@ -0,0 +1,208 @@
+package downloadstack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+)
+
+// Flood access levels
+const (
+	FloodLevelUser          = 5
+	FloodLevelAdministrator = 10
+)
+
+// FloodClientInterface defines the Flood API operations.
+// This interface allows for mock implementations in tests.
+type FloodClientInterface interface {
+	// Verify reports the session user, or that Flood has no users yet
+	Verify(ctx context.Context) (*FloodVerify, error)
+
+	// Authenticate logs in as the administrator
+	Authenticate(ctx context.Context) error
+
+	// Register creates a user. Without users, it creates the first administrator.
+	Register(ctx context.Context, user FloodUser) error
+
+	// ListUsers lists the users (administrators only)
+	ListUsers(ctx context.Context) ([]FloodUser, error)
+
+	// UpdateUser updates a user; empty fields of patch are left unchanged
+	UpdateUser(ctx context.Context, username string, patch FloodUserPatch) error
+
+	// DeleteUser deletes a user
+	DeleteUser(ctx context.Context, username string) error
+
+	// TestClientConnection reports whether Flood reaches the session user's torrent client
+	TestClientConnection(ctx context.Context) (bool, error)
+}
+
+// Ensure FloodClient implements the interface
+var _ FloodClientInterface = (*FloodClient)(nil)
+
+// FloodClient is a client for the Flood API
+type FloodClient struct {
+	baseURL    string
+	httpClient *http.Client
+	username   string
+	password   string
+}
+
+// FloodVerify is the response of /api/auth/verify
+type FloodVerify struct {
+	InitialUser bool   `json:"initialUser"`
+	Username    string `json:"username,omitempty"`
+	Level       int    `json:"level,omitempty"`
+}
+
+// FloodConnectionSettings is how Flood connects a user to the torrent client
+type FloodConnectionSettings struct {
+	// Client is rTorrent, qBittorrent, Transmission or Deluge
+	Client  string `json:"client"`
+	Type    string `json:"type"`
+	Version int    `json:"version"`
+
+	Host   string `json:"host,omitempty"`
+	Port   int    `json:"port,omitempty"`
+	Socket string `json:"socket,omitempty"`
+	URL    string `json:"url,omitempty"`
+
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// FloodUser is a Flood user. Flood never returns passwords.
+type FloodUser struct {
+	Username string                  `json:"username"`
+	Password string                  `json:"password,omitempty"`
+	Level    int                     `json:"level"`
+	Client   FloodConnectionSettings `json:"client"`
+}
+
+// FloodUserPatch updates a Flood user
+type FloodUserPatch struct {
+	Password string                   `json:"password,omitempty"`
+	Level    int                      `json:"level,omitempty"`
+	Client   *FloodConnectionSettings `json:"client,omitempty"`
+}
+
+// NewFloodClient creates a new Flood API client authenticating as the administrator
+func NewFloodClient(baseURL, username, password string) *FloodClient {
+	jar, _ := cookiejar.New(nil)
+	return &FloodClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		username:   username,
+		password:   password,
+		httpClient: newHTTPClient(baseURL, jar),
+	}
+}
+
+// request makes a request to the Flood API, returning the body of 2xx responses
+func (c *FloodClient) request(ctx context.Context, method, endpoint string, payload interface{}) ([]byte, error) {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("HTTP error: %d - %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return respBody, nil
+}
+
+// Verify reports the session user, or that Flood has no users yet.
+// Without a session on a Flood with users, it fails with HTTP 401.
+func (c *FloodClient) Verify(ctx context.Context) (*FloodVerify, error) {
+	body, err := c.request(ctx, "GET", "/api/auth/verify", nil)
+	if err != nil {
+		return nil, err
+	}
+	var verify FloodVerify
+	if err := json.Unmarshal(body, &verify); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal verify response: %w", err)
+	}
+	return &verify, nil
+}
+
+// Authenticate logs in as the administrator; the session cookie is kept for later requests
+func (c *FloodClient) Authenticate(ctx context.Context) error {
+	_, err := c.request(ctx, "POST", "/api/auth/authenticate", map[string]string{
+		"username": c.username,
+		"password": c.password,
+	})
+	if err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+	return nil
+}
+
+// Register creates a user without switching the session to it
+func (c *FloodClient) Register(ctx context.Context, user FloodUser) error {
+	_, err := c.request(ctx, "POST", "/api/auth/register?cookie=false", user)
+	return err
+}
+
+// ListUsers lists the users
+func (c *FloodClient) ListUsers(ctx context.Context) ([]FloodUser, error) {
+	body, err := c.request(ctx, "GET", "/api/auth/users", nil)
+	if err != nil {
+		return nil, err
+	}
+	var users []FloodUser
+	if err := json.Unmarshal(body, &users); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal users: %w", err)
+	}
+	return users, nil
+}
+
+// UpdateUser updates a user
+func (c *FloodClient) UpdateUser(ctx context.Context, username string, patch FloodUserPatch) error {
+	_, err := c.request(ctx, "PATCH", "/api/auth/users/"+url.PathEscape(username), patch)
+	return err
+}
+
+// DeleteUser deletes a user
+func (c *FloodClient) DeleteUser(ctx context.Context, username string) error {
+	_, err := c.request(ctx, "DELETE", "/api/auth/users/"+url.PathEscape(username), nil)
+	return err
+}
+
+// TestClientConnection reports whether Flood reaches the administrator's torrent client
+func (c *FloodClient) TestClientConnection(ctx context.Context) (bool, error) {
+	body, err := c.request(ctx, "GET", "/api/client/connection-test", nil)
+	if err != nil {
+		return false, err
+	}
+	var result struct {
+		IsConnected bool `json:"isConnected"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return false, fmt.Errorf("failed to unmarshal connection test: %w", err)
+	}
+	return result.IsConnected, nil
+}
@@ -0,0 +1,153 @@
+package downloadstack
+
+import (
+	"context"
+	"fmt"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// FloodConnection renders a client spec as Flood connection settings.
+// username and password are the resolved client credentials, if any.
+func FloodConnection(spec *arrv1alpha1.FloodClientSpec, username, password string) FloodConnectionSettings {
+	switch spec.Type {
+	case "rtorrent":
+		if spec.Socket != "" {
+			return FloodConnectionSettings{Client: "rTorrent", Type: "socket", Version: 1, Socket: spec.Socket}
+		}
+		return FloodConnectionSettings{Client: "rTorrent", Type: "tcp", Version: 1, Host: spec.Host, Port: spec.Port}
+	case "qbittorrent":
+		return FloodConnectionSettings{Client: "qBittorrent", Type: "web", Version: 1, URL: spec.URL, Username: username, Password: password}
+	case "transmission":
+		return FloodConnectionSettings{Client: "Transmission", Type: "rpc", Version: 1, URL: spec.URL, Username: username, Password: password}
+	case "deluge":
+		return FloodConnectionSettings{Client: "Deluge", Type: "rpc", Version: 1, Host: spec.Host, Port: spec.Port, Username: username, Password: password}
+	default:
+		return FloodConnectionSettings{}
+	}
+}
+
+// EnsureFloodAdmin logs in as the administrator, creating it first when Flood
+// has no users yet (a fresh install shows a registration page until then)
+func EnsureFloodAdmin(ctx context.Context, client FloodClientInterface, admin FloodUser) error {
+	verify, err := client.Verify(ctx)
+	if err == nil && verify.InitialUser {
+		admin.Level = FloodLevelAdministrator
+		if err := client.Register(ctx, admin); err != nil {
+			return fmt.Errorf("failed to create administrator %s: %w", admin.Username, err)
+		}
+	}
+	return client.Authenticate(ctx)
+}
+
+// SyncFloodUsers creates or updates the desired users and deletes users
+// previously managed by the operator that are no longer desired. Flood never
+// returns passwords, so a user's password is only written when the user is
+// created or rotated reports it changed; rotated also covers the passwords of
+// the client connections.
+// admin is the authenticated administrator: its client connection is kept in
+// sync, but its password is left alone and it is never deleted.
+// Returns the names of the users now managed by the operator.
+func SyncFloodUsers(ctx context.Context, client FloodClientInterface, admin FloodUser, desired []FloodUser, previous []string, rotated map[string]bool) ([]string, error) {
+	current, err := client.ListUsers(ctx)
+	if err != nil {
+		return previous, fmt.Errorf("failed to get users: %w", err)
+	}
+	currentByName := make(map[string]FloodUser, len(current))
+	for _, user := range current {
+		currentByName[user.Username] = user
+	}
+
+	if existing, ok := currentByName[admin.Username]; ok && (rotated[admin.Username] || !floodUserMatches(existing, admin, FloodLevelAdministrator)) {
+		patch := FloodUserPatch{Client: &admin.Client}
+		if err := client.UpdateUser(ctx, admin.Username, patch); err != nil {
+			return previous, fmt.Errorf("failed to update administrator %s: %w", admin.Username, err)
+		}
+	}
+
+	managed := make([]string, 0, len(desired))
+	desiredNames := make(map[string]bool, len(desired))
+	for _, user := range desired {
+		desiredNames[user.Username] = true
+
+		existing, ok := currentByName[user.Username]
+		if !ok {
+			if err := client.Register(ctx, user); err != nil {
+				return unionNames(managed, previous), fmt.Errorf("failed to create user %s: %w", user.Username, err)
+			}
+			managed = append(managed, user.Username)
+			continue
+		}
+
+		if rotated[user.Username] || !floodUserMatches(existing, user, user.Level) {
+			patch := FloodUserPatch{Level: user.Level, Client: &user.Client}
+			if rotated[user.Username] {
+				patch.Password = user.Password
+			}
+			if err := client.UpdateUser(ctx, user.Username, patch); err != nil {
+				return unionNames(managed, previous), fmt.Errorf("failed to update user %s: %w", user.Username, err)
+			}
+		}
+		managed = append(managed, user.Username)
+	}
+
+	for _, name := range previous {
+		if desiredNames[name] || name == admin.Username {
+			continue
+		}
+		if _, ok := currentByName[name]; !ok {
+			continue
+		}
+		if err := client.DeleteUser(ctx, name); err != nil {
+			return unionNames(managed, previous), fmt.Errorf("failed to delete user %s: %w", name, err)
+		}
+	}
+
+	if len(managed) == 0 {
+		return nil, nil
+	}
+	return managed, nil
+}
+
+// DeleteFloodUsers deletes the users previously managed by the operator, once
+// Flood is no longer configured. Users already gone are skipped. Returns the
+// users left, so a failed deletion is retried.
+func DeleteFloodUsers(ctx context.Context, client FloodClientInterface, managed []string) ([]string, error) {
+	if err := client.Authenticate(ctx); err != nil {
+		return managed, fmt.Errorf("failed to log in: %w", err)
+	}
+	current, err := client.ListUsers(ctx)
+	if err != nil {
+		return managed, fmt.Errorf("failed to get users: %w", err)
+	}
+	exists := make(map[string]bool, len(current))
+	for _, user := range current {
+		exists[user.Username] = true
+	}
+	for i, name := range managed {
+		if !exists[name] {
+			continue
+		}
+		if err := client.DeleteUser(ctx, name); err != nil {
+			return managed[i:], fmt.Errorf("failed to delete user %s: %w", name, err)
+		}
+	}
+	return nil, nil
+}
+
+// floodUserMatches reports whether current has the desired level and client
+// connection. Client passwords are never returned, so they are not compared;
+// users listed without their connection are taken to match.
+func floodUserMatches(current, desired FloodUser, level int) bool {
+	if current.Level != level {
+		return false
+	}
+	if current.Client.Client == "" {
+		return true
+	}
+	want := desired.Client
+	want.Password = ""
+	got := current.Client
+	got.Password = ""
+	return got == want
+}
@@ -0,0 +1,187 @@
+package downloadstack
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// fakeFloodClient serves a user list and records user writes
+type fakeFloodClient struct {
+	FloodClientInterface
+	initialUser   bool
+	users         []FloodUser
+	registered    []FloodUser
+	updates       map[string]FloodUserPatch
+	deletes       []string
+	authenticated bool
+}
+
+func (f *fakeFloodClient) Verify(_ context.Context) (*FloodVerify, error) {
+	if f.initialUser {
+		return &FloodVerify{InitialUser: true}, nil
+	}
+	return nil, errors.New("HTTP error: 401 - Unauthorized")
+}
+
+func (f *fakeFloodClient) Authenticate(_ context.Context) error {
+	f.authenticated = true
+	return nil
+}
+
+func (f *fakeFloodClient) Register(_ context.Context, user FloodUser) error {
+	f.registered = append(f.registered, user)
+	return nil
+}
+
+func (f *fakeFloodClient) ListUsers(_ context.Context) ([]FloodUser, error) {
+	return f.users, nil
+}
+
+func (f *fakeFloodClient) UpdateUser(_ context.Context, username string, patch FloodUserPatch) error {
+	if f.updates == nil {
+		f.updates = make(map[string]FloodUserPatch)
+	}
+	f.updates[username] = patch
+	return nil
+}
+
+func (f *fakeFloodClient) DeleteUser(_ context.Context, username string) error {
+	f.deletes = append(f.deletes, username)
+	return nil
+}
+
+var testFloodRTorrent = FloodConnectionSettings{Client: "rTorrent", Type: "tcp", Version: 1, Host: "localhost", Port: 5000}
+
+func TestSyncFloodUsers(t *testing.T) {
+	admin := FloodUser{Username: "admin", Client: testFloodRTorrent}
+	client := &fakeFloodClient{users: []FloodUser{
+		{Username: "admin", Level: FloodLevelAdministrator, Client: testFloodRTorrent},
+		{Username: "alice", Level: FloodLevelUser, Client: testFloodRTorrent},
+		{Username: "bob", Level: FloodLevelUser, Client: testFloodRTorrent},
+		{Username: "old", Level: FloodLevelUser},
+		{Username: "manual", Level: FloodLevelUser},
+	}}
+
+	desired := []FloodUser{
+		{Username: "alice", Password: "a", Level: FloodLevelUser, Client: testFloodRTorrent},
+		{Username: "bob", Password: "b", Level: FloodLevelAdministrator, Client: testFloodRTorrent},
+		{Username: "carol", Password: "c", Level: FloodLevelUser, Client: testFloodRTorrent},
+	}
+	managed, err := SyncFloodUsers(context.Background(), client, admin, desired,
+		[]string{"alice", "old", "gone"}, map[string]bool{"alice": false, "carol": true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(managed, []string{"alice", "bob", "carol"}) {
+		t.Errorf("expected managed [alice bob carol], got %v", managed)
+	}
+	if len(client.registered) != 1 || client.registered[0].Username != "carol" || client.registered[0].Password != "c" {
+		t.Errorf("expected carol registered with her password, got %+v", client.registered)
+	}
+	if _, ok := client.updates["alice"]; ok {
+		t.Error("expected unchanged alice to be left alone")
+	}
+	if patch, ok := client.updates["bob"]; !ok || patch.Level != FloodLevelAdministrator || patch.Password != "" {
+		t.Errorf("expected bob promoted without a password write, got %+v", patch)
+	}
+	if _, ok := client.updates["admin"]; ok {
+		t.Error("expected the unchanged administrator to be left alone")
+	}
+	if !reflect.DeepEqual(client.deletes, []string{"old"}) {
+		t.Errorf("expected only the managed user pruned, got %v", client.deletes)
+	}
+}
+
+func TestSyncFloodUsersRotation(t *testing.T) {
+	moved := FloodConnectionSettings{Client: "rTorrent", Type: "socket", Version: 1, Socket: "/run/rtorrent.sock"}
+	admin := FloodUser{Username: "admin", Client: moved}
+	client := &fakeFloodClient{users: []FloodUser{
+		{Username: "admin", Level: FloodLevelAdministrator, Client: testFloodRTorrent},
+		{Username: "alice", Level: FloodLevelUser, Client: moved},
+	}}
+
+	_, err := SyncFloodUsers(context.Background(), client, admin,
+		[]FloodUser{{Username: "alice", Password: "new", Level: FloodLevelUser, Client: moved}},
+		[]string{"alice", "admin"}, map[string]bool{"alice": true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patch := client.updates["alice"]; patch.Password != "new" {
+		t.Errorf("expected the rotated password written, got %+v", patch)
+	}
+	if patch, ok := client.updates["admin"]; !ok || patch.Password != "" || patch.Client == nil || *patch.Client != moved {
+		t.Errorf("expected only the administrator's client updated, got %+v", patch)
+	}
+	if len(client.deletes) != 0 {
+		t.Errorf("expected the administrator never deleted, got %v", client.deletes)
+	}
+}
+
+func TestDeleteFloodUsers(t *testing.T) {
+	client := &fakeFloodClient{users: []FloodUser{
+		{Username: "admin", Level: FloodLevelAdministrator},
+		{Username: "alice", Level: FloodLevelUser},
+		{Username: "manual", Level: FloodLevelUser},
+	}}
+
+	left, err := DeleteFloodUsers(context.Background(), client, []string{"alice", "bob"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if left != nil {
+		t.Errorf("expected no users left, got %v", left)
+	}
+	if !client.authenticated {
+		t.Error("expected a login before deleting")
+	}
+	if !reflect.DeepEqual(client.deletes, []string{"alice"}) {
+		t.Errorf("expected only the managed user still in Flood deleted, got %v", client.deletes)
+	}
+}
+
+func TestEnsureFloodAdmin(t *testing.T) {
+	admin := FloodUser{Username: "admin", Password: "secret", Client: testFloodRTorrent}
+
+	fresh := &fakeFloodClient{initialUser: true}
+	if err := EnsureFloodAdmin(context.Background(), fresh, admin); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fresh.registered) != 1 || fresh.registered[0].Level != FloodLevelAdministrator {
+		t.Errorf("expected the administrator registered on a fresh Flood, got %+v", fresh.registered)
+	}
+	if !fresh.authenticated {
+		t.Error("expected a login after registering")
+	}
+
+	existing := &fakeFloodClient{}
+	if err := EnsureFloodAdmin(context.Background(), existing, admin); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(existing.registered) != 0 || !existing.authenticated {
+		t.Errorf("expected a plain login, got registered=%+v authenticated=%v", existing.registered, existing.authenticated)
+	}
+}
+
+func TestFloodConnection(t *testing.T) {
+	tests := []struct {
+		spec arrv1alpha1.FloodClientSpec
+		want FloodConnectionSettings
+	}{
+		{arrv1alpha1.FloodClientSpec{Type: "rtorrent", Host: "localhost", Port: 5000}, testFloodRTorrent},
+		{arrv1alpha1.FloodClientSpec{Type: "rtorrent", Socket: "/run/rtorrent.sock", Host: "ignored"},
+			FloodConnectionSettings{Client: "rTorrent", Type: "socket", Version: 1, Socket: "/run/rtorrent.sock"}},
+		{arrv1alpha1.FloodClientSpec{Type: "qbittorrent", URL: "http://localhost:8080"},
+			FloodConnectionSettings{Client: "qBittorrent", Type: "web", Version: 1, URL: "http://localhost:8080", Username: "u", Password: "p"}},
+		{arrv1alpha1.FloodClientSpec{Type: "deluge", Host: "localhost", Port: 58846},
+			FloodConnectionSettings{Client: "Deluge", Type: "rpc", Version: 1, Host: "localhost", Port: 58846, Username: "u", Password: "p"}},
+	}
+	for _, tt := range tests {
+		if got := FloodConnection(&tt.spec, "u", "p"); got != tt.want {
+			t.Errorf("FloodConnection(%+v) = %+v, want %+v", tt.spec, got, tt.want)
+		}
+	}
+}
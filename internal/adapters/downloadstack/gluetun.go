@@ -4,6 +4,7 @@ package downloadstack
 import (
 	"crypto/sha256"
 	"fmt"
+	"net"
 	"sort"
 	"strings"
 
@@ -15,9 +16,10 @@ type GluetunEnvInput struct {
 	Spec *arrv1alpha1.GluetunSpec
 
 	// Resolved credentials
-	Username   string
-	Password   string
-	PrivateKey string // For WireGuard
+	Username     string
+	Password     string
+	PrivateKey   string // For WireGuard
+	PresharedKey string // For WireGuard
 }
 
 // GenerateGluetunEnv generates environment variables for Gluetun container
@@ -34,6 +36,10 @@ func GenerateGluetunEnv(input *GluetunEnvInput) map[string]string {
 		if input.PrivateKey != "" {
 			env["WIREGUARD_PRIVATE_KEY"] = input.PrivateKey
 		}
+		if input.PresharedKey != "" {
+			env["WIREGUARD_PRESHARED_KEY"] = input.PresharedKey
+		}
+		addWireGuardEnv(env, spec.WireGuard)
 	} else {
 		// OpenVPN
 		if input.Username != "" {
@@ -98,6 +104,14 @@ func GenerateGluetunEnv(input *GluetunEnvInput) map[string]string {
 		if spec.DNS.KeepNameserver {
 			env["DNS_KEEP_NAMESERVER"] = "on"
 		}
+		addDNSBlocklistEnv(env, spec.DNS)
+		if len(spec.DNS.Providers) > 0 {
+			env["DOT_PROVIDERS"] = strings.Join(spec.DNS.Providers, ",")
+		}
+		if len(spec.DNS.UpstreamAddresses) > 0 {
+			env["DNS_UPSTREAM_RESOLVER_TYPE"] = "plain"
+			env["DNS_UPSTREAM_PLAIN_ADDRESSES"] = strings.Join(UpstreamDNSAddresses(spec.DNS.UpstreamAddresses), ",")
+		}
 	}
 
 	// IPv6
@@ -117,6 +131,65 @@ func GenerateGluetunEnv(input *GluetunEnvInput) map[string]string {
 	return env
 }
 
+// addWireGuardEnv adds the WireGuard tunnel settings
+func addWireGuardEnv(env map[string]string, wg *arrv1alpha1.GluetunWireGuardSpec) {
+	if wg == nil {
+		return
+	}
+	if len(wg.Addresses) > 0 {
+		env["WIREGUARD_ADDRESSES"] = strings.Join(wg.Addresses, ",")
+	}
+	if wg.MTU != nil {
+		env["WIREGUARD_MTU"] = fmt.Sprintf("%d", *wg.MTU)
+	}
+	if wg.PublicKey != "" {
+		env["WIREGUARD_PUBLIC_KEY"] = wg.PublicKey
+	}
+	if wg.EndpointIP != "" {
+		env["VPN_ENDPOINT_IP"] = wg.EndpointIP
+	}
+	if wg.EndpointPort != nil {
+		env["VPN_ENDPOINT_PORT"] = fmt.Sprintf("%d", *wg.EndpointPort)
+	}
+	if len(wg.AllowedIPs) > 0 {
+		env["WIREGUARD_ALLOWED_IPS"] = strings.Join(wg.AllowedIPs, ",")
+	}
+}
+
+// addDNSBlocklistEnv adds the blocklists that are set, leaving Gluetun's defaults for the rest
+func addDNSBlocklistEnv(env map[string]string, dns *arrv1alpha1.GluetunDNSSpec) {
+	for key, value := range map[string]*bool{
+		"BLOCK_MALICIOUS":    dns.BlockMalicious,
+		"BLOCK_ADS":          dns.BlockAds,
+		"BLOCK_SURVEILLANCE": dns.BlockSurveillance,
+	} {
+		if value != nil {
+			env[key] = onOff(*value)
+		}
+	}
+}
+
+// UpstreamDNSAddresses returns the upstream DNS addresses as IP:port, adding
+// port 53 where it is omitted. Addresses that are not an IP are left as they are.
+func UpstreamDNSAddresses(addresses []string) []string {
+	result := make([]string, len(addresses))
+	for i, address := range addresses {
+		result[i] = address
+		if ip := net.ParseIP(strings.Trim(address, "[]")); ip != nil {
+			result[i] = net.JoinHostPort(ip.String(), "53")
+		}
+	}
+	return result
+}
+
+// onOff formats a boolean as a Gluetun on/off value
+func onOff(value bool) string {
+	if value {
+		return "on"
+	}
+	return "off"
+}
+
 // HashGluetunEnv computes a hash of the env map for change detection
 func HashGluetunEnv(env map[string]string) string {
 	// Sort keys for deterministic ordering
@@ -0,0 +1,277 @@
+package downloadstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultGluetunServersURL is Gluetun's published server list
+	DefaultGluetunServersURL = "https://raw.githubusercontent.com/qdm12/gluetun/master/internal/storage/servers.json"
+
+	// DefaultGluetunServersTTL is how long a fetched server list is used before it is fetched again
+	DefaultGluetunServersTTL = 24 * time.Hour
+
+	// DefaultGluetunServersRetry is how long a failed fetch is remembered before the list is fetched again
+	DefaultGluetunServersRetry = 15 * time.Minute
+)
+
+// GluetunServer is a VPN server from Gluetun's server list
+type GluetunServer struct {
+	VPN      string `json:"vpn"`
+	Country  string `json:"country"`
+	Region   string `json:"region"`
+	City     string `json:"city"`
+	Hostname string `json:"hostname"`
+}
+
+// GluetunServerCatalog holds the servers of each provider, keyed by the
+// lowercase provider name Gluetun expects in VPN_SERVICE_PROVIDER
+type GluetunServerCatalog map[string][]GluetunServer
+
+// ParseGluetunServers parses Gluetun's servers.json. Besides one object per
+// provider, the file has a top-level format version, which is skipped.
+func ParseGluetunServers(data []byte) (GluetunServerCatalog, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse Gluetun server list: %w", err)
+	}
+
+	catalog := make(GluetunServerCatalog, len(raw))
+	for provider, msg := range raw {
+		var p struct {
+			Servers []GluetunServer `json:"servers"`
+		}
+		if err := json.Unmarshal(msg, &p); err != nil {
+			continue
+		}
+		catalog[strings.ToLower(provider)] = p.Servers
+	}
+	return catalog, nil
+}
+
+// GluetunServerCheck is the result of checking a server selection against the catalog
+type GluetunServerCheck struct {
+	// Known is false when the provider isn't in the catalog (e.g., custom)
+	Known bool
+
+	// Matches is the number of servers matching the whole selection
+	Matches int
+
+	// Problems lists selection values that match no server of the provider
+	Problems []string
+}
+
+// CheckGluetunServers matches the server selection of spec against the
+// provider's servers the way Gluetun does: values of one filter are
+// alternatives, and a server has to pass every filter that is set.
+// Matching is case-insensitive.
+func CheckGluetunServers(catalog GluetunServerCatalog, spec *arrv1alpha1.GluetunSpec) GluetunServerCheck {
+	servers, ok := catalog[strings.ToLower(spec.Provider.Name)]
+	if !ok {
+		return GluetunServerCheck{}
+	}
+
+	vpnType := spec.VPNType
+	if vpnType == "" {
+		vpnType = "openvpn"
+	}
+	var candidates []GluetunServer
+	for _, s := range servers {
+		if s.VPN == "" || strings.EqualFold(s.VPN, vpnType) {
+			candidates = append(candidates, s)
+		}
+	}
+
+	check := GluetunServerCheck{Known: true}
+	filters := gluetunServerFilters(spec.Server)
+	for _, f := range filters {
+		for _, value := range f.values {
+			if !anyServer(candidates, func(s GluetunServer) bool { return strings.EqualFold(f.field(s), value) }) {
+				check.Problems = append(check.Problems,
+					fmt.Sprintf("%s %q matches no %s %s server", f.name, value, spec.Provider.Name, vpnType))
+			}
+		}
+	}
+
+	for _, s := range candidates {
+		if matchesGluetunFilters(s, filters) {
+			check.Matches++
+		}
+	}
+	return check
+}
+
+// gluetunServerFilter is one server selection field with the server attribute it matches
+type gluetunServerFilter struct {
+	name   string
+	values []string
+	field  func(GluetunServer) string
+}
+
+// gluetunServerFilters returns the filters set in the server selection
+func gluetunServerFilters(server *arrv1alpha1.GluetunServerSpec) []gluetunServerFilter {
+	if server == nil {
+		return nil
+	}
+	all := []gluetunServerFilter{
+		{"region", server.Regions, func(s GluetunServer) string { return s.Region }},
+		{"country", server.Countries, func(s GluetunServer) string { return s.Country }},
+		{"city", server.Cities, func(s GluetunServer) string { return s.City }},
+		{"hostname", server.Hostnames, func(s GluetunServer) string { return s.Hostname }},
+	}
+	var set []gluetunServerFilter
+	for _, f := range all {
+		if len(f.values) > 0 {
+			set = append(set, f)
+		}
+	}
+	return set
+}
+
+// matchesGluetunFilters reports whether a server passes every filter
+func matchesGluetunFilters(s GluetunServer, filters []gluetunServerFilter) bool {
+	for _, f := range filters {
+		value := f.field(s)
+		match := false
+		for _, v := range f.values {
+			if strings.EqualFold(value, v) {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// anyServer reports whether any server satisfies pred
+func anyServer(servers []GluetunServer, pred func(GluetunServer) bool) bool {
+	for _, s := range servers {
+		if pred(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// GluetunServerCache fetches Gluetun's server list and keeps it for TTL.
+// When a refresh fails, the previous list keeps being served and the fetch
+// isn't retried for RetryAfter. Only one fetch runs at a time, and it runs
+// without holding the lock, so readers of a cached list never wait on it.
+type GluetunServerCache struct {
+	URL        string
+	TTL        time.Duration
+	RetryAfter time.Duration
+
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	catalog   GluetunServerCatalog
+	fetchedAt time.Time
+	fetchErr  error
+	failedAt  time.Time
+	fetching  chan struct{}
+}
+
+// NewGluetunServerCache creates a cache for the server list at url
+func NewGluetunServerCache(url string) *GluetunServerCache {
+	return &GluetunServerCache{
+		URL:        url,
+		TTL:        DefaultGluetunServersTTL,
+		RetryAfter: DefaultGluetunServersRetry,
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// Get returns the cached server list, fetching it when it is missing or stale
+func (c *GluetunServerCache) Get(ctx context.Context) (GluetunServerCatalog, error) {
+	c.mu.Lock()
+	if c.catalog != nil && time.Since(c.fetchedAt) < c.TTL {
+		defer c.mu.Unlock()
+		return c.catalog, nil
+	}
+	if c.fetchErr != nil && time.Since(c.failedAt) < c.RetryAfter {
+		defer c.mu.Unlock()
+		return c.stale()
+	}
+	if done := c.fetching; done != nil {
+		// Serve the stale list while another caller refreshes it, or wait for the first fetch
+		catalog := c.catalog
+		c.mu.Unlock()
+		if catalog != nil {
+			return catalog, nil
+		}
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.catalog != nil {
+			return c.catalog, nil
+		}
+		return nil, c.fetchErr
+	}
+	done := make(chan struct{})
+	c.fetching = done
+	c.mu.Unlock()
+
+	catalog, err := c.fetch(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fetching = nil
+	close(done)
+	if err != nil {
+		c.fetchErr = err
+		c.failedAt = time.Now()
+		return c.stale()
+	}
+	c.catalog = catalog
+	c.fetchedAt = time.Now()
+	c.fetchErr = nil
+	return catalog, nil
+}
+
+// stale returns the previous list, or the last fetch error when there is none.
+// The caller holds the lock.
+func (c *GluetunServerCache) stale() (GluetunServerCatalog, error) {
+	if c.catalog != nil {
+		return c.catalog, nil
+	}
+	return nil, c.fetchErr
+}
+
+// fetch downloads and parses the server list
+func (c *GluetunServerCache) fetch(ctx context.Context) (GluetunServerCatalog, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Gluetun server list: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch Gluetun server list: HTTP %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Gluetun server list: %w", err)
+	}
+	return ParseGluetunServers(data)
+}
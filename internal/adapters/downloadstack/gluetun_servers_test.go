@@ -0,0 +1,133 @@
+package downloadstack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+const testGluetunServers = `{
+  "version": 1,
+  "mullvad": {"version": 3, "timestamp": 1700000000, "servers": [
+    {"vpn": "wireguard", "country": "Netherlands", "city": "Amsterdam", "hostname": "nl-ams-wg-001"},
+    {"vpn": "openvpn", "country": "Netherlands", "city": "Amsterdam", "hostname": "nl-ams-ovpn-001"},
+    {"vpn": "wireguard", "country": "Germany", "city": "Frankfurt", "hostname": "de-fra-wg-001"}
+  ]}
+}`
+
+func TestCheckGluetunServers(t *testing.T) {
+	catalog, err := ParseGluetunServers([]byte(testGluetunServers))
+	if err != nil {
+		t.Fatalf("ParseGluetunServers() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		spec         arrv1alpha1.GluetunSpec
+		wantKnown    bool
+		wantMatches  int
+		wantProblems int
+	}{
+		{
+			name:        "countries match case-insensitively",
+			spec:        gluetunSpec("Mullvad", "wireguard", &arrv1alpha1.GluetunServerSpec{Countries: []string{"netherlands", "Germany"}}),
+			wantKnown:   true,
+			wantMatches: 2,
+		},
+		{
+			name:         "typo matches nothing",
+			spec:         gluetunSpec("mullvad", "wireguard", &arrv1alpha1.GluetunServerSpec{Countries: []string{"Netherland"}}),
+			wantKnown:    true,
+			wantProblems: 1,
+		},
+		{
+			name:      "known values whose combination matches nothing",
+			spec:      gluetunSpec("mullvad", "wireguard", &arrv1alpha1.GluetunServerSpec{Countries: []string{"Germany"}, Cities: []string{"Amsterdam"}}),
+			wantKnown: true,
+		},
+		{
+			name:         "hostname of another VPN type",
+			spec:         gluetunSpec("mullvad", "", &arrv1alpha1.GluetunServerSpec{Hostnames: []string{"de-fra-wg-001"}}),
+			wantKnown:    true,
+			wantProblems: 1,
+		},
+		{
+			name: "unknown provider is skipped",
+			spec: gluetunSpec("custom", "wireguard", &arrv1alpha1.GluetunServerSpec{Countries: []string{"Narnia"}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CheckGluetunServers(catalog, &tt.spec)
+			if got.Known != tt.wantKnown || got.Matches != tt.wantMatches || len(got.Problems) != tt.wantProblems {
+				t.Errorf("CheckGluetunServers() = %+v, want known=%v matches=%d problems=%d",
+					got, tt.wantKnown, tt.wantMatches, tt.wantProblems)
+			}
+		})
+	}
+}
+
+func TestGluetunServerCacheKeepsStaleList(t *testing.T) {
+	var fail atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(testGluetunServers))
+	}))
+	defer server.Close()
+
+	cache := NewGluetunServerCache(server.URL)
+	if _, err := cache.Get(context.Background()); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	fail.Store(true)
+	cache.TTL = 0
+	catalog, err := cache.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get() with a stale list error = %v", err)
+	}
+	if len(catalog["mullvad"]) != 3 {
+		t.Errorf("Get() returned %d mullvad servers, want 3", len(catalog["mullvad"]))
+	}
+}
+
+func TestGluetunServerCacheBacksOffAfterFailure(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	cache := NewGluetunServerCache(server.URL)
+	for range 3 {
+		if _, err := cache.Get(context.Background()); err == nil {
+			t.Fatal("Get() error = nil, want the fetch error")
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("server got %d requests, want 1 while backing off", got)
+	}
+
+	cache.RetryAfter = 0
+	_, _ = cache.Get(context.Background())
+	if got := requests.Load(); got != 2 {
+		t.Errorf("server got %d requests, want 2 after the backoff", got)
+	}
+}
+
+func gluetunSpec(provider, vpnType string, server *arrv1alpha1.GluetunServerSpec) arrv1alpha1.GluetunSpec {
+	return arrv1alpha1.GluetunSpec{
+		Provider: arrv1alpha1.GluetunProviderSpec{Name: provider},
+		VPNType:  vpnType,
+		Server:   server,
+	}
+}
@@ -0,0 +1,120 @@
+package downloadstack
+
+import (
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+func TestGenerateGluetunEnvWireGuard(t *testing.T) {
+	mtu := 1280
+	port := 51820
+	wireGuard := &arrv1alpha1.GluetunWireGuardSpec{
+		Addresses:    []string{"10.2.0.2/32", "fd00::2/128"},
+		MTU:          &mtu,
+		PublicKey:    "server-public-key",
+		EndpointIP:   "203.0.113.10",
+		EndpointPort: &port,
+	}
+
+	tests := []struct {
+		name    string
+		vpnType string
+		want    map[string]string
+		absent  []string
+	}{
+		{
+			name:    "wireguard emits tunnel settings",
+			vpnType: "wireguard",
+			want: map[string]string{
+				"WIREGUARD_PRIVATE_KEY":   "private",
+				"WIREGUARD_PRESHARED_KEY": "preshared",
+				"WIREGUARD_ADDRESSES":     "10.2.0.2/32,fd00::2/128",
+				"WIREGUARD_MTU":           "1280",
+				"WIREGUARD_PUBLIC_KEY":    "server-public-key",
+				"VPN_ENDPOINT_IP":         "203.0.113.10",
+				"VPN_ENDPOINT_PORT":       "51820",
+			},
+			absent: []string{"WIREGUARD_ALLOWED_IPS"},
+		},
+		{
+			name:    "openvpn ignores wireguard settings",
+			vpnType: "openvpn",
+			absent:  []string{"WIREGUARD_PRIVATE_KEY", "WIREGUARD_PRESHARED_KEY", "WIREGUARD_ADDRESSES", "VPN_ENDPOINT_IP"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := GenerateGluetunEnv(&GluetunEnvInput{
+				Spec: &arrv1alpha1.GluetunSpec{
+					Provider:  arrv1alpha1.GluetunProviderSpec{Name: "custom"},
+					VPNType:   tt.vpnType,
+					WireGuard: wireGuard,
+				},
+				PrivateKey:   "private",
+				PresharedKey: "preshared",
+			})
+
+			for key, want := range tt.want {
+				if got := env[key]; got != want {
+					t.Errorf("%s = %q, want %q", key, got, want)
+				}
+			}
+			for _, key := range tt.absent {
+				if _, ok := env[key]; ok {
+					t.Errorf("%s should not be set", key)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateGluetunEnvDNS(t *testing.T) {
+	spec := &arrv1alpha1.GluetunSpec{
+		Provider: arrv1alpha1.GluetunProviderSpec{Name: "mullvad"},
+		VPNType:  "wireguard",
+		DNS: &arrv1alpha1.GluetunDNSSpec{
+			OverTLS:        true,
+			BlockMalicious: ptr.To(false),
+			BlockAds:       ptr.To(true),
+			Providers:      []string{"quad9", "cloudflare"},
+		},
+	}
+	env := GenerateGluetunEnv(&GluetunEnvInput{Spec: spec})
+
+	want := map[string]string{
+		"DOT":             "on",
+		"DOT_PROVIDERS":   "quad9,cloudflare",
+		"BLOCK_MALICIOUS": "off",
+		"BLOCK_ADS":       "on",
+	}
+	for key, value := range want {
+		if env[key] != value {
+			t.Errorf("%s = %q, want %q", key, env[key], value)
+		}
+	}
+	for _, key := range []string{"BLOCK_SURVEILLANCE", "DNS_UPSTREAM_RESOLVER_TYPE", "DNS_UPSTREAM_PLAIN_ADDRESSES"} {
+		if _, ok := env[key]; ok {
+			t.Errorf("%s should not be set", key)
+		}
+	}
+
+	// Changing a blocklist restarts Gluetun
+	hash := HashGluetunEnv(env)
+	spec.DNS.BlockSurveillance = ptr.To(true)
+	if HashGluetunEnv(GenerateGluetunEnv(&GluetunEnvInput{Spec: spec})) == hash {
+		t.Error("expected the env hash to change with the blocklists")
+	}
+
+	spec.DNS = &arrv1alpha1.GluetunDNSSpec{UpstreamAddresses: []string{"10.96.0.10", "fd00::10", "192.168.1.1:5353"}}
+	env = GenerateGluetunEnv(&GluetunEnvInput{Spec: spec})
+	if env["DNS_UPSTREAM_RESOLVER_TYPE"] != "plain" {
+		t.Errorf("DNS_UPSTREAM_RESOLVER_TYPE = %q, want plain", env["DNS_UPSTREAM_RESOLVER_TYPE"])
+	}
+	if got, want := env["DNS_UPSTREAM_PLAIN_ADDRESSES"], "10.96.0.10:53,[fd00::10]:53,192.168.1.1:5353"; got != want {
+		t.Errorf("DNS_UPSTREAM_PLAIN_ADDRESSES = %q, want %q", got, want)
+	}
+}
@@ -30,6 +30,7 @@ type MockTransmissionClient struct {
 	SetSessionFunc      func(ctx context.Context, settings map[string]interface{}) error
 	GetSessionStatsFunc func(ctx context.Context) (map[string]interface{}, error)
 	UpdateBlocklistFunc func(ctx context.Context) error
+	GetTorrentsFunc     func(ctx context.Context) ([]TransmissionTorrent, error)
 
 	// Call tracking
 	mu                   sync.Mutex
@@ -38,6 +39,9 @@ type MockTransmissionClient struct {
 	SetSessionCalls      []map[string]interface{}
 	GetSessionStatsCalls int
 	UpdateBlocklistCalls int
+	LabelCalls           map[int][]string
+	MoveCalls            map[string][]int
+	RemoveCalls          [][]int
 }
 
 // Ensure MockTransmissionClient implements the interface
@@ -130,6 +134,47 @@ func (m *MockTransmissionClient) UpdateBlocklist(ctx context.Context) error {
 	return nil
 }
 
+// GetTorrents lists the torrents (none by default).
+func (m *MockTransmissionClient) GetTorrents(ctx context.Context) ([]TransmissionTorrent, error) {
+	if m.GetTorrentsFunc != nil {
+		return m.GetTorrentsFunc(ctx)
+	}
+	return nil, nil
+}
+
+// SetTorrentLabels records the labels set on a torrent.
+func (m *MockTransmissionClient) SetTorrentLabels(_ context.Context, id int, labels []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.LabelCalls == nil {
+		m.LabelCalls = make(map[int][]string)
+	}
+	m.LabelCalls[id] = labels
+	return nil
+}
+
+// MoveTorrents records the torrents moved to each location.
+func (m *MockTransmissionClient) MoveTorrents(_ context.Context, ids []int, location string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.MoveCalls == nil {
+		m.MoveCalls = make(map[string][]int)
+	}
+	m.MoveCalls[location] = append(m.MoveCalls[location], ids...)
+	return nil
+}
+
+// RemoveTorrents records the removed torrents.
+func (m *MockTransmissionClient) RemoveTorrents(_ context.Context, ids []int, _ bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.RemoveCalls = append(m.RemoveCalls, ids)
+	return nil
+}
+
 // Reset clears all call tracking data.
 func (m *MockTransmissionClient) Reset() {
 	m.mu.Lock()
@@ -140,6 +185,9 @@ func (m *MockTransmissionClient) Reset() {
 	m.SetSessionCalls = make([]map[string]interface{}, 0)
 	m.GetSessionStatsCalls = 0
 	m.UpdateBlocklistCalls = 0
+	m.LabelCalls = nil
+	m.MoveCalls = nil
+	m.RemoveCalls = nil
 }
 
 // WithConnectionError configures the mock to return an error on TestConnection.
@@ -158,6 +206,14 @@ func (m *MockTransmissionClient) WithSession(session *TransmissionSession) *Mock
 	return m
 }
 
+// WithTorrents configures the mock to list specific torrents.
+func (m *MockTransmissionClient) WithTorrents(torrents []TransmissionTorrent) *MockTransmissionClient {
+	m.GetTorrentsFunc = func(ctx context.Context) ([]TransmissionTorrent, error) {
+		return torrents, nil
+	}
+	return m
+}
+
 // WithSetSessionError configures the mock to return an error on SetSession.
 func (m *MockTransmissionClient) WithSetSessionError(err error) *MockTransmissionClient {
 	m.SetSessionFunc = func(ctx context.Context, settings map[string]interface{}) error {
@@ -0,0 +1,139 @@
+package downloadstack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NewsServer is a usenet provider server resolved from a NewsServerPolicy,
+// rendered the same way into every SABnzbd and NZBGet client that references it
+type NewsServer struct {
+	// Name is the server name in the client (the NewsServerPolicy name)
+	Name string
+
+	Host        string
+	Port        int
+	UseTLS      bool
+	Connections int
+
+	// Tier is the SABnzbd priority and the NZBGet level (0 = primary)
+	Tier int
+
+	// Retention in days (0 = unlimited)
+	Retention int
+	Enabled   bool
+
+	Username string
+	Password string
+}
+
+// sabnzbdServerValues maps a server to SABnzbd server keywords, without the password
+func sabnzbdServerValues(server NewsServer) map[string]string {
+	return map[string]string{
+		"host":        server.Host,
+		"port":        strconv.Itoa(server.Port),
+		"username":    server.Username,
+		"connections": strconv.Itoa(server.Connections),
+		"ssl":         boolToSABnzbd(server.UseTLS),
+		"priority":    strconv.Itoa(server.Tier),
+		"retention":   strconv.Itoa(server.Retention),
+		"enable":      boolToSABnzbd(server.Enabled),
+	}
+}
+
+// boolToSABnzbd renders a switch the way SABnzbd stores it
+func boolToSABnzbd(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// SyncSABnzbdServers creates or updates the desired servers and deletes servers
+// previously managed by the operator that are no longer desired. SABnzbd masks
+// passwords, so a server's password is only written when the server is created,
+// another setting drifted, or rotated reports it changed.
+// Returns the names of the servers now managed by the operator.
+func SyncSABnzbdServers(ctx context.Context, client SABnzbdClientInterface, desired []NewsServer, previous []string, rotated map[string]bool) ([]string, error) {
+	if len(desired) == 0 && len(previous) == 0 {
+		return nil, nil
+	}
+
+	current, err := client.GetServers(ctx)
+	if err != nil {
+		return previous, fmt.Errorf("failed to get servers: %w", err)
+	}
+	currentByName := make(map[string]map[string]string, len(current))
+	for _, server := range current {
+		currentByName[strings.ToLower(server["name"])] = server
+	}
+
+	managed := make([]string, 0, len(desired))
+	desiredNames := make(map[string]bool, len(desired))
+	for _, server := range desired {
+		desiredNames[strings.ToLower(server.Name)] = true
+
+		want := sabnzbdServerValues(server)
+		existing, ok := currentByName[strings.ToLower(server.Name)]
+		if ok && !rotated[server.Name] && sabnzbdServerMatches(existing, want) {
+			managed = append(managed, server.Name)
+			continue
+		}
+
+		want["password"] = server.Password
+		if err := client.SetServer(ctx, server.Name, want); err != nil {
+			return unionNames(managed, previous), fmt.Errorf("failed to set server %s: %w", server.Name, err)
+		}
+		managed = append(managed, server.Name)
+	}
+
+	for _, name := range previous {
+		lower := strings.ToLower(name)
+		if desiredNames[lower] {
+			continue
+		}
+		if _, ok := currentByName[lower]; !ok {
+			continue
+		}
+		if err := client.DeleteServer(ctx, name); err != nil {
+			return unionNames(managed, previous), fmt.Errorf("failed to delete server %s: %w", name, err)
+		}
+	}
+
+	if len(managed) == 0 {
+		return nil, nil
+	}
+	return managed, nil
+}
+
+// sabnzbdServerMatches reports whether every desired keyword has its value.
+// Hosts compare case-insensitively.
+func sabnzbdServerMatches(current, desired map[string]string) bool {
+	for key, want := range desired {
+		if key == "host" {
+			if !strings.EqualFold(current[key], want) {
+				return false
+			}
+			continue
+		}
+		if current[key] != want {
+			return false
+		}
+	}
+	return true
+}
+
+// unionNames merges name lists, dropping case-insensitive duplicates
+func unionNames(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var result []string
+	for _, name := range append(append([]string{}, a...), b...) {
+		if !seen[strings.ToLower(name)] {
+			seen[strings.ToLower(name)] = true
+			result = append(result, name)
+		}
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+package downloadstack
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// fakeSABnzbdServerClient serves a server list and records server writes
+type fakeSABnzbdServerClient struct {
+	SABnzbdClientInterface
+	servers []map[string]string
+	sets    []string
+	deletes []string
+}
+
+func (f *fakeSABnzbdServerClient) GetServers(_ context.Context) ([]map[string]string, error) {
+	return f.servers, nil
+}
+
+func (f *fakeSABnzbdServerClient) SetServer(_ context.Context, name string, values map[string]string) error {
+	f.sets = append(f.sets, name)
+	return nil
+}
+
+func (f *fakeSABnzbdServerClient) DeleteServer(_ context.Context, name string) error {
+	f.deletes = append(f.deletes, name)
+	return nil
+}
+
+func testNewsServer(name string) NewsServer {
+	return NewsServer{
+		Name: name, Host: name + ".example.com", Port: 563, UseTLS: true,
+		Connections: 8, Enabled: true, Username: "user", Password: "secret",
+	}
+}
+
+func TestSyncSABnzbdServers(t *testing.T) {
+	primary := testNewsServer("primary")
+	client := &fakeSABnzbdServerClient{servers: []map[string]string{
+		{"name": "primary", "host": "PRIMARY.example.com", "port": "563", "username": "user", "password": "**********",
+			"connections": "8", "ssl": "1", "priority": "0", "retention": "0", "enable": "1"},
+		{"name": "block", "host": "block.example.com"},
+		{"name": "manual", "host": "manual.example.com"},
+	}}
+
+	managed, err := SyncSABnzbdServers(context.Background(), client,
+		[]NewsServer{primary, testNewsServer("fill")}, []string{"primary", "block"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(managed, []string{"primary", "fill"}) {
+		t.Errorf("expected managed [primary fill], got %v", managed)
+	}
+	if !reflect.DeepEqual(client.sets, []string{"fill"}) {
+		t.Errorf("expected only the missing server written, got %v", client.sets)
+	}
+	if !reflect.DeepEqual(client.deletes, []string{"block"}) {
+		t.Errorf("expected only the managed server pruned, got %v", client.deletes)
+	}
+
+	// A rotated credential rewrites a server that otherwise matches
+	client.sets = nil
+	client.deletes = nil
+	if _, err := SyncSABnzbdServers(context.Background(), client, []NewsServer{primary}, []string{"primary"},
+		map[string]bool{"primary": true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(client.sets, []string{"primary"}) {
+		t.Errorf("expected rotated server rewritten, got %v", client.sets)
+	}
+}
+
+func TestSyncNZBGetServers(t *testing.T) {
+	client := &fakeNZBGetClient{items: []NZBGetConfigItem{
+		{Name: "MainDir", Value: "/downloads"},
+		{Name: "Server1.Name", Value: "manual"},
+		{Name: "Server1.Host", Value: "manual.example.com"},
+		{Name: "Server2.Name", Value: "old"},
+		{Name: "Server2.Host", Value: "old.example.com"},
+		{Name: "Server3.Name", Value: "primary"},
+		{Name: "Server3.Host", Value: "stale.example.com"},
+		{Name: "Server3.Group", Value: "1"},
+	}}
+
+	primary := testNewsServer("primary")
+	fill := testNewsServer("fill")
+	fill.Tier = 1
+	fill.UseTLS = false
+
+	managed, err := SyncNZBGetServers(context.Background(), client, []NewsServer{primary, fill}, []string{"primary", "old"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(managed, []string{"primary", "fill"}) {
+		t.Errorf("expected managed [primary fill], got %v", managed)
+	}
+	if client.saves != 1 || client.reloads != 1 {
+		t.Fatalf("expected 1 save and 1 reload, got %d and %d", client.saves, client.reloads)
+	}
+
+	servers := ParseNZBGetServers(client.items)
+	var names []string
+	for _, server := range servers {
+		names = append(names, server.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"manual", "primary", "fill"}) {
+		t.Fatalf("expected servers [manual primary fill], got %v", names)
+	}
+	if got := servers[1].Options; got["Host"] != "primary.example.com" || got["Group"] != "1" || got["Password"] != "secret" {
+		t.Errorf("expected primary updated in place keeping its group, got %v", got)
+	}
+	if got := servers[2].Options; got["Level"] != "1" || got["Encryption"] != "no" || got["IpVersion"] != "auto" {
+		t.Errorf("expected fill created with defaults, got %v", got)
+	}
+	if client.items[0] != (NZBGetConfigItem{Name: "MainDir", Value: "/downloads"}) {
+		t.Errorf("expected unrelated options kept, got %v", client.items[0])
+	}
+
+	// A second sync with nothing changed does not save
+	if _, err := SyncNZBGetServers(context.Background(), client, []NewsServer{primary, fill}, managed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.saves != 1 {
+		t.Errorf("expected no save when in sync, got %d saves", client.saves)
+	}
+}
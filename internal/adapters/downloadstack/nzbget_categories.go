@@ -0,0 +1,103 @@
+package downloadstack
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// nzbgetCategoryOption matches category options like "Category3.DestDir"
+var nzbgetCategoryOption = regexp.MustCompile(`^(?i)category(\d+)\.(.+)$`)
+
+// NZBGetCategory represents a category stored as CategoryN.* options
+type NZBGetCategory struct {
+	// Name is the category name (CategoryN.Name)
+	Name string
+
+	// Options holds the remaining CategoryN.* options keyed by option name
+	// (e.g., "DestDir", "Unpack", "Extensions", "Aliases")
+	Options map[string]string
+}
+
+// ParseNZBGetCategories extracts categories from config options, ordered by index.
+// Entries without a name are skipped.
+func ParseNZBGetCategories(items []NZBGetConfigItem) []NZBGetCategory {
+	return parseNZBGetSections(items, nzbgetCategoryOption)
+}
+
+// ReplaceNZBGetCategories returns config options with all CategoryN.* options
+// replaced by the given categories, renumbered contiguously from 1.
+// NZBGet stops reading categories at the first missing index, so gaps are not allowed.
+func ReplaceNZBGetCategories(items []NZBGetConfigItem, categories []NZBGetCategory) []NZBGetConfigItem {
+	return replaceNZBGetSections(items, nzbgetCategoryOption, "Category", categories)
+}
+
+// parseNZBGetSections extracts the numbered sections matched by pattern (e.g.
+// CategoryN.* or ServerN.*), ordered by index. Sections without a name are skipped.
+func parseNZBGetSections(items []NZBGetConfigItem, pattern *regexp.Regexp) []NZBGetCategory {
+	byIndex := make(map[int]*NZBGetCategory)
+	for _, item := range items {
+		m := pattern.FindStringSubmatch(item.Name)
+		if m == nil {
+			continue
+		}
+		idx, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+
+		section, ok := byIndex[idx]
+		if !ok {
+			section = &NZBGetCategory{Options: make(map[string]string)}
+			byIndex[idx] = section
+		}
+		if strings.EqualFold(m[2], "Name") {
+			section.Name = item.Value
+		} else {
+			section.Options[m[2]] = item.Value
+		}
+	}
+
+	indexes := make([]int, 0, len(byIndex))
+	for idx := range byIndex {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+
+	sections := make([]NZBGetCategory, 0, len(indexes))
+	for _, idx := range indexes {
+		if byIndex[idx].Name != "" {
+			sections = append(sections, *byIndex[idx])
+		}
+	}
+	return sections
+}
+
+// replaceNZBGetSections returns config options with all sections matched by
+// pattern replaced by the given ones, numbered <prefix>1, <prefix>2, ...
+func replaceNZBGetSections(items []NZBGetConfigItem, pattern *regexp.Regexp, prefix string, sections []NZBGetCategory) []NZBGetConfigItem {
+	result := make([]NZBGetConfigItem, 0, len(items))
+	for _, item := range items {
+		if !pattern.MatchString(item.Name) {
+			result = append(result, item)
+		}
+	}
+
+	for i, section := range sections {
+		option := fmt.Sprintf("%s%d.", prefix, i+1)
+		result = append(result, NZBGetConfigItem{Name: option + "Name", Value: section.Name})
+
+		keys := make([]string, 0, len(section.Options))
+		for k := range section.Options {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			result = append(result, NZBGetConfigItem{Name: option + k, Value: section.Options[k]})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,42 @@
+package downloadstack
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNZBGetCategoriesRoundTrip(t *testing.T) {
+	items := []NZBGetConfigItem{
+		{Name: "MainDir", Value: "/downloads"},
+		{Name: "Category1.Name", Value: "movies"},
+		{Name: "Category1.DestDir", Value: "/downloads/movies"},
+		{Name: "Category3.Name", Value: "tv"},
+		{Name: "Category3.Unpack", Value: "no"},
+		{Name: "Category4.DestDir", Value: "/orphan"},
+	}
+
+	categories := ParseNZBGetCategories(items)
+	expected := []NZBGetCategory{
+		{Name: "movies", Options: map[string]string{"DestDir": "/downloads/movies"}},
+		{Name: "tv", Options: map[string]string{"Unpack": "no"}},
+	}
+	if !reflect.DeepEqual(categories, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, categories)
+	}
+
+	// Drop "movies" and add "music"; indexes must be renumbered without gaps
+	updated := ReplaceNZBGetCategories(items, []NZBGetCategory{
+		categories[1],
+		{Name: "music", Options: map[string]string{"DestDir": "/downloads/music"}},
+	})
+	expectedItems := []NZBGetConfigItem{
+		{Name: "MainDir", Value: "/downloads"},
+		{Name: "Category1.Name", Value: "tv"},
+		{Name: "Category1.Unpack", Value: "no"},
+		{Name: "Category2.Name", Value: "music"},
+		{Name: "Category2.DestDir", Value: "/downloads/music"},
+	}
+	if !reflect.DeepEqual(updated, expectedItems) {
+		t.Errorf("expected %+v, got %+v", expectedItems, updated)
+	}
+}
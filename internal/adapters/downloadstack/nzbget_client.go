@@ -26,6 +26,12 @@ type NZBGetClientInterface interface {
 	// SetConfig updates NZBGet configuration
 	SetConfig(ctx context.Context, name, value string) error
 
+	// LoadConfig gets the configuration as stored in the config file
+	LoadConfig(ctx context.Context) ([]NZBGetConfigItem, error)
+
+	// SaveConfig writes the full configuration to the config file
+	SaveConfig(ctx context.Context, items []NZBGetConfigItem) error
+
 	// GetStatus gets current download status
 	GetStatus(ctx context.Context) (*NZBGetStatus, error)
 
@@ -263,12 +269,10 @@ type NZBGetHistoryItem struct {
 // NewNZBGetClient creates a new NZBGet JSON-RPC client
 func NewNZBGetClient(baseURL, username, password string) *NZBGetClient {
 	return &NZBGetClient{
-		baseURL:  strings.TrimSuffix(baseURL, "/"),
-		username: username,
-		password: password,
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		username:   username,
+		password:   password,
+		httpClient: newHTTPClient(baseURL, nil),
 	}
 }
 
@@ -388,6 +392,42 @@ func (c *NZBGetClient) SetConfig(ctx context.Context, name, value string) error
 	return nil
 }
 
+// LoadConfig gets the configuration as stored in the config file
+// (unlike GetConfig, which returns the values currently in use)
+func (c *NZBGetClient) LoadConfig(ctx context.Context) ([]NZBGetConfigItem, error) {
+	resp, err := c.request(ctx, "loadconfig")
+	if err != nil {
+		return nil, err
+	}
+
+	var config []NZBGetConfigItem
+	if err := json.Unmarshal(resp.Result, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	return config, nil
+}
+
+// SaveConfig writes the full configuration to the config file.
+// Changes take effect after Reload.
+func (c *NZBGetClient) SaveConfig(ctx context.Context, items []NZBGetConfigItem) error {
+	resp, err := c.request(ctx, "saveconfig", items)
+	if err != nil {
+		return err
+	}
+
+	var success bool
+	if err := json.Unmarshal(resp.Result, &success); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !success {
+		return fmt.Errorf("saveconfig failed")
+	}
+
+	return nil
+}
+
 // GetStatus gets current download status
 func (c *NZBGetClient) GetStatus(ctx context.Context) (*NZBGetStatus, error) {
 	resp, err := c.request(ctx, "status")
@@ -0,0 +1,159 @@
+package downloadstack
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// nzbgetServerOption matches news server options like "Server2.Host"
+var nzbgetServerOption = regexp.MustCompile(`^(?i)server(\d+)\.(.+)$`)
+
+// NZBGetServer represents a news server stored as ServerN.* options
+type NZBGetServer struct {
+	// Name is the server name (ServerN.Name)
+	Name string
+
+	// Options holds the remaining ServerN.* options keyed by option name
+	// (e.g., "Host", "Level", "Connections")
+	Options map[string]string
+}
+
+// ParseNZBGetServers extracts news servers from config options, ordered by index.
+// Entries without a name are skipped.
+func ParseNZBGetServers(items []NZBGetConfigItem) []NZBGetServer {
+	sections := parseNZBGetSections(items, nzbgetServerOption)
+	servers := make([]NZBGetServer, len(sections))
+	for i, section := range sections {
+		servers[i] = NZBGetServer(section)
+	}
+	return servers
+}
+
+// ReplaceNZBGetServers returns config options with all ServerN.* options replaced
+// by the given servers, renumbered contiguously from 1
+func ReplaceNZBGetServers(items []NZBGetConfigItem, servers []NZBGetServer) []NZBGetConfigItem {
+	sections := make([]NZBGetCategory, len(servers))
+	for i, server := range servers {
+		sections[i] = NZBGetCategory(server)
+	}
+	return replaceNZBGetSections(items, nzbgetServerOption, "Server", sections)
+}
+
+// nzbgetServerDefaults are set on servers the operator creates, matching what the
+// NZBGet web UI writes for a new server
+var nzbgetServerDefaults = map[string]string{
+	"Group":     "0",
+	"Optional":  "no",
+	"JoinGroup": "no",
+	"Cipher":    "",
+	"IpVersion": "auto",
+	"Notes":     "",
+}
+
+// applyNZBGetServer sets the options rendered from server, keeping the others
+func applyNZBGetServer(existing NZBGetServer, server NewsServer) NZBGetServer {
+	options := make(map[string]string, len(existing.Options)+9)
+	for k, v := range existing.Options {
+		options[k] = v
+	}
+
+	options["Active"] = boolToNZBGet(server.Enabled)
+	options["Level"] = strconv.Itoa(server.Tier)
+	options["Host"] = server.Host
+	options["Port"] = strconv.Itoa(server.Port)
+	options["Username"] = server.Username
+	options["Password"] = server.Password
+	options["Encryption"] = boolToNZBGet(server.UseTLS)
+	options["Connections"] = strconv.Itoa(server.Connections)
+	options["Retention"] = strconv.Itoa(server.Retention)
+
+	return NZBGetServer{Name: server.Name, Options: options}
+}
+
+// boolToNZBGet renders a switch the way NZBGet stores it
+func boolToNZBGet(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// SyncNZBGetServers rewrites the ServerN.* options: desired servers are created or
+// updated in place, servers previously managed by the operator that are no longer
+// desired are deleted, and servers created outside the operator are kept.
+// The config is only saved and reloaded when it changed.
+// Returns the names of the servers now managed by the operator.
+func SyncNZBGetServers(ctx context.Context, client NZBGetClientInterface, desired []NewsServer, previous []string) ([]string, error) {
+	if len(desired) == 0 && len(previous) == 0 {
+		return nil, nil
+	}
+
+	items, err := client.LoadConfig(ctx)
+	if err != nil {
+		return previous, fmt.Errorf("failed to load config: %w", err)
+	}
+	current := ParseNZBGetServers(items)
+
+	desiredByName := make(map[string]NewsServer, len(desired))
+	for _, server := range desired {
+		desiredByName[strings.ToLower(server.Name)] = server
+	}
+	previousNames := make(map[string]bool, len(previous))
+	for _, name := range previous {
+		previousNames[strings.ToLower(name)] = true
+	}
+
+	// Keep the existing order; update desired servers and drop pruned ones
+	var servers []NZBGetServer
+	seen := make(map[string]bool, len(desired))
+	for _, existing := range current {
+		lower := strings.ToLower(existing.Name)
+		if server, ok := desiredByName[lower]; ok {
+			servers = append(servers, applyNZBGetServer(existing, server))
+			seen[lower] = true
+			continue
+		}
+		if previousNames[lower] {
+			continue
+		}
+		servers = append(servers, existing)
+	}
+
+	// Append new servers in spec order
+	for _, server := range desired {
+		if seen[strings.ToLower(server.Name)] {
+			continue
+		}
+		options := make(map[string]string, len(nzbgetServerDefaults))
+		for k, v := range nzbgetServerDefaults {
+			options[k] = v
+		}
+		servers = append(servers, applyNZBGetServer(NZBGetServer{Options: options}, server))
+	}
+
+	managed := make([]string, 0, len(desired))
+	for _, server := range desired {
+		managed = append(managed, server.Name)
+	}
+	if len(managed) == 0 {
+		managed = nil
+	}
+
+	updated := ReplaceNZBGetServers(items, servers)
+	if reflect.DeepEqual(updated, ReplaceNZBGetServers(items, current)) {
+		return managed, nil
+	}
+
+	if err := client.SaveConfig(ctx, updated); err != nil {
+		return unionNames(managed, previous), fmt.Errorf("failed to save servers: %w", err)
+	}
+	if err := client.Reload(ctx); err != nil {
+		return managed, fmt.Errorf("failed to reload after saving servers: %w", err)
+	}
+
+	return managed, nil
+}
@@ -0,0 +1,160 @@
+package downloadstack
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// NZBGetSyncResult reports what a settings sync changed
+type NZBGetSyncResult struct {
+	// Drifted lists the options (and "DownloadRate") that were corrected
+	Drifted []string
+}
+
+// SyncNZBGetSettings loads the stored config, changes the options that differ from
+// the spec and saves the whole config back, since saveconfig replaces it. Nothing is
+// saved when the config already matches. Switches are tri-state: unset leaves NZBGet's
+// value, false writes "no".
+func SyncNZBGetSettings(ctx context.Context, client NZBGetClientInterface, spec *arrv1alpha1.NZBGetSpec) (*NZBGetSyncResult, error) {
+	result := &NZBGetSyncResult{}
+
+	if desired := buildNZBGetSettings(spec); len(desired) > 0 {
+		items, err := client.LoadConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load NZBGet config: %w", err)
+		}
+
+		updated, drifted := applyNZBGetSettings(items, desired)
+		if len(drifted) > 0 {
+			if err := client.SaveConfig(ctx, updated); err != nil {
+				return nil, fmt.Errorf("failed to save NZBGet config: %w", err)
+			}
+			if err := client.Reload(ctx); err != nil {
+				return nil, fmt.Errorf("failed to reload NZBGet config: %w", err)
+			}
+			result.Drifted = drifted
+		}
+	}
+
+	// The download rate is a runtime setting; the status reports it in bytes/s
+	if spec.Speed != nil && spec.Speed.DownloadRate > 0 {
+		status, err := client.GetStatus(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read NZBGet status: %w", err)
+		}
+		if status.DownloadLimit != int64(spec.Speed.DownloadRate)*1024 {
+			if err := client.SetDownloadRate(ctx, spec.Speed.DownloadRate); err != nil {
+				return nil, fmt.Errorf("failed to set download rate: %w", err)
+			}
+			result.Drifted = append(result.Drifted, "DownloadRate")
+		}
+	}
+
+	return result, nil
+}
+
+// applyNZBGetSettings returns the config with the desired options set, and the sorted
+// names of the options whose stored values differed. Option names match case-insensitively,
+// like NZBGet reads them; missing options are appended.
+func applyNZBGetSettings(items []NZBGetConfigItem, desired map[string]string) ([]NZBGetConfigItem, []string) {
+	updated := make([]NZBGetConfigItem, len(items))
+	copy(updated, items)
+
+	found := make(map[string]bool, len(desired))
+	var drifted []string
+	for i, item := range updated {
+		for name, value := range desired {
+			if !strings.EqualFold(item.Name, name) {
+				continue
+			}
+			found[name] = true
+			if item.Value != value {
+				updated[i].Value = value
+				drifted = append(drifted, name)
+			}
+		}
+	}
+
+	var missing []string
+	for name := range desired {
+		if !found[name] {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		updated = append(updated, NZBGetConfigItem{Name: name, Value: desired[name]})
+	}
+
+	drifted = append(drifted, missing...)
+	sort.Strings(drifted)
+	return updated, drifted
+}
+
+// buildNZBGetSettings maps the spec to NZBGet options. Empty strings, non-positive
+// numbers and unset switches are left out.
+func buildNZBGetSettings(spec *arrv1alpha1.NZBGetSpec) map[string]string {
+	settings := make(map[string]string)
+	setString := func(name, value string) {
+		if value != "" {
+			settings[name] = value
+		}
+	}
+	setInt := func(name string, value int) {
+		if value > 0 {
+			settings[name] = strconv.Itoa(value)
+		}
+	}
+	setBool := func(name string, value *bool) {
+		if value == nil {
+			return
+		}
+		settings[name] = "no"
+		if *value {
+			settings[name] = "yes"
+		}
+	}
+
+	if speed := spec.Speed; speed != nil {
+		setInt("ArticleTimeout", speed.ArticleTimeout)
+		setInt("WriteBuffer", speed.WriteBuffer)
+	}
+
+	if dirs := spec.Directories; dirs != nil {
+		setString("MainDir", dirs.MainDir)
+		setString("DestDir", dirs.DestDir)
+		setString("InterDir", dirs.InterDir)
+		setString("NzbDir", dirs.NzbDir)
+		setString("TempDir", dirs.TempDir)
+		setString("ScriptDir", dirs.ScriptDir)
+	}
+
+	if queue := spec.Queue; queue != nil {
+		setBool("FlushQueue", queue.FlushQueue)
+		setBool("DupeCheck", queue.DupeCheck)
+		setInt("PropagationDelay", queue.PropagationDelay)
+		setString("HealthCheck", queue.HealthCheck)
+	}
+
+	if pp := spec.PostProcessing; pp != nil {
+		setString("ParCheck", pp.ParCheck)
+		setBool("ParRepair", pp.ParRepair)
+		setBool("Unpack", pp.Unpack)
+		setBool("UnpackCleanupDisk", pp.UnpackCleanupDisk)
+		setBool("DirectUnpack", pp.DirectUnpack)
+	}
+
+	if conns := spec.Connections; conns != nil {
+		setInt("ArticleConnections", conns.ArticleConnections)
+		setInt("RetryInterval", conns.RetryInterval)
+		setInt("TerminateTimeout", conns.TerminateTimeout)
+		setBool("Decode", conns.Decode)
+	}
+
+	return settings
+}
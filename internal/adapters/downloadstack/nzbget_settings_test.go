@@ -0,0 +1,94 @@
+package downloadstack
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// fakeNZBGetClient serves a stored config and status and records writes
+type fakeNZBGetClient struct {
+	NZBGetClientInterface
+	items   []NZBGetConfigItem
+	status  NZBGetStatus
+	saves   int
+	reloads int
+	rate    int
+}
+
+func (f *fakeNZBGetClient) LoadConfig(_ context.Context) ([]NZBGetConfigItem, error) {
+	return f.items, nil
+}
+
+func (f *fakeNZBGetClient) SaveConfig(_ context.Context, items []NZBGetConfigItem) error {
+	f.items = items
+	f.saves++
+	return nil
+}
+
+func (f *fakeNZBGetClient) Reload(_ context.Context) error {
+	f.reloads++
+	return nil
+}
+
+func (f *fakeNZBGetClient) GetStatus(_ context.Context) (*NZBGetStatus, error) {
+	return &f.status, nil
+}
+
+func (f *fakeNZBGetClient) SetDownloadRate(_ context.Context, rate int) error {
+	f.rate = rate
+	return nil
+}
+
+func TestSyncNZBGetSettingsReadModifyWrite(t *testing.T) {
+	client := &fakeNZBGetClient{
+		items: []NZBGetConfigItem{
+			{Name: "MainDir", Value: "/downloads"},
+			{Name: "DupeCheck", Value: "yes"},
+			{Name: "Server1.Host", Value: "news.example.com"},
+		},
+		status: NZBGetStatus{DownloadLimit: 2048 * 1024},
+	}
+	spec := &arrv1alpha1.NZBGetSpec{
+		Directories: &arrv1alpha1.NZBGetDirectoriesSpec{MainDir: "/downloads"},
+		Queue:       &arrv1alpha1.NZBGetQueueSpec{DupeCheck: ptr.To(false), FlushQueue: ptr.To(true)},
+		Speed:       &arrv1alpha1.NZBGetSpeedSpec{DownloadRate: 2048},
+	}
+
+	result, err := SyncNZBGetSettings(context.Background(), client, spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Drifted, []string{"DupeCheck", "FlushQueue"}) {
+		t.Errorf("expected drift in [DupeCheck FlushQueue], got %v", result.Drifted)
+	}
+	expected := []NZBGetConfigItem{
+		{Name: "MainDir", Value: "/downloads"},
+		{Name: "DupeCheck", Value: "no"},
+		{Name: "Server1.Host", Value: "news.example.com"},
+		{Name: "FlushQueue", Value: "yes"},
+	}
+	if !reflect.DeepEqual(client.items, expected) {
+		t.Errorf("expected saved config %v, got %v", expected, client.items)
+	}
+	if client.saves != 1 || client.reloads != 1 {
+		t.Errorf("expected 1 save and 1 reload, got %d and %d", client.saves, client.reloads)
+	}
+	if client.rate != 0 {
+		t.Errorf("expected the matching download rate to be left alone, got %d", client.rate)
+	}
+
+	// A config that already matches is not saved; unset switches are left alone
+	spec.Queue.FlushQueue = nil
+	result, err = SyncNZBGetSettings(context.Background(), client, spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Drifted) != 0 || client.saves != 1 {
+		t.Errorf("expected no drift and no save, got drift %v and %d saves", result.Drifted, client.saves)
+	}
+}
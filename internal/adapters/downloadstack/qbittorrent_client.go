@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -31,6 +32,15 @@ type QBittorrentClientInterface interface {
 
 	// GetTransferInfo gets transfer info (speeds, etc.)
 	GetTransferInfo(ctx context.Context) (map[string]interface{}, error)
+
+	// GetTorrents lists all torrents
+	GetTorrents(ctx context.Context) ([]QBittorrentTorrent, error)
+
+	// SetShareLimits sets the ratio and seeding time limits of torrents
+	SetShareLimits(ctx context.Context, hashes []string, ratioLimit float64, seedingTimeLimit int) error
+
+	// SetUploadLimit sets the upload limit of torrents in bytes/s (0 = unlimited)
+	SetUploadLimit(ctx context.Context, hashes []string, limit int) error
 }
 
 // Ensure QBittorrentClient implements the interface
@@ -51,6 +61,9 @@ type QBittorrentPreferences struct {
 	Locale                 string `json:"locale,omitempty"`
 	CreateSubfolderEnabled bool   `json:"create_subfolder_enabled,omitempty"`
 	StartPausedEnabled     bool   `json:"start_paused_enabled,omitempty"`
+	AddStoppedEnabled      bool   `json:"add_stopped_enabled,omitempty"`
+	TorrentContentLayout   string `json:"torrent_content_layout,omitempty"`
+	AutoTMMEnabled         bool   `json:"auto_tmm_enabled,omitempty"`
 	AutoDeleteMode         int    `json:"auto_delete_mode,omitempty"`
 	PreallocateAll         bool   `json:"preallocate_all,omitempty"`
 	IncompleteFilesExt     bool   `json:"incomplete_files_ext,omitempty"`
@@ -147,13 +160,10 @@ type QBittorrentPreferences struct {
 func NewQBittorrentClient(baseURL, username, password string) *QBittorrentClient {
 	jar, _ := cookiejar.New(nil)
 	return &QBittorrentClient{
-		baseURL:  strings.TrimSuffix(baseURL, "/"),
-		username: username,
-		password: password,
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-			Jar:     jar,
-		},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		username:   username,
+		password:   password,
+		httpClient: newHTTPClient(baseURL, jar),
 	}
 }
 
@@ -320,6 +330,71 @@ func (c *QBittorrentClient) GetTransferInfo(ctx context.Context) (map[string]int
 	return info, nil
 }
 
+// GetTags gets all torrent tags
+func (c *QBittorrentClient) GetTags(ctx context.Context) ([]string, error) {
+	body, err := c.request(ctx, "GET", "/api/v2/torrents/tags", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []string
+	if err := json.Unmarshal(body, &tags); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// CreateTags creates torrent tags (existing tags are left unchanged)
+func (c *QBittorrentClient) CreateTags(ctx context.Context, tags []string) error {
+	data := url.Values{}
+	data.Set("tags", strings.Join(tags, ","))
+
+	_, err := c.request(ctx, "POST", "/api/v2/torrents/createTags", data)
+	return err
+}
+
+// QBittorrentCategory is a torrent category
+type QBittorrentCategory struct {
+	Name     string `json:"name"`
+	SavePath string `json:"savePath"`
+}
+
+// GetCategories gets all torrent categories, keyed by name
+func (c *QBittorrentClient) GetCategories(ctx context.Context) (map[string]QBittorrentCategory, error) {
+	body, err := c.request(ctx, "GET", "/api/v2/torrents/categories", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var categories map[string]QBittorrentCategory
+	if err := json.Unmarshal(body, &categories); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// CreateCategory creates a torrent category
+func (c *QBittorrentClient) CreateCategory(ctx context.Context, name, savePath string) error {
+	data := url.Values{}
+	data.Set("category", name)
+	data.Set("savePath", savePath)
+
+	_, err := c.request(ctx, "POST", "/api/v2/torrents/createCategory", data)
+	return err
+}
+
+// EditCategory updates the save path of a torrent category
+func (c *QBittorrentClient) EditCategory(ctx context.Context, name, savePath string) error {
+	data := url.Values{}
+	data.Set("category", name)
+	data.Set("savePath", savePath)
+
+	_, err := c.request(ctx, "POST", "/api/v2/torrents/editCategory", data)
+	return err
+}
+
 // GetMainData gets main data including torrents
 func (c *QBittorrentClient) GetMainData(ctx context.Context, rid int) (map[string]interface{}, error) {
 	data := url.Values{}
@@ -340,6 +415,60 @@ func (c *QBittorrentClient) GetMainData(ctx context.Context, rid int) (map[strin
 	return mainData, nil
 }
 
+// QBittorrentTorrent is a torrent as listed by /api/v2/torrents/info
+type QBittorrentTorrent struct {
+	Hash    string `json:"hash"`
+	Name    string `json:"name"`
+	Tracker string `json:"tracker"`
+
+	// RatioLimit is -2 for the global limit and -1 for no limit
+	RatioLimit float64 `json:"ratio_limit"`
+
+	// SeedingTimeLimit is in minutes, -2 for the global limit and -1 for no limit
+	SeedingTimeLimit int `json:"seeding_time_limit"`
+
+	// UpLimit is in bytes/s, 0 or -1 for unlimited
+	UpLimit int `json:"up_limit"`
+}
+
+// GetTorrents lists all torrents
+func (c *QBittorrentClient) GetTorrents(ctx context.Context) ([]QBittorrentTorrent, error) {
+	body, err := c.request(ctx, "GET", "/api/v2/torrents/info", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var torrents []QBittorrentTorrent
+	if err := json.Unmarshal(body, &torrents); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal torrents: %w", err)
+	}
+
+	return torrents, nil
+}
+
+// SetShareLimits sets the ratio and seeding time limits of torrents. The inactive
+// seeding time limit (required from 4.6) is left on the global limit.
+func (c *QBittorrentClient) SetShareLimits(ctx context.Context, hashes []string, ratioLimit float64, seedingTimeLimit int) error {
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("ratioLimit", strconv.FormatFloat(ratioLimit, 'f', -1, 64))
+	data.Set("seedingTimeLimit", strconv.Itoa(seedingTimeLimit))
+	data.Set("inactiveSeedingTimeLimit", "-2")
+
+	_, err := c.request(ctx, "POST", "/api/v2/torrents/setShareLimits", data)
+	return err
+}
+
+// SetUploadLimit sets the upload limit of torrents in bytes/s (0 = unlimited)
+func (c *QBittorrentClient) SetUploadLimit(ctx context.Context, hashes []string, limit int) error {
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("limit", strconv.Itoa(limit))
+
+	_, err := c.request(ctx, "POST", "/api/v2/torrents/setUploadLimit", data)
+	return err
+}
+
 // ToggleSpeedLimitsMode toggles alternative speed limits
 func (c *QBittorrentClient) ToggleSpeedLimitsMode(ctx context.Context) error {
 	_, err := c.request(ctx, "POST", "/api/v2/transfer/toggleSpeedLimitsMode", nil)
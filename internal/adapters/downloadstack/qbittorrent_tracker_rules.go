@@ -0,0 +1,121 @@
+package downloadstack
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// qBittorrent share limit values that defer to the global limits
+const (
+	qbtGlobalRatioLimit       = -2
+	qbtGlobalSeedingTimeLimit = -2
+)
+
+// TrackerRuleResult counts the torrents a tracker rule matched and corrected
+type TrackerRuleResult struct {
+	Name    string
+	Matched int
+	Applied int
+}
+
+// shareLimits are the limits a tracker rule sets on a torrent
+type shareLimits struct {
+	ratio       float64
+	seedingTime int
+}
+
+// EnforceQBittorrentTrackerRules applies each rule's limits to the torrents whose
+// current tracker matches it, touching only torrents that differ. The first
+// matching rule wins. Torrents that haven't contacted a tracker yet have no
+// tracker URL and are picked up by a later sync.
+func EnforceQBittorrentTrackerRules(ctx context.Context, client QBittorrentClientInterface, rules []arrv1alpha1.QBittorrentTrackerRule) ([]TrackerRuleResult, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	patterns := make([]*regexp.Regexp, len(rules))
+	limits := make([]shareLimits, len(rules))
+	for i, rule := range rules {
+		re, err := regexp.Compile(rule.Tracker)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tracker pattern of rule %s: %w", rule.Name, err)
+		}
+		patterns[i] = re
+		limits[i] = shareLimits{ratio: qbtGlobalRatioLimit, seedingTime: qbtGlobalSeedingTimeLimit}
+		if rule.RatioLimit != "" {
+			ratio, err := strconv.ParseFloat(rule.RatioLimit, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ratio limit of rule %s: %w", rule.Name, err)
+			}
+			limits[i].ratio = ratio
+		}
+		if rule.SeedingTimeLimit != nil {
+			limits[i].seedingTime = *rule.SeedingTimeLimit
+		}
+	}
+
+	torrents, err := client.GetTorrents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list qBittorrent torrents: %w", err)
+	}
+
+	results := make([]TrackerRuleResult, len(rules))
+	shareHashes := make([][]string, len(rules))
+	uploadHashes := make([][]string, len(rules))
+	corrected := make([]map[string]bool, len(rules))
+	for i, rule := range rules {
+		results[i].Name = rule.Name
+		corrected[i] = make(map[string]bool)
+	}
+
+	for _, t := range torrents {
+		if t.Tracker == "" {
+			continue
+		}
+		for i, re := range patterns {
+			if !re.MatchString(t.Tracker) {
+				continue
+			}
+			results[i].Matched++
+			if math.Abs(t.RatioLimit-limits[i].ratio) > 0.001 || t.SeedingTimeLimit != limits[i].seedingTime {
+				shareHashes[i] = append(shareHashes[i], t.Hash)
+				corrected[i][t.Hash] = true
+			}
+			if up := rules[i].UploadLimit; up != nil && !uploadLimitMatches(t.UpLimit, *up*1024) {
+				uploadHashes[i] = append(uploadHashes[i], t.Hash)
+				corrected[i][t.Hash] = true
+			}
+			break
+		}
+	}
+
+	for i, rule := range rules {
+		if len(shareHashes[i]) > 0 {
+			if err := client.SetShareLimits(ctx, shareHashes[i], limits[i].ratio, limits[i].seedingTime); err != nil {
+				return nil, fmt.Errorf("failed to set share limits of rule %s: %w", rule.Name, err)
+			}
+		}
+		if len(uploadHashes[i]) > 0 {
+			if err := client.SetUploadLimit(ctx, uploadHashes[i], *rule.UploadLimit*1024); err != nil {
+				return nil, fmt.Errorf("failed to set upload limit of rule %s: %w", rule.Name, err)
+			}
+		}
+		results[i].Applied = len(corrected[i])
+	}
+
+	return results, nil
+}
+
+// uploadLimitMatches compares a torrent's upload limit with the desired one in
+// bytes/s. qBittorrent reports unlimited as 0 or -1.
+func uploadLimitMatches(current, desired int) bool {
+	if desired == 0 {
+		return current <= 0
+	}
+	return current == desired
+}
@@ -0,0 +1,89 @@
+package downloadstack
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// fakeQBittorrentClient serves a torrent list and records limit changes
+type fakeQBittorrentClient struct {
+	QBittorrentClientInterface
+	torrents []QBittorrentTorrent
+	share    map[string][]string // "ratio/time" -> hashes
+	upload   map[int][]string
+}
+
+func (f *fakeQBittorrentClient) GetTorrents(_ context.Context) ([]QBittorrentTorrent, error) {
+	return f.torrents, nil
+}
+
+func (f *fakeQBittorrentClient) SetShareLimits(_ context.Context, hashes []string, ratioLimit float64, seedingTimeLimit int) error {
+	if f.share == nil {
+		f.share = make(map[string][]string)
+	}
+	key := fmt.Sprintf("%g/%d", ratioLimit, seedingTimeLimit)
+	f.share[key] = append(f.share[key], hashes...)
+	return nil
+}
+
+func (f *fakeQBittorrentClient) SetUploadLimit(_ context.Context, hashes []string, limit int) error {
+	if f.upload == nil {
+		f.upload = make(map[int][]string)
+	}
+	f.upload[limit] = append(f.upload[limit], hashes...)
+	return nil
+}
+
+func TestEnforceQBittorrentTrackerRules(t *testing.T) {
+	client := &fakeQBittorrentClient{torrents: []QBittorrentTorrent{
+		// Already at the rule's limits
+		{Hash: "a", Tracker: "https://tracker.private.example/announce", RatioLimit: 3, SeedingTimeLimit: 10080, UpLimit: 512 * 1024},
+		// Global limits, needs both share and upload limits
+		{Hash: "b", Tracker: "https://tracker.private.example/announce", RatioLimit: -2, SeedingTimeLimit: -2, UpLimit: -1},
+		// Matches both patterns, the first rule wins
+		{Hash: "c", Tracker: "https://private.example/announce", RatioLimit: -2, SeedingTimeLimit: -2, UpLimit: 512 * 1024},
+		// Matches the second rule only
+		{Hash: "f", Tracker: "udp://open.example:1337", RatioLimit: -2, SeedingTimeLimit: -2},
+		// No tracker contacted yet
+		{Hash: "d", Tracker: ""},
+		// No rule
+		{Hash: "e", Tracker: "https://other.example/announce", RatioLimit: 5},
+	}}
+	rules := []arrv1alpha1.QBittorrentTrackerRule{
+		{Name: "private", Tracker: `private\.example`, RatioLimit: "3", SeedingTimeLimit: ptr.To(10080), UploadLimit: ptr.To(512)},
+		{Name: "public", Tracker: `example:1337|private`, RatioLimit: "0"},
+	}
+
+	results, err := EnforceQBittorrentTrackerRules(context.Background(), client, rules)
+	if err != nil {
+		t.Fatalf("EnforceQBittorrentTrackerRules() error = %v", err)
+	}
+
+	want := []TrackerRuleResult{
+		{Name: "private", Matched: 3, Applied: 2},
+		{Name: "public", Matched: 1, Applied: 1},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("results = %+v, want %+v", results, want)
+	}
+	if !reflect.DeepEqual(client.upload, map[int][]string{512 * 1024: {"b"}}) {
+		t.Errorf("upload limits = %v", client.upload)
+	}
+	wantShare := map[string][]string{"3/10080": {"b", "c"}, "0/-2": {"f"}}
+	if !reflect.DeepEqual(client.share, wantShare) {
+		t.Errorf("share limits = %v, want %v", client.share, wantShare)
+	}
+}
+
+func TestEnforceQBittorrentTrackerRulesInvalidPattern(t *testing.T) {
+	rules := []arrv1alpha1.QBittorrentTrackerRule{{Name: "bad", Tracker: "("}}
+	if _, err := EnforceQBittorrentTrackerRules(context.Background(), &fakeQBittorrentClient{}, rules); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+}
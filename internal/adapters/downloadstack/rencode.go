@@ -0,0 +1,340 @@
+package downloadstack
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// rencode type codes, as defined by the rencode format used by the Deluge daemon RPC
+const (
+	rencodeChrList    = 59
+	rencodeChrDict    = 60
+	rencodeChrInt     = 61
+	rencodeChrInt1    = 62
+	rencodeChrInt2    = 63
+	rencodeChrInt4    = 64
+	rencodeChrInt8    = 65
+	rencodeChrFloat32 = 66
+	rencodeChrFloat64 = 44
+	rencodeChrTrue    = 67
+	rencodeChrFalse   = 68
+	rencodeChrNone    = 69
+	rencodeChrTerm    = 127
+
+	rencodeIntPosFixedStart = 0
+	rencodeIntPosFixedCount = 44
+	rencodeDictFixedStart   = 102
+	rencodeDictFixedCount   = 25
+	rencodeIntNegFixedStart = 70
+	rencodeIntNegFixedCount = 32
+	rencodeStrFixedStart    = 128
+	rencodeStrFixedCount    = 64
+	rencodeListFixedStart   = rencodeStrFixedStart + rencodeStrFixedCount
+	rencodeListFixedCount   = 64
+)
+
+// rencodeMarshal encodes a value in rencode format.
+// Supported types: nil, bool, integers, float32/64, string, []byte,
+// []interface{}, []int, []string and map[string]interface{}.
+func rencodeMarshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := rencodeEncode(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func rencodeEncode(buf *bytes.Buffer, v interface{}) error {
+	switch x := v.(type) {
+	case nil:
+		buf.WriteByte(rencodeChrNone)
+	case bool:
+		if x {
+			buf.WriteByte(rencodeChrTrue)
+		} else {
+			buf.WriteByte(rencodeChrFalse)
+		}
+	case int:
+		rencodeEncodeInt(buf, int64(x))
+	case int32:
+		rencodeEncodeInt(buf, int64(x))
+	case int64:
+		rencodeEncodeInt(buf, x)
+	case float32:
+		buf.WriteByte(rencodeChrFloat32)
+		_ = binary.Write(buf, binary.BigEndian, x)
+	case float64:
+		buf.WriteByte(rencodeChrFloat64)
+		_ = binary.Write(buf, binary.BigEndian, x)
+	case string:
+		rencodeEncodeBytes(buf, []byte(x))
+	case []byte:
+		rencodeEncodeBytes(buf, x)
+	case []int:
+		items := make([]interface{}, len(x))
+		for i, n := range x {
+			items[i] = n
+		}
+		return rencodeEncode(buf, items)
+	case []string:
+		items := make([]interface{}, len(x))
+		for i, s := range x {
+			items[i] = s
+		}
+		return rencodeEncode(buf, items)
+	case []interface{}:
+		if len(x) < rencodeListFixedCount {
+			buf.WriteByte(byte(rencodeListFixedStart + len(x)))
+		} else {
+			buf.WriteByte(rencodeChrList)
+		}
+		for _, item := range x {
+			if err := rencodeEncode(buf, item); err != nil {
+				return err
+			}
+		}
+		if len(x) >= rencodeListFixedCount {
+			buf.WriteByte(rencodeChrTerm)
+		}
+	case map[string]interface{}:
+		if len(x) < rencodeDictFixedCount {
+			buf.WriteByte(byte(rencodeDictFixedStart + len(x)))
+		} else {
+			buf.WriteByte(rencodeChrDict)
+		}
+		// Sort keys for deterministic output
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			rencodeEncodeBytes(buf, []byte(k))
+			if err := rencodeEncode(buf, x[k]); err != nil {
+				return err
+			}
+		}
+		if len(x) >= rencodeDictFixedCount {
+			buf.WriteByte(rencodeChrTerm)
+		}
+	default:
+		return fmt.Errorf("rencode: unsupported type %T", v)
+	}
+	return nil
+}
+
+func rencodeEncodeInt(buf *bytes.Buffer, x int64) {
+	switch {
+	case x >= 0 && x < rencodeIntPosFixedCount:
+		buf.WriteByte(byte(rencodeIntPosFixedStart + x))
+	case x < 0 && x >= -rencodeIntNegFixedCount:
+		buf.WriteByte(byte(rencodeIntNegFixedStart - 1 - x))
+	case x >= math.MinInt8 && x <= math.MaxInt8:
+		buf.WriteByte(rencodeChrInt1)
+		_ = binary.Write(buf, binary.BigEndian, int8(x))
+	case x >= math.MinInt16 && x <= math.MaxInt16:
+		buf.WriteByte(rencodeChrInt2)
+		_ = binary.Write(buf, binary.BigEndian, int16(x))
+	case x >= math.MinInt32 && x <= math.MaxInt32:
+		buf.WriteByte(rencodeChrInt4)
+		_ = binary.Write(buf, binary.BigEndian, int32(x))
+	default:
+		buf.WriteByte(rencodeChrInt8)
+		_ = binary.Write(buf, binary.BigEndian, x)
+	}
+}
+
+func rencodeEncodeBytes(buf *bytes.Buffer, b []byte) {
+	if len(b) < rencodeStrFixedCount {
+		buf.WriteByte(byte(rencodeStrFixedStart + len(b)))
+	} else {
+		buf.WriteString(strconv.Itoa(len(b)))
+		buf.WriteByte(':')
+	}
+	buf.Write(b)
+}
+
+// rencodeUnmarshal decodes a rencode value.
+// Strings decode to string, integers to int64, floats to float64,
+// lists to []interface{} and dicts to map[string]interface{}.
+func rencodeUnmarshal(data []byte) (interface{}, error) {
+	d := &rencodeDecoder{data: data}
+	v, err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+	if d.pos != len(data) {
+		return nil, fmt.Errorf("rencode: %d trailing bytes", len(data)-d.pos)
+	}
+	return v, nil
+}
+
+type rencodeDecoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *rencodeDecoder) read(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.data) {
+		return nil, fmt.Errorf("rencode: unexpected end of data")
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *rencodeDecoder) decode() (interface{}, error) {
+	head, err := d.read(1)
+	if err != nil {
+		return nil, err
+	}
+	c := int(head[0])
+
+	switch {
+	case c == rencodeChrNone:
+		return nil, nil
+	case c == rencodeChrTrue:
+		return true, nil
+	case c == rencodeChrFalse:
+		return false, nil
+	case c == rencodeChrInt1:
+		b, err := d.read(1)
+		if err != nil {
+			return nil, err
+		}
+		return int64(int8(b[0])), nil
+	case c == rencodeChrInt2:
+		b, err := d.read(2)
+		if err != nil {
+			return nil, err
+		}
+		return int64(int16(binary.BigEndian.Uint16(b))), nil
+	case c == rencodeChrInt4:
+		b, err := d.read(4)
+		if err != nil {
+			return nil, err
+		}
+		return int64(int32(binary.BigEndian.Uint32(b))), nil
+	case c == rencodeChrInt8:
+		b, err := d.read(8)
+		if err != nil {
+			return nil, err
+		}
+		return int64(binary.BigEndian.Uint64(b)), nil
+	case c == rencodeChrInt:
+		end := bytes.IndexByte(d.data[d.pos:], rencodeChrTerm)
+		if end < 0 {
+			return nil, fmt.Errorf("rencode: unterminated integer")
+		}
+		s, _ := d.read(end)
+		d.pos++ // skip terminator
+		n, err := strconv.ParseInt(string(s), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("rencode: invalid integer %q: %w", s, err)
+		}
+		return n, nil
+	case c == rencodeChrFloat32:
+		b, err := d.read(4)
+		if err != nil {
+			return nil, err
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case c == rencodeChrFloat64:
+		b, err := d.read(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	case c >= '0' && c <= '9':
+		colon := bytes.IndexByte(d.data[d.pos:], ':')
+		if colon < 0 {
+			return nil, fmt.Errorf("rencode: unterminated string length")
+		}
+		digits, _ := d.read(colon)
+		d.pos++ // skip ':'
+		n, err := strconv.Atoi(string(head) + string(digits))
+		if err != nil {
+			return nil, fmt.Errorf("rencode: invalid string length: %w", err)
+		}
+		b, err := d.read(n)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case c == rencodeChrList:
+		var list []interface{}
+		for d.pos < len(d.data) && d.data[d.pos] != rencodeChrTerm {
+			v, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		if _, err := d.read(1); err != nil {
+			return nil, err
+		}
+		return list, nil
+	case c == rencodeChrDict:
+		dict := make(map[string]interface{})
+		for d.pos < len(d.data) && d.data[d.pos] != rencodeChrTerm {
+			if err := d.decodeDictEntry(dict); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := d.read(1); err != nil {
+			return nil, err
+		}
+		return dict, nil
+	case c >= rencodeIntPosFixedStart && c < rencodeIntPosFixedStart+rencodeIntPosFixedCount:
+		return int64(c - rencodeIntPosFixedStart), nil
+	case c >= rencodeIntNegFixedStart && c < rencodeIntNegFixedStart+rencodeIntNegFixedCount:
+		return int64(-1 - (c - rencodeIntNegFixedStart)), nil
+	case c >= rencodeStrFixedStart && c < rencodeStrFixedStart+rencodeStrFixedCount:
+		b, err := d.read(c - rencodeStrFixedStart)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case c >= rencodeListFixedStart && c < rencodeListFixedStart+rencodeListFixedCount:
+		n := c - rencodeListFixedStart
+		list := make([]interface{}, 0, n)
+		for i := 0; i < n; i++ {
+			v, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case c >= rencodeDictFixedStart && c < rencodeDictFixedStart+rencodeDictFixedCount:
+		n := c - rencodeDictFixedStart
+		dict := make(map[string]interface{}, n)
+		for i := 0; i < n; i++ {
+			if err := d.decodeDictEntry(dict); err != nil {
+				return nil, err
+			}
+		}
+		return dict, nil
+	}
+
+	return nil, fmt.Errorf("rencode: unknown type code %d", c)
+}
+
+// decodeDictEntry decodes a key/value pair into dict.
+// Non-string keys (e.g., integer torrent IDs) are stringified.
+func (d *rencodeDecoder) decodeDictEntry(dict map[string]interface{}) error {
+	k, err := d.decode()
+	if err != nil {
+		return err
+	}
+	v, err := d.decode()
+	if err != nil {
+		return err
+	}
+	dict[fmt.Sprint(k)] = v
+	return nil
+}
@@ -0,0 +1,59 @@
+package downloadstack
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRencodeRoundTrip(t *testing.T) {
+	long := strings.Repeat("x", 100)
+	longList := make([]interface{}, 70)
+	for i := range longList {
+		longList[i] = int64(i)
+	}
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected interface{}
+	}{
+		{name: "none", input: nil, expected: nil},
+		{name: "true", input: true, expected: true},
+		{name: "false", input: false, expected: false},
+		{name: "small int", input: 5, expected: int64(5)},
+		{name: "negative fixed int", input: -3, expected: int64(-3)},
+		{name: "int1", input: 100, expected: int64(100)},
+		{name: "int2", input: -1000, expected: int64(-1000)},
+		{name: "int4", input: 58846, expected: int64(58846)},
+		{name: "int8", input: int64(1) << 40, expected: int64(1) << 40},
+		{name: "float64", input: 1.5, expected: 1.5},
+		{name: "short string", input: "daemon.login", expected: "daemon.login"},
+		{name: "long string", input: long, expected: long},
+		{name: "int slice", input: []int{6881, 6891}, expected: []interface{}{int64(6881), int64(6891)}},
+		{name: "long list", input: longList, expected: longList},
+		{
+			name: "request",
+			input: []interface{}{[]interface{}{1, "daemon.login", []interface{}{"user", "pass"},
+				map[string]interface{}{"client_version": "2.0.0"}}},
+			expected: []interface{}{[]interface{}{int64(1), "daemon.login", []interface{}{"user", "pass"},
+				map[string]interface{}{"client_version": "2.0.0"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := rencodeMarshal(tt.input)
+			if err != nil {
+				t.Fatalf("marshal failed: %v", err)
+			}
+			got, err := rencodeUnmarshal(data)
+			if err != nil {
+				t.Fatalf("unmarshal failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %#v, got %#v", tt.expected, got)
+			}
+		})
+	}
+}
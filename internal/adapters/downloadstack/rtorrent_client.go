@@ -119,12 +119,10 @@ type XMLRPCResponse struct {
 // NewRTorrentClient creates a new rTorrent XML-RPC client
 func NewRTorrentClient(baseURL, username, password string) *RTorrentClient {
 	return &RTorrentClient{
-		baseURL:  strings.TrimSuffix(baseURL, "/"),
-		username: username,
-		password: password,
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		username:   username,
+		password:   password,
+		httpClient: newHTTPClient(baseURL, nil),
 	}
 }
 
@@ -0,0 +1,127 @@
+package downloadstack
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// RTorrentConfigFileKey is the Secret key holding the rendered rtorrent.rc
+const RTorrentConfigFileKey = "rtorrent.rc"
+
+// rtorrentWatchInterval is how often, in seconds, rTorrent scans the watch directory
+const rtorrentWatchInterval = 5
+
+// RenderRTorrentConfigFile renders the spec as an rtorrent.rc fragment. It holds
+// the settings also applied over XML-RPC, plus the session and watch directories
+// rTorrent only reads at start. Lines are written in a fixed order, so equal
+// specs render equal files.
+func RenderRTorrentConfigFile(spec *arrv1alpha1.RTorrentSpec) ([]byte, error) {
+	var lines []string
+	set := func(command, value string) {
+		lines = append(lines, command+" = "+value)
+	}
+	quote := func(field, value string) (string, error) {
+		if strings.ContainsAny(value, "\"\\\n\r") {
+			return "", fmt.Errorf("failed to render rTorrent rtorrent.rc: %s %q contains a quote, backslash or newline", field, value)
+		}
+		return `"` + value + `"`, nil
+	}
+
+	if d := spec.Directories; d != nil {
+		if d.Directory != "" {
+			dir, err := quote("directory", d.Directory)
+			if err != nil {
+				return nil, err
+			}
+			set("directory.default.set", dir)
+		}
+		if d.SessionDirectory != "" {
+			dir, err := quote("sessionDirectory", d.SessionDirectory)
+			if err != nil {
+				return nil, err
+			}
+			set("session.path.set", dir)
+		}
+		if d.WatchDirectory != "" {
+			load, err := quote("watchDirectory", "load.start="+strings.TrimSuffix(d.WatchDirectory, "/")+"/*.torrent")
+			if err != nil {
+				return nil, err
+			}
+			set("schedule2", fmt.Sprintf("watch_directory, %d, %d, %s", rtorrentWatchInterval, rtorrentWatchInterval, load))
+		}
+	}
+
+	// Rates are in KiB/s, like the spec
+	if s := spec.Speed; s != nil {
+		if s.DownloadRate > 0 {
+			set("throttle.global_down.max_rate.set_kb", strconv.Itoa(s.DownloadRate))
+		}
+		if s.UploadRate > 0 {
+			set("throttle.global_up.max_rate.set_kb", strconv.Itoa(s.UploadRate))
+		}
+	}
+
+	if c := spec.Connections; c != nil {
+		if c.MaxPeers > 0 {
+			set("throttle.max_peers.normal.set", strconv.Itoa(c.MaxPeers))
+		}
+		if c.MaxUploads > 0 {
+			set("throttle.max_uploads.global.set", strconv.Itoa(c.MaxUploads))
+		}
+		if c.MaxUploadsPerTorrent > 0 {
+			set("throttle.max_uploads.set", strconv.Itoa(c.MaxUploadsPerTorrent))
+		}
+		switch {
+		case c.PortRange != "":
+			set("network.port_range.set", c.PortRange)
+		case c.Port > 0:
+			set("network.port_range.set", fmt.Sprintf("%d-%d", c.Port, c.Port))
+		}
+		if c.PortRange != "" || c.Port > 0 {
+			set("network.port_random.set", rtorrentBool(c.PortRandomize))
+		}
+	}
+
+	if p := spec.Protocol; p != nil {
+		if p.DHT != nil {
+			mode := "off"
+			if *p.DHT {
+				mode = "auto"
+			}
+			set("dht.mode.set", mode)
+		}
+		if p.PEX != nil {
+			set("protocol.pex.set", rtorrentBool(*p.PEX))
+		}
+		if p.Encryption != "" {
+			set("protocol.encryption.set", p.Encryption)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# Rendered by nebularr; changes are overwritten\n")
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return []byte(b.String()), nil
+}
+
+// rtorrentBool formats a boolean as an rtorrent.rc value
+func rtorrentBool(v bool) string {
+	if v {
+		return "yes"
+	}
+	return "no"
+}
+
+// HashRTorrentConfigFile generates a hash of a rendered rtorrent.rc for change
+// detection
+func HashRTorrentConfigFile(data []byte) string {
+	hash := sha256.Sum256(data)
+	return fmt.Sprintf("%x", hash[:8]) // First 8 bytes as hex
+}
@@ -0,0 +1,57 @@
+package downloadstack
+
+import (
+	"testing"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+func TestRenderRTorrentConfigFile(t *testing.T) {
+	dht := false
+	spec := &arrv1alpha1.RTorrentSpec{
+		Directories: &arrv1alpha1.RTorrentDirectoriesSpec{
+			Directory:        "/downloads",
+			SessionDirectory: "/config/session",
+			WatchDirectory:   "/watch/",
+		},
+		Speed:       &arrv1alpha1.RTorrentSpeedSpec{DownloadRate: 1024},
+		Connections: &arrv1alpha1.RTorrentConnectionsSpec{MaxPeers: 100, Port: 51413},
+		Protocol:    &arrv1alpha1.RTorrentProtocolSpec{DHT: &dht, Encryption: "allow_incoming,try_outgoing"},
+	}
+
+	data, err := RenderRTorrentConfigFile(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `# Rendered by nebularr; changes are overwritten
+directory.default.set = "/downloads"
+session.path.set = "/config/session"
+schedule2 = watch_directory, 5, 5, "load.start=/watch/*.torrent"
+throttle.global_down.max_rate.set_kb = 1024
+throttle.max_peers.normal.set = 100
+network.port_range.set = 51413-51413
+network.port_random.set = no
+dht.mode.set = off
+protocol.encryption.set = allow_incoming,try_outgoing
+`
+	if string(data) != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, data)
+	}
+
+	// Equal specs hash equal, a changed spec does not
+	again, _ := RenderRTorrentConfigFile(spec)
+	if HashRTorrentConfigFile(data) != HashRTorrentConfigFile(again) {
+		t.Error("expected equal specs to hash equal")
+	}
+	spec.Directories.WatchDirectory = "/watch/movies"
+	changed, _ := RenderRTorrentConfigFile(spec)
+	if HashRTorrentConfigFile(data) == HashRTorrentConfigFile(changed) {
+		t.Error("expected a changed spec to change the hash")
+	}
+
+	// Values that would break out of the quoted string are rejected
+	spec.Directories.SessionDirectory = "/config\"\nexecute = rm"
+	if _, err := RenderRTorrentConfigFile(spec); err == nil {
+		t.Error("expected an error for a directory containing a quote")
+	}
+}
@@ -1,6 +1,7 @@
 package downloadstack
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -22,6 +23,9 @@ type SABnzbdClientInterface interface {
 	// GetConfig gets SABnzbd configuration
 	GetConfig(ctx context.Context) (*SABnzbdConfig, error)
 
+	// GetConfigSection gets the values of a configuration section as strings
+	GetConfigSection(ctx context.Context, section string) (map[string]string, error)
+
 	// SetConfig updates SABnzbd configuration
 	SetConfig(ctx context.Context, section, keyword, value string) error
 
@@ -39,6 +43,24 @@ type SABnzbdClientInterface interface {
 
 	// SetSpeedLimit sets download speed limit (KB/s, 0 = unlimited)
 	SetSpeedLimit(ctx context.Context, limit int) error
+
+	// GetCategories gets all download categories
+	GetCategories(ctx context.Context) ([]SABnzbdCategory, error)
+
+	// SetCategory creates or updates a download category
+	SetCategory(ctx context.Context, category SABnzbdCategory) error
+
+	// DeleteCategory deletes a download category
+	DeleteCategory(ctx context.Context, name string) error
+
+	// GetServers gets the news servers, with values as strings like GetConfigSection
+	GetServers(ctx context.Context) ([]map[string]string, error)
+
+	// SetServer creates or updates a news server
+	SetServer(ctx context.Context, name string, values map[string]string) error
+
+	// DeleteServer deletes a news server
+	DeleteServer(ctx context.Context, name string) error
 }
 
 // Ensure SABnzbdClient implements the interface
@@ -123,7 +145,7 @@ type SABnzbdCategory struct {
 	Dir      string `json:"dir,omitempty"`
 	Script   string `json:"script,omitempty"`
 	Priority int    `json:"priority"`
-	PostProc int    `json:"pp,omitempty"` // Post-processing: 0=skip, 1=repair, 2=repair+unpack, 3=repair+unpack+delete
+	PostProc string `json:"pp,omitempty"` // Post-processing: 0=skip, 1=repair, 2=repair+unpack, 3=repair+unpack+delete ("" = default)
 }
 
 // SABnzbdQueue represents the download queue
@@ -180,11 +202,9 @@ type SABnzbdResponse struct {
 // NewSABnzbdClient creates a new SABnzbd API client
 func NewSABnzbdClient(baseURL, apiKey string) *SABnzbdClient {
 	return &SABnzbdClient{
-		baseURL: strings.TrimSuffix(baseURL, "/"),
-		apiKey:  apiKey,
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: newHTTPClient(baseURL, nil),
 	}
 }
 
@@ -258,6 +278,49 @@ func (c *SABnzbdClient) GetConfig(ctx context.Context) (*SABnzbdConfig, error) {
 	return &result.Config, nil
 }
 
+// GetConfigSection gets the values of a flat configuration section (e.g., misc).
+// SABnzbd reports switches as 0/1 numbers; booleans are normalized to "1"/"0" too,
+// so values compare with what SetConfig writes.
+func (c *SABnzbdClient) GetConfigSection(ctx context.Context, section string) (map[string]string, error) {
+	params := url.Values{}
+	params.Set("section", section)
+
+	body, err := c.request(ctx, "get_config", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Config map[string]map[string]interface{} `json:"config"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse %s config: %w", section, err)
+	}
+
+	return sabnzbdConfigValues(result.Config[section]), nil
+}
+
+// sabnzbdConfigValues renders decoded config values as the strings SetConfig writes
+func sabnzbdConfigValues(raw map[string]interface{}) map[string]string {
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		switch v := value.(type) {
+		case nil:
+			values[key] = ""
+		case bool:
+			values[key] = "0"
+			if v {
+				values[key] = "1"
+			}
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+	return values
+}
+
 // SetConfig updates a SABnzbd configuration value
 func (c *SABnzbdClient) SetConfig(ctx context.Context, section, keyword, value string) error {
 	params := url.Values{}
@@ -282,6 +345,158 @@ func (c *SABnzbdClient) SetConfig(ctx context.Context, section, keyword, value s
 	return nil
 }
 
+// GetCategories gets all download categories
+func (c *SABnzbdClient) GetCategories(ctx context.Context) ([]SABnzbdCategory, error) {
+	params := url.Values{}
+	params.Set("section", "categories")
+
+	body, err := c.request(ctx, "get_config", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Config struct {
+			Categories []SABnzbdCategory `json:"categories"`
+		} `json:"config"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse categories: %w", err)
+	}
+
+	return result.Config.Categories, nil
+}
+
+// SetCategory creates or updates a download category
+func (c *SABnzbdClient) SetCategory(ctx context.Context, category SABnzbdCategory) error {
+	params := url.Values{}
+	params.Set("section", "categories")
+	params.Set("keyword", category.Name)
+	params.Set("dir", category.Dir)
+	params.Set("priority", fmt.Sprintf("%d", category.Priority))
+	params.Set("script", category.Script)
+	if category.PostProc != "" {
+		params.Set("pp", category.PostProc)
+	}
+
+	body, err := c.request(ctx, "set_config", params)
+	if err != nil {
+		return err
+	}
+
+	var result SABnzbdResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !result.Status {
+		return fmt.Errorf("set_config failed for category %s: %s", category.Name, result.Error)
+	}
+
+	return nil
+}
+
+// DeleteCategory deletes a download category
+func (c *SABnzbdClient) DeleteCategory(ctx context.Context, name string) error {
+	params := url.Values{}
+	params.Set("section", "categories")
+	params.Set("keyword", name)
+
+	body, err := c.request(ctx, "del_config", params)
+	if err != nil {
+		return err
+	}
+
+	var result SABnzbdResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !result.Status {
+		return fmt.Errorf("del_config failed for category %s: %s", name, result.Error)
+	}
+
+	return nil
+}
+
+// GetServers gets the news servers. Passwords are masked by SABnzbd.
+func (c *SABnzbdClient) GetServers(ctx context.Context) ([]map[string]string, error) {
+	params := url.Values{}
+	params.Set("section", "servers")
+
+	body, err := c.request(ctx, "get_config", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Config struct {
+			Servers []map[string]interface{} `json:"servers"`
+		} `json:"config"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse servers: %w", err)
+	}
+
+	servers := make([]map[string]string, 0, len(result.Config.Servers))
+	for _, server := range result.Config.Servers {
+		servers = append(servers, sabnzbdConfigValues(server))
+	}
+	return servers, nil
+}
+
+// SetServer creates or updates a news server. Values are server keywords
+// (host, port, username, password, connections, ssl, priority, enable, ...).
+func (c *SABnzbdClient) SetServer(ctx context.Context, name string, values map[string]string) error {
+	params := url.Values{}
+	params.Set("section", "servers")
+	params.Set("keyword", name)
+	for key, value := range values {
+		params.Set(key, value)
+	}
+
+	body, err := c.request(ctx, "set_config", params)
+	if err != nil {
+		return err
+	}
+
+	var result SABnzbdResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !result.Status {
+		return fmt.Errorf("set_config failed for server %s: %s", name, result.Error)
+	}
+
+	return nil
+}
+
+// DeleteServer deletes a news server
+func (c *SABnzbdClient) DeleteServer(ctx context.Context, name string) error {
+	params := url.Values{}
+	params.Set("section", "servers")
+	params.Set("keyword", name)
+
+	body, err := c.request(ctx, "del_config", params)
+	if err != nil {
+		return err
+	}
+
+	var result SABnzbdResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !result.Status {
+		return fmt.Errorf("del_config failed for server %s: %s", name, result.Error)
+	}
+
+	return nil
+}
+
 // GetQueue gets the current download queue
 func (c *SABnzbdClient) GetQueue(ctx context.Context) (*SABnzbdQueue, error) {
 	body, err := c.request(ctx, "queue", nil)
@@ -331,7 +546,9 @@ func (c *SABnzbdClient) Resume(ctx context.Context) error {
 // SetSpeedLimit sets download speed limit (KB/s, 0 = unlimited)
 func (c *SABnzbdClient) SetSpeedLimit(ctx context.Context, limit int) error {
 	params := url.Values{}
-	params.Set("value", fmt.Sprintf("%d", limit))
+	params.Set("name", "speedlimit")
+	// Without a suffix SABnzbd reads the value as a percentage
+	params.Set("value", fmt.Sprintf("%dK", limit))
 
 	_, err := c.request(ctx, "config", params)
 	return err
@@ -0,0 +1,120 @@
+package downloadstack
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// SABnzbdSyncResult reports what a settings sync changed
+type SABnzbdSyncResult struct {
+	// Drifted lists the settings (misc keywords, "speedlimit", "pause") that were corrected
+	Drifted []string
+}
+
+// SyncSABnzbdSettings reads the misc config and queue state back, and writes only the
+// settings that differ from the spec. Switches are tri-state: unset leaves SABnzbd's
+// value, false turns the setting off.
+func SyncSABnzbdSettings(ctx context.Context, client SABnzbdClientInterface, spec *arrv1alpha1.SABnzbdSpec) (*SABnzbdSyncResult, error) {
+	result := &SABnzbdSyncResult{}
+
+	if desired := buildSABnzbdMiscSettings(spec); len(desired) > 0 {
+		current, err := client.GetConfigSection(ctx, "misc")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SABnzbd config: %w", err)
+		}
+
+		keys := make([]string, 0, len(desired))
+		for key := range desired {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if current[key] == desired[key] {
+				continue
+			}
+			if err := client.SetConfig(ctx, "misc", key, desired[key]); err != nil {
+				return nil, fmt.Errorf("failed to set %s: %w", key, err)
+			}
+			result.Drifted = append(result.Drifted, key)
+		}
+	}
+
+	speed := spec.Speed
+	if speed == nil || (speed.SpeedLimit <= 0 && speed.PauseDownloads == nil) {
+		return result, nil
+	}
+	queue, err := client.GetQueue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SABnzbd queue: %w", err)
+	}
+
+	// The queue reports the absolute limit in bytes/s
+	if speed.SpeedLimit > 0 && queue.SpeedLimitAbs != strconv.Itoa(speed.SpeedLimit*1024) {
+		if err := client.SetSpeedLimit(ctx, speed.SpeedLimit); err != nil {
+			return nil, fmt.Errorf("failed to set speed limit: %w", err)
+		}
+		result.Drifted = append(result.Drifted, "speedlimit")
+	}
+
+	if pause := speed.PauseDownloads; pause != nil && *pause != queue.Paused {
+		if *pause {
+			err = client.Pause(ctx)
+		} else {
+			err = client.Resume(ctx)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to set pause state: %w", err)
+		}
+		result.Drifted = append(result.Drifted, "pause")
+	}
+
+	return result, nil
+}
+
+// buildSABnzbdMiscSettings maps the spec to misc config keywords and the values
+// SABnzbd stores for them. Empty strings, non-positive numbers and unset switches
+// are left out.
+func buildSABnzbdMiscSettings(spec *arrv1alpha1.SABnzbdSpec) map[string]string {
+	settings := make(map[string]string)
+	setString := func(key, value string) {
+		if value != "" {
+			settings[key] = value
+		}
+	}
+	setBool := func(key string, value *bool) {
+		if value == nil {
+			return
+		}
+		settings[key] = "0"
+		if *value {
+			settings[key] = "1"
+		}
+	}
+
+	if dirs := spec.Directories; dirs != nil {
+		setString("download_dir", dirs.DownloadDir)
+		setString("complete_dir", dirs.CompleteDir)
+		setString("incomplete_dir", dirs.IncompleteDir)
+		setString("script_dir", dirs.ScriptDir)
+		setString("nzb_backup_dir", dirs.NzbBackupDir)
+	}
+
+	if queue := spec.Queue; queue != nil {
+		setBool("pre_check", queue.PreCheck)
+		if queue.MaxRetries > 0 {
+			settings["max_art_tries"] = strconv.Itoa(queue.MaxRetries)
+		}
+	}
+
+	if pp := spec.PostProcessing; pp != nil {
+		setBool("unpack", pp.UnpackEnabled)
+		setBool("cleanup_list", pp.CleanupEnabled)
+	}
+
+	return settings
+}
@@ -0,0 +1,116 @@
+package downloadstack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// fakeSABnzbdClient serves a fixed misc config and queue and records writes
+type fakeSABnzbdClient struct {
+	SABnzbdClientInterface
+	misc       map[string]string
+	queue      SABnzbdQueue
+	setCalls   []string
+	speedLimit int
+	pauses     []bool
+}
+
+func (f *fakeSABnzbdClient) GetConfigSection(_ context.Context, _ string) (map[string]string, error) {
+	return f.misc, nil
+}
+
+func (f *fakeSABnzbdClient) SetConfig(_ context.Context, _, keyword, value string) error {
+	f.setCalls = append(f.setCalls, keyword+"="+value)
+	f.misc[keyword] = value
+	return nil
+}
+
+func (f *fakeSABnzbdClient) GetQueue(_ context.Context) (*SABnzbdQueue, error) {
+	return &f.queue, nil
+}
+
+func (f *fakeSABnzbdClient) SetSpeedLimit(_ context.Context, limit int) error {
+	f.speedLimit = limit
+	return nil
+}
+
+func (f *fakeSABnzbdClient) Pause(_ context.Context) error {
+	f.pauses = append(f.pauses, true)
+	return nil
+}
+
+func (f *fakeSABnzbdClient) Resume(_ context.Context) error {
+	f.pauses = append(f.pauses, false)
+	return nil
+}
+
+func TestSyncSABnzbdSettingsWritesOnlyDrift(t *testing.T) {
+	client := &fakeSABnzbdClient{
+		misc:  map[string]string{"complete_dir": "/downloads/complete", "pre_check": "1", "unpack": "1", "max_art_tries": "3"},
+		queue: SABnzbdQueue{Paused: true, SpeedLimitAbs: "10240"},
+	}
+	spec := &arrv1alpha1.SABnzbdSpec{
+		Directories:    &arrv1alpha1.SABnzbdDirectoriesSpec{CompleteDir: "/downloads/complete"},
+		Queue:          &arrv1alpha1.SABnzbdQueueSpec{PreCheck: ptr.To(false), MaxRetries: 3},
+		PostProcessing: &arrv1alpha1.SABnzbdPostProcessingSpec{UnpackEnabled: ptr.To(true)},
+		Speed:          &arrv1alpha1.SABnzbdSpeedSpec{SpeedLimit: 10, PauseDownloads: ptr.To(false)},
+	}
+
+	result, err := SyncSABnzbdSettings(context.Background(), client, spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(client.setCalls, []string{"pre_check=0"}) {
+		t.Errorf("expected only pre_check to be cleared, got %v", client.setCalls)
+	}
+	if client.speedLimit != 0 {
+		t.Errorf("expected the matching speed limit to be left alone, got %d", client.speedLimit)
+	}
+	if !reflect.DeepEqual(client.pauses, []bool{false}) {
+		t.Errorf("expected the queue to be resumed, got %v", client.pauses)
+	}
+	if !reflect.DeepEqual(result.Drifted, []string{"pre_check", "pause"}) {
+		t.Errorf("expected drift in [pre_check pause], got %v", result.Drifted)
+	}
+
+	// Unset switches leave SABnzbd's values alone
+	client.setCalls, client.pauses = nil, nil
+	client.queue.Paused = false
+	spec.Queue.PreCheck = nil
+	spec.Speed.PauseDownloads = nil
+	client.misc["unpack"] = "0"
+	spec.PostProcessing.UnpackEnabled = nil
+	result, err = SyncSABnzbdSettings(context.Background(), client, spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Drifted) != 0 || len(client.setCalls) != 0 || len(client.pauses) != 0 {
+		t.Errorf("expected no writes, got drift %v, sets %v, pauses %v", result.Drifted, client.setCalls, client.pauses)
+	}
+}
+
+func TestSABnzbdGetConfigSectionNormalizesValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("mode") != "get_config" || r.URL.Query().Get("section") != "misc" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`{"config":{"misc":{"pre_check":0,"unpack":true,"max_art_tries":3,"complete_dir":"/done","email_to":null}}}`))
+	}))
+	defer server.Close()
+
+	values, err := NewSABnzbdClient(server.URL, "key").GetConfigSection(context.Background(), "misc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{"pre_check": "0", "unpack": "1", "max_art_tries": "3", "complete_dir": "/done", "email_to": ""}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+}
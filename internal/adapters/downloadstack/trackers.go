@@ -0,0 +1,27 @@
+package downloadstack
+
+import "strings"
+
+// TrackerList merges inline announce URLs with the lines of a ConfigMap value
+// into the newline-separated list Transmission and qBittorrent store. Lines are
+// trimmed; a run of blank lines becomes one tier separator, and blank lines at
+// either end are dropped.
+func TrackerList(trackers []string, data string) string {
+	lines := append(append([]string{}, trackers...), strings.Split(data, "\n")...)
+
+	var result []string
+	blank := false
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			blank = len(result) > 0
+			continue
+		}
+		if blank {
+			result = append(result, "")
+			blank = false
+		}
+		result = append(result, line)
+	}
+	return strings.Join(result, "\n")
+}
@@ -0,0 +1,24 @@
+package downloadstack
+
+import "testing"
+
+func TestTrackerList(t *testing.T) {
+	tests := []struct {
+		name     string
+		trackers []string
+		data     string
+		want     string
+	}{
+		{"empty", nil, "", ""},
+		{"inline", []string{"udp://a:1337/announce", " udp://b:80/announce "}, "", "udp://a:1337/announce\nudp://b:80/announce"},
+		{"configmap after inline", []string{"udp://a/announce"}, "udp://b/announce\r\nudp://c/announce\n", "udp://a/announce\nudp://b/announce\nudp://c/announce"},
+		{"tiers", nil, "\n\nudp://a/announce\n\n\n  \nudp://b/announce\n\n", "udp://a/announce\n\nudp://b/announce"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TrackerList(tt.trackers, tt.data); got != tt.want {
+				t.Errorf("TrackerList() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 )
 
 const (
@@ -21,6 +23,14 @@ const (
 	DefaultTimeout = 30 * time.Second
 )
 
+// newHTTPClient creates an HTTP client for a download client at baseURL,
+// sharing the pooled connections of its target. jar may be nil.
+func newHTTPClient(baseURL string, jar http.CookieJar) *http.Client {
+	c := httpclient.NewHTTPClient(httpclient.Config{BaseURL: baseURL, Timeout: DefaultTimeout})
+	c.Jar = jar
+	return c
+}
+
 // TransmissionClientInterface defines the Transmission RPC operations.
 // This interface allows for mock implementations in tests.
 type TransmissionClientInterface interface {
@@ -38,6 +48,18 @@ type TransmissionClientInterface interface {
 
 	// UpdateBlocklist updates the blocklist
 	UpdateBlocklist(ctx context.Context) error
+
+	// GetTorrents lists the torrents with the fields the torrent policy needs
+	GetTorrents(ctx context.Context) ([]TransmissionTorrent, error)
+
+	// SetTorrentLabels replaces the labels of a torrent (RPC version 16+)
+	SetTorrentLabels(ctx context.Context, id int, labels []string) error
+
+	// MoveTorrents moves the data of torrents to location
+	MoveTorrents(ctx context.Context, ids []int, location string) error
+
+	// RemoveTorrents removes torrents, optionally deleting their data
+	RemoveTorrents(ctx context.Context, ids []int, deleteData bool) error
 }
 
 // Ensure TransmissionClient implements the interface
@@ -67,10 +89,41 @@ type TransmissionRPCResponse struct {
 	Tag       int                    `json:"tag,omitempty"`
 }
 
+// transmissionTorrentFields are the torrent-get fields decoded into TransmissionTorrent
+var transmissionTorrentFields = []string{
+	"id", "hashString", "name", "downloadDir", "labels", "percentDone", "uploadRatio", "secondsSeeding", "trackers",
+}
+
+// TransmissionTorrent is a torrent as listed by torrent-get
+type TransmissionTorrent struct {
+	ID          int      `json:"id"`
+	Hash        string   `json:"hashString"`
+	Name        string   `json:"name"`
+	DownloadDir string   `json:"downloadDir"`
+	Labels      []string `json:"labels"`
+
+	// PercentDone is 1 once all wanted files are downloaded
+	PercentDone float64 `json:"percentDone"`
+
+	// UploadRatio is -1 when nothing was downloaded yet
+	UploadRatio    float64 `json:"uploadRatio"`
+	SecondsSeeding int     `json:"secondsSeeding"`
+
+	Trackers []TransmissionTracker `json:"trackers"`
+}
+
+// TransmissionTracker is a tracker of a torrent
+type TransmissionTracker struct {
+	Announce string `json:"announce"`
+}
+
 // TransmissionSession contains Transmission session/settings information
 type TransmissionSession struct {
 	Version string `json:"version"`
 
+	// RPCVersion is the RPC protocol version (17 for Transmission 4.0)
+	RPCVersion int `json:"rpc-version"`
+
 	// Speed limits
 	SpeedLimitDown        int  `json:"speed-limit-down"`
 	SpeedLimitDownEnabled bool `json:"speed-limit-down-enabled"`
@@ -123,18 +176,19 @@ type TransmissionSession struct {
 	BlocklistEnabled bool   `json:"blocklist-enabled"`
 	BlocklistURL     string `json:"blocklist-url"`
 	BlocklistSize    int    `json:"blocklist-size"`
+
+	// DefaultTrackers are added to public torrents, one per line (RPC version 17+)
+	DefaultTrackers string `json:"default-trackers"`
 }
 
 // NewTransmissionClient creates a new Transmission RPC client
 func NewTransmissionClient(baseURL, username, password string) *TransmissionClient {
 	return &TransmissionClient{
-		baseURL:  baseURL,
-		rpcURL:   baseURL + "/transmission/rpc",
-		username: username,
-		password: password,
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		baseURL:    baseURL,
+		rpcURL:     baseURL + "/transmission/rpc",
+		username:   username,
+		password:   password,
+		httpClient: newHTTPClient(baseURL, nil),
 	}
 }
 
@@ -298,3 +352,42 @@ func (c *TransmissionClient) UpdateBlocklist(ctx context.Context) error {
 	_, err := c.request(ctx, "blocklist-update", nil)
 	return err
 }
+
+// GetTorrents lists the torrents with the fields the torrent policy needs
+func (c *TransmissionClient) GetTorrents(ctx context.Context) ([]TransmissionTorrent, error) {
+	resp, err := c.request(ctx, "torrent-get", map[string]interface{}{"fields": transmissionTorrentFields})
+	if err != nil {
+		return nil, err
+	}
+
+	argBytes, err := json.Marshal(resp.Arguments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal arguments: %w", err)
+	}
+
+	var result struct {
+		Torrents []TransmissionTorrent `json:"torrents"`
+	}
+	if err := json.Unmarshal(argBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal torrents: %w", err)
+	}
+	return result.Torrents, nil
+}
+
+// SetTorrentLabels replaces the labels of a torrent (RPC version 16+)
+func (c *TransmissionClient) SetTorrentLabels(ctx context.Context, id int, labels []string) error {
+	_, err := c.request(ctx, "torrent-set", map[string]interface{}{"ids": []int{id}, "labels": labels})
+	return err
+}
+
+// MoveTorrents moves the data of torrents to location
+func (c *TransmissionClient) MoveTorrents(ctx context.Context, ids []int, location string) error {
+	_, err := c.request(ctx, "torrent-set-location", map[string]interface{}{"ids": ids, "location": location, "move": true})
+	return err
+}
+
+// RemoveTorrents removes torrents, optionally deleting their data
+func (c *TransmissionClient) RemoveTorrents(ctx context.Context, ids []int, deleteData bool) error {
+	_, err := c.request(ctx, "torrent-remove", map[string]interface{}{"ids": ids, "delete-local-data": deleteData})
+	return err
+}
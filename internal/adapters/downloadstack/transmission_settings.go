@@ -2,6 +2,10 @@ package downloadstack
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
 	"strconv"
 
 	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
@@ -12,37 +16,189 @@ type TransmissionSettingsInput struct {
 	Spec     *arrv1alpha1.TransmissionSpec
 	Username string
 	Password string
+
+	// DefaultTrackers is the resolved trackers.defaultTrackerList (see TrackerList);
+	// nil leaves the default trackers unmanaged
+	DefaultTrackers *string
+}
+
+// TransmissionSyncResult reports what a settings sync changed and skipped
+type TransmissionSyncResult struct {
+	// Drifted lists the field groups (e.g., "speed", "queue") that were corrected
+	Drifted []string
+
+	// Unrealized lists settings the detected RPC version does not support
+	Unrealized []arrv1alpha1.UnrealizedFeature
 }
 
-// SyncTransmissionSettings synchronizes the desired settings to Transmission
-func SyncTransmissionSettings(ctx context.Context, client TransmissionClientInterface, input *TransmissionSettingsInput) error {
-	settings := buildTransmissionSettings(input.Spec)
-	if len(settings) == 0 {
+// SyncTransmissionSettings reads the current session, diffs it against the spec and sends
+// only the changed settings; nothing is sent when the session already matches. Settings
+// the session's RPC version does not support are skipped and reported as unrealized.
+func SyncTransmissionSettings(ctx context.Context, client TransmissionClientInterface, input *TransmissionSettingsInput) (*TransmissionSyncResult, error) {
+	result := &TransmissionSyncResult{}
+	desired := buildTransmissionSettings(input.Spec)
+	if input.DefaultTrackers != nil {
+		desired.set("trackers", "default-trackers", *input.DefaultTrackers)
+	}
+	if len(desired) == 0 {
+		return result, nil
+	}
+
+	current, err := client.GetSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Transmission session: %w", err)
+	}
+	result.Unrealized = desired.dropUnsupported(current.RPCVersion)
+
+	delta, drifted, err := diffTransmissionSettings(current, desired)
+	if err != nil {
+		return nil, err
+	}
+	if len(delta) == 0 {
+		return result, nil
+	}
+
+	if err := client.SetSession(ctx, delta); err != nil {
+		return nil, err
+	}
+	result.Drifted = drifted
+	return result, nil
+}
+
+// transmissionKeyMinRPCVersion is the RPC version that introduced each session key
+// newer than RPC 5 (Transmission 1.60), the oldest version the operator talks to
+var transmissionKeyMinRPCVersion = map[string]int{
+	"lpd-enabled":                9,
+	"idle-seeding-limit":         10,
+	"idle-seeding-limit-enabled": 10,
+	"blocklist-url":              11,
+	"utp-enabled":                13,
+	"download-queue-size":        14,
+	"download-queue-enabled":     14,
+	"seed-queue-size":            14,
+	"seed-queue-enabled":         14,
+	"queue-stalled-enabled":      14,
+	"queue-stalled-minutes":      14,
+	"default-trackers":           17,
+}
+
+// dropUnsupported removes settings the RPC version does not know and reports them.
+// An unknown RPC version (0) keeps everything.
+func (s transmissionSettings) dropUnsupported(rpcVersion int) []arrv1alpha1.UnrealizedFeature {
+	if rpcVersion == 0 {
 		return nil
 	}
-	return client.SetSession(ctx, settings)
+
+	var unrealized []arrv1alpha1.UnrealizedFeature
+	for _, group := range s.groupNames() {
+		keys := make([]string, 0, len(s[group]))
+		for key := range s[group] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if minVersion, ok := transmissionKeyMinRPCVersion[key]; ok && rpcVersion < minVersion {
+				delete(s[group], key)
+				unrealized = append(unrealized, arrv1alpha1.UnrealizedFeature{
+					Feature: "transmission:" + key,
+					Reason:  fmt.Sprintf("requires RPC version %d, Transmission reports %d", minVersion, rpcVersion),
+				})
+			}
+		}
+		if len(s[group]) == 0 {
+			delete(s, group)
+		}
+	}
+	return unrealized
+}
+
+// diffTransmissionSettings returns the desired settings whose values differ from the
+// session, and the sorted groups they belong to. Both sides go through JSON so that
+// numbers compare as float64 regardless of their Go type.
+func diffTransmissionSettings(current *TransmissionSession, desired transmissionSettings) (map[string]interface{}, []string, error) {
+	currentMap, err := toJSONMap(current)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read Transmission session: %w", err)
+	}
+
+	delta := make(map[string]interface{})
+	var drifted []string
+	for _, group := range desired.groupNames() {
+		desiredMap, err := toJSONMap(desired[group])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode %s settings: %w", group, err)
+		}
+
+		changed := false
+		for key, value := range desiredMap {
+			if have, ok := currentMap[key]; ok && reflect.DeepEqual(have, value) {
+				continue
+			}
+			delta[key] = desired[group][key]
+			changed = true
+		}
+		if changed {
+			drifted = append(drifted, group)
+		}
+	}
+	return delta, drifted, nil
+}
+
+// toJSONMap round-trips a value through JSON into a generic map
+func toJSONMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// transmissionSettings holds desired session settings keyed by field group, then RPC key
+type transmissionSettings map[string]map[string]interface{}
+
+// set records a setting under its field group
+func (s transmissionSettings) set(group, key string, value interface{}) {
+	if s[group] == nil {
+		s[group] = make(map[string]interface{})
+	}
+	s[group][key] = value
+}
+
+// groupNames returns the field groups in sorted order
+func (s transmissionSettings) groupNames() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
-// buildTransmissionSettings converts CRD spec to Transmission settings map
-func buildTransmissionSettings(spec *arrv1alpha1.TransmissionSpec) map[string]interface{} {
-	settings := make(map[string]interface{})
+// buildTransmissionSettings converts CRD spec to Transmission settings grouped by spec section
+func buildTransmissionSettings(spec *arrv1alpha1.TransmissionSpec) transmissionSettings {
+	settings := make(transmissionSettings)
 
 	// Speed limits
 	if spec.Speed != nil {
-		settings["speed-limit-down"] = spec.Speed.DownloadLimit
-		settings["speed-limit-down-enabled"] = spec.Speed.DownloadLimitEnabled
-		settings["speed-limit-up"] = spec.Speed.UploadLimit
-		settings["speed-limit-up-enabled"] = spec.Speed.UploadLimitEnabled
+		settings.set("speed", "speed-limit-down", spec.Speed.DownloadLimit)
+		settings.set("speed", "speed-limit-down-enabled", spec.Speed.DownloadLimitEnabled)
+		settings.set("speed", "speed-limit-up", spec.Speed.UploadLimit)
+		settings.set("speed", "speed-limit-up-enabled", spec.Speed.UploadLimitEnabled)
 	}
 
 	// Alt-speed (turtle mode)
 	if spec.AltSpeed != nil {
-		settings["alt-speed-enabled"] = spec.AltSpeed.Enabled
-		settings["alt-speed-down"] = spec.AltSpeed.Down
-		settings["alt-speed-up"] = spec.AltSpeed.Up
-		settings["alt-speed-time-enabled"] = spec.AltSpeed.TimeEnabled
-		settings["alt-speed-time-begin"] = spec.AltSpeed.TimeBegin
-		settings["alt-speed-time-end"] = spec.AltSpeed.TimeEnd
+		settings.set("altSpeed", "alt-speed-enabled", spec.AltSpeed.Enabled)
+		settings.set("altSpeed", "alt-speed-down", spec.AltSpeed.Down)
+		settings.set("altSpeed", "alt-speed-up", spec.AltSpeed.Up)
+		settings.set("altSpeed", "alt-speed-time-enabled", spec.AltSpeed.TimeEnabled)
+		settings.set("altSpeed", "alt-speed-time-begin", spec.AltSpeed.TimeBegin)
+		settings.set("altSpeed", "alt-speed-time-end", spec.AltSpeed.TimeEnd)
 
 		// Convert days array to bitmask (Transmission uses a bitmask)
 		// Sunday = 1, Monday = 2, Tuesday = 4, ... Saturday = 64
@@ -67,82 +223,82 @@ func buildTransmissionSettings(spec *arrv1alpha1.TransmissionSpec) map[string]in
 					dayMask |= 1
 				}
 			}
-			settings["alt-speed-time-day"] = dayMask
+			settings.set("altSpeed", "alt-speed-time-day", dayMask)
 		}
 	}
 
 	// Directories
 	if spec.Directories != nil {
 		if spec.Directories.Download != "" {
-			settings["download-dir"] = spec.Directories.Download
+			settings.set("directories", "download-dir", spec.Directories.Download)
 		}
 		if spec.Directories.Incomplete != "" {
-			settings["incomplete-dir"] = spec.Directories.Incomplete
+			settings.set("directories", "incomplete-dir", spec.Directories.Incomplete)
 		}
-		settings["incomplete-dir-enabled"] = spec.Directories.IncompleteEnabled
+		settings.set("directories", "incomplete-dir-enabled", spec.Directories.IncompleteEnabled)
 	}
 
 	// Seeding
 	if spec.Seeding != nil {
 		if spec.Seeding.RatioLimit != "" {
 			if ratio, err := strconv.ParseFloat(spec.Seeding.RatioLimit, 64); err == nil {
-				settings["seedRatioLimit"] = ratio
+				settings.set("seeding", "seedRatioLimit", ratio)
 			}
 		}
-		settings["seedRatioLimited"] = spec.Seeding.RatioLimited
-		settings["idle-seeding-limit"] = spec.Seeding.IdleLimit
-		settings["idle-seeding-limit-enabled"] = spec.Seeding.IdleLimitEnabled
+		settings.set("seeding", "seedRatioLimited", spec.Seeding.RatioLimited)
+		settings.set("seeding", "idle-seeding-limit", spec.Seeding.IdleLimit)
+		settings.set("seeding", "idle-seeding-limit-enabled", spec.Seeding.IdleLimitEnabled)
 	}
 
 	// Queue
 	if spec.Queue != nil {
-		settings["download-queue-size"] = spec.Queue.DownloadSize
-		settings["download-queue-enabled"] = spec.Queue.DownloadEnabled
-		settings["seed-queue-size"] = spec.Queue.SeedSize
-		settings["seed-queue-enabled"] = spec.Queue.SeedEnabled
-		settings["queue-stalled-enabled"] = spec.Queue.StalledEnabled
-		settings["queue-stalled-minutes"] = spec.Queue.StalledMinutes
+		settings.set("queue", "download-queue-size", spec.Queue.DownloadSize)
+		settings.set("queue", "download-queue-enabled", spec.Queue.DownloadEnabled)
+		settings.set("queue", "seed-queue-size", spec.Queue.SeedSize)
+		settings.set("queue", "seed-queue-enabled", spec.Queue.SeedEnabled)
+		settings.set("queue", "queue-stalled-enabled", spec.Queue.StalledEnabled)
+		settings.set("queue", "queue-stalled-minutes", spec.Queue.StalledMinutes)
 	}
 
 	// Peers
 	if spec.Peers != nil {
 		if spec.Peers.LimitGlobal > 0 {
-			settings["peer-limit-global"] = spec.Peers.LimitGlobal
+			settings.set("peers", "peer-limit-global", spec.Peers.LimitGlobal)
 		}
 		if spec.Peers.LimitPerTorrent > 0 {
-			settings["peer-limit-per-torrent"] = spec.Peers.LimitPerTorrent
+			settings.set("peers", "peer-limit-per-torrent", spec.Peers.LimitPerTorrent)
 		}
 		if spec.Peers.Port > 0 {
-			settings["peer-port"] = spec.Peers.Port
+			settings.set("peers", "peer-port", spec.Peers.Port)
 		}
-		settings["peer-port-random-on-start"] = spec.Peers.RandomPort
-		settings["port-forwarding-enabled"] = spec.Peers.PortForwardingEnabled
+		settings.set("peers", "peer-port-random-on-start", spec.Peers.RandomPort)
+		settings.set("peers", "port-forwarding-enabled", spec.Peers.PortForwardingEnabled)
 	}
 
 	// Security
 	if spec.Security != nil {
 		if spec.Security.Encryption != "" {
-			settings["encryption"] = spec.Security.Encryption
+			settings.set("security", "encryption", spec.Security.Encryption)
 		}
 		if spec.Security.PEXEnabled != nil {
-			settings["pex-enabled"] = *spec.Security.PEXEnabled
+			settings.set("security", "pex-enabled", *spec.Security.PEXEnabled)
 		}
 		if spec.Security.DHTEnabled != nil {
-			settings["dht-enabled"] = *spec.Security.DHTEnabled
+			settings.set("security", "dht-enabled", *spec.Security.DHTEnabled)
 		}
 		if spec.Security.LPDEnabled != nil {
-			settings["lpd-enabled"] = *spec.Security.LPDEnabled
+			settings.set("security", "lpd-enabled", *spec.Security.LPDEnabled)
 		}
 		if spec.Security.UTPEnabled != nil {
-			settings["utp-enabled"] = *spec.Security.UTPEnabled
+			settings.set("security", "utp-enabled", *spec.Security.UTPEnabled)
 		}
 	}
 
 	// Blocklist
 	if spec.Blocklist != nil {
-		settings["blocklist-enabled"] = spec.Blocklist.Enabled
+		settings.set("blocklist", "blocklist-enabled", spec.Blocklist.Enabled)
 		if spec.Blocklist.URL != "" {
-			settings["blocklist-url"] = spec.Blocklist.URL
+			settings.set("blocklist", "blocklist-url", spec.Blocklist.URL)
 		}
 	}
 
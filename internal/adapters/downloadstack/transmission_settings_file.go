@@ -0,0 +1,189 @@
+package downloadstack
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"sort"
+	"strings"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// TransmissionSettingsFileKey is the Secret key holding the rendered settings.json
+const TransmissionSettingsFileKey = "settings.json"
+
+// transmissionSettingsFileKeys maps RPC session keys to their settings.json names
+// where the two differ
+var transmissionSettingsFileKeys = map[string]string{
+	"seedRatioLimit":   "ratio-limit",
+	"seedRatioLimited": "ratio-limit-enabled",
+}
+
+// transmissionFileEncryption maps the RPC encryption modes to the settings.json values
+var transmissionFileEncryption = map[string]int{
+	"tolerated": 0,
+	"preferred": 1,
+	"required":  2,
+}
+
+// TransmissionSettingsFileInput holds what settings.json needs beyond the spec
+type TransmissionSettingsFileInput struct {
+	Spec *arrv1alpha1.TransmissionSpec
+
+	// Username and Password are written when rpcAuthenticationRequired is true
+	Username string
+	Password string
+
+	// ClusterWhitelist and ClusterHostWhitelist are merged into the whitelists
+	// when settingsFile.clusterWhitelist is set
+	ClusterWhitelist     []string
+	ClusterHostWhitelist []string
+}
+
+// RenderTransmissionSettingsFile renders the spec as a settings.json fragment.
+// It holds the settings also applied over RPC, plus the settingsFile settings
+// Transmission only reads at start. Keys are sorted, so equal specs render equal
+// files.
+func RenderTransmissionSettingsFile(input *TransmissionSettingsFileInput) ([]byte, error) {
+	spec := input.Spec
+	file := make(map[string]interface{})
+	for _, group := range buildTransmissionSettings(spec) {
+		for key, value := range group {
+			if name, ok := transmissionSettingsFileKeys[key]; ok {
+				key = name
+			}
+			if key == "encryption" {
+				value = transmissionFileEncryption[value.(string)]
+			}
+			file[key] = value
+		}
+	}
+
+	if sf := spec.SettingsFile; sf != nil {
+		whitelist, hostWhitelist := sf.RPCWhitelist, sf.RPCHostWhitelist
+		if sf.ClusterWhitelist {
+			whitelist = mergeWhitelist(whitelist, append([]string{"127.0.0.1", "::1"}, input.ClusterWhitelist...))
+			hostWhitelist = mergeWhitelist(hostWhitelist, input.ClusterHostWhitelist)
+		}
+		file["rpc-whitelist-enabled"] = len(whitelist) > 0
+		if len(whitelist) > 0 {
+			file["rpc-whitelist"] = strings.Join(whitelist, ",")
+		}
+		file["rpc-host-whitelist-enabled"] = len(hostWhitelist) > 0
+		if len(hostWhitelist) > 0 {
+			file["rpc-host-whitelist"] = strings.Join(hostWhitelist, ",")
+		}
+		if sf.RPCAuthenticationRequired != nil {
+			file["rpc-authentication-required"] = *sf.RPCAuthenticationRequired
+			// Transmission replaces a plain-text password with its hash on start
+			if *sf.RPCAuthenticationRequired {
+				file["rpc-username"] = input.Username
+				file["rpc-password"] = input.Password
+			}
+		}
+	}
+
+	data, err := json.MarshalIndent(file, "", "    ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to render Transmission settings.json: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// HashTransmissionSettingsFile generates a hash of a rendered settings.json for
+// change detection
+func HashTransmissionSettingsFile(data []byte) string {
+	hash := sha256.Sum256(data)
+	return fmt.Sprintf("%x", hash[:8]) // First 8 bytes as hex
+}
+
+// mergeWhitelist appends the extra entries not already listed, keeping order
+func mergeWhitelist(list, extra []string) []string {
+	seen := make(map[string]bool, len(list)+len(extra))
+	merged := make([]string, 0, len(list)+len(extra))
+	for _, entry := range append(append([]string{}, list...), extra...) {
+		if !seen[entry] {
+			seen[entry] = true
+			merged = append(merged, entry)
+		}
+	}
+	return merged
+}
+
+// TransmissionWhitelistFromCIDRs converts pod CIDRs to rpc-whitelist wildcards.
+// Transmission only matches whole octets, so a prefix not ending on an octet
+// boundary is split into the wildcards covering exactly its addresses
+// (10.0.16.0/20 becomes 10.0.16.* to 10.0.31.*). IPv6 and prefixes shorter
+// than /8 are skipped. The result is sorted.
+func TransmissionWhitelistFromCIDRs(cidrs []string) []string {
+	var entries []string
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil || !prefix.Addr().Is4() || prefix.Bits() < 8 {
+			continue
+		}
+		octets := prefix.Masked().Addr().As4()
+		whole := (prefix.Bits() + 7) / 8
+		span := 1 << (whole*8 - prefix.Bits())
+		for n := 0; n < span; n++ {
+			parts := make([]string, 4)
+			for i := range parts {
+				switch {
+				case i == whole-1:
+					parts[i] = fmt.Sprint(int(octets[i]) + n)
+				case i < whole:
+					parts[i] = fmt.Sprint(octets[i])
+				default:
+					parts[i] = "*"
+				}
+			}
+			entries = append(entries, strings.Join(parts, "."))
+		}
+	}
+	return MergeTransmissionWhitelist(nil, entries)
+}
+
+// MergeTransmissionWhitelist returns the sorted union of two sets of whitelist
+// entries, so the rendered file doesn't depend on the order Nodes are listed in
+func MergeTransmissionWhitelist(known, entries []string) []string {
+	merged := mergeWhitelist(known, entries)
+	sort.Strings(merged)
+	return merged
+}
+
+// TransmissionHostWhitelist returns the rpc-host-whitelist names a connection URL's
+// Service answers to in-cluster. A short name (transmission or transmission.media)
+// expands to every form the cluster DNS resolves, with the cluster domain as a
+// wildcard; other host names are returned as is. IP addresses and localhost need
+// no entry, as Transmission always accepts them.
+func TransmissionHostWhitelist(rawURL, namespace string) []string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil
+	}
+	host := u.Hostname()
+	if host == "" || host == "localhost" || net.ParseIP(host) != nil {
+		return nil
+	}
+
+	parts := strings.Split(host, ".")
+	switch {
+	case len(parts) == 1:
+		// Resolved in the config's namespace
+	case len(parts) == 2 || parts[2] == "svc":
+		namespace = parts[1]
+	default:
+		return []string{host}
+	}
+	name := parts[0]
+	return []string{
+		name,
+		name + "." + namespace,
+		name + "." + namespace + ".svc",
+		name + "." + namespace + ".svc.*",
+	}
+}
@@ -0,0 +1,309 @@
+package downloadstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+func TestSyncTransmissionSettingsSendsOnlyDrift(t *testing.T) {
+	client := NewMockTransmissionClient()
+	input := &TransmissionSettingsInput{Spec: &arrv1alpha1.TransmissionSpec{
+		// Matches the mock's default session
+		Peers: &arrv1alpha1.TransmissionPeersSpec{LimitGlobal: 200, LimitPerTorrent: 50, Port: 51413},
+		// Differs from the mock's default session
+		Seeding: &arrv1alpha1.TransmissionSeedingSpec{RatioLimit: "2.0", RatioLimited: true},
+	}}
+
+	result, err := SyncTransmissionSettings(context.Background(), client, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Drifted, []string{"seeding"}) {
+		t.Errorf("expected drift in [seeding], got %v", result.Drifted)
+	}
+	if len(client.SetSessionCalls) != 1 {
+		t.Fatalf("expected 1 session-set, got %d", len(client.SetSessionCalls))
+	}
+	expected := map[string]interface{}{"seedRatioLimit": 2.0, "seedRatioLimited": true}
+	if !reflect.DeepEqual(client.SetSessionCalls[0], expected) {
+		t.Errorf("expected delta %v, got %v", expected, client.SetSessionCalls[0])
+	}
+
+	// A spec that already matches sends nothing
+	input.Spec.Seeding = nil
+	result, err = SyncTransmissionSettings(context.Background(), client, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Drifted) != 0 || len(client.SetSessionCalls) != 1 {
+		t.Errorf("expected no drift and no session-set, got drift %v and %d calls", result.Drifted, len(client.SetSessionCalls))
+	}
+}
+
+func TestSyncTransmissionSettingsSkipsKeysUnknownToRPCVersion(t *testing.T) {
+	client := NewMockTransmissionClient().WithSession(&TransmissionSession{Version: "2.33", RPCVersion: 13})
+	input := &TransmissionSettingsInput{Spec: &arrv1alpha1.TransmissionSpec{
+		Queue: &arrv1alpha1.TransmissionQueueSpec{DownloadSize: 5, DownloadEnabled: true},
+		Peers: &arrv1alpha1.TransmissionPeersSpec{LimitGlobal: 100},
+	}}
+
+	result, err := SyncTransmissionSettings(context.Background(), client, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Drifted, []string{"peers"}) {
+		t.Errorf("expected drift in [peers], got %v", result.Drifted)
+	}
+	if len(result.Unrealized) != 6 || result.Unrealized[0].Feature != "transmission:download-queue-enabled" {
+		t.Errorf("expected the 6 queue keys as unrealized, got %v", result.Unrealized)
+	}
+	for key := range client.SetSessionCalls[0] {
+		if _, queued := transmissionKeyMinRPCVersion[key]; queued {
+			t.Errorf("sent %s to an RPC 13 session", key)
+		}
+	}
+}
+
+func TestTransmissionSettingsDropUnsupportedBoundaries(t *testing.T) {
+	spec := &arrv1alpha1.TransmissionSpec{
+		Queue: &arrv1alpha1.TransmissionQueueSpec{DownloadSize: 5, DownloadEnabled: true},
+	}
+	tests := []struct {
+		name       string
+		rpcVersion int
+		dropped    int
+	}{
+		{"unknown RPC version keeps everything", 0, 0},
+		{"just before the queue keys", 13, 6},
+		{"the version that added the queue keys", 14, 0},
+		{"Transmission 4", 17, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := buildTransmissionSettings(spec)
+			unrealized := settings.dropUnsupported(tt.rpcVersion)
+			if len(unrealized) != tt.dropped {
+				t.Errorf("dropUnsupported(%d) reported %v, want %d keys", tt.rpcVersion, unrealized, tt.dropped)
+			}
+			if _, kept := settings["queue"]; kept != (tt.dropped == 0) {
+				t.Errorf("dropUnsupported(%d) left the queue group = %v", tt.rpcVersion, kept)
+			}
+		})
+	}
+}
+
+func TestSyncTransmissionSettingsDefaultTrackers(t *testing.T) {
+	trackers := "udp://a/announce\n\nudp://b/announce"
+	client := NewMockTransmissionClient().WithSession(&TransmissionSession{Version: "4.0.0", RPCVersion: 17})
+	input := &TransmissionSettingsInput{Spec: &arrv1alpha1.TransmissionSpec{}, DefaultTrackers: &trackers}
+
+	result, err := SyncTransmissionSettings(context.Background(), client, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Drifted, []string{"trackers"}) {
+		t.Errorf("expected drift in [trackers], got %v", result.Drifted)
+	}
+	if got := client.SetSessionCalls[0]["default-trackers"]; got != trackers {
+		t.Errorf("expected default-trackers %q, got %v", trackers, got)
+	}
+
+	// Transmission 3 has no default trackers
+	client = NewMockTransmissionClient().WithSession(&TransmissionSession{Version: "3.00", RPCVersion: 16})
+	result, err = SyncTransmissionSettings(context.Background(), client, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Unrealized) != 1 || result.Unrealized[0].Feature != "transmission:default-trackers" {
+		t.Errorf("expected default-trackers unrealized, got %v", result.Unrealized)
+	}
+	if len(client.SetSessionCalls) != 0 {
+		t.Errorf("expected no session-set, got %v", client.SetSessionCalls)
+	}
+}
+
+func TestParseClientVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    ClientVersion
+		ok      bool
+	}{
+		{"v5.0.1", ClientVersion{5, 0, 1}, true},
+		{"4.6", ClientVersion{4, 6, 0}, true},
+		{"4.0.5 (a6fe2a64aa)", ClientVersion{4, 0, 5}, true},
+		{"unknown", ClientVersion{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseClientVersion(tt.version)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseClientVersion(%q) = %v, %v; want %v, %v", tt.version, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	if !(ClientVersion{4, 3, 2}).AtLeast(4, 3, 2) || (ClientVersion{4, 3, 1}).AtLeast(4, 3, 2) || !(ClientVersion{5, 0, 0}).AtLeast(4, 6, 0) {
+		t.Error("AtLeast compared versions incorrectly")
+	}
+}
+
+func TestRenderTransmissionSettingsFile(t *testing.T) {
+	spec := &arrv1alpha1.TransmissionSpec{
+		Seeding:  &arrv1alpha1.TransmissionSeedingSpec{RatioLimit: "1.5", RatioLimited: true},
+		Security: &arrv1alpha1.TransmissionSecuritySpec{Encryption: "required"},
+		Peers:    &arrv1alpha1.TransmissionPeersSpec{Port: 51413, PortForwardingEnabled: true},
+		SettingsFile: &arrv1alpha1.TransmissionSettingsFileSpec{
+			RPCWhitelist: []string{"127.0.0.1", "192.168.*.*"},
+		},
+	}
+
+	data, err := RenderTransmissionSettingsFile(&TransmissionSettingsFileInput{Spec: spec})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var file map[string]interface{}
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatalf("rendered file is not JSON: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"ratio-limit":                1.5,
+		"ratio-limit-enabled":        true,
+		"encryption":                 2.0,
+		"peer-port":                  51413.0,
+		"port-forwarding-enabled":    true,
+		"rpc-whitelist":              "127.0.0.1,192.168.*.*",
+		"rpc-whitelist-enabled":      true,
+		"rpc-host-whitelist-enabled": false,
+	}
+	for key, want := range expected {
+		if got, ok := file[key]; !ok || !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: expected %v, got %v", key, want, got)
+		}
+	}
+	if _, ok := file["seedRatioLimit"]; ok {
+		t.Error("expected RPC key seedRatioLimit to be renamed")
+	}
+
+	again, err := RenderTransmissionSettingsFile(&TransmissionSettingsFileInput{Spec: spec})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if HashTransmissionSettingsFile(again) != HashTransmissionSettingsFile(data) {
+		t.Error("expected rendering to be deterministic")
+	}
+}
+
+func TestRenderTransmissionSettingsFileClusterWhitelist(t *testing.T) {
+	required := true
+	input := &TransmissionSettingsFileInput{
+		Spec: &arrv1alpha1.TransmissionSpec{
+			SettingsFile: &arrv1alpha1.TransmissionSettingsFileSpec{
+				RPCWhitelist:              []string{"192.168.*.*", "127.0.0.1"},
+				ClusterWhitelist:          true,
+				RPCAuthenticationRequired: &required,
+			},
+		},
+		Username:             "admin",
+		Password:             "secret",
+		ClusterWhitelist:     []string{"10.244.*.*"},
+		ClusterHostWhitelist: []string{"transmission", "transmission.media"},
+	}
+
+	data, err := RenderTransmissionSettingsFile(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var file map[string]interface{}
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatalf("rendered file is not JSON: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"rpc-whitelist":               "192.168.*.*,127.0.0.1,::1,10.244.*.*",
+		"rpc-whitelist-enabled":       true,
+		"rpc-host-whitelist":          "transmission,transmission.media",
+		"rpc-host-whitelist-enabled":  true,
+		"rpc-authentication-required": true,
+		"rpc-username":                "admin",
+		"rpc-password":                "secret",
+	}
+	for key, want := range expected {
+		if got, ok := file[key]; !ok || !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: expected %v, got %v", key, want, got)
+		}
+	}
+
+	// Without clusterWhitelist the discovered entries are ignored
+	input.Spec.SettingsFile.ClusterWhitelist = false
+	notRequired := false
+	input.Spec.SettingsFile.RPCAuthenticationRequired = &notRequired
+	data, err = RenderTransmissionSettingsFile(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	file = nil
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatalf("rendered file is not JSON: %v", err)
+	}
+	if file["rpc-whitelist"] != "192.168.*.*,127.0.0.1" || file["rpc-host-whitelist-enabled"] != false {
+		t.Errorf("expected only the listed whitelist, got %v and %v", file["rpc-whitelist"], file["rpc-host-whitelist-enabled"])
+	}
+	if _, ok := file["rpc-password"]; ok || file["rpc-authentication-required"] != false {
+		t.Error("expected authentication disabled without credentials")
+	}
+}
+
+func TestTransmissionWhitelistFromCIDRs(t *testing.T) {
+	got := TransmissionWhitelistFromCIDRs([]string{
+		"10.244.1.0/24", "10.244.2.0/24", "10.0.16.0/20", "10.244.1.0/24",
+		"172.16.0.0/12", "fd00::/64", "0.0.0.0/0", "invalid",
+	})
+	want := []string{"10.244.1.*", "10.244.2.*"}
+	for i := 16; i < 32; i++ {
+		want = append(want, fmt.Sprintf("10.0.%d.*", i))
+	}
+	for i := 16; i < 32; i++ {
+		want = append(want, fmt.Sprintf("172.%d.*.*", i))
+	}
+	sort.Strings(want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	// A prefix is never widened beyond its own addresses
+	if got := TransmissionWhitelistFromCIDRs([]string{"10.244.0.0/23"}); !reflect.DeepEqual(got, []string{"10.244.0.*", "10.244.1.*"}) {
+		t.Errorf("expected a /23 split into two /24 wildcards, got %v", got)
+	}
+}
+
+func TestMergeTransmissionWhitelist(t *testing.T) {
+	known := []string{"10.244.1.*", "10.244.3.*"}
+	got := MergeTransmissionWhitelist(known, []string{"10.244.2.*", "10.244.1.*"})
+	if want := []string{"10.244.1.*", "10.244.2.*", "10.244.3.*"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestTransmissionHostWhitelist(t *testing.T) {
+	tests := []struct {
+		url  string
+		want []string
+	}{
+		{"http://transmission:9091", []string{"transmission", "transmission.media", "transmission.media.svc", "transmission.media.svc.*"}},
+		{"http://transmission.downloads:9091", []string{"transmission", "transmission.downloads", "transmission.downloads.svc", "transmission.downloads.svc.*"}},
+		{"http://transmission.downloads.svc.cluster.local:9091", []string{"transmission", "transmission.downloads", "transmission.downloads.svc", "transmission.downloads.svc.*"}},
+		{"https://torrents.example.com", []string{"torrents.example.com"}},
+		{"http://localhost:9091", nil},
+		{"http://10.0.0.5:9091", nil},
+	}
+	for _, tt := range tests {
+		if got := TransmissionHostWhitelist(tt.url, "media"); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("TransmissionHostWhitelist(%q) = %v; want %v", tt.url, got, tt.want)
+		}
+	}
+}
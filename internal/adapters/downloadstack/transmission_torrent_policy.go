@@ -0,0 +1,214 @@
+package downloadstack
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// transmissionLabelsMinRPCVersion is the RPC version that introduced torrent labels (Transmission 3.0)
+const transmissionLabelsMinRPCVersion = 16
+
+// TorrentPolicyResult counts what a torrent policy changed
+type TorrentPolicyResult struct {
+	Torrents int
+	Labeled  int
+	Moved    int
+	Removed  int
+
+	// AwaitingImport counts finished torrents left alone because an *arr app
+	// hasn't imported them yet
+	AwaitingImport int
+
+	// Unrealized lists policy parts the detected RPC version does not support
+	Unrealized []arrv1alpha1.UnrealizedFeature
+}
+
+// removalRule is a TransmissionRemovalRule with its pattern and ratio parsed
+type removalRule struct {
+	spec    arrv1alpha1.TransmissionRemovalRule
+	tracker *regexp.Regexp
+	ratio   float64
+}
+
+// EnforceTransmissionTorrentPolicy walks the torrent list once:
+// finished torrents past a removal rule are removed, torrents of a category
+// missing its label get it, and finished torrents of a category with a
+// completeDir are moved there. A torrent's category is the one named by one of
+// its labels, otherwise the one whose downloadDir holds it. With dryRun the
+// counts are computed without changing anything.
+// Finished torrents whose hash is in queued, the downloads the *arr apps
+// haven't imported yet, are neither moved nor removed.
+func EnforceTransmissionTorrentPolicy(ctx context.Context, client TransmissionClientInterface, policy *arrv1alpha1.TransmissionTorrentPolicySpec, queued map[string]bool) (*TorrentPolicyResult, error) {
+	result := &TorrentPolicyResult{}
+	if policy == nil {
+		return result, nil
+	}
+
+	rules := make([]removalRule, len(policy.RemovalRules))
+	for i, spec := range policy.RemovalRules {
+		rules[i] = removalRule{spec: spec, ratio: -1}
+		if spec.Tracker != "" {
+			re, err := regexp.Compile(spec.Tracker)
+			if err != nil {
+				return nil, fmt.Errorf("invalid tracker pattern of removal rule %s: %w", spec.Name, err)
+			}
+			rules[i].tracker = re
+		}
+		if spec.RatioLimit != "" {
+			ratio, err := strconv.ParseFloat(spec.RatioLimit, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ratio limit of removal rule %s: %w", spec.Name, err)
+			}
+			rules[i].ratio = ratio
+		}
+	}
+
+	session, err := client.GetSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Transmission session: %w", err)
+	}
+	labels := session.RPCVersion == 0 || session.RPCVersion >= transmissionLabelsMinRPCVersion
+	if !labels && len(policy.Categories) > 0 {
+		result.Unrealized = append(result.Unrealized, arrv1alpha1.UnrealizedFeature{
+			Feature: "transmission:torrentPolicy.labels",
+			Reason: fmt.Sprintf("requires RPC version %d, Transmission reports %d",
+				transmissionLabelsMinRPCVersion, session.RPCVersion),
+		})
+	}
+
+	torrents, err := client.GetTorrents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Transmission torrents: %w", err)
+	}
+	result.Torrents = len(torrents)
+
+	removeIDs := make(map[bool][]int) // by deleteData
+	moveIDs := make(map[string][]int) // by location
+	for _, t := range torrents {
+		category := torrentCategory(t, policy.Categories)
+		finished := t.PercentDone >= 1
+		if finished && queued[strings.ToUpper(t.Hash)] {
+			// Moving or removing it would break the app's import
+			finished = false
+			result.AwaitingImport++
+		}
+
+		if rule := removalRuleFor(t, category, rules); finished && rule != nil {
+			removeIDs[rule.spec.DeleteData] = append(removeIDs[rule.spec.DeleteData], t.ID)
+			result.Removed++
+			continue
+		}
+		if category == nil {
+			continue
+		}
+
+		if labels && !hasLabel(t.Labels, category.Name) {
+			result.Labeled++
+			if !policy.DryRun {
+				if err := client.SetTorrentLabels(ctx, t.ID, append(append([]string{}, t.Labels...), category.Name)); err != nil {
+					return nil, fmt.Errorf("failed to label torrent %s: %w", t.Name, err)
+				}
+			}
+		}
+
+		if finished && category.CompleteDir != "" && path.Clean(t.DownloadDir) != path.Clean(category.CompleteDir) {
+			moveIDs[category.CompleteDir] = append(moveIDs[category.CompleteDir], t.ID)
+			result.Moved++
+		}
+	}
+
+	if policy.DryRun {
+		return result, nil
+	}
+
+	locations := make([]string, 0, len(moveIDs))
+	for location := range moveIDs {
+		locations = append(locations, location)
+	}
+	sort.Strings(locations)
+	for _, location := range locations {
+		if err := client.MoveTorrents(ctx, moveIDs[location], location); err != nil {
+			return nil, fmt.Errorf("failed to move torrents to %s: %w", location, err)
+		}
+	}
+	for _, deleteData := range []bool{false, true} {
+		if ids := removeIDs[deleteData]; len(ids) > 0 {
+			if err := client.RemoveTorrents(ctx, ids, deleteData); err != nil {
+				return nil, fmt.Errorf("failed to remove torrents: %w", err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// torrentCategory returns the category named by one of the torrent's labels,
+// otherwise the first whose downloadDir holds the torrent, or nil
+func torrentCategory(t TransmissionTorrent, categories []arrv1alpha1.TransmissionTorrentCategorySpec) *arrv1alpha1.TransmissionTorrentCategorySpec {
+	for i := range categories {
+		if hasLabel(t.Labels, categories[i].Name) {
+			return &categories[i]
+		}
+	}
+	for i := range categories {
+		if dir := categories[i].DownloadDir; dir != "" && pathWithin(t.DownloadDir, dir) {
+			return &categories[i]
+		}
+	}
+	return nil
+}
+
+// removalRuleFor returns the first rule whose tracker and category match the
+// torrent and whose ratio or seeding time limit it reached, or nil
+func removalRuleFor(t TransmissionTorrent, category *arrv1alpha1.TransmissionTorrentCategorySpec, rules []removalRule) *removalRule {
+	for i := range rules {
+		rule := &rules[i]
+		if rule.spec.Category != "" && (category == nil || !strings.EqualFold(category.Name, rule.spec.Category)) {
+			continue
+		}
+		if rule.tracker != nil && !trackerMatches(t, rule.tracker) {
+			continue
+		}
+		// The first matching rule decides, even if its limits are not reached yet
+		ratioReached := rule.ratio >= 0 && t.UploadRatio >= rule.ratio
+		timeReached := rule.spec.SeedingTimeLimit != nil && t.SecondsSeeding >= *rule.spec.SeedingTimeLimit*60
+		if ratioReached || timeReached {
+			return rule
+		}
+		return nil
+	}
+	return nil
+}
+
+// trackerMatches reports whether any announce URL of the torrent matches re
+func trackerMatches(t TransmissionTorrent, re *regexp.Regexp) bool {
+	for _, tracker := range t.Trackers {
+		if re.MatchString(tracker.Announce) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasLabel reports whether labels contains label, ignoring case
+func hasLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if strings.EqualFold(l, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathWithin reports whether p is dir or below it
+func pathWithin(p, dir string) bool {
+	p, dir = path.Clean(p), path.Clean(dir)
+	return p == dir || strings.HasPrefix(p, strings.TrimSuffix(dir, "/")+"/")
+}
@@ -0,0 +1,112 @@
+package downloadstack
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+func testTransmissionTorrent(id int, dir string, done float64, ratio float64, tracker string, labels ...string) TransmissionTorrent {
+	t := TransmissionTorrent{ID: id, Name: "torrent", DownloadDir: dir, PercentDone: done, UploadRatio: ratio, Labels: labels}
+	if tracker != "" {
+		t.Trackers = []TransmissionTracker{{Announce: tracker}}
+	}
+	return t
+}
+
+func TestEnforceTransmissionTorrentPolicy(t *testing.T) {
+	client := NewMockTransmissionClient().WithTorrents([]TransmissionTorrent{
+		// Unlabeled, still downloading: labeled only
+		testTransmissionTorrent(1, "/downloads/tv-sonarr", 0.5, 0, ""),
+		// Finished tv torrent: moved
+		testTransmissionTorrent(2, "/downloads/tv-sonarr/", 1, 0.5, "https://public.example/announce", "tv"),
+		// Already in completeDir: left alone
+		testTransmissionTorrent(3, "/downloads/complete/tv", 1, 0.5, "", "tv"),
+		// Private tracker past its ratio: removed with data
+		testTransmissionTorrent(4, "/downloads/tv-sonarr", 1, 2.5, "https://private.example/announce?passkey=x", "tv"),
+		// Matches the private rule but below its ratio: the later catch-all does not apply
+		testTransmissionTorrent(5, "/downloads/complete/tv", 1, 1.5, "https://private.example/announce", "tv"),
+		// Public torrent past the catch-all ratio: removed
+		testTransmissionTorrent(6, "/downloads/other", 1, 1.2, "https://public.example/announce"),
+	})
+	policy := &arrv1alpha1.TransmissionTorrentPolicySpec{
+		Categories: []arrv1alpha1.TransmissionTorrentCategorySpec{
+			{Name: "tv", DownloadDir: "/downloads/tv-sonarr", CompleteDir: "/downloads/complete/tv"},
+		},
+		RemovalRules: []arrv1alpha1.TransmissionRemovalRule{
+			{Name: "private", Tracker: `private\.example`, RatioLimit: "2.0", DeleteData: true},
+			{Name: "rest", RatioLimit: "1.0", SeedingTimeLimit: ptr.To(60)},
+		},
+	}
+
+	result, err := EnforceTransmissionTorrentPolicy(context.Background(), client, policy, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Torrents != 6 || result.Labeled != 1 || result.Moved != 1 || result.Removed != 2 {
+		t.Errorf("expected 6 torrents, 1 labeled, 1 moved and 2 removed, got %+v", result)
+	}
+	if !reflect.DeepEqual(client.LabelCalls, map[int][]string{1: {"tv"}}) {
+		t.Errorf("expected torrent 1 labeled tv, got %v", client.LabelCalls)
+	}
+	if !reflect.DeepEqual(client.MoveCalls, map[string][]int{"/downloads/complete/tv": {2}}) {
+		t.Errorf("expected torrent 2 moved, got %v", client.MoveCalls)
+	}
+	if !reflect.DeepEqual(client.RemoveCalls, [][]int{{6}, {4}}) {
+		t.Errorf("expected torrent 6 removed, then 4 with its data, got %v", client.RemoveCalls)
+	}
+}
+
+func TestEnforceTransmissionTorrentPolicyAwaitingImport(t *testing.T) {
+	pending := testTransmissionTorrent(1, "/downloads/tv-sonarr", 1, 3, "", "tv")
+	pending.Hash = "abc123"
+	imported := testTransmissionTorrent(2, "/downloads/tv-sonarr", 1, 3, "", "tv")
+	imported.Hash = "def456"
+	client := NewMockTransmissionClient().WithTorrents([]TransmissionTorrent{pending, imported})
+	policy := &arrv1alpha1.TransmissionTorrentPolicySpec{
+		Categories:   []arrv1alpha1.TransmissionTorrentCategorySpec{{Name: "tv", DownloadDir: "/downloads/tv-sonarr", CompleteDir: "/downloads/complete/tv"}},
+		RemovalRules: []arrv1alpha1.TransmissionRemovalRule{{Name: "all", RatioLimit: "2"}},
+	}
+
+	result, err := EnforceTransmissionTorrentPolicy(context.Background(), client, policy, map[string]bool{"ABC123": true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.AwaitingImport != 1 || result.Removed != 1 || result.Moved != 0 {
+		t.Errorf("expected 1 torrent awaiting import and 1 removed, got %+v", result)
+	}
+	if !reflect.DeepEqual(client.RemoveCalls, [][]int{{2}}) {
+		t.Errorf("expected only the imported torrent removed, got %v", client.RemoveCalls)
+	}
+}
+
+func TestEnforceTransmissionTorrentPolicyDryRunAndOldRPC(t *testing.T) {
+	client := NewMockTransmissionClient().
+		WithSession(&TransmissionSession{RPCVersion: 15}).
+		WithTorrents([]TransmissionTorrent{
+			testTransmissionTorrent(1, "/downloads/movies", 1, 3, ""),
+		})
+	policy := &arrv1alpha1.TransmissionTorrentPolicySpec{
+		Categories:   []arrv1alpha1.TransmissionTorrentCategorySpec{{Name: "movies", DownloadDir: "/downloads/movies"}},
+		RemovalRules: []arrv1alpha1.TransmissionRemovalRule{{Name: "all", RatioLimit: "2"}},
+		DryRun:       true,
+	}
+
+	result, err := EnforceTransmissionTorrentPolicy(context.Background(), client, policy, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Removed != 1 {
+		t.Errorf("expected 1 torrent reported as removed, got %d", result.Removed)
+	}
+	if client.RemoveCalls != nil || client.LabelCalls != nil {
+		t.Errorf("expected no changes in a dry run, got removes %v and labels %v", client.RemoveCalls, client.LabelCalls)
+	}
+	if len(result.Unrealized) != 1 || result.Unrealized[0].Feature != "transmission:torrentPolicy.labels" {
+		t.Errorf("expected labels unrealized on RPC 15, got %v", result.Unrealized)
+	}
+}
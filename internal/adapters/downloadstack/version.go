@@ -0,0 +1,40 @@
+package downloadstack
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// clientVersionPattern matches the leading "major.minor[.patch]" of a version string
+var clientVersionPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// ClientVersion is a parsed download client version
+type ClientVersion struct {
+	Major, Minor, Patch int
+}
+
+// ParseClientVersion parses versions like "v5.0.1", "4.6.7" or "4.0.5 (a6fe2a64aa)"
+func ParseClientVersion(version string) (ClientVersion, bool) {
+	m := clientVersionPattern.FindStringSubmatch(version)
+	if m == nil {
+		return ClientVersion{}, false
+	}
+	v := ClientVersion{}
+	v.Major, _ = strconv.Atoi(m[1])
+	v.Minor, _ = strconv.Atoi(m[2])
+	if m[3] != "" {
+		v.Patch, _ = strconv.Atoi(m[3])
+	}
+	return v, true
+}
+
+// AtLeast reports whether v is major.minor.patch or newer
+func (v ClientVersion) AtLeast(major, minor, patch int) bool {
+	if v.Major != major {
+		return v.Major > major
+	}
+	if v.Minor != minor {
+		return v.Minor > minor
+	}
+	return v.Patch >= patch
+}
@@ -0,0 +1,101 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+// ErrorCategory classifies an adapter error by how the controller should react to it
+type ErrorCategory string
+
+const (
+	// ErrorCategoryUnknown is an error that is not classified further; it is retried with backoff
+	ErrorCategoryUnknown ErrorCategory = ""
+
+	// ErrorCategoryAuth is a rejected API key; retrying fast won't help until the key changes
+	ErrorCategoryAuth ErrorCategory = "Auth"
+
+	// ErrorCategoryTransient is a failure expected to clear on its own, such as a
+	// refused connection, a timeout, rate limiting or a proxy reporting the app down
+	ErrorCategoryTransient ErrorCategory = "Transient"
+
+	// ErrorCategoryValidation is a request the app rejected; it fails the same way until the spec changes
+	ErrorCategoryValidation ErrorCategory = "Validation"
+
+	// ErrorCategoryNotFound is a resource the app doesn't have
+	ErrorCategoryNotFound ErrorCategory = "NotFound"
+)
+
+// Error is an adapter error with a category
+type Error struct {
+	Category ErrorCategory
+	Err      error
+}
+
+// Error implements error
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// NewError wraps err with a category. A nil err stays nil.
+func NewError(category ErrorCategory, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Category: category, Err: err}
+}
+
+// CategoryOf returns the category of err: that of the outermost *Error in its
+// chain, otherwise one derived from an HTTP status or network error
+func CategoryOf(err error) ErrorCategory {
+	if err == nil {
+		return ErrorCategoryUnknown
+	}
+
+	var adapterErr *Error
+	if errors.As(err, &adapterErr) {
+		return adapterErr.Category
+	}
+
+	var statusErr *httpclient.StatusError
+	if errors.As(err, &statusErr) {
+		return categoryForStatus(statusErr.Code)
+	}
+
+	var dnsErr *net.DNSError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, syscall.EHOSTUNREACH), errors.As(err, &dnsErr):
+		return ErrorCategoryTransient
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return ErrorCategoryTransient
+	}
+	return ErrorCategoryUnknown
+}
+
+// categoryForStatus classifies an unexpected HTTP status code
+func categoryForStatus(code int) ErrorCategory {
+	switch code {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrorCategoryAuth
+	case http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity:
+		return ErrorCategoryValidation
+	case http.StatusNotFound:
+		return ErrorCategoryNotFound
+	case http.StatusRequestTimeout, http.StatusTooManyRequests,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return ErrorCategoryTransient
+	}
+	return ErrorCategoryUnknown
+}
@@ -0,0 +1,60 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+func TestCategoryOf(t *testing.T) {
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCategory
+	}{
+		{"nil", nil, ErrorCategoryUnknown},
+		{"plain", errors.New("boom"), ErrorCategoryUnknown},
+		{"unauthorized", &httpclient.StatusError{Code: 401}, ErrorCategoryAuth},
+		{"forbidden wrapped", fmt.Errorf("failed to get tags: %w", &httpclient.StatusError{Code: 403}), ErrorCategoryAuth},
+		{"bad request", &httpclient.StatusError{Code: 400, Body: "invalid path"}, ErrorCategoryValidation},
+		{"not found", &httpclient.StatusError{Code: 404}, ErrorCategoryNotFound},
+		{"rate limited", &httpclient.StatusError{Code: 429}, ErrorCategoryTransient},
+		{"bad gateway", &httpclient.StatusError{Code: 502}, ErrorCategoryTransient},
+		{"server error", &httpclient.StatusError{Code: 500}, ErrorCategoryUnknown},
+		{"refused", fmt.Errorf("failed to connect: %w", refused), ErrorCategoryTransient},
+		{"dns", &net.DNSError{Err: "no such host", Name: "radarr"}, ErrorCategoryTransient},
+		{"deadline", fmt.Errorf("request failed: %w", context.DeadlineExceeded), ErrorCategoryTransient},
+		{"explicit", NewError(ErrorCategoryValidation, errors.New("unknown qualities: 8K")), ErrorCategoryValidation},
+		{"explicit over status", NewError(ErrorCategoryNotFound, &httpclient.StatusError{Code: 500}), ErrorCategoryNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CategoryOf(tt.err); got != tt.want {
+				t.Errorf("CategoryOf(%v) = %q, want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewError(t *testing.T) {
+	if NewError(ErrorCategoryAuth, nil) != nil {
+		t.Error("expected nil for a nil error")
+	}
+
+	cause := errors.New("api key rejected")
+	err := NewError(ErrorCategoryAuth, cause)
+	if err.Error() != cause.Error() {
+		t.Errorf("expected message %q, got %q", cause.Error(), err.Error())
+	}
+	if !errors.Is(err, cause) {
+		t.Error("expected the cause to be unwrapped")
+	}
+}
@@ -7,16 +7,33 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
+
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
 // DefaultTimeout is the default HTTP request timeout.
 const DefaultTimeout = 30 * time.Second
 
+// StatusError is returned for a response with an unexpected status code.
+type StatusError struct {
+	Code int
+	Body string
+}
+
+// Error implements error.
+func (e *StatusError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("unexpected status %d", e.Code)
+	}
+	return fmt.Sprintf("unexpected status %d: %s", e.Code, e.Body)
+}
+
 // Client is an HTTP client for *arr API communication.
 // It handles authentication via X-Api-Key header and JSON serialization.
 type Client struct {
@@ -39,10 +56,46 @@ type Config struct {
 
 	// Timeout is the HTTP request timeout (defaults to DefaultTimeout if zero)
 	Timeout time.Duration
+
+	// ExtraHeaders are set on every request (e.g., for forward-auth proxies)
+	ExtraHeaders map[string]string
+
+	// ClientCert and ClientKey are a PEM-encoded client certificate for mutual TLS
+	ClientCert string
+	ClientKey  string
+
+	// CACert is a PEM-encoded CA bundle used instead of the system roots
+	CACert string
+}
+
+// ConfigForConnection returns the Config for reaching the service described by conn.
+func ConfigForConnection(conn *irv1.ConnectionIR) Config {
+	return Config{
+		BaseURL:            conn.URL,
+		APIKey:             conn.APIKey,
+		InsecureSkipVerify: conn.InsecureSkipVerify,
+		ExtraHeaders:       conn.ExtraHeaders,
+		ClientCert:         conn.ClientCert,
+		ClientKey:          conn.ClientKey,
+		CACert:             conn.CACert,
+		Timeout:            conn.Timeout,
+	}
 }
 
 // New creates a new HTTP client with the given configuration.
 func New(cfg Config) *Client {
+	return &Client{
+		baseURL:    cfg.BaseURL,
+		apiKey:     cfg.APIKey,
+		httpClient: NewHTTPClient(cfg),
+	}
+}
+
+// NewHTTPClient creates an *http.Client honoring the TLS and header settings of cfg.
+// Adapters built on generated API clients use it in place of New.
+// Clients for the same target and TLS settings share one connection pool.
+// If the certificates in cfg can't be loaded, every request fails with that error.
+func NewHTTPClient(cfg Config) *http.Client {
 	timeout := cfg.Timeout
 	if timeout == 0 {
 		timeout = DefaultTimeout
@@ -52,39 +105,135 @@ func New(cfg Config) *Client {
 		Timeout: timeout,
 	}
 
-	if cfg.InsecureSkipVerify {
-		hc.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // User explicitly requested insecure
+	transport, err := sharedTransport(cfg)
+	if err != nil {
+		transport = errTransport{err: err}
+	}
+	hc.Transport = transport
+
+	if len(cfg.ExtraHeaders) > 0 {
+		hc.Transport = &headerTransport{headers: cfg.ExtraHeaders, next: hc.Transport}
+	}
+
+	return hc
+}
+
+// tlsConfigFor builds the TLS configuration for cfg, or nil if the defaults apply.
+func tlsConfigFor(cfg Config) (*tls.Config, error) {
+	if !cfg.InsecureSkipVerify && cfg.ClientCert == "" && cfg.CACert == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // User explicitly requested insecure
+	}
+
+	if cfg.ClientCert != "" {
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCert), []byte(cfg.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
 		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
 	}
 
-	return &Client{
-		baseURL:    cfg.BaseURL,
-		apiKey:     cfg.APIKey,
-		httpClient: hc,
+	if cfg.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.CACert)) {
+			return nil, fmt.Errorf("invalid CA certificate: no PEM certificates found")
+		}
+		tlsConfig.RootCAs = pool
 	}
+
+	return tlsConfig, nil
+}
+
+// headerTransport sets extra headers on every request before passing it on.
+type headerTransport struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// errTransport fails every request with err.
+type errTransport struct {
+	err error
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t errTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+	return nil, t.err
 }
 
 // Get performs a GET request and decodes the JSON response into result.
 func (c *Client) Get(ctx context.Context, path string, result interface{}) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	resp, err := c.get(ctx, path)
 	if err != nil {
 		return err
 	}
-	req.Header.Set("X-Api-Key", c.apiKey)
+	defer func() { _ = resp.Body.Close() }()
 
-	resp, err := c.httpClient.Do(req)
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// GetEach performs a GET request for a JSON array and calls fn with each element
+// as it is decoded, so large lists are never held in memory as a whole.
+// Decoding stops at the first error fn returns.
+func GetEach[T any](ctx context.Context, c *Client, path string, fn func(item *T) error) error {
+	resp, err := c.get(ctx, path)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	dec := json.NewDecoder(resp.Body)
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('[') {
+		return fmt.Errorf("expected a JSON array, got %v", tok)
+	}
+	for dec.More() {
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return err
+		}
+		if err := fn(&item); err != nil {
+			return err
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// get performs a GET request, returning the response of a 200 OK and a
+// StatusError for any other status. The caller closes the body.
+func (c *Client) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Api-Key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+		_ = resp.Body.Close()
+		return nil, &StatusError{Code: resp.StatusCode, Body: string(body)}
 	}
-
-	return json.NewDecoder(resp.Body).Decode(result)
+	return resp, nil
 }
 
 // Post performs a POST request with a JSON body and optionally decodes the response.
@@ -113,7 +262,7 @@ func (c *Client) Post(ctx context.Context, path string, body, result interface{}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+		return &StatusError{Code: resp.StatusCode, Body: string(body)}
 	}
 
 	if result != nil {
@@ -148,7 +297,7 @@ func (c *Client) Put(ctx context.Context, path string, body, result interface{})
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+		return &StatusError{Code: resp.StatusCode, Body: string(body)}
 	}
 
 	if result != nil {
@@ -179,7 +328,7 @@ func (c *Client) Delete(ctx context.Context, path string) error {
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+		return &StatusError{Code: resp.StatusCode, Body: string(body)}
 	}
 
 	return nil
@@ -0,0 +1,117 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClientExtraHeadersAndCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "key" || r.Header.Get("Remote-User") != "nebularr" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"version":"5.0.0"}`))
+	}))
+	defer server.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	c := New(Config{
+		BaseURL:      server.URL,
+		APIKey:       "key",
+		ExtraHeaders: map[string]string{"Remote-User": "nebularr"},
+		CACert:       string(ca),
+	})
+
+	var status struct {
+		Version string `json:"version"`
+	}
+	if err := c.Get(context.Background(), "/api/v3/system/status", &status); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if status.Version != "5.0.0" {
+		t.Errorf("version = %q, want %q", status.Version, "5.0.0")
+	}
+}
+
+func TestClientInvalidClientCertificate(t *testing.T) {
+	c := New(Config{
+		BaseURL:    "https://radarr.invalid",
+		ClientCert: "not a certificate",
+		ClientKey:  "not a key",
+	})
+
+	err := c.Get(context.Background(), "/api/v3/system/status", nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid client certificate") {
+		t.Errorf("Get() error = %v, want invalid client certificate", err)
+	}
+}
+
+func TestClientStatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("Unauthorized"))
+	}))
+	defer server.Close()
+
+	err := New(Config{BaseURL: server.URL}).Delete(context.Background(), "/api/v3/tag/1")
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("Delete() error = %v, want a StatusError", err)
+	}
+	if statusErr.Code != http.StatusUnauthorized || err.Error() != "unexpected status 401: Unauthorized" {
+		t.Errorf("Delete() error = %v", err)
+	}
+}
+
+func TestGetEach(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":1,"name":"a"},{"id":2,"name":"b"},{"id":3,"name":"c"}]`))
+	}))
+	defer server.Close()
+
+	type item struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	c := New(Config{BaseURL: server.URL})
+
+	var names []string
+	err := GetEach(context.Background(), c, "/api/v3/customformat", func(i *item) error {
+		names = append(names, i.Name)
+		return nil
+	})
+	if err != nil || strings.Join(names, ",") != "a,b,c" {
+		t.Fatalf("GetEach() = %v, %v", names, err)
+	}
+
+	stop := errors.New("stop")
+	names = nil
+	err = GetEach(context.Background(), c, "/api/v3/customformat", func(i *item) error {
+		names = append(names, i.Name)
+		if i.ID == 2 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || len(names) != 2 {
+		t.Errorf("GetEach() = %v, %v, want to stop after the second item", names, err)
+	}
+}
+
+func TestGetEachNotArray(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"page":1}`))
+	}))
+	defer server.Close()
+
+	err := GetEach(context.Background(), New(Config{BaseURL: server.URL}), "/api/v3/queue", func(*struct{}) error { return nil })
+	if err == nil {
+		t.Error("GetEach() error = nil, want an error for a non-array body")
+	}
+}
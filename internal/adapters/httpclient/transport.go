@@ -0,0 +1,108 @@
+package httpclient
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/poiley/nebularr-operator/internal/metrics"
+)
+
+// Pool settings of the shared transports. Every reconcile creates new clients,
+// so connections are kept in one transport per target instead of per client.
+const (
+	// MaxIdleConnsPerHost is the number of idle connections kept per target
+	MaxIdleConnsPerHost = 16
+
+	// IdleConnTimeout closes idle connections after this long
+	IdleConnTimeout = 90 * time.Second
+
+	// transportExpiry drops transports no client was created for in this long,
+	// e.g. after a config was deleted or its certificates rotated
+	transportExpiry = time.Hour
+)
+
+// transportKey identifies a target and the TLS options used to reach it
+type transportKey struct {
+	target   string // scheme://host
+	insecure bool
+	tls      string // hash of the client certificate, key and CA bundle
+}
+
+// cachedTransport is a pooled transport and when a client last used it
+type cachedTransport struct {
+	transport *http.Transport
+	tracked   http.RoundTripper
+	lastUsed  time.Time
+}
+
+var (
+	transportsMu sync.Mutex
+	transports   = map[transportKey]*cachedTransport{}
+)
+
+// sharedTransport returns the pooled transport for the target and TLS
+// options of cfg, creating it on first use
+func sharedTransport(cfg Config) (http.RoundTripper, error) {
+	target, host := cfg.BaseURL, cfg.BaseURL
+	if u, err := url.Parse(cfg.BaseURL); err == nil && u.Host != "" {
+		target, host = u.Scheme+"://"+u.Host, u.Host
+	}
+	key := transportKey{target: target, insecure: cfg.InsecureSkipVerify}
+	if cfg.ClientCert != "" || cfg.CACert != "" {
+		sum := sha256.Sum256([]byte(cfg.ClientCert + "\x00" + cfg.ClientKey + "\x00" + cfg.CACert))
+		key.tls = hex.EncodeToString(sum[:])
+	}
+
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+
+	now := time.Now()
+	if cached, ok := transports[key]; ok {
+		cached.lastUsed = now
+		return cached.tracked, nil
+	}
+
+	tlsConfig, err := tlsConfigFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.MaxIdleConnsPerHost = MaxIdleConnsPerHost
+	transport.IdleConnTimeout = IdleConnTimeout
+
+	for k, cached := range transports {
+		if now.Sub(cached.lastUsed) > transportExpiry {
+			cached.transport.CloseIdleConnections()
+			delete(transports, k)
+		}
+	}
+	cached := &cachedTransport{
+		transport: transport,
+		tracked:   &reuseTransport{host: host, next: transport},
+		lastUsed:  now,
+	}
+	transports[key] = cached
+	return cached.tracked, nil
+}
+
+// reuseTransport records whether each request reused a pooled connection
+type reuseTransport struct {
+	host string
+	next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *reuseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			metrics.RecordHTTPConnection(t.host, info.Reused)
+		},
+	}
+	return t.next.RoundTrip(req.WithContext(httptrace.WithClientTrace(req.Context(), trace)))
+}
@@ -0,0 +1,65 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/poiley/nebularr-operator/internal/metrics"
+)
+
+func TestSharedTransport(t *testing.T) {
+	a, err := sharedTransport(Config{BaseURL: "http://radarr:7878/radarr"})
+	if err != nil {
+		t.Fatalf("sharedTransport() error = %v", err)
+	}
+	b, _ := sharedTransport(Config{BaseURL: "http://radarr:7878", APIKey: "other"})
+	if a != b {
+		t.Error("clients of the same target got different transports")
+	}
+	if c, _ := sharedTransport(Config{BaseURL: "http://radarr:7878", InsecureSkipVerify: true}); c == a {
+		t.Error("clients with different TLS options share a transport")
+	}
+	if c, _ := sharedTransport(Config{BaseURL: "http://sonarr:8989"}); c == a {
+		t.Error("clients of different targets share a transport")
+	}
+	if _, err := sharedTransport(Config{BaseURL: "https://radarr:7878", CACert: "not a certificate"}); err == nil {
+		t.Error("sharedTransport() with an invalid CA succeeded")
+	}
+}
+
+func TestConnectionReuse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	host := mustHost(t, server.URL)
+
+	var result map[string]interface{}
+	for i := 0; i < 3; i++ {
+		// A new client per request, as reconciles create them
+		if err := New(Config{BaseURL: server.URL}).Get(context.Background(), "/api/v3/system/status", &result); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+
+	if got := testutil.ToFloat64(metrics.HTTPConnections.WithLabelValues(host, "false")); got != 1 {
+		t.Errorf("new connections = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(metrics.HTTPConnections.WithLabelValues(host, "true")); got != 2 {
+		t.Errorf("reused connections = %v, want 2", got)
+	}
+}
+
+func mustHost(t *testing.T, rawURL string) string {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u.Host
+}
@@ -14,6 +14,13 @@ import (
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
+// ownedEndpoints are the collections of the resource types carrying the ownership tag
+var ownedEndpoints = map[string]string{
+	adapters.ResourceDownloadClient: "/api/v1/downloadclient",
+	adapters.ResourceIndexer:        "/api/v1/indexer",
+	adapters.ResourceNotification:   "/api/v1/notification",
+}
+
 // Adapter implements the adapters.Adapter interface for Lidarr
 type Adapter struct{}
 
@@ -98,11 +105,11 @@ func (a *Adapter) CurrentState(ctx context.Context, conn *irv1.ConnectionIR) (*i
 		Connection:  conn,
 	}
 
-	// Get ownership tag ID
-	tagID, err := a.getOwnershipTagID(ctx, c)
+	// Get ownership tag ID. Without the tag nothing is tagged as managed yet, but
+	// resources recognised by name (e.g., nebularr- quality profiles) are still read.
+	tagID, err := a.getOwnershipTagID(ctx, c, conn)
 	if err != nil {
-		// No ownership tag means no managed resources
-		return ir, nil
+		tagID = 0
 	}
 
 	// Get quality profiles
@@ -172,6 +179,11 @@ func (a *Adapter) CurrentState(ctx context.Context, conn *irv1.ConnectionIR) (*i
 		ir.DelayProfiles = delayProfiles
 	}
 
+	// Get release profiles (all of them, they have no name or ownership tag)
+	if releaseProfiles, err := a.getReleaseProfiles(ctx, c); err == nil {
+		ir.ReleaseProfiles = releaseProfiles
+	}
+
 	return ir, nil
 }
 
@@ -228,6 +240,11 @@ func (a *Adapter) Diff(current, desired *irv1.IR, caps *adapters.Capabilities) (
 		return nil, fmt.Errorf("failed to diff delay profiles: %w", err)
 	}
 
+	// Diff release profiles
+	if err := a.diffReleaseProfiles(current, desired, changes); err != nil {
+		return nil, fmt.Errorf("failed to diff release profiles: %w", err)
+	}
+
 	return changes, nil
 }
 
@@ -236,15 +253,18 @@ func (a *Adapter) Apply(ctx context.Context, conn *irv1.ConnectionIR, changes *a
 	c := a.newClient(conn)
 
 	// Ensure ownership tag exists
-	tagID, err := a.ensureOwnershipTag(ctx, c)
+	tagID, err := a.ensureOwnershipTag(ctx, c, conn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to ensure ownership tag: %w", err)
 	}
 
-	// Use shared apply loop with adapter-specific callbacks
+	// Use shared apply loop with adapter-specific callbacks. Creates first adopt a
+	// same-named resource still carrying the shared ownership tag.
 	result := shared.ApplyChanges(
 		changes,
-		func(change adapters.Change) error { return a.applyCreate(ctx, c, change, tagID) },
+		shared.AdoptingCreate(ctx, c, "v1", ownedEndpoints, tagID, func(change adapters.Change) error {
+			return a.applyCreate(ctx, c, change, tagID)
+		}),
 		func(change adapters.Change) error { return a.applyUpdate(ctx, c, change, tagID) },
 		func(change adapters.Change) error { return a.applyDelete(ctx, c, change) },
 	)
@@ -252,6 +272,9 @@ func (a *Adapter) Apply(ctx context.Context, conn *irv1.ConnectionIR, changes *a
 	return result, nil
 }
 
+// Ensure Adapter implements DirectApplier
+var _ adapters.DirectApplier = (*Adapter)(nil)
+
 // ApplyDirect applies configuration directly from IR (not via ChangeSet)
 // This is used for resources like import lists, media management, and authentication
 // that use a different sync pattern (direct apply rather than diff-based)
@@ -259,7 +282,7 @@ func (a *Adapter) ApplyDirect(ctx context.Context, conn *irv1.ConnectionIR, ir *
 	c := a.newClient(conn)
 
 	// Ensure ownership tag exists
-	tagID, err := a.ensureOwnershipTag(ctx, c)
+	tagID, err := a.ensureOwnershipTag(ctx, c, conn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to ensure ownership tag: %w", err)
 	}
@@ -290,13 +313,41 @@ func (a *Adapter) ApplyDirect(ctx context.Context, conn *irv1.ConnectionIR, ir *
 	return result, nil
 }
 
+// Ensure Adapter implements PathChecker
+var _ adapters.PathChecker = (*Adapter)(nil)
+
+// CheckPath looks a root folder path up through Lidarr's filesystem API
+func (a *Adapter) CheckPath(ctx context.Context, conn *irv1.ConnectionIR, path string) error {
+	return shared.CheckPath(ctx, a.newClient(conn), "v1", path)
+}
+
+// Ensure Adapter implements RawRequester
+var _ adapters.RawRequester = (*Adapter)(nil)
+
+// SendRaw sends an unmodeled API request to Lidarr (spec.raw)
+func (a *Adapter) SendRaw(ctx context.Context, conn *irv1.ConnectionIR, method, path string, body []byte) error {
+	return shared.SendRaw(ctx, a.newClient(conn), method, path, body)
+}
+
+// Ensure Adapter implements RefReader
+var _ adapters.RefReader = (*Adapter)(nil)
+
+// ManagedRefs picks the Lidarr IDs of the resources declared in desired out of current
+func (a *Adapter) ManagedRefs(current, desired *irv1.IR) *adapters.ManagedRefs {
+	return shared.ManagedRefsFromIR(current, desired)
+}
+
+// Ensure Adapter implements QueueReader
+var _ adapters.QueueReader = (*Adapter)(nil)
+
+// QueuedDownloadIDs returns the download IDs of the Lidarr queue
+func (a *Adapter) QueuedDownloadIDs(ctx context.Context, conn *irv1.ConnectionIR) (map[string]bool, error) {
+	return shared.ReadQueuedDownloadIDs(ctx, a.newClient(conn), "/api/v1/queue?includeUnknownArtistItems=true")
+}
+
 // newClient creates a new HTTP client for Lidarr API communication
 func (a *Adapter) newClient(conn *irv1.ConnectionIR) *httpclient.Client {
-	return httpclient.New(httpclient.Config{
-		BaseURL:            conn.URL,
-		APIKey:             conn.APIKey,
-		InsecureSkipVerify: conn.InsecureSkipVerify,
-	})
+	return httpclient.New(httpclient.ConfigForConnection(conn))
 }
 
 // Ensure Adapter implements HealthChecker
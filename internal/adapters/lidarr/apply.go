@@ -28,7 +28,9 @@ func (a *Adapter) applyCreate(ctx context.Context, c *httpclient.Client, change
 	case adapters.ResourceCustomFormat:
 		return a.createCustomFormat(ctx, c, change.Payload.(*irv1.CustomFormatIR))
 	case adapters.ResourceDelayProfile:
-		return a.createDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR), tagID)
+		return a.createDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR))
+	case adapters.ResourceReleaseProfile:
+		return a.createReleaseProfile(ctx, c, change.Payload.(*irv1.ReleaseProfileIR))
 	default:
 		return fmt.Errorf("unsupported resource type for create: %s", change.ResourceType)
 	}
@@ -52,7 +54,11 @@ func (a *Adapter) applyUpdate(ctx context.Context, c *httpclient.Client, change
 	case adapters.ResourceCustomFormat:
 		return a.updateCustomFormat(ctx, c, change.Payload.(*irv1.CustomFormatIR))
 	case adapters.ResourceDelayProfile:
-		return a.updateDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR), tagID)
+		return a.updateDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR))
+	case adapters.ResourceDelayProfileOrder:
+		return shared.ReorderDelayProfiles(ctx, c, "v1", change.Payload.([]string))
+	case adapters.ResourceReleaseProfile:
+		return a.updateReleaseProfile(ctx, c, *change.ID, change.Payload.(*irv1.ReleaseProfileIR))
 	default:
 		return fmt.Errorf("unsupported resource type for update: %s", change.ResourceType)
 	}
@@ -81,6 +87,8 @@ func (a *Adapter) applyDelete(ctx context.Context, c *httpclient.Client, change
 		return a.deleteCustomFormat(ctx, c, *change.ID)
 	case adapters.ResourceDelayProfile:
 		return a.deleteDelayProfile(ctx, c, *change.ID)
+	case adapters.ResourceReleaseProfile:
+		return a.deleteReleaseProfile(ctx, c, *change.ID)
 	default:
 		return fmt.Errorf("unsupported resource type for delete: %s", change.ResourceType)
 	}
@@ -264,6 +272,9 @@ func (a *Adapter) downloadClientFromIR(dc irv1.DownloadClientIR, tagID int) Down
 		RemoveCompletedDownloads: dc.RemoveCompletedDownloads,
 		RemoveFailedDownloads:    dc.RemoveFailedDownloads,
 	}
+	if dc.APIKey != "" {
+		resource.Fields = append(resource.Fields, Field{Name: "apiKey", Value: dc.APIKey})
+	}
 	return resource
 }
 
@@ -14,17 +14,15 @@ import (
 // This is used by CurrentState to get the current state for diffing
 // Note: Requires Lidarr v2.0+
 func (a *Adapter) getAllCustomFormats(ctx context.Context, c *httpclient.Client) ([]irv1.CustomFormatIR, error) {
-	var customFormats []CustomFormatResource
-	if err := c.Get(ctx, "/api/v1/customformat", &customFormats); err != nil {
+	var result []irv1.CustomFormatIR
+	err := httpclient.GetEach(ctx, c, "/api/v1/customformat", func(cf *CustomFormatResource) error {
+		result = append(result, a.customFormatToIR(cf))
+		return nil
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to get custom formats: %w", err)
 	}
 
-	result := make([]irv1.CustomFormatIR, 0, len(customFormats))
-	for _, cf := range customFormats {
-		ir := a.customFormatToIR(&cf)
-		result = append(result, ir)
-	}
-
 	return result, nil
 }
 
@@ -148,29 +146,7 @@ func (a *Adapter) diffCustomFormats(current, desired *irv1.IR, changes *adapters
 
 // customFormatsEqual checks if two custom formats are equal (ignoring ID)
 func customFormatsEqual(a, b irv1.CustomFormatIR) bool {
-	if a.IncludeWhenRenaming != b.IncludeWhenRenaming {
-		return false
-	}
-
-	if len(a.Specifications) != len(b.Specifications) {
-		return false
-	}
-
-	// Compare specifications
-	for i, specA := range a.Specifications {
-		specB := b.Specifications[i]
-		if specA.Type != specB.Type || specA.Name != specB.Name {
-			return false
-		}
-		if specA.Negate != specB.Negate || specA.Required != specB.Required {
-			return false
-		}
-		if specA.Value != specB.Value {
-			return false
-		}
-	}
-
-	return true
+	return adapters.CustomFormatsEqual(&a, &b)
 }
 
 // createCustomFormat creates a custom format in Lidarr
@@ -12,18 +12,25 @@ import (
 
 // getManagedDelayProfiles retrieves delay profiles
 // Unlike other resources, we manage ALL delay profiles (not just tagged ones)
-// because Lidarr creates a default delay profile that we may need to modify
+// because Lidarr creates a default delay profile that we may need to modify.
+// Profiles are identified by their tag set, so tag IDs are labeled with names.
 func (a *Adapter) getManagedDelayProfiles(ctx context.Context, c *httpclient.Client) ([]irv1.DelayProfileIR, error) {
 	var profiles []DelayProfileResource
 	if err := c.Get(ctx, "/api/v1/delayprofile", &profiles); err != nil {
 		return nil, fmt.Errorf("failed to get delay profiles: %w", err)
 	}
 
+	labels, err := shared.GetTagLabels(ctx, c, "v1")
+	if err != nil {
+		return nil, err
+	}
+
 	result := make([]irv1.DelayProfileIR, 0, len(profiles))
 	for _, p := range profiles {
 		ir := a.delayProfileToIR(&p)
 		result = append(result, ir)
 	}
+	shared.LabelDelayProfileTags(result, labels)
 
 	return result, nil
 }
@@ -96,16 +103,12 @@ func (a *Adapter) diffDelayProfiles(current, desired *irv1.IR, changes *adapters
 	return nil
 }
 
-// createDelayProfile creates a new delay profile
-func (a *Adapter) createDelayProfile(ctx context.Context, c *httpclient.Client, ir *irv1.DelayProfileIR, tagID int) error {
-	// Convert tag names to IDs if needed
-	var tagIDs []int
-	if tagID > 0 && len(ir.TagNames) == 0 {
-		// Use the ownership tag
-		tagIDs = []int{tagID}
+// createDelayProfile creates a new delay profile, creating any tags it references
+func (a *Adapter) createDelayProfile(ctx context.Context, c *httpclient.Client, ir *irv1.DelayProfileIR) error {
+	tagIDs, err := shared.EnsureTagIDs(ctx, c, "v1", ir.TagNames)
+	if err != nil {
+		return err
 	}
-	// If ir.Tags is populated (from resolved tag names), use those
-	tagIDs = append(tagIDs, ir.Tags...)
 
 	profile := a.irToDelayProfile(ir, tagIDs)
 
@@ -113,13 +116,11 @@ func (a *Adapter) createDelayProfile(ctx context.Context, c *httpclient.Client,
 }
 
 // updateDelayProfile updates an existing delay profile
-func (a *Adapter) updateDelayProfile(ctx context.Context, c *httpclient.Client, ir *irv1.DelayProfileIR, tagID int) error {
-	// Convert tag names to IDs if needed
-	var tagIDs []int
-	if tagID > 0 && len(ir.TagNames) == 0 {
-		tagIDs = []int{tagID}
+func (a *Adapter) updateDelayProfile(ctx context.Context, c *httpclient.Client, ir *irv1.DelayProfileIR) error {
+	tagIDs, err := shared.EnsureTagIDs(ctx, c, "v1", ir.TagNames)
+	if err != nil {
+		return err
 	}
-	tagIDs = append(tagIDs, ir.Tags...)
 
 	profile := a.irToDelayProfile(ir, tagIDs)
 	profile.ID = ir.ID
@@ -170,7 +170,7 @@ func (a *Adapter) diffNotifications(current, desired *irv1.IR, changes *adapters
 // notificationsEqual checks if two notifications are equal (ignoring ID)
 func notificationsEqual(a, b irv1.NotificationIR) bool {
 	// Compare implementation and events
-	if a.Implementation != b.Implementation {
+	if !adapters.ImplementationsEqual(a.Implementation, b.Implementation) {
 		return false
 	}
 	if a.OnGrab != b.OnGrab || a.OnUpgrade != b.OnUpgrade || a.OnRename != b.OnRename {
@@ -196,17 +196,8 @@ func notificationsEqual(a, b irv1.NotificationIR) bool {
 		return false
 	}
 
-	// Compare fields (simplified)
-	if len(a.Fields) != len(b.Fields) {
-		return false
-	}
-	for k, v := range a.Fields {
-		if bv, ok := b.Fields[k]; !ok || fmt.Sprintf("%v", v) != fmt.Sprintf("%v", bv) {
-			return false
-		}
-	}
-
-	return true
+	// The app returns every field of the schema; only the configured ones matter
+	return adapters.FieldsEqual(a.Fields, b.Fields)
 }
 
 // createNotification creates a notification in Lidarr
@@ -34,6 +34,7 @@ func (a *Adapter) getManagedQualityProfiles(ctx context.Context, c *httpclient.C
 // profileToIR converts a Lidarr quality profile to IR
 func (a *Adapter) profileToIR(p *QualityProfileResource) *irv1.AudioQualityIR {
 	ir := &irv1.AudioQualityIR{
+		ID:             p.ID,
 		ProfileName:    p.Name,
 		UpgradeAllowed: p.UpgradeAllowed,
 	}
@@ -0,0 +1,257 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// getReleaseProfiles retrieves all release profiles.
+// Lidarr release profiles have neither a name nor an ownership tag (tags restrict
+// which artists a profile applies to), so like delay profiles they are managed as
+// a whole. Tag IDs are labeled with names for comparison.
+func (a *Adapter) getReleaseProfiles(ctx context.Context, c *httpclient.Client) ([]irv1.ReleaseProfileIR, error) {
+	var profiles []ReleaseProfileResource
+	if err := c.Get(ctx, "/api/v1/releaseprofile", &profiles); err != nil {
+		return nil, fmt.Errorf("failed to get release profiles: %w", err)
+	}
+
+	labels, err := shared.GetTagLabels(ctx, c, "v1")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]irv1.ReleaseProfileIR, 0, len(profiles))
+	for _, p := range profiles {
+		ir := a.releaseProfileToIR(&p)
+		for _, id := range ir.Tags {
+			if label, ok := labels[id]; ok {
+				ir.TagNames = append(ir.TagNames, label)
+			}
+		}
+		result = append(result, ir)
+	}
+
+	return result, nil
+}
+
+// releaseProfileToIR converts a Lidarr release profile to IR
+func (a *Adapter) releaseProfileToIR(p *ReleaseProfileResource) irv1.ReleaseProfileIR {
+	ir := irv1.ReleaseProfileIR{
+		ID:                           shared.IntPtr(p.ID),
+		Name:                         releaseProfileDisplayName(p.Required, p.Ignored),
+		Enabled:                      p.Enabled,
+		Required:                     p.Required,
+		Ignored:                      p.Ignored,
+		IncludePreferredWhenRenaming: p.IncludePreferredWhenRenaming,
+		IndexerID:                    p.IndexerID,
+	}
+
+	for _, term := range p.Preferred {
+		ir.Preferred = append(ir.Preferred, irv1.PreferredTermIR{Term: term.Key, Score: term.Value})
+	}
+
+	if len(p.Tags) > 0 {
+		ir.Tags = make([]int, len(p.Tags))
+		copy(ir.Tags, p.Tags)
+	}
+
+	return ir
+}
+
+// irToReleaseProfile converts IR to a Lidarr release profile resource
+func (a *Adapter) irToReleaseProfile(ir *irv1.ReleaseProfileIR, tagIDs []int) ReleaseProfileResource {
+	p := ReleaseProfileResource{
+		Enabled:                      ir.Enabled,
+		Required:                     TermList{},
+		Ignored:                      TermList{},
+		Preferred:                    []PreferredTermResource{},
+		IncludePreferredWhenRenaming: ir.IncludePreferredWhenRenaming,
+		IndexerID:                    ir.IndexerID,
+		Tags:                         []int{},
+	}
+
+	p.Required = append(p.Required, ir.Required...)
+	p.Ignored = append(p.Ignored, ir.Ignored...)
+	for _, term := range ir.Preferred {
+		p.Preferred = append(p.Preferred, PreferredTermResource{Key: term.Term, Value: term.Score})
+	}
+	if len(tagIDs) > 0 {
+		p.Tags = tagIDs
+	}
+
+	return p
+}
+
+// diffReleaseProfiles computes changes needed for release profiles.
+// Profiles that already match a declared one are kept. The remaining declared
+// profiles update the remaining existing ones in ID order; whatever is left over is
+// created or deleted. Nothing is changed when no release profiles are declared.
+func (a *Adapter) diffReleaseProfiles(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
+	if len(desired.ReleaseProfiles) == 0 {
+		return nil
+	}
+
+	existing := make([]irv1.ReleaseProfileIR, len(current.ReleaseProfiles))
+	copy(existing, current.ReleaseProfiles)
+	sort.SliceStable(existing, func(i, j int) bool {
+		return releaseProfileID(existing[i]) < releaseProfileID(existing[j])
+	})
+
+	matched := make([]bool, len(existing))
+	var unmatched []irv1.ReleaseProfileIR
+	for _, rp := range desired.ReleaseProfiles {
+		found := false
+		for i, cp := range existing {
+			if !matched[i] && releaseProfilesEqual(cp, rp) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, rp)
+		}
+	}
+
+	var spare []irv1.ReleaseProfileIR
+	for i, cp := range existing {
+		if !matched[i] {
+			spare = append(spare, cp)
+		}
+	}
+
+	for i, rp := range unmatched {
+		payload := rp // Copy to avoid pointer issues
+		if i < len(spare) {
+			id := releaseProfileID(spare[i])
+			payload.ID = shared.IntPtr(id)
+			changes.Updates = append(changes.Updates, adapters.Change{
+				ResourceType: adapters.ResourceReleaseProfile,
+				Name:         rp.Name,
+				ID:           shared.IntPtr(id),
+				Payload:      &payload,
+			})
+			continue
+		}
+		changes.Creates = append(changes.Creates, adapters.Change{
+			ResourceType: adapters.ResourceReleaseProfile,
+			Name:         rp.Name,
+			Payload:      &payload,
+		})
+	}
+
+	for i := len(unmatched); i < len(spare); i++ {
+		changes.Deletes = append(changes.Deletes, adapters.Change{
+			ResourceType: adapters.ResourceReleaseProfile,
+			Name:         spare[i].Name,
+			ID:           shared.IntPtr(releaseProfileID(spare[i])),
+		})
+	}
+
+	return nil
+}
+
+// releaseProfilesEqual compares two release profiles, ignoring term order and tag case
+func releaseProfilesEqual(a, b irv1.ReleaseProfileIR) bool {
+	if a.Enabled != b.Enabled ||
+		a.IncludePreferredWhenRenaming != b.IncludePreferredWhenRenaming ||
+		a.IndexerID != b.IndexerID {
+		return false
+	}
+	if !termsEqual(a.Required, b.Required) || !termsEqual(a.Ignored, b.Ignored) {
+		return false
+	}
+	if shared.DelayProfileKey(a.TagNames) != shared.DelayProfileKey(b.TagNames) {
+		return false
+	}
+	if len(a.Preferred) != len(b.Preferred) {
+		return false
+	}
+	scores := make(map[string]int, len(a.Preferred))
+	for _, term := range a.Preferred {
+		scores[term.Term] = term.Score
+	}
+	for _, term := range b.Preferred {
+		if score, ok := scores[term.Term]; !ok || score != term.Score {
+			return false
+		}
+	}
+	return true
+}
+
+// termsEqual compares two term lists as sets
+func termsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// releaseProfileID returns the server-side ID of a profile read from Lidarr
+func releaseProfileID(p irv1.ReleaseProfileIR) int {
+	if p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// releaseProfileDisplayName derives a name for an unnamed profile from its terms
+func releaseProfileDisplayName(required, ignored []string) string {
+	var parts []string
+	if len(required) > 0 {
+		parts = append(parts, "required:"+strings.Join(required, ","))
+	}
+	if len(ignored) > 0 {
+		parts = append(parts, "ignored:"+strings.Join(ignored, ","))
+	}
+	if len(parts) == 0 {
+		return "preferred"
+	}
+	return strings.Join(parts, " ")
+}
+
+// createReleaseProfile creates a new release profile, creating any tags it references
+func (a *Adapter) createReleaseProfile(ctx context.Context, c *httpclient.Client, ir *irv1.ReleaseProfileIR) error {
+	tagIDs, err := shared.EnsureTagIDs(ctx, c, "v1", ir.TagNames)
+	if err != nil {
+		return err
+	}
+
+	profile := a.irToReleaseProfile(ir, tagIDs)
+
+	return c.Post(ctx, "/api/v1/releaseprofile", profile, nil)
+}
+
+// updateReleaseProfile updates an existing release profile
+func (a *Adapter) updateReleaseProfile(ctx context.Context, c *httpclient.Client, id int, ir *irv1.ReleaseProfileIR) error {
+	tagIDs, err := shared.EnsureTagIDs(ctx, c, "v1", ir.TagNames)
+	if err != nil {
+		return err
+	}
+
+	profile := a.irToReleaseProfile(ir, tagIDs)
+	profile.ID = id
+
+	return c.Put(ctx, fmt.Sprintf("/api/v1/releaseprofile/%d", id), profile, nil)
+}
+
+// deleteReleaseProfile deletes a release profile
+func (a *Adapter) deleteReleaseProfile(ctx context.Context, c *httpclient.Client, id int) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/v1/releaseprofile/%d", id))
+}
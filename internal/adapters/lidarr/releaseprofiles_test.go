@@ -0,0 +1,124 @@
+package lidarr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestDiffReleaseProfiles(t *testing.T) {
+	profile := func(id int, name string, ignored ...string) irv1.ReleaseProfileIR {
+		p := irv1.ReleaseProfileIR{Name: name, Enabled: true, Ignored: ignored}
+		if id != 0 {
+			p.ID = &id
+		}
+		return p
+	}
+	withTags := func(p irv1.ReleaseProfileIR, tags ...string) irv1.ReleaseProfileIR {
+		p.TagNames = tags
+		return p
+	}
+	withPreferred := func(p irv1.ReleaseProfileIR, term string, score int) irv1.ReleaseProfileIR {
+		p.Preferred = append(p.Preferred, irv1.PreferredTermIR{Term: term, Score: score})
+		return p
+	}
+
+	tests := []struct {
+		name        string
+		current     []irv1.ReleaseProfileIR
+		desired     []irv1.ReleaseProfileIR
+		wantCreates []string
+		wantUpdates []int
+		wantDeletes []int
+	}{
+		{
+			name:    "nothing declared leaves profiles alone",
+			current: []irv1.ReleaseProfileIR{profile(1, "", "vinyl")},
+		},
+		{
+			name:    "in sync regardless of term order and tag case",
+			current: []irv1.ReleaseProfileIR{withTags(profile(1, "", "128", "vinyl"), "Jazz")},
+			desired: []irv1.ReleaseProfileIR{withTags(profile(0, "no-vinyl", "vinyl", "128"), "jazz")},
+		},
+		{
+			name:        "changed preferred score updates the existing profile",
+			current:     []irv1.ReleaseProfileIR{withPreferred(profile(3, "", "vinyl"), "WEB", 10)},
+			desired:     []irv1.ReleaseProfileIR{withPreferred(profile(0, "scene", "vinyl"), "WEB", 20)},
+			wantUpdates: []int{3},
+		},
+		{
+			name:        "matching profiles are kept and the rest paired by ID",
+			current:     []irv1.ReleaseProfileIR{profile(5, "", "mp3"), profile(2, "", "vinyl"), profile(4, "", "128")},
+			desired:     []irv1.ReleaseProfileIR{profile(0, "lossless", "mp3", "aac"), profile(0, "no-vinyl", "vinyl")},
+			wantUpdates: []int{4},
+			wantDeletes: []int{5},
+		},
+		{
+			name:        "extra declared profiles are created",
+			current:     []irv1.ReleaseProfileIR{profile(1, "", "vinyl")},
+			desired:     []irv1.ReleaseProfileIR{profile(0, "no-vinyl", "vinyl"), profile(0, "no-low", "128")},
+			wantCreates: []string{"no-low"},
+		},
+	}
+
+	a := &Adapter{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := &adapters.ChangeSet{}
+			current := &irv1.IR{ReleaseProfiles: tt.current}
+			desired := &irv1.IR{ReleaseProfiles: tt.desired}
+			if err := a.diffReleaseProfiles(current, desired, changes); err != nil {
+				t.Fatalf("diffReleaseProfiles() error = %v", err)
+			}
+
+			var creates []string
+			var updates, deletes []int
+			for _, c := range changes.Creates {
+				creates = append(creates, c.Name)
+			}
+			for _, c := range changes.Updates {
+				updates = append(updates, *c.ID)
+			}
+			for _, c := range changes.Deletes {
+				deletes = append(deletes, *c.ID)
+			}
+
+			if !reflect.DeepEqual(creates, tt.wantCreates) {
+				t.Errorf("creates = %v, want %v", creates, tt.wantCreates)
+			}
+			if !reflect.DeepEqual(updates, tt.wantUpdates) {
+				t.Errorf("updates = %v, want %v", updates, tt.wantUpdates)
+			}
+			if !reflect.DeepEqual(deletes, tt.wantDeletes) {
+				t.Errorf("deletes = %v, want %v", deletes, tt.wantDeletes)
+			}
+		})
+	}
+}
+
+func TestTermListUnmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want TermList
+	}{
+		{name: "array", data: `["FLAC","24bit"]`, want: TermList{"FLAC", "24bit"}},
+		{name: "comma-separated string", data: `"vinyl, 128 ,"`, want: TermList{"vinyl", "128"}},
+		{name: "empty string", data: `""`, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got TermList
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package lidarr
 
 import (
+	"encoding/json"
+	"strings"
+
 	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 )
 
@@ -114,3 +117,47 @@ type CustomFormatResource struct {
 // DelayProfileResource represents a Lidarr delay profile
 // Type alias to shared base type
 type DelayProfileResource = shared.BaseDelayProfileResource
+
+// ReleaseProfileResource represents a Lidarr release profile.
+// Lidarr release profiles have no name; they are identified by their content.
+type ReleaseProfileResource struct {
+	ID                           int                     `json:"id,omitempty"`
+	Enabled                      bool                    `json:"enabled"`
+	Required                     TermList                `json:"required"`
+	Ignored                      TermList                `json:"ignored"`
+	Preferred                    []PreferredTermResource `json:"preferred"`
+	IncludePreferredWhenRenaming bool                    `json:"includePreferredWhenRenaming"`
+	IndexerID                    int                     `json:"indexerId"`
+	Tags                         []int                   `json:"tags"`
+}
+
+// PreferredTermResource is a preferred term and its score
+type PreferredTermResource struct {
+	Key   string `json:"key"`
+	Value int    `json:"value"`
+}
+
+// TermList is a list of release profile terms.
+// Older Lidarr versions return terms as a single comma-separated string.
+type TermList []string
+
+// UnmarshalJSON accepts both a JSON array and a comma-separated string
+func (t *TermList) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err == nil {
+		*t = list
+		return nil
+	}
+
+	var joined string
+	if err := json.Unmarshal(data, &joined); err != nil {
+		return err
+	}
+	*t = nil
+	for _, term := range strings.Split(joined, ",") {
+		if term = strings.TrimSpace(term); term != "" {
+			*t = append(*t, term)
+		}
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ package mock
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -44,18 +45,24 @@ type Adapter struct {
 	ApplyFunc        func(ctx context.Context, conn *irv1.ConnectionIR, changes *adapters.ChangeSet) (*adapters.ApplyResult, error)
 
 	// Optional interface implementations
-	ApplyDirectFunc func(ctx context.Context, conn *irv1.ConnectionIR, ir *irv1.IR) (*adapters.ApplyResult, error)
-	GetHealthFunc   func(ctx context.Context, conn *irv1.ConnectionIR) (*irv1.HealthStatus, error)
+	ApplyDirectFunc      func(ctx context.Context, conn *irv1.ConnectionIR, ir *irv1.IR) (*adapters.ApplyResult, error)
+	GetHealthFunc        func(ctx context.Context, conn *irv1.ConnectionIR) (*irv1.HealthStatus, error)
+	CheckPathFunc        func(ctx context.Context, conn *irv1.ConnectionIR, path string) error
+	ManagedRefsFunc      func(current, desired *irv1.IR) *adapters.ManagedRefs
+	TestNotificationFunc func(ctx context.Context, conn *irv1.ConnectionIR, name string) error
 
 	// Call tracking for assertions
-	mu                sync.Mutex
-	ConnectCalls      []ConnectCall
-	DiscoverCalls     []DiscoverCall
-	CurrentStateCalls []CurrentStateCall
-	DiffCalls         []DiffCall
-	ApplyCalls        []ApplyCall
-	ApplyDirectCalls  []ApplyDirectCall
-	GetHealthCalls    []GetHealthCall
+	mu                    sync.Mutex
+	ConnectCalls          []ConnectCall
+	DiscoverCalls         []DiscoverCall
+	CurrentStateCalls     []CurrentStateCall
+	DiffCalls             []DiffCall
+	ApplyCalls            []ApplyCall
+	ApplyDirectCalls      []ApplyDirectCall
+	GetHealthCalls        []GetHealthCall
+	CheckPathCalls        []CheckPathCall
+	ManagedRefsCalls      []ManagedRefsCall
+	TestNotificationCalls []TestNotificationCall
 }
 
 // Call tracking types
@@ -91,11 +98,29 @@ type GetHealthCall struct {
 	Conn *irv1.ConnectionIR
 }
 
+type CheckPathCall struct {
+	Conn *irv1.ConnectionIR
+	Path string
+}
+
+type ManagedRefsCall struct {
+	Current *irv1.IR
+	Desired *irv1.IR
+}
+
+type TestNotificationCall struct {
+	Conn *irv1.ConnectionIR
+	Name string
+}
+
 // Ensure Adapter implements the required interfaces
 var (
-	_ adapters.Adapter       = (*Adapter)(nil)
-	_ adapters.DirectApplier = (*Adapter)(nil)
-	_ adapters.HealthChecker = (*Adapter)(nil)
+	_ adapters.Adapter            = (*Adapter)(nil)
+	_ adapters.DirectApplier      = (*Adapter)(nil)
+	_ adapters.HealthChecker      = (*Adapter)(nil)
+	_ adapters.PathChecker        = (*Adapter)(nil)
+	_ adapters.RefReader          = (*Adapter)(nil)
+	_ adapters.NotificationTester = (*Adapter)(nil)
 )
 
 // NewAdapter creates a new mock adapter with default happy-path implementations.
@@ -223,9 +248,15 @@ func (m *Adapter) ApplyDirect(ctx context.Context, conn *irv1.ConnectionIR, ir *
 	if ir.MediaManagement != nil {
 		applied++
 	}
+	if ir.Collections != nil {
+		applied++
+	}
 	if ir.Authentication != nil {
 		applied++
 	}
+	if ir.UI != nil {
+		applied++
+	}
 
 	return &adapters.ApplyResult{
 		Applied: applied,
@@ -251,6 +282,48 @@ func (m *Adapter) GetHealth(ctx context.Context, conn *irv1.ConnectionIR) (*irv1
 	}, nil
 }
 
+// CheckPath looks a directory up (PathChecker interface).
+func (m *Adapter) CheckPath(ctx context.Context, conn *irv1.ConnectionIR, path string) error {
+	m.mu.Lock()
+	m.CheckPathCalls = append(m.CheckPathCalls, CheckPathCall{Conn: conn, Path: path})
+	m.mu.Unlock()
+
+	if m.CheckPathFunc != nil {
+		return m.CheckPathFunc(ctx, conn, path)
+	}
+
+	// Default: every path exists
+	return nil
+}
+
+// ManagedRefs picks the remote IDs of the declared resources out of current (RefReader interface).
+func (m *Adapter) ManagedRefs(current, desired *irv1.IR) *adapters.ManagedRefs {
+	m.mu.Lock()
+	m.ManagedRefsCalls = append(m.ManagedRefsCalls, ManagedRefsCall{Current: current, Desired: desired})
+	m.mu.Unlock()
+
+	if m.ManagedRefsFunc != nil {
+		return m.ManagedRefsFunc(current, desired)
+	}
+
+	// Default: nothing exists yet
+	return &adapters.ManagedRefs{}
+}
+
+// TestNotification runs the connection test of the named notification (NotificationTester interface).
+func (m *Adapter) TestNotification(ctx context.Context, conn *irv1.ConnectionIR, name string) error {
+	m.mu.Lock()
+	m.TestNotificationCalls = append(m.TestNotificationCalls, TestNotificationCall{Conn: conn, Name: name})
+	m.mu.Unlock()
+
+	if m.TestNotificationFunc != nil {
+		return m.TestNotificationFunc(ctx, conn, name)
+	}
+
+	// Default: every test passes
+	return nil
+}
+
 // Reset clears all call tracking data.
 func (m *Adapter) Reset() {
 	m.mu.Lock()
@@ -263,6 +336,9 @@ func (m *Adapter) Reset() {
 	m.ApplyCalls = nil
 	m.ApplyDirectCalls = nil
 	m.GetHealthCalls = nil
+	m.CheckPathCalls = nil
+	m.ManagedRefsCalls = nil
+	m.TestNotificationCalls = nil
 }
 
 // CallCounts returns the number of times each method was called.
@@ -271,13 +347,16 @@ func (m *Adapter) CallCounts() map[string]int {
 	defer m.mu.Unlock()
 
 	return map[string]int{
-		"Connect":      len(m.ConnectCalls),
-		"Discover":     len(m.DiscoverCalls),
-		"CurrentState": len(m.CurrentStateCalls),
-		"Diff":         len(m.DiffCalls),
-		"Apply":        len(m.ApplyCalls),
-		"ApplyDirect":  len(m.ApplyDirectCalls),
-		"GetHealth":    len(m.GetHealthCalls),
+		"Connect":          len(m.ConnectCalls),
+		"Discover":         len(m.DiscoverCalls),
+		"CurrentState":     len(m.CurrentStateCalls),
+		"Diff":             len(m.DiffCalls),
+		"Apply":            len(m.ApplyCalls),
+		"ApplyDirect":      len(m.ApplyDirectCalls),
+		"GetHealth":        len(m.GetHealthCalls),
+		"CheckPath":        len(m.CheckPathCalls),
+		"ManagedRefs":      len(m.ManagedRefsCalls),
+		"TestNotification": len(m.TestNotificationCalls),
 	}
 }
 
@@ -345,6 +424,27 @@ func (m *Adapter) WithHealthIssues(issues []irv1.HealthIssue) *Adapter {
 	return m
 }
 
+// WithMissingPaths returns the adapter configured to report the given paths as not found.
+func (m *Adapter) WithMissingPaths(paths ...string) *Adapter {
+	m.CheckPathFunc = func(ctx context.Context, conn *irv1.ConnectionIR, path string) error {
+		for _, missing := range paths {
+			if path == missing {
+				return fmt.Errorf("%w: %s", adapters.ErrPathNotFound, path)
+			}
+		}
+		return nil
+	}
+	return m
+}
+
+// WithManagedRefs returns the adapter configured to report the given remote IDs.
+func (m *Adapter) WithManagedRefs(refs *adapters.ManagedRefs) *Adapter {
+	m.ManagedRefsFunc = func(current, desired *irv1.IR) *adapters.ManagedRefs {
+		return refs
+	}
+	return m
+}
+
 // WithDiscoverError returns the adapter configured to return an error on Discover.
 func (m *Adapter) WithDiscoverError(err error) *Adapter {
 	m.DiscoverFunc = func(ctx context.Context, conn *irv1.ConnectionIR) (*adapters.Capabilities, error) {
@@ -0,0 +1,205 @@
+package adapters
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// The apps return resources in their own representation: PascalCase
+// implementation names, numbers decoded as float64, booleans as "True", and
+// every field of a schema including the ones the operator never sets. The
+// comparators below normalize both sides first, so the diffs only report
+// changes that would actually alter the resource.
+
+// Defaults the apps fill in for a priority of 0 (their valid range is 1-50)
+const (
+	DefaultDownloadClientPriority = 1
+	DefaultIndexerPriority        = 25
+)
+
+// CanonicalImplementation maps the operator's download client types (e.g.
+// "qbittorrent") to the *arr implementation names. Other names are returned
+// unchanged.
+func CanonicalImplementation(impl string) string {
+	switch strings.ToLower(impl) {
+	case "qbittorrent":
+		return "QBittorrent"
+	case "transmission":
+		return "Transmission"
+	case "deluge":
+		return "Deluge"
+	case "rtorrent":
+		return "RTorrent"
+	case "sabnzbd":
+		return "Sabnzbd"
+	case "nzbget":
+		return "NzbGet"
+	default:
+		return impl
+	}
+}
+
+// ImplementationsEqual compares implementation or type names ignoring case
+func ImplementationsEqual(current, desired string) bool {
+	return strings.EqualFold(current, desired)
+}
+
+// IntEqualOrDefault compares an int the app defaults when it is 0: a desired
+// 0 matches the default
+func IntEqualOrDefault(current, desired, def int) bool {
+	if desired == 0 {
+		desired = def
+	}
+	if current == 0 {
+		current = def
+	}
+	return current == desired
+}
+
+// URLsEqual compares URLs ignoring the case of scheme and host and a trailing
+// slash. Values that don't parse are compared as trimmed strings.
+func URLsEqual(current, desired string) bool {
+	return normalizeURL(current) == normalizeURL(desired)
+}
+
+// normalizeURL lowercases the scheme and host of raw and drops a trailing slash
+func normalizeURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return strings.TrimSuffix(raw, "/")
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return u.String()
+}
+
+// StringSetsEqual compares two sets of names ignoring order and case
+func StringSetsEqual(current, desired []string) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	seen := make(map[string]int, len(current))
+	for _, s := range current {
+		seen[strings.ToLower(s)]++
+	}
+	for _, s := range desired {
+		key := strings.ToLower(s)
+		if seen[key] == 0 {
+			return false
+		}
+		seen[key]--
+	}
+	return true
+}
+
+// IntSetsEqual compares two sets of ints ignoring order
+func IntSetsEqual(current, desired []int) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	seen := make(map[int]int, len(current))
+	for _, v := range current {
+		seen[v]++
+	}
+	for _, v := range desired {
+		if seen[v] == 0 {
+			return false
+		}
+		seen[v]--
+	}
+	return true
+}
+
+// NormalizeFieldValue renders a field value for comparison: nil is "",
+// booleans are "true"/"false" whatever their casing, and numbers drop
+// trailing zeros, so 8080, 8080.0 and "8080" compare equal. Lists are
+// rendered element by element.
+func NormalizeFieldValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		s := strings.TrimSpace(val)
+		if b, err := strconv.ParseBool(s); err == nil && !isNumeric(s) {
+			return strconv.FormatBool(b)
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		return s
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(val), 'f', -1, 32)
+	case int:
+		return strconv.Itoa(val)
+	case int32:
+		return strconv.FormatInt(int64(val), 10)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case []interface{}:
+		parts := make([]string, len(val))
+		for i, item := range val {
+			parts[i] = NormalizeFieldValue(item)
+		}
+		return "[" + strings.Join(parts, ",") + "]"
+	case []string:
+		parts := make([]string, len(val))
+		for i, item := range val {
+			parts[i] = NormalizeFieldValue(item)
+		}
+		return "[" + strings.Join(parts, ",") + "]"
+	case []int:
+		parts := make([]string, len(val))
+		for i, item := range val {
+			parts[i] = strconv.Itoa(item)
+		}
+		return "[" + strings.Join(parts, ",") + "]"
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+// isNumeric reports whether s is a number ("1" and "0" parse as booleans too)
+func isNumeric(s string) bool {
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+// FieldValuesEqual compares two field values after normalizing them
+func FieldValuesEqual(current, desired interface{}) bool {
+	return NormalizeFieldValue(current) == NormalizeFieldValue(desired)
+}
+
+// MaskedFieldValue is what the apps return in place of passwords and API keys
+const MaskedFieldValue = "********"
+
+// FieldsEqual compares the fields the desired state sets with the current
+// ones. The apps return every field of a schema, so fields missing from
+// desired are left to the app and ignored. A desired field the app doesn't
+// return matches an empty value, and masked values are skipped: credential
+// changes are tracked through the secret hash instead.
+func FieldsEqual[V any](current, desired map[string]V) bool {
+	for key, want := range desired {
+		got, ok := current[key]
+		if !ok {
+			if NormalizeFieldValue(want) != "" {
+				return false
+			}
+			continue
+		}
+		if NormalizeFieldValue(got) == MaskedFieldValue {
+			continue
+		}
+		if !FieldValuesEqual(got, want) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+package adapters
+
+import (
+	"testing"
+
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestNormalizeFieldValue(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{nil, ""},
+		{"  movies ", "movies"},
+		{"True", "true"},
+		{false, "false"},
+		{float64(8080), "8080"},
+		{"8080.0", "8080"},
+		{8080, "8080"},
+		{"1", "1"},
+		{1.5, "1.5"},
+		{[]interface{}{float64(1), "2"}, "[1,2]"},
+		{[]int{1, 2}, "[1,2]"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeFieldValue(tt.in); got != tt.want {
+			t.Errorf("NormalizeFieldValue(%#v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFieldsEqual(t *testing.T) {
+	current := map[string]interface{}{
+		"host":     "qbit.media.svc",
+		"port":     float64(8080),
+		"useSsl":   false,
+		"password": MaskedFieldValue,
+		"priority": float64(0),
+	}
+
+	if !FieldsEqual(current, map[string]interface{}{"host": "qbit.media.svc", "port": 8080, "useSsl": "False"}) {
+		t.Error("FieldsEqual() = false for a subset with different representations")
+	}
+	if !FieldsEqual(current, map[string]interface{}{"password": "hunter2"}) {
+		t.Error("FieldsEqual() = false for a masked secret")
+	}
+	if !FieldsEqual(current, map[string]interface{}{"category": ""}) {
+		t.Error("FieldsEqual() = false for an empty field the app doesn't return")
+	}
+	if FieldsEqual(current, map[string]interface{}{"port": 9090}) {
+		t.Error("FieldsEqual() = true for a changed port")
+	}
+	if FieldsEqual(current, map[string]interface{}{"category": "movies"}) {
+		t.Error("FieldsEqual() = true for a field missing from current")
+	}
+	if !FieldsEqual(map[string]string{"minimumSeeders": "1"}, map[string]string{"minimumSeeders": "1.0"}) {
+		t.Error("FieldsEqual() = false for string settings")
+	}
+}
+
+func TestCanonicalImplementation(t *testing.T) {
+	if got := CanonicalImplementation("qbittorrent"); got != "QBittorrent" {
+		t.Errorf("CanonicalImplementation(qbittorrent) = %q", got)
+	}
+	if got := CanonicalImplementation("NZBGet"); got != "NzbGet" {
+		t.Errorf("CanonicalImplementation(NZBGet) = %q", got)
+	}
+	if got := CanonicalImplementation("Torznab"); got != "Torznab" {
+		t.Errorf("CanonicalImplementation(Torznab) = %q, want unchanged", got)
+	}
+}
+
+func TestURLsEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"http://Radarr.media:7878/", "http://radarr.media:7878", true},
+		{"HTTP://radarr:7878/api/", "http://radarr:7878/api", true},
+		{"http://radarr:7878/API", "http://radarr:7878/api", false},
+		{"http://radarr:7878", "https://radarr:7878", false},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		if got := URLsEqual(tt.a, tt.b); got != tt.want {
+			t.Errorf("URLsEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSetsEqual(t *testing.T) {
+	if !StringSetsEqual([]string{"4K", "anime"}, []string{"Anime", "4k"}) {
+		t.Error("StringSetsEqual() = false ignoring order and case")
+	}
+	if StringSetsEqual([]string{"a", "a"}, []string{"a", "b"}) {
+		t.Error("StringSetsEqual() = true for different multisets")
+	}
+	if !IntSetsEqual([]int{2000, 2010}, []int{2010, 2000}) {
+		t.Error("IntSetsEqual() = false ignoring order")
+	}
+	if IntSetsEqual([]int{2000}, []int{2000, 2010}) {
+		t.Error("IntSetsEqual() = true for different lengths")
+	}
+}
+
+func TestDownloadClientsEqualNormalizes(t *testing.T) {
+	current := irv1.DownloadClientIR{Name: "qbit", Implementation: "QBittorrent", Host: "Qbit.media", Port: 8080, Priority: 1}
+	desired := irv1.DownloadClientIR{Name: "qbit", Implementation: "qbittorrent", Host: "qbit.media", Port: 8080}
+	if !DownloadClientsEqual(current, desired) {
+		t.Error("DownloadClientsEqual() = false for implementation case and default priority")
+	}
+	desired.Port = 9090
+	if DownloadClientsEqual(current, desired) {
+		t.Error("DownloadClientsEqual() = true for a changed port")
+	}
+}
+
+func TestIndexersEqualNormalizes(t *testing.T) {
+	current := irv1.IndexerIR{Name: "nzbgeek", Implementation: "Newznab", URL: "https://api.nzbgeek.info/", Priority: 25}
+	desired := irv1.IndexerIR{Name: "nzbgeek", Implementation: "newznab", URL: "https://api.nzbgeek.info"}
+	if !IndexersEqual(current, desired) {
+		t.Error("IndexersEqual() = false for trailing slash and default priority")
+	}
+	desired.Priority = 10
+	if IndexersEqual(current, desired) {
+		t.Error("IndexersEqual() = true for a changed priority")
+	}
+}
+
+func TestCustomFormatsEqualNormalizesValues(t *testing.T) {
+	current := &irv1.CustomFormatIR{Name: "x265", Specifications: []irv1.FormatSpecIR{
+		{Name: "size", Type: "SizeSpecification", Value: "5.0"},
+		{Name: "codec", Type: "ReleaseTitleSpecification", Value: "x265"},
+	}}
+	desired := &irv1.CustomFormatIR{Name: "x265", Specifications: []irv1.FormatSpecIR{
+		{Name: "codec", Type: "releasetitlespecification", Value: "x265"},
+		{Name: "size", Type: "SizeSpecification", Value: "5"},
+	}}
+	if !CustomFormatsEqual(current, desired) {
+		t.Error("CustomFormatsEqual() = false for reordered specs with equal values")
+	}
+}
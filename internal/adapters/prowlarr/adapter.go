@@ -16,6 +16,14 @@ import (
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
+// ownedEndpoints are the collections of the resource types carrying the ownership tag
+var ownedEndpoints = map[string]string{
+	adapters.ResourceIndexer:        "/api/v1/indexer",
+	"IndexerProxy":                  "/api/v1/indexerproxy",
+	adapters.ResourceApplication:    "/api/v1/applications",
+	adapters.ResourceDownloadClient: "/api/v1/downloadclient",
+}
+
 // Adapter implements the adapters.Adapter interface for Prowlarr
 type Adapter struct{}
 
@@ -101,15 +109,24 @@ func (a *Adapter) CurrentState(ctx context.Context, conn *irv1.ConnectionIR) (*i
 		},
 	}
 
-	// Get ownership tag ID
-	tagID, err := a.getOwnershipTagID(ctx, c)
+	// Get ownership tag ID. Without the tag nothing is tagged as managed yet, but
+	// resources recognised by name (e.g., nebularr- quality profiles) are still read.
+	tagID, err := a.getOwnershipTagID(ctx, c, conn)
 	if err != nil {
-		// No ownership tag means no managed resources
-		return ir, nil
+		tagID = 0
+	}
+
+	// Get app profiles, which also name the profile of each indexer
+	profileNames := make(map[int]string)
+	if profiles, err := getAppProfiles(ctx, c); err == nil {
+		ir.Prowlarr.AppProfiles = appProfilesToIR(profiles)
+		for _, p := range profiles {
+			profileNames[p.ID] = p.Name
+		}
 	}
 
 	// Get managed indexers
-	if indexers, err := a.getManagedIndexers(ctx, c, tagID); err == nil {
+	if indexers, err := a.getManagedIndexers(ctx, c, tagID, profileNames); err == nil {
 		ir.Prowlarr.Indexers = indexers
 	}
 
@@ -149,6 +166,9 @@ func (a *Adapter) Diff(current, desired *irv1.IR, caps *adapters.Capabilities) (
 		currentProwlarr = &irv1.ProwlarrIR{}
 	}
 
+	// Diff app profiles first, so indexers created in the same pass find them
+	a.diffAppProfiles(currentProwlarr, desired.Prowlarr, changes)
+
 	// Diff indexers
 	if err := a.diffIndexers(currentProwlarr, desired.Prowlarr, changes); err != nil {
 		return nil, fmt.Errorf("failed to diff indexers: %w", err)
@@ -177,15 +197,18 @@ func (a *Adapter) Apply(ctx context.Context, conn *irv1.ConnectionIR, changes *a
 	c := a.newClient(conn)
 
 	// Ensure ownership tag exists
-	tagID, err := a.ensureOwnershipTag(ctx, c)
+	tagID, err := a.ensureOwnershipTag(ctx, c, conn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to ensure ownership tag: %w", err)
 	}
 
-	// Use shared apply loop with adapter-specific callbacks
+	// Use shared apply loop with adapter-specific callbacks. Creates first adopt a
+	// same-named resource still carrying the shared ownership tag.
 	result := shared.ApplyChanges(
 		changes,
-		func(change adapters.Change) error { return a.applyCreate(ctx, c, change, tagID) },
+		shared.AdoptingCreate(ctx, c, "v1", ownedEndpoints, tagID, func(change adapters.Change) error {
+			return a.applyCreate(ctx, c, change, tagID)
+		}),
 		func(change adapters.Change) error { return a.applyUpdate(ctx, c, change, tagID) },
 		func(change adapters.Change) error { return a.applyDelete(ctx, c, change) },
 	)
@@ -193,13 +216,25 @@ func (a *Adapter) Apply(ctx context.Context, conn *irv1.ConnectionIR, changes *a
 	return result, nil
 }
 
+// Ensure Adapter implements RawRequester
+var _ adapters.RawRequester = (*Adapter)(nil)
+
+// SendRaw sends an unmodeled API request to Prowlarr (spec.raw)
+func (a *Adapter) SendRaw(ctx context.Context, conn *irv1.ConnectionIR, method, path string, body []byte) error {
+	return shared.SendRaw(ctx, a.newClient(conn), method, path, body)
+}
+
+// Ensure Adapter implements RefReader
+var _ adapters.RefReader = (*Adapter)(nil)
+
+// ManagedRefs picks the Prowlarr IDs of the resources declared in desired out of current
+func (a *Adapter) ManagedRefs(current, desired *irv1.IR) *adapters.ManagedRefs {
+	return shared.ManagedRefsFromIR(current, desired)
+}
+
 // newClient creates a new HTTP client for Prowlarr API communication
 func (a *Adapter) newClient(conn *irv1.ConnectionIR) *httpclient.Client {
-	return httpclient.New(httpclient.Config{
-		BaseURL:            conn.URL,
-		APIKey:             conn.APIKey,
-		InsecureSkipVerify: conn.InsecureSkipVerify,
-	})
+	return httpclient.New(httpclient.ConfigForConnection(conn))
 }
 
 // Ensure Adapter implements HealthChecker
@@ -0,0 +1,154 @@
+package prowlarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// appRequestTimeout bounds requests to downstream apps, which are read on
+// every reconcile of the Prowlarr config
+const appRequestTimeout = 10 * time.Second
+
+// unassignedDownloadClient is read back for an application whose synced
+// indexers use no download client, or not all the same one
+const unassignedDownloadClient = "(unassigned)"
+
+// appIndexer is the part of a downstream app's indexer the download client
+// assignment reads; the full resource is kept for writing it back
+type appIndexer struct {
+	ID               int `json:"id"`
+	DownloadClientID int `json:"downloadClientId"`
+
+	raw map[string]interface{}
+}
+
+// appDownloadClient is a download client of a downstream app
+type appDownloadClient struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// newAppClient creates a client for the downstream app of an application
+func newAppClient(app irv1.ProwlarrApplicationIR) (*httpclient.Client, string) {
+	apiVersion := "v3"
+	if app.Type == irv1.AppTypeLidarr || app.Type == irv1.AppTypeReadarr {
+		apiVersion = "v1"
+	}
+	return httpclient.New(httpclient.Config{BaseURL: app.URL, APIKey: app.APIKey, Timeout: appRequestTimeout}), apiVersion
+}
+
+// getSyncedIndexers lists the indexers Prowlarr synced to the app, recognised
+// the same way indexer policies recognise them
+func getSyncedIndexers(ctx context.Context, c *httpclient.Client, apiVersion, prowlarrURL string) ([]appIndexer, error) {
+	var raw []map[string]interface{}
+	if err := c.Get(ctx, fmt.Sprintf("/api/%s/indexer", apiVersion), &raw); err != nil {
+		return nil, fmt.Errorf("failed to get indexers: %w", err)
+	}
+
+	var synced []appIndexer
+	for _, r := range raw {
+		if _, ok := shared.ProwlarrSyncedIndexer(r, prowlarrURL); !ok {
+			continue
+		}
+		data, err := json.Marshal(r)
+		if err != nil {
+			return nil, err
+		}
+		var idx appIndexer
+		if err := json.Unmarshal(data, &idx); err != nil {
+			return nil, err
+		}
+		idx.raw = r
+		synced = append(synced, idx)
+	}
+	return synced, nil
+}
+
+// readDownloadClient returns the download client the app's synced indexers
+// use: "" when there are none or the app cannot be read, so nothing is
+// compared, and unassignedDownloadClient when they don't share one
+func readDownloadClient(ctx context.Context, app irv1.ProwlarrApplicationIR) string {
+	if app.URL == "" || app.APIKey == "" || app.ProwlarrURL == "" {
+		return ""
+	}
+	c, apiVersion := newAppClient(app)
+	indexers, err := getSyncedIndexers(ctx, c, apiVersion, app.ProwlarrURL)
+	if err != nil || len(indexers) == 0 {
+		return ""
+	}
+
+	id := indexers[0].DownloadClientID
+	for _, idx := range indexers[1:] {
+		if idx.DownloadClientID != id {
+			return unassignedDownloadClient
+		}
+	}
+	if id == 0 {
+		return unassignedDownloadClient
+	}
+
+	var clients []appDownloadClient
+	if err := c.Get(ctx, fmt.Sprintf("/api/%s/downloadclient", apiVersion), &clients); err != nil {
+		return ""
+	}
+	for _, dc := range clients {
+		if dc.ID == id {
+			return dc.Name
+		}
+	}
+	return unassignedDownloadClient
+}
+
+// downloadClientAssigned reports whether the current assignment satisfies the
+// desired one. An unset desired client leaves the app's choice alone.
+func downloadClientAssigned(current, desired string) bool {
+	return desired == "" || current == "" || strings.EqualFold(current, desired)
+}
+
+// assignDownloadClient points every indexer Prowlarr synced to the app at
+// the app's download client named by app.DownloadClient. Indexers Prowlarr
+// syncs later are assigned on a following reconcile.
+func assignDownloadClient(ctx context.Context, app irv1.ProwlarrApplicationIR) error {
+	if app.DownloadClient == "" {
+		return nil
+	}
+	c, apiVersion := newAppClient(app)
+
+	var clients []appDownloadClient
+	if err := c.Get(ctx, fmt.Sprintf("/api/%s/downloadclient", apiVersion), &clients); err != nil {
+		return fmt.Errorf("failed to get download clients of application %s: %w", app.Name, err)
+	}
+	id := 0
+	for _, dc := range clients {
+		if strings.EqualFold(dc.Name, app.DownloadClient) {
+			id = dc.ID
+			break
+		}
+	}
+	if id == 0 {
+		return fmt.Errorf("download client %q not found in application %s", app.DownloadClient, app.Name)
+	}
+
+	indexers, err := getSyncedIndexers(ctx, c, apiVersion, app.ProwlarrURL)
+	if err != nil {
+		return fmt.Errorf("failed to read indexers of application %s: %w", app.Name, err)
+	}
+	for _, idx := range indexers {
+		if idx.DownloadClientID == id {
+			continue
+		}
+		idx.raw["downloadClientId"] = id
+		path := fmt.Sprintf("/api/%s/indexer/%d", apiVersion, idx.ID)
+		if err := c.Put(ctx, path, idx.raw, nil); err != nil {
+			return fmt.Errorf("failed to assign download client to indexer %d of application %s: %w", idx.ID, app.Name, err)
+		}
+	}
+	return nil
+}
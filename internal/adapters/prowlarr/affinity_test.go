@@ -0,0 +1,106 @@
+package prowlarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// fakeApp serves the indexers and download clients of a downstream Radarr
+// and records indexer updates
+func fakeApp(t *testing.T, indexers []map[string]interface{}, updated map[string]map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v3/downloadclient":
+			_, _ = w.Write([]byte(`[{"id": 1, "name": "qbittorrent"}, {"id": 2, "name": "sabnzbd"}]`))
+		case r.URL.Path == "/api/v3/indexer" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(indexers)
+		case strings.HasPrefix(r.URL.Path, "/api/v3/indexer/") && r.Method == http.MethodPut:
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			updated[strings.TrimPrefix(r.URL.Path, "/api/v3/indexer/")] = body
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func syncedIndexer(id, downloadClientID int, baseURL string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               id,
+		"name":             "indexer",
+		"downloadClientId": downloadClientID,
+		"priority":         25,
+		"fields":           []map[string]interface{}{{"name": "baseUrl", "value": baseURL}},
+	}
+}
+
+func TestAssignDownloadClient(t *testing.T) {
+	updated := map[string]map[string]interface{}{}
+	server := fakeApp(t, []map[string]interface{}{
+		syncedIndexer(1, 0, "http://prowlarr:9696/3/"),
+		syncedIndexer(2, 1, "http://prowlarr:9696/4/"),
+		syncedIndexer(3, 0, "https://manual.example/api"),
+	}, updated)
+	defer server.Close()
+
+	app := irv1.ProwlarrApplicationIR{
+		Name:           "nebularr-prowlarr-radarr",
+		Type:           irv1.AppTypeRadarr,
+		URL:            server.URL,
+		APIKey:         "key",
+		ProwlarrURL:    "http://prowlarr:9696",
+		DownloadClient: "QBittorrent",
+	}
+	if got := readDownloadClient(context.Background(), app); got != unassignedDownloadClient {
+		t.Errorf("readDownloadClient() = %q, want %q", got, unassignedDownloadClient)
+	}
+
+	if err := assignDownloadClient(context.Background(), app); err != nil {
+		t.Fatalf("assignDownloadClient() error = %v", err)
+	}
+	if len(updated) != 1 || updated["1"] == nil {
+		t.Fatalf("updated indexers = %v, want only indexer 1", updated)
+	}
+	if updated["1"]["downloadClientId"] != float64(1) || updated["1"]["priority"] != float64(25) {
+		t.Errorf("indexer 1 = %v, want downloadClientId 1 and the other fields kept", updated["1"])
+	}
+
+	app.DownloadClient = "nzbget"
+	if err := assignDownloadClient(context.Background(), app); err == nil {
+		t.Error("assignDownloadClient() with an unknown client succeeded")
+	}
+}
+
+func TestReadDownloadClient(t *testing.T) {
+	server := fakeApp(t, []map[string]interface{}{
+		syncedIndexer(1, 2, "http://prowlarr:9696/3/"),
+		syncedIndexer(2, 2, "http://prowlarr:9696/4/"),
+	}, nil)
+	defer server.Close()
+
+	app := irv1.ProwlarrApplicationIR{Type: irv1.AppTypeRadarr, URL: server.URL, APIKey: "key", ProwlarrURL: "http://prowlarr:9696/"}
+	if got := readDownloadClient(context.Background(), app); got != "sabnzbd" {
+		t.Errorf("readDownloadClient() = %q, want sabnzbd", got)
+	}
+
+	for _, tc := range []struct {
+		current, desired string
+		want             bool
+	}{
+		{"sabnzbd", "", true},
+		{"", "sabnzbd", true},
+		{"SABnzbd", "sabnzbd", true},
+		{unassignedDownloadClient, "sabnzbd", false},
+		{"qbittorrent", "sabnzbd", false},
+	} {
+		if got := downloadClientAssigned(tc.current, tc.desired); got != tc.want {
+			t.Errorf("downloadClientAssigned(%q, %q) = %v, want %v", tc.current, tc.desired, got, tc.want)
+		}
+	}
+}
@@ -3,25 +3,35 @@ package prowlarr
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
 // Package-level cache for application IDs
 var applicationIDCache = make(map[string]int) // "baseURL:name" -> ID
 
-// getManagedApplications retrieves applications tagged with ownership tag
+// getManagedApplications retrieves the applications carrying the ownership tag or,
+// with routing tags instead, the config's application tag
 func (a *Adapter) getManagedApplications(ctx context.Context, c *httpclient.Client, tagID int) ([]irv1.ProwlarrApplicationIR, error) {
 	var apps []ApplicationResource
 	if err := c.Get(ctx, "/api/v1/applications", &apps); err != nil {
 		return nil, fmt.Errorf("failed to get applications: %w", err)
 	}
 
+	labels, err := shared.GetTagLabels(ctx, c, "v1")
+	if err != nil {
+		return nil, err
+	}
+
+	appTagID := applicationTagID(labels, tagID)
+
 	managed := make([]irv1.ProwlarrApplicationIR, 0, len(apps))
 	for _, app := range apps {
-		if !hasTag(app.Tags, tagID) {
+		if tagID == 0 || !hasTag(app.Tags, tagID) && (appTagID == 0 || !hasTag(app.Tags, appTagID)) {
 			continue
 		}
 
@@ -31,9 +41,11 @@ func (a *Adapter) getManagedApplications(ctx context.Context, c *httpclient.Clie
 
 		// Convert to IR
 		ir := irv1.ProwlarrApplicationIR{
+			ID:        app.ID,
 			Name:      app.Name,
 			Type:      implToAppType(app.Implementation),
 			SyncLevel: app.SyncLevel,
+			Tags:      routingTags(app.Tags, labels, tagID, appTagID),
 		}
 
 		// Extract settings from fields
@@ -61,6 +73,7 @@ func (a *Adapter) getManagedApplications(ctx context.Context, c *httpclient.Clie
 				}
 			}
 		}
+		ir.DownloadClient = readDownloadClient(ctx, ir)
 
 		managed = append(managed, ir)
 	}
@@ -114,6 +127,10 @@ func (a *Adapter) diffApplications(current, desired *irv1.ProwlarrIR, changes *a
 
 	// Find creates and updates
 	for name, desiredApp := range desiredByName {
+		if desiredApp.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		currentApp, exists := currentByName[name]
 		if !exists {
 			// Create
@@ -147,40 +164,30 @@ func (a *Adapter) diffApplications(current, desired *irv1.ProwlarrIR, changes *a
 
 // applicationsEqual compares two applications for equality
 func applicationsEqual(a, b irv1.ProwlarrApplicationIR) bool {
-	if a.Type != b.Type ||
-		a.URL != b.URL ||
-		a.ProwlarrURL != b.ProwlarrURL ||
-		a.SyncLevel != b.SyncLevel {
-		return false
-	}
-
-	// Compare sync categories
-	if len(a.SyncCategories) != len(b.SyncCategories) {
-		return false
-	}
-	catMap := make(map[int]bool)
-	for _, c := range a.SyncCategories {
-		catMap[c] = true
-	}
-	for _, c := range b.SyncCategories {
-		if !catMap[c] {
-			return false
-		}
-	}
-
-	return true
+	return adapters.ImplementationsEqual(a.Type, b.Type) &&
+		adapters.URLsEqual(a.URL, b.URL) &&
+		adapters.URLsEqual(a.ProwlarrURL, b.ProwlarrURL) &&
+		strings.EqualFold(a.SyncLevel, b.SyncLevel) &&
+		adapters.StringSetsEqual(a.Tags, b.Tags) &&
+		adapters.IntSetsEqual(a.SyncCategories, b.SyncCategories) &&
+		downloadClientAssigned(a.DownloadClient, b.DownloadClient)
 	// Note: APIKey is not compared (secret)
 }
 
 // createApplication creates an application in Prowlarr
 func (a *Adapter) createApplication(ctx context.Context, c *httpclient.Client, app irv1.ProwlarrApplicationIR, tagID int) error {
+	tags, err := applicationTagIDs(ctx, c, app.Tags, tagID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve tags for application %s: %w", app.Name, err)
+	}
+
 	impl := appTypeToImpl(app.Type)
 	resource := ApplicationResource{
 		Name:           app.Name,
 		Implementation: impl,
 		ConfigContract: impl + "Settings", // Required by Prowlarr API
 		SyncLevel:      app.SyncLevel,
-		Tags:           []int{tagID},
+		Tags:           tags,
 	}
 
 	// Build fields
@@ -195,7 +202,7 @@ func (a *Adapter) createApplication(ctx context.Context, c *httpclient.Client, a
 	cacheKey := fmt.Sprintf("%s:%s", c.BaseURL(), app.Name)
 	applicationIDCache[cacheKey] = created.ID
 
-	return nil
+	return assignDownloadClient(ctx, app)
 }
 
 // updateApplication updates an existing application
@@ -219,6 +226,11 @@ func (a *Adapter) updateApplication(ctx context.Context, c *httpclient.Client, a
 		}
 	}
 
+	tags, err := applicationTagIDs(ctx, c, app.Tags, tagID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve tags for application %s: %w", app.Name, err)
+	}
+
 	impl := appTypeToImpl(app.Type)
 	resource := ApplicationResource{
 		ID:             id,
@@ -226,7 +238,7 @@ func (a *Adapter) updateApplication(ctx context.Context, c *httpclient.Client, a
 		Implementation: impl,
 		ConfigContract: impl + "Settings", // Required by Prowlarr API
 		SyncLevel:      app.SyncLevel,
-		Tags:           []int{tagID},
+		Tags:           tags,
 	}
 
 	resource.Fields = buildApplicationFields(app)
@@ -236,7 +248,7 @@ func (a *Adapter) updateApplication(ctx context.Context, c *httpclient.Client, a
 		return fmt.Errorf("failed to update application %s: %w", app.Name, err)
 	}
 
-	return nil
+	return assignDownloadClient(ctx, app)
 }
 
 // deleteApplication deletes an application
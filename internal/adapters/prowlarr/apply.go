@@ -19,6 +19,13 @@ func (a *Adapter) applyCreate(ctx context.Context, c *httpclient.Client, change
 		}
 		return a.createIndexer(ctx, c, idx, tagID)
 
+	case adapters.ResourceAppProfile:
+		profile, ok := change.Payload.(irv1.ProwlarrAppProfileIR)
+		if !ok {
+			return fmt.Errorf("invalid payload type for app profile")
+		}
+		return a.createAppProfile(ctx, c, profile)
+
 	case "IndexerProxy":
 		proxy, ok := change.Payload.(irv1.IndexerProxyIR)
 		if !ok {
@@ -55,6 +62,13 @@ func (a *Adapter) applyUpdate(ctx context.Context, c *httpclient.Client, change
 		}
 		return a.updateIndexer(ctx, c, idx, tagID)
 
+	case adapters.ResourceAppProfile:
+		profile, ok := change.Payload.(irv1.ProwlarrAppProfileIR)
+		if !ok {
+			return fmt.Errorf("invalid payload type for app profile")
+		}
+		return a.updateAppProfile(ctx, c, profile)
+
 	case "IndexerProxy":
 		proxy, ok := change.Payload.(irv1.IndexerProxyIR)
 		if !ok {
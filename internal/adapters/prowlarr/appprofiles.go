@@ -0,0 +1,147 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// appProfilePath is the app profile collection of the Prowlarr API
+const appProfilePath = "/api/v1/appprofile"
+
+// getAppProfiles retrieves every app profile. App profiles carry no tags, so
+// they are matched by name rather than by the ownership tag.
+func getAppProfiles(ctx context.Context, c *httpclient.Client) ([]AppProfileResource, error) {
+	var profiles []AppProfileResource
+	if err := c.Get(ctx, appProfilePath, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to get app profiles: %w", err)
+	}
+	return profiles, nil
+}
+
+// appProfilesToIR converts app profiles to IR
+func appProfilesToIR(profiles []AppProfileResource) []irv1.ProwlarrAppProfileIR {
+	result := make([]irv1.ProwlarrAppProfileIR, 0, len(profiles))
+	for _, p := range profiles {
+		result = append(result, irv1.ProwlarrAppProfileIR{
+			Name:                    p.Name,
+			EnableRss:               p.EnableRss,
+			EnableAutomaticSearch:   p.EnableAutomaticSearch,
+			EnableInteractiveSearch: p.EnableInteractiveSearch,
+			MinimumSeeders:          p.MinimumSeeders,
+		})
+	}
+	return result
+}
+
+// diffAppProfiles computes creates and updates for the declared app profiles.
+// Profiles missing from the spec may be used by other indexers and are never deleted.
+func (a *Adapter) diffAppProfiles(current, desired *irv1.ProwlarrIR, changes *adapters.ChangeSet) {
+	currentByName := make(map[string]irv1.ProwlarrAppProfileIR)
+	for _, p := range current.AppProfiles {
+		currentByName[p.Name] = p
+	}
+
+	for _, p := range desired.AppProfiles {
+		existing, ok := currentByName[p.Name]
+		switch {
+		case !ok:
+			changes.Creates = append(changes.Creates, adapters.Change{
+				ResourceType: adapters.ResourceAppProfile,
+				Name:         p.Name,
+				Payload:      p,
+			})
+		case existing != p:
+			changes.Updates = append(changes.Updates, adapters.Change{
+				ResourceType: adapters.ResourceAppProfile,
+				Name:         p.Name,
+				Payload:      p,
+			})
+		}
+	}
+}
+
+// createAppProfile creates an app profile
+func (a *Adapter) createAppProfile(ctx context.Context, c *httpclient.Client, profile irv1.ProwlarrAppProfileIR) error {
+	if err := c.Post(ctx, appProfilePath, appProfileResource(0, profile), nil); err != nil {
+		return fmt.Errorf("failed to create app profile %s: %w", profile.Name, err)
+	}
+	return nil
+}
+
+// updateAppProfile updates the app profile with the name of profile
+func (a *Adapter) updateAppProfile(ctx context.Context, c *httpclient.Client, profile irv1.ProwlarrAppProfileIR) error {
+	profiles, err := getAppProfiles(ctx, c)
+	if err != nil {
+		return err
+	}
+	for _, existing := range profiles {
+		if existing.Name != profile.Name {
+			continue
+		}
+		path := fmt.Sprintf("%s/%d", appProfilePath, existing.ID)
+		if err := c.Put(ctx, path, appProfileResource(existing.ID, profile), nil); err != nil {
+			return fmt.Errorf("failed to update app profile %s: %w", profile.Name, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("app profile %s not found", profile.Name)
+}
+
+// appProfileResource builds the API resource of profile
+func appProfileResource(id int, profile irv1.ProwlarrAppProfileIR) AppProfileResource {
+	return AppProfileResource{
+		ID:                      id,
+		Name:                    profile.Name,
+		EnableRss:               profile.EnableRss,
+		EnableAutomaticSearch:   profile.EnableAutomaticSearch,
+		EnableInteractiveSearch: profile.EnableInteractiveSearch,
+		MinimumSeeders:          profile.MinimumSeeders,
+	}
+}
+
+// indexerAppProfileID returns the app profile ID to send for idx. Without a
+// profile name, an existing indexer keeps currentID and a new one gets the
+// first profile, which Prowlarr creates as Standard. A named profile that
+// doesn't exist yet is created with Prowlarr's defaults.
+func indexerAppProfileID(ctx context.Context, c *httpclient.Client, idx irv1.ProwlarrIndexerIR, currentID int) (int, error) {
+	if idx.AppProfile == "" && currentID != 0 {
+		return currentID, nil
+	}
+
+	profiles, err := getAppProfiles(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+
+	if idx.AppProfile == "" {
+		first := 0
+		for _, p := range profiles {
+			if first == 0 || p.ID < first {
+				first = p.ID
+			}
+		}
+		return first, nil
+	}
+
+	for _, p := range profiles {
+		if p.Name == idx.AppProfile {
+			return p.ID, nil
+		}
+	}
+
+	created := appProfileResource(0, irv1.ProwlarrAppProfileIR{
+		Name:                    idx.AppProfile,
+		EnableRss:               true,
+		EnableAutomaticSearch:   true,
+		EnableInteractiveSearch: true,
+		MinimumSeeders:          1,
+	})
+	if err := c.Post(ctx, appProfilePath, created, &created); err != nil {
+		return 0, fmt.Errorf("failed to create app profile %s: %w", idx.AppProfile, err)
+	}
+	return created.ID, nil
+}
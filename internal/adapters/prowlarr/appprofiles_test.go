@@ -0,0 +1,102 @@
+package prowlarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestIndexerAppProfileID(t *testing.T) {
+	var created []AppProfileResource
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != appProfilePath {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode([]AppProfileResource{{ID: 4, Name: "RSS only"}, {ID: 1, Name: "Standard"}})
+		case http.MethodPost:
+			var p AppProfileResource
+			_ = json.NewDecoder(r.Body).Decode(&p)
+			created = append(created, p)
+			p.ID = 7
+			_ = json.NewEncoder(w).Encode(p)
+		}
+	}))
+	defer server.Close()
+
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL, APIKey: "key"})
+	tests := []struct {
+		name      string
+		profile   string
+		currentID int
+		want      int
+	}{
+		{name: "unset keeps the current profile", currentID: 4, want: 4},
+		{name: "unset on create uses the first profile", want: 1},
+		{name: "existing profile", profile: "RSS only", currentID: 1, want: 4},
+		{name: "missing profile is created", profile: "Search only", want: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx := irv1.ProwlarrIndexerIR{Name: "nebularr-p-nyaa", AppProfile: tt.profile}
+			got, err := indexerAppProfileID(context.Background(), c, idx, tt.currentID)
+			if err != nil {
+				t.Fatalf("indexerAppProfileID() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("indexerAppProfileID() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if len(created) != 1 || created[0].Name != "Search only" || !created[0].EnableRss || created[0].MinimumSeeders != 1 {
+		t.Errorf("created = %+v, want one profile with Prowlarr's defaults", created)
+	}
+}
+
+func TestDiffAppProfiles(t *testing.T) {
+	rss := irv1.ProwlarrAppProfileIR{Name: "RSS only", EnableRss: true, MinimumSeeders: 1}
+	current := &irv1.ProwlarrIR{AppProfiles: []irv1.ProwlarrAppProfileIR{
+		{Name: "Standard", EnableRss: true, EnableAutomaticSearch: true, EnableInteractiveSearch: true, MinimumSeeders: 1},
+		{Name: "RSS only", EnableRss: true, EnableAutomaticSearch: true, MinimumSeeders: 1},
+	}}
+	desired := &irv1.ProwlarrIR{AppProfiles: []irv1.ProwlarrAppProfileIR{
+		rss,
+		{Name: "Interactive", EnableInteractiveSearch: true},
+	}}
+
+	changes := &adapters.ChangeSet{}
+	(&Adapter{}).diffAppProfiles(current, desired, changes)
+
+	if len(changes.Creates) != 1 || changes.Creates[0].Name != "Interactive" {
+		t.Errorf("creates = %+v, want Interactive", changes.Creates)
+	}
+	if len(changes.Updates) != 1 || changes.Updates[0].Payload != rss {
+		t.Errorf("updates = %+v, want RSS only", changes.Updates)
+	}
+	if len(changes.Deletes) != 0 {
+		t.Errorf("deletes = %+v, want none: undeclared profiles are kept", changes.Deletes)
+	}
+}
+
+func TestIndexersEqualAppProfile(t *testing.T) {
+	current := irv1.ProwlarrIndexerIR{Name: "nebularr-p-nyaa", Definition: "nyaa", Enable: true, Priority: 25, AppProfile: "Standard"}
+
+	desired := current
+	desired.AppProfile = ""
+	if !indexersEqual(current, desired) {
+		t.Error("an unset app profile should leave the indexer's profile alone")
+	}
+	desired.AppProfile = "RSS only"
+	if indexersEqual(current, desired) {
+		t.Error("a different app profile should be an update")
+	}
+}
@@ -32,6 +32,7 @@ func (a *Adapter) getManagedDownloadClients(ctx context.Context, c *httpclient.C
 
 		// Convert to IR
 		ir := irv1.DownloadClientIR{
+			ID:             client.ID,
 			Name:           client.Name,
 			Protocol:       client.Protocol,
 			Implementation: strings.ToLower(client.Implementation),
@@ -93,6 +94,10 @@ func (a *Adapter) diffDownloadClients(current, desired *irv1.ProwlarrIR, changes
 
 	// Find creates and updates
 	for name, desiredClient := range desiredByName {
+		if desiredClient.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		currentClient, exists := currentByName[name]
 		if !exists {
 			// Create
@@ -127,16 +132,17 @@ func (a *Adapter) diffDownloadClients(current, desired *irv1.ProwlarrIR, changes
 // downloadClientsEqual compares two download clients for equality
 func downloadClientsEqual(a, b irv1.DownloadClientIR) bool {
 	return a.Protocol == b.Protocol &&
-		a.Implementation == b.Implementation &&
+		adapters.ImplementationsEqual(a.Implementation, b.Implementation) &&
 		a.Enable == b.Enable &&
-		a.Priority == b.Priority &&
-		a.Host == b.Host &&
+		adapters.IntEqualOrDefault(a.Priority, b.Priority, adapters.DefaultDownloadClientPriority) &&
+		strings.EqualFold(a.Host, b.Host) &&
 		a.Port == b.Port &&
 		a.UseTLS == b.UseTLS &&
 		a.Username == b.Username &&
 		a.Category == b.Category &&
-		a.Directory == b.Directory
-	// Note: Password is not compared (secret)
+		a.Directory == b.Directory &&
+		!adapters.SecretChanged(a.SecretHash, b.SecretHash)
+	// Note: Password is not compared (secret); its hash is
 }
 
 // createDownloadClient creates a download client in Prowlarr
@@ -237,22 +243,7 @@ func (a *Adapter) deleteDownloadClient(ctx context.Context, c *httpclient.Client
 
 // implFromClientType converts IR client type to implementation name
 func implFromClientType(clientType string) string {
-	switch strings.ToLower(clientType) {
-	case "qbittorrent":
-		return "QBittorrent"
-	case "transmission":
-		return "Transmission"
-	case "deluge":
-		return "Deluge"
-	case "rtorrent":
-		return "RTorrent"
-	case "sabnzbd":
-		return "Sabnzbd"
-	case "nzbget":
-		return "NzbGet"
-	default:
-		return clientType
-	}
+	return adapters.CanonicalImplementation(clientType)
 }
 
 // buildDownloadClientFields builds fields for a download client
@@ -292,6 +283,13 @@ func buildDownloadClientFields(client irv1.DownloadClientIR) []DownloadClientFie
 		})
 	}
 
+	if client.APIKey != "" {
+		fields = append(fields, DownloadClientField{
+			Name:  "apiKey",
+			Value: client.APIKey,
+		})
+	}
+
 	if client.Category != "" {
 		fields = append(fields, DownloadClientField{
 			Name:  "category",
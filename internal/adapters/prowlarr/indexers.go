@@ -2,27 +2,35 @@ package prowlarr
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
 // Package-level cache for indexer IDs
 var indexerIDCache = make(map[string]int) // "baseURL:name" -> ID
 
-// getManagedIndexers retrieves indexers tagged with ownership tag
-func (a *Adapter) getManagedIndexers(ctx context.Context, c *httpclient.Client, tagID int) ([]irv1.ProwlarrIndexerIR, error) {
-	var indexers []IndexerResource
-	if err := c.Get(ctx, "/api/v1/indexer", &indexers); err != nil {
-		return nil, fmt.Errorf("failed to get indexers: %w", err)
+// getManagedIndexers retrieves indexers tagged with ownership tag.
+// profileNames maps app profile IDs to their names.
+func (a *Adapter) getManagedIndexers(ctx context.Context, c *httpclient.Client, tagID int, profileNames map[int]string) ([]irv1.ProwlarrIndexerIR, error) {
+	labels, err := shared.GetTagLabels(ctx, c, "v1")
+	if err != nil {
+		return nil, err
 	}
 
-	managed := make([]irv1.ProwlarrIndexerIR, 0, len(indexers))
-	for _, idx := range indexers {
+	// Indexers are decoded one at a time so those of other owners are never kept
+	var managed []irv1.ProwlarrIndexerIR
+	err = httpclient.GetEach(ctx, c, "/api/v1/indexer", func(idx *IndexerResource) error {
 		if !hasTag(idx.Tags, tagID) {
-			continue
+			return nil
 		}
 
 		// Cache the ID
@@ -31,10 +39,13 @@ func (a *Adapter) getManagedIndexers(ctx context.Context, c *httpclient.Client,
 
 		// Convert to IR
 		ir := irv1.ProwlarrIndexerIR{
+			ID:         idx.ID,
 			Name:       idx.Name,
 			Definition: idx.DefinitionName,
 			Enable:     idx.Enable,
 			Priority:   idx.Priority,
+			Tags:       routingTags(idx.Tags, labels, tagID),
+			AppProfile: profileNames[idx.AppProfileID],
 		}
 
 		// Extract settings from fields
@@ -49,14 +60,26 @@ func (a *Adapter) getManagedIndexers(ctx context.Context, c *httpclient.Client,
 				if v, ok := field.Value.(string); ok {
 					ir.APIKey = v
 				}
+			case fieldQueryLimit:
+				ir.QueryLimit = limitFromField(field.Value)
+			case fieldGrabLimit:
+				ir.GrabLimit = limitFromField(field.Value)
+			case fieldLimitsUnit:
+				if unit := fieldInt(field.Value); unit != nil {
+					ir.LimitsUnit = limitsUnitName(*unit)
+				}
 			default:
-				if v, ok := field.Value.(string); ok {
-					ir.Settings[field.Name] = v
+				if field.Value != nil {
+					ir.Settings[field.Name] = adapters.NormalizeFieldValue(field.Value)
 				}
 			}
 		}
 
 		managed = append(managed, ir)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get indexers: %w", err)
 	}
 
 	return managed, nil
@@ -76,6 +99,10 @@ func (a *Adapter) diffIndexers(current, desired *irv1.ProwlarrIR, changes *adapt
 
 	// Find creates and updates
 	for name, desiredIdx := range desiredByName {
+		if desiredIdx.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		currentIdx, exists := currentByName[name]
 		if !exists {
 			// Create
@@ -109,60 +136,57 @@ func (a *Adapter) diffIndexers(current, desired *irv1.ProwlarrIR, changes *adapt
 
 // indexersEqual compares two indexers for equality
 func indexersEqual(a, b irv1.ProwlarrIndexerIR) bool {
-	if a.Definition != b.Definition ||
+	if !adapters.ImplementationsEqual(a.Definition, b.Definition) ||
 		a.Enable != b.Enable ||
-		a.Priority != b.Priority ||
-		a.BaseURL != b.BaseURL {
+		!adapters.IntEqualOrDefault(a.Priority, b.Priority, adapters.DefaultIndexerPriority) ||
+		!adapters.URLsEqual(a.BaseURL, b.BaseURL) ||
+		!adapters.StringSetsEqual(a.Tags, b.Tags) ||
+		(b.AppProfile != "" && a.AppProfile != b.AppProfile) ||
+		!limitEqual(a.QueryLimit, b.QueryLimit) ||
+		!limitEqual(a.GrabLimit, b.GrabLimit) ||
+		(b.LimitsUnit != "" && a.LimitsUnit != b.LimitsUnit) ||
+		adapters.SecretChanged(a.SecretHash, b.SecretHash) {
 		return false
 	}
 
-	// Compare settings (ignoring APIKey since it's a secret)
-	if len(a.Settings) != len(b.Settings) {
-		return false
-	}
-	for k, v := range a.Settings {
-		if b.Settings[k] != v {
-			return false
-		}
-	}
+	// Prowlarr returns every setting of the definition; only the configured
+	// ones are compared (APIKey is a secret, tracked by its hash)
+	return adapters.FieldsEqual(a.Settings, b.Settings)
+}
 
-	return true
+// limitEqual reports whether the current limit matches a desired one; an
+// unset desired limit matches anything
+func limitEqual(current, desired *int) bool {
+	if desired == nil {
+		return true
+	}
+	return current != nil && *current == *desired
 }
 
 // createIndexer creates an indexer in Prowlarr
 func (a *Adapter) createIndexer(ctx context.Context, c *httpclient.Client, idx irv1.ProwlarrIndexerIR, tagID int) error {
+	tags, err := indexerTagIDs(ctx, c, idx.Tags, tagID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve tags for indexer %s: %w", idx.Name, err)
+	}
+
+	profileID, err := indexerAppProfileID(ctx, c, idx, 0)
+	if err != nil {
+		return fmt.Errorf("failed to resolve app profile for indexer %s: %w", idx.Name, err)
+	}
+
 	// Build the resource
 	resource := IndexerResource{
 		Name:           idx.Name,
 		DefinitionName: idx.Definition,
 		Enable:         idx.Enable,
 		Priority:       idx.Priority,
-		Tags:           []int{tagID},
+		AppProfileID:   profileID,
+		Tags:           tags,
 	}
 
 	// Build fields from settings
-	resource.Fields = []IndexerField{}
-
-	if idx.BaseURL != "" {
-		resource.Fields = append(resource.Fields, IndexerField{
-			Name:  "baseUrl",
-			Value: idx.BaseURL,
-		})
-	}
-
-	if idx.APIKey != "" {
-		resource.Fields = append(resource.Fields, IndexerField{
-			Name:  "apiKey",
-			Value: idx.APIKey,
-		})
-	}
-
-	for k, v := range idx.Settings {
-		resource.Fields = append(resource.Fields, IndexerField{
-			Name:  k,
-			Value: v,
-		})
-	}
+	resource.Fields = indexerFields(idx, idx.APIKey)
 
 	var created IndexerResource
 	if err := c.Post(ctx, "/api/v1/indexer", resource, &created); err != nil {
@@ -199,6 +223,27 @@ func (a *Adapter) updateIndexer(ctx context.Context, c *httpclient.Client, idx i
 		}
 	}
 
+	tags, err := indexerTagIDs(ctx, c, idx.Tags, tagID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve tags for indexer %s: %w", idx.Name, err)
+	}
+
+	path := fmt.Sprintf("/api/v1/indexer/%d", id)
+
+	// Without a profile name the indexer keeps the profile it has
+	var currentProfileID int
+	if idx.AppProfile == "" {
+		var existing IndexerResource
+		if err := c.Get(ctx, path, &existing); err != nil {
+			return fmt.Errorf("failed to get indexer %s: %w", idx.Name, err)
+		}
+		currentProfileID = existing.AppProfileID
+	}
+	profileID, err := indexerAppProfileID(ctx, c, idx, currentProfileID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve app profile for indexer %s: %w", idx.Name, err)
+	}
+
 	// Build the resource
 	resource := IndexerResource{
 		ID:             id,
@@ -206,41 +251,157 @@ func (a *Adapter) updateIndexer(ctx context.Context, c *httpclient.Client, idx i
 		DefinitionName: idx.Definition,
 		Enable:         idx.Enable,
 		Priority:       idx.Priority,
-		Tags:           []int{tagID},
+		AppProfileID:   profileID,
+		Tags:           tags,
 	}
 
 	// Build fields
-	resource.Fields = []IndexerField{}
+	resource.Fields = indexerFields(idx, idx.APIKey)
+
+	if err := c.Put(ctx, path, resource, nil); err != nil {
+		return fmt.Errorf("failed to update indexer %s: %w", idx.Name, err)
+	}
+
+	return nil
+}
+
+// indexerFields builds the fields of idx, with apiKey as its API key
+func indexerFields(idx irv1.ProwlarrIndexerIR, apiKey string) []IndexerField {
+	fields := []IndexerField{}
 
 	if idx.BaseURL != "" {
-		resource.Fields = append(resource.Fields, IndexerField{
+		fields = append(fields, IndexerField{
 			Name:  "baseUrl",
 			Value: idx.BaseURL,
 		})
 	}
 
-	if idx.APIKey != "" {
-		resource.Fields = append(resource.Fields, IndexerField{
+	if apiKey != "" {
+		fields = append(fields, IndexerField{
 			Name:  "apiKey",
-			Value: idx.APIKey,
+			Value: apiKey,
 		})
 	}
 
 	for k, v := range idx.Settings {
-		resource.Fields = append(resource.Fields, IndexerField{
+		fields = append(fields, IndexerField{
 			Name:  k,
 			Value: v,
 		})
 	}
 
-	path := fmt.Sprintf("/api/v1/indexer/%d", id)
-	if err := c.Put(ctx, path, resource, nil); err != nil {
-		return fmt.Errorf("failed to update indexer %s: %w", idx.Name, err)
+	// Limits live in the indexer's base settings, next to the definition's fields
+	if idx.QueryLimit != nil {
+		fields = append(fields, IndexerField{Name: fieldQueryLimit, Value: limitToField(*idx.QueryLimit)})
+	}
+	if idx.GrabLimit != nil {
+		fields = append(fields, IndexerField{Name: fieldGrabLimit, Value: limitToField(*idx.GrabLimit)})
+	}
+	if idx.LimitsUnit != "" {
+		fields = append(fields, IndexerField{Name: fieldLimitsUnit, Value: limitsUnitValue(idx.LimitsUnit)})
+	}
+	return fields
+}
+
+// Prowlarr field names of the indexer's query and grab limits
+const (
+	fieldQueryLimit = "baseSettings.queryLimit"
+	fieldGrabLimit  = "baseSettings.grabLimit"
+	fieldLimitsUnit = "baseSettings.limitsUnit"
+)
+
+// limitsUnitValue maps a limits unit to Prowlarr's enum (0 = day, 1 = hour)
+func limitsUnitValue(unit string) int {
+	if strings.EqualFold(unit, "hour") {
+		return 1
+	}
+	return 0
+}
+
+// limitsUnitName maps Prowlarr's limits unit enum to its name
+func limitsUnitName(value int) string {
+	if value == 1 {
+		return "hour"
+	}
+	return "day"
+}
+
+// limitToField renders a limit for Prowlarr, which only accepts positive
+// limits and stores no limit as null
+func limitToField(limit int) interface{} {
+	if limit <= 0 {
+		return nil
+	}
+	return limit
+}
+
+// limitFromField reads a limit, where null means unlimited (0)
+func limitFromField(v interface{}) *int {
+	if limit := fieldInt(v); limit != nil {
+		return limit
 	}
+	unlimited := 0
+	return &unlimited
+}
+
+// fieldInt returns a numeric field value, or nil when the field is empty
+func fieldInt(v interface{}) *int {
+	n, err := strconv.Atoi(adapters.NormalizeFieldValue(v))
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+// TestIndexerKey asks Prowlarr to test idx with apiKey, without saving it.
+// Prowlarr reports a tracker's 401 as a failed validation, so both a 401
+// response and a validation failure mentioning it count as a rejected key.
+func (a *Adapter) TestIndexerKey(ctx context.Context, conn *irv1.ConnectionIR, idx irv1.ProwlarrIndexerIR, apiKey string) error {
+	c := a.newClient(conn)
 
+	resource := IndexerResource{
+		Name:           idx.Name,
+		DefinitionName: idx.Definition,
+		Enable:         idx.Enable,
+		Priority:       idx.Priority,
+		Fields:         indexerFields(idx, apiKey),
+	}
+	if err := c.Post(ctx, "/api/v1/indexer/test", resource, nil); err != nil {
+		if isUnauthorized(err) {
+			return fmt.Errorf("indexer %s rejected the key: %w: %v", idx.Name, adapters.ErrUnauthorized, err)
+		}
+		return fmt.Errorf("indexer %s test failed: %w", idx.Name, err)
+	}
 	return nil
 }
 
+// testFailure is one of the validation errors Prowlarr returns (400) when an
+// indexer test fails
+type testFailure struct {
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// isUnauthorized reports whether an indexer test failed because the indexer
+// rejected the key. Prowlarr reports the indexer's response in the validation
+// errors of a 400; a 401 from Prowlarr itself is about the connection's API key.
+func isUnauthorized(err error) bool {
+	var statusErr *httpclient.StatusError
+	if !errors.As(err, &statusErr) || statusErr.Code != http.StatusBadRequest {
+		return false
+	}
+	var failures []testFailure
+	if json.Unmarshal([]byte(statusErr.Body), &failures) != nil {
+		return false
+	}
+	for _, f := range failures {
+		msg := strings.ToLower(f.ErrorMessage)
+		if strings.Contains(msg, "[401:") || strings.Contains(msg, "401 unauthorized") {
+			return true
+		}
+	}
+	return false
+}
+
 // deleteIndexer deletes an indexer
 func (a *Adapter) deleteIndexer(ctx context.Context, c *httpclient.Client, name string) error {
 	cacheKey := fmt.Sprintf("%s:%s", c.BaseURL(), name)
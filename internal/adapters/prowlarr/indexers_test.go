@@ -0,0 +1,128 @@
+package prowlarr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestTestIndexerKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/indexer/test" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var resource IndexerResource
+		_ = json.NewDecoder(r.Body).Decode(&resource)
+		for _, f := range resource.Fields {
+			if f.Name != "apiKey" {
+				continue
+			}
+			switch f.Value {
+			case "prowlarr-key-rejected":
+				w.WriteHeader(http.StatusUnauthorized)
+			case "old":
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`[{"errorMessage":"HTTP request failed: [401:Unauthorized] [GET] at [https://tracker/api]"}]`))
+			case "broken":
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`[{"errorMessage":"Unable to connect to indexer"}]`))
+			}
+			return
+		}
+	}))
+	defer server.Close()
+
+	a := &Adapter{}
+	conn := &irv1.ConnectionIR{URL: server.URL, APIKey: "key"}
+	idx := irv1.ProwlarrIndexerIR{Name: "nebularr-p-tracker", Definition: "tracker"}
+
+	if err := a.TestIndexerKey(context.Background(), conn, idx, "new"); err != nil {
+		t.Errorf("TestIndexerKey(new) error = %v", err)
+	}
+	if err := a.TestIndexerKey(context.Background(), conn, idx, "old"); !errors.Is(err, adapters.ErrUnauthorized) {
+		t.Errorf("TestIndexerKey(old) error = %v, want ErrUnauthorized", err)
+	}
+	if err := a.TestIndexerKey(context.Background(), conn, idx, "broken"); err == nil || errors.Is(err, adapters.ErrUnauthorized) {
+		t.Errorf("TestIndexerKey(broken) error = %v, want a non-auth failure", err)
+	}
+	// Prowlarr rejecting its own API key says nothing about the indexer's key
+	if err := a.TestIndexerKey(context.Background(), conn, idx, "prowlarr-key-rejected"); err == nil || errors.Is(err, adapters.ErrUnauthorized) {
+		t.Errorf("TestIndexerKey(prowlarr-key-rejected) error = %v, want a non-auth failure", err)
+	}
+}
+
+func TestIndexerLimits(t *testing.T) {
+	queryLimit, grabLimit := 100, 0
+	idx := irv1.ProwlarrIndexerIR{
+		Name:       "nebularr-p-tracker",
+		Definition: "tracker",
+		QueryLimit: &queryLimit,
+		GrabLimit:  &grabLimit,
+		LimitsUnit: "hour",
+	}
+
+	fields := map[string]interface{}{}
+	for _, f := range indexerFields(idx, "") {
+		fields[f.Name] = f.Value
+	}
+	if fields[fieldQueryLimit] != 100 {
+		t.Errorf("queryLimit = %v, want 100", fields[fieldQueryLimit])
+	}
+	if v, ok := fields[fieldGrabLimit]; !ok || v != nil {
+		t.Errorf("grabLimit = %v (present %v), want null", v, ok)
+	}
+	if fields[fieldLimitsUnit] != 1 {
+		t.Errorf("limitsUnit = %v, want 1 (hour)", fields[fieldLimitsUnit])
+	}
+
+	// Prowlarr returns numbers as float64 and no limit as null
+	current := idx
+	current.QueryLimit = limitFromField(float64(100))
+	current.GrabLimit = limitFromField(nil)
+	current.LimitsUnit = limitsUnitName(*fieldInt(float64(1)))
+	if !indexersEqual(current, idx) {
+		t.Errorf("indexersEqual() = false for limits read back from Prowlarr")
+	}
+
+	current.QueryLimit = limitFromField(float64(50))
+	if indexersEqual(current, idx) {
+		t.Errorf("indexersEqual() = true with a different query limit")
+	}
+
+	// Unset limits leave the indexer's own alone
+	unset := idx
+	unset.QueryLimit, unset.GrabLimit, unset.LimitsUnit = nil, nil, ""
+	if !indexersEqual(current, unset) {
+		t.Errorf("indexersEqual() = false with no desired limits")
+	}
+	for _, f := range indexerFields(unset, "") {
+		if strings.HasPrefix(f.Name, "baseSettings.") {
+			t.Errorf("indexerFields() sent %s without a desired limit", f.Name)
+		}
+	}
+}
+
+func TestDiffIndexersPaused(t *testing.T) {
+	current := &irv1.ProwlarrIR{Indexers: []irv1.ProwlarrIndexerIR{
+		{Name: "nebularr-p-tracker", Definition: "tracker", Enable: true, Priority: 10},
+	}}
+	desired := &irv1.ProwlarrIR{Indexers: []irv1.ProwlarrIndexerIR{
+		{Name: "nebularr-p-tracker", Definition: "tracker", Enable: false, Priority: 25, Paused: true},
+		{Name: "nebularr-p-nyaa", Definition: "nyaa", Enable: true, Paused: true},
+	}}
+
+	changes := &adapters.ChangeSet{}
+	if err := (&Adapter{}).diffIndexers(current, desired, changes); err != nil {
+		t.Fatalf("diffIndexers() error = %v", err)
+	}
+	if len(changes.Creates)+len(changes.Updates)+len(changes.Deletes) != 0 {
+		t.Errorf("diffIndexers() = %+v, want paused indexers kept as they are", changes)
+	}
+}
@@ -3,6 +3,7 @@ package prowlarr
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
@@ -31,6 +32,7 @@ func (a *Adapter) getManagedProxies(ctx context.Context, c *httpclient.Client, t
 
 		// Convert to IR
 		ir := irv1.IndexerProxyIR{
+			ID:   proxy.ID,
 			Name: proxy.Name,
 			Type: implToProxyType(proxy.Implementation),
 		}
@@ -113,6 +115,10 @@ func (a *Adapter) diffProxies(current, desired *irv1.ProwlarrIR, changes *adapte
 
 	// Find creates and updates
 	for name, desiredProxy := range desiredByName {
+		if desiredProxy.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		currentProxy, exists := currentByName[name]
 		if !exists {
 			// Create
@@ -146,8 +152,8 @@ func (a *Adapter) diffProxies(current, desired *irv1.ProwlarrIR, changes *adapte
 
 // proxiesEqual compares two proxies for equality
 func proxiesEqual(a, b irv1.IndexerProxyIR) bool {
-	return a.Type == b.Type &&
-		a.Host == b.Host &&
+	return adapters.ImplementationsEqual(a.Type, b.Type) &&
+		strings.EqualFold(a.Host, b.Host) &&
 		a.Port == b.Port &&
 		a.Username == b.Username &&
 		a.RequestTimeout == b.RequestTimeout
@@ -0,0 +1,62 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// IndexerStatsResource is the response of Prowlarr's indexerstats endpoint
+type IndexerStatsResource struct {
+	Indexers []IndexerStatistic `json:"indexers"`
+}
+
+// IndexerStatistic holds the request counts of a single indexer.
+// Each total includes the failed requests.
+type IndexerStatistic struct {
+	IndexerID                 int    `json:"indexerId"`
+	IndexerName               string `json:"indexerName"`
+	NumberOfQueries           int    `json:"numberOfQueries"`
+	NumberOfGrabs             int    `json:"numberOfGrabs"`
+	NumberOfRssQueries        int    `json:"numberOfRssQueries"`
+	NumberOfAuthQueries       int    `json:"numberOfAuthQueries"`
+	NumberOfFailedQueries     int    `json:"numberOfFailedQueries"`
+	NumberOfFailedGrabs       int    `json:"numberOfFailedGrabs"`
+	NumberOfFailedRssQueries  int    `json:"numberOfFailedRssQueries"`
+	NumberOfFailedAuthQueries int    `json:"numberOfFailedAuthQueries"`
+}
+
+// Ensure Adapter implements IndexerStatsReader
+var _ adapters.IndexerStatsReader = (*Adapter)(nil)
+
+// IndexerStats returns the request counts of every indexer since the given time
+func (a *Adapter) IndexerStats(ctx context.Context, conn *irv1.ConnectionIR, since time.Time) (map[string]adapters.IndexerStats, error) {
+	c := a.newClient(conn)
+
+	params := url.Values{}
+	params.Set("startDate", since.UTC().Format(time.RFC3339))
+	params.Set("endDate", time.Now().UTC().Format(time.RFC3339))
+
+	var resource IndexerStatsResource
+	if err := c.Get(ctx, "/api/v1/indexerstats?"+params.Encode(), &resource); err != nil {
+		return nil, fmt.Errorf("failed to get indexer stats: %w", err)
+	}
+
+	return indexerStatsByName(resource.Indexers), nil
+}
+
+// indexerStatsByName sums the request counts of each indexer across request kinds
+func indexerStatsByName(indexers []IndexerStatistic) map[string]adapters.IndexerStats {
+	stats := make(map[string]adapters.IndexerStats, len(indexers))
+	for _, idx := range indexers {
+		stats[idx.IndexerName] = adapters.IndexerStats{
+			Requests: idx.NumberOfQueries + idx.NumberOfGrabs + idx.NumberOfRssQueries + idx.NumberOfAuthQueries,
+			Failures: idx.NumberOfFailedQueries + idx.NumberOfFailedGrabs + idx.NumberOfFailedRssQueries + idx.NumberOfFailedAuthQueries,
+		}
+	}
+	return stats
+}
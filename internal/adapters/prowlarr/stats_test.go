@@ -0,0 +1,39 @@
+package prowlarr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestIndexerStats(t *testing.T) {
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/indexerstats" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("startDate"); got != "2026-01-02T03:04:05Z" {
+			t.Errorf("startDate = %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"indexers":[{"indexerId":1,"indexerName":"nebularr-p-nyaa",
+			"numberOfQueries":10,"numberOfGrabs":2,"numberOfRssQueries":5,"numberOfAuthQueries":1,
+			"numberOfFailedQueries":4,"numberOfFailedGrabs":1,"numberOfFailedRssQueries":2,"numberOfFailedAuthQueries":0}]}`))
+	}))
+	defer server.Close()
+
+	a := &Adapter{}
+	stats, err := a.IndexerStats(context.Background(), &irv1.ConnectionIR{URL: server.URL, APIKey: "key"}, since)
+	if err != nil {
+		t.Fatalf("IndexerStats() error = %v", err)
+	}
+	want := adapters.IndexerStats{Requests: 18, Failures: 7}
+	if got := stats["nebularr-p-nyaa"]; got != want {
+		t.Errorf("IndexerStats() = %+v, want %+v", got, want)
+	}
+}
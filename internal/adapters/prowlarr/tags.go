@@ -2,22 +2,96 @@ package prowlarr
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
-// getOwnershipTagID retrieves the ownership tag ID, returning error if not found
-func (a *Adapter) getOwnershipTagID(ctx context.Context, c *httpclient.Client) (int, error) {
-	return shared.GetOwnershipTagID(ctx, c, "v1")
+// getOwnershipTagID retrieves the ID of the connection's ownership tag, returning error if not found
+func (a *Adapter) getOwnershipTagID(ctx context.Context, c *httpclient.Client, conn *irv1.ConnectionIR) (int, error) {
+	return shared.GetOwnershipTagID(ctx, c, "v1", shared.OwnershipTagLabel(conn))
 }
 
 // ensureOwnershipTag creates the ownership tag if it doesn't exist and returns its ID
-func (a *Adapter) ensureOwnershipTag(ctx context.Context, c *httpclient.Client) (int, error) {
-	return shared.EnsureOwnershipTag(ctx, c, "v1")
+func (a *Adapter) ensureOwnershipTag(ctx context.Context, c *httpclient.Client, conn *irv1.ConnectionIR) (int, error) {
+	return shared.EnsureOwnershipTag(ctx, c, "v1", shared.OwnershipTagLabel(conn))
 }
 
 // hasTag checks if a resource has the specified tag
 func hasTag(tags []int, tagID int) bool {
 	return shared.HasTag(tags, tagID)
 }
+
+// applicationTagSuffix names the tag marking the applications of a config: the
+// ownership tag label plus this suffix. No indexer carries it, so unlike the
+// ownership tag it doesn't change which indexers an application receives.
+const applicationTagSuffix = "-applications"
+
+// applicationTagID returns the ID of the application tag belonging to the
+// ownership tag, or 0 when either doesn't exist
+func applicationTagID(labels map[int]string, ownershipTagID int) int {
+	owner, ok := labels[ownershipTagID]
+	if !ok {
+		return 0
+	}
+	for id, label := range labels {
+		if strings.EqualFold(label, owner+applicationTagSuffix) {
+			return id
+		}
+	}
+	return 0
+}
+
+// routingTags returns the labels of tags, leaving out the ownership and application tags
+func routingTags(tags []int, labels map[int]string, ownTagIDs ...int) []string {
+	var names []string
+	for _, id := range tags {
+		if slices.Contains(ownTagIDs, id) {
+			continue
+		}
+		if label, ok := labels[id]; ok {
+			names = append(names, label)
+		}
+	}
+	return names
+}
+
+// indexerTagIDs resolves the tags written to an indexer: the ownership tag plus
+// its routing tags, created on first use.
+func indexerTagIDs(ctx context.Context, c *httpclient.Client, names []string, ownershipTagID int) ([]int, error) {
+	ids, err := shared.EnsureTagIDs(ctx, c, "v1", names)
+	if err != nil {
+		return nil, err
+	}
+	return append([]int{ownershipTagID}, ids...), nil
+}
+
+// applicationTagIDs resolves the tags written to an application: the application
+// tag marking it as managed, plus its routing tags or the ownership tag.
+// Prowlarr syncs an indexer to an application when they share any tag, and every
+// managed indexer carries the ownership tag, so an application with routing tags
+// doesn't get it. An application without routing tags carries the ownership tag
+// and receives every managed indexer.
+func applicationTagIDs(ctx context.Context, c *httpclient.Client, names []string, ownershipTagID int) ([]int, error) {
+	labels, err := shared.GetTagLabels(ctx, c, "v1")
+	if err != nil {
+		return nil, err
+	}
+	owner, ok := labels[ownershipTagID]
+	if !ok {
+		return nil, fmt.Errorf("ownership tag %d not found", ownershipTagID)
+	}
+
+	ids, err := shared.EnsureTagIDs(ctx, c, "v1", append(slices.Clone(names), owner+applicationTagSuffix))
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		ids = append(ids, ownershipTagID)
+	}
+	return ids, nil
+}
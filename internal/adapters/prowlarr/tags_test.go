@@ -0,0 +1,173 @@
+package prowlarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestRoutingTags(t *testing.T) {
+	labels := map[int]string{1: "nebularr-managed", 2: "movies", 3: "private"}
+
+	got := routingTags([]int{1, 3, 2, 9}, labels, 1)
+	if want := []string{"private", "movies"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("routingTags() = %v, want %v", got, want)
+	}
+	if got := routingTags([]int{1}, labels, 1); got != nil {
+		t.Errorf("routingTags() = %v, want nil", got)
+	}
+}
+
+func TestGetManagedApplications(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/tag":
+			_, _ = w.Write([]byte(`[{"id":1,"label":"nebularr-p-1a2b3c4d"},{"id":2,"label":"nebularr-p-1a2b3c4d-applications"},` +
+				`{"id":3,"label":"anime"},{"id":4,"label":"nebularr-other-5e6f7a8b"}]`))
+		case "/api/v1/applications":
+			_, _ = w.Write([]byte(`[` +
+				`{"id":10,"name":"nebularr-p-radarr","implementation":"Radarr","tags":[1,2]},` +
+				`{"id":11,"name":"nebularr-p-sonarr","implementation":"Sonarr","tags":[2,3]},` +
+				`{"id":12,"name":"nebularr-other-sonarr","implementation":"Sonarr","tags":[3]},` +
+				`{"id":13,"name":"nebularr-other-lidarr","implementation":"Lidarr","tags":[4]},` +
+				`{"id":14,"name":"Manual","implementation":"Readarr","tags":[]}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL, APIKey: "key"})
+
+	a := &Adapter{}
+	apps, err := a.getManagedApplications(context.Background(), c, 1)
+	if err != nil {
+		t.Fatalf("getManagedApplications() error = %v", err)
+	}
+	var got []string
+	for _, app := range apps {
+		got = append(got, app.Name+":"+strings.Join(app.Tags, ","))
+	}
+	// Another config's applications and applications only named like managed ones are left alone
+	if want := []string{"nebularr-p-radarr:", "nebularr-p-sonarr:anime"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("getManagedApplications() = %v, want %v", got, want)
+	}
+
+	if apps, _ := a.getManagedApplications(context.Background(), c, 0); len(apps) != 0 {
+		t.Errorf("getManagedApplications() without an ownership tag = %+v, want none", apps)
+	}
+}
+
+func TestApplicationTagIDs(t *testing.T) {
+	var created []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tag":
+			_, _ = w.Write([]byte(`[{"id":1,"label":"nebularr-p-1a2b3c4d"},{"id":3,"label":"anime"}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tag":
+			var tag struct {
+				Label string `json:"label"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&tag)
+			created = append(created, tag.Label)
+			_, _ = w.Write([]byte(`{"id":2,"label":"` + tag.Label + `"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL, APIKey: "key"})
+
+	tests := []struct {
+		name  string
+		names []string
+		want  []int
+	}{
+		{name: "routing tags replace the ownership tag", names: []string{"anime"}, want: []int{3, 2}},
+		{name: "without routing tags", want: []int{2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applicationTagIDs(context.Background(), c, tt.names, 1)
+			if err != nil {
+				t.Fatalf("applicationTagIDs() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applicationTagIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if !slices.Contains(created, "nebularr-p-1a2b3c4d-applications") {
+		t.Errorf("created tags %v, want the application tag", created)
+	}
+
+	if _, err := applicationTagIDs(context.Background(), c, nil, 9); err == nil {
+		t.Error("applicationTagIDs() with a missing ownership tag succeeded")
+	}
+}
+
+func TestDiffTagRouting(t *testing.T) {
+	indexer := func(tags ...string) irv1.ProwlarrIndexerIR {
+		return irv1.ProwlarrIndexerIR{Name: "nebularr-p-nyaa", Definition: "nyaa", Enable: true, Priority: 25, Tags: tags}
+	}
+	application := func(tags ...string) irv1.ProwlarrApplicationIR {
+		return irv1.ProwlarrApplicationIR{Name: "nebularr-p-sonarr", Type: irv1.AppTypeSonarr, SyncLevel: irv1.SyncLevelFullSync, Tags: tags}
+	}
+
+	tests := []struct {
+		name        string
+		current     *irv1.ProwlarrIR
+		desired     *irv1.ProwlarrIR
+		wantUpdates []string
+	}{
+		{
+			name:    "in sync regardless of tag order and case",
+			current: &irv1.ProwlarrIR{Indexers: []irv1.ProwlarrIndexerIR{indexer("anime", "private")}, Applications: []irv1.ProwlarrApplicationIR{application("anime")}},
+			desired: &irv1.ProwlarrIR{Indexers: []irv1.ProwlarrIndexerIR{indexer("Private", "anime")}, Applications: []irv1.ProwlarrApplicationIR{application("Anime")}},
+		},
+		{
+			name:        "added indexer tag updates the indexer",
+			current:     &irv1.ProwlarrIR{Indexers: []irv1.ProwlarrIndexerIR{indexer()}},
+			desired:     &irv1.ProwlarrIR{Indexers: []irv1.ProwlarrIndexerIR{indexer("anime")}},
+			wantUpdates: []string{"nebularr-p-nyaa"},
+		},
+		{
+			name:        "removed application tag updates the application",
+			current:     &irv1.ProwlarrIR{Applications: []irv1.ProwlarrApplicationIR{application("anime")}},
+			desired:     &irv1.ProwlarrIR{Applications: []irv1.ProwlarrApplicationIR{application()}},
+			wantUpdates: []string{"nebularr-p-sonarr"},
+		},
+	}
+
+	a := &Adapter{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := &adapters.ChangeSet{}
+			if err := a.diffIndexers(tt.current, tt.desired, changes); err != nil {
+				t.Fatalf("diffIndexers() error = %v", err)
+			}
+			if err := a.diffApplications(tt.current, tt.desired, changes); err != nil {
+				t.Fatalf("diffApplications() error = %v", err)
+			}
+
+			var updates []string
+			for _, ch := range changes.Updates {
+				updates = append(updates, ch.Name)
+			}
+			if !reflect.DeepEqual(updates, tt.wantUpdates) {
+				t.Errorf("updates = %v, want %v", updates, tt.wantUpdates)
+			}
+			if len(changes.Creates) != 0 || len(changes.Deletes) != 0 {
+				t.Errorf("unexpected creates %v or deletes %v", changes.Creates, changes.Deletes)
+			}
+		})
+	}
+}
@@ -49,6 +49,16 @@ type IndexerCategory struct {
 	Name string `json:"name"`
 }
 
+// AppProfileResource represents a Prowlarr app profile
+type AppProfileResource struct {
+	ID                      int    `json:"id,omitempty"`
+	Name                    string `json:"name"`
+	EnableRss               bool   `json:"enableRss"`
+	EnableAutomaticSearch   bool   `json:"enableAutomaticSearch"`
+	EnableInteractiveSearch bool   `json:"enableInteractiveSearch"`
+	MinimumSeeders          int    `json:"minimumSeeders"`
+}
+
 // IndexerProxyResource represents a Prowlarr indexer proxy
 type IndexerProxyResource struct {
 	ID             int                 `json:"id,omitempty"`
@@ -4,22 +4,24 @@ package radarr
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
 	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
-const (
-	// OwnershipTagName is the tag name used to identify Nebularr-managed resources
-	OwnershipTagName = "nebularr-managed"
-)
+// ownedEndpoints are the collections of the resource types carrying the ownership tag
+var ownedEndpoints = map[string]string{
+	adapters.ResourceDownloadClient: "/api/v3/downloadclient",
+	adapters.ResourceIndexer:        "/api/v3/indexer",
+	adapters.ResourceNotification:   "/api/v3/notification",
+}
 
 // Adapter implements the adapters.Adapter interface for Radarr
 type Adapter struct{}
@@ -51,7 +53,7 @@ func (a *Adapter) Connect(ctx context.Context, conn *irv1.ConnectionIR) (*adapte
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var status client.SystemResource
@@ -85,8 +87,18 @@ func (a *Adapter) Discover(ctx context.Context, conn *irv1.ConnectionIR) (*adapt
 		Sources: []string{"bluray", "webdl", "webrip", "hdtv", "dvd", "cam", "telesync", "telecine", "workprint"},
 	}
 
+	// Discover the version, which decides the settings the compiler keeps
+	resp, err := c.GetApiV3SystemStatus(ctx)
+	if err == nil && resp.StatusCode == http.StatusOK {
+		defer func() { _ = resp.Body.Close() }()
+		var status client.SystemResource
+		if err := json.NewDecoder(resp.Body).Decode(&status); err == nil {
+			caps.Version = ptrToString(status.Version)
+		}
+	}
+
 	// Discover custom format specs
-	resp, err := c.GetApiV3CustomformatSchema(ctx)
+	resp, err = c.GetApiV3CustomformatSchema(ctx)
 	if err == nil && resp.StatusCode == http.StatusOK {
 		defer func() { _ = resp.Body.Close() }()
 		var schemas []client.CustomFormatSpecificationSchema
@@ -151,17 +163,18 @@ func (a *Adapter) CurrentState(ctx context.Context, conn *irv1.ConnectionIR) (*i
 		Connection:  conn,
 	}
 
-	// Get ownership tag ID
-	tagID, err := a.getOwnershipTagID(ctx, c)
+	// Get ownership tag ID. Without the tag nothing is tagged as managed yet, but
+	// resources recognised by name (e.g., nebularr- quality profiles) are still read.
+	tagID, err := a.getOwnershipTagID(ctx, c, conn)
 	if err != nil {
-		// No ownership tag means no managed resources
-		return ir, nil
+		tagID = 0
 	}
 
 	// Get quality profiles tagged with ownership tag
 	if profiles, err := a.getManagedQualityProfiles(ctx, c, tagID); err == nil && len(profiles) > 0 {
 		ir.Quality = &irv1.QualityIR{
-			Video: profiles[0], // For now, we only manage one profile per config
+			Video:         profiles[0],
+			VideoProfiles: profiles[1:],
 		}
 	}
 
@@ -233,6 +246,11 @@ func (a *Adapter) CurrentState(ctx context.Context, conn *irv1.ConnectionIR) (*i
 		ir.DelayProfiles = delayProfiles
 	}
 
+	// Get auto-tagging rules (identified by name, since their tags are applied to movies)
+	if autoTags, err := a.getManagedAutoTags(ctx, c, conn); err == nil {
+		ir.AutoTags = autoTags
+	}
+
 	return ir, nil
 }
 
@@ -289,6 +307,11 @@ func (a *Adapter) Diff(current, desired *irv1.IR, caps *adapters.Capabilities) (
 		return nil, fmt.Errorf("failed to diff delay profiles: %w", err)
 	}
 
+	// Diff auto-tagging rules
+	if err := a.diffAutoTags(current, desired, changes); err != nil {
+		return nil, fmt.Errorf("failed to diff auto tags: %w", err)
+	}
+
 	return changes, nil
 }
 
@@ -299,52 +322,23 @@ func (a *Adapter) Apply(ctx context.Context, conn *irv1.ConnectionIR, changes *a
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
 
-	result := &adapters.ApplyResult{}
-
 	// Ensure ownership tag exists
-	tagID, err := a.ensureOwnershipTag(ctx, c)
+	tagID, err := a.ensureOwnershipTag(ctx, c, conn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to ensure ownership tag: %w", err)
 	}
 
-	// Apply creates
-	for _, change := range changes.Creates {
-		if err := a.applyCreate(ctx, c, change, tagID); err != nil {
-			result.Failed++
-			result.Errors = append(result.Errors, adapters.ApplyError{
-				Change: change,
-				Error:  err,
-			})
-		} else {
-			result.Applied++
-		}
-	}
-
-	// Apply updates
-	for _, change := range changes.Updates {
-		if err := a.applyUpdate(ctx, c, change, tagID); err != nil {
-			result.Failed++
-			result.Errors = append(result.Errors, adapters.ApplyError{
-				Change: change,
-				Error:  err,
-			})
-		} else {
-			result.Applied++
-		}
-	}
-
-	// Apply deletes
-	for _, change := range changes.Deletes {
-		if err := a.applyDelete(ctx, c, change); err != nil {
-			result.Failed++
-			result.Errors = append(result.Errors, adapters.ApplyError{
-				Change: change,
-				Error:  err,
-			})
-		} else {
-			result.Applied++
-		}
-	}
+	// Use shared apply loop with adapter-specific callbacks. Creates first adopt a
+	// same-named resource still carrying the shared ownership tag.
+	hc := httpclient.New(httpclient.ConfigForConnection(conn))
+	result := shared.ApplyChanges(
+		changes,
+		shared.AdoptingCreate(ctx, hc, "v3", ownedEndpoints, tagID, func(change adapters.Change) error {
+			return a.applyCreate(ctx, c, change, tagID)
+		}),
+		func(change adapters.Change) error { return a.applyUpdate(ctx, c, change, tagID) },
+		func(change adapters.Change) error { return a.applyDelete(ctx, c, change) },
+	)
 
 	return result, nil
 }
@@ -359,7 +353,7 @@ func (a *Adapter) ApplyDirect(ctx context.Context, conn *irv1.ConnectionIR, ir *
 	}
 
 	// Ensure ownership tag exists
-	tagID, err := a.ensureOwnershipTag(ctx, c)
+	tagID, err := a.ensureOwnershipTag(ctx, c, conn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to ensure ownership tag: %w", err)
 	}
@@ -379,29 +373,88 @@ func (a *Adapter) ApplyDirect(ctx context.Context, conn *irv1.ConnectionIR, ir *
 				Errors:  stats.Errors,
 			}, nil
 		},
+		ApplyImportListOptions: func() error {
+			return shared.ApplyImportListOptions(ctx, httpclient.New(httpclient.ConfigForConnection(conn)), ir.ImportListOptions, 0)
+		},
 		ApplyMediaManagement: func() error {
 			return a.applyMediaManagement(ctx, c, ir.MediaManagement)
 		},
+		ApplyCollections: func() error {
+			return a.applyCollections(ctx, c, ir.Collections)
+		},
 		ApplyAuthentication: func() error {
 			return a.applyAuthentication(ctx, c, ir.Authentication)
 		},
+		ApplyUIConfig: func() error {
+			return shared.ApplyUIConfig(ctx, httpclient.New(httpclient.ConfigForConnection(conn)), ir.UI)
+		},
+		ApplyQualityDefinitions: func() error {
+			return a.applyQualityDefinitions(ctx, c, ir.QualityDefinitions)
+		},
 	})
 
 	return result, nil
 }
 
+// Ensure Adapter implements PathChecker
+var _ adapters.PathChecker = (*Adapter)(nil)
+
+// CheckPath looks a root folder path up through Radarr's filesystem API
+func (a *Adapter) CheckPath(ctx context.Context, conn *irv1.ConnectionIR, path string) error {
+	return shared.CheckPath(ctx, httpclient.New(httpclient.ConfigForConnection(conn)), "v3", path)
+}
+
+// Ensure Adapter implements RawRequester
+var _ adapters.RawRequester = (*Adapter)(nil)
+
+// SendRaw sends an unmodeled API request to Radarr (spec.raw).
+// The generated client only covers modeled endpoints, so this uses the shared HTTP client.
+func (a *Adapter) SendRaw(ctx context.Context, conn *irv1.ConnectionIR, method, path string, body []byte) error {
+	c := httpclient.New(httpclient.ConfigForConnection(conn))
+	return shared.SendRaw(ctx, c, method, path, body)
+}
+
+// Ensure Adapter implements RefReader
+var _ adapters.RefReader = (*Adapter)(nil)
+
+// ManagedRefs picks the Radarr IDs of the resources declared in desired out of current
+func (a *Adapter) ManagedRefs(current, desired *irv1.IR) *adapters.ManagedRefs {
+	return shared.ManagedRefsFromIR(current, desired)
+}
+
+// Ensure Adapter implements QueueReader
+var _ adapters.QueueReader = (*Adapter)(nil)
+
+// QueuedDownloadIDs returns the download IDs of the Radarr queue
+func (a *Adapter) QueuedDownloadIDs(ctx context.Context, conn *irv1.ConnectionIR) (map[string]bool, error) {
+	return shared.ReadQueuedDownloadIDs(ctx, httpclient.New(httpclient.ConfigForConnection(conn)), "/api/v3/queue?includeUnknownMovieItems=true")
+}
+
+// Ensure Adapter implements BlocklistManager
+var _ adapters.BlocklistManager = (*Adapter)(nil)
+
+// GetBlocklist returns Radarr's blocklist (spec.maintenance)
+func (a *Adapter) GetBlocklist(ctx context.Context, conn *irv1.ConnectionIR) ([]adapters.BlocklistEntry, error) {
+	return shared.GetBlocklist(ctx, httpclient.New(httpclient.ConfigForConnection(conn)), "v3")
+}
+
+// RemoveBlocklistEntry removes one entry from Radarr's blocklist
+func (a *Adapter) RemoveBlocklistEntry(ctx context.Context, conn *irv1.ConnectionIR, id int) error {
+	return shared.RemoveBlocklistEntry(ctx, httpclient.New(httpclient.ConfigForConnection(conn)), "v3", id)
+}
+
+// Ensure Adapter implements Snapshotter
+var _ adapters.Snapshotter = (*Adapter)(nil)
+
+// Snapshot returns the raw bodies of the managed quality profiles and custom formats
+func (a *Adapter) Snapshot(ctx context.Context, conn *irv1.ConnectionIR) (map[string]map[string]json.RawMessage, error) {
+	return shared.SnapshotManaged(ctx, httpclient.New(httpclient.ConfigForConnection(conn)))
+}
+
 // newClient creates a new Radarr API client
 func (a *Adapter) newClient(conn *irv1.ConnectionIR) (*client.Client, error) {
-	// Create HTTP client with TLS config if needed
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	if conn.InsecureSkipVerify {
-		httpClient.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // User explicitly requested insecure
-		}
-	}
+	// Shared HTTP client honors TLS settings and extra headers
+	httpClient := httpclient.NewHTTPClient(httpclient.ConfigForConnection(conn))
 
 	// Create the oapi-codegen client
 	c, err := client.NewClient(conn.URL, client.WithHTTPClient(httpClient), client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
@@ -461,7 +514,7 @@ func (a *Adapter) GetHealth(ctx context.Context, conn *irv1.ConnectionIR) (*irv1
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var healthChecks []client.HealthResource
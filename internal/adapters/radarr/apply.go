@@ -7,9 +7,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
@@ -31,7 +35,9 @@ func (a *Adapter) applyCreate(ctx context.Context, c *client.Client, change adap
 	case adapters.ResourceNotification:
 		return a.createNotification(ctx, c, change.Payload.(*irv1.NotificationIR), tagID)
 	case adapters.ResourceDelayProfile:
-		return a.createDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR), tagID)
+		return a.createDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR))
+	case adapters.ResourceAutoTag:
+		return a.createAutoTag(ctx, c, change.Payload.(*irv1.AutoTagIR))
 	default:
 		return fmt.Errorf("unknown resource type for create: %s", change.ResourceType)
 	}
@@ -55,7 +61,11 @@ func (a *Adapter) applyUpdate(ctx context.Context, c *client.Client, change adap
 	case adapters.ResourceNotification:
 		return a.updateNotification(ctx, c, change.Payload.(*irv1.NotificationIR), tagID)
 	case adapters.ResourceDelayProfile:
-		return a.updateDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR), tagID)
+		return a.updateDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR))
+	case adapters.ResourceDelayProfileOrder:
+		return a.reorderDelayProfiles(ctx, c, change.Payload.([]string))
+	case adapters.ResourceAutoTag:
+		return a.updateAutoTag(ctx, c, change.Payload.(*irv1.AutoTagIR))
 	default:
 		return fmt.Errorf("unknown resource type for update: %s", change.ResourceType)
 	}
@@ -87,6 +97,11 @@ func (a *Adapter) applyDelete(ctx context.Context, c *client.Client, change adap
 			return a.deleteDelayProfile(ctx, c, *change.ID)
 		}
 		return fmt.Errorf("delay profile ID is required for delete")
+	case adapters.ResourceAutoTag:
+		if change.ID != nil {
+			return a.deleteAutoTag(ctx, c, *change.ID)
+		}
+		return fmt.Errorf("auto tag ID is required for delete")
 	default:
 		return fmt.Errorf("unknown resource type for delete: %s", change.ResourceType)
 	}
@@ -108,7 +123,7 @@ func (a *Adapter) createQualityProfile(ctx context.Context, c *client.Client, ir
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+		return &httpclient.StatusError{Code: resp.StatusCode, Body: string(body)}
 	}
 
 	return nil
@@ -127,14 +142,17 @@ func (a *Adapter) updateQualityProfile(ctx context.Context, c *client.Client, ir
 	}
 	profile.Id = intPtr(profileID)
 
-	resp, err := c.PutApiV3QualityprofileId(ctx, fmt.Sprintf("%d", profileID), profile)
+	// Keep the current profile to restore if the update fails midway
+	previous, err := readRawResource(c.GetApiV3QualityprofileId(ctx, int32(profileID)))
 	if err != nil {
-		return fmt.Errorf("failed to update quality profile: %w", err)
+		return fmt.Errorf("failed to read quality profile before update: %w", err)
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	id := fmt.Sprintf("%d", profileID)
+	if err := checkPutResponse(c.PutApiV3QualityprofileId(ctx, id, profile)); err != nil {
+		return shared.RollbackUpdate(fmt.Errorf("failed to update quality profile: %w", err), previous, func(body json.RawMessage) error {
+			return checkPutResponse(c.PutApiV3QualityprofileIdWithBody(ctx, id, "application/json", bytes.NewReader(body)))
+		})
 	}
 
 	return nil
@@ -153,12 +171,32 @@ func (a *Adapter) deleteQualityProfile(ctx context.Context, c *client.Client, na
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
 }
 
+// getQualityProfileIDs returns quality profile IDs keyed by name
+func (a *Adapter) getQualityProfileIDs(ctx context.Context, c *client.Client) (map[string]int, error) {
+	resp, err := c.GetApiV3Qualityprofile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quality profiles: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var profiles []client.QualityProfileResource
+	if err := json.NewDecoder(resp.Body).Decode(&profiles); err != nil {
+		return nil, fmt.Errorf("failed to decode quality profiles: %w", err)
+	}
+
+	ids := make(map[string]int, len(profiles))
+	for _, p := range profiles {
+		ids[ptrToString(p.Name)] = ptrToInt(p.Id)
+	}
+	return ids, nil
+}
+
 func (a *Adapter) findQualityProfileIDByName(ctx context.Context, c *client.Client, name string) (int, error) {
 	resp, err := c.GetApiV3Qualityprofile(ctx)
 	if err != nil {
@@ -181,33 +219,31 @@ func (a *Adapter) findQualityProfileIDByName(ctx context.Context, c *client.Clie
 }
 
 func (a *Adapter) irToQualityProfile(ctx context.Context, c *client.Client, ir *irv1.VideoQualityIR) (client.PostApiV3QualityprofileJSONRequestBody, error) {
-	// Fetch the quality profile schema - this gives us all items with proper structure
-	resp, err := c.GetApiV3QualityprofileSchema(ctx)
+	// Start from the schema (or the profile to clone) - this gives us all items with proper structure
+	profile, err := a.qualityProfileBase(ctx, c, ir)
 	if err != nil {
-		return client.QualityProfileResource{}, fmt.Errorf("failed to get quality profile schema: %w", err)
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		return client.QualityProfileResource{}, fmt.Errorf("unexpected status code getting schema: %d", resp.StatusCode)
-	}
-
-	var profile client.QualityProfileResource
-	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
-		return client.QualityProfileResource{}, fmt.Errorf("failed to decode quality profile schema: %w", err)
+		return client.QualityProfileResource{}, err
 	}
+	cloned := ir.CloneFrom != ""
 
 	// Set profile metadata
 	profile.Name = stringPtr(ir.ProfileName)
 	profile.UpgradeAllowed = boolPtr(ir.UpgradeAllowed)
 	profile.MinFormatScore = intPtr(ir.MinimumCustomFormatScore)
 
-	// Set default language to "Original" (id: -2)
-	langID := int32(-2)
-	langName := "Original"
-	profile.Language = &client.Language{
-		Id:   &langID,
-		Name: &langName,
+	// Set the profile language, "Original" (id: -2) unless the spec chooses one.
+	// A cloned profile keeps its own language.
+	if ir.Language != nil || !cloned {
+		langID := int32(irv1.LanguageOriginal)
+		langName := "Original"
+		if ir.Language != nil {
+			langID = int32(ir.Language.ID)
+			langName = ir.Language.Name
+		}
+		profile.Language = &client.Language{
+			Id:   &langID,
+			Name: &langName,
+		}
 	}
 
 	if ir.UpgradeUntilCustomFormatScore > 0 {
@@ -236,12 +272,92 @@ func (a *Adapter) irToQualityProfile(ctx context.Context, c *client.Client, ir *
 		if err != nil {
 			return client.QualityProfileResource{}, fmt.Errorf("failed to build format items: %w", err)
 		}
+		if cloned && profile.FormatItems != nil {
+			// Keep the scores the cloned profile gives other formats
+			formatItems = mergeFormatItems(*profile.FormatItems, formatItems)
+		}
 		profile.FormatItems = &formatItems
 	}
 
 	return profile, nil
 }
 
+// qualityProfileBase returns the profile a managed profile is built from: the
+// schema, or a copy of the existing profile named by CloneFrom with its order,
+// groups and format scores
+func (a *Adapter) qualityProfileBase(ctx context.Context, c *client.Client, ir *irv1.VideoQualityIR) (client.QualityProfileResource, error) {
+	if ir.CloneFrom == "" {
+		resp, err := c.GetApiV3QualityprofileSchema(ctx)
+		if err != nil {
+			return client.QualityProfileResource{}, fmt.Errorf("failed to get quality profile schema: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusOK {
+			return client.QualityProfileResource{}, fmt.Errorf("failed to get schema: %w", &httpclient.StatusError{Code: resp.StatusCode})
+		}
+
+		var schema client.QualityProfileResource
+		if err := json.NewDecoder(resp.Body).Decode(&schema); err != nil {
+			return client.QualityProfileResource{}, fmt.Errorf("failed to decode quality profile schema: %w", err)
+		}
+		return schema, nil
+	}
+
+	resp, err := c.GetApiV3Qualityprofile(ctx)
+	if err != nil {
+		return client.QualityProfileResource{}, fmt.Errorf("failed to get quality profiles: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return client.QualityProfileResource{}, fmt.Errorf("failed to get quality profiles: %w", &httpclient.StatusError{Code: resp.StatusCode})
+	}
+
+	var profiles []client.QualityProfileResource
+	if err := json.NewDecoder(resp.Body).Decode(&profiles); err != nil {
+		return client.QualityProfileResource{}, fmt.Errorf("failed to decode quality profiles: %w", err)
+	}
+	for _, p := range profiles {
+		if strings.EqualFold(ptrToString(p.Name), ir.CloneFrom) {
+			p.Id = nil
+			return p, nil
+		}
+	}
+	return client.QualityProfileResource{}, adapters.NewError(adapters.ErrorCategoryNotFound,
+		fmt.Errorf("quality profile %q to clone from not found", ir.CloneFrom))
+}
+
+// mergeFormatItems overrides the scores of base with those of desired, matching
+// by format ID, and appends the desired formats base doesn't list
+func mergeFormatItems(base, desired []client.ProfileFormatItemResource) []client.ProfileFormatItemResource {
+	desiredByID := make(map[int32]client.ProfileFormatItemResource, len(desired))
+	for _, item := range desired {
+		if item.Format != nil {
+			desiredByID[*item.Format] = item
+		}
+	}
+
+	merged := make([]client.ProfileFormatItemResource, 0, len(base)+len(desired))
+	for _, item := range base {
+		if item.Format != nil {
+			if d, ok := desiredByID[*item.Format]; ok {
+				item.Score = d.Score
+				delete(desiredByID, *item.Format)
+			}
+		}
+		merged = append(merged, item)
+	}
+	for _, item := range desired {
+		if item.Format != nil {
+			if _, ok := desiredByID[*item.Format]; ok {
+				merged = append(merged, item)
+			}
+		}
+	}
+	return merged
+}
+
 // buildAllowedQualitySet builds a set of quality IDs that should be allowed based on tiers
 func (a *Adapter) buildAllowedQualitySet(tiers []irv1.VideoQualityTierIR) map[int]bool {
 	allowed := make(map[int]bool)
@@ -322,15 +438,30 @@ func (a *Adapter) qualityNameToID(name string) int {
 	return ids[name]
 }
 
-// findCutoffQualityID finds the quality ID for the cutoff tier
-func (a *Adapter) findCutoffQualityID(cutoff irv1.VideoQualityTierIR, _ *[]client.QualityProfileQualityItemResource) int {
+// findCutoffQualityID finds the quality ID for the cutoff tier. A quality inside
+// a group (e.g. of a cloned profile) is cut off at the group's ID, as Radarr requires.
+func (a *Adapter) findCutoffQualityID(cutoff irv1.VideoQualityTierIR, items *[]client.QualityProfileQualityItemResource) int {
 	if len(cutoff.Sources) == 0 {
 		return 0
 	}
 	res := parseResolution(cutoff.Resolution)
 	// Use first source for cutoff
 	qualityName := a.buildQualityName(res, cutoff.Sources[0])
-	return a.qualityNameToID(qualityName)
+	qualityID := a.qualityNameToID(qualityName)
+
+	if items != nil && qualityID > 0 {
+		for _, item := range *items {
+			if item.Id == nil || item.Items == nil {
+				continue
+			}
+			for _, nested := range *item.Items {
+				if nested.Quality != nil && nested.Quality.Id != nil && int(*nested.Quality.Id) == qualityID {
+					return int(*item.Id)
+				}
+			}
+		}
+	}
+	return qualityID
 }
 
 // markAllowedQualities updates the items to mark allowed qualities
@@ -377,7 +508,10 @@ func parseResolution(res string) int {
 
 func (a *Adapter) createCustomFormat(ctx context.Context, c *client.Client, ir *irv1.CustomFormatIR) error {
 	// Use minimal struct to avoid null serialization issues with generated client
-	cf := a.buildMinimalCustomFormat(ir)
+	cf, err := a.buildMinimalCustomFormat(ir)
+	if err != nil {
+		return fmt.Errorf("failed to build custom format: %w", err)
+	}
 
 	// Serialize to JSON
 	jsonBody, err := json.Marshal(cf)
@@ -395,7 +529,7 @@ func (a *Adapter) createCustomFormat(ctx context.Context, c *client.Client, ir *
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
 		// Read response body for error details
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+		return &httpclient.StatusError{Code: resp.StatusCode, Body: string(body)}
 	}
 
 	return nil
@@ -408,7 +542,10 @@ func (a *Adapter) updateCustomFormat(ctx context.Context, c *client.Client, ir *
 	}
 
 	// Use minimal struct to avoid null serialization issues
-	cf := a.buildMinimalCustomFormat(ir)
+	cf, err := a.buildMinimalCustomFormat(ir)
+	if err != nil {
+		return fmt.Errorf("failed to build custom format: %w", err)
+	}
 	cf.ID = &cfID
 
 	// Serialize to JSON
@@ -417,17 +554,50 @@ func (a *Adapter) updateCustomFormat(ctx context.Context, c *client.Client, ir *
 		return fmt.Errorf("failed to marshal custom format: %w", err)
 	}
 
-	resp, err := c.PutApiV3CustomformatIdWithBody(ctx, fmt.Sprintf("%d", cfID), "application/json", bytes.NewReader(jsonBody))
+	// Keep the current custom format to restore if the update fails midway
+	previous, err := readRawResource(c.GetApiV3CustomformatId(ctx, int32(cfID)))
+	if err != nil {
+		return fmt.Errorf("failed to read custom format before update: %w", err)
+	}
+
+	id := fmt.Sprintf("%d", cfID)
+	if err := checkPutResponse(c.PutApiV3CustomformatIdWithBody(ctx, id, "application/json", bytes.NewReader(jsonBody))); err != nil {
+		return shared.RollbackUpdate(fmt.Errorf("failed to update custom format: %w", err), previous, func(body json.RawMessage) error {
+			return checkPutResponse(c.PutApiV3CustomformatIdWithBody(ctx, id, "application/json", bytes.NewReader(body)))
+		})
+	}
+
+	return nil
+}
+
+// readRawResource returns the body of a successful GET
+func readRawResource(resp *http.Response, err error) (json.RawMessage, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &httpclient.StatusError{Code: resp.StatusCode, Body: string(body)}
+	}
+	return body, nil
+}
+
+// checkPutResponse turns a failed PUT into an error
+func checkPutResponse(resp *http.Response, err error) error {
 	if err != nil {
-		return fmt.Errorf("failed to update custom format: %w", err)
+		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+		return &httpclient.StatusError{Code: resp.StatusCode, Body: string(body)}
 	}
-
 	return nil
 }
 
@@ -444,7 +614,7 @@ func (a *Adapter) deleteCustomFormat(ctx context.Context, c *client.Client, name
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -496,7 +666,7 @@ type minimalCustomFormat struct {
 }
 
 // buildMinimalCustomFormat creates a minimal custom format payload for creation
-func (a *Adapter) buildMinimalCustomFormat(ir *irv1.CustomFormatIR) minimalCustomFormat {
+func (a *Adapter) buildMinimalCustomFormat(ir *irv1.CustomFormatIR) (minimalCustomFormat, error) {
 	cf := minimalCustomFormat{
 		Name:                            ir.Name,
 		IncludeCustomFormatWhenRenaming: ir.IncludeWhenRenaming,
@@ -504,21 +674,25 @@ func (a *Adapter) buildMinimalCustomFormat(ir *irv1.CustomFormatIR) minimalCusto
 	}
 
 	for _, spec := range ir.Specifications {
+		fields, err := a.buildMinimalFields(spec)
+		if err != nil {
+			return minimalCustomFormat{}, fmt.Errorf("specification %s: %w", spec.Name, err)
+		}
 		s := minimalSpec{
 			Name:           spec.Name,
 			Implementation: spec.Type,
 			Negate:         spec.Negate,
 			Required:       spec.Required,
-			Fields:         a.buildMinimalFields(spec),
+			Fields:         fields,
 		}
 		cf.Specifications = append(cf.Specifications, s)
 	}
 
-	return cf
+	return cf, nil
 }
 
 // buildMinimalFields creates the Fields array for a custom format specification
-func (a *Adapter) buildMinimalFields(spec irv1.FormatSpecIR) []minimalField {
+func (a *Adapter) buildMinimalFields(spec irv1.FormatSpecIR) ([]minimalField, error) {
 	switch spec.Type {
 	case "ReleaseTitleSpecification":
 		return []minimalField{
@@ -526,29 +700,51 @@ func (a *Adapter) buildMinimalFields(spec irv1.FormatSpecIR) []minimalField {
 				Name:  "value",
 				Value: spec.Value,
 			},
-		}
+		}, nil
 	case "SourceSpecification":
 		return []minimalField{
 			{
 				Name:  "value",
 				Value: a.sourceToInt(spec.Value),
 			},
-		}
+		}, nil
 	case "ResolutionSpecification":
 		return []minimalField{
 			{
 				Name:  "value",
 				Value: a.resolutionToInt(spec.Value),
 			},
+		}, nil
+	case "LanguageSpecification":
+		id, err := a.languageToInt(spec.Value)
+		if err != nil {
+			return nil, err
 		}
+		return []minimalField{
+			{
+				Name:  "value",
+				Value: id,
+			},
+		}, nil
 	default:
 		return []minimalField{
 			{
 				Name:  "value",
 				Value: spec.Value,
 			},
-		}
+		}, nil
+	}
+}
+
+// languageToInt converts a language ID string to the Radarr language ID.
+// The compiler resolves language names to IDs, so anything else is rejected
+// rather than sent as Unknown (0).
+func (a *Adapter) languageToInt(language string) (int, error) {
+	id, err := strconv.Atoi(language)
+	if err != nil {
+		return 0, fmt.Errorf("unknown language %q", language)
 	}
+	return id, nil
 }
 
 // sourceToInt converts source string to Radarr source enum value
@@ -597,7 +793,7 @@ func (a *Adapter) buildFormatItems(ctx context.Context, c *client.Client, format
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var formats []client.CustomFormatResource
@@ -645,7 +841,7 @@ func (a *Adapter) createDownloadClient(ctx context.Context, c *client.Client, ir
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+		return &httpclient.StatusError{Code: resp.StatusCode, Body: string(body)}
 	}
 
 	return nil
@@ -667,7 +863,7 @@ func (a *Adapter) updateDownloadClient(ctx context.Context, c *client.Client, ir
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -686,7 +882,7 @@ func (a *Adapter) deleteDownloadClient(ctx context.Context, c *client.Client, na
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -757,6 +953,9 @@ func (a *Adapter) buildDownloadClientFields(ir *irv1.DownloadClientIR) []client.
 	if ir.Password != "" {
 		fields = append(fields, client.Field{Name: stringPtr("password"), Value: ir.Password})
 	}
+	if ir.APIKey != "" {
+		fields = append(fields, client.Field{Name: stringPtr("apiKey"), Value: ir.APIKey})
+	}
 
 	// Add implementation-specific fields
 	switch ir.Implementation {
@@ -795,22 +994,7 @@ func (a *Adapter) buildDownloadClientFields(ir *irv1.DownloadClientIR) []client.
 
 func (a *Adapter) normalizeImplementation(impl string) string {
 	// Map our lowercase implementation names to Radarr's pascal case
-	switch impl {
-	case "qbittorrent":
-		return "QBittorrent"
-	case "transmission":
-		return "Transmission"
-	case "deluge":
-		return "Deluge"
-	case "rtorrent":
-		return "RTorrent"
-	case "sabnzbd":
-		return "Sabnzbd"
-	case "nzbget":
-		return "NzbGet"
-	default:
-		return impl
-	}
+	return adapters.CanonicalImplementation(impl)
 }
 
 // Indexer operations
@@ -826,7 +1010,7 @@ func (a *Adapter) createIndexer(ctx context.Context, c *client.Client, ir *irv1.
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+		return &httpclient.StatusError{Code: resp.StatusCode, Body: string(body)}
 	}
 
 	return nil
@@ -848,7 +1032,7 @@ func (a *Adapter) updateIndexer(ctx context.Context, c *client.Client, ir *irv1.
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -867,7 +1051,7 @@ func (a *Adapter) deleteIndexer(ctx context.Context, c *client.Client, name stri
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -980,7 +1164,7 @@ func (a *Adapter) createRootFolder(ctx context.Context, c *client.Client, ir *ir
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -1020,7 +1204,7 @@ func (a *Adapter) updateNamingConfig(ctx context.Context, c *client.Client, ir *
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
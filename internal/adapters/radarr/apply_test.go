@@ -0,0 +1,196 @@
+package radarr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestCreateQualityProfileCloneFrom(t *testing.T) {
+	handTuned := `[{"id":4,"name":"Hand Tuned","language":{"id":1,"name":"English"},"items":[
+		{"quality":{"id":4,"name":"HDTV-720p"},"allowed":true},
+		{"id":1001,"name":"WEB 1080p","allowed":true,"items":[
+			{"quality":{"id":3,"name":"WEBDL-1080p"},"allowed":true},
+			{"quality":{"id":15,"name":"WEBRip-1080p"},"allowed":true}]}],
+		"formatItems":[{"format":1,"name":"DV","score":50},{"format":2,"name":"x265","score":10}],
+		"minUpgradeFormatScore":5}]`
+
+	var posted client.QualityProfileResource
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/qualityprofile":
+			_, _ = w.Write([]byte(handTuned))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/customformat":
+			_, _ = w.Write([]byte(`[{"id":2,"name":"x265"},{"id":3,"name":"HDR"}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v3/qualityprofile":
+			_ = json.NewDecoder(r.Body).Decode(&posted)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(posted)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	a := &Adapter{}
+	c, err := a.newClient(&irv1.ConnectionIR{URL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile := &irv1.VideoQualityIR{
+		ProfileName:  "nebularr-movies",
+		CloneFrom:    "hand tuned",
+		Tiers:        []irv1.VideoQualityTierIR{{Resolution: "1080p", Sources: []string{"webdl"}, Allowed: true}},
+		Cutoff:       irv1.VideoQualityTierIR{Resolution: "1080p", Sources: []string{"webdl"}},
+		FormatScores: map[string]int{"x265": 30, "HDR": 5},
+	}
+	if err := a.createQualityProfile(context.Background(), c, profile); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if posted.Id != nil || ptrToString(posted.Name) != "nebularr-movies" {
+		t.Errorf("expected a new profile named nebularr-movies, got id %v name %q", posted.Id, ptrToString(posted.Name))
+	}
+	if posted.Items == nil || len(*posted.Items) != 2 {
+		t.Fatalf("expected the cloned items, got %+v", posted.Items)
+	}
+	items := *posted.Items
+	group := items[1]
+	if ptrToBool(items[0].Allowed) || !ptrToBool(group.Allowed) || ptrToInt(group.Id) != 1001 || group.Items == nil {
+		t.Fatalf("expected only the WEB 1080p group allowed, got %+v", items)
+	}
+	nested := *group.Items
+	if !ptrToBool(nested[0].Allowed) || ptrToBool(nested[1].Allowed) {
+		t.Errorf("expected WEBDL-1080p allowed and WEBRip-1080p not, got %+v", nested)
+	}
+	if ptrToInt(posted.Cutoff) != 1001 {
+		t.Errorf("cutoff = %d, want the group 1001", ptrToInt(posted.Cutoff))
+	}
+	if posted.Language == nil || ptrToInt(posted.Language.Id) != 1 {
+		t.Errorf("expected the cloned profile's language, got %+v", posted.Language)
+	}
+	scores := map[int]int{}
+	if posted.FormatItems != nil {
+		for _, fi := range *posted.FormatItems {
+			scores[ptrToInt(fi.Format)] = ptrToInt(fi.Score)
+		}
+	}
+	if want := map[int]int{1: 50, 2: 30, 3: 5}; !reflect.DeepEqual(scores, want) {
+		t.Errorf("format scores = %v, want %v", scores, want)
+	}
+	if ptrToInt(posted.MinUpgradeFormatScore) != 5 {
+		t.Errorf("minUpgradeFormatScore = %d, want the cloned 5", ptrToInt(posted.MinUpgradeFormatScore))
+	}
+
+	profile.CloneFrom = "Missing"
+	err = a.createQualityProfile(context.Background(), c, profile)
+	if adapters.CategoryOf(err) != adapters.ErrorCategoryNotFound {
+		t.Errorf("expected a NotFound error for a missing profile, got %v", err)
+	}
+}
+
+func TestFindCutoffTierGroup(t *testing.T) {
+	webRes := int32(1080)
+	webdl, webrip := client.Webdl, client.Webrip
+	items := []client.QualityProfileQualityItemResource{
+		{Quality: &client.Quality{Id: intPtr(4)}, Allowed: boolPtr(false)},
+		{Id: intPtr(1001), Name: stringPtr("WEB 1080p"), Items: &[]client.QualityProfileQualityItemResource{
+			{Quality: &client.Quality{Id: intPtr(15), Resolution: &webRes, Source: &webrip}, Allowed: boolPtr(false)},
+			{Quality: &client.Quality{Id: intPtr(3), Resolution: &webRes, Source: &webdl}, Allowed: boolPtr(true)},
+		}},
+	}
+
+	// A cutoff written at the group's ID reads back as its allowed quality, so it doesn't drift
+	a := &Adapter{}
+	tier := a.findCutoffTier(items, 1001)
+	want := irv1.VideoQualityTierIR{Resolution: "1080p", Sources: []string{"webdl"}, Allowed: true}
+	if tier == nil || !reflect.DeepEqual(*tier, want) {
+		t.Fatalf("findCutoffTier() = %+v, want %+v", tier, want)
+	}
+	if got := a.findCutoffQualityID(*tier, &items); got != 1001 {
+		t.Errorf("findCutoffQualityID() = %d, want the group 1001", got)
+	}
+}
+
+func TestCheckPutResponse(t *testing.T) {
+	respond := func(code int, body string) *http.Response {
+		return &http.Response{StatusCode: code, Body: io.NopCloser(strings.NewReader(body))}
+	}
+
+	for _, code := range []int{http.StatusOK, http.StatusAccepted} {
+		if err := checkPutResponse(respond(code, ""), nil); err != nil {
+			t.Errorf("status %d: unexpected error: %v", code, err)
+		}
+	}
+
+	err := checkPutResponse(respond(http.StatusBadRequest, "invalid specification"), nil)
+	var statusErr *httpclient.StatusError
+	if !errors.As(err, &statusErr) || statusErr.Code != http.StatusBadRequest || statusErr.Body != "invalid specification" {
+		t.Errorf("expected a 400 StatusError with the body, got %v", err)
+	}
+
+	transportErr := errors.New("connection reset")
+	if err := checkPutResponse(nil, transportErr); !errors.Is(err, transportErr) {
+		t.Errorf("expected the transport error, got %v", err)
+	}
+}
+
+func TestUpdateCustomFormatRollsBack(t *testing.T) {
+	previous := `{"id":7,"name":"nebularr-x265","specifications":[]}`
+	var puts []string
+	failPuts := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/customformat":
+			_, _ = w.Write([]byte(`[{"id":7,"name":"nebularr-x265"}]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/customformat/7":
+			_, _ = w.Write([]byte(previous))
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v3/customformat/7":
+			body, _ := io.ReadAll(r.Body)
+			puts = append(puts, string(body))
+			if len(puts) <= failPuts {
+				http.Error(w, "specification failed validation", http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write(body)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	a := &Adapter{}
+	c, err := a.newClient(&irv1.ConnectionIR{URL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ir := &irv1.CustomFormatIR{Name: "nebularr-x265"}
+
+	// The failed update is undone by putting back the body read before it
+	err = a.updateCustomFormat(ctx, c, ir)
+	if err == nil || !strings.Contains(err.Error(), "rolled back") {
+		t.Fatalf("expected a rolled back error, got %v", err)
+	}
+	if len(puts) != 2 || puts[1] != previous {
+		t.Fatalf("expected the update then the previous body, got %q", puts)
+	}
+
+	// A failed rollback is reported with the update error
+	puts, failPuts = nil, 2
+	err = a.updateCustomFormat(ctx, c, ir)
+	if err == nil || !strings.Contains(err.Error(), "rollback failed") {
+		t.Errorf("expected a rollback failed error, got %v", err)
+	}
+}
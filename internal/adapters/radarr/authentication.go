@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
@@ -19,7 +20,7 @@ func (a *Adapter) getHostConfig(ctx context.Context, c *client.Client) (*client.
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var config client.HostConfigResource
@@ -44,7 +45,7 @@ func (a *Adapter) updateHostConfig(ctx context.Context, c *client.Client, config
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
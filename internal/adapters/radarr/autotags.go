@@ -0,0 +1,130 @@
+package radarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// getManagedAutoTags retrieves the auto-tagging rules of the connection's config
+func (a *Adapter) getManagedAutoTags(ctx context.Context, c *client.Client, conn *irv1.ConnectionIR) ([]irv1.AutoTagIR, error) {
+	resp, err := c.GetApiV3Autotagging(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auto tags: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	// Decode into the shared resource, which keeps field values untyped
+	var rules []shared.AutoTaggingResource
+	if err := json.NewDecoder(resp.Body).Decode(&rules); err != nil {
+		return nil, fmt.Errorf("failed to decode auto tags: %w", err)
+	}
+
+	labels, err := a.getTagLabels(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []irv1.AutoTagIR
+	for i := range rules {
+		if shared.IsManagedAutoTag(rules[i].Name, shared.OwnershipTagLabel(conn)) {
+			result = append(result, shared.AutoTaggingToIR(&rules[i], labels))
+		}
+	}
+
+	return result, nil
+}
+
+// diffAutoTags computes changes needed for auto-tagging rules using shared logic
+func (a *Adapter) diffAutoTags(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
+	shared.DiffAutoTags(current.AutoTags, desired.AutoTags, changes)
+	return nil
+}
+
+// buildAutoTagBody resolves the rule's tags and serializes it
+func (a *Adapter) buildAutoTagBody(ctx context.Context, c *client.Client, ir *irv1.AutoTagIR) ([]byte, error) {
+	tagIDs, err := a.ensureTagIDs(ctx, c, ir.TagNames)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, len(tagIDs))
+	for i, id := range tagIDs {
+		ids[i] = int(id)
+	}
+
+	body, err := json.Marshal(shared.IRToAutoTagging(ir, ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal auto tag: %w", err)
+	}
+	return body, nil
+}
+
+// createAutoTag creates an auto-tagging rule, creating any tags it applies
+func (a *Adapter) createAutoTag(ctx context.Context, c *client.Client, ir *irv1.AutoTagIR) error {
+	body, err := a.buildAutoTagBody(ctx, c, ir)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.PostApiV3AutotaggingWithBody(ctx, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create auto tag: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return &httpclient.StatusError{Code: resp.StatusCode, Body: string(respBody)}
+	}
+
+	return nil
+}
+
+// updateAutoTag updates an existing auto-tagging rule
+func (a *Adapter) updateAutoTag(ctx context.Context, c *client.Client, ir *irv1.AutoTagIR) error {
+	body, err := a.buildAutoTagBody(ctx, c, ir)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.PutApiV3AutotaggingIdWithBody(ctx, fmt.Sprintf("%d", ir.ID), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to update auto tag: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		respBody, _ := io.ReadAll(resp.Body)
+		return &httpclient.StatusError{Code: resp.StatusCode, Body: string(respBody)}
+	}
+
+	return nil
+}
+
+// deleteAutoTag deletes an auto-tagging rule
+func (a *Adapter) deleteAutoTag(ctx context.Context, c *client.Client, id int) error {
+	resp, err := c.DeleteApiV3AutotaggingId(ctx, int32(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete auto tag: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	return nil
+}
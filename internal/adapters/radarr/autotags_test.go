@@ -0,0 +1,153 @@
+package radarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// fakeAutoTags serves Radarr's auto-tagging rules and tags, recording the writes sent to it
+type fakeAutoTags struct {
+	rules   string
+	tags    []map[string]interface{}
+	writes  map[string]map[string]interface{}
+	deletes []string
+}
+
+func newFakeAutoTags(t *testing.T, rules string) (*fakeAutoTags, *irv1.ConnectionIR) {
+	fake := &fakeAutoTags{
+		rules:  rules,
+		tags:   []map[string]interface{}{{"id": 7, "label": "anime"}},
+		writes: map[string]map[string]interface{}{},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/autotagging":
+			_, _ = w.Write([]byte(fake.rules))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/tag":
+			_ = json.NewEncoder(w).Encode(fake.tags)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v3/tag":
+			tag := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&tag)
+			tag["id"] = 8
+			fake.tags = append(fake.tags, tag)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(tag)
+		case r.Method == http.MethodPost || r.Method == http.MethodPut:
+			body := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			fake.writes[r.Method+" "+r.URL.Path] = body
+			_ = json.NewEncoder(w).Encode(body)
+		case r.Method == http.MethodDelete:
+			fake.deletes = append(fake.deletes, r.URL.Path)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return fake, &irv1.ConnectionIR{URL: server.URL, OwnerTag: shared.ConfigOwnershipTag("media", "movies")}
+}
+
+func TestGetManagedAutoTagsRadarr(t *testing.T) {
+	ownerTag := shared.ConfigOwnershipTag("media", "movies")
+	_, conn := newFakeAutoTags(t, `[`+
+		`{"id":1,"name":"`+strings.ToUpper(ownerTag)+`-anime","removeTagsAutomatically":true,"tags":[7],"specifications":[`+
+		`{"name":"Anime","implementation":"GenreSpecification","negate":false,"required":true,"fields":[{"name":"value","value":["Anime"]}]},`+
+		`{"name":"Eighties","implementation":"YearSpecification","fields":[{"name":"min","value":1980},{"name":"max","value":1989}]}]},`+
+		`{"id":2,"name":"`+shared.ConfigOwnershipTag("media", "movies-4k")+`-anime","tags":[7],"specifications":[]},`+
+		`{"id":3,"name":"Anime","tags":[7],"specifications":[]}]`)
+
+	a := &Adapter{}
+	c, err := a.newClient(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, err := a.getManagedAutoTags(context.Background(), c, conn)
+	if err != nil {
+		t.Fatalf("getManagedAutoTags() error = %v", err)
+	}
+
+	// The owner tag prefix matches case-insensitively; other configs' rules and rules made in the UI are left alone
+	if len(rules) != 1 || rules[0].ID != 1 {
+		t.Fatalf("getManagedAutoTags() = %+v, want only rule 1", rules)
+	}
+	minYear, maxYear := 1980, 1989
+	want := irv1.AutoTagIR{
+		ID:                      1,
+		Name:                    rules[0].Name,
+		RemoveTagsAutomatically: true,
+		TagNames:                []string{"anime"},
+		Specifications: []irv1.AutoTagSpecIR{
+			{Type: "GenreSpecification", Name: "Anime", Required: true, Values: []string{"Anime"}},
+			{Type: "YearSpecification", Name: "Eighties", Min: &minYear, Max: &maxYear},
+		},
+	}
+	if !reflect.DeepEqual(rules[0], want) {
+		t.Errorf("rule = %+v, want %+v", rules[0], want)
+	}
+}
+
+func TestWriteAutoTags(t *testing.T) {
+	fake, conn := newFakeAutoTags(t, `[]`)
+	a := &Adapter{}
+	c, err := a.newClient(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ir := &irv1.AutoTagIR{
+		ID:       5,
+		Name:     shared.AutoTagName(conn.OwnerTag, "kids"),
+		TagNames: []string{"Anime", "Kids"},
+		Specifications: []irv1.AutoTagSpecIR{
+			{Type: "QualityProfileSpecification", Name: "HD", Value: "4"},
+			{Type: "RootFolderSpecification", Name: "Kids folder", Negate: true, Value: "/movies/kids"},
+		},
+	}
+
+	if err := a.createAutoTag(ctx, c, ir); err != nil {
+		t.Fatalf("createAutoTag() error = %v", err)
+	}
+	posted := fake.writes["POST /api/v3/autotagging"]
+	if posted == nil {
+		t.Fatalf("expected a POST to /api/v3/autotagging, got %v", fake.writes)
+	}
+	// The existing tag is reused and the missing one created lowercase
+	if !reflect.DeepEqual(posted["tags"], []interface{}{float64(7), float64(8)}) || fake.tags[1]["label"] != "kids" {
+		t.Errorf("tags = %v with created %v, want [7 8] and kids", posted["tags"], fake.tags)
+	}
+	// Numeric values are sent as numbers and the rest as strings
+	specs := posted["specifications"].([]interface{})
+	values := []interface{}{}
+	for _, spec := range specs {
+		fields := spec.(map[string]interface{})["fields"].([]interface{})
+		values = append(values, fields[0].(map[string]interface{})["value"])
+	}
+	if !reflect.DeepEqual(values, []interface{}{float64(4), "/movies/kids"}) {
+		t.Errorf("specification values = %v, want [4 /movies/kids]", values)
+	}
+	if specs[1].(map[string]interface{})["negate"] != true {
+		t.Errorf("negate not sent: %v", specs[1])
+	}
+
+	if err := a.updateAutoTag(ctx, c, ir); err != nil {
+		t.Fatalf("updateAutoTag() error = %v", err)
+	}
+	if put := fake.writes["PUT /api/v3/autotagging/5"]; put == nil || put["id"] != float64(5) {
+		t.Errorf("expected a PUT of rule 5, got %v", fake.writes)
+	}
+
+	if err := a.deleteAutoTag(ctx, c, 5); err != nil {
+		t.Fatalf("deleteAutoTag() error = %v", err)
+	}
+	if !reflect.DeepEqual(fake.deletes, []string{"/api/v3/autotagging/5"}) {
+		t.Errorf("deletes = %v, want /api/v3/autotagging/5", fake.deletes)
+	}
+}
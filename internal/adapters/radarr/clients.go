@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
@@ -20,7 +21,7 @@ func (a *Adapter) getManagedDownloadClients(ctx context.Context, c *client.Clien
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var clients []client.DownloadClientResource
@@ -45,6 +46,7 @@ func (a *Adapter) getManagedDownloadClients(ctx context.Context, c *client.Clien
 // downloadClientToIR converts a Radarr download client to IR
 func (a *Adapter) downloadClientToIR(dc *client.DownloadClientResource) irv1.DownloadClientIR {
 	ir := irv1.DownloadClientIR{
+		ID:                       ptrToInt(dc.Id),
 		Name:                     ptrToString(dc.Name),
 		Enable:                   ptrToBool(dc.Enable),
 		Priority:                 ptrToInt(dc.Priority),
@@ -119,6 +121,10 @@ func (a *Adapter) diffDownloadClients(current, desired *irv1.IR, changes *adapte
 
 	// Find creates and updates
 	for name, desiredDC := range desiredMap {
+		if desiredDC.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		currentDC, exists := currentMap[name]
 		if !exists {
 			changes.Creates = append(changes.Creates, adapters.Change{
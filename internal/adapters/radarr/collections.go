@@ -0,0 +1,134 @@
+package radarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// getCollections fetches all movie collections
+func (a *Adapter) getCollections(ctx context.Context, c *client.Client) ([]client.CollectionResource, error) {
+	resp, err := c.GetApiV3Collection(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collections: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("collections are not supported by this Radarr version (requires v5 or later)")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	var collections []client.CollectionResource
+	if err := json.NewDecoder(resp.Body).Decode(&collections); err != nil {
+		return nil, fmt.Errorf("failed to decode collections: %w", err)
+	}
+
+	return collections, nil
+}
+
+// applyCollections applies collection settings from IR to every matching
+// collection. Collections already matching are left alone, and the rest are
+// changed with a single bulk update.
+func (a *Adapter) applyCollections(ctx context.Context, c *client.Client, ir *irv1.CollectionsIR) error {
+	if ir == nil {
+		return nil
+	}
+
+	update := client.CollectionUpdateResource{
+		Monitored:   ir.Monitored,
+		SearchOnAdd: ir.SearchOnAdd,
+	}
+	if ir.MinimumAvailability != "" {
+		availability := client.MovieStatusType(ir.MinimumAvailability)
+		update.MinimumAvailability = &availability
+	}
+	if ir.RootFolderPath != "" {
+		update.RootFolderPath = stringPtr(ir.RootFolderPath)
+	}
+	if ir.QualityProfileName != "" {
+		profileIDs, err := a.getQualityProfileIDs(ctx, c)
+		if err != nil {
+			return err
+		}
+		profileID, ok := profileIDs[ir.QualityProfileName]
+		if !ok {
+			return fmt.Errorf("quality profile %q not found for collections", ir.QualityProfileName)
+		}
+		update.QualityProfileId = intPtr(profileID)
+	}
+
+	collections, err := a.getCollections(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	var ids []int32
+	for _, collection := range collections {
+		if collection.Id == nil || !collectionSelected(ir.Titles, ptrToString(collection.Title)) {
+			continue
+		}
+		if collectionNeedsUpdate(collection, update) {
+			ids = append(ids, *collection.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	update.CollectionIds = &ids
+
+	resp, err := c.PutApiV3Collection(ctx, update)
+	if err != nil {
+		return fmt.Errorf("failed to update collections: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	return nil
+}
+
+// collectionSelected reports whether title is one of titles (case-insensitive).
+// An empty list selects every collection.
+func collectionSelected(titles []string, title string) bool {
+	if len(titles) == 0 {
+		return true
+	}
+	for _, t := range titles {
+		if strings.EqualFold(t, title) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectionNeedsUpdate reports whether applying update would change collection
+func collectionNeedsUpdate(collection client.CollectionResource, update client.CollectionUpdateResource) bool {
+	if update.Monitored != nil && (collection.Monitored == nil || *collection.Monitored != *update.Monitored) {
+		return true
+	}
+	if update.SearchOnAdd != nil && (collection.SearchOnAdd == nil || *collection.SearchOnAdd != *update.SearchOnAdd) {
+		return true
+	}
+	if update.MinimumAvailability != nil &&
+		(collection.MinimumAvailability == nil || *collection.MinimumAvailability != *update.MinimumAvailability) {
+		return true
+	}
+	if update.QualityProfileId != nil && ptrToInt(collection.QualityProfileId) != ptrToInt(update.QualityProfileId) {
+		return true
+	}
+	if update.RootFolderPath != nil && ptrToString(collection.RootFolderPath) != *update.RootFolderPath {
+		return true
+	}
+	return false
+}
@@ -7,13 +7,16 @@ import (
 	"net/http"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
 // getManagedDelayProfiles retrieves delay profiles
 // Unlike other resources, we manage ALL delay profiles (not just tagged ones)
-// because Radarr creates a default delay profile that we may need to modify
+// because Radarr creates a default delay profile that we may need to modify.
+// Profiles are identified by their tag set, so tag IDs are labeled with names.
 func (a *Adapter) getManagedDelayProfiles(ctx context.Context, c *client.Client) ([]irv1.DelayProfileIR, error) {
 	resp, err := c.GetApiV3Delayprofile(ctx)
 	if err != nil {
@@ -22,7 +25,7 @@ func (a *Adapter) getManagedDelayProfiles(ctx context.Context, c *client.Client)
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var profiles []client.DelayProfileResource
@@ -30,11 +33,17 @@ func (a *Adapter) getManagedDelayProfiles(ctx context.Context, c *client.Client)
 		return nil, fmt.Errorf("failed to decode delay profiles: %w", err)
 	}
 
+	labels, err := a.getTagLabels(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
 	result := make([]irv1.DelayProfileIR, 0, len(profiles))
 	for _, p := range profiles {
 		ir := a.delayProfileToIR(&p)
 		result = append(result, ir)
 	}
+	shared.LabelDelayProfileTags(result, labels)
 
 	return result, nil
 }
@@ -108,125 +117,17 @@ func (a *Adapter) irToDelayProfile(ir *irv1.DelayProfileIR, tagIDs []int32) clie
 	return p
 }
 
-// diffDelayProfiles computes changes needed for delay profiles
+// diffDelayProfiles computes changes needed for delay profiles using shared logic
 func (a *Adapter) diffDelayProfiles(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
-	currentProfiles := current.DelayProfiles
-	desiredProfiles := desired.DelayProfiles
-
-	// Build maps for comparison
-	currentByOrder := make(map[int]irv1.DelayProfileIR)
-	for _, p := range currentProfiles {
-		currentByOrder[p.Order] = p
-	}
-
-	desiredByOrder := make(map[int]irv1.DelayProfileIR)
-	for _, p := range desiredProfiles {
-		desiredByOrder[p.Order] = p
-	}
-
-	// Find creates and updates
-	for _, dp := range desiredProfiles {
-		current, exists := currentByOrder[dp.Order]
-		if !exists {
-			// Create new delay profile
-			changes.Creates = append(changes.Creates, adapters.Change{
-				ResourceType: adapters.ResourceDelayProfile,
-				Name:         dp.Name,
-				Payload:      &dp,
-			})
-		} else if !delayProfilesEqual(current, dp) {
-			// Update existing delay profile
-			updated := dp
-			updated.ID = current.ID
-			changes.Updates = append(changes.Updates, adapters.Change{
-				ResourceType: adapters.ResourceDelayProfile,
-				Name:         dp.Name,
-				ID:           intPtrFromInt(current.ID),
-				Payload:      &updated,
-			})
-		}
-	}
-
-	// Find deletes - only delete profiles with order > 1
-	// (Order 1 is the default profile that always exists)
-	for _, cp := range currentProfiles {
-		if cp.Order == 1 {
-			// Don't delete the default profile
-			continue
-		}
-		if _, exists := desiredByOrder[cp.Order]; !exists {
-			changes.Deletes = append(changes.Deletes, adapters.Change{
-				ResourceType: adapters.ResourceDelayProfile,
-				Name:         cp.Name,
-				ID:           intPtrFromInt(cp.ID),
-			})
-		}
-	}
-
+	shared.DiffDelayProfiles(current.DelayProfiles, desired.DelayProfiles, changes)
 	return nil
 }
 
-// delayProfilesEqual compares two delay profiles for equality
-func delayProfilesEqual(a, b irv1.DelayProfileIR) bool {
-	if a.Order != b.Order {
-		return false
-	}
-	if a.PreferredProtocol != b.PreferredProtocol {
-		return false
-	}
-	if a.UsenetDelay != b.UsenetDelay {
-		return false
-	}
-	if a.TorrentDelay != b.TorrentDelay {
-		return false
-	}
-	if a.EnableUsenet != b.EnableUsenet {
-		return false
-	}
-	if a.EnableTorrent != b.EnableTorrent {
-		return false
-	}
-	if a.BypassIfHighestQuality != b.BypassIfHighestQuality {
-		return false
-	}
-	if a.BypassIfAboveCustomFormatScore != b.BypassIfAboveCustomFormatScore {
-		return false
-	}
-	if a.MinimumCustomFormatScore != b.MinimumCustomFormatScore {
-		return false
-	}
-	// Compare tags
-	if len(a.Tags) != len(b.Tags) {
-		return false
-	}
-	tagSet := make(map[int]bool)
-	for _, t := range a.Tags {
-		tagSet[t] = true
-	}
-	for _, t := range b.Tags {
-		if !tagSet[t] {
-			return false
-		}
-	}
-	return true
-}
-
-// createDelayProfile creates a new delay profile
-func (a *Adapter) createDelayProfile(ctx context.Context, c *client.Client, ir *irv1.DelayProfileIR, tagID int) error {
-	// Convert tag names to IDs if needed
-	// Pre-allocate with capacity for ownership tag + ir.Tags
-	capacity := len(ir.Tags)
-	if tagID > 0 && len(ir.TagNames) == 0 {
-		capacity++
-	}
-	tagIDs := make([]int32, 0, capacity)
-	if tagID > 0 && len(ir.TagNames) == 0 {
-		// Use the ownership tag
-		tagIDs = append(tagIDs, int32(tagID))
-	}
-	// If ir.Tags is populated (from resolved tag names), use those
-	for _, t := range ir.Tags {
-		tagIDs = append(tagIDs, int32(t))
+// createDelayProfile creates a new delay profile, creating any tags it references
+func (a *Adapter) createDelayProfile(ctx context.Context, c *client.Client, ir *irv1.DelayProfileIR) error {
+	tagIDs, err := a.ensureTagIDs(ctx, c, ir.TagNames)
+	if err != nil {
+		return err
 	}
 
 	profile := a.irToDelayProfile(ir, tagIDs)
@@ -238,26 +139,17 @@ func (a *Adapter) createDelayProfile(ctx context.Context, c *client.Client, ir *
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
 }
 
 // updateDelayProfile updates an existing delay profile
-func (a *Adapter) updateDelayProfile(ctx context.Context, c *client.Client, ir *irv1.DelayProfileIR, tagID int) error {
-	// Convert tag names to IDs if needed
-	// Pre-allocate with capacity for ownership tag + ir.Tags
-	capacity := len(ir.Tags)
-	if tagID > 0 && len(ir.TagNames) == 0 {
-		capacity++
-	}
-	tagIDs := make([]int32, 0, capacity)
-	if tagID > 0 && len(ir.TagNames) == 0 {
-		tagIDs = append(tagIDs, int32(tagID))
-	}
-	for _, t := range ir.Tags {
-		tagIDs = append(tagIDs, int32(t))
+func (a *Adapter) updateDelayProfile(ctx context.Context, c *client.Client, ir *irv1.DelayProfileIR) error {
+	tagIDs, err := a.ensureTagIDs(ctx, c, ir.TagNames)
+	if err != nil {
+		return err
 	}
 
 	profile := a.irToDelayProfile(ir, tagIDs)
@@ -270,7 +162,7 @@ func (a *Adapter) updateDelayProfile(ctx context.Context, c *client.Client, ir *
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -285,13 +177,69 @@ func (a *Adapter) deleteDelayProfile(ctx context.Context, c *client.Client, id i
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
 }
 
-// intPtrFromInt returns a pointer to an int
-func intPtrFromInt(i int) *int {
-	return &i
+// reorderDelayProfiles moves delay profiles into the given tag-set order
+func (a *Adapter) reorderDelayProfiles(ctx context.Context, c *client.Client, keys []string) error {
+	labels, err := a.getTagLabels(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.GetApiV3Delayprofile(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get delay profiles: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	var profiles []client.DelayProfileResource
+	if err := json.NewDecoder(resp.Body).Decode(&profiles); err != nil {
+		return fmt.Errorf("failed to decode delay profiles: %w", err)
+	}
+
+	current := make([]shared.BaseDelayProfileResource, 0, len(profiles))
+	for _, p := range profiles {
+		ir := a.delayProfileToIR(&p)
+		current = append(current, shared.BaseDelayProfileResource{ID: ir.ID, Tags: ir.Tags})
+	}
+	ids := shared.DelayProfileIDsByKey(current, labels)
+
+	var after *int32
+	for _, key := range keys {
+		id, ok := ids[key]
+		if !ok {
+			return fmt.Errorf("delay profile for tags %q not found", key)
+		}
+
+		if err := a.moveDelayProfile(ctx, c, int32(id), after); err != nil {
+			return err
+		}
+		prev := int32(id)
+		after = &prev
+	}
+
+	return nil
+}
+
+// moveDelayProfile places a delay profile after another one, or first when after is nil
+func (a *Adapter) moveDelayProfile(ctx context.Context, c *client.Client, id int32, after *int32) error {
+	resp, err := c.PutApiV3DelayprofileReorderId(ctx, id, &client.PutApiV3DelayprofileReorderIdParams{After: after})
+	if err != nil {
+		return fmt.Errorf("failed to reorder delay profile: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	return nil
 }
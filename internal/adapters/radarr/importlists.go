@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
@@ -19,7 +20,7 @@ func (a *Adapter) getImportLists(ctx context.Context, c *client.Client) ([]clien
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var lists []client.ImportListResource
@@ -39,7 +40,7 @@ func (a *Adapter) getImportListSchemas(ctx context.Context, c *client.Client) ([
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var schemas []client.ImportListResource
@@ -122,6 +123,12 @@ func (a *Adapter) applyImportLists(
 		return nil, fmt.Errorf("failed to get import list schemas: %w", err)
 	}
 
+	// Import lists reference quality profiles by name
+	profileIDs, err := a.getQualityProfileIDs(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
 	// Index existing by name
 	existingByName := make(map[string]*client.ImportListResource)
 	for i := range existing {
@@ -144,6 +151,14 @@ func (a *Adapter) applyImportLists(
 			continue
 		}
 
+		profileID, ok := profileIDs[list.QualityProfileName]
+		if !ok {
+			stats.Skipped++
+			stats.Errors = append(stats.Errors, fmt.Errorf("quality profile %q not found for import list %s", list.QualityProfileName, list.Name))
+			continue
+		}
+		list.QualityProfileID = profileID
+
 		// Build fields from settings
 		fields := buildImportListFields(list.Settings, schema)
 
@@ -247,7 +262,7 @@ func (a *Adapter) createImportList(ctx context.Context, c *client.Client, payloa
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -262,7 +277,7 @@ func (a *Adapter) updateImportList(ctx context.Context, c *client.Client, payloa
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -277,7 +292,7 @@ func (a *Adapter) deleteImportList(ctx context.Context, c *client.Client, id int
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
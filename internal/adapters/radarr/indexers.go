@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
@@ -20,7 +22,7 @@ func (a *Adapter) getManagedIndexers(ctx context.Context, c *client.Client, tagI
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var indexers []client.IndexerResource
@@ -51,6 +53,7 @@ func (a *Adapter) indexerToIR(idx *client.IndexerResource) irv1.IndexerIR {
 	enabled := enableRss || enableAutoSearch || enableInteractive
 
 	ir := irv1.IndexerIR{
+		ID:                      ptrToInt(idx.Id),
 		Name:                    ptrToString(idx.Name),
 		Enable:                  enabled,
 		Priority:                ptrToInt(idx.Priority),
@@ -131,6 +134,10 @@ func (a *Adapter) diffIndexers(current, desired *irv1.IR, changes *adapters.Chan
 
 	// Find creates and updates
 	for name, desiredIdx := range desiredIndexers {
+		if desiredIdx.Paused {
+			// Paused entries are kept as they are
+			continue
+		}
 		if _, exists := currentIndexers[name]; !exists {
 			changes.Creates = append(changes.Creates, adapters.Change{
 				ResourceType: adapters.ResourceIndexer,
@@ -159,3 +166,86 @@ func (a *Adapter) diffIndexers(current, desired *irv1.IR, changes *adapters.Chan
 
 	return nil
 }
+
+// Ensure Adapter implements IndexerTester
+var _ adapters.IndexerTester = (*Adapter)(nil)
+
+// findIndexerByName returns the Radarr indexer with the given name
+func (a *Adapter) findIndexerByName(ctx context.Context, c *client.Client, name string) (*client.IndexerResource, error) {
+	resp, err := c.GetApiV3Indexer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get indexers: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	var indexers []client.IndexerResource
+	if err := json.NewDecoder(resp.Body).Decode(&indexers); err != nil {
+		return nil, fmt.Errorf("failed to decode indexers: %w", err)
+	}
+
+	for i := range indexers {
+		if ptrToString(indexers[i].Name) == name {
+			return &indexers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("indexer %q not found", name)
+}
+
+// TestIndexer asks Radarr to test the named indexer
+func (a *Adapter) TestIndexer(ctx context.Context, conn *irv1.ConnectionIR, name string) error {
+	c, err := a.newClient(conn)
+	if err != nil {
+		return err
+	}
+
+	idx, err := a.findIndexerByName(ctx, c, name)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.PostApiV3IndexerTest(ctx, nil, *idx)
+	if err != nil {
+		return fmt.Errorf("failed to test indexer: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("indexer test failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DisableIndexer turns off RSS and searches for the named indexer
+func (a *Adapter) DisableIndexer(ctx context.Context, conn *irv1.ConnectionIR, name string) error {
+	c, err := a.newClient(conn)
+	if err != nil {
+		return err
+	}
+
+	idx, err := a.findIndexerByName(ctx, c, name)
+	if err != nil {
+		return err
+	}
+
+	idx.EnableRss = boolPtr(false)
+	idx.EnableAutomaticSearch = boolPtr(false)
+	idx.EnableInteractiveSearch = boolPtr(false)
+
+	resp, err := c.PutApiV3IndexerId(ctx, int32(ptrToInt(idx.Id)), nil, *idx)
+	if err != nil {
+		return fmt.Errorf("failed to disable indexer: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	return nil
+}
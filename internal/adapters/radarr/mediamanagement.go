@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
@@ -19,7 +20,7 @@ func (a *Adapter) getMediaManagementConfig(ctx context.Context, c *client.Client
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var config client.MediaManagementConfigResource
@@ -44,7 +45,7 @@ func (a *Adapter) updateMediaManagementConfig(ctx context.Context, c *client.Cli
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
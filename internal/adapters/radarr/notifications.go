@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
@@ -20,7 +22,7 @@ func (a *Adapter) getManagedNotifications(ctx context.Context, c *client.Client,
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var notifications []client.NotificationResource
@@ -192,7 +194,7 @@ func (a *Adapter) diffNotifications(current, desired *irv1.IR, changes *adapters
 // notificationsEqual checks if two notifications are equal (ignoring ID)
 func notificationsEqual(a, b irv1.NotificationIR) bool {
 	// Compare implementation and events
-	if a.Implementation != b.Implementation {
+	if !adapters.ImplementationsEqual(a.Implementation, b.Implementation) {
 		return false
 	}
 	if a.OnGrab != b.OnGrab || a.OnDownload != b.OnDownload || a.OnUpgrade != b.OnUpgrade {
@@ -215,17 +217,8 @@ func notificationsEqual(a, b irv1.NotificationIR) bool {
 		return false
 	}
 
-	// Compare fields (simplified - could be more thorough)
-	if len(a.Fields) != len(b.Fields) {
-		return false
-	}
-	for k, v := range a.Fields {
-		if bv, ok := b.Fields[k]; !ok || fmt.Sprintf("%v", v) != fmt.Sprintf("%v", bv) {
-			return false
-		}
-	}
-
-	return true
+	// The app returns every field of the schema; only the configured ones matter
+	return adapters.FieldsEqual(a.Fields, b.Fields)
 }
 
 // createNotification creates a notification in Radarr
@@ -239,7 +232,7 @@ func (a *Adapter) createNotification(ctx context.Context, c *client.Client, ir *
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -256,7 +249,7 @@ func (a *Adapter) updateNotification(ctx context.Context, c *client.Client, ir *
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -271,12 +264,58 @@ func (a *Adapter) deleteNotification(ctx context.Context, c *client.Client, id i
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
 }
 
+// Ensure Adapter implements NotificationTester
+var _ adapters.NotificationTester = (*Adapter)(nil)
+
+// TestNotification asks Radarr to test the named notification
+func (a *Adapter) TestNotification(ctx context.Context, conn *irv1.ConnectionIR, name string) error {
+	c, err := a.newClient(conn)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.GetApiV3Notification(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get notifications: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	var notifications []client.NotificationResource
+	if err := json.NewDecoder(resp.Body).Decode(&notifications); err != nil {
+		return fmt.Errorf("failed to decode notifications: %w", err)
+	}
+
+	for _, n := range notifications {
+		if ptrToString(n.Name) != name {
+			continue
+		}
+
+		testResp, err := c.PostApiV3NotificationTest(ctx, nil, n)
+		if err != nil {
+			return fmt.Errorf("failed to test notification: %w", err)
+		}
+		defer func() { _ = testResp.Body.Close() }()
+
+		if testResp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(testResp.Body)
+			return fmt.Errorf("notification test failed with status %d: %s", testResp.StatusCode, string(body))
+		}
+		return nil
+	}
+
+	return fmt.Errorf("notification %q not found", name)
+}
+
 // ptrToInt32 safely dereferences an int32 pointer
 func ptrToInt32(p *int32) int32 {
 	if p == nil {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
@@ -20,7 +21,7 @@ func (a *Adapter) getManagedQualityProfiles(ctx context.Context, c *client.Clien
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var profiles []client.QualityProfileResource
@@ -47,6 +48,7 @@ func (a *Adapter) getManagedQualityProfiles(ctx context.Context, c *client.Clien
 // qualityProfileToIR converts a Radarr quality profile to IR
 func (a *Adapter) qualityProfileToIR(profile *client.QualityProfileResource) *irv1.VideoQualityIR {
 	ir := &irv1.VideoQualityIR{
+		ID:             ptrToInt(profile.Id),
 		ProfileName:    ptrToString(profile.Name),
 		UpgradeAllowed: ptrToBool(profile.UpgradeAllowed),
 		FormatScores:   make(map[string]int),
@@ -91,15 +93,31 @@ func (a *Adapter) qualityProfileToIR(profile *client.QualityProfileResource) *ir
 		ir.UpgradeUntilCustomFormatScore = int(*profile.CutoffFormatScore)
 	}
 
+	if profile.Language != nil && profile.Language.Id != nil {
+		ir.Language = &irv1.LanguageIR{ID: int(*profile.Language.Id), Name: ptrToString(profile.Language.Name)}
+	}
+
 	return ir
 }
 
 // findCutoffTier searches through quality items to find the tier matching the cutoff ID.
-// The cutoff ID can match either a quality group ID or an individual quality ID.
+// The cutoff ID can match either a quality group ID or an individual quality ID. A group
+// cutoff reads back as its first allowed quality, which findCutoffQualityID maps to the group.
 func (a *Adapter) findCutoffTier(items []client.QualityProfileQualityItemResource, cutoffID int) *irv1.VideoQualityTierIR {
 	for _, item := range items {
 		// Check if this item's ID matches the cutoff (for groups)
 		if item.Id != nil && int(*item.Id) == cutoffID {
+			if item.Items != nil && len(*item.Items) > 0 {
+				nested := *item.Items
+				first := &nested[0]
+				for i := range nested {
+					if ptrToBool(nested[i].Allowed) {
+						first = &nested[i]
+						break
+					}
+				}
+				return a.qualityItemToTier(first)
+			}
 			return a.qualityItemToTier(&item)
 		}
 
@@ -194,18 +212,8 @@ func (a *Adapter) qualityToSource(q *client.Quality) string {
 
 // diffQualityProfiles computes changes needed for quality profiles
 func (a *Adapter) diffQualityProfiles(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
-	var currentProfile *irv1.VideoQualityIR
-	var desiredProfile *irv1.VideoQualityIR
-
-	if current.Quality != nil {
-		currentProfile = current.Quality.Video
-	}
-	if desired.Quality != nil {
-		desiredProfile = desired.Quality.Video
-	}
-
 	// Use shared diff logic
-	adapters.DiffQualityProfiles(currentProfile, desiredProfile, nil, changes)
+	adapters.DiffVideoQualityProfileSets(current.Quality.AllVideoProfiles(), desired.Quality.AllVideoProfiles(), changes)
 	return nil
 }
 
@@ -243,7 +251,7 @@ func (a *Adapter) getManagedCustomFormats(ctx context.Context, c *client.Client)
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var formats []client.CustomFormatResource
@@ -273,6 +281,7 @@ func (a *Adapter) customFormatToIR(format *client.CustomFormatResource) *irv1.Cu
 	}
 
 	ir := &irv1.CustomFormatIR{
+		ID:                  ptrToInt(format.Id),
 		Name:                ptrToString(format.Name),
 		IncludeWhenRenaming: ptrToBool(format.IncludeCustomFormatWhenRenaming),
 	}
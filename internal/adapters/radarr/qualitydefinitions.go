@@ -0,0 +1,100 @@
+package radarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// getQualityDefinitions fetches all quality definitions from Radarr
+func (a *Adapter) getQualityDefinitions(ctx context.Context, c *client.Client) ([]client.QualityDefinitionResource, error) {
+	resp, err := c.GetApiV3Qualitydefinition(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quality definitions: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
+	}
+
+	var definitions []client.QualityDefinitionResource
+	if err := json.NewDecoder(resp.Body).Decode(&definitions); err != nil {
+		return nil, fmt.Errorf("failed to decode quality definitions: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// applyQualityDefinitions updates quality definition size limits from IR.
+// Only definitions whose values differ are sent, in a single bulk update.
+func (a *Adapter) applyQualityDefinitions(ctx context.Context, c *client.Client, desired []irv1.QualityDefinitionIR) error {
+	if len(desired) == 0 {
+		return nil
+	}
+
+	current, err := a.getQualityDefinitions(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]int, len(current))
+	for i, def := range current {
+		if def.Quality != nil && def.Quality.Name != nil {
+			byName[strings.ToLower(*def.Quality.Name)] = i
+		}
+	}
+
+	var updates []client.QualityDefinitionResource
+	var missing []string
+	for _, want := range desired {
+		idx, ok := byName[strings.ToLower(want.Quality)]
+		if !ok {
+			missing = append(missing, want.Quality)
+			continue
+		}
+
+		def := current[idx]
+		var changed, fieldChanged bool
+		def.MinSize, fieldChanged = shared.ResolveSizeLimit(def.MinSize, want.MinSize)
+		changed = changed || fieldChanged
+		def.MaxSize, fieldChanged = shared.ResolveSizeLimit(def.MaxSize, want.MaxSize)
+		changed = changed || fieldChanged
+		def.PreferredSize, fieldChanged = shared.ResolveSizeLimit(def.PreferredSize, want.PreferredSize)
+		changed = changed || fieldChanged
+		if want.Title != "" && (def.Title == nil || *def.Title != want.Title) {
+			def.Title = stringPtr(want.Title)
+			changed = true
+		}
+
+		if changed {
+			updates = append(updates, def)
+		}
+	}
+
+	if len(updates) > 0 {
+		resp, err := c.PutApiV3QualitydefinitionUpdate(ctx, updates)
+		if err != nil {
+			return fmt.Errorf("failed to update quality definitions: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+			return &httpclient.StatusError{Code: resp.StatusCode}
+		}
+	}
+
+	if len(missing) > 0 {
+		return adapters.NewError(adapters.ErrorCategoryValidation, fmt.Errorf("unknown qualities: %s", strings.Join(missing, ", ")))
+	}
+
+	return nil
+}
@@ -7,7 +7,9 @@ import (
 	"net/http"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
@@ -20,7 +22,7 @@ func (a *Adapter) getRemotePathMappings(ctx context.Context, c *client.Client) (
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var mappings []client.RemotePathMappingResource
@@ -72,62 +74,9 @@ func (a *Adapter) irToRemotePathMapping(ir *irv1.RemotePathMappingIR) client.Rem
 	return m
 }
 
-// diffRemotePathMappings computes changes for remote path mappings
+// diffRemotePathMappings computes changes for remote path mappings using shared logic
 func (a *Adapter) diffRemotePathMappings(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
-	currentMappings := current.RemotePathMappings
-	desiredMappings := desired.RemotePathMappings
-
-	// Build lookup maps by host+remotePath (unique key)
-	currentByKey := make(map[string]irv1.RemotePathMappingIR)
-	for _, m := range currentMappings {
-		key := m.Host + "|" + m.RemotePath
-		currentByKey[key] = m
-	}
-
-	desiredByKey := make(map[string]irv1.RemotePathMappingIR)
-	for _, m := range desiredMappings {
-		key := m.Host + "|" + m.RemotePath
-		desiredByKey[key] = m
-	}
-
-	// Find creates and updates
-	for key, d := range desiredByKey {
-		if c, exists := currentByKey[key]; exists {
-			// Check if update needed (local path changed)
-			if c.LocalPath != d.LocalPath {
-				updated := d
-				updated.ID = c.ID // Preserve the ID for update
-				id := c.ID
-				changes.Updates = append(changes.Updates, adapters.Change{
-					ResourceType: adapters.ResourceRemotePathMapping,
-					Name:         fmt.Sprintf("%s -> %s", d.RemotePath, d.LocalPath),
-					ID:           &id,
-					Payload:      &updated,
-				})
-			}
-		} else {
-			// Create
-			changes.Creates = append(changes.Creates, adapters.Change{
-				ResourceType: adapters.ResourceRemotePathMapping,
-				Name:         fmt.Sprintf("%s -> %s", d.RemotePath, d.LocalPath),
-				Payload:      &d,
-			})
-		}
-	}
-
-	// Find deletes
-	for key, c := range currentByKey {
-		if _, exists := desiredByKey[key]; !exists {
-			id := c.ID
-			changes.Deletes = append(changes.Deletes, adapters.Change{
-				ResourceType: adapters.ResourceRemotePathMapping,
-				Name:         fmt.Sprintf("%s -> %s", c.RemotePath, c.LocalPath),
-				ID:           &id,
-				Payload:      &c,
-			})
-		}
-	}
-
+	shared.DiffRemotePathMappings(current.RemotePathMappings, desired.RemotePathMappings, changes)
 	return nil
 }
 
@@ -142,7 +91,7 @@ func (a *Adapter) createRemotePathMapping(ctx context.Context, c *client.Client,
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -160,7 +109,7 @@ func (a *Adapter) updateRemotePathMapping(ctx context.Context, c *client.Client,
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
@@ -175,7 +124,7 @@ func (a *Adapter) deleteRemotePathMapping(ctx context.Context, c *client.Client,
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	return nil
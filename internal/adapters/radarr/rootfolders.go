@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
@@ -20,7 +21,7 @@ func (a *Adapter) getRootFolders(ctx context.Context, c *client.Client) ([]irv1.
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var folders []client.RootFolderResource
@@ -31,6 +32,7 @@ func (a *Adapter) getRootFolders(ctx context.Context, c *client.Client) ([]irv1.
 	result := make([]irv1.RootFolderIR, 0, len(folders))
 	for _, folder := range folders {
 		ir := irv1.RootFolderIR{
+			ID:   ptrToInt(folder.Id),
 			Path: ptrToString(folder.Path),
 		}
 		result = append(result, ir)
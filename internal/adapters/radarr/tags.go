@@ -5,55 +5,130 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/radarr/client"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
-// getOwnershipTagID retrieves the ID of the Nebularr ownership tag
-func (a *Adapter) getOwnershipTagID(ctx context.Context, c *client.Client) (int, error) {
+// getOwnershipTagID retrieves the ID of the connection's ownership tag, or of the
+// tag earlier versions gave the config until ensureOwnershipTag renames it
+func (a *Adapter) getOwnershipTagID(ctx context.Context, c *client.Client, conn *irv1.ConnectionIR) (int, error) {
+	label := shared.OwnershipTagLabel(conn)
+	labels, err := a.getTagLabels(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	if id, _, found := shared.FindOwnershipTag(labels, label); found {
+		return id, nil
+	}
+	return 0, fmt.Errorf("ownership tag %q not found", label)
+}
+
+// ensureOwnershipTag ensures the connection's ownership tag exists and returns its ID.
+// A tag earlier versions gave the config is renamed instead, keeping its resources.
+func (a *Adapter) ensureOwnershipTag(ctx context.Context, c *client.Client, conn *irv1.ConnectionIR) (int, error) {
+	label := shared.OwnershipTagLabel(conn)
+	labels, err := a.getTagLabels(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+
+	id, legacy, found := shared.FindOwnershipTag(labels, label)
+	if found && !legacy {
+		return id, nil
+	}
+	if found {
+		resp, err := c.PutApiV3TagId(ctx, strconv.Itoa(id), client.PutApiV3TagIdJSONRequestBody{
+			Id:    int32Ptr(int32(id)),
+			Label: stringPtr(label),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to rename ownership tag %q: %w", labels[id], err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+			return 0, fmt.Errorf("failed to rename ownership tag %q: %w", labels[id], &httpclient.StatusError{Code: resp.StatusCode})
+		}
+		return id, nil
+	}
+
+	return a.createTag(ctx, c, label)
+}
+
+// getTagLabels returns tag labels keyed by tag ID
+func (a *Adapter) getTagLabels(ctx context.Context, c *client.Client) (map[int]string, error) {
 	resp, err := c.GetApiV3Tag(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get tags: %w", err)
+		return nil, fmt.Errorf("failed to get tags: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return 0, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, &httpclient.StatusError{Code: resp.StatusCode}
 	}
 
 	var tags []client.TagResource
 	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
-		return 0, fmt.Errorf("failed to decode tags: %w", err)
+		return nil, fmt.Errorf("failed to decode tags: %w", err)
 	}
 
+	labels := make(map[int]string, len(tags))
 	for _, tag := range tags {
-		if ptrToString(tag.Label) == OwnershipTagName {
-			return ptrToInt(tag.Id), nil
-		}
+		labels[ptrToInt(tag.Id)] = ptrToString(tag.Label)
 	}
-
-	return 0, fmt.Errorf("ownership tag not found")
+	return labels, nil
 }
 
-// ensureOwnershipTag ensures the Nebularr ownership tag exists and returns its ID
-func (a *Adapter) ensureOwnershipTag(ctx context.Context, c *client.Client) (int, error) {
-	// First try to get existing tag
-	tagID, err := a.getOwnershipTagID(ctx, c)
-	if err == nil {
-		return tagID, nil
+// ensureTagIDs resolves tag names to IDs, creating tags that don't exist yet.
+// Radarr stores tag labels lowercase, so names are matched case-insensitively.
+func (a *Adapter) ensureTagIDs(ctx context.Context, c *client.Client, names []string) ([]int32, error) {
+	if len(names) == 0 {
+		return []int32{}, nil
+	}
+
+	labels, err := a.getTagLabels(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	byLabel := make(map[string]int, len(labels))
+	for id, label := range labels {
+		byLabel[strings.ToLower(label)] = id
 	}
 
-	// Create the tag
+	ids := make([]int32, 0, len(names))
+	for _, name := range names {
+		label := strings.ToLower(name)
+		if id, ok := byLabel[label]; ok {
+			ids = append(ids, int32(id))
+			continue
+		}
+
+		id, err := a.createTag(ctx, c, label)
+		if err != nil {
+			return nil, err
+		}
+		byLabel[label] = id
+		ids = append(ids, int32(id))
+	}
+	return ids, nil
+}
+
+// createTag creates a tag and returns its ID
+func (a *Adapter) createTag(ctx context.Context, c *client.Client, label string) (int, error) {
 	resp, err := c.PostApiV3Tag(ctx, client.PostApiV3TagJSONRequestBody{
-		Label: stringPtr(OwnershipTagName),
+		Label: stringPtr(label),
 	})
 	if err != nil {
-		return 0, fmt.Errorf("failed to create ownership tag: %w", err)
+		return 0, fmt.Errorf("failed to create tag %q: %w", label, err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return 0, fmt.Errorf("unexpected status code creating tag: %d", resp.StatusCode)
+		return 0, fmt.Errorf("failed to create tag: %w", &httpclient.StatusError{Code: resp.StatusCode})
 	}
 
 	var tag client.TagResource
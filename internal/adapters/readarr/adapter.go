@@ -14,6 +14,12 @@ import (
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
+// ownedEndpoints are the collections of the resource types carrying the ownership tag
+var ownedEndpoints = map[string]string{
+	adapters.ResourceDownloadClient: "/api/v1/downloadclient",
+	adapters.ResourceIndexer:        "/api/v1/indexer",
+}
+
 // Adapter implements the adapters.Adapter interface for Readarr
 type Adapter struct{}
 
@@ -96,11 +102,11 @@ func (a *Adapter) CurrentState(ctx context.Context, conn *irv1.ConnectionIR) (*i
 		Connection:  conn,
 	}
 
-	// Get ownership tag ID
-	tagID, err := a.getOwnershipTagID(ctx, c)
+	// Get ownership tag ID. Without the tag nothing is tagged as managed yet, but
+	// resources recognised by name (e.g., nebularr- quality profiles) are still read.
+	tagID, err := a.getOwnershipTagID(ctx, c, conn)
 	if err != nil {
-		// No ownership tag means no managed resources
-		return ir, nil
+		tagID = 0
 	}
 
 	// Get quality profiles (managed by name prefix)
@@ -133,6 +139,31 @@ func (a *Adapter) CurrentState(ctx context.Context, conn *irv1.ConnectionIR) (*i
 		ir.RootFolders = folders
 	}
 
+	// Get import lists tagged with ownership tag
+	if importLists, err := a.getManagedImportLists(ctx, c, tagID); err == nil {
+		ir.ImportLists = importLists
+	}
+
+	// Get media management config
+	if mediaManagement, err := a.getMediaManagementIR(ctx, c); err == nil {
+		ir.MediaManagement = mediaManagement
+	}
+
+	// Get naming config
+	if naming, err := a.getNamingIR(ctx, c); err == nil {
+		ir.Naming = &irv1.NamingIR{Readarr: naming}
+	}
+
+	// Get authentication config
+	if auth, err := a.getAuthenticationIR(ctx, c); err == nil {
+		ir.Authentication = auth
+	}
+
+	// Get delay profiles (all of them, not just tagged)
+	if delayProfiles, err := a.getManagedDelayProfiles(ctx, c); err == nil {
+		ir.DelayProfiles = delayProfiles
+	}
+
 	return ir, nil
 }
 
@@ -169,6 +200,14 @@ func (a *Adapter) Diff(current, desired *irv1.IR, caps *adapters.Capabilities) (
 		return nil, fmt.Errorf("failed to diff root folders: %w", err)
 	}
 
+	// Diff naming config
+	a.diffNaming(current, desired, changes)
+
+	// Diff delay profiles
+	if err := a.diffDelayProfiles(current, desired, changes); err != nil {
+		return nil, fmt.Errorf("failed to diff delay profiles: %w", err)
+	}
+
 	return changes, nil
 }
 
@@ -177,15 +216,18 @@ func (a *Adapter) Apply(ctx context.Context, conn *irv1.ConnectionIR, changes *a
 	c := a.newClient(conn)
 
 	// Ensure ownership tag exists
-	tagID, err := a.ensureOwnershipTag(ctx, c)
+	tagID, err := a.ensureOwnershipTag(ctx, c, conn)
 	if err != nil {
 		return &adapters.ApplyResult{}, fmt.Errorf("failed to ensure ownership tag: %w", err)
 	}
 
-	// Use shared apply loop with adapter-specific callbacks
+	// Use shared apply loop with adapter-specific callbacks. Creates first adopt a
+	// same-named resource still carrying the shared ownership tag.
 	result := shared.ApplyChanges(
 		changes,
-		func(change adapters.Change) error { return a.applyCreate(ctx, c, change, tagID) },
+		shared.AdoptingCreate(ctx, c, "v1", ownedEndpoints, tagID, func(change adapters.Change) error {
+			return a.applyCreate(ctx, c, change, tagID)
+		}),
 		func(change adapters.Change) error { return a.applyUpdate(ctx, c, change, tagID) },
 		func(change adapters.Change) error { return a.applyDelete(ctx, c, change) },
 	)
@@ -193,81 +235,53 @@ func (a *Adapter) Apply(ctx context.Context, conn *irv1.ConnectionIR, changes *a
 	return result, nil
 }
 
-// diffDownloadClients computes changes for download clients
-func (a *Adapter) diffDownloadClients(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
-	currentMap := make(map[string]irv1.DownloadClientIR)
-	for _, dc := range current.DownloadClients {
-		currentMap[dc.Name] = dc
-	}
+// Ensure Adapter implements DirectApplier
+var _ adapters.DirectApplier = (*Adapter)(nil)
 
-	desiredMap := make(map[string]irv1.DownloadClientIR)
-	for _, dc := range desired.DownloadClients {
-		desiredMap[dc.Name] = dc
-	}
+// ApplyDirect applies configuration directly from IR (not via ChangeSet)
+// This is used for resources like import lists, media management, and authentication
+// that use a different sync pattern (direct apply rather than diff-based)
+func (a *Adapter) ApplyDirect(ctx context.Context, conn *irv1.ConnectionIR, ir *irv1.IR) (*adapters.ApplyResult, error) {
+	c := a.newClient(conn)
 
-	// Creates and updates
-	for name, desiredDC := range desiredMap {
-		if _, exists := currentMap[name]; !exists {
-			changes.Creates = append(changes.Creates, adapters.Change{
-				ResourceType: adapters.ResourceDownloadClient,
-				Name:         name,
-				Payload:      desiredDC,
-			})
-		}
-		// Note: For simplicity, we're not doing deep updates here
-		// A production implementation would compare fields
+	// Ensure ownership tag exists
+	tagID, err := a.ensureOwnershipTag(ctx, c, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure ownership tag: %w", err)
 	}
 
-	// Deletes (only managed resources)
-	for name := range currentMap {
-		if _, exists := desiredMap[name]; !exists {
-			changes.Deletes = append(changes.Deletes, adapters.Change{
-				ResourceType: adapters.ResourceDownloadClient,
-				Name:         name,
-			})
-		}
-	}
+	// Use shared apply direct helper with adapter-specific callbacks
+	result := shared.ApplyDirect(ir, shared.DirectApplyCallbacks{
+		ApplyImportLists: func() (*shared.ImportListStats, error) {
+			return a.applyImportLists(ctx, c, ir, tagID)
+		},
+		ApplyMediaManagement: func() error {
+			return a.applyMediaManagement(ctx, c, ir.MediaManagement)
+		},
+		ApplyAuthentication: func() error {
+			return a.applyAuthentication(ctx, c, ir.Authentication)
+		},
+	})
+
+	return result, nil
+}
 
+// diffDownloadClients computes changes for download clients
+func (a *Adapter) diffDownloadClients(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
+	adapters.DiffDownloadClientsWithIR(current.DownloadClients, desired.DownloadClients, changes)
 	return nil
 }
 
 // diffIndexers computes changes for indexers
 func (a *Adapter) diffIndexers(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
-	currentIndexers := make(map[string]irv1.IndexerIR)
+	var currentIndexers, desiredIndexers []irv1.IndexerIR
 	if current.Indexers != nil {
-		for _, idx := range current.Indexers.Direct {
-			currentIndexers[idx.Name] = idx
-		}
+		currentIndexers = current.Indexers.Direct
 	}
-
-	desiredIndexers := make(map[string]irv1.IndexerIR)
 	if desired.Indexers != nil {
-		for _, idx := range desired.Indexers.Direct {
-			desiredIndexers[idx.Name] = idx
-		}
+		desiredIndexers = desired.Indexers.Direct
 	}
-
-	// Creates
-	for name, desiredIdx := range desiredIndexers {
-		if _, exists := currentIndexers[name]; !exists {
-			changes.Creates = append(changes.Creates, adapters.Change{
-				ResourceType: adapters.ResourceIndexer,
-				Name:         name,
-				Payload:      desiredIdx,
-			})
-		}
-	}
-
-	// Deletes
-	for name := range currentIndexers {
-		if _, exists := desiredIndexers[name]; !exists {
-			changes.Deletes = append(changes.Deletes, adapters.Change{
-				ResourceType: adapters.ResourceIndexer,
-				Name:         name,
-			})
-		}
-	}
-
+	adapters.DiffIndexersWithIR(currentIndexers, desiredIndexers, changes)
 	return nil
 }
 
@@ -305,6 +319,8 @@ func (a *Adapter) applyCreate(ctx context.Context, c *httpclient.Client, change
 		return a.createIndexer(ctx, c, change.Payload.(irv1.IndexerIR), tagID)
 	case adapters.ResourceRootFolder:
 		return a.createRootFolder(ctx, c, change.Payload.(irv1.RootFolderIR))
+	case adapters.ResourceDelayProfile:
+		return a.createDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR))
 	default:
 		return fmt.Errorf("unknown resource type: %s", change.ResourceType)
 	}
@@ -323,6 +339,22 @@ func (a *Adapter) applyUpdate(ctx context.Context, c *httpclient.Client, change
 			return a.updateMetadataProfile(ctx, c, change.Payload.(*irv1.MetadataProfileIR), *change.ID)
 		}
 		return fmt.Errorf("metadata profile update requires ID")
+	case adapters.ResourceNamingConfig:
+		return a.updateNaming(ctx, c, change.Payload.(*irv1.ReadarrNamingIR))
+	case adapters.ResourceDownloadClient:
+		if change.ID != nil {
+			return a.updateDownloadClient(ctx, c, change.Payload.(irv1.DownloadClientIR), *change.ID, tagID)
+		}
+		return fmt.Errorf("download client update requires ID")
+	case adapters.ResourceIndexer:
+		if change.ID != nil {
+			return a.updateIndexer(ctx, c, change.Payload.(irv1.IndexerIR), *change.ID, tagID)
+		}
+		return fmt.Errorf("indexer update requires ID")
+	case adapters.ResourceDelayProfile:
+		return a.updateDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR))
+	case adapters.ResourceDelayProfileOrder:
+		return shared.ReorderDelayProfiles(ctx, c, "v1", change.Payload.([]string))
 	default:
 		// Other resources don't support updates yet
 		return nil
@@ -340,6 +372,8 @@ func (a *Adapter) applyDelete(ctx context.Context, c *httpclient.Client, change
 		return a.deleteDownloadClientByName(ctx, c, change.Name)
 	case adapters.ResourceIndexer:
 		return a.deleteIndexerByName(ctx, c, change.Name)
+	case adapters.ResourceDelayProfile:
+		return a.deleteDelayProfile(ctx, c, *change.ID)
 	default:
 		return fmt.Errorf("unknown resource type: %s", change.ResourceType)
 	}
@@ -347,10 +381,26 @@ func (a *Adapter) applyDelete(ctx context.Context, c *httpclient.Client, change
 
 // createDownloadClient creates a new download client
 func (a *Adapter) createDownloadClient(ctx context.Context, c *httpclient.Client, dc irv1.DownloadClientIR, tagID int) error {
-	// Build the resource from IR
+	resource := a.irToDownloadClient(dc, tagID)
+
+	var result DownloadClientResource
+	return c.Post(ctx, "/api/v1/downloadclient", resource, &result)
+}
+
+// updateDownloadClient replaces an existing download client
+func (a *Adapter) updateDownloadClient(ctx context.Context, c *httpclient.Client, dc irv1.DownloadClientIR, id, tagID int) error {
+	resource := a.irToDownloadClient(dc, tagID)
+	resource.ID = id
+
+	var result DownloadClientResource
+	return c.Put(ctx, fmt.Sprintf("/api/v1/downloadclient/%d", id), resource, &result)
+}
+
+// irToDownloadClient builds the API resource for a download client
+func (a *Adapter) irToDownloadClient(dc irv1.DownloadClientIR, tagID int) DownloadClientResource {
 	resource := DownloadClientResource{
 		Name:           dc.Name,
-		Implementation: dc.Implementation,
+		Implementation: adapters.CanonicalImplementation(dc.Implementation),
 		Protocol:       dc.Protocol,
 		Enable:         dc.Enable,
 		Priority:       dc.Priority,
@@ -367,14 +417,33 @@ func (a *Adapter) createDownloadClient(ctx context.Context, c *httpclient.Client
 	if dc.Category != "" {
 		resource.Fields = append(resource.Fields, FieldResource{Name: "bookCategory", Value: dc.Category})
 	}
+	if dc.APIKey != "" {
+		resource.Fields = append(resource.Fields, FieldResource{Name: "apiKey", Value: dc.APIKey})
+	}
 
-	var result DownloadClientResource
-	return c.Post(ctx, "/api/v1/downloadclient", resource, &result)
+	return resource
 }
 
 // createIndexer creates a new indexer
 func (a *Adapter) createIndexer(ctx context.Context, c *httpclient.Client, idx irv1.IndexerIR, tagID int) error {
-	resource := IndexerResource{
+	resource := a.irToIndexer(idx, tagID)
+
+	var result IndexerResource
+	return c.Post(ctx, "/api/v1/indexer", resource, &result)
+}
+
+// updateIndexer replaces an existing indexer
+func (a *Adapter) updateIndexer(ctx context.Context, c *httpclient.Client, idx irv1.IndexerIR, id, tagID int) error {
+	resource := a.irToIndexer(idx, tagID)
+	resource.ID = id
+
+	var result IndexerResource
+	return c.Put(ctx, fmt.Sprintf("/api/v1/indexer/%d", id), resource, &result)
+}
+
+// irToIndexer builds the API resource for an indexer
+func (a *Adapter) irToIndexer(idx irv1.IndexerIR, tagID int) IndexerResource {
+	return IndexerResource{
 		Name:           idx.Name,
 		Implementation: idx.Implementation,
 		Protocol:       idx.Protocol,
@@ -390,9 +459,6 @@ func (a *Adapter) createIndexer(ctx context.Context, c *httpclient.Client, idx i
 			{Name: "enableInteractiveSearch", Value: idx.EnableInteractiveSearch},
 		},
 	}
-
-	var result IndexerResource
-	return c.Post(ctx, "/api/v1/indexer", resource, &result)
 }
 
 // createRootFolder creates a new root folder
@@ -438,25 +504,45 @@ func (a *Adapter) deleteIndexerByName(ctx context.Context, c *httpclient.Client,
 	return nil // Not found is not an error
 }
 
+// Ensure Adapter implements RawRequester
+var _ adapters.RawRequester = (*Adapter)(nil)
+
+// SendRaw sends an unmodeled API request to Readarr (spec.raw)
+func (a *Adapter) SendRaw(ctx context.Context, conn *irv1.ConnectionIR, method, path string, body []byte) error {
+	return shared.SendRaw(ctx, a.newClient(conn), method, path, body)
+}
+
+// Ensure Adapter implements RefReader
+var _ adapters.RefReader = (*Adapter)(nil)
+
+// ManagedRefs picks the Readarr IDs of the resources declared in desired out of current
+func (a *Adapter) ManagedRefs(current, desired *irv1.IR) *adapters.ManagedRefs {
+	return shared.ManagedRefsFromIR(current, desired)
+}
+
+// Ensure Adapter implements QueueReader
+var _ adapters.QueueReader = (*Adapter)(nil)
+
+// QueuedDownloadIDs returns the download IDs of the Readarr queue
+func (a *Adapter) QueuedDownloadIDs(ctx context.Context, conn *irv1.ConnectionIR) (map[string]bool, error) {
+	return shared.ReadQueuedDownloadIDs(ctx, a.newClient(conn), "/api/v1/queue?includeUnknownAuthorItems=true")
+}
+
 // newClient creates a new HTTP client for Readarr API communication
 func (a *Adapter) newClient(conn *irv1.ConnectionIR) *httpclient.Client {
-	return httpclient.New(httpclient.Config{
-		BaseURL:            conn.URL,
-		APIKey:             conn.APIKey,
-		InsecureSkipVerify: conn.InsecureSkipVerify,
-	})
+	return httpclient.New(httpclient.ConfigForConnection(conn))
 }
 
 // Note: HealthResource is now defined as a type alias in types.go
 
-// getOwnershipTagID finds or returns -1 if the ownership tag doesn't exist
-func (a *Adapter) getOwnershipTagID(ctx context.Context, c *httpclient.Client) (int, error) {
-	return shared.GetOwnershipTagID(ctx, c, "v1")
+// getOwnershipTagID retrieves the ID of the connection's ownership tag
+func (a *Adapter) getOwnershipTagID(ctx context.Context, c *httpclient.Client, conn *irv1.ConnectionIR) (int, error) {
+	return shared.GetOwnershipTagID(ctx, c, "v1", shared.OwnershipTagLabel(conn))
 }
 
 // ensureOwnershipTag creates the ownership tag if it doesn't exist and returns its ID
-func (a *Adapter) ensureOwnershipTag(ctx context.Context, c *httpclient.Client) (int, error) {
-	return shared.EnsureOwnershipTag(ctx, c, "v1")
+func (a *Adapter) ensureOwnershipTag(ctx context.Context, c *httpclient.Client, conn *irv1.ConnectionIR) (int, error) {
+	return shared.EnsureOwnershipTag(ctx, c, "v1", shared.OwnershipTagLabel(conn))
 }
 
 // getManagedDownloadClients retrieves download clients tagged with the ownership tag
@@ -502,7 +588,7 @@ func (a *Adapter) getRootFolders(ctx context.Context, c *httpclient.Client) ([]i
 
 	var result []irv1.RootFolderIR
 	for _, folder := range folders {
-		result = append(result, irv1.RootFolderIR{Path: folder.Path})
+		result = append(result, irv1.RootFolderIR{ID: folder.ID, Path: folder.Path})
 	}
 
 	return result, nil
@@ -511,6 +597,7 @@ func (a *Adapter) getRootFolders(ctx context.Context, c *httpclient.Client) ([]i
 // downloadClientToIR converts a download client resource to IR
 func (a *Adapter) downloadClientToIR(dc *DownloadClientResource) irv1.DownloadClientIR {
 	ir := irv1.DownloadClientIR{
+		ID:             dc.ID,
 		Name:           dc.Name,
 		Implementation: dc.Implementation,
 		Protocol:       dc.Protocol,
@@ -553,6 +640,7 @@ func (a *Adapter) downloadClientToIR(dc *DownloadClientResource) irv1.DownloadCl
 // indexerToIR converts an indexer resource to IR
 func (a *Adapter) indexerToIR(idx *IndexerResource) irv1.IndexerIR {
 	ir := irv1.IndexerIR{
+		ID:             idx.ID,
 		Name:           idx.Name,
 		Implementation: idx.Implementation,
 		Protocol:       idx.Protocol,
@@ -0,0 +1,58 @@
+package readarr
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestApplyAuthentication(t *testing.T) {
+	fake, c := newFakeConfig(t, hostConfigAPIPath, `{"id":1,"port":8787,"authenticationMethod":"None",
+		"authenticationRequired":"Enabled","apiKey":"readarr-key","instanceName":"Readarr"}`)
+	a := &Adapter{}
+	ctx := context.Background()
+
+	ir := &irv1.AuthenticationIR{
+		Method:                 "forms",
+		AuthenticationRequired: "disabledForLocalAddresses",
+		Username:               "admin",
+		Password:               "hunter2",
+	}
+	if err := a.applyAuthentication(ctx, c, ir); err != nil {
+		t.Fatalf("applyAuthentication() error = %v", err)
+	}
+	if !reflect.DeepEqual(fake.puts, []string{hostConfigAPIPath + "/1"}) {
+		t.Fatalf("puts = %v, want one PUT to %s/1", fake.puts, hostConfigAPIPath)
+	}
+
+	var put shared.HostConfigResource
+	if err := json.Unmarshal(fake.body, &put); err != nil {
+		t.Fatal(err)
+	}
+	if put.AuthenticationMethod != "Forms" || put.AuthenticationRequired != "DisabledForLocalAddresses" {
+		t.Errorf("sent method %q required %q, want Forms and DisabledForLocalAddresses", put.AuthenticationMethod, put.AuthenticationRequired)
+	}
+	if put.Username != "admin" || put.Password != "hunter2" || put.PasswordConfirmation != "hunter2" {
+		t.Errorf("sent username %q password %q confirmation %q, want the forms credentials", put.Username, put.Password, put.PasswordConfirmation)
+	}
+	// The rest of the host config is sent back as read
+	if put.Port != 8787 || put.ApiKey != "readarr-key" || put.InstanceName != "Readarr" {
+		t.Errorf("host settings not preserved: %+v", put)
+	}
+
+	// Without a password the current one is kept
+	if err := a.applyAuthentication(ctx, c, &irv1.AuthenticationIR{Method: "basic"}); err != nil {
+		t.Fatalf("applyAuthentication() error = %v", err)
+	}
+	var basic shared.HostConfigResource
+	if err := json.Unmarshal(fake.body, &basic); err != nil {
+		t.Fatal(err)
+	}
+	if basic.AuthenticationMethod != "Basic" || basic.Password != "hunter2" {
+		t.Errorf("sent method %q password %q, want Basic with the password unchanged", basic.AuthenticationMethod, basic.Password)
+	}
+}
@@ -0,0 +1,159 @@
+package readarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// fakeDelayProfiles serves Readarr's delay profiles and tags, recording the writes sent to it
+type fakeDelayProfiles struct {
+	mu       sync.Mutex
+	tags     []shared.TagResource
+	profiles []DelayProfileResource
+	posted   []DelayProfileResource
+	puts     map[string]DelayProfileResource
+	deletes  []string
+}
+
+func newFakeDelayProfiles(t *testing.T) (*fakeDelayProfiles, *httpclient.Client) {
+	fake := &fakeDelayProfiles{
+		tags: []shared.TagResource{{ID: 1, Label: "audiobooks"}},
+		profiles: []DelayProfileResource{
+			{ID: 1, Order: 2147483647, PreferredProtocol: "usenet", EnableUsenet: true, EnableTorrent: true, Tags: []int{}},
+			{ID: 3, Order: 1, PreferredProtocol: "torrent", TorrentDelay: 60, EnableTorrent: true, Tags: []int{1, 9}},
+		},
+		puts: map[string]DelayProfileResource{},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tag":
+			_ = json.NewEncoder(w).Encode(fake.tags)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tag":
+			var tag shared.TagResource
+			_ = json.NewDecoder(r.Body).Decode(&tag)
+			tag.ID = len(fake.tags) + 1
+			fake.tags = append(fake.tags, tag)
+			_ = json.NewEncoder(w).Encode(tag)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/delayprofile":
+			_ = json.NewEncoder(w).Encode(fake.profiles)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/delayprofile":
+			var profile DelayProfileResource
+			_ = json.NewDecoder(r.Body).Decode(&profile)
+			fake.posted = append(fake.posted, profile)
+			_ = json.NewEncoder(w).Encode(profile)
+		case r.Method == http.MethodPut:
+			var profile DelayProfileResource
+			_ = json.NewDecoder(r.Body).Decode(&profile)
+			fake.puts[r.URL.Path] = profile
+			_ = json.NewEncoder(w).Encode(profile)
+		case r.Method == http.MethodDelete:
+			fake.deletes = append(fake.deletes, r.URL.Path)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	a := &Adapter{}
+	return fake, a.newClient(&irv1.ConnectionIR{URL: server.URL})
+}
+
+func TestGetManagedDelayProfiles(t *testing.T) {
+	_, c := newFakeDelayProfiles(t)
+	a := &Adapter{}
+
+	profiles, err := a.getManagedDelayProfiles(context.Background(), c)
+	if err != nil {
+		t.Fatalf("getManagedDelayProfiles() error = %v", err)
+	}
+	if len(profiles) != 2 {
+		t.Fatalf("expected both profiles, got %+v", profiles)
+	}
+
+	if profiles[0].TagNames != nil || profiles[0].PreferredProtocol != irv1.ProtocolUsenet {
+		t.Errorf("default profile = %+v, want untagged usenet", profiles[0])
+	}
+	// Tag IDs without a label are left out of the names
+	tagged := profiles[1]
+	if !reflect.DeepEqual(tagged.TagNames, []string{"audiobooks"}) || !reflect.DeepEqual(tagged.Tags, []int{1, 9}) {
+		t.Errorf("tagged profile names %v ids %v, want [audiobooks] and [1 9]", tagged.TagNames, tagged.Tags)
+	}
+	if tagged.PreferredProtocol != irv1.ProtocolTorrent || tagged.TorrentDelay != 60 || tagged.Order != 1 {
+		t.Errorf("tagged profile = %+v, want a torrent profile delayed 60 at order 1", tagged)
+	}
+}
+
+func TestCreateDelayProfile(t *testing.T) {
+	fake, c := newFakeDelayProfiles(t)
+	a := &Adapter{}
+
+	ir := &irv1.DelayProfileIR{
+		Order:             2,
+		PreferredProtocol: irv1.ProtocolTorrent,
+		TorrentDelay:      120,
+		EnableTorrent:     true,
+		TagNames:          []string{"AudioBooks", "Lossless"},
+	}
+	if err := a.createDelayProfile(context.Background(), c, ir); err != nil {
+		t.Fatalf("createDelayProfile() error = %v", err)
+	}
+
+	// The existing tag is matched case-insensitively and the missing one created lowercase
+	if len(fake.tags) != 2 || fake.tags[1].Label != "lossless" {
+		t.Errorf("tags = %+v, want lossless created", fake.tags)
+	}
+	if len(fake.posted) != 1 {
+		t.Fatalf("expected one created profile, got %+v", fake.posted)
+	}
+	want := DelayProfileResource{Order: 2, PreferredProtocol: "torrent", TorrentDelay: 120, EnableTorrent: true, Tags: []int{1, 2}}
+	if !reflect.DeepEqual(fake.posted[0], want) {
+		t.Errorf("posted %+v, want %+v", fake.posted[0], want)
+	}
+}
+
+func TestUpdateDelayProfile(t *testing.T) {
+	fake, c := newFakeDelayProfiles(t)
+	a := &Adapter{}
+
+	// An untagged profile is sent with an empty tag list, which applies it to everything
+	ir := &irv1.DelayProfileIR{ID: 1, Order: 2147483647, PreferredProtocol: irv1.ProtocolUsenet, UsenetDelay: 30, EnableUsenet: true}
+	if err := a.updateDelayProfile(context.Background(), c, ir); err != nil {
+		t.Fatalf("updateDelayProfile() error = %v", err)
+	}
+
+	put, ok := fake.puts["/api/v1/delayprofile/1"]
+	if !ok {
+		t.Fatalf("expected a PUT to /api/v1/delayprofile/1, got %v", fake.puts)
+	}
+	want := DelayProfileResource{ID: 1, Order: 2147483647, PreferredProtocol: "usenet", UsenetDelay: 30, EnableUsenet: true, Tags: []int{}}
+	if !reflect.DeepEqual(put, want) {
+		t.Errorf("put %+v, want %+v", put, want)
+	}
+	if len(fake.tags) != 1 {
+		t.Errorf("no tags should be created, got %+v", fake.tags)
+	}
+}
+
+func TestDeleteDelayProfile(t *testing.T) {
+	fake, c := newFakeDelayProfiles(t)
+	a := &Adapter{}
+
+	if err := a.deleteDelayProfile(context.Background(), c, 3); err != nil {
+		t.Fatalf("deleteDelayProfile() error = %v", err)
+	}
+	if !reflect.DeepEqual(fake.deletes, []string{"/api/v1/delayprofile/3"}) {
+		t.Errorf("deletes = %v, want /api/v1/delayprofile/3", fake.deletes)
+	}
+}
@@ -0,0 +1,307 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// defaultMetadataProfileID is used when no managed metadata profile exists yet
+const defaultMetadataProfileID = 1
+
+// getImportLists fetches all import lists from Readarr
+func (a *Adapter) getImportLists(ctx context.Context, c *httpclient.Client) ([]ImportListResource, error) {
+	var lists []ImportListResource
+	if err := c.Get(ctx, "/api/v1/importlist", &lists); err != nil {
+		return nil, fmt.Errorf("failed to get import lists: %w", err)
+	}
+	return lists, nil
+}
+
+// getImportListSchemas fetches available import list schemas
+func (a *Adapter) getImportListSchemas(ctx context.Context, c *httpclient.Client) ([]ImportListResource, error) {
+	var schemas []ImportListResource
+	if err := c.Get(ctx, "/api/v1/importlist/schema", &schemas); err != nil {
+		return nil, fmt.Errorf("failed to get import list schemas: %w", err)
+	}
+	return schemas, nil
+}
+
+// findImportListSchema finds a schema by implementation type
+func findImportListSchema(schemas []ImportListResource, listType string) *ImportListResource {
+	for i := range schemas {
+		if schemas[i].Implementation == listType {
+			return &schemas[i]
+		}
+	}
+	return nil
+}
+
+// buildImportListFields builds the fields array from settings
+func buildImportListFields(settings map[string]string, schema *ImportListResource) []FieldResource {
+	fields := make([]FieldResource, 0)
+
+	// Create a map of schema fields for validation
+	schemaFields := make(map[string]bool)
+	for _, f := range schema.Fields {
+		schemaFields[f.Name] = true
+	}
+
+	for name, value := range settings {
+		if schemaFields[name] {
+			fields = append(fields, FieldResource{Name: name, Value: value})
+		}
+	}
+
+	return fields
+}
+
+// typedImportListFields translates the typed list settings to Readarr fields
+func typedImportListFields(list *irv1.ImportListIR) []FieldResource {
+	var fields []FieldResource
+	switch {
+	case list.GoodreadsList != nil:
+		fields = append(fields, FieldResource{Name: "listId", Value: list.GoodreadsList.ListID})
+	case list.GoodreadsOwnedBooks != nil:
+		session := list.GoodreadsOwnedBooks
+		fields = append(fields,
+			FieldResource{Name: "accessToken", Value: session.AccessToken},
+			FieldResource{Name: "accessTokenSecret", Value: session.AccessTokenSecret},
+			FieldResource{Name: "userId", Value: session.UserID},
+			FieldResource{Name: "userName", Value: session.UserName},
+		)
+	case list.LazyLibrarian != nil:
+		fields = append(fields,
+			FieldResource{Name: "baseUrl", Value: list.LazyLibrarian.URL},
+			FieldResource{Name: "apiKey", Value: list.LazyLibrarian.APIKey},
+		)
+	}
+	return fields
+}
+
+// mergeImportListFields replaces fields with the overrides of the same name
+// and appends the remaining overrides
+func mergeImportListFields(fields, overrides []FieldResource) []FieldResource {
+	for _, o := range overrides {
+		replaced := false
+		for i := range fields {
+			if fields[i].Name == o.Name {
+				fields[i].Value = o.Value
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			fields = append(fields, o)
+		}
+	}
+	return fields
+}
+
+// applyImportLists applies import list changes directly to Readarr
+func (a *Adapter) applyImportLists(
+	ctx context.Context,
+	c *httpclient.Client,
+	ir *irv1.IR,
+	tagID int,
+) (*shared.ImportListStats, error) {
+	stats := &shared.ImportListStats{}
+
+	if len(ir.ImportLists) == 0 {
+		return stats, nil
+	}
+
+	// Get existing import lists
+	existing, err := a.getImportLists(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing import lists: %w", err)
+	}
+
+	// Get schemas for validation
+	schemas, err := a.getImportListSchemas(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get import list schemas: %w", err)
+	}
+
+	metadataProfileID := a.resolveMetadataProfileID(ctx, c)
+
+	// Import lists reference quality profiles by name
+	profileIDs, err := a.getQualityProfileIDs(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quality profiles: %w", err)
+	}
+
+	// Index existing by name
+	existingByName := make(map[string]*ImportListResource)
+	for i := range existing {
+		existingByName[existing[i].Name] = &existing[i]
+	}
+
+	// Track desired names for orphan detection
+	desiredNames := make(map[string]bool)
+
+	for _, list := range ir.ImportLists {
+		desiredNames[list.Name] = true
+
+		// Find schema for this type
+		schema := findImportListSchema(schemas, list.Type)
+		if schema == nil {
+			stats.Skipped++
+			stats.Errors = append(stats.Errors, fmt.Errorf("unknown import list type %s for %s", list.Type, list.Name))
+			continue
+		}
+
+		profileID, ok := profileIDs[list.QualityProfileName]
+		if !ok {
+			stats.Skipped++
+			stats.Errors = append(stats.Errors, fmt.Errorf("quality profile %q not found for import list %s", list.QualityProfileName, list.Name))
+			continue
+		}
+		list.QualityProfileID = profileID
+
+		// Build fields from settings; typed list settings take precedence
+		fields := mergeImportListFields(buildImportListFields(list.Settings, schema), typedImportListFields(&list))
+
+		// Build the payload
+		payload := a.irToImportList(&list, schema, fields, metadataProfileID, tagID)
+
+		existingList := existingByName[list.Name]
+
+		if existingList == nil {
+			// Create new import list
+			if err := a.createImportList(ctx, c, payload); err != nil {
+				stats.Errors = append(stats.Errors, fmt.Errorf("failed to create import list %s: %w", list.Name, err))
+			} else {
+				stats.Created++
+			}
+		} else {
+			// Update existing import list
+			payload.ID = existingList.ID
+			if err := a.updateImportList(ctx, c, payload); err != nil {
+				stats.Errors = append(stats.Errors, fmt.Errorf("failed to update import list %s: %w", list.Name, err))
+			} else {
+				stats.Updated++
+			}
+		}
+	}
+
+	// Delete orphaned import lists (managed by us but not in desired state)
+	for name, existingList := range existingByName {
+		if !desiredNames[name] && containsTag(existingList.Tags, tagID) {
+			if err := a.deleteImportList(ctx, c, existingList.ID); err != nil {
+				stats.Errors = append(stats.Errors, fmt.Errorf("failed to delete import list %s: %w", name, err))
+			} else {
+				stats.Deleted++
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+// resolveMetadataProfileID returns the ID of the managed metadata profile,
+// falling back to Readarr's built-in default profile
+func (a *Adapter) resolveMetadataProfileID(ctx context.Context, c *httpclient.Client) int {
+	profiles, err := a.getManagedMetadataProfiles(ctx, c)
+	if err != nil || len(profiles) == 0 || profiles[0].ID == nil {
+		return defaultMetadataProfileID
+	}
+	return *profiles[0].ID
+}
+
+// irToImportList converts an IR import list to a Readarr ImportListResource
+func (a *Adapter) irToImportList(ir *irv1.ImportListIR, schema *ImportListResource, fields []FieldResource, metadataProfileID, tagID int) ImportListResource {
+	// Readarr uses book-centric monitor values; anything else (e.g. the
+	// Sonarr-style "all" default) falls back to monitoring the entire author
+	shouldMonitor := "entireAuthor"
+	switch ir.ShouldMonitor {
+	case "none", "specificBook", "entireAuthor":
+		shouldMonitor = ir.ShouldMonitor
+	}
+
+	return ImportListResource{
+		Name:               ir.Name,
+		Implementation:     ir.Type,
+		ConfigContract:     schema.ConfigContract,
+		Enable:             ir.Enabled,
+		EnableAutomaticAdd: ir.EnableAuto,
+		ShouldMonitor:      shouldMonitor,
+		ShouldSearch:       ir.SearchOnAdd,
+		MonitorNewItems:    "all",
+		RootFolderPath:     ir.RootFolderPath,
+		QualityProfileId:   ir.QualityProfileID,
+		MetadataProfileId:  metadataProfileID,
+		ListType:           schema.ListType,
+		ListOrder:          schema.ListOrder,
+		Tags:               []int{tagID},
+		Fields:             fields,
+	}
+}
+
+// createImportList creates a new import list
+func (a *Adapter) createImportList(ctx context.Context, c *httpclient.Client, payload ImportListResource) error {
+	var result ImportListResource
+	return c.Post(ctx, "/api/v1/importlist", payload, &result)
+}
+
+// updateImportList updates an existing import list
+func (a *Adapter) updateImportList(ctx context.Context, c *httpclient.Client, payload ImportListResource) error {
+	path := fmt.Sprintf("/api/v1/importlist/%d", payload.ID)
+	var result ImportListResource
+	return c.Put(ctx, path, payload, &result)
+}
+
+// deleteImportList deletes an import list
+func (a *Adapter) deleteImportList(ctx context.Context, c *httpclient.Client, id int) error {
+	path := fmt.Sprintf("/api/v1/importlist/%d", id)
+	return c.Delete(ctx, path)
+}
+
+// getManagedImportLists retrieves import lists managed by Nebularr (tagged)
+func (a *Adapter) getManagedImportLists(ctx context.Context, c *httpclient.Client, tagID int) ([]irv1.ImportListIR, error) {
+	lists, err := a.getImportLists(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var managed []irv1.ImportListIR
+	for _, list := range lists {
+		if containsTag(list.Tags, tagID) {
+			managed = append(managed, a.importListToIR(&list))
+		}
+	}
+
+	return managed, nil
+}
+
+// importListToIR converts a Readarr ImportListResource to an IR ImportListIR
+func (a *Adapter) importListToIR(list *ImportListResource) irv1.ImportListIR {
+	ir := irv1.ImportListIR{
+		Name:             list.Name,
+		Type:             list.Implementation,
+		Enabled:          list.Enable,
+		EnableAuto:       list.EnableAutomaticAdd,
+		SearchOnAdd:      list.ShouldSearch,
+		QualityProfileID: list.QualityProfileId,
+		RootFolderPath:   list.RootFolderPath,
+		ShouldMonitor:    list.ShouldMonitor,
+		Settings:         make(map[string]string),
+	}
+
+	// Convert fields to settings
+	for _, f := range list.Fields {
+		if f.Value != nil {
+			switch v := f.Value.(type) {
+			case string:
+				ir.Settings[f.Name] = v
+			default:
+				ir.Settings[f.Name] = fmt.Sprintf("%v", v)
+			}
+		}
+	}
+
+	return ir
+}
@@ -0,0 +1,244 @@
+package readarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// testImportListTagID is the ownership tag of the import lists in these tests
+const testImportListTagID = 7
+
+// fakeImportLists serves Readarr's import lists with their schemas and profiles,
+// recording the writes sent to it
+type fakeImportLists struct {
+	mu      sync.Mutex
+	lists   []ImportListResource
+	posted  []ImportListResource
+	puts    map[string]ImportListResource
+	deletes []string
+}
+
+func newFakeImportLists(t *testing.T) (*fakeImportLists, *httpclient.Client) {
+	fake := &fakeImportLists{
+		lists: []ImportListResource{
+			{ID: 4, Name: "nebularr-lazylibrarian", Implementation: "LazyLibrarianImport", Tags: []int{testImportListTagID}},
+			{ID: 5, Name: "nebularr-old", Implementation: "LazyLibrarianImport", Tags: []int{testImportListTagID}},
+			{ID: 6, Name: "hand made", Implementation: "LazyLibrarianImport", Tags: []int{}},
+		},
+		puts: map[string]ImportListResource{},
+	}
+	schemas := []ImportListResource{
+		{Implementation: "LazyLibrarianImport", ConfigContract: "LazyLibrarianImportSettings", ListType: "other", ListOrder: 3,
+			Fields: []FieldResource{{Name: "baseUrl"}, {Name: "apiKey"}}},
+		{Implementation: "GoodreadsListImportList", ConfigContract: "GoodreadsListImportListSettings", ListType: "goodreads", ListOrder: 1,
+			Fields: []FieldResource{{Name: "listId"}}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/importlist":
+			_ = json.NewEncoder(w).Encode(fake.lists)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/importlist/schema":
+			_ = json.NewEncoder(w).Encode(schemas)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/metadataprofile":
+			_, _ = w.Write([]byte(`[{"id":1,"name":"Standard"},{"id":3,"name":"nebularr-books"}]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/qualityprofile":
+			_, _ = w.Write([]byte(`[{"id":1,"name":"eBook"},{"id":2,"name":"Spoken"}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/importlist":
+			var list ImportListResource
+			_ = json.NewDecoder(r.Body).Decode(&list)
+			fake.posted = append(fake.posted, list)
+			_ = json.NewEncoder(w).Encode(list)
+		case r.Method == http.MethodPut:
+			var list ImportListResource
+			_ = json.NewDecoder(r.Body).Decode(&list)
+			fake.puts[r.URL.Path] = list
+			_ = json.NewEncoder(w).Encode(list)
+		case r.Method == http.MethodDelete:
+			fake.deletes = append(fake.deletes, r.URL.Path)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	a := &Adapter{}
+	return fake, a.newClient(&irv1.ConnectionIR{URL: server.URL})
+}
+
+func TestApplyImportLists(t *testing.T) {
+	fake, c := newFakeImportLists(t)
+	a := &Adapter{}
+
+	ir := &irv1.IR{ImportLists: []irv1.ImportListIR{
+		{
+			Name:               "nebularr-lazylibrarian",
+			Type:               "LazyLibrarianImport",
+			Enabled:            true,
+			QualityProfileName: "Spoken",
+			RootFolderPath:     "/books",
+			Settings:           map[string]string{"baseUrl": "http://lazylibrarian:5299", "unknown": "dropped"},
+		},
+		{
+			Name:               "nebularr-new",
+			Type:               "LazyLibrarianImport",
+			EnableAuto:         true,
+			QualityProfileName: "eBook",
+			RootFolderPath:     "/books",
+			ShouldMonitor:      "specificBook",
+		},
+	}}
+	stats, err := a.applyImportLists(context.Background(), c, ir, testImportListTagID)
+	if err != nil {
+		t.Fatalf("applyImportLists() error = %v", err)
+	}
+	if stats.Created != 1 || stats.Updated != 1 || stats.Deleted != 1 || len(stats.Errors) != 0 {
+		t.Fatalf("stats = %+v, want one created, updated and deleted", stats)
+	}
+
+	// The existing list keeps its ID; settings the schema doesn't know are left out
+	put, ok := fake.puts["/api/v1/importlist/4"]
+	if !ok {
+		t.Fatalf("expected a PUT to /api/v1/importlist/4, got %v", fake.puts)
+	}
+	want := ImportListResource{
+		ID:                4,
+		Name:              "nebularr-lazylibrarian",
+		Implementation:    "LazyLibrarianImport",
+		ConfigContract:    "LazyLibrarianImportSettings",
+		Enable:            true,
+		ShouldMonitor:     "entireAuthor",
+		MonitorNewItems:   "all",
+		RootFolderPath:    "/books",
+		QualityProfileId:  2,
+		MetadataProfileId: 3,
+		ListType:          "other",
+		ListOrder:         3,
+		Tags:              []int{testImportListTagID},
+		Fields:            []FieldResource{{Name: "baseUrl", Value: "http://lazylibrarian:5299"}},
+	}
+	if !reflect.DeepEqual(put, want) {
+		t.Errorf("put %+v, want %+v", put, want)
+	}
+
+	if len(fake.posted) != 1 {
+		t.Fatalf("expected one created list, got %+v", fake.posted)
+	}
+	created := fake.posted[0]
+	if created.Name != "nebularr-new" || created.QualityProfileId != 1 || created.ShouldMonitor != "specificBook" || !created.EnableAutomaticAdd {
+		t.Errorf("created %+v, want nebularr-new on eBook monitoring specific books", created)
+	}
+
+	// Only the tagged list missing from the spec is deleted; the hand-made one is left alone
+	if !reflect.DeepEqual(fake.deletes, []string{"/api/v1/importlist/5"}) {
+		t.Errorf("deletes = %v, want /api/v1/importlist/5", fake.deletes)
+	}
+}
+
+func TestTypedImportListFields(t *testing.T) {
+	tests := []struct {
+		name string
+		list irv1.ImportListIR
+		want []FieldResource
+	}{
+		{
+			name: "goodreads list",
+			list: irv1.ImportListIR{GoodreadsList: &irv1.GoodreadsListImportIR{ListID: 1362}},
+			want: []FieldResource{{Name: "listId", Value: 1362}},
+		},
+		{
+			name: "goodreads owned books",
+			list: irv1.ImportListIR{GoodreadsOwnedBooks: &irv1.GoodreadsSessionIR{
+				AccessToken: "token", AccessTokenSecret: "secret", UserID: "42", UserName: "reader",
+			}},
+			want: []FieldResource{
+				{Name: "accessToken", Value: "token"},
+				{Name: "accessTokenSecret", Value: "secret"},
+				{Name: "userId", Value: "42"},
+				{Name: "userName", Value: "reader"},
+			},
+		},
+		{
+			name: "lazylibrarian",
+			list: irv1.ImportListIR{LazyLibrarian: &irv1.LazyLibrarianImportIR{URL: "http://lazylibrarian:5299", APIKey: "ll-key"}},
+			want: []FieldResource{{Name: "baseUrl", Value: "http://lazylibrarian:5299"}, {Name: "apiKey", Value: "ll-key"}},
+		},
+		{
+			name: "generic settings only",
+			list: irv1.ImportListIR{Settings: map[string]string{"listId": "1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := typedImportListFields(&tt.list); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("typedImportListFields() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeImportListFields(t *testing.T) {
+	fields := []FieldResource{{Name: "baseUrl", Value: "http://old:5299"}, {Name: "apiKey", Value: "old-key"}}
+	overrides := []FieldResource{{Name: "apiKey", Value: "ll-key"}, {Name: "listId", Value: 7}}
+
+	// Typed fields replace generic settings of the same name and the rest are appended
+	want := []FieldResource{{Name: "baseUrl", Value: "http://old:5299"}, {Name: "apiKey", Value: "ll-key"}, {Name: "listId", Value: 7}}
+	if got := mergeImportListFields(fields, overrides); !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeImportListFields() = %+v, want %+v", got, want)
+	}
+}
+
+func TestApplyImportListsTypedSettings(t *testing.T) {
+	fake, c := newFakeImportLists(t)
+	a := &Adapter{}
+
+	ir := &irv1.IR{ImportLists: []irv1.ImportListIR{
+		{
+			Name:               "nebularr-lazylibrarian",
+			Type:               "LazyLibrarianImport",
+			QualityProfileName: "Spoken",
+			Settings:           map[string]string{"baseUrl": "http://generic:5299"},
+			LazyLibrarian:      &irv1.LazyLibrarianImportIR{URL: "http://lazylibrarian:5299", APIKey: "ll-key"},
+		},
+		{
+			Name:               "nebularr-goodreads",
+			Type:               "GoodreadsListImportList",
+			QualityProfileName: "Audiobook",
+			GoodreadsList:      &irv1.GoodreadsListImportIR{ListID: 1362},
+		},
+		// Still desired, so the skipped list is not deleted either
+		{
+			Name:               "nebularr-old",
+			Type:               "LazyLibrarianImport",
+			QualityProfileName: "Missing",
+		},
+	}}
+	stats, err := a.applyImportLists(context.Background(), c, ir, testImportListTagID)
+	if err != nil {
+		t.Fatalf("applyImportLists() error = %v", err)
+	}
+
+	// Lists whose quality profile isn't in Readarr are skipped and reported
+	if stats.Updated != 1 || stats.Created != 0 || stats.Skipped != 2 || len(stats.Errors) != 2 {
+		t.Fatalf("stats = %+v, want one update and two skipped lists", stats)
+	}
+	if len(fake.posted) != 0 || len(fake.deletes) != 0 {
+		t.Errorf("skipped lists were written: posted %+v, deletes %v", fake.posted, fake.deletes)
+	}
+
+	// The typed URL wins over the generic baseUrl setting
+	want := []FieldResource{{Name: "baseUrl", Value: "http://lazylibrarian:5299"}, {Name: "apiKey", Value: "ll-key"}}
+	if put := fake.puts["/api/v1/importlist/4"]; !reflect.DeepEqual(put.Fields, want) {
+		t.Errorf("fields = %+v, want %+v", put.Fields, want)
+	}
+}
@@ -0,0 +1,93 @@
+package readarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// fakeConfig serves one Readarr config resource, storing what is PUT back to it
+type fakeConfig struct {
+	mu   sync.Mutex
+	body []byte
+	puts []string
+}
+
+func newFakeConfig(t *testing.T, path, body string) (*fakeConfig, *httpclient.Client) {
+	fake := &fakeConfig{body: []byte(body)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == path:
+			_, _ = w.Write(fake.body)
+		case r.Method == http.MethodPut:
+			var config map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&config)
+			fake.body, _ = json.Marshal(config)
+			fake.puts = append(fake.puts, r.URL.Path)
+			_, _ = w.Write(fake.body)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	a := &Adapter{}
+	return fake, a.newClient(&irv1.ConnectionIR{URL: server.URL})
+}
+
+func TestApplyMediaManagement(t *testing.T) {
+	fake, c := newFakeConfig(t, mediaManagementAPIPath, `{"id":1,"recycleBin":"","fileDate":"bookReleaseDate",
+		"extraFileExtensions":"srt,nfo","minimumFreeSpaceWhenImporting":100,"watchLibraryForChanges":true,
+		"allowFingerprinting":"newFiles"}`)
+	a := &Adapter{}
+	ctx := context.Background()
+
+	ir := &irv1.MediaManagementIR{
+		RecycleBin:            "/books/.recycle",
+		RecycleBinCleanupDays: 14,
+		SetPermissions:        true,
+		ChmodFolder:           "755",
+		ChownGroup:            "media",
+		DeleteEmptyFolders:    true,
+		CreateEmptyFolders:    true,
+		UseHardlinks:          true,
+	}
+	if err := a.applyMediaManagement(ctx, c, ir); err != nil {
+		t.Fatalf("applyMediaManagement() error = %v", err)
+	}
+	if !reflect.DeepEqual(fake.puts, []string{mediaManagementAPIPath + "/1"}) {
+		t.Fatalf("puts = %v, want one PUT to %s/1", fake.puts, mediaManagementAPIPath)
+	}
+
+	// Fields the spec doesn't manage are sent back as read
+	var put MediaManagementConfigResource
+	if err := json.Unmarshal(fake.body, &put); err != nil {
+		t.Fatal(err)
+	}
+	if put.FileDate != "bookReleaseDate" || put.ExtraFileExtensions != "srt,nfo" || put.MinimumFreeSpaceWhenImporting != 100 {
+		t.Errorf("unmanaged fields not preserved: %+v", put)
+	}
+
+	// Unset library watching and fingerprinting keep their current values, so the
+	// config reads back as what was applied
+	got, err := a.getMediaManagementIR(ctx, c)
+	if err != nil {
+		t.Fatalf("getMediaManagementIR() error = %v", err)
+	}
+	watch := true
+	want := *ir
+	want.WatchLibraryForChanges = &watch
+	want.AllowFingerprinting = "newFiles"
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("read back %+v, want %+v", *got, want)
+	}
+}
@@ -0,0 +1,69 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+const namingAPIPath = "/api/v1/config/naming"
+
+// getNamingIR converts the current naming config to IR format
+func (a *Adapter) getNamingIR(ctx context.Context, c *httpclient.Client) (*irv1.ReadarrNamingIR, error) {
+	naming, err := shared.FetchConfig[NamingConfigResource](ctx, c, namingAPIPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &irv1.ReadarrNamingIR{
+		RenameBooks:              naming.RenameBooks,
+		ReplaceIllegalCharacters: naming.ReplaceIllegalCharacters,
+		ColonReplacementFormat:   naming.ColonReplacementFormat,
+		StandardBookFormat:       naming.StandardBookFormat,
+		AuthorFolderFormat:       naming.AuthorFolderFormat,
+	}, nil
+}
+
+// diffNaming computes changes needed for the naming config.
+// Naming is only managed when the spec sets it.
+func (a *Adapter) diffNaming(current, desired *irv1.IR, changes *adapters.ChangeSet) {
+	if desired.Naming == nil || desired.Naming.Readarr == nil {
+		return
+	}
+	desiredNaming := desired.Naming.Readarr
+
+	var currentNaming *irv1.ReadarrNamingIR
+	if current.Naming != nil {
+		currentNaming = current.Naming.Readarr
+	}
+	if currentNaming != nil && *currentNaming == *desiredNaming {
+		return
+	}
+
+	// The naming config always exists, so it is only ever updated
+	changes.Updates = append(changes.Updates, adapters.Change{
+		ResourceType: adapters.ResourceNamingConfig,
+		Name:         "naming",
+		Payload:      desiredNaming,
+	})
+}
+
+// updateNaming updates the managed naming fields, keeping the others
+func (a *Adapter) updateNaming(ctx context.Context, c *httpclient.Client, naming *irv1.ReadarrNamingIR) error {
+	current, err := shared.FetchConfig[NamingConfigResource](ctx, c, namingAPIPath)
+	if err != nil {
+		return fmt.Errorf("failed to get current naming config: %w", err)
+	}
+
+	current.RenameBooks = naming.RenameBooks
+	current.ReplaceIllegalCharacters = naming.ReplaceIllegalCharacters
+	current.ColonReplacementFormat = naming.ColonReplacementFormat
+	current.StandardBookFormat = naming.StandardBookFormat
+	current.AuthorFolderFormat = naming.AuthorFolderFormat
+
+	return shared.UpdateConfig(ctx, c, namingAPIPath, current.ID, *current)
+}
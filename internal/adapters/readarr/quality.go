@@ -9,6 +9,20 @@ import (
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
+// getQualityProfileIDs maps the names of all quality profiles to their IDs
+func (a *Adapter) getQualityProfileIDs(ctx context.Context, c *httpclient.Client) (map[string]int, error) {
+	var profiles []QualityProfileResource
+	if err := c.Get(ctx, "/api/v1/qualityprofile", &profiles); err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]int, len(profiles))
+	for _, p := range profiles {
+		ids[p.Name] = p.ID
+	}
+	return ids, nil
+}
+
 // getManagedQualityProfiles retrieves quality profiles that are managed by Nebularr.
 // Quality profiles in Readarr don't have tags, so we identify managed profiles by name prefix.
 func (a *Adapter) getManagedQualityProfiles(ctx context.Context, c *httpclient.Client) ([]*irv1.BookQualityIR, error) {
@@ -35,6 +49,7 @@ func (a *Adapter) getManagedQualityProfiles(ctx context.Context, c *httpclient.C
 // qualityProfileToIR converts a Readarr quality profile to IR
 func (a *Adapter) qualityProfileToIR(profile *QualityProfileResource) *irv1.BookQualityIR {
 	ir := &irv1.BookQualityIR{
+		ID:             profile.ID,
 		ProfileName:    profile.Name,
 		UpgradeAllowed: profile.UpgradeAllowed,
 	}
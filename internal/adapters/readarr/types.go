@@ -112,17 +112,22 @@ type NamingConfigResource struct {
 
 // ImportListResource represents an import list in Readarr
 type ImportListResource struct {
-	ID                 int             `json:"id"`
+	ID                 int             `json:"id,omitempty"`
 	Name               string          `json:"name"`
 	Implementation     string          `json:"implementation"`
+	ConfigContract     string          `json:"configContract"`
 	Enable             bool            `json:"enable"`
 	EnableAutomaticAdd bool            `json:"enableAutomaticAdd"`
-	ShouldMonitor      string          `json:"shouldMonitor"`
+	ShouldMonitor      string          `json:"shouldMonitor"`   // none, specificBook, entireAuthor
+	ShouldSearch       bool            `json:"shouldSearch"`    // search for new books when added
+	MonitorNewItems    string          `json:"monitorNewItems"` // none, new, all
 	RootFolderPath     string          `json:"rootFolderPath"`
 	QualityProfileId   int             `json:"qualityProfileId"`
 	MetadataProfileId  int             `json:"metadataProfileId"`
-	Tags               []int           `json:"tags,omitempty"`
-	Fields             []FieldResource `json:"fields,omitempty"`
+	ListType           string          `json:"listType,omitempty"`
+	ListOrder          int             `json:"listOrder"`
+	Tags               []int           `json:"tags"`
+	Fields             []FieldResource `json:"fields"`
 }
 
 // NotificationResource represents a notification in Readarr
@@ -161,3 +166,7 @@ type QualityDefinitionResource struct {
 	MinSize float64         `json:"minSize,omitempty"`
 	MaxSize float64         `json:"maxSize,omitempty"`
 }
+
+// DelayProfileResource represents a Readarr delay profile
+// Type alias to shared base type
+type DelayProfileResource = shared.BaseDelayProfileResource
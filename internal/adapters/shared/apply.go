@@ -2,6 +2,8 @@
 package shared
 
 import (
+	"time"
+
 	"github.com/poiley/nebularr-operator/internal/adapters"
 )
 
@@ -15,7 +17,8 @@ type ApplyFunc func(change adapters.Change) error
 // respective slice. Each callback should handle the adapter-specific logic for that
 // operation type.
 //
-// Returns an ApplyResult with counts of applied/failed operations and any errors.
+// Returns an ApplyResult with counts of applied/failed operations, any errors and
+// the time each change took.
 func ApplyChanges(
 	changes *adapters.ChangeSet,
 	createFn ApplyFunc,
@@ -24,44 +27,30 @@ func ApplyChanges(
 ) *adapters.ApplyResult {
 	result := &adapters.ApplyResult{}
 
-	// Apply creates
-	for _, change := range changes.Creates {
-		if err := createFn(change); err != nil {
-			result.Failed++
-			result.Errors = append(result.Errors, adapters.ApplyError{
-				Change: change,
-				Error:  err,
-			})
-		} else {
-			result.Applied++
-		}
-	}
-
-	// Apply updates
-	for _, change := range changes.Updates {
-		if err := updateFn(change); err != nil {
-			result.Failed++
-			result.Errors = append(result.Errors, adapters.ApplyError{
-				Change: change,
-				Error:  err,
+	apply := func(action string, changes []adapters.Change, fn ApplyFunc) {
+		for _, change := range changes {
+			start := time.Now()
+			err := fn(change)
+			result.Durations = append(result.Durations, adapters.ChangeDuration{
+				ResourceType: change.ResourceType,
+				Action:       action,
+				Duration:     time.Since(start),
 			})
-		} else {
-			result.Applied++
+			if err != nil {
+				result.Failed++
+				result.Errors = append(result.Errors, adapters.ApplyError{
+					Change: change,
+					Error:  err,
+				})
+			} else {
+				result.Applied++
+			}
 		}
 	}
 
-	// Apply deletes
-	for _, change := range changes.Deletes {
-		if err := deleteFn(change); err != nil {
-			result.Failed++
-			result.Errors = append(result.Errors, adapters.ApplyError{
-				Change: change,
-				Error:  err,
-			})
-		} else {
-			result.Applied++
-		}
-	}
+	apply("create", changes.Creates, createFn)
+	apply("update", changes.Updates, updateFn)
+	apply("delete", changes.Deletes, deleteFn)
 
 	return result
 }
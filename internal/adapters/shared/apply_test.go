@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+)
+
+func TestApplyChangesRecordsDurations(t *testing.T) {
+	changes := &adapters.ChangeSet{
+		Creates: []adapters.Change{{ResourceType: adapters.ResourceIndexer, Name: "a"}},
+		Updates: []adapters.Change{{ResourceType: adapters.ResourceCustomFormat, Name: "b"}},
+		Deletes: []adapters.Change{{ResourceType: adapters.ResourceDownloadClient, Name: "c"}},
+	}
+	ok := func(adapters.Change) error { return nil }
+	fail := func(adapters.Change) error { return errors.New("boom") }
+
+	result := ApplyChanges(changes, ok, fail, ok)
+
+	if result.Applied != 2 || result.Failed != 1 {
+		t.Errorf("applied=%d failed=%d, want 2 and 1", result.Applied, result.Failed)
+	}
+	expected := []struct{ resourceType, action string }{
+		{adapters.ResourceIndexer, "create"},
+		{adapters.ResourceCustomFormat, "update"},
+		{adapters.ResourceDownloadClient, "delete"},
+	}
+	if len(result.Durations) != len(expected) {
+		t.Fatalf("got %d durations, want %d", len(result.Durations), len(expected))
+	}
+	for i, e := range expected {
+		d := result.Durations[i]
+		if d.ResourceType != e.resourceType || d.Action != e.action {
+			t.Errorf("durations[%d] = %s/%s, want %s/%s", i, d.ResourceType, d.Action, e.resourceType, e.action)
+		}
+	}
+}
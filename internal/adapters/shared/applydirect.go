@@ -22,15 +22,23 @@ type ImportListStats struct {
 type DirectApplyCallbacks struct {
 	// ApplyImportLists applies import lists and returns stats
 	ApplyImportLists func() (*ImportListStats, error)
+	// ApplyImportListOptions applies the global import list options (Radarr/Sonarr)
+	ApplyImportListOptions func() error
 	// ApplyMediaManagement applies media management config
 	ApplyMediaManagement func() error
+	// ApplyCollections applies collection settings (Radarr)
+	ApplyCollections func() error
 	// ApplyAuthentication applies authentication config
 	ApplyAuthentication func() error
+	// ApplyUIConfig applies the UI settings (Radarr/Sonarr)
+	ApplyUIConfig func() error
+	// ApplyQualityDefinitions applies quality definition size limits
+	ApplyQualityDefinitions func() error
 }
 
 // ApplyDirect applies configuration directly from IR using the provided callbacks.
 // This handles the common pattern of applying import lists, media management,
-// and authentication with proper result tracking.
+// authentication, and quality definitions with proper result tracking.
 func ApplyDirect(ir *irv1.IR, callbacks DirectApplyCallbacks) *adapters.ApplyResult {
 	result := &adapters.ApplyResult{}
 
@@ -56,6 +64,19 @@ func ApplyDirect(ir *irv1.IR, callbacks DirectApplyCallbacks) *adapters.ApplyRes
 		}
 	}
 
+	// Apply import list options if callback provided and config exists
+	if callbacks.ApplyImportListOptions != nil && ir.ImportListOptions != nil {
+		if err := callbacks.ApplyImportListOptions(); err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, adapters.ApplyError{
+				Change: adapters.Change{ResourceType: adapters.ResourceImportListOptions},
+				Error:  fmt.Errorf("failed to apply import list options: %w", err),
+			})
+		} else {
+			result.Applied++
+		}
+	}
+
 	// Apply media management if callback provided and config exists
 	if callbacks.ApplyMediaManagement != nil && ir.MediaManagement != nil {
 		if err := callbacks.ApplyMediaManagement(); err != nil {
@@ -69,6 +90,19 @@ func ApplyDirect(ir *irv1.IR, callbacks DirectApplyCallbacks) *adapters.ApplyRes
 		}
 	}
 
+	// Apply collections if callback provided and config exists
+	if callbacks.ApplyCollections != nil && ir.Collections != nil {
+		if err := callbacks.ApplyCollections(); err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, adapters.ApplyError{
+				Change: adapters.Change{ResourceType: adapters.ResourceCollection},
+				Error:  err,
+			})
+		} else {
+			result.Applied++
+		}
+	}
+
 	// Apply authentication if callback provided and config exists
 	if callbacks.ApplyAuthentication != nil && ir.Authentication != nil {
 		if err := callbacks.ApplyAuthentication(); err != nil {
@@ -82,5 +116,31 @@ func ApplyDirect(ir *irv1.IR, callbacks DirectApplyCallbacks) *adapters.ApplyRes
 		}
 	}
 
+	// Apply UI settings if callback provided and config exists
+	if callbacks.ApplyUIConfig != nil && ir.UI != nil {
+		if err := callbacks.ApplyUIConfig(); err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, adapters.ApplyError{
+				Change: adapters.Change{ResourceType: adapters.ResourceUIConfig},
+				Error:  fmt.Errorf("failed to apply UI settings: %w", err),
+			})
+		} else {
+			result.Applied++
+		}
+	}
+
+	// Apply quality definitions if callback provided and there are definitions
+	if callbacks.ApplyQualityDefinitions != nil && len(ir.QualityDefinitions) > 0 {
+		if err := callbacks.ApplyQualityDefinitions(); err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, adapters.ApplyError{
+				Change: adapters.Change{ResourceType: adapters.ResourceQualityDefinition},
+				Error:  fmt.Errorf("failed to apply quality definitions: %w", err),
+			})
+		} else {
+			result.Applied++
+		}
+	}
+
 	return result
 }
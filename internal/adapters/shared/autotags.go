@@ -0,0 +1,239 @@
+// Package shared provides common functionality used across multiple *arr adapters.
+package shared
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// AutoTagName returns the name of an auto-tagging rule of the config whose
+// ownership tag label is ownerTag. Rules carry no ownership tag (their tags are
+// applied to media), so the name, starting with the label, identifies the config.
+func AutoTagName(ownerTag, name string) string {
+	return ownerTag + "-" + name
+}
+
+// IsManagedAutoTag reports whether an auto-tagging rule belongs to the config
+// whose ownership tag label is ownerTag
+func IsManagedAutoTag(name, ownerTag string) bool {
+	return strings.HasPrefix(strings.ToLower(name), strings.ToLower(ownerTag)+"-")
+}
+
+// AutoTaggingToIR converts an auto-tagging rule to IR, labeling its tag IDs
+func AutoTaggingToIR(r *AutoTaggingResource, labels map[int]string) irv1.AutoTagIR {
+	ir := irv1.AutoTagIR{
+		ID:                      r.ID,
+		Name:                    r.Name,
+		RemoveTagsAutomatically: r.RemoveTagsAutomatically,
+		Specifications:          make([]irv1.AutoTagSpecIR, 0, len(r.Specifications)),
+	}
+	for _, id := range r.Tags {
+		if label, ok := labels[id]; ok {
+			ir.TagNames = append(ir.TagNames, label)
+		}
+	}
+
+	for _, spec := range r.Specifications {
+		specIR := irv1.AutoTagSpecIR{
+			Type:     spec.Implementation,
+			Name:     spec.Name,
+			Negate:   spec.Negate,
+			Required: spec.Required,
+		}
+		for _, field := range spec.Fields {
+			setAutoTagField(&specIR, field)
+		}
+		ir.Specifications = append(ir.Specifications, specIR)
+	}
+
+	return ir
+}
+
+// IRToAutoTagging converts IR to an auto-tagging rule applying tagIDs
+func IRToAutoTagging(ir *irv1.AutoTagIR, tagIDs []int) AutoTaggingResource {
+	r := AutoTaggingResource{
+		ID:                      ir.ID,
+		Name:                    ir.Name,
+		RemoveTagsAutomatically: ir.RemoveTagsAutomatically,
+		Tags:                    tagIDs,
+		Specifications:          make([]AutoTaggingSpecification, 0, len(ir.Specifications)),
+	}
+
+	for _, spec := range ir.Specifications {
+		r.Specifications = append(r.Specifications, AutoTaggingSpecification{
+			Name:           spec.Name,
+			Implementation: spec.Type,
+			Negate:         spec.Negate,
+			Required:       spec.Required,
+			Fields:         autoTagFields(spec),
+		})
+	}
+
+	return r
+}
+
+// autoTagFields builds the fields of a specification.
+// List values are sent as arrays, and numeric values as numbers.
+func autoTagFields(spec irv1.AutoTagSpecIR) []Field {
+	fields := []Field{}
+	switch {
+	case len(spec.Values) > 0:
+		fields = append(fields, Field{Name: "value", Value: spec.Values})
+	case spec.Value != "":
+		if n, err := strconv.Atoi(spec.Value); err == nil {
+			fields = append(fields, Field{Name: "value", Value: n})
+		} else {
+			fields = append(fields, Field{Name: "value", Value: spec.Value})
+		}
+	}
+	if spec.Min != nil {
+		fields = append(fields, Field{Name: "min", Value: *spec.Min})
+	}
+	if spec.Max != nil {
+		fields = append(fields, Field{Name: "max", Value: *spec.Max})
+	}
+	return fields
+}
+
+// setAutoTagField reads a field returned by the app into spec
+func setAutoTagField(spec *irv1.AutoTagSpecIR, field Field) {
+	switch field.Name {
+	case "value":
+		switch v := field.Value.(type) {
+		case nil:
+			// Specifications such as MonitoredSpecification have no value
+		case []interface{}:
+			for _, item := range v {
+				spec.Values = append(spec.Values, fmt.Sprintf("%v", item))
+			}
+		case float64:
+			spec.Value = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			spec.Value = fmt.Sprintf("%v", v)
+		}
+	case "min", "max":
+		v, ok := field.Value.(float64)
+		if !ok {
+			return
+		}
+		n := int(v)
+		if field.Name == "min" {
+			spec.Min = &n
+		} else {
+			spec.Max = &n
+		}
+	}
+}
+
+// DiffAutoTags computes changes needed for auto-tagging rules.
+// Rules are matched by name. current should only hold rules managed by Nebularr,
+// so rules created by hand are never deleted.
+func DiffAutoTags(current, desired []irv1.AutoTagIR, changes *adapters.ChangeSet) {
+	currentByName := make(map[string]irv1.AutoTagIR, len(current))
+	for _, at := range current {
+		currentByName[at.Name] = at
+	}
+
+	desiredNames := make(map[string]bool, len(desired))
+	for _, at := range desired {
+		if desiredNames[at.Name] {
+			continue
+		}
+		desiredNames[at.Name] = true
+
+		existing, exists := currentByName[at.Name]
+		if !exists {
+			payload := at // Copy to avoid pointer issues
+			changes.Creates = append(changes.Creates, adapters.Change{
+				ResourceType: adapters.ResourceAutoTag,
+				Name:         at.Name,
+				Payload:      &payload,
+			})
+		} else if !AutoTagsEqual(existing, at) {
+			updated := at
+			updated.ID = existing.ID
+			changes.Updates = append(changes.Updates, adapters.Change{
+				ResourceType: adapters.ResourceAutoTag,
+				Name:         at.Name,
+				ID:           IntPtr(existing.ID),
+				Payload:      &updated,
+			})
+		}
+	}
+
+	for _, at := range current {
+		if desiredNames[at.Name] {
+			continue
+		}
+		changes.Deletes = append(changes.Deletes, adapters.Change{
+			ResourceType: adapters.ResourceAutoTag,
+			Name:         at.Name,
+			ID:           IntPtr(at.ID),
+		})
+	}
+}
+
+// AutoTagsEqual compares two auto-tagging rules.
+// Tag labels are compared case-insensitively and specifications by name.
+func AutoTagsEqual(current, desired irv1.AutoTagIR) bool {
+	if current.Name != desired.Name ||
+		current.RemoveTagsAutomatically != desired.RemoveTagsAutomatically ||
+		len(current.Specifications) != len(desired.Specifications) {
+		return false
+	}
+	if !tagLabelsEqual(current.TagNames, desired.TagNames) {
+		return false
+	}
+
+	currentSpecs := make(map[string]irv1.AutoTagSpecIR, len(current.Specifications))
+	for _, spec := range current.Specifications {
+		currentSpecs[spec.Name] = spec
+	}
+	for _, spec := range desired.Specifications {
+		cur, ok := currentSpecs[spec.Name]
+		if !ok || !autoTagSpecsEqual(cur, spec) {
+			return false
+		}
+	}
+	return true
+}
+
+// autoTagSpecsEqual compares two auto-tagging specifications
+func autoTagSpecsEqual(current, desired irv1.AutoTagSpecIR) bool {
+	return current.Type == desired.Type &&
+		current.Negate == desired.Negate &&
+		current.Required == desired.Required &&
+		current.Value == desired.Value &&
+		slices.Equal(current.Values, desired.Values) &&
+		intPtrsEqual(current.Min, desired.Min) &&
+		intPtrsEqual(current.Max, desired.Max)
+}
+
+// tagLabelsEqual compares two sets of tag labels case-insensitively
+func tagLabelsEqual(a, b []string) bool {
+	normalize := func(labels []string) []string {
+		out := make([]string, 0, len(labels))
+		for _, l := range labels {
+			l = strings.ToLower(l)
+			if !slices.Contains(out, l) {
+				out = append(out, l)
+			}
+		}
+		slices.Sort(out)
+		return out
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
+
+// intPtrsEqual compares two optional integers
+func intPtrsEqual(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
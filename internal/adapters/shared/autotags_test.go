@@ -0,0 +1,207 @@
+package shared
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestIsManagedAutoTag(t *testing.T) {
+	ownerTag := ConfigOwnershipTag("media", "tv")
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: AutoTagName(ownerTag, "anime"), want: true},
+		{name: strings.ToUpper(AutoTagName(ownerTag, "anime")), want: true},
+		{name: AutoTagName(ConfigOwnershipTag("media-tv", "x"), "anime")},
+		{name: AutoTagName(ConfigOwnershipTag("other", "tv"), "anime")},
+		{name: "nebularr-tv-anime"},
+		{name: ownerTag},
+		{name: "Anime"},
+	}
+	for _, tt := range tests {
+		if got := IsManagedAutoTag(tt.name, ownerTag); got != tt.want {
+			t.Errorf("IsManagedAutoTag(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDiffAutoTags(t *testing.T) {
+	rule := func(id int, name string, tags ...string) irv1.AutoTagIR {
+		return irv1.AutoTagIR{
+			ID:       id,
+			Name:     name,
+			TagNames: tags,
+			Specifications: []irv1.AutoTagSpecIR{
+				{Type: "GenreSpecification", Name: "Anime", Values: []string{"Anime"}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		current     []irv1.AutoTagIR
+		desired     []irv1.AutoTagIR
+		wantCreates []string
+		wantUpdates []string
+		wantDeletes []int
+	}{
+		{
+			name:    "in sync",
+			current: []irv1.AutoTagIR{rule(1, "nebularr-main-anime", "Anime")},
+			desired: []irv1.AutoTagIR{rule(0, "nebularr-main-anime", "anime")},
+		},
+		{
+			name:        "new rule is created",
+			desired:     []irv1.AutoTagIR{rule(0, "nebularr-main-anime", "anime")},
+			wantCreates: []string{"nebularr-main-anime"},
+		},
+		{
+			name:        "changed tags update the rule",
+			current:     []irv1.AutoTagIR{rule(1, "nebularr-main-anime", "anime")},
+			desired:     []irv1.AutoTagIR{rule(0, "nebularr-main-anime", "anime", "kids")},
+			wantUpdates: []string{"nebularr-main-anime"},
+		},
+		{
+			name:        "undeclared rule is deleted",
+			current:     []irv1.AutoTagIR{rule(1, "nebularr-main-anime", "anime"), rule(2, "nebularr-main-old", "old")},
+			desired:     []irv1.AutoTagIR{rule(0, "nebularr-main-anime", "anime")},
+			wantDeletes: []int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := &adapters.ChangeSet{}
+			DiffAutoTags(tt.current, tt.desired, changes)
+
+			var creates, updates []string
+			var deletes []int
+			for _, c := range changes.Creates {
+				creates = append(creates, c.Name)
+			}
+			for _, c := range changes.Updates {
+				updates = append(updates, c.Name)
+				if got := c.Payload.(*irv1.AutoTagIR).ID; got != *c.ID {
+					t.Errorf("update payload ID = %d, want %d", got, *c.ID)
+				}
+			}
+			for _, c := range changes.Deletes {
+				deletes = append(deletes, *c.ID)
+			}
+
+			if !reflect.DeepEqual(creates, tt.wantCreates) {
+				t.Errorf("creates = %v, want %v", creates, tt.wantCreates)
+			}
+			if !reflect.DeepEqual(updates, tt.wantUpdates) {
+				t.Errorf("updates = %v, want %v", updates, tt.wantUpdates)
+			}
+			if !reflect.DeepEqual(deletes, tt.wantDeletes) {
+				t.Errorf("deletes = %v, want %v", deletes, tt.wantDeletes)
+			}
+		})
+	}
+}
+
+func TestAutoTaggingRoundTrip(t *testing.T) {
+	minYear, maxYear := 1980, 1989
+	ir := irv1.AutoTagIR{
+		ID:                      3,
+		Name:                    "nebularr-main-eighties",
+		RemoveTagsAutomatically: true,
+		TagNames:                []string{"80s"},
+		Specifications: []irv1.AutoTagSpecIR{
+			{Type: "YearSpecification", Name: "Eighties", Required: true, Min: &minYear, Max: &maxYear},
+			{Type: "GenreSpecification", Name: "Not horror", Negate: true, Values: []string{"Horror"}},
+			{Type: "RootFolderSpecification", Name: "Movies", Value: "/movies"},
+			{Type: "QualityProfileSpecification", Name: "HD", Value: "4"},
+			{Type: "MonitoredSpecification", Name: "Monitored"},
+		},
+	}
+
+	// Round-trip through JSON the way the app returns it
+	body, err := json.Marshal(IRToAutoTagging(&ir, []int{7}))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var resource AutoTaggingResource
+	if err := json.Unmarshal(body, &resource); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	got := AutoTaggingToIR(&resource, map[int]string{7: "80s"})
+	if !reflect.DeepEqual(got, ir) {
+		t.Errorf("round trip = %+v, want %+v", got, ir)
+	}
+	if !AutoTagsEqual(got, ir) {
+		t.Error("AutoTagsEqual reported a difference after round trip")
+	}
+}
+
+func TestAutoTagsEqual(t *testing.T) {
+	one, two := 1, 2
+	base := func() irv1.AutoTagIR {
+		return irv1.AutoTagIR{
+			Name:     "nebularr-main-kids",
+			TagNames: []string{"kids", "family"},
+			Specifications: []irv1.AutoTagSpecIR{
+				{Type: "GenreSpecification", Name: "Family", Values: []string{"Family", "Animation"}},
+				{Type: "YearSpecification", Name: "Recent", Min: &one},
+			},
+		}
+	}
+
+	// The app returns rules in its own order and tag labels lowercase
+	reordered := base()
+	reordered.TagNames = []string{"Family", "KIDS", "kids"}
+	reordered.Specifications = []irv1.AutoTagSpecIR{base().Specifications[1], base().Specifications[0]}
+	if !AutoTagsEqual(base(), reordered) {
+		t.Error("rules differing only in spec order and tag case should be equal")
+	}
+
+	changes := map[string]func(*irv1.AutoTagIR){
+		"remove tags automatically": func(r *irv1.AutoTagIR) { r.RemoveTagsAutomatically = true },
+		"tag added":                 func(r *irv1.AutoTagIR) { r.TagNames = append(r.TagNames, "anime") },
+		"spec negated":              func(r *irv1.AutoTagIR) { r.Specifications[0].Negate = true },
+		"spec required":             func(r *irv1.AutoTagIR) { r.Specifications[0].Required = true },
+		"value order":               func(r *irv1.AutoTagIR) { r.Specifications[0].Values = []string{"Animation", "Family"} },
+		"min changed":               func(r *irv1.AutoTagIR) { r.Specifications[1].Min = &two },
+		"max added":                 func(r *irv1.AutoTagIR) { r.Specifications[1].Max = &two },
+		"spec renamed":              func(r *irv1.AutoTagIR) { r.Specifications[1].Name = "Newer" },
+		"spec removed":              func(r *irv1.AutoTagIR) { r.Specifications = r.Specifications[:1] },
+	}
+	for name, change := range changes {
+		desired := base()
+		change(&desired)
+		if AutoTagsEqual(base(), desired) {
+			t.Errorf("%s: expected a difference", name)
+		}
+	}
+}
+
+func TestDiffAutoTagsDuplicateNames(t *testing.T) {
+	first := irv1.AutoTagIR{Name: "nebularr-main-anime", TagNames: []string{"anime"}}
+	second := irv1.AutoTagIR{Name: "nebularr-main-anime", TagNames: []string{"cartoons"}}
+
+	// Only the first declaration of a name is applied
+	changes := &adapters.ChangeSet{}
+	DiffAutoTags(nil, []irv1.AutoTagIR{first, second}, changes)
+	if len(changes.Creates) != 1 || !reflect.DeepEqual(changes.Creates[0].Payload.(*irv1.AutoTagIR).TagNames, []string{"anime"}) {
+		t.Errorf("creates = %+v, want only the first rule", changes.Creates)
+	}
+}
+
+func TestSetAutoTagFieldIgnoresNonNumericBounds(t *testing.T) {
+	spec := irv1.AutoTagSpecIR{}
+	setAutoTagField(&spec, Field{Name: "min", Value: "1980"})
+	setAutoTagField(&spec, Field{Name: "value", Value: nil})
+	setAutoTagField(&spec, Field{Name: "value", Value: true})
+	if spec.Min != nil || spec.Value != "true" || spec.Values != nil {
+		t.Errorf("spec = %+v, want no min and the value true", spec)
+	}
+}
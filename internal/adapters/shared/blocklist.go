@@ -0,0 +1,52 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+// blocklistPageSize is the number of entries requested per blocklist page
+const blocklistPageSize = 250
+
+// blocklistPage is one page of the paged blocklist endpoint
+type blocklistPage struct {
+	TotalRecords int `json:"totalRecords"`
+	Records      []struct {
+		ID          int       `json:"id"`
+		SourceTitle string    `json:"sourceTitle"`
+		Date        time.Time `json:"date"`
+	} `json:"records"`
+}
+
+// GetBlocklist pages through an app's blocklist.
+// apiVersion should be "v1" or "v3" depending on the service.
+func GetBlocklist(ctx context.Context, c *httpclient.Client, apiVersion string) ([]adapters.BlocklistEntry, error) {
+	var entries []adapters.BlocklistEntry
+	for page := 1; ; page++ {
+		var p blocklistPage
+		endpoint := fmt.Sprintf("/api/%s/blocklist?page=%d&pageSize=%d&sortKey=date&sortDirection=ascending",
+			apiVersion, page, blocklistPageSize)
+		if err := c.Get(ctx, endpoint, &p); err != nil {
+			return nil, fmt.Errorf("failed to get blocklist: %w", err)
+		}
+		for _, r := range p.Records {
+			entries = append(entries, adapters.BlocklistEntry{ID: r.ID, SourceTitle: r.SourceTitle, Date: r.Date})
+		}
+		if len(p.Records) < blocklistPageSize || len(entries) >= p.TotalRecords {
+			return entries, nil
+		}
+	}
+}
+
+// RemoveBlocklistEntry removes one blocklist entry.
+// apiVersion should be "v1" or "v3" depending on the service.
+func RemoveBlocklistEntry(ctx context.Context, c *httpclient.Client, apiVersion string, id int) error {
+	if err := c.Delete(ctx, fmt.Sprintf("/api/%s/blocklist/%d", apiVersion, id)); err != nil {
+		return fmt.Errorf("failed to remove blocklist entry %d: %w", id, err)
+	}
+	return nil
+}
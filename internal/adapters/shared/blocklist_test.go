@@ -0,0 +1,62 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+func TestBlocklist(t *testing.T) {
+	// 300 entries span two pages
+	const total = 300
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, r.URL.Path)
+			return
+		}
+		if r.URL.Path != "/api/v3/blocklist" {
+			http.NotFound(w, r)
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		var records []map[string]interface{}
+		for id := (page-1)*size + 1; id <= total && id <= page*size; id++ {
+			records = append(records, map[string]interface{}{
+				"id":          id,
+				"sourceTitle": fmt.Sprintf("Release.%d", id),
+				"date":        time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"totalRecords": total, "records": records})
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL})
+
+	entries, err := GetBlocklist(context.Background(), c, "v3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != total || entries[0].ID != 1 || entries[total-1].SourceTitle != "Release.300" {
+		t.Fatalf("expected %d entries across pages, got %d", total, len(entries))
+	}
+	if entries[0].Date.IsZero() {
+		t.Error("expected the entry date to be decoded")
+	}
+
+	if err := RemoveBlocklistEntry(context.Background(), c, "v3", 42); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"/api/v3/blocklist/42"}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("expected %v, got %v", want, deleted)
+	}
+}
@@ -0,0 +1,55 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+// ConfigPatch edits a singleton config resource (such as /config/ui) read as a
+// generic map. The config is sent back as read with only the fields passed to
+// Set replaced, so fields the operator doesn't manage and fields of other app
+// versions keep their value.
+type ConfigPatch struct {
+	path    string
+	id      int
+	fields  map[string]interface{}
+	changed bool
+}
+
+// FetchConfigPatch reads the config at path. name describes the config in errors.
+func FetchConfigPatch(ctx context.Context, c *httpclient.Client, path, name string) (*ConfigPatch, error) {
+	current, err := FetchConfig[map[string]interface{}](ctx, c, path)
+	if err != nil {
+		return nil, err
+	}
+	id, ok := (*current)["id"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("%s has no ID", name)
+	}
+	return &ConfigPatch{path: path, id: int(id), fields: *current}, nil
+}
+
+// Has reports whether the config read has the field key
+func (p *ConfigPatch) Has(key string) bool {
+	_, ok := p.fields[key]
+	return ok
+}
+
+// Set replaces the field key with value, marking the patch changed if it differs
+func (p *ConfigPatch) Set(key string, value interface{}) {
+	// Numbers decode as float64, so compare the printed values
+	if fmt.Sprint(p.fields[key]) != fmt.Sprint(value) {
+		p.fields[key] = value
+		p.changed = true
+	}
+}
+
+// Apply sends the config back if any field changed
+func (p *ConfigPatch) Apply(ctx context.Context, c *httpclient.Client) error {
+	if !p.changed {
+		return nil
+	}
+	return UpdateConfig(ctx, c, p.path, p.id, p.fields)
+}
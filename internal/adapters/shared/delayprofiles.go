@@ -2,75 +2,162 @@
 package shared
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
+// DefaultDelayProfileID is the built-in delay profile. It always exists, has no
+// tags, applies to everything no other profile matches and is always evaluated last.
+const DefaultDelayProfileID = 1
+
+// DelayProfileKey identifies a delay profile by its tag set.
+// The apps allow one profile per tag set, so the tags are the profile's identity.
+func DelayProfileKey(tagNames []string) string {
+	keys := make([]string, 0, len(tagNames))
+	for _, name := range tagNames {
+		keys = append(keys, strings.ToLower(name))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// LabelDelayProfileTags fills TagNames of profiles read from the app from their tag IDs
+func LabelDelayProfileTags(profiles []irv1.DelayProfileIR, labels map[int]string) {
+	for i := range profiles {
+		profiles[i].TagNames = nil
+		for _, id := range profiles[i].Tags {
+			if label, ok := labels[id]; ok {
+				profiles[i].TagNames = append(profiles[i].TagNames, label)
+			}
+		}
+	}
+}
+
 // DiffDelayProfiles computes changes needed for delay profiles.
-// Delay profiles are keyed by Order (priority), not name.
-// Order 1 is the default profile that always exists and should not be deleted.
+// Profiles are matched by tag set; a desired profile without tags configures the
+// default profile. Declared order is enforced with a DelayProfileOrder update,
+// emitted only when the resulting order would differ from the declared one.
+// Nothing is changed when no delay profiles are declared.
 func DiffDelayProfiles(
 	current []irv1.DelayProfileIR,
 	desired []irv1.DelayProfileIR,
 	changes *adapters.ChangeSet,
 ) {
-	// Build maps for comparison
-	currentByOrder := make(map[int]irv1.DelayProfileIR)
-	for _, p := range current {
-		currentByOrder[p.Order] = p
+	if len(desired) == 0 {
+		return
 	}
 
-	desiredByOrder := make(map[int]irv1.DelayProfileIR)
-	for _, p := range desired {
-		desiredByOrder[p.Order] = p
+	currentKeys := make([]string, len(current))
+	currentByKey := make(map[string]irv1.DelayProfileIR)
+	for i, p := range current {
+		key := DelayProfileKey(p.TagNames)
+		if p.ID != DefaultDelayProfileID && key == "" {
+			// A non-default profile whose tags no longer exist never matches
+			key = fmt.Sprintf("#%d", p.ID)
+		}
+		currentKeys[i] = key
+		currentByKey[key] = p
 	}
 
-	// Find creates and updates
-	for _, dp := range desired {
-		currentDP, exists := currentByOrder[dp.Order]
+	ordered := make([]irv1.DelayProfileIR, len(desired))
+	copy(ordered, desired)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Order < ordered[j].Order })
+
+	desiredKeys := make(map[string]bool)
+	var declaredOrder, createdOrder []string
+	for _, dp := range ordered {
+		key := DelayProfileKey(dp.TagNames)
+		if desiredKeys[key] {
+			continue
+		}
+		desiredKeys[key] = true
+
+		currentDP, exists := currentByKey[key]
+		if key != "" {
+			declaredOrder = append(declaredOrder, key)
+		}
+
 		if !exists {
-			// Create new delay profile
+			if key == "" {
+				// The default profile always exists; without it there is nothing to update
+				continue
+			}
 			payload := dp // Copy to avoid pointer issues
 			changes.Creates = append(changes.Creates, adapters.Change{
 				ResourceType: adapters.ResourceDelayProfile,
-				Name:         dp.Name,
+				Name:         delayProfileDisplayName(dp),
 				Payload:      &payload,
 			})
+			createdOrder = append(createdOrder, key)
 		} else if !DelayProfilesEqual(currentDP, dp) {
-			// Update existing delay profile
+			// Order is enforced separately through the reorder endpoint
 			updated := dp
 			updated.ID = currentDP.ID
+			updated.Order = currentDP.Order
 			changes.Updates = append(changes.Updates, adapters.Change{
 				ResourceType: adapters.ResourceDelayProfile,
-				Name:         dp.Name,
+				Name:         delayProfileDisplayName(dp),
 				ID:           IntPtr(currentDP.ID),
 				Payload:      &updated,
 			})
 		}
 	}
 
-	// Find deletes - only delete profiles with order > 1
-	// (Order 1 is the default profile that always exists)
-	for _, cp := range current {
-		if cp.Order == 1 {
-			// Don't delete the default profile
+	// Profiles not declared are removed; the default profile is never deleted
+	var kept []irv1.DelayProfileIR
+	for i, cp := range current {
+		key := currentKeys[i]
+		if cp.ID == DefaultDelayProfileID {
 			continue
 		}
-		if _, exists := desiredByOrder[cp.Order]; !exists {
-			changes.Deletes = append(changes.Deletes, adapters.Change{
-				ResourceType: adapters.ResourceDelayProfile,
-				Name:         cp.Name,
-				ID:           IntPtr(cp.ID),
-			})
+		if desiredKeys[key] {
+			kept = append(kept, cp)
+			continue
 		}
+		changes.Deletes = append(changes.Deletes, adapters.Change{
+			ResourceType: adapters.ResourceDelayProfile,
+			Name:         delayProfileDisplayName(cp),
+			ID:           IntPtr(cp.ID),
+		})
+	}
+
+	// Created profiles are appended after the existing ones
+	sort.Slice(kept, func(i, j int) bool { return kept[i].Order < kept[j].Order })
+	resulting := make([]string, 0, len(kept)+len(createdOrder))
+	for _, cp := range kept {
+		resulting = append(resulting, DelayProfileKey(cp.TagNames))
+	}
+	resulting = append(resulting, createdOrder...)
+
+	if strings.Join(resulting, "|") != strings.Join(declaredOrder, "|") {
+		changes.Updates = append(changes.Updates, adapters.Change{
+			ResourceType: adapters.ResourceDelayProfileOrder,
+			Name:         "delay profile order",
+			Payload:      declaredOrder,
+		})
 	}
 }
 
+// delayProfileDisplayName returns the profile name, or a name derived from its tags
+func delayProfileDisplayName(p irv1.DelayProfileIR) string {
+	if p.Name != "" {
+		return p.Name
+	}
+	if p.ID == DefaultDelayProfileID || len(p.TagNames) == 0 {
+		return "default"
+	}
+	return "tags:" + DelayProfileKey(p.TagNames)
+}
+
 // DelayProfilesEqual compares two delay profiles for equality.
+// Order is not compared; it is reconciled for all profiles at once.
 func DelayProfilesEqual(a, b irv1.DelayProfileIR) bool {
-	if a.Order != b.Order {
-		return false
-	}
 	if a.PreferredProtocol != b.PreferredProtocol {
 		return false
 	}
@@ -95,20 +182,59 @@ func DelayProfilesEqual(a, b irv1.DelayProfileIR) bool {
 	if a.MinimumCustomFormatScore != b.MinimumCustomFormatScore {
 		return false
 	}
-	// Compare tags
-	if len(a.Tags) != len(b.Tags) {
-		return false
+	return DelayProfileKey(a.TagNames) == DelayProfileKey(b.TagNames)
+}
+
+// DelayProfileIDsByKey maps tag-set keys to the IDs of non-default delay profiles
+func DelayProfileIDsByKey(profiles []BaseDelayProfileResource, labels map[int]string) map[string]int {
+	ids := make(map[string]int, len(profiles))
+	for _, p := range profiles {
+		if p.ID == DefaultDelayProfileID {
+			continue
+		}
+		names := make([]string, 0, len(p.Tags))
+		for _, id := range p.Tags {
+			if label, ok := labels[id]; ok {
+				names = append(names, label)
+			}
+		}
+		ids[DelayProfileKey(names)] = p.ID
 	}
-	tagSet := make(map[int]bool)
-	for _, t := range a.Tags {
-		tagSet[t] = true
+	return ids
+}
+
+// ReorderDelayProfiles moves delay profiles into the given tag-set order using the
+// reorder endpoint. apiVersion should be "v1" or "v3" depending on the service.
+func ReorderDelayProfiles(ctx context.Context, c *httpclient.Client, apiVersion string, keys []string) error {
+	labels, err := GetTagLabels(ctx, c, apiVersion)
+	if err != nil {
+		return err
+	}
+
+	var profiles []BaseDelayProfileResource
+	if err := c.Get(ctx, fmt.Sprintf("/api/%s/delayprofile", apiVersion), &profiles); err != nil {
+		return fmt.Errorf("failed to get delay profiles: %w", err)
 	}
-	for _, t := range b.Tags {
-		if !tagSet[t] {
-			return false
+	ids := DelayProfileIDsByKey(profiles, labels)
+
+	after := 0
+	for _, key := range keys {
+		id, ok := ids[key]
+		if !ok {
+			return fmt.Errorf("delay profile for tags %q not found", key)
+		}
+
+		path := fmt.Sprintf("/api/%s/delayprofile/reorder/%d", apiVersion, id)
+		if after != 0 {
+			path += fmt.Sprintf("?after=%d", after)
 		}
+		if err := c.Put(ctx, path, nil, nil); err != nil {
+			return fmt.Errorf("failed to reorder delay profile: %w", err)
+		}
+		after = id
 	}
-	return true
+
+	return nil
 }
 
 // IntPtr returns a pointer to the given int value.
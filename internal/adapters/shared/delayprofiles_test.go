@@ -0,0 +1,109 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestDiffDelayProfiles(t *testing.T) {
+	profile := func(id, order int, tags ...string) irv1.DelayProfileIR {
+		return irv1.DelayProfileIR{
+			ID:                id,
+			Order:             order,
+			PreferredProtocol: irv1.ProtocolUsenet,
+			EnableUsenet:      true,
+			TagNames:          tags,
+		}
+	}
+	withDelay := func(p irv1.DelayProfileIR, minutes int) irv1.DelayProfileIR {
+		p.UsenetDelay = minutes
+		return p
+	}
+
+	tests := []struct {
+		name        string
+		current     []irv1.DelayProfileIR
+		desired     []irv1.DelayProfileIR
+		wantCreates []string
+		wantUpdates []string
+		wantDeletes []int
+		wantOrder   []string
+	}{
+		{
+			name:    "nothing declared leaves profiles alone",
+			current: []irv1.DelayProfileIR{profile(1, 2147483647), profile(2, 1, "anime")},
+		},
+		{
+			name:    "in sync",
+			current: []irv1.DelayProfileIR{profile(1, 2147483647), profile(2, 1, "Anime"), profile(3, 2, "4k")},
+			desired: []irv1.DelayProfileIR{profile(0, 1), profile(0, 2, "anime"), profile(0, 3, "4k")},
+		},
+		{
+			name:        "untagged profile updates the default",
+			current:     []irv1.DelayProfileIR{profile(1, 2147483647)},
+			desired:     []irv1.DelayProfileIR{withDelay(profile(0, 1), 60)},
+			wantUpdates: []string{adapters.ResourceDelayProfile},
+		},
+		{
+			name:        "new profile is created and stale ones deleted",
+			current:     []irv1.DelayProfileIR{profile(1, 2147483647), profile(2, 1, "old"), profile(3, 2)},
+			desired:     []irv1.DelayProfileIR{profile(0, 1, "anime")},
+			wantCreates: []string{"tags:anime"},
+			wantDeletes: []int{2, 3},
+		},
+		{
+			name:        "creates are reordered ahead of existing profiles",
+			current:     []irv1.DelayProfileIR{profile(1, 2147483647), profile(2, 1, "4k")},
+			desired:     []irv1.DelayProfileIR{profile(0, 1, "anime"), profile(0, 2, "4k")},
+			wantCreates: []string{"tags:anime"},
+			wantUpdates: []string{adapters.ResourceDelayProfileOrder},
+			wantOrder:   []string{"anime", "4k"},
+		},
+		{
+			name:        "existing profiles swap order",
+			current:     []irv1.DelayProfileIR{profile(1, 2147483647), profile(2, 1, "4k"), profile(3, 2, "anime")},
+			desired:     []irv1.DelayProfileIR{profile(0, 1, "anime"), profile(0, 2, "4k")},
+			wantUpdates: []string{adapters.ResourceDelayProfileOrder},
+			wantOrder:   []string{"anime", "4k"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := &adapters.ChangeSet{}
+			DiffDelayProfiles(tt.current, tt.desired, changes)
+
+			var creates, updates []string
+			var deletes []int
+			var order []string
+			for _, c := range changes.Creates {
+				creates = append(creates, c.Name)
+			}
+			for _, c := range changes.Updates {
+				updates = append(updates, c.ResourceType)
+				if c.ResourceType == adapters.ResourceDelayProfileOrder {
+					order = c.Payload.([]string)
+				}
+			}
+			for _, c := range changes.Deletes {
+				deletes = append(deletes, *c.ID)
+			}
+
+			if !reflect.DeepEqual(creates, tt.wantCreates) {
+				t.Errorf("creates = %v, want %v", creates, tt.wantCreates)
+			}
+			if !reflect.DeepEqual(updates, tt.wantUpdates) {
+				t.Errorf("updates = %v, want %v", updates, tt.wantUpdates)
+			}
+			if !reflect.DeepEqual(deletes, tt.wantDeletes) {
+				t.Errorf("deletes = %v, want %v", deletes, tt.wantDeletes)
+			}
+			if !reflect.DeepEqual(order, tt.wantOrder) {
+				t.Errorf("order = %v, want %v", order, tt.wantOrder)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+// FileSystemResource is a directory listing from the filesystem API
+type FileSystemResource struct {
+	Parent      string                    `json:"parent,omitempty"`
+	Directories []FileSystemEntryResource `json:"directories,omitempty"`
+}
+
+// FileSystemEntryResource is an entry of a directory listing
+type FileSystemEntryResource struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// CheckPath looks a directory up through the app's filesystem API
+// (GET /api/<version>/filesystem). The API lists a directory's subdirectories,
+// and an empty directory lists the same as a missing one, so the path is looked
+// up in its parent's listing. A missing path wraps adapters.ErrPathNotFound and
+// names the nearest parent the app can see, which usually points at a missing
+// volume mount. Paths that aren't absolute POSIX paths are not checked.
+func CheckPath(ctx context.Context, c *httpclient.Client, apiVersion, dir string) error {
+	if !strings.HasPrefix(dir, "/") {
+		return nil
+	}
+	target := path.Clean(dir)
+
+	for current := target; current != "/"; current = path.Dir(current) {
+		found, err := listsDirectory(ctx, c, apiVersion, current)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		if current == target {
+			return nil
+		}
+		return fmt.Errorf("%w: %s (nearest existing parent: %s)", adapters.ErrPathNotFound, target, current)
+	}
+	if target == "/" {
+		return nil
+	}
+	return fmt.Errorf("%w: %s (nearest existing parent: /)", adapters.ErrPathNotFound, target)
+}
+
+// listsDirectory reports whether the parent directory of dir lists dir
+func listsDirectory(ctx context.Context, c *httpclient.Client, apiVersion, dir string) (bool, error) {
+	parent := path.Dir(dir)
+	if parent != "/" {
+		parent += "/"
+	}
+	params := url.Values{"path": {parent}, "includeFiles": {"false"}}
+
+	var listing FileSystemResource
+	if err := c.Get(ctx, "/api/"+apiVersion+"/filesystem?"+params.Encode(), &listing); err != nil {
+		return false, fmt.Errorf("failed to list %s: %w", parent, err)
+	}
+	for _, entry := range listing.Directories {
+		if path.Clean(entry.Path) == dir {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,58 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+func TestCheckPath(t *testing.T) {
+	// The app sees /data/tv and an empty /data/movies, but /media is not mounted
+	tree := map[string][]string{
+		"/":      {"/data/", "/config/"},
+		"/data/": {"/data/movies/", "/data/tv/"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/filesystem" {
+			http.NotFound(w, r)
+			return
+		}
+		var listing FileSystemResource
+		for _, dir := range tree[r.URL.Query().Get("path")] {
+			listing.Directories = append(listing.Directories, FileSystemEntryResource{Path: dir})
+		}
+		_ = json.NewEncoder(w).Encode(listing)
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL})
+
+	tests := []struct {
+		path    string
+		wantErr string
+	}{
+		{path: "/data/movies"},
+		{path: "/data/tv/"},
+		{path: `C:\Movies`},
+		{path: "/data/anime", wantErr: "/data/anime (nearest existing parent: /data)"},
+		{path: "/media/movies", wantErr: "/media/movies (nearest existing parent: /)"},
+	}
+	for _, tt := range tests {
+		err := CheckPath(context.Background(), c, "v3", tt.path)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", tt.path, err)
+			}
+			continue
+		}
+		if !errors.Is(err, adapters.ErrPathNotFound) || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: expected a not found error containing %q, got %v", tt.path, tt.wantErr, err)
+		}
+	}
+}
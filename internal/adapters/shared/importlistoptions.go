@@ -0,0 +1,41 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// ImportListConfigAPIPath is the global import list options endpoint of Radarr and Sonarr
+const ImportListConfigAPIPath = "/api/v3/config/importlist"
+
+// ApplyImportListOptions updates the global import list options the IR sets,
+// sending nothing when no field changes. tagID is the resolved listSyncTag
+// (0 = unset).
+func ApplyImportListOptions(ctx context.Context, c *httpclient.Client, ir *irv1.ImportListOptionsIR, tagID int) error {
+	if ir == nil {
+		return nil
+	}
+
+	patch, err := FetchConfigPatch(ctx, c, ImportListConfigAPIPath, "import list config")
+	if err != nil {
+		return err
+	}
+	if ir.CleanLibraryLevel != "" {
+		patch.Set("listSyncLevel", ir.CleanLibraryLevel)
+	}
+	if tagID != 0 {
+		patch.Set("listSyncTag", tagID)
+	}
+	if ir.SyncIntervalHours != 0 {
+		// The compiler prunes the setting for Radarr v5, which dropped it; this
+		// catches versions Discover couldn't read
+		if !patch.Has("importListSyncInterval") {
+			return fmt.Errorf("importListSyncInterval is not supported by this app version")
+		}
+		patch.Set("importListSyncInterval", ir.SyncIntervalHours)
+	}
+	return patch.Apply(ctx, c)
+}
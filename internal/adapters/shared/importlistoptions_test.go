@@ -0,0 +1,64 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestApplyImportListOptions(t *testing.T) {
+	stored := map[string]interface{}{"id": 1, "listSyncLevel": "disabled", "listSyncTag": 0}
+	var puts []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == ImportListConfigAPIPath:
+			_ = json.NewEncoder(w).Encode(stored)
+		case r.Method == http.MethodPut && r.URL.Path == ImportListConfigAPIPath+"/1":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			puts = append(puts, body)
+			stored = body
+			_ = json.NewEncoder(w).Encode(body)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL})
+	ctx := context.Background()
+
+	ir := &irv1.ImportListOptionsIR{CleanLibraryLevel: "keepAndTag", CleanLibraryTag: "dropped"}
+	if err := ApplyImportListOptions(ctx, c, ir, 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(puts) != 1 || puts[0]["listSyncLevel"] != "keepAndTag" || puts[0]["listSyncTag"] != float64(7) {
+		t.Fatalf("expected one update to keepAndTag with tag 7, got %v", puts)
+	}
+
+	// Unchanged options send nothing
+	if err := ApplyImportListOptions(ctx, c, ir, 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(puts) != 1 {
+		t.Errorf("expected no update for unchanged options, got %d", len(puts))
+	}
+
+	// The sync interval is only set where the app still has it
+	err := ApplyImportListOptions(ctx, c, &irv1.ImportListOptionsIR{SyncIntervalHours: 12}, 0)
+	if err == nil || !strings.Contains(err.Error(), "importListSyncInterval") {
+		t.Errorf("expected an unsupported sync interval error, got %v", err)
+	}
+	stored["importListSyncInterval"] = 24
+	if err := ApplyImportListOptions(ctx, c, &irv1.ImportListOptionsIR{SyncIntervalHours: 12}, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := puts[len(puts)-1]["importListSyncInterval"]; got != float64(12) {
+		t.Errorf("expected sync interval 12, got %v", got)
+	}
+}
@@ -0,0 +1,35 @@
+package shared
+
+import "strings"
+
+// ProwlarrNameSuffix is appended by Prowlarr to the names of indexers it syncs into apps
+const ProwlarrNameSuffix = " (Prowlarr)"
+
+// ProwlarrSyncedIndexer returns the Prowlarr-side name of an app indexer, read
+// generically from the app's API, and whether the Prowlarr at prowlarrURL synced
+// it. Prowlarr points the base URL of synced indexers at <prowlarrUrl>/<indexer id>/
+// and names them with ProwlarrNameSuffix.
+func ProwlarrSyncedIndexer(indexer map[string]interface{}, prowlarrURL string) (string, bool) {
+	name, _ := indexer["name"].(string)
+
+	synced := false
+	prowlarrURL = strings.TrimSuffix(prowlarrURL, "/")
+	if fields, ok := indexer["fields"].([]interface{}); ok && prowlarrURL != "" {
+		for _, f := range fields {
+			field, ok := f.(map[string]interface{})
+			if !ok || field["name"] != "baseUrl" {
+				continue
+			}
+			if baseURL, ok := field["value"].(string); ok && strings.HasPrefix(baseURL, prowlarrURL+"/") {
+				synced = true
+			}
+		}
+	}
+
+	if strings.HasSuffix(name, ProwlarrNameSuffix) {
+		synced = true
+		name = strings.TrimSuffix(name, ProwlarrNameSuffix)
+	}
+
+	return name, synced
+}
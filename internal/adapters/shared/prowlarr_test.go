@@ -0,0 +1,34 @@
+package shared
+
+import "testing"
+
+func TestProwlarrSyncedIndexer(t *testing.T) {
+	const prowlarrURL = "http://prowlarr:9696/"
+	withBaseURL := func(name, baseURL string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":   name,
+			"fields": []interface{}{map[string]interface{}{"name": "baseUrl", "value": baseURL}},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		indexer    map[string]interface{}
+		wantName   string
+		wantSynced bool
+	}{
+		{"named by Prowlarr", map[string]interface{}{"name": "NZBgeek (Prowlarr)"}, "NZBgeek", true},
+		{"base URL at this Prowlarr", withBaseURL("Nyaa", "http://prowlarr:9696/3/"), "Nyaa", true},
+		{"base URL elsewhere", withBaseURL("Tracker", "http://tracker.example"), "Tracker", false},
+		{"base URL sharing a prefix", withBaseURL("Other", "http://prowlarr:96960/3/"), "Other", false},
+		{"no fields", map[string]interface{}{"name": "Manual"}, "Manual", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, synced := ProwlarrSyncedIndexer(tt.indexer, prowlarrURL)
+			if name != tt.wantName || synced != tt.wantSynced {
+				t.Errorf("ProwlarrSyncedIndexer() = %q, %v, want %q, %v", name, synced, tt.wantName, tt.wantSynced)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package shared
+
+import (
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// ResolveSizeLimit merges a desired quality definition size limit into the current one.
+// A nil desired value keeps the current limit; irv1.SizeLimitUnlimited maps to nil (no limit).
+// Returns the resulting value and whether it differs from the current value.
+func ResolveSizeLimit(current, desired *float64) (*float64, bool) {
+	if desired == nil {
+		return current, false
+	}
+
+	var next *float64
+	if *desired != irv1.SizeLimitUnlimited {
+		v := *desired
+		next = &v
+	}
+
+	switch {
+	case current == nil && next == nil:
+		return nil, false
+	case current == nil || next == nil:
+		return next, true
+	default:
+		return next, *current != *next
+	}
+}
@@ -0,0 +1,43 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+// queuePageSize is the number of queue records requested per page
+const queuePageSize = 200
+
+// queuePage is one page of an app's download queue
+type queuePage struct {
+	TotalRecords int `json:"totalRecords"`
+	Records      []struct {
+		DownloadID string `json:"downloadId"`
+	} `json:"records"`
+}
+
+// ReadQueuedDownloadIDs returns the download IDs in an app's queue: the downloads
+// it grabbed and hasn't imported yet. path is the queue endpoint with the query
+// that includes unknown items (e.g. /api/v3/queue?includeUnknownMovieItems=true).
+// IDs are upper-cased, so torrent hashes compare across clients.
+func ReadQueuedDownloadIDs(ctx context.Context, c *httpclient.Client, path string) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	for page, read := 1, 0; ; page++ {
+		var p queuePage
+		if err := c.Get(ctx, fmt.Sprintf("%s&page=%d&pageSize=%d", path, page, queuePageSize), &p); err != nil {
+			return nil, fmt.Errorf("failed to get queue: %w", err)
+		}
+		for _, r := range p.Records {
+			if r.DownloadID != "" {
+				ids[strings.ToUpper(r.DownloadID)] = true
+			}
+		}
+		read += len(p.Records)
+		if len(p.Records) == 0 || read >= p.TotalRecords {
+			return ids, nil
+		}
+	}
+}
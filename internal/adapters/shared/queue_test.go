@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+func TestReadQueuedDownloadIDs(t *testing.T) {
+	pages := map[string][]map[string]interface{}{
+		"1": {{"downloadId": "abc123"}, {"downloadId": ""}},
+		"2": {{"downloadId": "DEF456"}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/queue" || r.URL.Query().Get("includeUnknownMovieItems") != "true" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"totalRecords": 3,
+			"records":      pages[r.URL.Query().Get("page")],
+		})
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL})
+
+	ids, err := ReadQueuedDownloadIDs(context.Background(), c, "/api/v3/queue?includeUnknownMovieItems=true")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := map[string]bool{"ABC123": true, "DEF456": true}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+}
@@ -0,0 +1,34 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+// SendRaw sends an unmodeled API request (spec.raw) with an optional JSON body.
+// Supported methods are PUT, POST and DELETE.
+func SendRaw(ctx context.Context, c *httpclient.Client, method, path string, body []byte) error {
+	var payload interface{}
+	if len(body) > 0 {
+		if !json.Valid(body) {
+			return fmt.Errorf("body is not valid JSON")
+		}
+		payload = json.RawMessage(body)
+	}
+
+	switch strings.ToUpper(method) {
+	case http.MethodPut:
+		return c.Put(ctx, path, payload, nil)
+	case http.MethodPost:
+		return c.Post(ctx, path, payload, nil)
+	case http.MethodDelete:
+		return c.Delete(ctx, path)
+	default:
+		return fmt.Errorf("unsupported method %q", method)
+	}
+}
@@ -0,0 +1,198 @@
+package shared
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// ManagedRefsFromIR returns the remote IDs of the resources declared in desired,
+// taken from the current state the adapter already read. Resources are matched
+// the way they are diffed: by name, root folders by path and remote path
+// mappings by host and remote path. Resources missing from current, or read
+// without an ID, are left out.
+func ManagedRefsFromIR(current, desired *irv1.IR) *adapters.ManagedRefs {
+	refs := &adapters.ManagedRefs{}
+	if current == nil || desired == nil {
+		return refs
+	}
+
+	var defaultProfile string
+	profiles := make(map[string]bool)
+	if q := desired.Quality; q != nil {
+		for _, p := range q.AllVideoProfiles() {
+			profiles[p.ProfileName] = true
+		}
+		if q.Audio != nil {
+			profiles[q.Audio.ProfileName] = true
+		}
+		if q.Book != nil {
+			profiles[q.Book.ProfileName] = true
+		}
+		switch {
+		case q.Video != nil:
+			defaultProfile = q.Video.ProfileName
+		case q.Audio != nil:
+			defaultProfile = q.Audio.ProfileName
+		case q.Book != nil:
+			defaultProfile = q.Book.ProfileName
+		}
+	}
+	delete(profiles, "")
+	profileIDs := make(map[string]int)
+	if q := current.Quality; q != nil {
+		for _, p := range q.AllVideoProfiles() {
+			profileIDs[p.ProfileName] = p.ID
+		}
+		if q.Audio != nil {
+			profileIDs[q.Audio.ProfileName] = q.Audio.ID
+		}
+		if q.Book != nil {
+			profileIDs[q.Book.ProfileName] = q.Book.ID
+		}
+	}
+	refs.QualityProfileIDs = matchRefs(profileIDs, profiles)
+	if id := profileIDs[defaultProfile]; defaultProfile != "" && id != 0 {
+		refs.QualityProfileID = IntPtr(id)
+	}
+
+	refs.CustomFormatIDs = matchRefs(customFormatIDs(current), keySet(customFormatIDs(desired)))
+
+	clients := make(map[string]bool)
+	for _, dc := range desired.DownloadClients {
+		clients[dc.Name] = true
+	}
+	clientIDs := make(map[string]int)
+	for _, dc := range current.DownloadClients {
+		clientIDs[dc.Name] = dc.ID
+	}
+
+	indexers := make(map[string]bool)
+	if desired.Indexers != nil {
+		for _, idx := range desired.Indexers.Direct {
+			indexers[idx.Name] = true
+		}
+	}
+	indexerIDs := make(map[string]int)
+	if current.Indexers != nil {
+		for _, idx := range current.Indexers.Direct {
+			indexerIDs[idx.Name] = idx.ID
+		}
+	}
+
+	proxies := make(map[string]bool)
+	applications := make(map[string]bool)
+	if p := desired.Prowlarr; p != nil {
+		for _, dc := range p.DownloadClients {
+			clients[dc.Name] = true
+		}
+		for _, idx := range p.Indexers {
+			indexers[idx.Name] = true
+		}
+		for _, proxy := range p.Proxies {
+			proxies[proxy.Name] = true
+		}
+		for _, app := range p.Applications {
+			applications[app.Name] = true
+		}
+	}
+	proxyIDs := make(map[string]int)
+	applicationIDs := make(map[string]int)
+	if p := current.Prowlarr; p != nil {
+		for _, dc := range p.DownloadClients {
+			clientIDs[dc.Name] = dc.ID
+		}
+		for _, idx := range p.Indexers {
+			indexerIDs[idx.Name] = idx.ID
+		}
+		for _, proxy := range p.Proxies {
+			proxyIDs[proxy.Name] = proxy.ID
+		}
+		for _, app := range p.Applications {
+			applicationIDs[app.Name] = app.ID
+		}
+	}
+	refs.DownloadClientIDs = matchRefs(clientIDs, clients)
+	refs.IndexerIDs = matchRefs(indexerIDs, indexers)
+	refs.ProxyIDs = matchRefs(proxyIDs, proxies)
+	refs.ApplicationIDs = matchRefs(applicationIDs, applications)
+
+	folders := make(map[string]bool)
+	for _, rf := range desired.RootFolders {
+		folders[rootFolderKey(rf.Path)] = true
+	}
+	folderIDs := make(map[string]int)
+	for _, rf := range current.RootFolders {
+		folderIDs[rootFolderKey(rf.Path)] = rf.ID
+	}
+	refs.RootFolderIDs = matchRefs(folderIDs, folders)
+
+	mappings := make(map[string]bool)
+	for _, m := range desired.RemotePathMappings {
+		mappings[RemotePathMappingKey(m.Host, m.RemotePath)] = true
+	}
+	mappingIDs := make(map[string]int)
+	for _, m := range current.RemotePathMappings {
+		mappingIDs[RemotePathMappingKey(m.Host, m.RemotePath)] = m.ID
+	}
+	refs.RemotePathMappingIDs = matchRefs(mappingIDs, mappings)
+
+	notifications := make(map[string]bool)
+	for _, n := range desired.Notifications {
+		notifications[n.Name] = true
+	}
+	notificationIDs := make(map[string]int)
+	for _, n := range current.Notifications {
+		notificationIDs[n.Name] = n.ID
+	}
+	refs.NotificationIDs = matchRefs(notificationIDs, notifications)
+
+	return refs
+}
+
+// customFormatIDs maps the names of the top-level and per-profile custom formats to their IDs
+func customFormatIDs(ir *irv1.IR) map[string]int {
+	ids := make(map[string]int)
+	for _, cf := range ir.CustomFormats {
+		ids[cf.Name] = cf.ID
+	}
+	for _, p := range ir.Quality.AllVideoProfiles() {
+		for _, cf := range p.CustomFormats {
+			if ids[cf.Name] == 0 {
+				ids[cf.Name] = cf.ID
+			}
+		}
+	}
+	return ids
+}
+
+// keySet returns the keys of ids
+func keySet(ids map[string]int) map[string]bool {
+	keys := make(map[string]bool, len(ids))
+	for key := range ids {
+		keys[key] = true
+	}
+	return keys
+}
+
+// matchRefs returns the sorted, non-zero IDs of the keys that are declared
+func matchRefs(ids map[string]int, declared map[string]bool) []int {
+	var matched []int
+	for key, id := range ids {
+		if declared[key] && id != 0 {
+			matched = append(matched, id)
+		}
+	}
+	sort.Ints(matched)
+	return matched
+}
+
+// rootFolderKey ignores the trailing separator the apps may add to root folder paths
+func rootFolderKey(path string) string {
+	if len(path) > 1 {
+		return strings.TrimRight(path, "/\\")
+	}
+	return path
+}
@@ -0,0 +1,97 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestManagedRefsFromIR(t *testing.T) {
+	current := &irv1.IR{
+		Quality: &irv1.QualityIR{
+			Video: &irv1.VideoQualityIR{
+				ID:          4,
+				ProfileName: "nebularr-movies",
+				CustomFormats: []irv1.CustomFormatIR{
+					{ID: 12, Name: "nebularr-movies-x265"},
+					{ID: 3, Name: "DV"},
+					{ID: 9, Name: "nebularr-movies-hdr"},
+				},
+			},
+			VideoProfiles: []*irv1.VideoQualityIR{{ID: 7, ProfileName: "nebularr-movies-uhd"}},
+		},
+		DownloadClients: []irv1.DownloadClientIR{{ID: 2, Name: "qbittorrent"}, {ID: 5, Name: "manual"}},
+		RootFolders:     []irv1.RootFolderIR{{ID: 8, Path: "/movies/"}, {ID: 6, Path: "/other"}},
+		RemotePathMappings: []irv1.RemotePathMappingIR{
+			{ID: 11, Host: "QBittorrent", RemotePath: "/downloads/", LocalPath: "/data/"},
+		},
+		Notifications: []irv1.NotificationIR{{Name: "discord"}},
+	}
+	desired := &irv1.IR{
+		Quality: &irv1.QualityIR{
+			Video: &irv1.VideoQualityIR{
+				ProfileName:   "nebularr-movies",
+				CustomFormats: []irv1.CustomFormatIR{{Name: "nebularr-movies-hdr"}},
+			},
+			VideoProfiles: []*irv1.VideoQualityIR{{ProfileName: "nebularr-movies-uhd"}},
+		},
+		CustomFormats:      []irv1.CustomFormatIR{{Name: "nebularr-movies-x265"}},
+		DownloadClients:    []irv1.DownloadClientIR{{Name: "qbittorrent"}, {Name: "sabnzbd"}},
+		RootFolders:        []irv1.RootFolderIR{{Path: "/movies"}},
+		RemotePathMappings: []irv1.RemotePathMappingIR{{Host: "qbittorrent", RemotePath: "/downloads"}},
+		Notifications:      []irv1.NotificationIR{{Name: "discord"}},
+	}
+
+	// Undeclared resources, missing resources and resources read without an ID are left out
+	want := &adapters.ManagedRefs{
+		QualityProfileID:     IntPtr(4),
+		QualityProfileIDs:    []int{4, 7},
+		CustomFormatIDs:      []int{9, 12},
+		DownloadClientIDs:    []int{2},
+		RootFolderIDs:        []int{8},
+		RemotePathMappingIDs: []int{11},
+	}
+	if refs := ManagedRefsFromIR(current, desired); !reflect.DeepEqual(refs, want) {
+		t.Errorf("refs = %+v, want %+v", refs, want)
+	}
+
+	// A default profile that doesn't exist yet has no ID
+	current.Quality.Video = nil
+	refs := ManagedRefsFromIR(current, desired)
+	if refs.QualityProfileID != nil {
+		t.Errorf("QualityProfileID = %d, want nil", *refs.QualityProfileID)
+	}
+	if !reflect.DeepEqual(refs.QualityProfileIDs, []int{7}) {
+		t.Errorf("QualityProfileIDs = %v, want [7]", refs.QualityProfileIDs)
+	}
+}
+
+func TestManagedRefsFromIRProwlarr(t *testing.T) {
+	current := &irv1.IR{
+		App: adapters.AppProwlarr,
+		Prowlarr: &irv1.ProwlarrIR{
+			Indexers:     []irv1.ProwlarrIndexerIR{{ID: 3, Name: "nebularr-main-nyaa"}, {ID: 1, Name: "manual"}},
+			Proxies:      []irv1.IndexerProxyIR{{ID: 2, Name: "nebularr-main-flaresolverr"}},
+			Applications: []irv1.ProwlarrApplicationIR{{ID: 6, Name: "nebularr-main-sonarr"}, {ID: 5, Name: "nebularr-main-radarr"}},
+		},
+	}
+	desired := &irv1.IR{
+		App: adapters.AppProwlarr,
+		Prowlarr: &irv1.ProwlarrIR{
+			Indexers:     []irv1.ProwlarrIndexerIR{{Name: "nebularr-main-nyaa"}},
+			Proxies:      []irv1.IndexerProxyIR{{Name: "nebularr-main-flaresolverr"}},
+			Applications: []irv1.ProwlarrApplicationIR{{Name: "nebularr-main-radarr"}, {Name: "nebularr-main-sonarr"}},
+		},
+	}
+
+	want := &adapters.ManagedRefs{
+		IndexerIDs:     []int{3},
+		ProxyIDs:       []int{2},
+		ApplicationIDs: []int{5, 6},
+	}
+	if refs := ManagedRefsFromIR(current, desired); !reflect.DeepEqual(refs, want) {
+		t.Errorf("refs = %+v, want %+v", refs, want)
+	}
+}
@@ -3,36 +3,61 @@ package shared
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
+// RemotePathMappingKey identifies a remote path mapping by host and remote path.
+// The apps compare hosts case-insensitively and store paths with a trailing
+// separator, so both are normalized.
+func RemotePathMappingKey(host, remotePath string) string {
+	return strings.ToLower(host) + "|" + normalizeMappingPath(remotePath)
+}
+
+// normalizeMappingPath appends the trailing separator the apps add to mapping paths
+func normalizeMappingPath(path string) string {
+	if path == "" || strings.HasSuffix(path, "/") || strings.HasSuffix(path, "\\") {
+		return path
+	}
+	// Windows paths (C:\downloads, \\server\share) use backslashes
+	if strings.Contains(path, "\\") && !strings.Contains(path, "/") {
+		return path + "\\"
+	}
+	return path + "/"
+}
+
 // DiffRemotePathMappings computes changes for remote path mappings.
-// Remote path mappings are keyed by host+remotePath combination.
+// Remote path mappings are keyed by host+remotePath combination. Mappings are not
+// tagged, so undeclared ones are only pruned when at least one mapping is declared;
+// nothing is changed when none are.
 func DiffRemotePathMappings(
 	current []irv1.RemotePathMappingIR,
 	desired []irv1.RemotePathMappingIR,
 	changes *adapters.ChangeSet,
 ) {
-	// Build lookup maps by host+remotePath (unique key)
+	if len(desired) == 0 {
+		return
+	}
+
 	currentByKey := make(map[string]irv1.RemotePathMappingIR)
 	for _, m := range current {
-		key := m.Host + "|" + m.RemotePath
-		currentByKey[key] = m
+		currentByKey[RemotePathMappingKey(m.Host, m.RemotePath)] = m
 	}
 
-	desiredByKey := make(map[string]irv1.RemotePathMappingIR)
-	for _, m := range desired {
-		key := m.Host + "|" + m.RemotePath
-		desiredByKey[key] = m
-	}
+	// Find creates and updates, in declared order
+	desiredKeys := make(map[string]bool)
+	for _, d := range desired {
+		key := RemotePathMappingKey(d.Host, d.RemotePath)
+		if desiredKeys[key] {
+			continue
+		}
+		desiredKeys[key] = true
 
-	// Find creates and updates
-	for key, d := range desiredByKey {
 		if c, exists := currentByKey[key]; exists {
 			// Check if update needed (local path changed)
-			if c.LocalPath != d.LocalPath {
+			if normalizeMappingPath(c.LocalPath) != normalizeMappingPath(d.LocalPath) {
 				updated := d
 				updated.ID = c.ID // Preserve the ID for update
 				changes.Updates = append(changes.Updates, adapters.Change{
@@ -54,15 +79,16 @@ func DiffRemotePathMappings(
 	}
 
 	// Find deletes
-	for key, c := range currentByKey {
-		if _, exists := desiredByKey[key]; !exists {
-			mapping := c // Copy to avoid pointer issues
-			changes.Deletes = append(changes.Deletes, adapters.Change{
-				ResourceType: adapters.ResourceRemotePathMapping,
-				Name:         fmt.Sprintf("%s -> %s", c.RemotePath, c.LocalPath),
-				ID:           IntPtr(c.ID),
-				Payload:      &mapping,
-			})
+	for _, c := range current {
+		if desiredKeys[RemotePathMappingKey(c.Host, c.RemotePath)] {
+			continue
 		}
+		mapping := c // Copy to avoid pointer issues
+		changes.Deletes = append(changes.Deletes, adapters.Change{
+			ResourceType: adapters.ResourceRemotePathMapping,
+			Name:         fmt.Sprintf("%s -> %s", c.RemotePath, c.LocalPath),
+			ID:           IntPtr(c.ID),
+			Payload:      &mapping,
+		})
 	}
 }
@@ -0,0 +1,76 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestDiffRemotePathMappings(t *testing.T) {
+	mapping := func(id int, host, remote, local string) irv1.RemotePathMappingIR {
+		return irv1.RemotePathMappingIR{ID: id, Host: host, RemotePath: remote, LocalPath: local}
+	}
+
+	tests := []struct {
+		name        string
+		current     []irv1.RemotePathMappingIR
+		desired     []irv1.RemotePathMappingIR
+		wantCreates []string
+		wantUpdates []int
+		wantDeletes []int
+	}{
+		{
+			name:    "nothing declared leaves mappings alone",
+			current: []irv1.RemotePathMappingIR{mapping(1, "qbit", "/downloads/", "/data/")},
+		},
+		{
+			name:    "trailing separators and host case match the stored mapping",
+			current: []irv1.RemotePathMappingIR{mapping(1, "qbit", "/downloads/", "/data/"), mapping(2, "sab", `C:\complete\`, "/usenet/")},
+			desired: []irv1.RemotePathMappingIR{mapping(0, "QBit", "/downloads", "/data"), mapping(0, "sab", `C:\complete`, "/usenet")},
+		},
+		{
+			name:        "changed local path updates the mapping",
+			current:     []irv1.RemotePathMappingIR{mapping(4, "qbit", "/downloads/", "/data/")},
+			desired:     []irv1.RemotePathMappingIR{mapping(0, "qbit", "/downloads", "/media/downloads")},
+			wantUpdates: []int{4},
+		},
+		{
+			name:        "undeclared mappings are pruned",
+			current:     []irv1.RemotePathMappingIR{mapping(1, "qbit", "/downloads/", "/data/"), mapping(2, "old", "/old/", "/old/")},
+			desired:     []irv1.RemotePathMappingIR{mapping(0, "qbit", "/downloads", "/data"), mapping(0, "sab", "/complete", "/usenet")},
+			wantCreates: []string{"/complete -> /usenet"},
+			wantDeletes: []int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := &adapters.ChangeSet{}
+			DiffRemotePathMappings(tt.current, tt.desired, changes)
+
+			var creates []string
+			var updates, deletes []int
+			for _, c := range changes.Creates {
+				creates = append(creates, c.Name)
+			}
+			for _, c := range changes.Updates {
+				updates = append(updates, *c.ID)
+			}
+			for _, c := range changes.Deletes {
+				deletes = append(deletes, *c.ID)
+			}
+
+			if !reflect.DeepEqual(creates, tt.wantCreates) {
+				t.Errorf("creates = %v, want %v", creates, tt.wantCreates)
+			}
+			if !reflect.DeepEqual(updates, tt.wantUpdates) {
+				t.Errorf("updates = %v, want %v", updates, tt.wantUpdates)
+			}
+			if !reflect.DeepEqual(deletes, tt.wantDeletes) {
+				t.Errorf("deletes = %v, want %v", deletes, tt.wantDeletes)
+			}
+		})
+	}
+}
@@ -67,3 +67,22 @@ type CustomFormatSpecification struct {
 	Required       bool    `json:"required"`
 	Fields         []Field `json:"fields"`
 }
+
+// AutoTaggingResource represents an auto-tagging rule (Radarr/Sonarr).
+// Tags are the tags applied to matching items, not ownership tags.
+type AutoTaggingResource struct {
+	ID                      int                        `json:"id,omitempty"`
+	Name                    string                     `json:"name"`
+	RemoveTagsAutomatically bool                       `json:"removeTagsAutomatically"`
+	Tags                    []int                      `json:"tags"`
+	Specifications          []AutoTaggingSpecification `json:"specifications"`
+}
+
+// AutoTaggingSpecification represents a condition within an auto-tagging rule.
+type AutoTaggingSpecification struct {
+	Name           string  `json:"name"`
+	Implementation string  `json:"implementation"`
+	Negate         bool    `json:"negate"`
+	Required       bool    `json:"required"`
+	Fields         []Field `json:"fields"`
+}
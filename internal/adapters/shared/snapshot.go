@@ -0,0 +1,67 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+// managedResourcePrefix names the quality profiles and custom formats created by Nebularr
+const managedResourcePrefix = "nebularr-"
+
+// RollbackUpdate restores the body a resource had before a failed update, so a
+// partially applied update doesn't leave it half-changed. The returned error wraps
+// the update error and says whether the rollback worked.
+func RollbackUpdate(updateErr error, previous json.RawMessage, restore func(body json.RawMessage) error) error {
+	if len(previous) == 0 {
+		return updateErr
+	}
+	if err := restore(previous); err != nil {
+		return fmt.Errorf("%w (rollback failed: %v)", updateErr, err)
+	}
+	return fmt.Errorf("%w (rolled back to the previous version)", updateErr)
+}
+
+// ManagedRawResources keys the raw bodies of resources named with the Nebularr
+// prefix by name, for last-known-good snapshots
+func ManagedRawResources(items []json.RawMessage) (map[string]json.RawMessage, error) {
+	managed := make(map[string]json.RawMessage)
+	for _, item := range items {
+		var named struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(item, &named); err != nil {
+			return nil, fmt.Errorf("failed to decode resource: %w", err)
+		}
+		if strings.HasPrefix(named.Name, managedResourcePrefix) {
+			managed[named.Name] = item
+		}
+	}
+	return managed, nil
+}
+
+// SnapshotManaged returns the raw bodies of the managed quality profiles and
+// custom formats of a v3 app (Radarr, Sonarr), keyed by resource type and name
+func SnapshotManaged(ctx context.Context, c *httpclient.Client) (map[string]map[string]json.RawMessage, error) {
+	endpoints := map[string]string{
+		adapters.ResourceQualityProfile: "/api/v3/qualityprofile",
+		adapters.ResourceCustomFormat:   "/api/v3/customformat",
+	}
+	snapshot := make(map[string]map[string]json.RawMessage, len(endpoints))
+	for resourceType, endpoint := range endpoints {
+		var items []json.RawMessage
+		if err := c.Get(ctx, endpoint, &items); err != nil {
+			return nil, fmt.Errorf("failed to get %s resources: %w", resourceType, err)
+		}
+		managed, err := ManagedRawResources(items)
+		if err != nil {
+			return nil, err
+		}
+		snapshot[resourceType] = managed
+	}
+	return snapshot, nil
+}
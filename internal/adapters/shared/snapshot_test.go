@@ -0,0 +1,55 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRollbackUpdate(t *testing.T) {
+	updateErr := errors.New("400 bad request")
+	previous := json.RawMessage(`{"id":1,"name":"nebularr-hd"}`)
+
+	var restored json.RawMessage
+	err := RollbackUpdate(updateErr, previous, func(body json.RawMessage) error {
+		restored = body
+		return nil
+	})
+	if !errors.Is(err, updateErr) || !strings.Contains(err.Error(), "rolled back") {
+		t.Errorf("err = %v, want wrapped update error noting the rollback", err)
+	}
+	if string(restored) != string(previous) {
+		t.Errorf("restored %s, want %s", restored, previous)
+	}
+
+	err = RollbackUpdate(updateErr, previous, func(json.RawMessage) error { return errors.New("timeout") })
+	if !errors.Is(err, updateErr) || !strings.Contains(err.Error(), "rollback failed: timeout") {
+		t.Errorf("err = %v, want wrapped update error noting the failed rollback", err)
+	}
+
+	called := false
+	err = RollbackUpdate(updateErr, nil, func(json.RawMessage) error { called = true; return nil })
+	if err != updateErr || called {
+		t.Errorf("without a previous body: err = %v, restore called = %v", err, called)
+	}
+}
+
+func TestManagedRawResources(t *testing.T) {
+	items := []json.RawMessage{
+		json.RawMessage(`{"id":1,"name":"nebularr-hd"}`),
+		json.RawMessage(`{"id":2,"name":"Any"}`),
+		json.RawMessage(`{"id":3,"name":"nebularr-x265"}`),
+	}
+	managed, err := ManagedRawResources(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(managed) != 2 || string(managed["nebularr-hd"]) != string(items[0]) || string(managed["nebularr-x265"]) != string(items[2]) {
+		t.Errorf("managed = %v, want the two nebularr- resources", managed)
+	}
+
+	if _, err := ManagedRawResources([]json.RawMessage{json.RawMessage(`[`)}); err == nil {
+		t.Error("expected an error for an invalid body")
+	}
+}
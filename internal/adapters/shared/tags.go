@@ -3,55 +3,189 @@ package shared
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/poiley/nebularr-operator/internal/adapters"
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
-// OwnershipTagName is the standard tag name used by Nebularr to track managed resources.
+// OwnershipTagName is the tag Nebularr used to mark every managed resource before
+// resources were tagged per config. Resources still carrying only this tag are
+// adopted by the config that declares a resource with the same name.
 const OwnershipTagName = "nebularr-managed"
 
-// GetOwnershipTagID retrieves the ID of the Nebularr ownership tag.
-// apiVersion should be "v1" or "v3" depending on the service.
-func GetOwnershipTagID(ctx context.Context, c *httpclient.Client, apiVersion string) (int, error) {
-	var tags []TagResource
-	endpoint := fmt.Sprintf("/api/%s/tag", apiVersion)
-	if err := c.Get(ctx, endpoint, &tags); err != nil {
-		return 0, fmt.Errorf("failed to get tags: %w", err)
-	}
+// tagLabelInvalid matches the characters the apps reject in tag labels
+var tagLabelInvalid = regexp.MustCompile(`[^a-z0-9-]+`)
 
-	for _, tag := range tags {
-		if tag.Label == OwnershipTagName {
-			return tag.ID, nil
+// ownershipTagHashLength is the number of hex characters of the namespace/name
+// hash that end a per-config ownership tag
+const ownershipTagHashLength = 8
+
+// hashedOwnershipTag matches a per-config ownership tag, capturing the tag
+// earlier versions gave the same config
+var hashedOwnershipTag = regexp.MustCompile(fmt.Sprintf(`^(nebularr-.+)-[0-9a-f]{%d}$`, ownershipTagHashLength))
+
+// ConfigOwnershipTag returns the tag marking the resources of one config,
+// nebularr-<namespace>-<name>-<hash>, with characters the apps don't allow replaced
+// by dashes. The hash of namespace/name keeps configs apart whose readable part
+// is the same, e.g. a-b/c and a/b-c.
+func ConfigOwnershipTag(namespace, name string) string {
+	sum := sha256.Sum256([]byte(namespace + "/" + name))
+	label := tagLabelInvalid.ReplaceAllString(strings.ToLower(fmt.Sprintf("nebularr-%s-%s", namespace, name)), "-")
+	return label + "-" + hex.EncodeToString(sum[:])[:ownershipTagHashLength]
+}
+
+// FindOwnershipTag finds the ownership tag with the given label in labels (tag
+// labels by ID). Without it, it finds the tag earlier versions gave the config,
+// its label without the hash, and reports legacy so the caller renames that tag.
+func FindOwnershipTag(labels map[int]string, label string) (id int, legacy bool, found bool) {
+	for id, l := range labels {
+		if strings.EqualFold(l, label) {
+			return id, false, true
 		}
 	}
+	match := hashedOwnershipTag.FindStringSubmatch(label)
+	if match == nil {
+		return 0, false, false
+	}
+	for id, l := range labels {
+		if strings.EqualFold(l, match[1]) {
+			return id, true, true
+		}
+	}
+	return 0, false, false
+}
 
-	return 0, fmt.Errorf("ownership tag %q not found", OwnershipTagName)
+// OwnershipTagLabel returns the ownership tag of a connection, falling back to the
+// shared tag when the connection has no per-config tag
+func OwnershipTagLabel(conn *irv1.ConnectionIR) string {
+	if conn == nil || conn.OwnerTag == "" {
+		return OwnershipTagName
+	}
+	return conn.OwnerTag
+}
+
+// GetOwnershipTagID retrieves the ID of the ownership tag with the given label,
+// or of the tag earlier versions gave the config until EnsureOwnershipTag renames it.
+// apiVersion should be "v1" or "v3" depending on the service.
+func GetOwnershipTagID(ctx context.Context, c *httpclient.Client, apiVersion, label string) (int, error) {
+	labels, err := GetTagLabels(ctx, c, apiVersion)
+	if err != nil {
+		return 0, err
+	}
+	if id, _, found := FindOwnershipTag(labels, label); found {
+		return id, nil
+	}
+	return 0, fmt.Errorf("ownership tag %q not found", label)
 }
 
 // EnsureOwnershipTag creates the ownership tag if it doesn't exist and returns its ID.
+// A tag earlier versions gave the config is renamed instead, keeping its resources.
 // apiVersion should be "v1" or "v3" depending on the service.
-func EnsureOwnershipTag(ctx context.Context, c *httpclient.Client, apiVersion string) (int, error) {
-	// Check if tag exists
-	tagID, err := GetOwnershipTagID(ctx, c, apiVersion)
-	if err == nil {
-		return tagID, nil
+func EnsureOwnershipTag(ctx context.Context, c *httpclient.Client, apiVersion, label string) (int, error) {
+	labels, err := GetTagLabels(ctx, c, apiVersion)
+	if err != nil {
+		return 0, err
 	}
+	endpoint := fmt.Sprintf("/api/%s/tag", apiVersion)
 
-	// Create the tag
-	tag := TagResource{
-		Label: OwnershipTagName,
+	id, legacy, found := FindOwnershipTag(labels, label)
+	if found && !legacy {
+		return id, nil
+	}
+	if found {
+		if err := c.Put(ctx, fmt.Sprintf("%s/%d", endpoint, id), TagResource{ID: id, Label: label}, nil); err != nil {
+			return 0, fmt.Errorf("failed to rename ownership tag %q: %w", labels[id], err)
+		}
+		return id, nil
 	}
 
-	endpoint := fmt.Sprintf("/api/%s/tag", apiVersion)
+	// Create the tag
 	var created TagResource
-	if err := c.Post(ctx, endpoint, tag, &created); err != nil {
+	if err := c.Post(ctx, endpoint, TagResource{Label: label}, &created); err != nil {
 		return 0, fmt.Errorf("failed to create ownership tag: %w", err)
 	}
 
 	return created.ID, nil
 }
 
+// AdoptLegacyResource moves the resource with the given name from the shared
+// nebularr-managed tag to the config's tag (ownerID). It reports false when no such
+// resource carries the shared tag, so the caller creates the resource instead.
+// endpoint is the resource collection, e.g. /api/v3/downloadclient.
+func AdoptLegacyResource(ctx context.Context, c *httpclient.Client, apiVersion, endpoint, name string, ownerID int) (bool, error) {
+	legacyID, err := GetOwnershipTagID(ctx, c, apiVersion, OwnershipTagName)
+	if err != nil || legacyID == ownerID {
+		// No legacy tag, so nothing to adopt
+		return false, nil
+	}
+
+	var items []map[string]interface{}
+	if err := c.Get(ctx, endpoint, &items); err != nil {
+		return false, fmt.Errorf("failed to get %s: %w", endpoint, err)
+	}
+	for _, item := range items {
+		if itemName, _ := item["name"].(string); !strings.EqualFold(itemName, name) {
+			continue
+		}
+		tags := rawTagIDs(item["tags"])
+		if !HasTag(tags, legacyID) {
+			return false, nil
+		}
+
+		retagged := make([]int, 0, len(tags))
+		for _, t := range tags {
+			if t != legacyID && t != ownerID {
+				retagged = append(retagged, t)
+			}
+		}
+		item["tags"] = append(retagged, ownerID)
+
+		id, ok := item["id"].(float64)
+		if !ok {
+			return false, fmt.Errorf("resource %s in %s has no id", name, endpoint)
+		}
+		if err := c.Put(ctx, fmt.Sprintf("%s/%d", endpoint, int(id)), item, nil); err != nil {
+			return false, fmt.Errorf("failed to adopt %s: %w", name, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// AdoptingCreate wraps a create callback so that creating a resource of one of the
+// given types first adopts a same-named resource carrying the shared tag. An adopted
+// resource counts as created; the next reconcile updates it if it differs from spec.
+// endpoints maps resource types (adapters.ResourceDownloadClient, ...) to collections.
+func AdoptingCreate(ctx context.Context, c *httpclient.Client, apiVersion string, endpoints map[string]string, ownerID int, create func(adapters.Change) error) func(adapters.Change) error {
+	return func(change adapters.Change) error {
+		if endpoint, ok := endpoints[change.ResourceType]; ok {
+			adopted, err := AdoptLegacyResource(ctx, c, apiVersion, endpoint, change.Name, ownerID)
+			if err != nil || adopted {
+				return err
+			}
+		}
+		return create(change)
+	}
+}
+
+// rawTagIDs reads the tags array of a resource decoded into a generic map
+func rawTagIDs(value interface{}) []int {
+	raw, _ := value.([]interface{})
+	ids := make([]int, 0, len(raw))
+	for _, v := range raw {
+		if id, ok := v.(float64); ok {
+			ids = append(ids, int(id))
+		}
+	}
+	return ids
+}
+
 // HasTag checks if an array of tag IDs contains the specified tag ID.
 func HasTag(tags []int, tagID int) bool {
 	for _, t := range tags {
@@ -61,3 +195,54 @@ func HasTag(tags []int, tagID int) bool {
 	}
 	return false
 }
+
+// GetTagLabels returns tag labels keyed by tag ID.
+// apiVersion should be "v1" or "v3" depending on the service.
+func GetTagLabels(ctx context.Context, c *httpclient.Client, apiVersion string) (map[int]string, error) {
+	var tags []TagResource
+	endpoint := fmt.Sprintf("/api/%s/tag", apiVersion)
+	if err := c.Get(ctx, endpoint, &tags); err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	labels := make(map[int]string, len(tags))
+	for _, tag := range tags {
+		labels[tag.ID] = tag.Label
+	}
+	return labels, nil
+}
+
+// EnsureTagIDs resolves tag names to IDs, creating tags that don't exist yet.
+// The apps store tag labels lowercase, so names are matched case-insensitively.
+func EnsureTagIDs(ctx context.Context, c *httpclient.Client, apiVersion string, names []string) ([]int, error) {
+	if len(names) == 0 {
+		return []int{}, nil
+	}
+
+	labels, err := GetTagLabels(ctx, c, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	byLabel := make(map[string]int, len(labels))
+	for id, label := range labels {
+		byLabel[strings.ToLower(label)] = id
+	}
+
+	endpoint := fmt.Sprintf("/api/%s/tag", apiVersion)
+	ids := make([]int, 0, len(names))
+	for _, name := range names {
+		label := strings.ToLower(name)
+		if id, ok := byLabel[label]; ok {
+			ids = append(ids, id)
+			continue
+		}
+
+		var created TagResource
+		if err := c.Post(ctx, endpoint, TagResource{Label: label}, &created); err != nil {
+			return nil, fmt.Errorf("failed to create tag %q: %w", label, err)
+		}
+		byLabel[label] = created.ID
+		ids = append(ids, created.ID)
+	}
+	return ids, nil
+}
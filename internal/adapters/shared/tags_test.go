@@ -0,0 +1,135 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestConfigOwnershipTag(t *testing.T) {
+	if got := ConfigOwnershipTag("media", "movies"); !strings.HasPrefix(got, "nebularr-media-movies-") || len(got) != len("nebularr-media-movies-")+8 {
+		t.Errorf("ConfigOwnershipTag() = %q", got)
+	}
+	if got := ConfigOwnershipTag("media", "Movies.4K"); !strings.HasPrefix(got, "nebularr-media-movies-4k-") {
+		t.Errorf("ConfigOwnershipTag() = %q, want dots replaced", got)
+	}
+	if ConfigOwnershipTag("a-b", "c") == ConfigOwnershipTag("a", "b-c") {
+		t.Errorf("ConfigOwnershipTag() gives a-b/c and a/b-c the same tag")
+	}
+	if got := OwnershipTagLabel(&irv1.ConnectionIR{}); got != OwnershipTagName {
+		t.Errorf("OwnershipTagLabel() = %q, want the shared tag without an owner", got)
+	}
+}
+
+func TestEnsureOwnershipTag(t *testing.T) {
+	label := ConfigOwnershipTag("media", "movies")
+	tests := []struct {
+		name       string
+		tags       string
+		wantID     int
+		wantRename bool
+		wantCreate bool
+	}{
+		{name: "existing", tags: `[{"id":5,"label":"` + label + `"},{"id":6,"label":"nebularr-media-movies"}]`, wantID: 5},
+		{name: "renames the tag of earlier versions", tags: `[{"id":6,"label":"nebularr-media-movies"}]`, wantID: 6, wantRename: true},
+		{name: "ignores other configs' tags", tags: `[{"id":7,"label":"nebularr-media-movies-4k"}]`, wantID: 9, wantCreate: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var renamed, created TagResource
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v3/tag":
+					_, _ = w.Write([]byte(tt.tags))
+				case r.Method == http.MethodPut && r.URL.Path == "/api/v3/tag/6":
+					_ = json.NewDecoder(r.Body).Decode(&renamed)
+					_, _ = w.Write([]byte(`{}`))
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v3/tag":
+					_ = json.NewDecoder(r.Body).Decode(&created)
+					_, _ = w.Write([]byte(`{"id":9}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+			c := httpclient.New(httpclient.Config{BaseURL: server.URL, APIKey: "key"})
+
+			id, err := EnsureOwnershipTag(context.Background(), c, "v3", label)
+			if err != nil {
+				t.Fatalf("EnsureOwnershipTag() error = %v", err)
+			}
+			if id != tt.wantID {
+				t.Errorf("EnsureOwnershipTag() = %d, want %d", id, tt.wantID)
+			}
+			if got := renamed.Label == label; got != tt.wantRename {
+				t.Errorf("renamed = %+v, want rename %v", renamed, tt.wantRename)
+			}
+			if got := created.Label == label; got != tt.wantCreate {
+				t.Errorf("created = %+v, want create %v", created, tt.wantCreate)
+			}
+
+			// The fake server doesn't store changes, so a created tag is still missing
+			if _, err := GetOwnershipTagID(context.Background(), c, "v3", label); (err != nil) != tt.wantCreate {
+				t.Errorf("GetOwnershipTagID() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestAdoptingCreate(t *testing.T) {
+	var put map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/tag":
+			_, _ = w.Write([]byte(`[{"id":1,"label":"nebularr-managed"},{"id":5,"label":"nebularr-media-movies"},{"id":7,"label":"4k"}]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/downloadclient":
+			_, _ = w.Write([]byte(`[{"id":3,"name":"qbittorrent","tags":[1,7]},{"id":4,"name":"other","tags":[9]}]`))
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v3/downloadclient/3":
+			_ = json.NewDecoder(r.Body).Decode(&put)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL, APIKey: "key"})
+	var created []string
+	create := AdoptingCreate(context.Background(), c, "v3",
+		map[string]string{adapters.ResourceDownloadClient: "/api/v3/downloadclient"}, 5,
+		func(change adapters.Change) error {
+			created = append(created, change.Name)
+			return nil
+		})
+
+	// A legacy resource with the same name is re-tagged instead of created
+	if err := create(adapters.Change{ResourceType: adapters.ResourceDownloadClient, Name: "qbittorrent"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if put == nil || !reflect.DeepEqual(put["tags"], []interface{}{float64(7), float64(5)}) {
+		t.Errorf("expected tags [7 5] on the adopted client, got %v", put)
+	}
+
+	// Resources without the legacy tag, and untracked types, are created
+	for _, change := range []adapters.Change{
+		{ResourceType: adapters.ResourceDownloadClient, Name: "other"},
+		{ResourceType: adapters.ResourceDownloadClient, Name: "sabnzbd"},
+		{ResourceType: adapters.ResourceQualityProfile, Name: "nebularr-hd"},
+	} {
+		if err := create(change); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if want := []string{"other", "sabnzbd", "nebularr-hd"}; !reflect.DeepEqual(created, want) {
+		t.Errorf("created %v, want %v", created, want)
+	}
+}
@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// UIConfigAPIPath is the UI settings endpoint of Radarr and Sonarr
+const UIConfigAPIPath = "/api/v3/config/ui"
+
+// ApplyUIConfig updates the UI settings the IR sets, sending nothing when no
+// field changes.
+func ApplyUIConfig(ctx context.Context, c *httpclient.Client, ir *irv1.UIConfigIR) error {
+	if ir == nil {
+		return nil
+	}
+
+	patch, err := FetchConfigPatch(ctx, c, UIConfigAPIPath, "UI config")
+	if err != nil {
+		return err
+	}
+	if ir.FirstDayOfWeek != nil {
+		patch.Set("firstDayOfWeek", *ir.FirstDayOfWeek)
+	}
+	if ir.TimeFormat != "" {
+		patch.Set("timeFormat", ir.TimeFormat)
+	}
+	if ir.Theme != "" {
+		patch.Set("theme", ir.Theme)
+	}
+	if ir.UILanguage != 0 {
+		patch.Set("uiLanguage", ir.UILanguage)
+	}
+	if ir.MovieRuntimeFormat != "" {
+		patch.Set("movieRuntimeFormat", ir.MovieRuntimeFormat)
+	}
+	return patch.Apply(ctx, c)
+}
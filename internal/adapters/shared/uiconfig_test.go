@@ -0,0 +1,62 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestApplyUIConfig(t *testing.T) {
+	stored := map[string]interface{}{
+		"id": 1, "firstDayOfWeek": 0, "timeFormat": "h(:mm)a", "theme": "auto", "uiLanguage": 1,
+		"showRelativeDates": true,
+	}
+	var puts []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == UIConfigAPIPath:
+			_ = json.NewEncoder(w).Encode(stored)
+		case r.Method == http.MethodPut && r.URL.Path == UIConfigAPIPath+"/1":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			puts = append(puts, body)
+			stored = body
+			_ = json.NewEncoder(w).Encode(body)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL})
+	ctx := context.Background()
+
+	monday := 1
+	ir := &irv1.UIConfigIR{FirstDayOfWeek: &monday, TimeFormat: "HH:mm", Theme: "dark", UILanguage: 4}
+	if err := ApplyUIConfig(ctx, c, ir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(puts) != 1 {
+		t.Fatalf("expected one update, got %d", len(puts))
+	}
+	got := puts[0]
+	if got["firstDayOfWeek"] != float64(1) || got["timeFormat"] != "HH:mm" || got["theme"] != "dark" || got["uiLanguage"] != float64(4) {
+		t.Errorf("unexpected update %v", got)
+	}
+	// Fields the IR leaves unset are sent back as read
+	if got["showRelativeDates"] != true {
+		t.Errorf("expected showRelativeDates to be kept, got %v", got["showRelativeDates"])
+	}
+
+	// Unchanged settings send nothing
+	if err := ApplyUIConfig(ctx, c, ir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(puts) != 1 {
+		t.Errorf("expected no update for unchanged settings, got %d", len(puts))
+	}
+}
@@ -4,6 +4,7 @@ package sonarr
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -13,6 +14,13 @@ import (
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
+// ownedEndpoints are the collections of the resource types carrying the ownership tag
+var ownedEndpoints = map[string]string{
+	adapters.ResourceDownloadClient: "/api/v3/downloadclient",
+	adapters.ResourceIndexer:        "/api/v3/indexer",
+	adapters.ResourceNotification:   "/api/v3/notification",
+}
+
 // Adapter implements the adapters.Adapter interface for Sonarr
 type Adapter struct{}
 
@@ -99,17 +107,18 @@ func (a *Adapter) CurrentState(ctx context.Context, conn *irv1.ConnectionIR) (*i
 		Connection:  conn,
 	}
 
-	// Get ownership tag ID
-	tagID, err := a.getOwnershipTagID(ctx, c)
+	// Get ownership tag ID. Without the tag nothing is tagged as managed yet, but
+	// resources recognised by name (e.g., nebularr- quality profiles) are still read.
+	tagID, err := a.getOwnershipTagID(ctx, c, conn)
 	if err != nil {
-		// No ownership tag means no managed resources
-		return ir, nil
+		tagID = 0
 	}
 
 	// Get quality profiles
 	if profiles, err := a.getManagedQualityProfiles(ctx, c, tagID); err == nil && len(profiles) > 0 {
 		ir.Quality = &irv1.QualityIR{
-			Video: profiles[0],
+			Video:         profiles[0],
+			VideoProfiles: profiles[1:],
 		}
 	}
 
@@ -173,6 +182,11 @@ func (a *Adapter) CurrentState(ctx context.Context, conn *irv1.ConnectionIR) (*i
 		ir.DelayProfiles = delayProfiles
 	}
 
+	// Get auto-tagging rules (identified by name, since their tags are applied to series)
+	if autoTags, err := a.getManagedAutoTags(ctx, c, conn); err == nil {
+		ir.AutoTags = autoTags
+	}
+
 	return ir, nil
 }
 
@@ -229,6 +243,11 @@ func (a *Adapter) Diff(current, desired *irv1.IR, caps *adapters.Capabilities) (
 		return nil, fmt.Errorf("failed to diff delay profiles: %w", err)
 	}
 
+	// Diff auto-tagging rules
+	if err := a.diffAutoTags(current, desired, changes); err != nil {
+		return nil, fmt.Errorf("failed to diff auto tags: %w", err)
+	}
+
 	return changes, nil
 }
 
@@ -237,15 +256,18 @@ func (a *Adapter) Apply(ctx context.Context, conn *irv1.ConnectionIR, changes *a
 	c := a.newClient(conn)
 
 	// Ensure ownership tag exists
-	tagID, err := a.ensureOwnershipTag(ctx, c)
+	tagID, err := a.ensureOwnershipTag(ctx, c, conn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to ensure ownership tag: %w", err)
 	}
 
-	// Use shared apply loop with adapter-specific callbacks
+	// Use shared apply loop with adapter-specific callbacks. Creates first adopt a
+	// same-named resource still carrying the shared ownership tag.
 	result := shared.ApplyChanges(
 		changes,
-		func(change adapters.Change) error { return a.applyCreate(ctx, c, change, tagID) },
+		shared.AdoptingCreate(ctx, c, "v3", ownedEndpoints, tagID, func(change adapters.Change) error {
+			return a.applyCreate(ctx, c, change, tagID)
+		}),
 		func(change adapters.Change) error { return a.applyUpdate(ctx, c, change, tagID) },
 		func(change adapters.Change) error { return a.applyDelete(ctx, c, change) },
 	)
@@ -260,7 +282,7 @@ func (a *Adapter) ApplyDirect(ctx context.Context, conn *irv1.ConnectionIR, ir *
 	c := a.newClient(conn)
 
 	// Ensure ownership tag exists
-	tagID, err := a.ensureOwnershipTag(ctx, c)
+	tagID, err := a.ensureOwnershipTag(ctx, c, conn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to ensure ownership tag: %w", err)
 	}
@@ -280,24 +302,82 @@ func (a *Adapter) ApplyDirect(ctx context.Context, conn *irv1.ConnectionIR, ir *
 				Errors:  stats.Errors,
 			}, nil
 		},
+		ApplyImportListOptions: func() error {
+			return a.applyImportListOptions(ctx, c, ir.ImportListOptions)
+		},
 		ApplyMediaManagement: func() error {
 			return a.applyMediaManagement(ctx, c, ir.MediaManagement)
 		},
 		ApplyAuthentication: func() error {
 			return a.applyAuthentication(ctx, c, ir.Authentication)
 		},
+		ApplyUIConfig: func() error {
+			return shared.ApplyUIConfig(ctx, c, ir.UI)
+		},
+		ApplyQualityDefinitions: func() error {
+			return a.applyQualityDefinitions(ctx, c, ir.QualityDefinitions)
+		},
 	})
 
 	return result, nil
 }
 
+// Ensure Adapter implements PathChecker
+var _ adapters.PathChecker = (*Adapter)(nil)
+
+// CheckPath looks a root folder path up through Sonarr's filesystem API
+func (a *Adapter) CheckPath(ctx context.Context, conn *irv1.ConnectionIR, path string) error {
+	return shared.CheckPath(ctx, a.newClient(conn), "v3", path)
+}
+
+// Ensure Adapter implements RawRequester
+var _ adapters.RawRequester = (*Adapter)(nil)
+
+// SendRaw sends an unmodeled API request to Sonarr (spec.raw)
+func (a *Adapter) SendRaw(ctx context.Context, conn *irv1.ConnectionIR, method, path string, body []byte) error {
+	return shared.SendRaw(ctx, a.newClient(conn), method, path, body)
+}
+
+// Ensure Adapter implements RefReader
+var _ adapters.RefReader = (*Adapter)(nil)
+
+// ManagedRefs picks the Sonarr IDs of the resources declared in desired out of current
+func (a *Adapter) ManagedRefs(current, desired *irv1.IR) *adapters.ManagedRefs {
+	return shared.ManagedRefsFromIR(current, desired)
+}
+
+// Ensure Adapter implements QueueReader
+var _ adapters.QueueReader = (*Adapter)(nil)
+
+// QueuedDownloadIDs returns the download IDs of the Sonarr queue
+func (a *Adapter) QueuedDownloadIDs(ctx context.Context, conn *irv1.ConnectionIR) (map[string]bool, error) {
+	return shared.ReadQueuedDownloadIDs(ctx, a.newClient(conn), "/api/v3/queue?includeUnknownSeriesItems=true")
+}
+
+// Ensure Adapter implements BlocklistManager
+var _ adapters.BlocklistManager = (*Adapter)(nil)
+
+// GetBlocklist returns Sonarr's blocklist (spec.maintenance)
+func (a *Adapter) GetBlocklist(ctx context.Context, conn *irv1.ConnectionIR) ([]adapters.BlocklistEntry, error) {
+	return shared.GetBlocklist(ctx, a.newClient(conn), "v3")
+}
+
+// RemoveBlocklistEntry removes one entry from Sonarr's blocklist
+func (a *Adapter) RemoveBlocklistEntry(ctx context.Context, conn *irv1.ConnectionIR, id int) error {
+	return shared.RemoveBlocklistEntry(ctx, a.newClient(conn), "v3", id)
+}
+
+// Ensure Adapter implements Snapshotter
+var _ adapters.Snapshotter = (*Adapter)(nil)
+
+// Snapshot returns the raw bodies of the managed quality profiles and custom formats
+func (a *Adapter) Snapshot(ctx context.Context, conn *irv1.ConnectionIR) (map[string]map[string]json.RawMessage, error) {
+	return shared.SnapshotManaged(ctx, a.newClient(conn))
+}
+
 // newClient creates a new HTTP client for Sonarr API communication
 func (a *Adapter) newClient(conn *irv1.ConnectionIR) *httpclient.Client {
-	return httpclient.New(httpclient.Config{
-		BaseURL:            conn.URL,
-		APIKey:             conn.APIKey,
-		InsecureSkipVerify: conn.InsecureSkipVerify,
-	})
+	return httpclient.New(httpclient.ConfigForConnection(conn))
 }
 
 // Ensure Adapter implements HealthChecker
@@ -2,7 +2,9 @@ package sonarr
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
@@ -28,7 +30,9 @@ func (a *Adapter) applyCreate(ctx context.Context, c *httpclient.Client, change
 	case adapters.ResourceNotification:
 		return a.createNotification(ctx, c, change.Payload.(*irv1.NotificationIR), tagID)
 	case adapters.ResourceDelayProfile:
-		return a.createDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR), tagID)
+		return a.createDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR))
+	case adapters.ResourceAutoTag:
+		return a.createAutoTag(ctx, c, change.Payload.(*irv1.AutoTagIR))
 	default:
 		return fmt.Errorf("unsupported resource type for create: %s", change.ResourceType)
 	}
@@ -38,7 +42,7 @@ func (a *Adapter) applyCreate(ctx context.Context, c *httpclient.Client, change
 func (a *Adapter) applyUpdate(ctx context.Context, c *httpclient.Client, change adapters.Change, tagID int) error {
 	switch change.ResourceType {
 	case adapters.ResourceQualityProfile:
-		return a.updateQualityProfile(ctx, c, *change.ID, change.Payload.(*irv1.VideoQualityIR))
+		return a.updateQualityProfile(ctx, c, change.Payload.(*irv1.VideoQualityIR))
 	case adapters.ResourceCustomFormat:
 		return a.updateCustomFormat(ctx, c, change.Payload.(*irv1.CustomFormatIR))
 	case adapters.ResourceDownloadClient:
@@ -52,7 +56,11 @@ func (a *Adapter) applyUpdate(ctx context.Context, c *httpclient.Client, change
 	case adapters.ResourceNotification:
 		return a.updateNotification(ctx, c, change.Payload.(*irv1.NotificationIR), tagID)
 	case adapters.ResourceDelayProfile:
-		return a.updateDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR), tagID)
+		return a.updateDelayProfile(ctx, c, change.Payload.(*irv1.DelayProfileIR))
+	case adapters.ResourceDelayProfileOrder:
+		return shared.ReorderDelayProfiles(ctx, c, "v3", change.Payload.([]string))
+	case adapters.ResourceAutoTag:
+		return a.updateAutoTag(ctx, c, change.Payload.(*irv1.AutoTagIR))
 	default:
 		return fmt.Errorf("unsupported resource type for update: %s", change.ResourceType)
 	}
@@ -66,7 +74,7 @@ func (a *Adapter) applyDelete(ctx context.Context, c *httpclient.Client, change
 
 	switch change.ResourceType {
 	case adapters.ResourceQualityProfile:
-		return c.Delete(ctx, fmt.Sprintf("/api/v3/qualityprofile/%d", *change.ID))
+		return a.deleteQualityProfile(ctx, c, change.Name)
 	case adapters.ResourceCustomFormat:
 		return a.deleteCustomFormat(ctx, c, *change.ID)
 	case adapters.ResourceDownloadClient:
@@ -81,6 +89,8 @@ func (a *Adapter) applyDelete(ctx context.Context, c *httpclient.Client, change
 		return a.deleteNotification(ctx, c, *change.ID)
 	case adapters.ResourceDelayProfile:
 		return a.deleteDelayProfile(ctx, c, *change.ID)
+	case adapters.ResourceAutoTag:
+		return a.deleteAutoTag(ctx, c, *change.ID)
 	default:
 		return fmt.Errorf("unsupported resource type for delete: %s", change.ResourceType)
 	}
@@ -88,10 +98,10 @@ func (a *Adapter) applyDelete(ctx context.Context, c *httpclient.Client, change
 
 // createQualityProfile creates a quality profile using the schema
 func (a *Adapter) createQualityProfile(ctx context.Context, c *httpclient.Client, profile *irv1.VideoQualityIR) error {
-	// Fetch schema to get all quality items with proper structure
-	var schema QualityProfileResource
-	if err := c.Get(ctx, "/api/v3/qualityprofile/schema", &schema); err != nil {
-		return fmt.Errorf("failed to get quality profile schema: %w", err)
+	// Fetch schema (or the profile to clone) to get all quality items with proper structure
+	schema, err := a.qualityProfileBase(ctx, c, profile)
+	if err != nil {
+		return err
 	}
 
 	// Build allowed qualities map from tiers
@@ -121,11 +131,16 @@ func (a *Adapter) createQualityProfile(ctx context.Context, c *httpclient.Client
 }
 
 // updateQualityProfile updates a quality profile using the schema
-func (a *Adapter) updateQualityProfile(ctx context.Context, c *httpclient.Client, id int, profile *irv1.VideoQualityIR) error {
-	// Fetch schema to get all quality items with proper structure
-	var schema QualityProfileResource
-	if err := c.Get(ctx, "/api/v3/qualityprofile/schema", &schema); err != nil {
-		return fmt.Errorf("failed to get quality profile schema: %w", err)
+func (a *Adapter) updateQualityProfile(ctx context.Context, c *httpclient.Client, profile *irv1.VideoQualityIR) error {
+	id, err := a.findQualityProfileID(ctx, c, profile.ProfileName)
+	if err != nil {
+		return err
+	}
+
+	// Fetch schema (or the profile to clone) to get all quality items with proper structure
+	schema, err := a.qualityProfileBase(ctx, c, profile)
+	if err != nil {
+		return err
 	}
 
 	// Build allowed qualities map from tiers
@@ -152,7 +167,56 @@ func (a *Adapter) updateQualityProfile(ctx context.Context, c *httpclient.Client
 		CutoffFormatScore:     profile.UpgradeUntilCustomFormatScore,
 	}
 
-	return c.Put(ctx, fmt.Sprintf("/api/v3/qualityprofile/%d", id), resource, nil)
+	return putWithRollback(ctx, c, fmt.Sprintf("/api/v3/qualityprofile/%d", id), resource)
+}
+
+// deleteQualityProfile deletes the quality profile with the given name
+func (a *Adapter) deleteQualityProfile(ctx context.Context, c *httpclient.Client, name string) error {
+	id, err := a.findQualityProfileID(ctx, c, name)
+	if err != nil {
+		return err
+	}
+	return c.Delete(ctx, fmt.Sprintf("/api/v3/qualityprofile/%d", id))
+}
+
+// qualityProfileBase returns the profile a managed profile is built from: the
+// schema, or the existing profile named by CloneFrom with its order, groups and
+// format scores
+func (a *Adapter) qualityProfileBase(ctx context.Context, c *httpclient.Client, profile *irv1.VideoQualityIR) (QualityProfileResource, error) {
+	if profile.CloneFrom == "" {
+		var schema QualityProfileResource
+		if err := c.Get(ctx, "/api/v3/qualityprofile/schema", &schema); err != nil {
+			return QualityProfileResource{}, fmt.Errorf("failed to get quality profile schema: %w", err)
+		}
+		return schema, nil
+	}
+
+	var profiles []QualityProfileResource
+	if err := c.Get(ctx, "/api/v3/qualityprofile", &profiles); err != nil {
+		return QualityProfileResource{}, fmt.Errorf("failed to get quality profiles: %w", err)
+	}
+	for _, p := range profiles {
+		if strings.EqualFold(p.Name, profile.CloneFrom) {
+			return p, nil
+		}
+	}
+	return QualityProfileResource{}, adapters.NewError(adapters.ErrorCategoryNotFound,
+		fmt.Errorf("quality profile %q to clone from not found", profile.CloneFrom))
+}
+
+// putWithRollback updates a resource and, if the update fails midway, restores
+// the body it had before
+func putWithRollback(ctx context.Context, c *httpclient.Client, endpoint string, body interface{}) error {
+	var previous json.RawMessage
+	if err := c.Get(ctx, endpoint, &previous); err != nil {
+		return fmt.Errorf("failed to read %s before update: %w", endpoint, err)
+	}
+	if err := c.Put(ctx, endpoint, body, nil); err != nil {
+		return shared.RollbackUpdate(err, previous, func(previous json.RawMessage) error {
+			return c.Put(ctx, endpoint, previous, nil)
+		})
+	}
+	return nil
 }
 
 // buildAllowedQualitiesMap creates a map of resolution -> sources that are allowed
@@ -377,6 +441,9 @@ func (a *Adapter) downloadClientFromIR(dc irv1.DownloadClientIR, tagID int) Down
 		RemoveCompletedDownloads: dc.RemoveCompletedDownloads,
 		RemoveFailedDownloads:    dc.RemoveFailedDownloads,
 	}
+	if dc.APIKey != "" {
+		resource.Fields = append(resource.Fields, Field{Name: "apiKey", Value: dc.APIKey})
+	}
 	return resource
 }
 
@@ -0,0 +1,131 @@
+package sonarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestCreateQualityProfileCloneFrom(t *testing.T) {
+	handTuned := QualityProfileResource{
+		ID:   4,
+		Name: "Hand Tuned",
+		Items: []QualityProfileItem{
+			{Quality: &Quality{ID: 4, Source: "television", Resolution: 720}, Allowed: true},
+			{ID: 1001, Name: "WEB 1080p", Allowed: true, Items: []QualityProfileItem{
+				{Quality: &Quality{ID: 3, Source: "web", Resolution: 1080}, Allowed: true},
+				{Quality: &Quality{ID: 15, Source: "webRip", Resolution: 1080}, Allowed: true},
+			}},
+		},
+		FormatItems:           []ProfileFormatItem{{Format: 1, Score: 50}, {Format: 2, Score: 10}},
+		MinUpgradeFormatScore: 5,
+	}
+
+	var posted QualityProfileResource
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/qualityprofile":
+			_ = json.NewEncoder(w).Encode([]QualityProfileResource{handTuned})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/customformat":
+			_ = json.NewEncoder(w).Encode([]CustomFormatResource{})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v3/qualityprofile":
+			_ = json.NewDecoder(r.Body).Decode(&posted)
+			_ = json.NewEncoder(w).Encode(posted)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL})
+
+	profile := &irv1.VideoQualityIR{
+		ProfileName: "nebularr-tv",
+		CloneFrom:   "hand tuned",
+		Tiers:       []irv1.VideoQualityTierIR{{Resolution: "1080p", Sources: []string{"webdl"}, Allowed: true}},
+		Cutoff:      irv1.VideoQualityTierIR{Resolution: "1080p", Sources: []string{"webdl"}},
+	}
+	a := &Adapter{}
+	if err := a.createQualityProfile(context.Background(), c, profile); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if posted.ID != 0 || posted.Name != "nebularr-tv" {
+		t.Errorf("expected a new profile named nebularr-tv, got id %d name %q", posted.ID, posted.Name)
+	}
+	wantItems := []QualityProfileItem{
+		{Quality: &Quality{ID: 4, Source: "television", Resolution: 720}, Allowed: false},
+		{ID: 1001, Name: "WEB 1080p", Allowed: true, Items: []QualityProfileItem{
+			{Quality: &Quality{ID: 3, Source: "web", Resolution: 1080}, Allowed: true},
+			{Quality: &Quality{ID: 15, Source: "webRip", Resolution: 1080}, Allowed: false},
+		}},
+	}
+	if !reflect.DeepEqual(posted.Items, wantItems) {
+		t.Errorf("expected the cloned groups with the preset's qualities, got %+v", posted.Items)
+	}
+	if posted.Cutoff != 1001 {
+		t.Errorf("cutoff = %d, want the group 1001", posted.Cutoff)
+	}
+	if !reflect.DeepEqual(posted.FormatItems, handTuned.FormatItems) || posted.MinUpgradeFormatScore != 5 {
+		t.Errorf("expected the cloned format scores, got %+v (min upgrade %d)", posted.FormatItems, posted.MinUpgradeFormatScore)
+	}
+
+	profile.CloneFrom = "Missing"
+	err := a.createQualityProfile(context.Background(), c, profile)
+	if adapters.CategoryOf(err) != adapters.ErrorCategoryNotFound {
+		t.Errorf("expected a NotFound error for a missing profile, got %v", err)
+	}
+}
+
+func TestPutWithRollback(t *testing.T) {
+	previous := map[string]interface{}{"id": float64(3), "name": "nebularr-tv", "cutoff": float64(7)}
+	var puts []map[string]interface{}
+	failPuts := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(previous)
+		case http.MethodPut:
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			puts = append(puts, body)
+			if len(puts) <= failPuts {
+				http.Error(w, "cutoff must be an allowed quality", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(body)
+		}
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL})
+	ctx := context.Background()
+	update := map[string]interface{}{"id": 3, "name": "nebularr-tv", "cutoff": 9}
+
+	if err := putWithRollback(ctx, c, "/api/v3/qualityprofile/3", update); err == nil || !strings.Contains(err.Error(), "rolled back") {
+		t.Fatalf("expected a rolled back error, got %v", err)
+	}
+	if len(puts) != 2 || !reflect.DeepEqual(puts[1], previous) {
+		t.Fatalf("expected the update then the previous body, got %v", puts)
+	}
+
+	puts, failPuts = nil, 2
+	if err := putWithRollback(ctx, c, "/api/v3/qualityprofile/3", update); err == nil || !strings.Contains(err.Error(), "rollback failed") {
+		t.Errorf("expected a rollback failed error, got %v", err)
+	}
+
+	// A successful update sends only the new body
+	puts, failPuts = nil, 0
+	if err := putWithRollback(ctx, c, "/api/v3/qualityprofile/3", update); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(puts) != 1 {
+		t.Errorf("expected one update, got %d", len(puts))
+	}
+}
@@ -0,0 +1,64 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// getManagedAutoTags retrieves the auto-tagging rules of the connection's config
+func (a *Adapter) getManagedAutoTags(ctx context.Context, c *httpclient.Client, conn *irv1.ConnectionIR) ([]irv1.AutoTagIR, error) {
+	var rules []shared.AutoTaggingResource
+	if err := c.Get(ctx, "/api/v3/autotagging", &rules); err != nil {
+		return nil, fmt.Errorf("failed to get auto tags: %w", err)
+	}
+
+	labels, err := shared.GetTagLabels(ctx, c, "v3")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []irv1.AutoTagIR
+	for i := range rules {
+		if shared.IsManagedAutoTag(rules[i].Name, shared.OwnershipTagLabel(conn)) {
+			result = append(result, shared.AutoTaggingToIR(&rules[i], labels))
+		}
+	}
+
+	return result, nil
+}
+
+// diffAutoTags computes changes needed for auto-tagging rules using shared logic
+func (a *Adapter) diffAutoTags(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
+	shared.DiffAutoTags(current.AutoTags, desired.AutoTags, changes)
+	return nil
+}
+
+// createAutoTag creates an auto-tagging rule, creating any tags it applies
+func (a *Adapter) createAutoTag(ctx context.Context, c *httpclient.Client, ir *irv1.AutoTagIR) error {
+	tagIDs, err := shared.EnsureTagIDs(ctx, c, "v3", ir.TagNames)
+	if err != nil {
+		return err
+	}
+
+	return c.Post(ctx, "/api/v3/autotagging", shared.IRToAutoTagging(ir, tagIDs), nil)
+}
+
+// updateAutoTag updates an existing auto-tagging rule
+func (a *Adapter) updateAutoTag(ctx context.Context, c *httpclient.Client, ir *irv1.AutoTagIR) error {
+	tagIDs, err := shared.EnsureTagIDs(ctx, c, "v3", ir.TagNames)
+	if err != nil {
+		return err
+	}
+
+	return c.Put(ctx, fmt.Sprintf("/api/v3/autotagging/%d", ir.ID), shared.IRToAutoTagging(ir, tagIDs), nil)
+}
+
+// deleteAutoTag deletes an auto-tagging rule
+func (a *Adapter) deleteAutoTag(ctx context.Context, c *httpclient.Client, id int) error {
+	return c.Delete(ctx, fmt.Sprintf("/api/v3/autotagging/%d", id))
+}
@@ -0,0 +1,51 @@
+package sonarr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestGetManagedAutoTags(t *testing.T) {
+	ownerTag := shared.ConfigOwnershipTag("media", "tv")
+	other := shared.ConfigOwnershipTag("media", "tv-4k")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/autotagging":
+			_, _ = w.Write([]byte(`[` +
+				`{"id":1,"name":"` + ownerTag + `-anime","tags":[7],"specifications":[]},` +
+				`{"id":2,"name":"` + other + `-anime","tags":[7],"specifications":[]},` +
+				`{"id":3,"name":"nebularr-tv-anime","tags":[7],"specifications":[]},` +
+				`{"id":4,"name":"Kids","tags":[8],"specifications":[]}]`))
+		case "/api/v3/tag":
+			_, _ = w.Write([]byte(`[{"id":7,"label":"anime"},{"id":8,"label":"kids"}]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	c := httpclient.New(httpclient.Config{BaseURL: server.URL})
+
+	a := &Adapter{}
+	rules, err := a.getManagedAutoTags(context.Background(), c, &irv1.ConnectionIR{OwnerTag: ownerTag})
+	if err != nil {
+		t.Fatalf("getManagedAutoTags() error = %v", err)
+	}
+	var names []string
+	for _, rule := range rules {
+		names = append(names, rule.Name)
+	}
+	// Rules of another config, rules only named like managed ones and rules made in the UI are left alone
+	if want := []string{ownerTag + "-anime"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("getManagedAutoTags() = %v, want %v", names, want)
+	}
+	if !reflect.DeepEqual(rules[0].TagNames, []string{"anime"}) {
+		t.Errorf("TagNames = %v, want [anime]", rules[0].TagNames)
+	}
+}
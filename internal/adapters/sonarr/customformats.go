@@ -3,6 +3,7 @@ package sonarr
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
@@ -13,17 +14,15 @@ import (
 // getAllCustomFormats retrieves all custom formats from Sonarr
 // This is used by CurrentState to get the current state for diffing
 func (a *Adapter) getAllCustomFormats(ctx context.Context, c *httpclient.Client) ([]irv1.CustomFormatIR, error) {
-	var customFormats []CustomFormatResource
-	if err := c.Get(ctx, "/api/v3/customformat", &customFormats); err != nil {
+	var result []irv1.CustomFormatIR
+	err := httpclient.GetEach(ctx, c, "/api/v3/customformat", func(cf *CustomFormatResource) error {
+		result = append(result, a.customFormatToIR(cf))
+		return nil
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to get custom formats: %w", err)
 	}
 
-	result := make([]irv1.CustomFormatIR, 0, len(customFormats))
-	for _, cf := range customFormats {
-		ir := a.customFormatToIR(&cf)
-		result = append(result, ir)
-	}
-
 	return result, nil
 }
 
@@ -63,7 +62,7 @@ func (a *Adapter) customFormatToIR(cf *CustomFormatResource) irv1.CustomFormatIR
 }
 
 // irToCustomFormat converts IR to a Sonarr custom format resource
-func (a *Adapter) irToCustomFormat(ir *irv1.CustomFormatIR) CustomFormatResource {
+func (a *Adapter) irToCustomFormat(ir *irv1.CustomFormatIR) (CustomFormatResource, error) {
 	cf := CustomFormatResource{
 		BaseCustomFormatResource: shared.BaseCustomFormatResource{
 			ID:                              ir.ID,
@@ -74,41 +73,64 @@ func (a *Adapter) irToCustomFormat(ir *irv1.CustomFormatIR) CustomFormatResource
 	}
 
 	for _, spec := range ir.Specifications {
+		fields, err := buildSpecFields(spec)
+		if err != nil {
+			return CustomFormatResource{}, fmt.Errorf("specification %s: %w", spec.Name, err)
+		}
 		cfSpec := CustomFormatSpecification{
 			Name:           spec.Name,
 			Implementation: spec.Type,
 			Negate:         spec.Negate,
 			Required:       spec.Required,
-			Fields:         buildSpecFields(spec),
+			Fields:         fields,
 		}
 		cf.Specifications = append(cf.Specifications, cfSpec)
 	}
 
-	return cf
+	return cf, nil
 }
 
 // buildSpecFields creates the Fields array for a custom format specification
-func buildSpecFields(spec irv1.FormatSpecIR) []Field {
+func buildSpecFields(spec irv1.FormatSpecIR) ([]Field, error) {
 	switch spec.Type {
 	case "ReleaseTitleSpecification", "ReleaseGroupSpecification", "EditionSpecification":
 		return []Field{
 			{Name: "value", Value: spec.Value},
-		}
+		}, nil
 	case "SourceSpecification":
 		return []Field{
 			{Name: "value", Value: sourceToInt(spec.Value)},
-		}
+		}, nil
 	case "ResolutionSpecification":
 		return []Field{
 			{Name: "value", Value: resolutionToInt(spec.Value)},
+		}, nil
+	case "LanguageSpecification":
+		id, err := languageToInt(spec.Value)
+		if err != nil {
+			return nil, err
 		}
+		return []Field{
+			{Name: "value", Value: id},
+		}, nil
 	default:
 		return []Field{
 			{Name: "value", Value: spec.Value},
-		}
+		}, nil
 	}
 }
 
+// languageToInt converts a language ID string to the Sonarr language ID.
+// The compiler resolves language names to IDs, so anything else is rejected
+// rather than sent as Unknown (0).
+func languageToInt(language string) (int, error) {
+	id, err := strconv.Atoi(language)
+	if err != nil {
+		return 0, fmt.Errorf("unknown language %q", language)
+	}
+	return id, nil
+}
+
 // sourceToInt converts source string to Sonarr source enum value
 func sourceToInt(source string) int {
 	sources := map[string]int{
@@ -195,34 +217,15 @@ func (a *Adapter) diffCustomFormats(current, desired *irv1.IR, changes *adapters
 
 // customFormatsEqual checks if two custom formats are equal (ignoring ID)
 func customFormatsEqual(a, b irv1.CustomFormatIR) bool {
-	if a.IncludeWhenRenaming != b.IncludeWhenRenaming {
-		return false
-	}
-
-	if len(a.Specifications) != len(b.Specifications) {
-		return false
-	}
-
-	// Compare specifications
-	for i, specA := range a.Specifications {
-		specB := b.Specifications[i]
-		if specA.Type != specB.Type || specA.Name != specB.Name {
-			return false
-		}
-		if specA.Negate != specB.Negate || specA.Required != specB.Required {
-			return false
-		}
-		if specA.Value != specB.Value {
-			return false
-		}
-	}
-
-	return true
+	return adapters.CustomFormatsEqual(&a, &b)
 }
 
 // createCustomFormat creates a custom format in Sonarr
 func (a *Adapter) createCustomFormat(ctx context.Context, c *httpclient.Client, ir *irv1.CustomFormatIR) error {
-	customFormat := a.irToCustomFormat(ir)
+	customFormat, err := a.irToCustomFormat(ir)
+	if err != nil {
+		return fmt.Errorf("failed to build custom format: %w", err)
+	}
 
 	var created CustomFormatResource
 	if err := c.Post(ctx, "/api/v3/customformat", customFormat, &created); err != nil {
@@ -234,11 +237,13 @@ func (a *Adapter) createCustomFormat(ctx context.Context, c *httpclient.Client,
 
 // updateCustomFormat updates a custom format in Sonarr
 func (a *Adapter) updateCustomFormat(ctx context.Context, c *httpclient.Client, ir *irv1.CustomFormatIR) error {
-	customFormat := a.irToCustomFormat(ir)
+	customFormat, err := a.irToCustomFormat(ir)
+	if err != nil {
+		return fmt.Errorf("failed to build custom format: %w", err)
+	}
 
 	endpoint := fmt.Sprintf("/api/v3/customformat/%d", ir.ID)
-	var updated CustomFormatResource
-	if err := c.Put(ctx, endpoint, customFormat, &updated); err != nil {
+	if err := putWithRollback(ctx, c, endpoint, customFormat); err != nil {
 		return fmt.Errorf("failed to update custom format: %w", err)
 	}
 
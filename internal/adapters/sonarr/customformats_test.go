@@ -189,7 +189,10 @@ func TestIRToCustomFormat(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := a.irToCustomFormat(&tt.input)
+			result, err := a.irToCustomFormat(&tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
 
 			if result.ID != tt.expected.ID {
 				t.Errorf("expected ID %d, got %d", tt.expected.ID, result.ID)
@@ -459,3 +462,37 @@ func TestResolutionToInt(t *testing.T) {
 		})
 	}
 }
+
+func TestLanguageSpecificationRoundTrip(t *testing.T) {
+	spec := irv1.FormatSpecIR{Type: "LanguageSpecification", Name: "Language", Value: "-2"}
+
+	fields, err := buildSpecFields(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fields) != 1 || fields[0].Value != -2 {
+		t.Fatalf("expected language ID -2 as an int, got %+v", fields)
+	}
+
+	// Sonarr returns the ID as a JSON number
+	a := &Adapter{}
+	ir := a.customFormatToIR(&CustomFormatResource{
+		BaseCustomFormatResource: shared.BaseCustomFormatResource{
+			Name: "nebularr-shows-original-language",
+			Specifications: []CustomFormatSpecification{{
+				Name:           "Language",
+				Implementation: "LanguageSpecification",
+				Fields:         []Field{{Name: "value", Value: float64(-2)}},
+			}},
+		},
+	})
+	if got := ir.Specifications[0]; got != spec {
+		t.Errorf("expected %+v after round trip, got %+v", spec, got)
+	}
+}
+
+func TestLanguageSpecificationRejectsUnknownValues(t *testing.T) {
+	if _, err := buildSpecFields(irv1.FormatSpecIR{Type: "LanguageSpecification", Value: "klingon"}); err == nil {
+		t.Error("expected an error for a language that is not an ID")
+	}
+}
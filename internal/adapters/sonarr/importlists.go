@@ -3,8 +3,10 @@ package sonarr
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
@@ -74,6 +76,71 @@ func buildImportListFields(settings map[string]string, schema *ImportListResourc
 	return fields
 }
 
+// typedImportListFields translates the typed list settings to Sonarr fields
+func typedImportListFields(list *irv1.ImportListIR) []Field {
+	var fields []Field
+	switch {
+	case list.PlexWatchlist != nil:
+		fields = append(fields, Field{Name: "accessToken", Value: list.PlexWatchlist.AccessToken})
+	case list.PlexRSS != nil:
+		fields = append(fields, Field{Name: "url", Value: list.PlexRSS.URL})
+	case list.TraktList != nil:
+		trakt := list.TraktList
+		fields = append(fields,
+			Field{Name: "authUser", Value: trakt.Username},
+			Field{Name: "username", Value: trakt.Username},
+			Field{Name: "listname", Value: trakt.ListName},
+			Field{Name: "accessToken", Value: trakt.AccessToken},
+		)
+		if trakt.RefreshToken != "" {
+			fields = append(fields, Field{Name: "refreshToken", Value: trakt.RefreshToken})
+		}
+		if trakt.Limit > 0 {
+			fields = append(fields, Field{Name: "limit", Value: trakt.Limit})
+		}
+		if trakt.AdditionalParameters != "" {
+			fields = append(fields, Field{Name: "traktAdditionalParameters", Value: trakt.AdditionalParameters})
+		}
+	case list.Custom != nil:
+		fields = append(fields, Field{Name: "baseUrl", Value: list.Custom.URL})
+	}
+	return fields
+}
+
+// mergeImportListFields replaces fields with the overrides of the same name
+// and appends the remaining overrides
+func mergeImportListFields(fields, overrides []Field) []Field {
+	for _, o := range overrides {
+		replaced := false
+		for i := range fields {
+			if fields[i].Name == o.Name {
+				fields[i].Value = o.Value
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			fields = append(fields, o)
+		}
+	}
+	return fields
+}
+
+// traktTokenFields are the fields Sonarr updates when it refreshes Trakt tokens
+var traktTokenFields = []string{"accessToken", "refreshToken", "expires"}
+
+// keepTraktTokens replaces the Trakt token fields with the ones of the existing
+// list, so the tokens Sonarr refreshed since the last write aren't overwritten
+func keepTraktTokens(fields, existing []Field) []Field {
+	var kept []Field
+	for _, f := range existing {
+		if slices.Contains(traktTokenFields, f.Name) {
+			kept = append(kept, f)
+		}
+	}
+	return mergeImportListFields(fields, kept)
+}
+
 // ImportListApplyStats tracks the results of applying import lists
 type ImportListApplyStats struct {
 	Created int
@@ -108,6 +175,12 @@ func (a *Adapter) applyImportLists(
 		return nil, fmt.Errorf("failed to get import list schemas: %w", err)
 	}
 
+	// Import lists reference quality profiles by name
+	profileIDs, err := a.getQualityProfileIDs(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quality profiles: %w", err)
+	}
+
 	// Index existing by name
 	existingByName := make(map[string]*ImportListResource)
 	for i := range existing {
@@ -128,11 +201,27 @@ func (a *Adapter) applyImportLists(
 			continue
 		}
 
-		// Build fields from settings
-		fields := buildImportListFields(list.Settings, schema)
+		profileID, ok := profileIDs[list.QualityProfileName]
+		if !ok {
+			stats.Skipped++
+			stats.Errors = append(stats.Errors, fmt.Errorf("quality profile %q not found for import list %s", list.QualityProfileName, list.Name))
+			continue
+		}
+		list.QualityProfileID = profileID
+
+		// Series added by the list get the series default tags
+		seriesTagIDs, err := shared.EnsureTagIDs(ctx, c, "v3", list.Tags)
+		if err != nil {
+			stats.Skipped++
+			stats.Errors = append(stats.Errors, fmt.Errorf("failed to resolve tags for import list %s: %w", list.Name, err))
+			continue
+		}
+
+		// Build fields from settings; typed list settings take precedence
+		fields := mergeImportListFields(buildImportListFields(list.Settings, schema), typedImportListFields(&list))
 
 		// Build the payload
-		payload := a.irToImportList(&list, schema, fields, tagID)
+		payload := a.irToImportList(&list, schema, fields, append([]int{tagID}, seriesTagIDs...))
 
 		existingList := existingByName[list.Name]
 
@@ -146,6 +235,9 @@ func (a *Adapter) applyImportLists(
 		} else {
 			// Update existing import list
 			payload.ID = existingList.ID
+			if list.TraktList != nil && list.TraktList.KeepTokens {
+				payload.Fields = keepTraktTokens(payload.Fields, existingList.Fields)
+			}
 			if err := a.updateImportList(ctx, c, payload); err != nil {
 				stats.Errors = append(stats.Errors, fmt.Errorf("failed to update import list %s: %w", list.Name, err))
 			} else {
@@ -168,8 +260,9 @@ func (a *Adapter) applyImportLists(
 	return stats, nil
 }
 
-// irToImportList converts an IR import list to a Sonarr ImportListResource
-func (a *Adapter) irToImportList(ir *irv1.ImportListIR, schema *ImportListResource, fields []Field, tagID int) ImportListResource {
+// irToImportList converts an IR import list to a Sonarr ImportListResource.
+// tagIDs holds the ownership tag followed by the tags of the series defaults.
+func (a *Adapter) irToImportList(ir *irv1.ImportListIR, schema *ImportListResource, fields []Field, tagIDs []int) ImportListResource {
 	// Default monitor if not set
 	shouldMonitor := ir.ShouldMonitor
 	if shouldMonitor == "" {
@@ -195,7 +288,7 @@ func (a *Adapter) irToImportList(ir *irv1.ImportListIR, schema *ImportListResour
 		SeasonFolder:             ir.SeasonFolder,
 		ListType:                 "program",
 		ListOrder:                0,
-		Tags:                     []int{tagID},
+		Tags:                     tagIDs,
 		Fields:                   fields,
 	}
 }
@@ -268,3 +361,21 @@ func (a *Adapter) importListToIR(list *ImportListResource) irv1.ImportListIR {
 
 	return ir
 }
+
+// applyImportListOptions applies the global import list options, creating the
+// clean library tag when needed
+func (a *Adapter) applyImportListOptions(ctx context.Context, c *httpclient.Client, ir *irv1.ImportListOptionsIR) error {
+	if ir == nil {
+		return nil
+	}
+
+	tagID := 0
+	if ir.CleanLibraryTag != "" {
+		ids, err := shared.EnsureTagIDs(ctx, c, "v3", []string{ir.CleanLibraryTag})
+		if err != nil {
+			return fmt.Errorf("failed to resolve clean library tag: %w", err)
+		}
+		tagID = ids[0]
+	}
+	return shared.ApplyImportListOptions(ctx, c, ir, tagID)
+}
@@ -0,0 +1,63 @@
+package sonarr
+
+import (
+	"reflect"
+	"testing"
+
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestTypedImportListFields(t *testing.T) {
+	list := &irv1.ImportListIR{
+		Type: "TraktListImport",
+		TraktList: &irv1.TraktListImportIR{
+			Username:    "alice",
+			ListName:    "shows",
+			AccessToken: "t0ken",
+			Limit:       50,
+		},
+	}
+
+	fields := typedImportListFields(list)
+	expected := []Field{
+		{Name: "authUser", Value: "alice"},
+		{Name: "username", Value: "alice"},
+		{Name: "listname", Value: "shows"},
+		{Name: "accessToken", Value: "t0ken"},
+		{Name: "limit", Value: 50},
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("fields = %v, want %v", fields, expected)
+	}
+}
+
+func TestMergeImportListFields(t *testing.T) {
+	fields := mergeImportListFields(
+		[]Field{{Name: "url", Value: "http://old"}, {Name: "other", Value: "x"}},
+		[]Field{{Name: "url", Value: "https://rss.plex.tv/abc"}, {Name: "baseUrl", Value: "https://lists"}},
+	)
+	expected := []Field{
+		{Name: "url", Value: "https://rss.plex.tv/abc"},
+		{Name: "other", Value: "x"},
+		{Name: "baseUrl", Value: "https://lists"},
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("fields = %v, want %v", fields, expected)
+	}
+}
+
+func TestKeepTraktTokens(t *testing.T) {
+	fields := keepTraktTokens(
+		[]Field{{Name: "listname", Value: "shows"}, {Name: "accessToken", Value: "from-secret"}, {Name: "refreshToken", Value: "old-refresh"}},
+		[]Field{{Name: "listname", Value: "other"}, {Name: "accessToken", Value: "refreshed"}, {Name: "refreshToken", Value: "new-refresh"}, {Name: "expires", Value: "2026-11-01T00:00:00Z"}},
+	)
+	expected := []Field{
+		{Name: "listname", Value: "shows"},
+		{Name: "accessToken", Value: "refreshed"},
+		{Name: "refreshToken", Value: "new-refresh"},
+		{Name: "expires", Value: "2026-11-01T00:00:00Z"},
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("fields = %v, want %v", fields, expected)
+	}
+}
@@ -2,6 +2,7 @@ package sonarr
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
@@ -61,3 +62,55 @@ func (a *Adapter) diffIndexers(current, desired *irv1.IR, changes *adapters.Chan
 	adapters.DiffIndexersWithIR(currentIndexers, desiredIndexers, changes)
 	return nil
 }
+
+// Ensure Adapter implements IndexerTester
+var _ adapters.IndexerTester = (*Adapter)(nil)
+
+// findIndexerByName returns the Sonarr indexer with the given name
+func (a *Adapter) findIndexerByName(ctx context.Context, c *httpclient.Client, name string) (*IndexerResource, error) {
+	var indexers []IndexerResource
+	if err := c.Get(ctx, "/api/v3/indexer", &indexers); err != nil {
+		return nil, fmt.Errorf("failed to get indexers: %w", err)
+	}
+
+	for i := range indexers {
+		if indexers[i].Name == name {
+			return &indexers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("indexer %q not found", name)
+}
+
+// TestIndexer asks Sonarr to test the named indexer
+func (a *Adapter) TestIndexer(ctx context.Context, conn *irv1.ConnectionIR, name string) error {
+	c := a.newClient(conn)
+
+	idx, err := a.findIndexerByName(ctx, c, name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Post(ctx, "/api/v3/indexer/test", idx, nil); err != nil {
+		return fmt.Errorf("indexer test failed: %w", err)
+	}
+	return nil
+}
+
+// DisableIndexer turns off RSS and searches for the named indexer
+func (a *Adapter) DisableIndexer(ctx context.Context, conn *irv1.ConnectionIR, name string) error {
+	c := a.newClient(conn)
+
+	idx, err := a.findIndexerByName(ctx, c, name)
+	if err != nil {
+		return err
+	}
+
+	idx.EnableRss = false
+	idx.EnableAutomaticSearch = false
+	idx.EnableInteractiveSearch = false
+
+	if err := c.Put(ctx, fmt.Sprintf("/api/v3/indexer/%d", idx.ID), idx, nil); err != nil {
+		return fmt.Errorf("failed to disable indexer: %w", err)
+	}
+	return nil
+}
@@ -168,7 +168,7 @@ func (a *Adapter) diffNotifications(current, desired *irv1.IR, changes *adapters
 // notificationsEqual checks if two notifications are equal (ignoring ID)
 func notificationsEqual(a, b irv1.NotificationIR) bool {
 	// Compare implementation and events
-	if a.Implementation != b.Implementation {
+	if !adapters.ImplementationsEqual(a.Implementation, b.Implementation) {
 		return false
 	}
 	if a.OnGrab != b.OnGrab || a.OnDownload != b.OnDownload || a.OnUpgrade != b.OnUpgrade {
@@ -191,17 +191,8 @@ func notificationsEqual(a, b irv1.NotificationIR) bool {
 		return false
 	}
 
-	// Compare fields (simplified)
-	if len(a.Fields) != len(b.Fields) {
-		return false
-	}
-	for k, v := range a.Fields {
-		if bv, ok := b.Fields[k]; !ok || fmt.Sprintf("%v", v) != fmt.Sprintf("%v", bv) {
-			return false
-		}
-	}
-
-	return true
+	// The app returns every field of the schema; only the configured ones matter
+	return adapters.FieldsEqual(a.Fields, b.Fields)
 }
 
 // createNotification creates a notification in Sonarr
@@ -229,6 +220,31 @@ func (a *Adapter) updateNotification(ctx context.Context, c *httpclient.Client,
 	return nil
 }
 
+// Ensure Adapter implements NotificationTester
+var _ adapters.NotificationTester = (*Adapter)(nil)
+
+// TestNotification asks Sonarr to test the named notification
+func (a *Adapter) TestNotification(ctx context.Context, conn *irv1.ConnectionIR, name string) error {
+	c := a.newClient(conn)
+
+	var notifications []NotificationResource
+	if err := c.Get(ctx, "/api/v3/notification", &notifications); err != nil {
+		return fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	for _, n := range notifications {
+		if n.Name != name {
+			continue
+		}
+		if err := c.Post(ctx, "/api/v3/notification/test", n, nil); err != nil {
+			return fmt.Errorf("notification test failed: %w", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("notification %q not found", name)
+}
+
 // deleteNotification deletes a notification from Sonarr
 func (a *Adapter) deleteNotification(ctx context.Context, c *httpclient.Client, id int) error {
 	endpoint := fmt.Sprintf("/api/v3/notification/%d", id)
@@ -2,14 +2,39 @@ package sonarr
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
-// profileID is used to track the managed profile ID
-var managedProfileID *int
+// getQualityProfileIDs returns quality profile IDs keyed by name
+func (a *Adapter) getQualityProfileIDs(ctx context.Context, c *httpclient.Client) (map[string]int, error) {
+	var profiles []QualityProfileResource
+	if err := c.Get(ctx, "/api/v3/qualityprofile", &profiles); err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]int, len(profiles))
+	for _, p := range profiles {
+		ids[p.Name] = p.ID
+	}
+	return ids, nil
+}
+
+// findQualityProfileID returns the ID of the quality profile with the given name
+func (a *Adapter) findQualityProfileID(ctx context.Context, c *httpclient.Client, name string) (int, error) {
+	ids, err := a.getQualityProfileIDs(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	id, ok := ids[name]
+	if !ok {
+		return 0, fmt.Errorf("quality profile not found: %s", name)
+	}
+	return id, nil
+}
 
 // getManagedQualityProfiles retrieves quality profiles managed by Nebularr
 func (a *Adapter) getManagedQualityProfiles(ctx context.Context, c *httpclient.Client, _ int) ([]*irv1.VideoQualityIR, error) {
@@ -23,7 +48,6 @@ func (a *Adapter) getManagedQualityProfiles(ctx context.Context, c *httpclient.C
 		// Check if profile name starts with "nebularr-" (our naming convention)
 		if len(p.Name) > 9 && p.Name[:9] == "nebularr-" {
 			ir := a.profileToIR(&p)
-			managedProfileID = &p.ID
 			managed = append(managed, ir)
 		}
 	}
@@ -34,8 +58,11 @@ func (a *Adapter) getManagedQualityProfiles(ctx context.Context, c *httpclient.C
 // profileToIR converts a Sonarr quality profile to IR
 func (a *Adapter) profileToIR(p *QualityProfileResource) *irv1.VideoQualityIR {
 	ir := &irv1.VideoQualityIR{
-		ProfileName:    p.Name,
-		UpgradeAllowed: p.UpgradeAllowed,
+		ID:                            p.ID,
+		ProfileName:                   p.Name,
+		UpgradeAllowed:                p.UpgradeAllowed,
+		MinimumCustomFormatScore:      p.MinFormatScore,
+		UpgradeUntilCustomFormatScore: p.CutoffFormatScore,
 	}
 
 	// Convert tiers
@@ -54,18 +81,8 @@ func (a *Adapter) profileToIR(p *QualityProfileResource) *irv1.VideoQualityIR {
 
 // diffQualityProfiles computes changes needed for quality profiles
 func (a *Adapter) diffQualityProfiles(current, desired *irv1.IR, changes *adapters.ChangeSet) error {
-	var currentProfile *irv1.VideoQualityIR
-	var desiredProfile *irv1.VideoQualityIR
-
-	if current.Quality != nil {
-		currentProfile = current.Quality.Video
-	}
-	if desired.Quality != nil {
-		desiredProfile = desired.Quality.Video
-	}
-
 	// Use shared diff logic
-	adapters.DiffQualityProfiles(currentProfile, desiredProfile, managedProfileID, changes)
+	adapters.DiffVideoQualityProfileSets(current.Quality.AllVideoProfiles(), desired.Quality.AllVideoProfiles(), changes)
 	return nil
 }
 
@@ -0,0 +1,71 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+const qualityDefinitionAPIPath = "/api/v3/qualitydefinition"
+
+// applyQualityDefinitions updates quality definition size limits from IR.
+// Only definitions whose values differ are sent, in a single bulk update.
+func (a *Adapter) applyQualityDefinitions(ctx context.Context, c *httpclient.Client, desired []irv1.QualityDefinitionIR) error {
+	if len(desired) == 0 {
+		return nil
+	}
+
+	var current []QualityDefinitionResource
+	if err := c.Get(ctx, qualityDefinitionAPIPath, &current); err != nil {
+		return fmt.Errorf("failed to get quality definitions: %w", err)
+	}
+
+	byName := make(map[string]int, len(current))
+	for i, def := range current {
+		byName[strings.ToLower(def.Quality.Name)] = i
+	}
+
+	var updates []QualityDefinitionResource
+	var missing []string
+	for _, want := range desired {
+		idx, ok := byName[strings.ToLower(want.Quality)]
+		if !ok {
+			missing = append(missing, want.Quality)
+			continue
+		}
+
+		def := current[idx]
+		var changed, fieldChanged bool
+		def.MinSize, fieldChanged = shared.ResolveSizeLimit(def.MinSize, want.MinSize)
+		changed = changed || fieldChanged
+		def.MaxSize, fieldChanged = shared.ResolveSizeLimit(def.MaxSize, want.MaxSize)
+		changed = changed || fieldChanged
+		def.PreferredSize, fieldChanged = shared.ResolveSizeLimit(def.PreferredSize, want.PreferredSize)
+		changed = changed || fieldChanged
+		if want.Title != "" && def.Title != want.Title {
+			def.Title = want.Title
+			changed = true
+		}
+
+		if changed {
+			updates = append(updates, def)
+		}
+	}
+
+	if len(updates) > 0 {
+		if err := c.Put(ctx, qualityDefinitionAPIPath+"/update", updates, nil); err != nil {
+			return fmt.Errorf("failed to update quality definitions: %w", err)
+		}
+	}
+
+	if len(missing) > 0 {
+		return adapters.NewError(adapters.ErrorCategoryValidation, fmt.Errorf("unknown qualities: %s", strings.Join(missing, ", ")))
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ func (a *Adapter) getRootFolders(ctx context.Context, c *httpclient.Client) ([]i
 	result := make([]irv1.RootFolderIR, 0, len(folders))
 	for _, f := range folders {
 		result = append(result, irv1.RootFolderIR{
+			ID:   f.ID,
 			Path: f.Path,
 		})
 	}
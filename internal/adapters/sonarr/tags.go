@@ -5,16 +5,17 @@ import (
 
 	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
 	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
-// getOwnershipTagID retrieves the ID of the Nebularr ownership tag
-func (a *Adapter) getOwnershipTagID(ctx context.Context, c *httpclient.Client) (int, error) {
-	return shared.GetOwnershipTagID(ctx, c, "v3")
+// getOwnershipTagID retrieves the ID of the connection's ownership tag
+func (a *Adapter) getOwnershipTagID(ctx context.Context, c *httpclient.Client, conn *irv1.ConnectionIR) (int, error) {
+	return shared.GetOwnershipTagID(ctx, c, "v3", shared.OwnershipTagLabel(conn))
 }
 
 // ensureOwnershipTag creates the ownership tag if it doesn't exist
-func (a *Adapter) ensureOwnershipTag(ctx context.Context, c *httpclient.Client) (int, error) {
-	return shared.EnsureOwnershipTag(ctx, c, "v3")
+func (a *Adapter) ensureOwnershipTag(ctx context.Context, c *httpclient.Client, conn *irv1.ConnectionIR) (int, error) {
+	return shared.EnsureOwnershipTag(ctx, c, "v3", shared.OwnershipTagLabel(conn))
 }
 
 // hasTag checks if an array of tag IDs contains the specified tag
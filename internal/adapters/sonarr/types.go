@@ -44,6 +44,17 @@ type Quality struct {
 	Resolution int    `json:"resolution"`
 }
 
+// QualityDefinitionResource represents the size limits for a quality
+type QualityDefinitionResource struct {
+	ID            int      `json:"id"`
+	Quality       Quality  `json:"quality"`
+	Title         string   `json:"title"`
+	Weight        int      `json:"weight"`
+	MinSize       *float64 `json:"minSize"`
+	MaxSize       *float64 `json:"maxSize"`
+	PreferredSize *float64 `json:"preferredSize"`
+}
+
 // ProfileFormatItem represents a custom format in a profile
 type ProfileFormatItem struct {
 	Format int `json:"format"`
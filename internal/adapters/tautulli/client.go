@@ -0,0 +1,388 @@
+// Package tautulli provides the Tautulli API client used to manage notification
+// agents and newsletters and to collect watch statistics.
+// Tautulli exposes a single command-style endpoint (/api/v2?cmd=...) that wraps
+// every reply in a {"response": {"result", "message", "data"}} envelope.
+package tautulli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+// DefaultTimeout is the default HTTP request timeout
+const DefaultTimeout = 30 * time.Second
+
+// Client provides access to the Tautulli API
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewClient creates a new Tautulli API client (a zero timeout uses DefaultTimeout)
+func NewClient(baseURL, apiKey string, timeout time.Duration) *Client {
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	return NewClientWithHTTP(baseURL, apiKey, httpclient.NewHTTPClient(httpclient.Config{BaseURL: baseURL, Timeout: timeout}))
+}
+
+// NewClientWithHTTP creates a new client with a custom HTTP client
+func NewClientWithHTTP(baseURL, apiKey string, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}
+}
+
+// flexInt decodes numbers Tautulli returns either as JSON numbers, numeric
+// strings or null
+type flexInt int
+
+func (f *flexInt) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*f = 0
+		return nil
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid number %s: %w", data, err)
+	}
+	*f = flexInt(n)
+	return nil
+}
+
+// Info represents the get_tautulli_info response
+type Info struct {
+	TautulliVersion string `json:"tautulli_version"`
+}
+
+// Notifier represents a configured notification agent
+type Notifier struct {
+	ID           flexInt `json:"id"`
+	AgentID      flexInt `json:"agent_id"`
+	AgentName    string  `json:"agent_name"`
+	FriendlyName string  `json:"friendly_name"`
+	Active       flexInt `json:"active"`
+}
+
+// NotifierConfig represents the get_notifier_config response.
+// Password fields in Config are masked by Tautulli.
+type NotifierConfig struct {
+	ID           flexInt                `json:"id"`
+	AgentID      flexInt                `json:"agent_id"`
+	AgentName    string                 `json:"agent_name"`
+	FriendlyName string                 `json:"friendly_name"`
+	Config       map[string]interface{} `json:"config"`
+	Actions      map[string]interface{} `json:"actions"`
+}
+
+// Newsletter represents a configured newsletter
+type Newsletter struct {
+	ID           flexInt `json:"id"`
+	AgentID      flexInt `json:"agent_id"`
+	AgentName    string  `json:"agent_name"`
+	FriendlyName string  `json:"friendly_name"`
+	Cron         string  `json:"cron"`
+	Active       flexInt `json:"active"`
+}
+
+// NewsletterConfig represents the get_newsletter_config response
+type NewsletterConfig struct {
+	ID           flexInt                `json:"id"`
+	AgentID      flexInt                `json:"agent_id"`
+	AgentName    string                 `json:"agent_name"`
+	FriendlyName string                 `json:"friendly_name"`
+	Cron         string                 `json:"cron"`
+	Active       flexInt                `json:"active"`
+	Subject      string                 `json:"subject"`
+	Config       map[string]interface{} `json:"config"`
+	EmailConfig  map[string]interface{} `json:"email_config"`
+}
+
+// Library represents a Plex library section known to Tautulli
+type Library struct {
+	SectionID   flexInt `json:"section_id"`
+	SectionName string  `json:"section_name"`
+	SectionType string  `json:"section_type"`
+	Count       flexInt `json:"count"`
+}
+
+// MediaItem represents a top-level item of a library (a movie or a show)
+type MediaItem struct {
+	RatingKey  string  `json:"rating_key"`
+	Title      string  `json:"title"`
+	Year       flexInt `json:"year"`
+	AddedAt    flexInt `json:"added_at"`
+	LastPlayed flexInt `json:"last_played"`
+	PlayCount  flexInt `json:"play_count"`
+}
+
+// mediaInfoPage represents one page of the get_library_media_info response
+type mediaInfoPage struct {
+	RecordsTotal flexInt     `json:"recordsTotal"`
+	Data         []MediaItem `json:"data"`
+}
+
+// watchTimeStats represents one entry of the get_library_watch_time_stats response
+type watchTimeStats struct {
+	QueryDays  flexInt `json:"query_days"`
+	TotalPlays flexInt `json:"total_plays"`
+}
+
+// addResult represents the reply to add_notifier_config and add_newsletter_config
+type addResult struct {
+	NotifierID   flexInt `json:"notifier_id"`
+	NewsletterID flexInt `json:"newsletter_id"`
+}
+
+// envelope is the wrapper around every Tautulli API reply
+type envelope struct {
+	Response struct {
+		Result  string          `json:"result"`
+		Message *string         `json:"message"`
+		Data    json.RawMessage `json:"data"`
+	} `json:"response"`
+}
+
+// call runs an API command. Reads use GET; commands that change Tautulli are
+// sent as a form POST so long settings are not limited by the URL length.
+func (c *Client) call(ctx context.Context, method, cmd string, params url.Values, result interface{}) error {
+	query := url.Values{}
+	query.Set("apikey", c.apiKey)
+	query.Set("cmd", cmd)
+
+	var body io.Reader
+	reqURL := c.baseURL + "/api/v2"
+	if method == http.MethodGet {
+		for key, values := range params {
+			query[key] = values
+		}
+	} else if params != nil {
+		body = strings.NewReader(params.Encode())
+	}
+	reqURL += "?" + query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(data))
+	}
+
+	var env envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", cmd, err)
+	}
+	if env.Response.Result != "success" {
+		message := "no message"
+		if env.Response.Message != nil {
+			message = *env.Response.Message
+		}
+		return fmt.Errorf("%s failed: %s", cmd, message)
+	}
+
+	if result != nil && len(env.Response.Data) > 0 {
+		if err := json.Unmarshal(env.Response.Data, result); err != nil {
+			return fmt.Errorf("failed to decode %s data: %w", cmd, err)
+		}
+	}
+	return nil
+}
+
+// GetInfo retrieves the Tautulli server information
+func (c *Client) GetInfo(ctx context.Context) (*Info, error) {
+	var info Info
+	if err := c.call(ctx, http.MethodGet, "get_tautulli_info", nil, &info); err != nil {
+		return nil, fmt.Errorf("failed to get Tautulli info: %w", err)
+	}
+	return &info, nil
+}
+
+// GetNotifiers retrieves all notification agents
+func (c *Client) GetNotifiers(ctx context.Context) ([]Notifier, error) {
+	var notifiers []Notifier
+	if err := c.call(ctx, http.MethodGet, "get_notifiers", nil, &notifiers); err != nil {
+		return nil, fmt.Errorf("failed to get notifiers: %w", err)
+	}
+	return notifiers, nil
+}
+
+// GetNotifierConfig retrieves the configuration of a notification agent
+func (c *Client) GetNotifierConfig(ctx context.Context, id int) (*NotifierConfig, error) {
+	var config NotifierConfig
+	params := url.Values{"notifier_id": {strconv.Itoa(id)}}
+	if err := c.call(ctx, http.MethodGet, "get_notifier_config", params, &config); err != nil {
+		return nil, fmt.Errorf("failed to get notifier %d: %w", id, err)
+	}
+	return &config, nil
+}
+
+// AddNotifier creates a notification agent with default settings and returns its ID
+func (c *Client) AddNotifier(ctx context.Context, agentID int) (int, error) {
+	var result addResult
+	params := url.Values{"agent_id": {strconv.Itoa(agentID)}}
+	if err := c.call(ctx, http.MethodPost, "add_notifier_config", params, &result); err != nil {
+		return 0, fmt.Errorf("failed to add notifier: %w", err)
+	}
+	if result.NotifierID == 0 {
+		return 0, fmt.Errorf("failed to add notifier: no notifier_id returned")
+	}
+	return int(result.NotifierID), nil
+}
+
+// SetNotifierConfig replaces the configuration of a notification agent
+func (c *Client) SetNotifierConfig(ctx context.Context, id, agentID int, params url.Values) error {
+	values := cloneValues(params)
+	values.Set("notifier_id", strconv.Itoa(id))
+	values.Set("agent_id", strconv.Itoa(agentID))
+	if err := c.call(ctx, http.MethodPost, "set_notifier_config", values, nil); err != nil {
+		return fmt.Errorf("failed to set notifier %d: %w", id, err)
+	}
+	return nil
+}
+
+// DeleteNotifier deletes a notification agent
+func (c *Client) DeleteNotifier(ctx context.Context, id int) error {
+	params := url.Values{"notifier_id": {strconv.Itoa(id)}}
+	if err := c.call(ctx, http.MethodPost, "delete_notifier", params, nil); err != nil {
+		return fmt.Errorf("failed to delete notifier %d: %w", id, err)
+	}
+	return nil
+}
+
+// GetNewsletters retrieves all newsletters
+func (c *Client) GetNewsletters(ctx context.Context) ([]Newsletter, error) {
+	var newsletters []Newsletter
+	if err := c.call(ctx, http.MethodGet, "get_newsletters", nil, &newsletters); err != nil {
+		return nil, fmt.Errorf("failed to get newsletters: %w", err)
+	}
+	return newsletters, nil
+}
+
+// GetNewsletterConfig retrieves the configuration of a newsletter
+func (c *Client) GetNewsletterConfig(ctx context.Context, id int) (*NewsletterConfig, error) {
+	var config NewsletterConfig
+	params := url.Values{"newsletter_id": {strconv.Itoa(id)}}
+	if err := c.call(ctx, http.MethodGet, "get_newsletter_config", params, &config); err != nil {
+		return nil, fmt.Errorf("failed to get newsletter %d: %w", id, err)
+	}
+	return &config, nil
+}
+
+// AddNewsletter creates a newsletter with default settings and returns its ID
+func (c *Client) AddNewsletter(ctx context.Context, agentID int) (int, error) {
+	var result addResult
+	params := url.Values{"agent_id": {strconv.Itoa(agentID)}}
+	if err := c.call(ctx, http.MethodPost, "add_newsletter_config", params, &result); err != nil {
+		return 0, fmt.Errorf("failed to add newsletter: %w", err)
+	}
+	if result.NewsletterID == 0 {
+		return 0, fmt.Errorf("failed to add newsletter: no newsletter_id returned")
+	}
+	return int(result.NewsletterID), nil
+}
+
+// SetNewsletterConfig replaces the configuration of a newsletter
+func (c *Client) SetNewsletterConfig(ctx context.Context, id, agentID int, params url.Values) error {
+	values := cloneValues(params)
+	values.Set("newsletter_id", strconv.Itoa(id))
+	values.Set("agent_id", strconv.Itoa(agentID))
+	if err := c.call(ctx, http.MethodPost, "set_newsletter_config", values, nil); err != nil {
+		return fmt.Errorf("failed to set newsletter %d: %w", id, err)
+	}
+	return nil
+}
+
+// DeleteNewsletter deletes a newsletter
+func (c *Client) DeleteNewsletter(ctx context.Context, id int) error {
+	params := url.Values{"newsletter_id": {strconv.Itoa(id)}}
+	if err := c.call(ctx, http.MethodPost, "delete_newsletter", params, nil); err != nil {
+		return fmt.Errorf("failed to delete newsletter %d: %w", id, err)
+	}
+	return nil
+}
+
+// GetLibraries retrieves the Plex libraries known to Tautulli
+func (c *Client) GetLibraries(ctx context.Context) ([]Library, error) {
+	var libraries []Library
+	if err := c.call(ctx, http.MethodGet, "get_libraries", nil, &libraries); err != nil {
+		return nil, fmt.Errorf("failed to get libraries: %w", err)
+	}
+	return libraries, nil
+}
+
+// GetLibraryPlays returns the number of plays in a library within the last days
+func (c *Client) GetLibraryPlays(ctx context.Context, sectionID, days int) (int, error) {
+	var stats []watchTimeStats
+	params := url.Values{
+		"section_id": {strconv.Itoa(sectionID)},
+		"query_days": {strconv.Itoa(days)},
+	}
+	if err := c.call(ctx, http.MethodGet, "get_library_watch_time_stats", params, &stats); err != nil {
+		return 0, fmt.Errorf("failed to get watch time stats of library %d: %w", sectionID, err)
+	}
+	for _, s := range stats {
+		if int(s.QueryDays) == days {
+			return int(s.TotalPlays), nil
+		}
+	}
+	return 0, nil
+}
+
+// mediaInfoPageSize is the number of items requested per get_library_media_info page
+const mediaInfoPageSize = 1000
+
+// EachMediaItem pages through the top-level items of a library and calls fn for each
+func (c *Client) EachMediaItem(ctx context.Context, sectionID int, fn func(item *MediaItem)) error {
+	for start := 0; ; start += mediaInfoPageSize {
+		var page mediaInfoPage
+		params := url.Values{
+			"section_id": {strconv.Itoa(sectionID)},
+			"start":      {strconv.Itoa(start)},
+			"length":     {strconv.Itoa(mediaInfoPageSize)},
+		}
+		if err := c.call(ctx, http.MethodGet, "get_library_media_info", params, &page); err != nil {
+			return fmt.Errorf("failed to get media of library %d: %w", sectionID, err)
+		}
+		for i := range page.Data {
+			fn(&page.Data[i])
+		}
+		if len(page.Data) < mediaInfoPageSize || start+len(page.Data) >= int(page.RecordsTotal) {
+			return nil
+		}
+	}
+}
+
+// cloneValues copies params so callers' values are not modified
+func cloneValues(params url.Values) url.Values {
+	values := make(url.Values, len(params)+2)
+	for key, v := range params {
+		values[key] = append([]string(nil), v...)
+	}
+	return values
+}
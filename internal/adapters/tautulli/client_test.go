@@ -0,0 +1,43 @@
+package tautulli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClientErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("apikey") != "secret" {
+			t.Errorf("apikey = %q, want secret", r.URL.Query().Get("apikey"))
+		}
+		_, _ = w.Write([]byte(`{"response": {"result": "error", "message": "Invalid apikey", "data": {}}}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "secret", 0).GetInfo(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "get_tautulli_info failed: Invalid apikey") {
+		t.Errorf("GetInfo() error = %v, want the API message", err)
+	}
+}
+
+func TestClientDecodesStringNumbers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"response": {"result": "success", "data": [
+			{"section_id": "4", "section_name": "Movies", "section_type": "movie", "count": "1203"},
+			{"section_id": 5, "section_name": "Shows", "section_type": "show", "count": null}
+		]}}`))
+	}))
+	defer server.Close()
+
+	libraries, err := NewClient(server.URL, "secret", 0).GetLibraries(context.Background())
+	if err != nil {
+		t.Fatalf("GetLibraries() error = %v", err)
+	}
+	if len(libraries) != 2 || libraries[0].SectionID != 4 || libraries[0].Count != 1203 ||
+		libraries[1].SectionID != 5 || libraries[1].Count != 0 {
+		t.Errorf("GetLibraries() = %+v", libraries)
+	}
+}
@@ -0,0 +1,88 @@
+package tautulli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// ResolveLibraries returns the libraries with the given names in that order,
+// or all libraries of one of the given types if names is empty
+func ResolveLibraries(libraries []Library, names []string, types ...string) ([]Library, error) {
+	if len(names) == 0 {
+		var result []Library
+		for _, lib := range libraries {
+			for _, t := range types {
+				if lib.SectionType == t {
+					result = append(result, lib)
+					break
+				}
+			}
+		}
+		return result, nil
+	}
+
+	result := make([]Library, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, lib := range libraries {
+			if strings.EqualFold(lib.SectionName, name) {
+				result = append(result, lib)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("library %q not found in Tautulli", name)
+		}
+	}
+	return result, nil
+}
+
+// CollectWatchStatistics counts the items, unwatched items and plays of the
+// selected libraries. An item is unwatched when it was added before the
+// window of spec.UnwatchedDays and nobody played it within the window.
+func CollectWatchStatistics(ctx context.Context, client *Client, spec *arrv1alpha1.TautulliWatchStatisticsSpec, now time.Time) ([]arrv1alpha1.TautulliLibraryStatistics, error) {
+	days := int(spec.UnwatchedDays)
+	if days == 0 {
+		days = 90
+	}
+	cutoff := now.AddDate(0, 0, -days).Unix()
+
+	all, err := client.GetLibraries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	libraries, err := ResolveLibraries(all, spec.Libraries, "movie", "show")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]arrv1alpha1.TautulliLibraryStatistics, 0, len(libraries))
+	for _, lib := range libraries {
+		stats := arrv1alpha1.TautulliLibraryStatistics{
+			Name:      lib.SectionName,
+			SectionID: int(lib.SectionID),
+			Type:      lib.SectionType,
+		}
+
+		err := client.EachMediaItem(ctx, stats.SectionID, func(item *MediaItem) {
+			stats.Items++
+			if int64(item.AddedAt) < cutoff && int64(item.LastPlayed) < cutoff {
+				stats.UnwatchedItems++
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if stats.Plays, err = client.GetLibraryPlays(ctx, stats.SectionID, days); err != nil {
+			return nil, err
+		}
+		result = append(result, stats)
+	}
+	return result, nil
+}
@@ -0,0 +1,433 @@
+package tautulli
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+)
+
+// AgentIDs maps the notifier agent names of TautulliNotifierSpec to Tautulli agent IDs
+var AgentIDs = map[string]int{
+	"pushbullet": 6,
+	"pushover":   7,
+	"email":      10,
+	"ifttt":      12,
+	"telegram":   13,
+	"slack":      14,
+	"scripts":    15,
+	"join":       18,
+	"discord":    20,
+	"mqtt":       23,
+	"zapier":     24,
+	"webhook":    25,
+}
+
+// Recently added is the only newsletter agent Tautulli ships
+const (
+	recentlyAddedAgentID   = 0
+	recentlyAddedAgentName = "recently_added"
+)
+
+// notifyActions lists every notification action. Actions a notifier does not
+// request are sent as 0, since Tautulli keeps actions missing from a request.
+var notifyActions = []string{
+	"play", "stop", "pause", "resume", "change", "buffer", "error", "watched", "created",
+	"intdown", "intup", "extdown", "extup", "pmsupdate", "concurrent", "newdevice", "plexpyupdate",
+}
+
+// DesiredNotifier is a notifier spec with its settings and secret values merged
+type DesiredNotifier struct {
+	Spec     arrv1alpha1.TautulliNotifierSpec
+	Settings map[string]string
+}
+
+// DesiredNewsletter is a newsletter spec with its notifier and libraries resolved to IDs
+type DesiredNewsletter struct {
+	Spec       arrv1alpha1.TautulliNewsletterSpec
+	NotifierID int
+	LibraryIDs []int
+}
+
+// SyncResult lists the items managed after a sync and counts what changed
+type SyncResult struct {
+	Items   []arrv1alpha1.TautulliManagedItem
+	Created int
+	Updated int
+	Deleted int
+}
+
+// item is a notifier or newsletter as listed by Tautulli
+type item struct {
+	id        int
+	agentName string
+	name      string
+}
+
+// itemKind abstracts over notifiers and newsletters, which Tautulli manages
+// through parallel commands
+type itemKind struct {
+	list    func(ctx context.Context) ([]item, error)
+	add     func(ctx context.Context, agentID int) (int, error)
+	set     func(ctx context.Context, id, agentID int, params url.Values) error
+	drifted func(ctx context.Context, id int, params url.Values) (bool, error)
+	remove  func(ctx context.Context, id int) error
+}
+
+// desiredItem is the agent and the full set of parameters of a notifier or newsletter
+type desiredItem struct {
+	name      string
+	agentID   int
+	agentName string
+	params    url.Values
+}
+
+// SyncNotifiers creates, updates and deletes notification agents so that the
+// desired ones exist. managed lists the agents created by earlier syncs;
+// those no longer desired are deleted. Agents are written only when their
+// parameters changed since the last sync or their visible settings drifted.
+func SyncNotifiers(ctx context.Context, client *Client, desired []DesiredNotifier, managed []arrv1alpha1.TautulliManagedItem) (*SyncResult, error) {
+	items := make([]desiredItem, 0, len(desired))
+	for _, d := range desired {
+		agentID, ok := AgentIDs[d.Spec.Agent]
+		if !ok {
+			return nil, fmt.Errorf("unknown notifier agent %q of notifier %s", d.Spec.Agent, d.Spec.Name)
+		}
+		items = append(items, desiredItem{
+			name:      d.Spec.Name,
+			agentID:   agentID,
+			agentName: d.Spec.Agent,
+			params:    NotifierParams(d),
+		})
+	}
+
+	kind := itemKind{
+		list: func(ctx context.Context) ([]item, error) {
+			notifiers, err := client.GetNotifiers(ctx)
+			if err != nil {
+				return nil, err
+			}
+			result := make([]item, len(notifiers))
+			for i, n := range notifiers {
+				result[i] = item{id: int(n.ID), agentName: n.AgentName, name: n.FriendlyName}
+			}
+			return result, nil
+		},
+		add: client.AddNotifier,
+		set: client.SetNotifierConfig,
+		drifted: func(ctx context.Context, id int, params url.Values) (bool, error) {
+			config, err := client.GetNotifierConfig(ctx, id)
+			if err != nil {
+				return false, err
+			}
+			return notifierDrifted(config, params), nil
+		},
+		remove: client.DeleteNotifier,
+	}
+	return syncItems(ctx, kind, items, managed)
+}
+
+// SyncNewsletters creates, updates and deletes recently added newsletters the
+// same way SyncNotifiers handles notification agents
+func SyncNewsletters(ctx context.Context, client *Client, desired []DesiredNewsletter, managed []arrv1alpha1.TautulliManagedItem) (*SyncResult, error) {
+	items := make([]desiredItem, 0, len(desired))
+	for _, d := range desired {
+		items = append(items, desiredItem{
+			name:      d.Spec.Name,
+			agentID:   recentlyAddedAgentID,
+			agentName: recentlyAddedAgentName,
+			params:    NewsletterParams(d),
+		})
+	}
+
+	kind := itemKind{
+		list: func(ctx context.Context) ([]item, error) {
+			newsletters, err := client.GetNewsletters(ctx)
+			if err != nil {
+				return nil, err
+			}
+			result := make([]item, len(newsletters))
+			for i, n := range newsletters {
+				result[i] = item{id: int(n.ID), agentName: n.AgentName, name: n.FriendlyName}
+			}
+			return result, nil
+		},
+		add: client.AddNewsletter,
+		set: client.SetNewsletterConfig,
+		drifted: func(ctx context.Context, id int, params url.Values) (bool, error) {
+			config, err := client.GetNewsletterConfig(ctx, id)
+			if err != nil {
+				return false, err
+			}
+			return newsletterDrifted(config, params), nil
+		},
+		remove: client.DeleteNewsletter,
+	}
+	return syncItems(ctx, kind, items, managed)
+}
+
+// syncItems reconciles the items of one kind. An item is found by the ID
+// recorded for its name, otherwise adopted by friendly name and agent, and
+// created if neither exists. An item whose agent changed is recreated.
+func syncItems(ctx context.Context, kind itemKind, desired []desiredItem, managed []arrv1alpha1.TautulliManagedItem) (*SyncResult, error) {
+	current, err := kind.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentByID := make(map[int]item, len(current))
+	for _, c := range current {
+		currentByID[c.id] = c
+	}
+	managedByName := make(map[string]arrv1alpha1.TautulliManagedItem, len(managed))
+	for _, m := range managed {
+		managedByName[m.Name] = m
+	}
+
+	result := &SyncResult{}
+	desiredNames := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		desiredNames[d.name] = true
+		hash := paramsHash(d.params)
+
+		existing, found := item{}, false
+		recorded, wasManaged := managedByName[d.name]
+		if wasManaged {
+			existing, found = currentByID[recorded.ID]
+		}
+		if !found {
+			for _, c := range current {
+				if c.name == d.name && c.agentName == d.agentName {
+					existing, found = c, true
+					break
+				}
+			}
+		}
+		if found && existing.agentName != d.agentName {
+			if err := kind.remove(ctx, existing.id); err != nil {
+				return nil, err
+			}
+			result.Deleted++
+			found = false
+		}
+
+		id := existing.id
+		if !found {
+			if id, err = kind.add(ctx, d.agentID); err != nil {
+				return nil, err
+			}
+			result.Created++
+		} else {
+			unchanged := wasManaged && recorded.ID == id && recorded.Hash == hash
+			if unchanged {
+				drifted, err := kind.drifted(ctx, id, d.params)
+				if err != nil {
+					return nil, err
+				}
+				unchanged = !drifted
+			}
+			if unchanged {
+				result.Items = append(result.Items, recorded)
+				continue
+			}
+			result.Updated++
+		}
+
+		if err := kind.set(ctx, id, d.agentID, d.params); err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, arrv1alpha1.TautulliManagedItem{Name: d.name, ID: id, Hash: hash})
+	}
+
+	for _, m := range managed {
+		if desiredNames[m.Name] {
+			continue
+		}
+		if _, ok := currentByID[m.ID]; !ok {
+			continue
+		}
+		if err := kind.remove(ctx, m.ID); err != nil {
+			return nil, err
+		}
+		result.Deleted++
+	}
+
+	return result, nil
+}
+
+// NotifierParams builds the set_notifier_config parameters of a notifier:
+// its friendly name, every notification action and the agent settings
+// prefixed with the agent name
+func NotifierParams(d DesiredNotifier) url.Values {
+	params := url.Values{}
+	params.Set("friendly_name", d.Spec.Name)
+
+	enabled := make(map[string]bool, len(d.Spec.Triggers))
+	for _, t := range d.Spec.Triggers {
+		enabled[string(t)] = true
+	}
+	for _, action := range notifyActions {
+		params.Set("on_"+action, boolParam(enabled[action]))
+	}
+
+	for key, value := range d.Settings {
+		params.Set(d.Spec.Agent+"_"+key, value)
+	}
+	return params
+}
+
+// NewsletterParams builds the set_newsletter_config parameters of a newsletter.
+// The newsletter is sent as a link through its notifier rather than as an HTML email.
+func NewsletterParams(d DesiredNewsletter) url.Values {
+	params := url.Values{}
+	params.Set("friendly_name", d.Spec.Name)
+	params.Set("active", boolParam(d.Spec.Enabled == nil || *d.Spec.Enabled))
+
+	schedule := d.Spec.Schedule
+	if schedule == "" {
+		schedule = "0 0 * * 0"
+	}
+	params.Set("cron", schedule)
+	if d.Spec.Subject != "" {
+		params.Set("subject", d.Spec.Subject)
+	}
+
+	timeFrame := d.Spec.TimeFrameDays
+	if timeFrame == 0 {
+		timeFrame = 7
+	}
+	params.Set("newsletter_config_time_frame", strconv.Itoa(int(timeFrame)))
+	params.Set("newsletter_config_time_frame_units", "days")
+	params.Set("newsletter_config_formatted", "0")
+	params.Set("newsletter_config_notifier_id", strconv.Itoa(d.NotifierID))
+	for _, id := range d.LibraryIDs {
+		params.Add("newsletter_config_incl_libraries", strconv.Itoa(id))
+	}
+	return params
+}
+
+// notifierDrifted reports whether the name, actions or visible settings of a
+// notification agent differ from params. Masked password settings are skipped;
+// secret changes are caught by the parameter hash instead.
+func notifierDrifted(config *NotifierConfig, params url.Values) bool {
+	prefix := config.AgentName + "_"
+	for key, values := range params {
+		switch {
+		case key == "friendly_name":
+			if config.FriendlyName != values[0] {
+				return true
+			}
+		case strings.HasPrefix(key, "on_"):
+			if current, ok := config.Actions[key]; ok && !valueEqual(current, values[0]) {
+				return true
+			}
+		case strings.HasPrefix(key, prefix):
+			// Settings the agent does not know are never returned, so only compare known ones
+			current, ok := config.Config[strings.TrimPrefix(key, prefix)]
+			if s, isString := current.(string); !ok || (isString && masked(s)) {
+				continue
+			}
+			if !valueEqual(current, values[0]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// newsletterDrifted reports whether the settings of a newsletter differ from params
+func newsletterDrifted(config *NewsletterConfig, params url.Values) bool {
+	const configPrefix = "newsletter_config_"
+	if config.FriendlyName != params.Get("friendly_name") ||
+		config.Cron != params.Get("cron") ||
+		strconv.Itoa(int(config.Active)) != params.Get("active") {
+		return true
+	}
+	if subject := params.Get("subject"); subject != "" && config.Subject != subject {
+		return true
+	}
+
+	for key, values := range params {
+		if !strings.HasPrefix(key, configPrefix) {
+			continue
+		}
+		current := config.Config[strings.TrimPrefix(key, configPrefix)]
+		if key == configPrefix+"incl_libraries" {
+			if !sameLibraries(current, values) {
+				return true
+			}
+			continue
+		}
+		if !valueEqual(current, values[0]) {
+			return true
+		}
+	}
+	if _, ok := params[configPrefix+"incl_libraries"]; !ok {
+		return !sameLibraries(config.Config["incl_libraries"], nil)
+	}
+	return false
+}
+
+// sameLibraries compares Tautulli's incl_libraries value with library IDs, ignoring order
+func sameLibraries(current interface{}, desired []string) bool {
+	var have []string
+	switch v := current.(type) {
+	case []interface{}:
+		for _, id := range v {
+			have = append(have, adapters.NormalizeFieldValue(id))
+		}
+	case string:
+		if v != "" {
+			have = strings.Split(v, ",")
+		}
+	}
+	want := make([]string, len(desired))
+	for i, id := range desired {
+		want[i] = adapters.NormalizeFieldValue(id)
+	}
+	for i := range have {
+		have[i] = adapters.NormalizeFieldValue(have[i])
+	}
+	sort.Strings(have)
+	sort.Strings(want)
+	return strings.Join(have, ",") == strings.Join(want, ",")
+}
+
+// valueEqual compares a value returned by Tautulli with a parameter value.
+// Tautulli stores checkboxes as 0/1, so true and false compare as 1 and 0.
+func valueEqual(current interface{}, desired string) bool {
+	normalize := func(s string) string {
+		switch s {
+		case "true":
+			return "1"
+		case "false":
+			return "0"
+		}
+		return s
+	}
+	return normalize(adapters.NormalizeFieldValue(current)) == normalize(adapters.NormalizeFieldValue(desired))
+}
+
+// masked reports whether Tautulli replaced a password setting with blanks
+func masked(s string) bool {
+	return s != "" && strings.TrimSpace(s) == ""
+}
+
+// boolParam formats a checkbox parameter
+func boolParam(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// paramsHash identifies the parameters written to an item, including secret values
+func paramsHash(params url.Values) string {
+	hash := sha256.Sum256([]byte(params.Encode()))
+	return fmt.Sprintf("%x", hash[:8])
+}
@@ -0,0 +1,237 @@
+package tautulli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// fakeTautulli is an in-memory Tautulli API serving notifiers, newsletters and one library
+type fakeTautulli struct {
+	notifiers   map[int]map[string]string
+	newsletters map[int]map[string]string
+	nextID      int
+	sets        int
+	media       []map[string]interface{}
+}
+
+func newFakeTautulli(t *testing.T) (*fakeTautulli, *Client) {
+	f := &fakeTautulli{notifiers: map[int]map[string]string{}, newsletters: map[int]map[string]string{}, nextID: 1}
+	server := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(server.Close)
+	return f, NewClient(server.URL, "key", 0)
+}
+
+func (f *fakeTautulli) serve(w http.ResponseWriter, r *http.Request) {
+	_ = r.ParseForm()
+	var data interface{}
+	id, _ := strconv.Atoi(r.Form.Get("notifier_id"))
+	newsletterID, _ := strconv.Atoi(r.Form.Get("newsletter_id"))
+
+	switch r.Form.Get("cmd") {
+	case "get_notifiers":
+		list := []map[string]interface{}{}
+		for id, n := range f.notifiers {
+			list = append(list, map[string]interface{}{"id": id, "agent_name": n["agent_name"], "friendly_name": n["friendly_name"]})
+		}
+		data = list
+	case "get_notifier_config":
+		n := f.notifiers[id]
+		config, actions := map[string]interface{}{}, map[string]interface{}{}
+		for key, value := range n {
+			if prefix := n["agent_name"] + "_"; len(key) > len(prefix) && key[:len(prefix)] == prefix {
+				config[key[len(prefix):]] = value
+			}
+			if len(key) > 3 && key[:3] == "on_" {
+				v, _ := strconv.Atoi(value)
+				actions[key] = v
+			}
+		}
+		data = map[string]interface{}{"id": id, "agent_name": n["agent_name"], "friendly_name": n["friendly_name"], "config": config, "actions": actions}
+	case "add_notifier_config":
+		f.notifiers[f.nextID] = map[string]string{"agent_name": agentNames[r.Form.Get("agent_id")], "friendly_name": ""}
+		data = map[string]interface{}{"notifier_id": f.nextID}
+		f.nextID++
+	case "set_notifier_config":
+		n := f.notifiers[id]
+		for key := range r.PostForm {
+			n[key] = r.PostForm.Get(key)
+		}
+		f.sets++
+	case "delete_notifier":
+		delete(f.notifiers, id)
+	case "get_newsletters":
+		list := []map[string]interface{}{}
+		for id, n := range f.newsletters {
+			list = append(list, map[string]interface{}{"id": id, "agent_name": "recently_added", "friendly_name": n["friendly_name"]})
+		}
+		data = list
+	case "get_newsletter_config":
+		n := f.newsletters[newsletterID]
+		active, _ := strconv.Atoi(n["active"])
+		data = map[string]interface{}{
+			"id": newsletterID, "agent_name": "recently_added", "friendly_name": n["friendly_name"],
+			"cron": n["cron"], "active": active, "subject": n["subject"],
+			"config": map[string]interface{}{
+				"time_frame": n["newsletter_config_time_frame"], "time_frame_units": n["newsletter_config_time_frame_units"],
+				"formatted": n["newsletter_config_formatted"], "notifier_id": n["newsletter_config_notifier_id"],
+				"incl_libraries": []string{n["newsletter_config_incl_libraries"]},
+			},
+		}
+	case "add_newsletter_config":
+		f.newsletters[f.nextID] = map[string]string{}
+		data = map[string]interface{}{"newsletter_id": f.nextID}
+		f.nextID++
+	case "set_newsletter_config":
+		n := f.newsletters[newsletterID]
+		for key := range r.PostForm {
+			n[key] = r.PostForm.Get(key)
+		}
+		f.sets++
+	case "delete_newsletter":
+		delete(f.newsletters, newsletterID)
+	case "get_libraries":
+		data = []map[string]interface{}{
+			{"section_id": "1", "section_name": "Movies", "section_type": "movie", "count": "3"},
+			{"section_id": "2", "section_name": "Music", "section_type": "artist", "count": "1"},
+		}
+	case "get_library_media_info":
+		start, _ := strconv.Atoi(r.Form.Get("start"))
+		length, _ := strconv.Atoi(r.Form.Get("length"))
+		end := min(start+length, len(f.media))
+		data = map[string]interface{}{"recordsTotal": len(f.media), "data": f.media[min(start, end):end]}
+	case "get_library_watch_time_stats":
+		data = []map[string]interface{}{{"query_days": 90, "total_plays": 12}}
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"response": map[string]interface{}{"result": "success", "data": data},
+	})
+}
+
+var agentNames = map[string]string{"20": "discord", "25": "webhook"}
+
+func TestSyncNotifiers(t *testing.T) {
+	f, client := newFakeTautulli(t)
+	ctx := context.Background()
+
+	desired := []DesiredNotifier{{
+		Spec: arrv1alpha1.TautulliNotifierSpec{
+			Name:     "discord-plays",
+			Agent:    "discord",
+			Triggers: []arrv1alpha1.TautulliTrigger{"play", "watched"},
+		},
+		Settings: map[string]string{"hook": "https://discord.example/hook"},
+	}}
+
+	result, err := SyncNotifiers(ctx, client, desired, nil)
+	if err != nil {
+		t.Fatalf("SyncNotifiers() error = %v", err)
+	}
+	if result.Created != 1 || len(result.Items) != 1 {
+		t.Fatalf("SyncNotifiers() = %+v, want one created notifier", result)
+	}
+	n := f.notifiers[result.Items[0].ID]
+	if n["friendly_name"] != "discord-plays" || n["discord_hook"] != "https://discord.example/hook" ||
+		n["on_play"] != "1" || n["on_watched"] != "1" || n["on_stop"] != "0" {
+		t.Errorf("notifier config = %v", n)
+	}
+
+	// Unchanged settings are not written again
+	sets := f.sets
+	result, err = SyncNotifiers(ctx, client, desired, result.Items)
+	if err != nil || result.Updated != 0 || f.sets != sets {
+		t.Fatalf("second SyncNotifiers() = %+v, %v, want no writes", result, err)
+	}
+
+	// Drift in Tautulli is reverted
+	f.notifiers[result.Items[0].ID]["on_stop"] = "1"
+	result, err = SyncNotifiers(ctx, client, desired, result.Items)
+	if err != nil || result.Updated != 1 || f.notifiers[result.Items[0].ID]["on_stop"] != "0" {
+		t.Fatalf("SyncNotifiers() after drift = %+v, %v, want the action reverted", result, err)
+	}
+
+	// Dropped notifiers are deleted
+	result, err = SyncNotifiers(ctx, client, nil, result.Items)
+	if err != nil || result.Deleted != 1 || len(f.notifiers) != 0 {
+		t.Fatalf("SyncNotifiers() without notifiers = %+v, %v, want the notifier deleted", result, err)
+	}
+}
+
+func TestSyncNotifiersAdoptsAndRecreates(t *testing.T) {
+	f, client := newFakeTautulli(t)
+	ctx := context.Background()
+	f.notifiers[7] = map[string]string{"agent_name": "webhook", "friendly_name": "alerts"}
+	f.nextID = 8
+
+	desired := []DesiredNotifier{{Spec: arrv1alpha1.TautulliNotifierSpec{Name: "alerts", Agent: "webhook"}}}
+	result, err := SyncNotifiers(ctx, client, desired, nil)
+	if err != nil || result.Created != 0 || result.Updated != 1 || result.Items[0].ID != 7 {
+		t.Fatalf("SyncNotifiers() = %+v, %v, want the existing webhook adopted", result, err)
+	}
+
+	desired[0].Spec.Agent = "discord"
+	result, err = SyncNotifiers(ctx, client, desired, result.Items)
+	if err != nil || result.Deleted != 1 || result.Created != 1 || f.notifiers[result.Items[0].ID]["agent_name"] != "discord" {
+		t.Fatalf("SyncNotifiers() = %+v, %v, want the notifier recreated as discord", result, err)
+	}
+}
+
+func TestSyncNewsletters(t *testing.T) {
+	f, client := newFakeTautulli(t)
+	ctx := context.Background()
+
+	desired := []DesiredNewsletter{{
+		Spec:       arrv1alpha1.TautulliNewsletterSpec{Name: "weekly", Notifier: "discord-plays"},
+		NotifierID: 3,
+		LibraryIDs: []int{1},
+	}}
+	result, err := SyncNewsletters(ctx, client, desired, nil)
+	if err != nil || result.Created != 1 {
+		t.Fatalf("SyncNewsletters() = %+v, %v", result, err)
+	}
+	n := f.newsletters[result.Items[0].ID]
+	if n["cron"] != "0 0 * * 0" || n["active"] != "1" || n["newsletter_config_time_frame"] != "7" ||
+		n["newsletter_config_notifier_id"] != "3" || n["newsletter_config_incl_libraries"] != "1" {
+		t.Errorf("newsletter config = %v", n)
+	}
+
+	sets := f.sets
+	if _, err := SyncNewsletters(ctx, client, desired, result.Items); err != nil || f.sets != sets {
+		t.Errorf("second SyncNewsletters() error = %v, writes = %d, want none", err, f.sets-sets)
+	}
+}
+
+func TestCollectWatchStatistics(t *testing.T) {
+	f, client := newFakeTautulli(t)
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	old := now.AddDate(0, 0, -200).Unix()
+	recent := now.AddDate(0, 0, -10).Unix()
+
+	f.media = []map[string]interface{}{
+		{"rating_key": "1", "added_at": strconv.FormatInt(old, 10), "last_played": nil},    // never played
+		{"rating_key": "2", "added_at": strconv.FormatInt(old, 10), "last_played": old},    // played long ago
+		{"rating_key": "3", "added_at": strconv.FormatInt(old, 10), "last_played": recent}, // played recently
+		{"rating_key": "4", "added_at": strconv.FormatInt(recent, 10), "last_played": nil}, // added recently
+	}
+
+	stats, err := CollectWatchStatistics(context.Background(), client, &arrv1alpha1.TautulliWatchStatisticsSpec{UnwatchedDays: 90}, now)
+	if err != nil {
+		t.Fatalf("CollectWatchStatistics() error = %v", err)
+	}
+	want := arrv1alpha1.TautulliLibraryStatistics{Name: "Movies", SectionID: 1, Type: "movie", Items: 4, UnwatchedItems: 2, Plays: 12}
+	if len(stats) != 1 || stats[0] != want {
+		t.Errorf("CollectWatchStatistics() = %+v, want [%+v]", stats, want)
+	}
+
+	if _, err := CollectWatchStatistics(context.Background(), client,
+		&arrv1alpha1.TautulliWatchStatisticsSpec{Libraries: []string{"TV"}}, now); err == nil {
+		t.Error("CollectWatchStatistics() error = nil, want an error for an unknown library")
+	}
+}
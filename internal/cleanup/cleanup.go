@@ -0,0 +1,154 @@
+// Package cleanup evaluates CleanupPolicy rules against the library of a
+// Radarr or Sonarr instance and applies the resulting deletions and unmonitors.
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// Actions taken on matched items
+const (
+	ActionDelete    = "delete"
+	ActionUnmonitor = "unmonitor"
+)
+
+// Item is a movie or series as far as the cleanup rules are concerned
+type Item struct {
+	ID        int
+	Title     string
+	Year      int
+	Monitored bool
+
+	// Available is set once the item is released (Radarr) or has aired episodes (Sonarr)
+	Available bool
+
+	// HasFiles is set when the item has at least one file
+	HasFiles bool
+
+	// CutoffMet is set when every wanted file exists and meets the quality cutoff
+	CutoffMet bool
+
+	Added time.Time
+	Tags  []string
+}
+
+// Library lists and changes the items of one app
+type Library interface {
+	List(ctx context.Context) ([]Item, error)
+	Delete(ctx context.Context, id int, deleteFiles, addImportExclusion bool) error
+	Unmonitor(ctx context.Context, id int) error
+}
+
+// Candidate is an item matched by a rule
+type Candidate struct {
+	Item   Item
+	Rule   arrv1alpha1.CleanupRule
+	Action string
+
+	// Deferred is set by Apply for deletions held back by the cap
+	Deferred bool
+}
+
+// Result counts what Apply changed
+type Result struct {
+	Deleted     int
+	Unmonitored int
+	Deferred    int
+}
+
+// Evaluate matches items against rules in order. Each item is handled by the
+// first rule it matches; items carrying an excluded tag are never matched.
+// Candidates are returned oldest item first, so capped deletions remove the
+// oldest items.
+func Evaluate(items []Item, rules []arrv1alpha1.CleanupRule, history WatchHistory, excludeTags []string, now time.Time) []Candidate {
+	sorted := append([]Item(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Added.Before(sorted[j].Added) })
+
+	var candidates []Candidate
+	for _, item := range sorted {
+		if hasAnyTag(item.Tags, excludeTags) {
+			continue
+		}
+		for _, rule := range rules {
+			if action, ok := match(item, rule, history, now); ok {
+				candidates = append(candidates, Candidate{Item: item, Rule: rule, Action: action})
+				break
+			}
+		}
+	}
+	return candidates
+}
+
+// match reports whether item matches rule and the action the rule takes
+func match(item Item, rule arrv1alpha1.CleanupRule, history WatchHistory, now time.Time) (string, bool) {
+	cutoff := now.AddDate(0, 0, -int(rule.Days))
+	switch rule.Type {
+	case arrv1alpha1.CleanupRuleWatchedUnmonitored:
+		lastPlayed, watched := history.LastPlayed(item.Title, item.Year)
+		return ActionDelete, !item.Monitored && watched && !lastPlayed.After(cutoff)
+	case arrv1alpha1.CleanupRuleUnavailable:
+		return ActionDelete, item.Monitored && item.Available && !item.HasFiles && !item.Added.After(cutoff)
+	case arrv1alpha1.CleanupRuleCutoffMet:
+		return ActionUnmonitor, item.Monitored && item.CutoffMet
+	}
+	return "", false
+}
+
+// Apply deletes and unmonitors the candidates. At most maxDeletions items are
+// deleted; the remaining deletions are marked deferred. With dryRun nothing
+// is changed and only deferrals are computed. Failures do not stop the run
+// and are returned together.
+func Apply(ctx context.Context, library Library, candidates []Candidate, dryRun bool, maxDeletions int) (*Result, error) {
+	result := &Result{}
+	var errs []error
+	deletions := 0
+	for i := range candidates {
+		c := &candidates[i]
+		switch c.Action {
+		case ActionDelete:
+			if deletions >= maxDeletions {
+				c.Deferred = true
+				result.Deferred++
+				continue
+			}
+			deletions++
+			if dryRun {
+				continue
+			}
+			if err := library.Delete(ctx, c.Item.ID, c.Rule.DeleteFiles, c.Rule.AddImportExclusion); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete %s: %w", c.Item.Title, err))
+				continue
+			}
+			result.Deleted++
+		case ActionUnmonitor:
+			if dryRun {
+				continue
+			}
+			if err := library.Unmonitor(ctx, c.Item.ID); err != nil {
+				errs = append(errs, fmt.Errorf("failed to unmonitor %s: %w", c.Item.Title, err))
+				continue
+			}
+			result.Unmonitored++
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// hasAnyTag reports whether tags contains any of excluded, ignoring case
+func hasAnyTag(tags, excluded []string) bool {
+	for _, tag := range tags {
+		for _, e := range excluded {
+			if strings.EqualFold(tag, e) {
+				return true
+			}
+		}
+	}
+	return false
+}
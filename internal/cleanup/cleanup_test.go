@@ -0,0 +1,116 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var now = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func daysAgo(days int) time.Time {
+	return now.AddDate(0, 0, -days)
+}
+
+func TestEvaluate(t *testing.T) {
+	items := []Item{
+		{ID: 1, Title: "Watched Long Ago", Year: 2001, Added: daysAgo(400)},
+		{ID: 2, Title: "Watched Recently", Year: 2002, Added: daysAgo(300)},
+		{ID: 3, Title: "Never Found", Year: 2003, Monitored: true, Available: true, Added: daysAgo(60)},
+		{ID: 4, Title: "Not Released", Year: 2027, Monitored: true, Added: daysAgo(60)},
+		{ID: 5, Title: "Just Added", Year: 2024, Monitored: true, Available: true, Added: daysAgo(5)},
+		{ID: 6, Title: "Perfect Copy", Year: 2005, Monitored: true, Available: true, HasFiles: true, CutoffMet: true, Added: daysAgo(100)},
+		{ID: 7, Title: "Kept", Year: 2006, Added: daysAgo(500), Tags: []string{"Keep"}},
+	}
+	history := WatchHistory{}
+	history.Record("Watched: Long Ago", 2001, daysAgo(120))
+	history.Record("watched recently", 2002, daysAgo(3))
+	history.Record("Kept", 2006, daysAgo(200))
+
+	rules := []arrv1alpha1.CleanupRule{
+		{Name: "watched", Type: arrv1alpha1.CleanupRuleWatchedUnmonitored, Days: 30},
+		{Name: "missing", Type: arrv1alpha1.CleanupRuleUnavailable, Days: 30},
+		{Name: "done", Type: arrv1alpha1.CleanupRuleCutoffMet},
+	}
+
+	candidates := Evaluate(items, rules, history, []string{"keep"}, now)
+	got := make(map[int]string)
+	var order []int
+	for _, c := range candidates {
+		got[c.Item.ID] = c.Rule.Name + ":" + c.Action
+		order = append(order, c.Item.ID)
+	}
+	want := map[int]string{1: "watched:delete", 3: "missing:delete", 6: "done:unmonitor"}
+	if len(got) != len(want) {
+		t.Fatalf("Evaluate() = %v, want %v", got, want)
+	}
+	for id, w := range want {
+		if got[id] != w {
+			t.Errorf("item %d = %q, want %q", id, got[id], w)
+		}
+	}
+	if order[0] != 1 || order[1] != 6 || order[2] != 3 {
+		t.Errorf("Evaluate() order = %v, want oldest first", order)
+	}
+}
+
+// fakeLibrary records changes and fails for the IDs in fail
+type fakeLibrary struct {
+	deleted     []int
+	unmonitored []int
+	fail        map[int]bool
+}
+
+func (f *fakeLibrary) List(ctx context.Context) ([]Item, error) { return nil, nil }
+
+func (f *fakeLibrary) Delete(ctx context.Context, id int, deleteFiles, addImportExclusion bool) error {
+	if f.fail[id] {
+		return errors.New("boom")
+	}
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func (f *fakeLibrary) Unmonitor(ctx context.Context, id int) error {
+	f.unmonitored = append(f.unmonitored, id)
+	return nil
+}
+
+func TestApply(t *testing.T) {
+	candidates := func() []Candidate {
+		return []Candidate{
+			{Item: Item{ID: 1, Title: "a"}, Action: ActionDelete},
+			{Item: Item{ID: 2, Title: "b"}, Action: ActionUnmonitor},
+			{Item: Item{ID: 3, Title: "c"}, Action: ActionDelete},
+			{Item: Item{ID: 4, Title: "d"}, Action: ActionDelete},
+		}
+	}
+
+	lib := &fakeLibrary{}
+	cs := candidates()
+	result, err := Apply(context.Background(), lib, cs, false, 2)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if result.Deleted != 2 || result.Unmonitored != 1 || result.Deferred != 1 || !cs[3].Deferred {
+		t.Errorf("Apply() = %+v, candidates = %+v", result, cs)
+	}
+	if len(lib.deleted) != 2 || lib.deleted[0] != 1 || lib.deleted[1] != 3 {
+		t.Errorf("deleted = %v, want [1 3]", lib.deleted)
+	}
+
+	lib = &fakeLibrary{}
+	result, err = Apply(context.Background(), lib, candidates(), true, 1)
+	if err != nil || len(lib.deleted)+len(lib.unmonitored) != 0 || result.Deferred != 2 {
+		t.Errorf("dry run Apply() = %+v, %v, changes = %v %v", result, err, lib.deleted, lib.unmonitored)
+	}
+
+	lib = &fakeLibrary{fail: map[int]bool{1: true}}
+	result, err = Apply(context.Background(), lib, candidates(), false, 10)
+	if err == nil || result.Deleted != 2 {
+		t.Errorf("Apply() with a failure = %+v, %v, want the other deletions applied and an error", result, err)
+	}
+}
@@ -0,0 +1,65 @@
+package cleanup
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/tautulli"
+)
+
+// WatchHistory holds when each title was last played, keyed by normalized title and year
+type WatchHistory map[string]time.Time
+
+// LastPlayed returns when the title was last played and whether it was played at all
+func (h WatchHistory) LastPlayed(title string, year int) (time.Time, bool) {
+	t, ok := h[watchKey(title, year)]
+	return t, ok
+}
+
+// Record notes a play of the title, keeping the most recent one
+func (h WatchHistory) Record(title string, year int, played time.Time) {
+	key := watchKey(title, year)
+	if last, ok := h[key]; !ok || played.After(last) {
+		h[key] = played
+	}
+}
+
+// watchKey normalizes a title so that punctuation and case differences
+// between Plex and the *arr apps do not prevent a match
+func watchKey(title string, year int) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String() + "|" + strconv.Itoa(year)
+}
+
+// TautulliWatchHistory reads the last played time of every item in the
+// Tautulli libraries of the given type (movie or show)
+func TautulliWatchHistory(ctx context.Context, client *tautulli.Client, libraryType string) (WatchHistory, error) {
+	libraries, err := client.GetLibraries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	history := WatchHistory{}
+	for _, lib := range libraries {
+		if lib.SectionType != libraryType {
+			continue
+		}
+		err := client.EachMediaItem(ctx, int(lib.SectionID), func(item *tautulli.MediaItem) {
+			if item.LastPlayed > 0 {
+				history.Record(item.Title, int(item.Year), time.Unix(int64(item.LastPlayed), 0))
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return history, nil
+}
@@ -0,0 +1,178 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+)
+
+// cutoffPageSize is the number of records requested per Sonarr wanted/cutoff page
+const cutoffPageSize = 1000
+
+// RadarrLibrary is the movie library of a Radarr instance
+type RadarrLibrary struct {
+	Client *httpclient.Client
+}
+
+// movieResource holds the Radarr movie fields the rules need
+type movieResource struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"title"`
+	Year        int       `json:"year"`
+	Monitored   bool      `json:"monitored"`
+	HasFile     bool      `json:"hasFile"`
+	IsAvailable bool      `json:"isAvailable"`
+	Added       time.Time `json:"added"`
+	Tags        []int     `json:"tags"`
+	MovieFile   *struct {
+		QualityCutoffNotMet bool `json:"qualityCutoffNotMet"`
+	} `json:"movieFile"`
+}
+
+// List returns every movie. Movies are decoded one at a time since large
+// libraries hold tens of thousands of them.
+func (l *RadarrLibrary) List(ctx context.Context) ([]Item, error) {
+	labels, err := shared.GetTagLabels(ctx, l.Client, "v3")
+	if err != nil {
+		return nil, err
+	}
+
+	var items []Item
+	err = httpclient.GetEach(ctx, l.Client, "/api/v3/movie", func(m *movieResource) error {
+		items = append(items, Item{
+			ID:        m.ID,
+			Title:     m.Title,
+			Year:      m.Year,
+			Monitored: m.Monitored,
+			Available: m.IsAvailable,
+			HasFiles:  m.HasFile,
+			CutoffMet: m.HasFile && m.MovieFile != nil && !m.MovieFile.QualityCutoffNotMet,
+			Added:     m.Added,
+			Tags:      tagLabels(m.Tags, labels),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movies: %w", err)
+	}
+	return items, nil
+}
+
+// Delete deletes a movie
+func (l *RadarrLibrary) Delete(ctx context.Context, id int, deleteFiles, addImportExclusion bool) error {
+	return l.Client.Delete(ctx, fmt.Sprintf("/api/v3/movie/%d?deleteFiles=%t&addImportExclusion=%t", id, deleteFiles, addImportExclusion))
+}
+
+// Unmonitor unmonitors a movie
+func (l *RadarrLibrary) Unmonitor(ctx context.Context, id int) error {
+	body := map[string]interface{}{"movieIds": []int{id}, "monitored": false}
+	return l.Client.Put(ctx, "/api/v3/movie/editor", body, nil)
+}
+
+// SonarrLibrary is the series library of a Sonarr instance
+type SonarrLibrary struct {
+	Client *httpclient.Client
+}
+
+// seriesResource holds the Sonarr series fields the rules need
+type seriesResource struct {
+	ID         int       `json:"id"`
+	Title      string    `json:"title"`
+	Year       int       `json:"year"`
+	Monitored  bool      `json:"monitored"`
+	Status     string    `json:"status"`
+	Added      time.Time `json:"added"`
+	Tags       []int     `json:"tags"`
+	Statistics struct {
+		EpisodeFileCount  int     `json:"episodeFileCount"`
+		EpisodeCount      int     `json:"episodeCount"`
+		PercentOfEpisodes float64 `json:"percentOfEpisodes"`
+	} `json:"statistics"`
+}
+
+// cutoffPage is one page of Sonarr's wanted/cutoff list
+type cutoffPage struct {
+	TotalRecords int `json:"totalRecords"`
+	Records      []struct {
+		SeriesID int `json:"seriesId"`
+	} `json:"records"`
+}
+
+// List returns every series. A series meets the cutoff once it ended, every
+// episode is downloaded and none is listed as below the cutoff.
+func (l *SonarrLibrary) List(ctx context.Context) ([]Item, error) {
+	labels, err := shared.GetTagLabels(ctx, l.Client, "v3")
+	if err != nil {
+		return nil, err
+	}
+	belowCutoff, err := l.seriesBelowCutoff(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []Item
+	err = httpclient.GetEach(ctx, l.Client, "/api/v3/series", func(s *seriesResource) error {
+		complete := s.Status == "ended" && s.Statistics.PercentOfEpisodes >= 100
+		items = append(items, Item{
+			ID:        s.ID,
+			Title:     s.Title,
+			Year:      s.Year,
+			Monitored: s.Monitored,
+			Available: s.Statistics.EpisodeCount > 0,
+			HasFiles:  s.Statistics.EpisodeFileCount > 0,
+			CutoffMet: complete && !belowCutoff[s.ID],
+			Added:     s.Added,
+			Tags:      tagLabels(s.Tags, labels),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+	return items, nil
+}
+
+// seriesBelowCutoff pages through wanted/cutoff and returns the series with
+// episodes below their quality cutoff
+func (l *SonarrLibrary) seriesBelowCutoff(ctx context.Context) (map[int]bool, error) {
+	result := make(map[int]bool)
+	for page, seen := 1, 0; ; page++ {
+		var p cutoffPage
+		path := fmt.Sprintf("/api/v3/wanted/cutoff?page=%d&pageSize=%d", page, cutoffPageSize)
+		if err := l.Client.Get(ctx, path, &p); err != nil {
+			return nil, fmt.Errorf("failed to get episodes below cutoff: %w", err)
+		}
+		for _, r := range p.Records {
+			result[r.SeriesID] = true
+		}
+		seen += len(p.Records)
+		if len(p.Records) == 0 || seen >= p.TotalRecords {
+			return result, nil
+		}
+	}
+}
+
+// Delete deletes a series
+func (l *SonarrLibrary) Delete(ctx context.Context, id int, deleteFiles, addImportExclusion bool) error {
+	return l.Client.Delete(ctx, fmt.Sprintf("/api/v3/series/%d?deleteFiles=%t&addImportListExclusion=%t", id, deleteFiles, addImportExclusion))
+}
+
+// Unmonitor unmonitors a series
+func (l *SonarrLibrary) Unmonitor(ctx context.Context, id int) error {
+	body := map[string]interface{}{"seriesIds": []int{id}, "monitored": false}
+	return l.Client.Put(ctx, "/api/v3/series/editor", body, nil)
+}
+
+// tagLabels resolves tag IDs to labels, skipping unknown IDs
+func tagLabels(ids []int, labels map[int]string) []string {
+	var result []string
+	for _, id := range ids {
+		if label, ok := labels[id]; ok {
+			result = append(result, label)
+		}
+	}
+	return result
+}
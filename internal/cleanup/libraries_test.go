@@ -0,0 +1,92 @@
+package cleanup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+func TestRadarrLibrary(t *testing.T) {
+	var deleted, edited string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v3/tag":
+			_, _ = w.Write([]byte(`[{"id": 1, "label": "keep"}]`))
+		case r.URL.Path == "/api/v3/movie" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`[
+				{"id": 10, "title": "Alien", "year": 1979, "monitored": true, "hasFile": true, "isAvailable": true,
+				 "added": "2024-01-02T00:00:00Z", "tags": [1], "movieFile": {"qualityCutoffNotMet": false}},
+				{"id": 11, "title": "Heat", "year": 1995, "monitored": true, "hasFile": true, "isAvailable": true,
+				 "added": "2024-01-03T00:00:00Z", "movieFile": {"qualityCutoffNotMet": true}}
+			]`))
+		case r.Method == http.MethodDelete:
+			deleted = r.URL.String()
+		case r.URL.Path == "/api/v3/movie/editor":
+			edited = r.Method
+		}
+	}))
+	defer server.Close()
+
+	lib := &RadarrLibrary{Client: httpclient.New(httpclient.Config{BaseURL: server.URL})}
+	items, err := lib.List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(items) != 2 || !items[0].CutoffMet || items[1].CutoffMet || len(items[0].Tags) != 1 || items[0].Tags[0] != "keep" {
+		t.Errorf("List() = %+v", items)
+	}
+
+	if err := lib.Delete(context.Background(), 10, true, false); err != nil || deleted != "/api/v3/movie/10?deleteFiles=true&addImportExclusion=false" {
+		t.Errorf("Delete() = %v, request %q", err, deleted)
+	}
+	if err := lib.Unmonitor(context.Background(), 10); err != nil || edited != http.MethodPut {
+		t.Errorf("Unmonitor() = %v, method %q", err, edited)
+	}
+}
+
+func TestSonarrLibraryCutoff(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/tag":
+			_, _ = w.Write([]byte(`[]`))
+		case "/api/v3/wanted/cutoff":
+			pages++
+			if r.URL.Query().Get("page") == "1" {
+				_, _ = w.Write([]byte(`{"totalRecords": 2, "records": [{"seriesId": 2}]}`))
+			} else {
+				_, _ = w.Write([]byte(`{"totalRecords": 2, "records": [{"seriesId": 3}]}`))
+			}
+		case "/api/v3/series":
+			_, _ = w.Write([]byte(`[
+				{"id": 1, "title": "Done", "status": "ended", "monitored": true, "statistics": {"episodeFileCount": 10, "episodeCount": 10, "percentOfEpisodes": 100}},
+				{"id": 2, "title": "Low Quality", "status": "ended", "monitored": true, "statistics": {"episodeFileCount": 10, "episodeCount": 10, "percentOfEpisodes": 100}},
+				{"id": 4, "title": "Running", "status": "continuing", "monitored": true, "statistics": {"episodeFileCount": 5, "episodeCount": 5, "percentOfEpisodes": 100}},
+				{"id": 5, "title": "Nothing Yet", "status": "continuing", "monitored": true, "statistics": {"episodeFileCount": 0, "episodeCount": 3}}
+			]`))
+		}
+	}))
+	defer server.Close()
+
+	lib := &SonarrLibrary{Client: httpclient.New(httpclient.Config{BaseURL: server.URL})}
+	items, err := lib.List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if pages != 2 {
+		t.Errorf("wanted/cutoff pages = %d, want 2", pages)
+	}
+	cutoffMet := map[int]bool{}
+	for _, item := range items {
+		cutoffMet[item.ID] = item.CutoffMet
+	}
+	if !cutoffMet[1] || cutoffMet[2] || cutoffMet[4] || cutoffMet[5] {
+		t.Errorf("CutoffMet = %v, want only series 1", cutoffMet)
+	}
+	if items[3].HasFiles || !items[3].Available {
+		t.Errorf("series 5 = %+v, want available without files", items[3])
+	}
+}
@@ -17,6 +17,9 @@ import (
 // Compiler transforms CRD intent into IR
 type Compiler struct {
 	expander *presets.Expander
+
+	// SecretKey keys the HMACs of resolved credentials (see SecretSalt)
+	SecretKey []byte
 }
 
 // New creates a new Compiler
@@ -41,7 +44,7 @@ func (c *Compiler) Compile(ctx context.Context, input CompileInput) (*irv1.IR, e
 	}
 
 	// 2. Expand quality preset based on app type
-	profileName := fmt.Sprintf("nebularr-%s", input.ConfigName)
+	profileName := DefaultQualityProfileName(input.ConfigName)
 	switch input.App {
 	case adapters.AppRadarr, adapters.AppSonarr:
 		presetName := input.QualityPreset
@@ -51,6 +54,11 @@ func (c *Compiler) Compile(ctx context.Context, input CompileInput) (*irv1.IR, e
 		ir.Quality = &irv1.QualityIR{
 			Video: c.expander.ExpandVideoPreset(presetName, input.QualityOverrides, profileName),
 		}
+		ir.Quality.Video.CloneFrom = input.QualityCloneFrom
+		c.expandQualityProfiles(ir.Quality, input)
+		for _, profile := range ir.Quality.AllVideoProfiles() {
+			profile.Language = input.ProfileLanguage
+		}
 	case adapters.AppLidarr:
 		presetName := input.QualityPreset
 		if presetName == "" {
@@ -89,8 +97,20 @@ func (c *Compiler) Compile(ctx context.Context, input CompileInput) (*irv1.IR, e
 			Sonarr: c.expander.ExpandSonarrNaming(namingPreset),
 		}
 	case adapters.AppLidarr:
+		naming := c.expander.ExpandLidarrNaming(namingPreset)
+		applyLidarrNamingOverrides(naming, input.NamingOverrides)
 		ir.Naming = &irv1.NamingIR{
-			Lidarr: c.expander.ExpandLidarrNaming(namingPreset),
+			Lidarr: naming,
+		}
+	case adapters.AppReadarr:
+		// Readarr naming is only managed when the spec sets it, so existing
+		// installs keep their naming
+		if input.NamingPreset != "" || input.NamingOverrides != nil {
+			naming := c.expander.ExpandReadarrNaming(namingPreset)
+			applyReadarrNamingOverrides(naming, input.NamingOverrides)
+			ir.Naming = &irv1.NamingIR{
+				Readarr: naming,
+			}
 		}
 	}
 
@@ -110,12 +130,19 @@ func (c *Compiler) Compile(ctx context.Context, input CompileInput) (*irv1.IR, e
 
 	// 8. Compile import lists
 	ir.ImportLists = c.compileImportListsToIR(input.ImportLists)
+	resolveImportListProfiles(ir.ImportLists, input)
 
-	// 9. Compile media management
+	// 9. Compile media management and collections (Radarr)
+	ir.ImportListOptions = c.compileImportListOptionsToIR(input.ImportListOptions)
 	ir.MediaManagement = c.compileMediaManagementToIR(input.MediaManagement)
+	ir.Collections = c.compileCollectionsToIR(input.Collections)
+	if ir.Collections != nil && declaresQualityProfile(input, ir.Collections.QualityProfileName) {
+		ir.Collections.QualityProfileName = QualityProfileName(input.ConfigName, ir.Collections.QualityProfileName)
+	}
 
-	// 10. Compile authentication
+	// 10. Compile authentication and UI settings
 	ir.Authentication = c.compileAuthenticationToIR(input.Authentication)
+	ir.UI = c.compileUIToIR(input.UI)
 
 	// 11. Compile notifications
 	ir.Notifications = c.compileNotificationsToIR(input.Notifications, input.ConfigName)
@@ -126,8 +153,8 @@ func (c *Compiler) Compile(ctx context.Context, input CompileInput) (*irv1.IR, e
 
 		// Populate format scores in quality profile from custom format scores
 		if ir.Quality != nil && len(input.CustomFormats) > 0 {
-			if ir.Quality.Video != nil {
-				ir.Quality.Video.FormatScores = c.compileFormatScores(input.CustomFormats, input.ConfigName)
+			for _, profile := range ir.Quality.AllVideoProfiles() {
+				profile.FormatScores = c.compileFormatScores(input.CustomFormats, input.ConfigName)
 			}
 			if ir.Quality.Audio != nil {
 				ir.Quality.Audio.FormatScores = c.compileFormatScores(input.CustomFormats, input.ConfigName)
@@ -135,31 +162,154 @@ func (c *Compiler) Compile(ctx context.Context, input CompileInput) (*irv1.IR, e
 		}
 	}
 
-	// 13. Compile delay profiles (Radarr/Sonarr/Lidarr)
-	if input.App == adapters.AppRadarr || input.App == adapters.AppSonarr || input.App == adapters.AppLidarr {
-		ir.DelayProfiles = c.compileDelayProfilesToIR(input.DelayProfiles)
+	// 13. Compile delay profiles (Radarr/Sonarr/Lidarr/Readarr)
+	var duplicateDelayProfiles []irv1.UnrealizedFeature
+	if input.App == adapters.AppRadarr || input.App == adapters.AppSonarr || input.App == adapters.AppLidarr || input.App == adapters.AppReadarr {
+		ir.DelayProfiles, duplicateDelayProfiles = c.compileDelayProfilesToIR(input.DelayProfiles)
 	}
 
-	// 14. Prune unsupported features based on capabilities
+	// 14. Compile quality definitions and auto tags (Radarr/Sonarr)
+	var invalidDefinitions []irv1.UnrealizedFeature
+	if input.App == adapters.AppRadarr || input.App == adapters.AppSonarr {
+		ir.QualityDefinitions, invalidDefinitions = c.compileQualityDefinitionsToIR(input.QualityDefinitions)
+		ir.AutoTags = c.compileAutoTagsToIR(input.AutoTags, input.Namespace, input.ConfigName)
+	}
+
+	// 15. Compile release profiles (Lidarr)
+	var invalidReleaseProfiles []irv1.UnrealizedFeature
+	if input.App == adapters.AppLidarr {
+		ir.ReleaseProfiles, invalidReleaseProfiles = c.compileReleaseProfilesToIR(input.ReleaseProfiles)
+	}
+
+	// 16. Prune unsupported features based on capabilities
 	if input.Capabilities != nil {
 		ir.Unrealized = c.pruneUnsupported(ir, input.Capabilities)
 	}
+	ir.Unrealized = append(ir.Unrealized, invalidDefinitions...)
+	ir.Unrealized = append(ir.Unrealized, duplicateDelayProfiles...)
+	ir.Unrealized = append(ir.Unrealized, invalidReleaseProfiles...)
 
-	// 15. Generate source hash for drift detection
+	// 17. Fingerprint credentials and generate source hash for drift detection
+	hashSecrets(ir, input.SecretSalt)
 	ir.SourceHash = c.hashInput(input)
 
 	return ir, nil
 }
 
+// DefaultQualityProfileName returns the generated name of a config's default quality profile
+func DefaultQualityProfileName(configName string) string {
+	return fmt.Sprintf("nebularr-%s", configName)
+}
+
+// QualityProfileName returns the generated name of an additional quality profile
+func QualityProfileName(configName, name string) string {
+	return fmt.Sprintf("nebularr-%s-%s", configName, name)
+}
+
+// expandQualityProfiles expands additional named video profiles into quality.
+// Custom formats created by their presets are merged into the default profile's
+// list, which is what the adapters sync.
+func (c *Compiler) expandQualityProfiles(quality *irv1.QualityIR, input CompileInput) {
+	known := make(map[string]bool)
+	for _, cf := range quality.Video.CustomFormats {
+		known[cf.Name] = true
+	}
+
+	for _, p := range input.QualityProfiles {
+		presetName := p.Preset
+		if presetName == "" {
+			presetName = presets.DefaultVideoPreset
+		}
+		profile := c.expander.ExpandVideoPreset(presetName, p.Overrides, QualityProfileName(input.ConfigName, p.Name))
+		quality.VideoProfiles = append(quality.VideoProfiles, profile)
+
+		for _, cf := range profile.CustomFormats {
+			if !known[cf.Name] {
+				known[cf.Name] = true
+				quality.Video.CustomFormats = append(quality.Video.CustomFormats, cf)
+			}
+		}
+	}
+}
+
+// applyLidarrNamingOverrides replaces preset values with the formats set in the spec
+func applyLidarrNamingOverrides(naming *irv1.LidarrNamingIR, overrides *NamingOverridesInput) {
+	if overrides == nil {
+		return
+	}
+	if overrides.Rename != nil {
+		naming.RenameTracks = *overrides.Rename
+	}
+	if overrides.StandardFormat != "" {
+		naming.StandardTrackFormat = overrides.StandardFormat
+	}
+	if overrides.MultiDiscFormat != "" {
+		naming.MultiDiscTrackFormat = overrides.MultiDiscFormat
+	}
+	if overrides.FolderFormat != "" {
+		naming.ArtistFolderFormat = overrides.FolderFormat
+	}
+	if overrides.AlbumFolderFormat != "" {
+		naming.AlbumFolderFormat = overrides.AlbumFolderFormat
+	}
+}
+
+// applyReadarrNamingOverrides replaces preset values with the formats set in the spec
+func applyReadarrNamingOverrides(naming *irv1.ReadarrNamingIR, overrides *NamingOverridesInput) {
+	if overrides == nil {
+		return
+	}
+	if overrides.Rename != nil {
+		naming.RenameBooks = *overrides.Rename
+	}
+	if overrides.StandardFormat != "" {
+		naming.StandardBookFormat = overrides.StandardFormat
+	}
+	if overrides.FolderFormat != "" {
+		naming.AuthorFolderFormat = overrides.FolderFormat
+	}
+	if overrides.ColonReplacement != nil {
+		naming.ColonReplacementFormat = *overrides.ColonReplacement
+	}
+}
+
+// resolveImportListProfiles rewrites import list profile references that name an
+// additional quality profile to that profile's generated name. Other references
+// are kept as literal profile names in the app.
+func resolveImportListProfiles(lists []irv1.ImportListIR, input CompileInput) {
+	for i := range lists {
+		if declaresQualityProfile(input, lists[i].QualityProfileName) {
+			lists[i].QualityProfileName = QualityProfileName(input.ConfigName, lists[i].QualityProfileName)
+		}
+	}
+}
+
+// declaresQualityProfile reports whether name is one of the additional quality profiles in input
+func declaresQualityProfile(input CompileInput, name string) bool {
+	for _, p := range input.QualityProfiles {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // hashInput generates a deterministic hash of the compilation input
 func (c *Compiler) hashInput(input CompileInput) string {
-	// Create a simplified struct for hashing (exclude resolved secrets for security)
+	// Resolved credentials are hashed as salted HMACs, never as plain values
+	input = redactSecrets(input)
+
+	// Create a simplified struct for hashing
 	hashable := struct {
 		App                string
 		ConfigName         string
 		QualityPreset      string
 		QualityOverrides   *presets.QualityOverrides
+		QualityCloneFrom   string
+		QualityProfiles    []QualityProfileInput
+		ProfileLanguage    *irv1.LanguageIR
 		NamingPreset       string
+		NamingOverrides    *NamingOverridesInput
 		DownloadClients    []DownloadClientInput
 		RemotePathMappings []RemotePathMappingInput
 		Indexers           *IndexersInput
@@ -167,12 +317,19 @@ func (c *Compiler) hashInput(input CompileInput) string {
 		Notifications      []NotificationInput
 		CustomFormats      []CustomFormatInput
 		DelayProfiles      []DelayProfileInput
+		QualityDefinitions []QualityDefinitionInput
+		ReleaseProfiles    []ReleaseProfileInput
+		AutoTags           []AutoTagInput
 	}{
 		App:                input.App,
 		ConfigName:         input.ConfigName,
 		QualityPreset:      input.QualityPreset,
 		QualityOverrides:   input.QualityOverrides,
+		QualityCloneFrom:   input.QualityCloneFrom,
+		QualityProfiles:    input.QualityProfiles,
+		ProfileLanguage:    input.ProfileLanguage,
 		NamingPreset:       input.NamingPreset,
+		NamingOverrides:    input.NamingOverrides,
 		DownloadClients:    input.DownloadClients,
 		RemotePathMappings: input.RemotePathMappings,
 		Indexers:           input.Indexers,
@@ -180,6 +337,9 @@ func (c *Compiler) hashInput(input CompileInput) string {
 		Notifications:      input.Notifications,
 		CustomFormats:      input.CustomFormats,
 		DelayProfiles:      input.DelayProfiles,
+		QualityDefinitions: input.QualityDefinitions,
+		ReleaseProfiles:    input.ReleaseProfiles,
+		AutoTags:           input.AutoTags,
 	}
 
 	data, err := json.Marshal(hashable)
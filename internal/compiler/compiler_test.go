@@ -2,10 +2,15 @@ package compiler
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
@@ -229,6 +234,102 @@ func TestConvertNotifications(t *testing.T) {
 	}
 }
 
+func TestConvertMediaServerHooks(t *testing.T) {
+	hooks := &arrv1alpha1.MediaServerHooksSpec{
+		Plex: &arrv1alpha1.PlexHookSpec{
+			URL:            "https://plex.example.com:32400",
+			TokenSecretRef: arrv1alpha1.SecretKeySelector{Name: "plex", Key: "token"},
+		},
+		Jellyfin: &arrv1alpha1.JellyfinHookSpec{
+			URL:             "http://jellyfin:8096",
+			APIKeySecretRef: arrv1alpha1.SecretKeySelector{Name: "jellyfin"},
+			UpdateLibrary:   boolPtr(false),
+		},
+	}
+	secrets := map[string]string{
+		"plex/token":      "plex-token",
+		"jellyfin/apiKey": "jellyfin-key",
+	}
+
+	result := convertMediaServerHooks(hooks, secrets)
+	if len(result) != 2 {
+		t.Fatalf("expected 2 notifications, got %d", len(result))
+	}
+
+	plex := result[0]
+	if plex.Implementation != "PlexServer" || plex.Fields["host"] != "plex.example.com" ||
+		plex.Fields["port"] != 32400 || plex.Fields["useSsl"] != true ||
+		plex.Fields["authToken"] != "plex-token" || plex.Fields["updateLibrary"] != true {
+		t.Errorf("unexpected plex notification: %+v", plex)
+	}
+
+	jellyfin := result[1]
+	if jellyfin.Implementation != "MediaBrowser" || jellyfin.Fields["apiKey"] != "jellyfin-key" ||
+		jellyfin.Fields["updateLibrary"] != false || !jellyfin.OnDownload {
+		t.Errorf("unexpected jellyfin notification: %+v", jellyfin)
+	}
+
+	if got := New().compileNotificationsToIR(result, "movies")[0].Name; got != MediaServerNotificationName("movies", MediaServerPlex) {
+		t.Errorf("compiled name %q does not match MediaServerNotificationName", got)
+	}
+}
+
+func TestConvertIndexersPreset(t *testing.T) {
+	spec := &arrv1alpha1.IndexersSpec{
+		Preset: "private-first",
+		Direct: []arrv1alpha1.DirectIndexer{
+			{Name: "public", URL: "http://public", Type: "torrent", Priority: 25},
+			{Name: "nzb", URL: "http://nzb", Type: "usenet", Priority: 25},
+			{Name: "pt-a", URL: "http://pt-a", Type: "torrent", Priority: 25, Tags: []string{"private"}},
+			{Name: "pt-b", URL: "http://pt-b", Type: "torrent", Tags: []string{"private"}},
+			{Name: "pinned", URL: "http://pinned", Type: "torrent", Priority: 7},
+		},
+	}
+
+	result := convertIndexers(spec, nil)
+
+	tests := []struct {
+		name     string
+		priority int
+		seeders  int
+	}{
+		{"public", 30, 5},
+		{"nzb", 10, 0},
+		{"pt-a", 1, 1},
+		{"pt-b", 2, 1},
+		{"pinned", 7, 5},
+	}
+	for i, tt := range tests {
+		got := result.Direct[i]
+		if got.Name != tt.name || got.Priority != tt.priority || got.MinimumSeeders != tt.seeders {
+			t.Errorf("indexer %d: got %s priority=%d seeders=%d, want %s priority=%d seeders=%d",
+				i, got.Name, got.Priority, got.MinimumSeeders, tt.name, tt.priority, tt.seeders)
+		}
+	}
+}
+
+func TestConvertIndexersPresetBandLimit(t *testing.T) {
+	spec := &arrv1alpha1.IndexersSpec{Preset: "private-first"}
+	for i := 0; i < 12; i++ {
+		spec.Direct = append(spec.Direct, arrv1alpha1.DirectIndexer{
+			Name: fmt.Sprintf("pt-%d", i), URL: "http://pt", Type: "torrent", Tags: []string{"private"},
+		})
+	}
+	spec.Direct = append(spec.Direct, arrv1alpha1.DirectIndexer{Name: "nzb", URL: "http://nzb", Type: "usenet"})
+
+	result := convertIndexers(spec, nil)
+
+	// The private band (1+) stops below the usenet band (10+) instead of reaching it
+	var got []int
+	for _, idx := range result.Direct {
+		got = append(got, idx.Priority)
+	}
+	want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 9, 9, 9, 10}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("priorities = %v, want %v", got, want)
+	}
+}
+
 func TestCompileCustomFormatsToIR(t *testing.T) {
 	c := New()
 
@@ -479,3 +580,382 @@ func TestCompileFormatScores(t *testing.T) {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func TestCompileQualityDefinitionsToIR(t *testing.T) {
+	c := New()
+
+	result, unrealized := c.compileQualityDefinitionsToIR([]QualityDefinitionInput{
+		{Quality: "Bluray-1080p", MinSize: "50.8", MaxSize: "2000", PreferredSize: "unlimited"},
+		{Quality: "HDTV-720p", MaxSize: "abc"},
+	})
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 quality definitions, got %d", len(result))
+	}
+
+	bluray := result[0]
+	if bluray.MinSize == nil || *bluray.MinSize != 50.8 {
+		t.Errorf("expected minSize 50.8, got %v", bluray.MinSize)
+	}
+	if bluray.MaxSize == nil || *bluray.MaxSize != 2000 {
+		t.Errorf("expected maxSize 2000, got %v", bluray.MaxSize)
+	}
+	if bluray.PreferredSize == nil || *bluray.PreferredSize != irv1.SizeLimitUnlimited {
+		t.Errorf("expected unlimited preferredSize, got %v", bluray.PreferredSize)
+	}
+
+	hdtv := result[1]
+	if hdtv.MinSize != nil || hdtv.MaxSize != nil || hdtv.PreferredSize != nil {
+		t.Errorf("expected all sizes unset for HDTV-720p, got %+v", hdtv)
+	}
+	if len(unrealized) != 1 || unrealized[0].Feature != "qualityDefinition:HDTV-720p:maxSize" {
+		t.Errorf("expected one unrealized maxSize entry, got %v", unrealized)
+	}
+}
+
+func TestCompileQualityProfiles(t *testing.T) {
+	c := New()
+
+	input := CompileInput{
+		App:           adapters.AppRadarr,
+		ConfigName:    "movies",
+		Namespace:     "default",
+		URL:           "http://radarr:7878",
+		APIKey:        "test-api-key",
+		QualityPreset: "balanced",
+		QualityProfiles: []QualityProfileInput{
+			{Name: "uhd", Preset: "4k-hdr"},
+		},
+		ImportLists: []ImportListInput{
+			{Name: "trending", Type: "tmdb", QualityProfileName: "uhd"},
+			{Name: "popular", Type: "tmdb", QualityProfileName: "Any"},
+		},
+		Collections: &CollectionsInput{QualityProfile: "uhd", RootFolder: "/movies"},
+	}
+
+	ir, err := c.Compile(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	profiles := ir.Quality.AllVideoProfiles()
+	if len(profiles) != 2 {
+		t.Fatalf("expected 2 video profiles, got %d", len(profiles))
+	}
+	if profiles[1].ProfileName != "nebularr-movies-uhd" {
+		t.Errorf("expected profile name nebularr-movies-uhd, got %q", profiles[1].ProfileName)
+	}
+
+	known := make(map[string]bool)
+	for _, cf := range ir.Quality.Video.CustomFormats {
+		known[cf.Name] = true
+	}
+	for _, cf := range profiles[1].CustomFormats {
+		if !known[cf.Name] {
+			t.Errorf("custom format %q from additional profile not merged into default profile", cf.Name)
+		}
+	}
+
+	if got := ir.ImportLists[0].QualityProfileName; got != "nebularr-movies-uhd" {
+		t.Errorf("expected import list profile nebularr-movies-uhd, got %q", got)
+	}
+	if got := ir.ImportLists[1].QualityProfileName; got != "Any" {
+		t.Errorf("expected import list profile Any to be kept, got %q", got)
+	}
+	if got := ir.Collections.QualityProfileName; got != "nebularr-movies-uhd" {
+		t.Errorf("expected collections profile nebularr-movies-uhd, got %q", got)
+	}
+}
+
+func TestCompileLanguage(t *testing.T) {
+	score := 50
+	radarr, err := New().CompileRadarrConfig(context.Background(), &arrv1alpha1.RadarrConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "movies"},
+		Spec: arrv1alpha1.RadarrConfigSpec{
+			Connection:      arrv1alpha1.ConnectionSpec{URL: "http://radarr:7878"},
+			QualityProfiles: []arrv1alpha1.NamedVideoQualitySpec{{Name: "uhd", Preset: "4k-hdr"}},
+			Language:        &arrv1alpha1.LanguageSpec{Profile: "french", PreferOriginal: true, Score: &score},
+		},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, profile := range radarr.Quality.AllVideoProfiles() {
+		if profile.Language == nil || profile.Language.ID != 2 || profile.Language.Name != "French" {
+			t.Errorf("expected profile %s language French (2), got %+v", profile.ProfileName, profile.Language)
+		}
+		if got := profile.FormatScores["nebularr-movies-original-language"]; got != 50 {
+			t.Errorf("expected profile %s to score original-language 50, got %d", profile.ProfileName, got)
+		}
+	}
+	if len(radarr.CustomFormats) != 1 || radarr.CustomFormats[0].Specifications[0].Value != "-2" {
+		t.Errorf("expected only the original language format on Radarr, got %+v", radarr.CustomFormats)
+	}
+
+	// Sonarr v4 profiles have no language, so it becomes a custom format
+	sonarr, err := New().CompileSonarrConfig(context.Background(), &arrv1alpha1.SonarrConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "shows"},
+		Spec: arrv1alpha1.SonarrConfigSpec{
+			Connection: arrv1alpha1.ConnectionSpec{URL: "http://sonarr:8989"},
+			Language:   &arrv1alpha1.LanguageSpec{Profile: "Portuguese (Brazil)"},
+		},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sonarr.Quality.Video.Language != nil {
+		t.Errorf("expected no Sonarr profile language, got %+v", sonarr.Quality.Video.Language)
+	}
+	if len(sonarr.CustomFormats) != 1 {
+		t.Fatalf("expected 1 language custom format, got %d", len(sonarr.CustomFormats))
+	}
+	cf := sonarr.CustomFormats[0]
+	if cf.Name != "nebularr-shows-language-portuguesebrazil" || cf.Specifications[0].Type != "LanguageSpecification" || cf.Specifications[0].Value != "33" {
+		t.Errorf("unexpected language custom format %+v", cf)
+	}
+	if got := sonarr.Quality.Video.FormatScores[cf.Name]; got != 100 {
+		t.Errorf("expected default language score 100, got %d", got)
+	}
+}
+
+func TestCompileSecretHashes(t *testing.T) {
+	c := New()
+	compile := func(salt, password string) *irv1.IR {
+		t.Helper()
+		ir, err := c.Compile(context.Background(), CompileInput{
+			App:        adapters.AppRadarr,
+			ConfigName: "test-config",
+			Namespace:  "default",
+			URL:        "http://radarr:7878",
+			APIKey:     "test-api-key",
+			SecretSalt: salt,
+			DownloadClients: []DownloadClientInput{
+				{Name: "qbit", Implementation: "qbittorrent", Host: "qbit", Port: 8080, Username: "admin", Password: password},
+				{Name: "sab", Implementation: "sabnzbd", Host: "sab", Port: 8080},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return ir
+	}
+
+	base := compile("uid-1", "hunter2")
+	if len(base.DownloadClients) != 2 {
+		t.Fatalf("expected 2 download clients, got %d", len(base.DownloadClients))
+	}
+	hash := base.DownloadClients[0].SecretHash
+	if hash == "" {
+		t.Fatal("expected a secret hash for a client with credentials")
+	}
+	if base.DownloadClients[1].SecretHash != "" {
+		t.Errorf("expected no secret hash for a client without credentials, got %q", base.DownloadClients[1].SecretHash)
+	}
+	if hash == SecretHash("", "admin", "hunter2") {
+		t.Error("expected the secret hash to depend on the salt")
+	}
+
+	rotated := compile("uid-1", "correct-horse")
+	if rotated.DownloadClients[0].SecretHash == hash {
+		t.Error("expected the secret hash to change when the password rotates")
+	}
+	if rotated.SourceHash == base.SourceHash {
+		t.Error("expected the source hash to change when the password rotates")
+	}
+	if again := compile("uid-1", "hunter2"); again.SourceHash != base.SourceHash {
+		t.Error("expected the source hash to be stable for the same credentials")
+	}
+
+	if SecretHash("s", "ab", "c") == SecretHash("s", "a", "bc") {
+		t.Error("expected value boundaries to be part of the secret hash")
+	}
+}
+
+func TestHashSecretsTraktTokens(t *testing.T) {
+	ir := &irv1.IR{ImportLists: []irv1.ImportListIR{
+		{Name: "favorites", TraktList: &irv1.TraktListImportIR{AccessToken: "t0ken", RefreshToken: "refresh"}},
+		{Name: "plex", PlexRSS: &irv1.PlexRSSImportIR{URL: "https://rss.plex.tv/abc"}},
+	}}
+	hashSecrets(ir, "salt")
+
+	if got, want := ir.ImportLists[0].TraktList.SecretHash, SecretHash("salt", "t0ken", "refresh"); got != want {
+		t.Errorf("trakt secret hash = %q, want %q", got, want)
+	}
+}
+
+func TestSecretSalt(t *testing.T) {
+	salt := SecretSalt([]byte("key-1"), "uid-1")
+	if salt != SecretSalt([]byte("key-1"), "uid-1") {
+		t.Error("expected the salt to be stable for the same key and UID")
+	}
+	if salt == SecretSalt([]byte("key-2"), "uid-1") {
+		t.Error("expected the salt to depend on the secret key")
+	}
+	if salt == SecretSalt([]byte("key-1"), "uid-2") {
+		t.Error("expected the salt to depend on the UID")
+	}
+
+	hash := SecretHash(salt, "admin", "hunter2")
+	if !IsKeyedSecretHash(hash) {
+		t.Errorf("expected %q to be a keyed secret hash", hash)
+	}
+	if IsKeyedSecretHash("0123456789abcdef") {
+		t.Error("expected a hash of the unkeyed scheme to be rejected")
+	}
+}
+
+func TestCompileAudioNaming(t *testing.T) {
+	rename := false
+
+	lidarr, err := New().CompileLidarrConfig(context.Background(), &arrv1alpha1.LidarrConfig{
+		Spec: arrv1alpha1.LidarrConfigSpec{
+			Connection: arrv1alpha1.ConnectionSpec{URL: "http://lidarr:8686"},
+			Naming: &arrv1alpha1.LidarrNamingSpec{
+				NamingSpec:         arrv1alpha1.NamingSpec{Preset: "jellyfin-music", RenameMedia: &rename},
+				ArtistFolderFormat: "{Artist CleanName}",
+			},
+		},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	naming := lidarr.Naming.Lidarr
+	if naming.MultiDiscTrackFormat != "Disc {medium:0}/{track:00} - {Track Title}" {
+		t.Errorf("expected the jellyfin-music multi-disc format, got %q", naming.MultiDiscTrackFormat)
+	}
+	if naming.ArtistFolderFormat != "{Artist CleanName}" || naming.RenameTracks {
+		t.Errorf("expected spec overrides to win, got folder %q and rename %v", naming.ArtistFolderFormat, naming.RenameTracks)
+	}
+
+	readarrConfig := &arrv1alpha1.ReadarrConfig{
+		Spec: arrv1alpha1.ReadarrConfigSpec{
+			Connection: arrv1alpha1.ConnectionSpec{URL: "http://readarr:8787"},
+		},
+	}
+	readarr, err := New().CompileReadarrConfig(context.Background(), readarrConfig, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if readarr.Naming != nil {
+		t.Errorf("expected Readarr naming to be unmanaged without spec.naming, got %+v", readarr.Naming)
+	}
+
+	readarrConfig.Spec.Naming = &arrv1alpha1.ReadarrNamingSpec{
+		NamingSpec:             arrv1alpha1.NamingSpec{Preset: "audiobookshelf"},
+		ColonReplacementFormat: "spaceDashSpace",
+	}
+	readarr, err = New().CompileReadarrConfig(context.Background(), readarrConfig, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &irv1.ReadarrNamingIR{
+		RenameBooks:              true,
+		ReplaceIllegalCharacters: true,
+		ColonReplacementFormat:   3,
+		StandardBookFormat:       "{Book Title}/{Book Title}{ (PartNumber)}",
+		AuthorFolderFormat:       "{Author Name}",
+	}
+	if readarr.Naming == nil || *readarr.Naming.Readarr != *expected {
+		t.Errorf("expected %+v, got %+v", expected, readarr.Naming)
+	}
+}
+
+func TestCompileAudioAndBookDelayProfiles(t *testing.T) {
+	profiles := []arrv1alpha1.DelayProfileSpec{
+		{Name: "default", PreferredProtocol: "usenet", UsenetDelay: 60},
+		{Name: "private", PreferredProtocol: "torrent", TorrentDelay: 30, Tags: []string{"private"}},
+	}
+
+	lidarr, err := New().CompileLidarrConfig(context.Background(), &arrv1alpha1.LidarrConfig{
+		Spec: arrv1alpha1.LidarrConfigSpec{
+			Connection:    arrv1alpha1.ConnectionSpec{URL: "http://lidarr:8686"},
+			DelayProfiles: profiles,
+		},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(lidarr.DelayProfiles) != 2 || lidarr.DelayProfiles[1].TorrentDelay != 30 {
+		t.Errorf("expected both Lidarr delay profiles compiled, got %+v", lidarr.DelayProfiles)
+	}
+
+	readarr, err := New().CompileReadarrConfig(context.Background(), &arrv1alpha1.ReadarrConfig{
+		Spec: arrv1alpha1.ReadarrConfigSpec{
+			Connection:    arrv1alpha1.ConnectionSpec{URL: "http://readarr:8787"},
+			DelayProfiles: profiles,
+		},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(readarr.DelayProfiles) != 2 || readarr.DelayProfiles[0].UsenetDelay != 60 ||
+		len(readarr.DelayProfiles[1].TagNames) != 1 || readarr.DelayProfiles[1].TagNames[0] != "private" {
+		t.Errorf("expected both Readarr delay profiles compiled with their tags, got %+v", readarr.DelayProfiles)
+	}
+}
+
+func TestCompileAutoTags(t *testing.T) {
+	minYear, yes := 2000, true
+	specs := []arrv1alpha1.AutoTagSpec{{
+		Name:                    "anime",
+		Tags:                    []string{"anime"},
+		RemoveTagsAutomatically: &yes,
+		Specifications: []arrv1alpha1.AutoTagSpecificationSpec{
+			{Name: "Anime", Type: "GenreSpecification", Values: []string{"Anime"}, Required: &yes},
+			{Name: "Recent", Type: "YearSpecification", Negate: &yes, Min: &minYear},
+		},
+	}}
+
+	c := New()
+	ir, err := c.Compile(context.Background(), CompileInput{
+		App:        adapters.AppSonarr,
+		ConfigName: "tv",
+		Namespace:  "media",
+		AutoTags:   convertAutoTags(specs),
+	})
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if len(ir.AutoTags) != 1 {
+		t.Fatalf("AutoTags = %+v, want one rule", ir.AutoTags)
+	}
+
+	// The rule is named after the config's ownership tag and its conditions carried over
+	want := irv1.AutoTagIR{
+		Name:                    shared.ConfigOwnershipTag("media", "tv") + "-anime",
+		RemoveTagsAutomatically: true,
+		TagNames:                []string{"anime"},
+		Specifications: []irv1.AutoTagSpecIR{
+			{Type: "GenreSpecification", Name: "Anime", Required: true, Values: []string{"Anime"}},
+			{Type: "YearSpecification", Name: "Recent", Negate: true, Min: &minYear},
+		},
+	}
+	if !reflect.DeepEqual(ir.AutoTags[0], want) {
+		t.Errorf("rule = %+v, want %+v", ir.AutoTags[0], want)
+	}
+
+	if convertAutoTags(nil) != nil {
+		t.Error("expected no rules when none are declared")
+	}
+}
+
+func TestPruneImportListSyncInterval(t *testing.T) {
+	c := New()
+	for _, tt := range []struct {
+		version string
+		want    int
+	}{
+		{version: "4.7.5.7809", want: 12},
+		{version: "5.2.6.8376", want: 0},
+		{version: "", want: 12},
+	} {
+		ir := &irv1.IR{App: adapters.AppRadarr, ImportListOptions: &irv1.ImportListOptionsIR{SyncIntervalHours: 12}}
+		unrealized := c.pruneUnsupported(ir, &adapters.Capabilities{Version: tt.version})
+		if got := ir.ImportListOptions.SyncIntervalHours; got != tt.want {
+			t.Errorf("version %q: SyncIntervalHours = %d, want %d", tt.version, got, tt.want)
+		}
+		if pruned := len(unrealized) == 1 && unrealized[0].Feature == "importListOptions:syncIntervalHours"; pruned != (tt.want == 0) {
+			t.Errorf("version %q: unrealized = %v", tt.version, unrealized)
+		}
+	}
+}
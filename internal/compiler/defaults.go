@@ -0,0 +1,102 @@
+package compiler
+
+import (
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/presets"
+)
+
+// The Default*Spec functions fill in the defaults the compiler would otherwise
+// apply silently, so the stored spec shows what the operator acts on. They
+// only set what compiles the same either way: indexer preset priorities and
+// categories derived through downloadClientRef depend on other entries or
+// objects and are left to the compiler.
+
+// DefaultRadarrSpec fills in the quality preset, naming preset and download client types
+func DefaultRadarrSpec(spec *arrv1alpha1.RadarrConfigSpec) {
+	spec.Quality = defaultVideoQuality(spec.Quality)
+	defaultQualityProfiles(spec.QualityProfiles)
+	if spec.Naming == nil {
+		spec.Naming = &arrv1alpha1.NamingSpec{}
+	}
+	defaultNaming(spec.Naming)
+	DefaultDownloadClients(spec.DownloadClients)
+}
+
+// DefaultSonarrSpec fills in the quality preset, naming preset and download client types
+func DefaultSonarrSpec(spec *arrv1alpha1.SonarrConfigSpec) {
+	spec.Quality = defaultVideoQuality(spec.Quality)
+	defaultQualityProfiles(spec.QualityProfiles)
+	if spec.Naming == nil {
+		spec.Naming = &arrv1alpha1.SonarrNamingSpec{}
+	}
+	defaultNaming(&spec.Naming.NamingSpec)
+	DefaultDownloadClients(spec.DownloadClients)
+}
+
+// DefaultLidarrSpec fills in the quality preset, naming preset and download client types
+func DefaultLidarrSpec(spec *arrv1alpha1.LidarrConfigSpec) {
+	if spec.Quality == nil {
+		spec.Quality = &arrv1alpha1.AudioQualitySpec{}
+	}
+	if spec.Quality.Preset == "" && spec.Quality.TemplateRef == nil {
+		spec.Quality.Preset = presets.DefaultAudioPreset
+	}
+	if spec.Naming == nil {
+		spec.Naming = &arrv1alpha1.LidarrNamingSpec{}
+	}
+	defaultNaming(&spec.Naming.NamingSpec)
+	DefaultDownloadClients(spec.DownloadClients)
+}
+
+// DefaultReadarrSpec fills in the download client types. Readarr quality and
+// naming are only managed when the spec sets them, so they have no default.
+func DefaultReadarrSpec(spec *arrv1alpha1.ReadarrConfigSpec) {
+	if spec.Naming != nil {
+		defaultNaming(&spec.Naming.NamingSpec)
+	}
+	DefaultDownloadClients(spec.DownloadClients)
+}
+
+// DefaultProwlarrSpec fills in the download client types
+func DefaultProwlarrSpec(spec *arrv1alpha1.ProwlarrConfigSpec) {
+	DefaultDownloadClients(spec.DownloadClients)
+}
+
+// DefaultDownloadClients sets the type inferred from the name on clients
+// without one. Clients with downloadClientRef take the type of the referenced
+// client instead.
+func DefaultDownloadClients(clients []arrv1alpha1.DownloadClientSpec) {
+	for i := range clients {
+		if clients[i].Type == "" && clients[i].DownloadClientRef == nil {
+			clients[i].Type = inferImplementationFromName(clients[i].Name)
+		}
+	}
+}
+
+// defaultVideoQuality returns quality with the default preset set. A template
+// or manual tiers stand in for the preset, so those are left alone.
+func defaultVideoQuality(quality *arrv1alpha1.VideoQualitySpec) *arrv1alpha1.VideoQualitySpec {
+	if quality == nil {
+		quality = &arrv1alpha1.VideoQualitySpec{}
+	}
+	if quality.Preset == "" && quality.TemplateRef == nil && len(quality.Tiers) == 0 {
+		quality.Preset = presets.DefaultVideoPreset
+	}
+	return quality
+}
+
+// defaultQualityProfiles sets the default preset on named quality profiles without one
+func defaultQualityProfiles(profiles []arrv1alpha1.NamedVideoQualitySpec) {
+	for i := range profiles {
+		if profiles[i].Preset == "" {
+			profiles[i].Preset = presets.DefaultVideoPreset
+		}
+	}
+}
+
+// defaultNaming sets the default naming preset
+func defaultNaming(naming *arrv1alpha1.NamingSpec) {
+	if naming.Preset == "" {
+		naming.Preset = presets.DefaultNamingPreset
+	}
+}
@@ -0,0 +1,89 @@
+package compiler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/presets"
+)
+
+func TestDefaultRadarrSpec(t *testing.T) {
+	config := &arrv1alpha1.RadarrConfig{}
+	config.Name = "movies"
+	config.Spec.QualityProfiles = []arrv1alpha1.NamedVideoQualitySpec{{Name: "kids"}, {Name: "uhd", Preset: "4k-hdr"}}
+	config.Spec.DownloadClients = []arrv1alpha1.DownloadClientSpec{
+		{Name: "sabnzbd", URL: "http://sabnzbd:8080"},
+		{Name: "torrents", URL: "http://transmission:9091", Type: "transmission"},
+		{Name: "stack", DownloadClientRef: &arrv1alpha1.DownloadClientRef{Name: "downloads", Type: "deluge"}},
+	}
+
+	defaulted := config.DeepCopy()
+	DefaultRadarrSpec(&defaulted.Spec)
+
+	spec := defaulted.Spec
+	if spec.Quality == nil || spec.Quality.Preset != presets.DefaultVideoPreset {
+		t.Errorf("expected the default quality preset, got %+v", spec.Quality)
+	}
+	if spec.QualityProfiles[0].Preset != presets.DefaultVideoPreset || spec.QualityProfiles[1].Preset != "4k-hdr" {
+		t.Errorf("expected only the profile without a preset defaulted, got %+v", spec.QualityProfiles)
+	}
+	if spec.Naming == nil || spec.Naming.Preset != presets.DefaultNamingPreset {
+		t.Errorf("expected the default naming preset, got %+v", spec.Naming)
+	}
+	if spec.DownloadClients[0].Type != "sabnzbd" || spec.DownloadClients[1].Type != "transmission" || spec.DownloadClients[2].Type != "" {
+		t.Errorf("expected only the type of the client without one or a ref inferred, got %+v", spec.DownloadClients)
+	}
+
+	// The defaults are the ones the compiler applies anyway
+	c := New()
+	before, err := c.CompileRadarrConfig(context.Background(), config, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	after, err := c.CompileRadarrConfig(context.Background(), defaulted, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(before.Quality, after.Quality) || !reflect.DeepEqual(before.Naming, after.Naming) ||
+		!reflect.DeepEqual(before.DownloadClients, after.DownloadClients) {
+		t.Error("expected defaulting not to change the compiled IR")
+	}
+
+	// Defaulting is idempotent
+	again := defaulted.DeepCopy()
+	DefaultRadarrSpec(&again.Spec)
+	if !reflect.DeepEqual(again.Spec, defaulted.Spec) {
+		t.Error("expected defaulting a defaulted spec to change nothing")
+	}
+}
+
+func TestDefaultVideoQualityKeepsTemplates(t *testing.T) {
+	spec := arrv1alpha1.SonarrConfigSpec{Quality: &arrv1alpha1.VideoQualitySpec{TemplateRef: &arrv1alpha1.LocalObjectReference{Name: "custom"}}}
+	DefaultSonarrSpec(&spec)
+	if spec.Quality.Preset != "" {
+		t.Errorf("expected no preset next to a template, got %q", spec.Quality.Preset)
+	}
+	if spec.Naming == nil || spec.Naming.Preset != presets.DefaultNamingPreset {
+		t.Errorf("expected the default naming preset, got %+v", spec.Naming)
+	}
+}
+
+func TestDefaultLidarrAndReadarrSpec(t *testing.T) {
+	lidarr := arrv1alpha1.LidarrConfigSpec{}
+	DefaultLidarrSpec(&lidarr)
+	if lidarr.Quality == nil || lidarr.Quality.Preset != presets.DefaultAudioPreset {
+		t.Errorf("expected the default audio preset, got %+v", lidarr.Quality)
+	}
+	if lidarr.Naming == nil || lidarr.Naming.Preset != presets.DefaultNamingPreset {
+		t.Errorf("expected the default naming preset, got %+v", lidarr.Naming)
+	}
+
+	// Readarr naming and quality stay unmanaged unless set
+	readarr := arrv1alpha1.ReadarrConfigSpec{}
+	DefaultReadarrSpec(&readarr)
+	if readarr.Quality != nil || readarr.Naming != nil {
+		t.Errorf("expected Readarr quality and naming left unset, got %+v %+v", readarr.Quality, readarr.Naming)
+	}
+}
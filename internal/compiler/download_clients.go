@@ -0,0 +1,162 @@
+package compiler
+
+import (
+	"fmt"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// ReferencedDownloadStacks returns the distinct DownloadStackConfig names
+// referenced through downloadClientRef
+func ReferencedDownloadStacks(clients []arrv1alpha1.DownloadClientSpec) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, dc := range clients {
+		if dc.DownloadClientRef != nil && !seen[dc.DownloadClientRef.Name] {
+			seen[dc.DownloadClientRef.Name] = true
+			names = append(names, dc.DownloadClientRef.Name)
+		}
+	}
+	return names
+}
+
+// ResolveDownloadClientRefs returns clients with the entries that set
+// downloadClientRef filled in from the referenced DownloadStackConfig client:
+// its URL and type, its credential Secrets and, when the entry sets no
+// category, the only category (or Deluge label) the client declares. Values set
+// on the entry take precedence. The entry is also pointed at the stack for the
+// category check (downloadStackRef) unless it names one itself.
+// stacks holds the referenced DownloadStackConfigs by name; entries referencing
+// a missing one, or a client it doesn't configure, are rejected.
+func ResolveDownloadClientRefs(clients []arrv1alpha1.DownloadClientSpec, stacks map[string]*arrv1alpha1.DownloadStackConfig) ([]arrv1alpha1.DownloadClientSpec, error) {
+	var invalid FieldErrors
+	result := make([]arrv1alpha1.DownloadClientSpec, 0, len(clients))
+
+	for i, dc := range clients {
+		path := fmt.Sprintf("spec.downloadClients[%d]", i)
+		ref := dc.DownloadClientRef
+		if ref == nil {
+			if dc.URL == "" {
+				invalid.add(path+".url", "", "required unless downloadClientRef is set")
+			}
+			result = append(result, dc)
+			continue
+		}
+
+		refPath := path + ".downloadClientRef"
+		stack, ok := stacks[ref.Name]
+		if !ok || stack == nil {
+			invalid.add(refPath+".name", ref.Name, "DownloadStackConfig not found")
+			continue
+		}
+		client, ok := stackClientFor(&stack.Spec, ref.Type, ref.Instance)
+		if !ok {
+			reason := fmt.Sprintf("DownloadStackConfig %s does not configure %s", stack.Name, ref.Type)
+			if ref.Instance != "" {
+				reason = fmt.Sprintf("DownloadStackConfig %s does not configure %s instance %q", stack.Name, ref.Type, ref.Instance)
+			}
+			invalid.add(refPath, ref.Name, reason)
+			continue
+		}
+
+		derived := *dc.DeepCopy()
+		if derived.URL == "" {
+			derived.URL = client.url
+		}
+		if derived.URL == "" {
+			invalid.add(refPath, ref.Name, fmt.Sprintf("%s of DownloadStackConfig %s has no URL the app can use", ref.Type, stack.Name))
+			continue
+		}
+		if derived.Type == "" {
+			derived.Type = ref.Type
+		}
+		if derived.CredentialsSecretRef == nil && client.credentials != nil {
+			derived.CredentialsSecretRef = client.credentials.DeepCopy()
+		}
+		if derived.APIKeySecretRef == nil && client.apiKey != nil {
+			derived.APIKeySecretRef = client.apiKey.DeepCopy()
+		}
+		if derived.PasswordSecretRef == nil && client.password != nil {
+			derived.PasswordSecretRef = client.password.DeepCopy()
+		}
+		if derived.Category == "" && len(client.categories) == 1 {
+			derived.Category = client.categories[0]
+		}
+		if derived.DownloadStackRef == nil {
+			derived.DownloadStackRef = &arrv1alpha1.LocalObjectReference{Name: ref.Name}
+			derived.DownloadStackInstance = ref.Instance
+		}
+		result = append(result, derived)
+	}
+
+	if err := invalid.err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// stackClient is what a download client entry can take from a DownloadStackConfig client
+type stackClient struct {
+	url         string
+	credentials *arrv1alpha1.CredentialsSecretRef
+	apiKey      *arrv1alpha1.SecretKeySelector
+	password    *arrv1alpha1.SecretKeySelector
+	categories  []string
+}
+
+// stackClientFor returns the client of spec with the given type and instance
+// name (empty = the unnamed client)
+func stackClientFor(spec *arrv1alpha1.DownloadStackConfigSpec, clientType, instance string) (stackClient, bool) {
+	switch clientType {
+	case "qbittorrent":
+		qb := spec.QBittorrentInstance(instance)
+		if qb == nil {
+			return stackClient{}, false
+		}
+		client := stackClient{url: qb.Connection.URL, credentials: qb.Connection.CredentialsSecretRef}
+		for _, c := range qb.Categories {
+			client.categories = append(client.categories, c.Name)
+		}
+		return client, true
+	case "transmission":
+		tr := spec.TransmissionInstance(instance)
+		if tr == nil {
+			return stackClient{}, false
+		}
+		return stackClient{url: tr.Connection.URL, credentials: tr.Connection.CredentialsSecretRef}, true
+	case "deluge":
+		deluge := spec.DelugeInstance(instance)
+		if deluge == nil {
+			return stackClient{}, false
+		}
+		return stackClient{url: deluge.Connection.URL, password: deluge.Connection.PasswordSecretRef, categories: deluge.Labels}, true
+	case "rtorrent":
+		rt := spec.RTorrentInstance(instance)
+		if rt == nil {
+			return stackClient{}, false
+		}
+		return stackClient{url: rt.Connection.URL, credentials: rt.Connection.CredentialsSecretRef}, true
+	case "sabnzbd":
+		sab := spec.SABnzbdInstance(instance)
+		if sab == nil {
+			return stackClient{}, false
+		}
+		client := stackClient{url: sab.Connection.URL, apiKey: &sab.Connection.APIKeySecretRef}
+		for _, c := range sab.Categories {
+			client.categories = append(client.categories, c.Name)
+		}
+		return client, true
+	case "nzbget":
+		nzbget := spec.NZBGetInstance(instance)
+		if nzbget == nil {
+			return stackClient{}, false
+		}
+		client := stackClient{url: nzbget.Connection.URL, credentials: nzbget.Connection.CredentialsSecretRef}
+		for _, c := range nzbget.Categories {
+			client.categories = append(client.categories, c.Name)
+		}
+		return client, true
+	default:
+		return stackClient{}, false
+	}
+}
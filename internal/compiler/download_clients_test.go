@@ -0,0 +1,127 @@
+package compiler
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+func TestResolveDownloadClientRefs(t *testing.T) {
+	creds := &arrv1alpha1.CredentialsSecretRef{Name: "qbittorrent-creds"}
+	stack := &arrv1alpha1.DownloadStackConfig{}
+	stack.Name = "downloads"
+	stack.Spec.QBittorrent = &arrv1alpha1.QBittorrentSpec{
+		Connection: arrv1alpha1.QBittorrentConnectionSpec{URL: "http://qbittorrent:8080", CredentialsSecretRef: creds},
+		Categories: []arrv1alpha1.QBittorrentCategorySpec{{Name: "movies"}},
+	}
+	stack.Spec.QBittorrentInstances = []arrv1alpha1.QBittorrentInstanceSpec{{
+		Name: "private",
+		QBittorrentSpec: arrv1alpha1.QBittorrentSpec{
+			Connection: arrv1alpha1.QBittorrentConnectionSpec{URL: "http://qbittorrent-private:8080"},
+			Categories: []arrv1alpha1.QBittorrentCategorySpec{{Name: "movies"}, {Name: "tv"}},
+		},
+	}}
+	stacks := map[string]*arrv1alpha1.DownloadStackConfig{"downloads": stack}
+
+	clients := []arrv1alpha1.DownloadClientSpec{
+		{Name: "sab", URL: "http://sabnzbd:8080"},
+		{Name: "qbittorrent", DownloadClientRef: &arrv1alpha1.DownloadClientRef{Name: "downloads", Type: "qbittorrent"}},
+		{Name: "private", Category: "radarr", DownloadClientRef: &arrv1alpha1.DownloadClientRef{Name: "downloads", Type: "qbittorrent", Instance: "private"}},
+	}
+	got, err := ResolveDownloadClientRefs(clients, stacks)
+	if err != nil {
+		t.Fatalf("ResolveDownloadClientRefs() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got[0], clients[0]) {
+		t.Errorf("client without a ref changed: %+v", got[0])
+	}
+	want := arrv1alpha1.DownloadClientSpec{
+		Name:                 "qbittorrent",
+		URL:                  "http://qbittorrent:8080",
+		Type:                 "qbittorrent",
+		CredentialsSecretRef: creds,
+		Category:             "movies",
+		DownloadClientRef:    clients[1].DownloadClientRef,
+		DownloadStackRef:     &arrv1alpha1.LocalObjectReference{Name: "downloads"},
+	}
+	if !reflect.DeepEqual(got[1], want) {
+		t.Errorf("derived client = %+v, want %+v", got[1], want)
+	}
+	// The entry's own category wins, and several declared categories pick none
+	if got[2].URL != "http://qbittorrent-private:8080" || got[2].Category != "radarr" || got[2].DownloadStackInstance != "private" {
+		t.Errorf("derived instance client = %+v", got[2])
+	}
+	if clients[1].URL != "" {
+		t.Errorf("the spec entry was modified")
+	}
+}
+
+func TestResolveDownloadClientRefsInvalid(t *testing.T) {
+	stack := &arrv1alpha1.DownloadStackConfig{}
+	stack.Name = "downloads"
+	stacks := map[string]*arrv1alpha1.DownloadStackConfig{"downloads": stack}
+
+	clients := []arrv1alpha1.DownloadClientSpec{
+		{Name: "no-url"},
+		{Name: "missing", DownloadClientRef: &arrv1alpha1.DownloadClientRef{Name: "other", Type: "qbittorrent"}},
+		{Name: "unconfigured", DownloadClientRef: &arrv1alpha1.DownloadClientRef{Name: "downloads", Type: "nzbget"}},
+	}
+	_, err := ResolveDownloadClientRefs(clients, stacks)
+
+	var fieldErrs FieldErrors
+	if !errors.As(err, &fieldErrs) {
+		t.Fatalf("ResolveDownloadClientRefs() error = %v, want FieldErrors", err)
+	}
+	var paths []string
+	for _, fe := range fieldErrs {
+		paths = append(paths, fe.Path)
+	}
+	want := []string{
+		"spec.downloadClients[0].url",
+		"spec.downloadClients[1].downloadClientRef.name",
+		"spec.downloadClients[2].downloadClientRef",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("invalid paths = %v, want %v", paths, want)
+	}
+}
+
+func TestDownloadClientCredentials(t *testing.T) {
+	stack := &arrv1alpha1.DownloadStackConfig{}
+	stack.Name = "downloads"
+	stack.Spec.SABnzbd = &arrv1alpha1.SABnzbdSpec{Connection: arrv1alpha1.SABnzbdConnectionSpec{
+		URL:             "http://sabnzbd:8080",
+		APIKeySecretRef: arrv1alpha1.SecretKeySelector{Name: "sabnzbd", Key: "apiKey"},
+	}}
+	stack.Spec.Deluge = &arrv1alpha1.DelugeSpec{Connection: arrv1alpha1.DelugeConnectionSpec{
+		URL:               "http://deluge:8112",
+		PasswordSecretRef: &arrv1alpha1.SecretKeySelector{Name: "deluge"},
+	}}
+	stacks := map[string]*arrv1alpha1.DownloadStackConfig{"downloads": stack}
+
+	clients, err := ResolveDownloadClientRefs([]arrv1alpha1.DownloadClientSpec{
+		{Name: "sab", DownloadClientRef: &arrv1alpha1.DownloadClientRef{Name: "downloads", Type: "sabnzbd"}},
+		{Name: "deluge", DownloadClientRef: &arrv1alpha1.DownloadClientRef{Name: "downloads", Type: "deluge"}},
+	}, stacks)
+	if err != nil {
+		t.Fatalf("ResolveDownloadClientRefs() error = %v", err)
+	}
+	if clients[0].APIKeySecretRef == nil || clients[0].APIKeySecretRef.Name != "sabnzbd" {
+		t.Errorf("SABnzbd API key ref = %+v", clients[0].APIKeySecretRef)
+	}
+	if clients[1].PasswordSecretRef == nil || clients[1].PasswordSecretRef.Name != "deluge" {
+		t.Errorf("Deluge password ref = %+v", clients[1].PasswordSecretRef)
+	}
+
+	resolved := map[string]string{"sabnzbd/apiKey": "sab-key", "deluge/password": "deluge-pass"}
+	inputs := convertDownloadClients(clients, resolved)
+	if inputs[0].APIKey != "sab-key" || inputs[0].Password != "" {
+		t.Errorf("SABnzbd credentials = %+v", inputs[0])
+	}
+	if inputs[1].Password != "deluge-pass" || inputs[1].Username != "" {
+		t.Errorf("Deluge credentials = %+v", inputs[1])
+	}
+}
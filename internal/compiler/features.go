@@ -2,7 +2,11 @@ package compiler
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
@@ -29,14 +33,36 @@ func (c *Compiler) compileImportListsToIR(lists []ImportListInput) []irv1.Import
 			SeriesType:    list.SeriesType,
 			SeasonFolder:  list.SeasonFolder,
 			ShouldMonitor: list.ShouldMonitor,
+			Tags:          list.Tags,
 			// Type-specific settings
-			Settings: list.Settings,
+			Settings:      list.Settings,
+			PlexWatchlist: list.PlexWatchlist,
+			PlexRSS:       list.PlexRSS,
+			TraktList:     list.TraktList,
+			Custom:        list.Custom,
+			// Readarr typed list settings
+			GoodreadsList:       list.GoodreadsList,
+			GoodreadsOwnedBooks: list.GoodreadsOwnedBooks,
+			LazyLibrarian:       list.LazyLibrarian,
 		}
 		result = append(result, ir)
 	}
 	return result
 }
 
+// compileImportListOptionsToIR converts import list options input to IR
+func (c *Compiler) compileImportListOptionsToIR(input *ImportListOptionsInput) *irv1.ImportListOptionsIR {
+	if input == nil {
+		return nil
+	}
+
+	return &irv1.ImportListOptionsIR{
+		CleanLibraryLevel: input.CleanLibraryLevel,
+		CleanLibraryTag:   input.CleanLibraryTag,
+		SyncIntervalHours: input.SyncIntervalHours,
+	}
+}
+
 // compileMediaManagementToIR converts media management input to IR
 func (c *Compiler) compileMediaManagementToIR(input *MediaManagementInput) *irv1.MediaManagementIR {
 	if input == nil {
@@ -57,6 +83,22 @@ func (c *Compiler) compileMediaManagementToIR(input *MediaManagementInput) *irv1
 	}
 }
 
+// compileCollectionsToIR converts collection input to IR
+func (c *Compiler) compileCollectionsToIR(input *CollectionsInput) *irv1.CollectionsIR {
+	if input == nil {
+		return nil
+	}
+
+	return &irv1.CollectionsIR{
+		Titles:              input.Titles,
+		Monitored:           input.Monitored,
+		SearchOnAdd:         input.SearchOnAdd,
+		MinimumAvailability: input.MinimumAvailability,
+		QualityProfileName:  input.QualityProfile,
+		RootFolderPath:      input.RootFolder,
+	}
+}
+
 // compileAuthenticationToIR converts authentication input to IR
 func (c *Compiler) compileAuthenticationToIR(input *AuthenticationInput) *irv1.AuthenticationIR {
 	if input == nil {
@@ -71,6 +113,26 @@ func (c *Compiler) compileAuthenticationToIR(input *AuthenticationInput) *irv1.A
 	}
 }
 
+// compileUIToIR converts UI settings input to IR
+func (c *Compiler) compileUIToIR(input *UIInput) *irv1.UIConfigIR {
+	if input == nil {
+		return nil
+	}
+
+	return &irv1.UIConfigIR{
+		FirstDayOfWeek:     input.FirstDayOfWeek,
+		TimeFormat:         input.TimeFormat,
+		Theme:              input.Theme,
+		UILanguage:         input.UILanguage,
+		MovieRuntimeFormat: input.MovieRuntimeFormat,
+	}
+}
+
+// NotificationName returns the generated name of a notification in the app
+func NotificationName(configName, name string) string {
+	return fmt.Sprintf("nebularr-%s-%s", configName, name)
+}
+
 // compileNotificationsToIR converts notification inputs to IR
 func (c *Compiler) compileNotificationsToIR(notifications []NotificationInput, configName string) []irv1.NotificationIR {
 	if len(notifications) == 0 {
@@ -80,7 +142,7 @@ func (c *Compiler) compileNotificationsToIR(notifications []NotificationInput, c
 	result := make([]irv1.NotificationIR, 0, len(notifications))
 	for _, n := range notifications {
 		ir := irv1.NotificationIR{
-			Name:           fmt.Sprintf("nebularr-%s-%s", configName, n.Name),
+			Name:           NotificationName(configName, n.Name),
 			Implementation: n.Implementation,
 			ConfigContract: n.Implementation + "Settings",
 			Enabled:        true,
@@ -154,6 +216,40 @@ func (c *Compiler) compileCustomFormatsToIR(formats []CustomFormatInput, configN
 	return result
 }
 
+// compileAutoTagsToIR converts auto-tagging rule inputs to IR, naming each rule
+// after the config's ownership tag
+func (c *Compiler) compileAutoTagsToIR(autoTags []AutoTagInput, namespace, configName string) []irv1.AutoTagIR {
+	if len(autoTags) == 0 {
+		return nil
+	}
+
+	result := make([]irv1.AutoTagIR, 0, len(autoTags))
+	for _, at := range autoTags {
+		ir := irv1.AutoTagIR{
+			Name:                    shared.AutoTagName(shared.ConfigOwnershipTag(namespace, configName), at.Name),
+			RemoveTagsAutomatically: at.RemoveTagsAutomatically,
+			TagNames:                at.Tags,
+			Specifications:          make([]irv1.AutoTagSpecIR, 0, len(at.Specifications)),
+		}
+
+		for _, spec := range at.Specifications {
+			ir.Specifications = append(ir.Specifications, irv1.AutoTagSpecIR{
+				Type:     spec.Type,
+				Name:     spec.Name,
+				Negate:   spec.Negate,
+				Required: spec.Required,
+				Value:    spec.Value,
+				Values:   spec.Values,
+				Min:      spec.Min,
+				Max:      spec.Max,
+			})
+		}
+
+		result = append(result, ir)
+	}
+	return result
+}
+
 // compileFormatScores extracts format scores from custom format inputs
 // This maps custom format names to their scores for use in quality profiles
 func (c *Compiler) compileFormatScores(formats []CustomFormatInput, configName string) map[string]int {
@@ -177,10 +273,12 @@ func (c *Compiler) compileFormatScores(formats []CustomFormatInput, configName s
 	return scores
 }
 
-// compileDelayProfilesToIR converts delay profile inputs to IR
-func (c *Compiler) compileDelayProfilesToIR(profiles []DelayProfileInput) []irv1.DelayProfileIR {
+// compileDelayProfilesToIR converts delay profile inputs to IR, sorted by order.
+// The apps allow one profile per tag set, so later profiles repeating a tag set are
+// reported as unrealized. An untagged profile configures the default profile.
+func (c *Compiler) compileDelayProfilesToIR(profiles []DelayProfileInput) ([]irv1.DelayProfileIR, []irv1.UnrealizedFeature) {
 	if len(profiles) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	result := make([]irv1.DelayProfileIR, 0, len(profiles))
@@ -212,6 +310,105 @@ func (c *Compiler) compileDelayProfilesToIR(profiles []DelayProfileInput) []irv1
 		}
 		result = append(result, ir)
 	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Order < result[j].Order })
 
-	return result
+	var unrealized []irv1.UnrealizedFeature
+	seen := make(map[string]bool, len(result))
+	deduped := result[:0]
+	for _, ir := range result {
+		key := shared.DelayProfileKey(ir.TagNames)
+		if seen[key] {
+			tags := key
+			if tags == "" {
+				tags = "(none)"
+			}
+			unrealized = append(unrealized, irv1.UnrealizedFeature{
+				Feature: fmt.Sprintf("delayProfile:%s", ir.Name),
+				Reason:  fmt.Sprintf("another delay profile already uses tags %s", tags),
+			})
+			continue
+		}
+		seen[key] = true
+		deduped = append(deduped, ir)
+	}
+
+	return deduped, unrealized
+}
+
+// compileReleaseProfilesToIR converts release profile inputs to IR, keeping declared order.
+// The apps reject profiles without any required, ignored or preferred term, so those
+// are reported as unrealized instead of failing the apply.
+func (c *Compiler) compileReleaseProfilesToIR(profiles []ReleaseProfileInput) ([]irv1.ReleaseProfileIR, []irv1.UnrealizedFeature) {
+	if len(profiles) == 0 {
+		return nil, nil
+	}
+
+	var unrealized []irv1.UnrealizedFeature
+	result := make([]irv1.ReleaseProfileIR, 0, len(profiles))
+	for _, p := range profiles {
+		if len(p.Required) == 0 && len(p.Ignored) == 0 && len(p.Preferred) == 0 {
+			unrealized = append(unrealized, irv1.UnrealizedFeature{
+				Feature: fmt.Sprintf("releaseProfile:%s", p.Name),
+				Reason:  "release profile has no required, ignored or preferred terms",
+			})
+			continue
+		}
+
+		ir := irv1.ReleaseProfileIR{
+			Name:                         p.Name,
+			Enabled:                      p.Enabled,
+			Required:                     p.Required,
+			Ignored:                      p.Ignored,
+			IncludePreferredWhenRenaming: p.IncludePreferredWhenRenaming,
+			IndexerID:                    p.IndexerID,
+			TagNames:                     p.Tags,
+		}
+		for _, term := range p.Preferred {
+			ir.Preferred = append(ir.Preferred, irv1.PreferredTermIR{Term: term.Term, Score: term.Score})
+		}
+		result = append(result, ir)
+	}
+
+	return result, unrealized
+}
+
+// compileQualityDefinitionsToIR converts quality definition inputs to IR.
+// Size values that cannot be parsed are left unchanged and reported as unrealized.
+func (c *Compiler) compileQualityDefinitionsToIR(defs []QualityDefinitionInput) ([]irv1.QualityDefinitionIR, []irv1.UnrealizedFeature) {
+	if len(defs) == 0 {
+		return nil, nil
+	}
+
+	var unrealized []irv1.UnrealizedFeature
+	parse := func(quality, field, value string, allowUnlimited bool) *float64 {
+		if value == "" {
+			return nil
+		}
+		if allowUnlimited && strings.EqualFold(value, "unlimited") {
+			v := irv1.SizeLimitUnlimited
+			return &v
+		}
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || v < 0 {
+			unrealized = append(unrealized, irv1.UnrealizedFeature{
+				Feature: fmt.Sprintf("qualityDefinition:%s:%s", quality, field),
+				Reason:  fmt.Sprintf("invalid size %q", value),
+			})
+			return nil
+		}
+		return &v
+	}
+
+	result := make([]irv1.QualityDefinitionIR, 0, len(defs))
+	for _, d := range defs {
+		result = append(result, irv1.QualityDefinitionIR{
+			Quality:       d.Quality,
+			Title:         d.Title,
+			MinSize:       parse(d.Quality, "minSize", d.MinSize, false),
+			MaxSize:       parse(d.Quality, "maxSize", d.MaxSize, true),
+			PreferredSize: parse(d.Quality, "preferredSize", d.PreferredSize, true),
+		})
+	}
+
+	return result, unrealized
 }
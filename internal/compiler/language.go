@@ -0,0 +1,152 @@
+package compiler
+
+import (
+	"strconv"
+	"strings"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+const (
+	// defaultLanguageScore is the score of the language custom formats
+	defaultLanguageScore = 100
+
+	// languageOriginal and languageAny are the pseudo languages shared by Radarr and Sonarr
+	languageOriginal = irv1.LanguageOriginal
+	languageAny      = -1
+)
+
+// radarrLanguages are the languages known to Radarr, by ID
+var radarrLanguages = map[int]string{
+	languageOriginal: "Original", languageAny: "Any",
+	1: "English", 2: "French", 3: "Spanish", 4: "German", 5: "Italian", 6: "Danish",
+	7: "Dutch", 8: "Japanese", 9: "Icelandic", 10: "Chinese", 11: "Russian", 12: "Polish",
+	13: "Vietnamese", 14: "Swedish", 15: "Norwegian", 16: "Finnish", 17: "Turkish",
+	18: "Portuguese", 19: "Flemish", 20: "Greek", 21: "Korean", 22: "Hungarian",
+	23: "Hebrew", 24: "Lithuanian", 25: "Czech", 26: "Hindi", 27: "Romanian", 28: "Thai",
+	29: "Bulgarian", 30: "Portuguese (Brazil)", 31: "Arabic", 32: "Ukrainian", 33: "Persian",
+	34: "Bengali", 35: "Slovak", 36: "Latvian", 37: "Spanish (Latino)", 38: "Catalan",
+	39: "Croatian", 40: "Serbian", 41: "Bosnian", 42: "Estonian", 43: "Tamil",
+	44: "Indonesian", 45: "Telugu", 46: "Macedonian", 47: "Slovenian",
+}
+
+// sonarrLanguages are the languages known to Sonarr v4, by ID. Past Czech the
+// IDs differ from Radarr's.
+var sonarrLanguages = map[int]string{
+	languageOriginal: "Original", languageAny: "Any",
+	1: "English", 2: "French", 3: "Spanish", 4: "German", 5: "Italian", 6: "Danish",
+	7: "Dutch", 8: "Japanese", 9: "Icelandic", 10: "Chinese", 11: "Russian", 12: "Polish",
+	13: "Vietnamese", 14: "Swedish", 15: "Norwegian", 16: "Finnish", 17: "Turkish",
+	18: "Portuguese", 19: "Flemish", 20: "Greek", 21: "Korean", 22: "Hungarian",
+	23: "Hebrew", 24: "Lithuanian", 25: "Czech", 26: "Arabic", 27: "Hindi", 28: "Bulgarian",
+	29: "Malayalam", 30: "Ukrainian", 31: "Slovak", 32: "Thai", 33: "Portuguese (Brazil)",
+	34: "Spanish (Latino)", 35: "Romanian", 36: "Latvian", 37: "Persian", 38: "Catalan",
+	39: "Croatian", 40: "Serbian", 41: "Bosnian", 42: "Estonian", 43: "Tamil",
+	44: "Indonesian", 45: "Macedonian", 46: "Slovenian",
+}
+
+// lookupLanguage resolves a language name (case and punctuation insensitive,
+// e.g. "portuguese-brazil") to the app's language
+func lookupLanguage(app, name string) (irv1.LanguageIR, bool) {
+	languages := appLanguages(app)
+	key := normalizeLanguageName(name)
+	for id, lang := range languages {
+		if normalizeLanguageName(lang) == key {
+			return irv1.LanguageIR{ID: id, Name: lang}, true
+		}
+	}
+	return irv1.LanguageIR{}, false
+}
+
+// appLanguages returns the languages known to app, by ID
+func appLanguages(app string) map[int]string {
+	if app == adapters.AppSonarr {
+		return sonarrLanguages
+	}
+	return radarrLanguages
+}
+
+// customFormatLanguageID resolves the value of a LanguageSpecification, a
+// language ID or name, to the app's language ID
+func customFormatLanguageID(app, value string) (int, bool) {
+	if id, err := strconv.Atoi(value); err == nil {
+		_, ok := appLanguages(app)[id]
+		return id, ok
+	}
+	lang, ok := lookupLanguage(app, value)
+	return lang.ID, ok
+}
+
+// resolveCustomFormatLanguages replaces language names in the LanguageSpecifications
+// of formats with the app's language IDs, which is what the apps expect
+func resolveCustomFormatLanguages(app string, formats []CustomFormatInput) {
+	for i := range formats {
+		for j := range formats[i].Specifications {
+			spec := &formats[i].Specifications[j]
+			if spec.Type != "LanguageSpecification" {
+				continue
+			}
+			if id, ok := customFormatLanguageID(app, spec.Value); ok {
+				spec.Value = strconv.Itoa(id)
+			}
+		}
+	}
+}
+
+// normalizeLanguageName keeps only the lowercased letters of a language name
+func normalizeLanguageName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r >= 'a' && r <= 'z' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// convertLanguage returns the profile language (Radarr) and the custom formats
+// that express the language preferences. Sonarr v4 has no profile language, so
+// its profile language becomes a custom format as well.
+func convertLanguage(app string, spec *arrv1alpha1.LanguageSpec) (*irv1.LanguageIR, []CustomFormatInput) {
+	if spec == nil {
+		return nil, nil
+	}
+	score := defaultLanguageScore
+	if spec.Score != nil {
+		score = *spec.Score
+	}
+	languageFormat := func(name string, id int) CustomFormatInput {
+		return CustomFormatInput{
+			Name:  name,
+			Score: score,
+			Specifications: []CustomFormatSpecInput{{
+				Name:  "Language",
+				Type:  "LanguageSpecification",
+				Value: strconv.Itoa(id),
+			}},
+		}
+	}
+
+	var profile *irv1.LanguageIR
+	var formats []CustomFormatInput
+	preferOriginal := spec.PreferOriginal
+	if spec.Profile != "" {
+		if lang, ok := lookupLanguage(app, spec.Profile); ok {
+			switch {
+			case app == adapters.AppRadarr:
+				profile = &lang
+			case lang.ID == languageOriginal:
+				// Covered by the original language format below
+				preferOriginal = true
+			case lang.ID != languageAny:
+				formats = append(formats, languageFormat("language-"+normalizeLanguageName(lang.Name), lang.ID))
+			}
+		}
+	}
+	if preferOriginal {
+		formats = append(formats, languageFormat("original-language", languageOriginal))
+	}
+	return profile, formats
+}
@@ -16,6 +16,13 @@ import (
 // Note: Prowlarr is different from other *arr apps - it manages indexers
 // natively and syncs them to downstream applications.
 func (c *Compiler) CompileProwlarrConfig(ctx context.Context, config *arrv1alpha1.ProwlarrConfig, resolvedSecrets map[string]string, caps *adapters.Capabilities) (*irv1.IR, error) {
+	// Reject spec values that would otherwise be silently dropped
+	var invalid FieldErrors
+	validateProwlarrApplications(&invalid, config.Spec.Applications)
+	if err := invalid.err(); err != nil {
+		return nil, err
+	}
+
 	// Create base IR with Prowlarr-specific data
 	ir := &irv1.IR{
 		Version:     irv1.IRVersion,
@@ -36,6 +43,9 @@ func (c *Compiler) CompileProwlarrConfig(ctx context.Context, config *arrv1alpha
 	// Compile indexers
 	ir.Prowlarr.Indexers = compileProwlarrIndexers(config.Spec.Indexers, config.Name, resolvedSecrets)
 
+	// Compile app profiles
+	ir.Prowlarr.AppProfiles = compileProwlarrAppProfiles(config.Spec.AppProfiles)
+
 	// Compile proxies
 	ir.Prowlarr.Proxies = compileProwlarrProxies(config.Spec.Proxies, config.Name, resolvedSecrets)
 
@@ -45,6 +55,9 @@ func (c *Compiler) CompileProwlarrConfig(ctx context.Context, config *arrv1alpha
 	// Compile download clients
 	ir.Prowlarr.DownloadClients = compileProwlarrDownloadClients(config.Spec.DownloadClients, config.Name, resolvedSecrets)
 
+	// Fingerprint credentials so rotations are detected
+	hashSecrets(ir, SecretSalt(c.SecretKey, config.UID))
+
 	return ir, nil
 }
 
@@ -70,6 +83,11 @@ func compileProwlarrIndexers(indexers []arrv1alpha1.ProwlarrIndexer, configName
 			Priority:   priority,
 			BaseURL:    idx.BaseURL,
 			Tags:       idx.Tags,
+			AppProfile: idx.AppProfile,
+			QueryLimit: idx.QueryLimit,
+			GrabLimit:  idx.GrabLimit,
+			LimitsUnit: idx.LimitsUnit,
+			Paused:     idx.Pause,
 		}
 
 		// Copy settings
@@ -91,6 +109,15 @@ func compileProwlarrIndexers(indexers []arrv1alpha1.ProwlarrIndexer, configName
 				ir.APIKey = apiKey
 			}
 		}
+		if idx.NextAPIKeySecretRef != nil {
+			keyName := idx.NextAPIKeySecretRef.Key
+			if keyName == "" {
+				keyName = "apiKey"
+			}
+			if apiKey, ok := resolvedSecrets[idx.NextAPIKeySecretRef.Name+"/"+keyName]; ok {
+				ir.NextAPIKey = apiKey
+			}
+		}
 
 		result = append(result, ir)
 	}
@@ -98,6 +125,29 @@ func compileProwlarrIndexers(indexers []arrv1alpha1.ProwlarrIndexer, configName
 	return result
 }
 
+// compileProwlarrAppProfiles converts CRD app profiles to IR, filling in
+// Prowlarr's defaults (everything enabled, one seeder)
+func compileProwlarrAppProfiles(profiles []arrv1alpha1.ProwlarrAppProfile) []irv1.ProwlarrAppProfileIR {
+	result := make([]irv1.ProwlarrAppProfileIR, 0, len(profiles))
+
+	enabled := func(v *bool) bool { return v == nil || *v }
+	for _, profile := range profiles {
+		ir := irv1.ProwlarrAppProfileIR{
+			Name:                    profile.Name,
+			EnableRss:               enabled(profile.EnableRss),
+			EnableAutomaticSearch:   enabled(profile.EnableAutomaticSearch),
+			EnableInteractiveSearch: enabled(profile.EnableInteractiveSearch),
+			MinimumSeeders:          1,
+		}
+		if profile.MinimumSeeders != nil {
+			ir.MinimumSeeders = *profile.MinimumSeeders
+		}
+		result = append(result, ir)
+	}
+
+	return result
+}
+
 // compileProwlarrProxies converts CRD proxies to IR
 func compileProwlarrProxies(proxies []arrv1alpha1.IndexerProxy, configName string, resolvedSecrets map[string]string) []irv1.IndexerProxyIR {
 	result := make([]irv1.IndexerProxyIR, 0, len(proxies))
@@ -110,6 +160,7 @@ func compileProwlarrProxies(proxies []arrv1alpha1.IndexerProxy, configName strin
 			Port:           proxy.Port,
 			RequestTimeout: proxy.RequestTimeout,
 			Tags:           proxy.Tags,
+			Paused:         proxy.Pause,
 		}
 
 		// Set default timeout for FlareSolverr
@@ -154,11 +205,14 @@ func compileProwlarrApplications(apps []arrv1alpha1.ProwlarrApplication, configN
 		}
 
 		ir := irv1.ProwlarrApplicationIR{
-			Name:        fmt.Sprintf("nebularr-%s-%s", configName, app.Name),
-			Type:        app.Type,
-			URL:         app.URL,
-			ProwlarrURL: prowlarrURL, // Apps need this to connect back to Prowlarr
-			SyncLevel:   syncLevel,
+			Name:           fmt.Sprintf("nebularr-%s-%s", configName, app.Name),
+			Type:           app.Type,
+			URL:            app.URL,
+			ProwlarrURL:    prowlarrURL, // Apps need this to connect back to Prowlarr
+			SyncLevel:      syncLevel,
+			Tags:           app.Tags,
+			DownloadClient: app.DownloadClient,
+			Paused:         app.Pause,
 		}
 
 		// Convert sync categories
@@ -197,7 +251,7 @@ func compileProwlarrDownloadClients(clients []arrv1alpha1.DownloadClientSpec, co
 		}
 
 		ir := irv1.DownloadClientIR{
-			Name:           fmt.Sprintf("nebularr-%s-%s", configName, dc.Name),
+			Name:           DownloadClientName(configName, dc.Name),
 			Implementation: strings.ToLower(impl),
 			Protocol:       inferProtocol(impl),
 			Enable:         true,
@@ -206,27 +260,10 @@ func compileProwlarrDownloadClients(clients []arrv1alpha1.DownloadClientSpec, co
 			Port:           port,
 			UseTLS:         useTLS,
 			Category:       dc.Category,
+			Paused:         dc.Pause,
 		}
 
-		// Resolve credentials from secrets
-		if dc.CredentialsSecretRef != nil {
-			usernameKey := dc.CredentialsSecretRef.UsernameKey
-			if usernameKey == "" {
-				usernameKey = "username"
-			}
-			passwordKey := dc.CredentialsSecretRef.PasswordKey
-			if passwordKey == "" {
-				passwordKey = "password"
-			}
-
-			secretPrefix := dc.CredentialsSecretRef.Name + "/"
-			if username, ok := resolvedSecrets[secretPrefix+usernameKey]; ok {
-				ir.Username = username
-			}
-			if password, ok := resolvedSecrets[secretPrefix+passwordKey]; ok {
-				ir.Password = password
-			}
-		}
+		ir.Username, ir.Password, ir.APIKey = downloadClientCredentials(dc, resolvedSecrets)
 
 		result = append(result, ir)
 	}
@@ -2,6 +2,8 @@ package compiler
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
@@ -18,18 +20,22 @@ func (c *Compiler) pruneUnsupported(ir *irv1.IR, caps *adapters.Capabilities) []
 			supportedRes[res] = true
 		}
 
-		prunedTiers := make([]irv1.VideoQualityTierIR, 0)
-		for _, tier := range ir.Quality.Video.Tiers {
-			if supportedRes[tier.Resolution] {
-				prunedTiers = append(prunedTiers, tier)
-			} else {
-				unrealized = append(unrealized, irv1.UnrealizedFeature{
-					Feature: fmt.Sprintf("resolution:%s", tier.Resolution),
-					Reason:  "not supported by service",
-				})
+		reported := make(map[string]bool)
+		for _, profile := range ir.Quality.AllVideoProfiles() {
+			prunedTiers := make([]irv1.VideoQualityTierIR, 0)
+			for _, tier := range profile.Tiers {
+				if supportedRes[tier.Resolution] {
+					prunedTiers = append(prunedTiers, tier)
+				} else if !reported[tier.Resolution] {
+					reported[tier.Resolution] = true
+					unrealized = append(unrealized, irv1.UnrealizedFeature{
+						Feature: fmt.Sprintf("resolution:%s", tier.Resolution),
+						Reason:  "not supported by service",
+					})
+				}
 			}
+			profile.Tiers = prunedTiers
 		}
-		ir.Quality.Video.Tiers = prunedTiers
 	}
 
 	// Check download client types
@@ -76,9 +82,27 @@ func (c *Compiler) pruneUnsupported(ir *irv1.IR, caps *adapters.Capabilities) []
 		ir.Indexers.Direct = prunedIndexers
 	}
 
+	// Radarr v5 dropped the import list sync interval
+	if opts := ir.ImportListOptions; opts != nil && opts.SyncIntervalHours != 0 && ir.App == adapters.AppRadarr {
+		if major, ok := majorVersion(caps.Version); ok && major >= 5 {
+			opts.SyncIntervalHours = 0
+			unrealized = append(unrealized, irv1.UnrealizedFeature{
+				Feature: "importListOptions:syncIntervalHours",
+				Reason:  fmt.Sprintf("not supported by Radarr %s", caps.Version),
+			})
+		}
+	}
+
 	return unrealized
 }
 
+// majorVersion returns the major version of versions like "5.2.6.8376"
+func majorVersion(version string) (int, bool) {
+	major, _, _ := strings.Cut(strings.TrimPrefix(version, "v"), ".")
+	n, err := strconv.Atoi(major)
+	return n, err == nil
+}
+
 // inferProtocol determines the protocol from implementation type
 func inferProtocol(impl string) string {
 	switch impl {
@@ -94,20 +118,5 @@ func inferProtocol(impl string) string {
 
 // normalizeImplementation converts implementation names to their canonical form
 func normalizeImplementation(impl string) string {
-	switch impl {
-	case "qbittorrent":
-		return "QBittorrent"
-	case "transmission":
-		return "Transmission"
-	case "deluge":
-		return "Deluge"
-	case "rtorrent":
-		return "RTorrent"
-	case "sabnzbd":
-		return "Sabnzbd"
-	case "nzbget":
-		return "NzbGet"
-	default:
-		return impl
-	}
+	return adapters.CanonicalImplementation(impl)
 }
@@ -2,6 +2,7 @@ package compiler
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
@@ -14,10 +15,28 @@ import (
 
 // CompileRadarrConfig compiles a RadarrConfig CRD to IR
 func (c *Compiler) CompileRadarrConfig(ctx context.Context, config *arrv1alpha1.RadarrConfig, resolvedSecrets map[string]string, caps *adapters.Capabilities) (*irv1.IR, error) {
+	// Reject spec values that would otherwise be silently replaced or dropped
+	var invalid FieldErrors
+	validateVideoQuality(&invalid, config.Spec.Quality, config.Spec.QualityProfiles)
+	validateIndexers(&invalid, config.Spec.Indexers)
+	if config.Spec.Naming != nil {
+		validateNamingPreset(&invalid, adapters.AppRadarr, config.Spec.Naming.Preset)
+	}
+	validateImportLists(&invalid, adapters.AppRadarr, config.Spec.ImportLists)
+	validateImportListOptions(&invalid, adapters.AppRadarr, config.Spec.ImportListOptions)
+	validateLanguage(&invalid, adapters.AppRadarr, config.Spec.Language)
+	validateCustomFormatLanguages(&invalid, adapters.AppRadarr, config.Spec.CustomFormats)
+	validateUI(&invalid, adapters.AppRadarr, config.Spec.UI)
+	validateMaintenance(&invalid, config.Spec.Maintenance)
+	if err := invalid.err(); err != nil {
+		return nil, err
+	}
+
 	input := CompileInput{
 		App:             adapters.AppRadarr,
 		ConfigName:      config.Name,
 		Namespace:       config.Namespace,
+		SecretSalt:      SecretSalt(c.SecretKey, config.UID),
 		Capabilities:    caps,
 		ResolvedSecrets: resolvedSecrets,
 	}
@@ -32,6 +51,7 @@ func (c *Compiler) CompileRadarrConfig(ctx context.Context, config *arrv1alpha1.
 	// Quality
 	if config.Spec.Quality != nil {
 		input.QualityPreset = config.Spec.Quality.Preset
+		input.QualityCloneFrom = config.Spec.Quality.CloneFrom
 		if len(config.Spec.Quality.Exclude) > 0 || len(config.Spec.Quality.PreferAdditional) > 0 || len(config.Spec.Quality.RejectAdditional) > 0 {
 			input.QualityOverrides = &presets.QualityOverrides{
 				Exclude:          config.Spec.Quality.Exclude,
@@ -40,6 +60,7 @@ func (c *Compiler) CompileRadarrConfig(ctx context.Context, config *arrv1alpha1.
 			}
 		}
 	}
+	input.QualityProfiles = convertQualityProfiles(config.Spec.QualityProfiles)
 
 	// Naming
 	if config.Spec.Naming != nil {
@@ -60,31 +81,68 @@ func (c *Compiler) CompileRadarrConfig(ctx context.Context, config *arrv1alpha1.
 
 	// Import lists
 	input.ImportLists = convertImportLists(config.Spec.ImportLists, resolvedSecrets)
+	input.ImportListOptions = convertImportListOptions(config.Spec.ImportListOptions)
 
 	// Media management
 	input.MediaManagement = convertMediaManagement(config.Spec.MediaManagement)
 
+	// Collections
+	input.Collections = convertCollections(config.Spec.Collections)
+
 	// Authentication
 	input.Authentication = convertAuthentication(config.Spec.Authentication, resolvedSecrets)
 
-	// Notifications
+	// UI settings
+	input.UI = convertUI(adapters.AppRadarr, config.Spec.UI)
+
+	// Notifications (including media server library refresh hooks)
 	input.Notifications = convertNotifications(config.Spec.Notifications, resolvedSecrets)
+	input.Notifications = append(input.Notifications, convertMediaServerHooks(config.Spec.MediaServerHooks, resolvedSecrets)...)
 
-	// Custom formats
+	// Custom formats, including those expressing the language preferences
 	input.CustomFormats = convertCustomFormats(config.Spec.CustomFormats)
+	resolveCustomFormatLanguages(adapters.AppRadarr, input.CustomFormats)
+	profileLanguage, languageFormats := convertLanguage(adapters.AppRadarr, config.Spec.Language)
+	input.ProfileLanguage = profileLanguage
+	input.CustomFormats = append(input.CustomFormats, languageFormats...)
 
 	// Delay profiles
 	input.DelayProfiles = convertDelayProfiles(config.Spec.DelayProfiles)
 
+	// Quality definitions
+	input.QualityDefinitions = convertQualityDefinitions(config.Spec.QualityDefinitions)
+
+	// Auto tags
+	input.AutoTags = convertAutoTags(config.Spec.AutoTags)
+
 	return c.Compile(ctx, input)
 }
 
 // CompileSonarrConfig compiles a SonarrConfig CRD to IR
 func (c *Compiler) CompileSonarrConfig(ctx context.Context, config *arrv1alpha1.SonarrConfig, resolvedSecrets map[string]string, caps *adapters.Capabilities) (*irv1.IR, error) {
+	// Reject spec values that would otherwise be silently replaced or dropped
+	var invalid FieldErrors
+	validateVideoQuality(&invalid, config.Spec.Quality, config.Spec.QualityProfiles)
+	validateIndexers(&invalid, config.Spec.Indexers)
+	if config.Spec.Naming != nil {
+		validateNamingPreset(&invalid, adapters.AppSonarr, config.Spec.Naming.Preset)
+	}
+	importLists := applySeriesDefaults(config.Spec.ImportLists, config.Spec.SeriesDefaults)
+	validateImportLists(&invalid, adapters.AppSonarr, importLists)
+	validateImportListOptions(&invalid, adapters.AppSonarr, config.Spec.ImportListOptions)
+	validateLanguage(&invalid, adapters.AppSonarr, config.Spec.Language)
+	validateCustomFormatLanguages(&invalid, adapters.AppSonarr, config.Spec.CustomFormats)
+	validateUI(&invalid, adapters.AppSonarr, config.Spec.UI)
+	validateMaintenance(&invalid, config.Spec.Maintenance)
+	if err := invalid.err(); err != nil {
+		return nil, err
+	}
+
 	input := CompileInput{
 		App:             adapters.AppSonarr,
 		ConfigName:      config.Name,
 		Namespace:       config.Namespace,
+		SecretSalt:      SecretSalt(c.SecretKey, config.UID),
 		Capabilities:    caps,
 		ResolvedSecrets: resolvedSecrets,
 	}
@@ -98,6 +156,7 @@ func (c *Compiler) CompileSonarrConfig(ctx context.Context, config *arrv1alpha1.
 	// Quality
 	if config.Spec.Quality != nil {
 		input.QualityPreset = config.Spec.Quality.Preset
+		input.QualityCloneFrom = config.Spec.Quality.CloneFrom
 		if len(config.Spec.Quality.Exclude) > 0 || len(config.Spec.Quality.PreferAdditional) > 0 || len(config.Spec.Quality.RejectAdditional) > 0 {
 			input.QualityOverrides = &presets.QualityOverrides{
 				Exclude:          config.Spec.Quality.Exclude,
@@ -106,6 +165,7 @@ func (c *Compiler) CompileSonarrConfig(ctx context.Context, config *arrv1alpha1.
 			}
 		}
 	}
+	input.QualityProfiles = convertQualityProfiles(config.Spec.QualityProfiles)
 
 	// Naming
 	if config.Spec.Naming != nil {
@@ -124,8 +184,14 @@ func (c *Compiler) CompileSonarrConfig(ctx context.Context, config *arrv1alpha1.
 	// Root folders
 	input.RootFolders = config.Spec.RootFolders
 
-	// Import lists
-	input.ImportLists = convertImportLists(config.Spec.ImportLists, resolvedSecrets)
+	// Import lists, with the series defaults filled in
+	input.ImportLists = convertImportLists(importLists, resolvedSecrets)
+	if defaults := config.Spec.SeriesDefaults; defaults != nil {
+		for i := range input.ImportLists {
+			input.ImportLists[i].Tags = defaults.Tags
+		}
+	}
+	input.ImportListOptions = convertImportListOptions(config.Spec.ImportListOptions)
 
 	// Media management
 	input.MediaManagement = convertMediaManagement(config.Spec.MediaManagement)
@@ -133,24 +199,50 @@ func (c *Compiler) CompileSonarrConfig(ctx context.Context, config *arrv1alpha1.
 	// Authentication
 	input.Authentication = convertAuthentication(config.Spec.Authentication, resolvedSecrets)
 
-	// Notifications
+	// UI settings
+	input.UI = convertUI(adapters.AppSonarr, config.Spec.UI)
+
+	// Notifications (including media server library refresh hooks)
 	input.Notifications = convertNotifications(config.Spec.Notifications, resolvedSecrets)
+	input.Notifications = append(input.Notifications, convertMediaServerHooks(config.Spec.MediaServerHooks, resolvedSecrets)...)
 
-	// Custom formats
+	// Custom formats, including those expressing the language preferences
 	input.CustomFormats = convertCustomFormats(config.Spec.CustomFormats)
+	resolveCustomFormatLanguages(adapters.AppSonarr, input.CustomFormats)
+	profileLanguage, languageFormats := convertLanguage(adapters.AppSonarr, config.Spec.Language)
+	input.ProfileLanguage = profileLanguage
+	input.CustomFormats = append(input.CustomFormats, languageFormats...)
 
 	// Delay profiles
 	input.DelayProfiles = convertDelayProfiles(config.Spec.DelayProfiles)
 
+	// Quality definitions
+	input.QualityDefinitions = convertQualityDefinitions(config.Spec.QualityDefinitions)
+
+	// Auto tags
+	input.AutoTags = convertAutoTags(config.Spec.AutoTags)
+
 	return c.Compile(ctx, input)
 }
 
 // CompileLidarrConfig compiles a LidarrConfig CRD to IR
 func (c *Compiler) CompileLidarrConfig(ctx context.Context, config *arrv1alpha1.LidarrConfig, resolvedSecrets map[string]string, caps *adapters.Capabilities) (*irv1.IR, error) {
+	// Reject spec values that would otherwise be silently dropped
+	var invalid FieldErrors
+	validateIndexers(&invalid, config.Spec.Indexers)
+	if config.Spec.Naming != nil {
+		validateNamingPreset(&invalid, adapters.AppLidarr, config.Spec.Naming.Preset)
+	}
+	validateImportLists(&invalid, adapters.AppLidarr, config.Spec.ImportLists)
+	if err := invalid.err(); err != nil {
+		return nil, err
+	}
+
 	input := CompileInput{
 		App:             adapters.AppLidarr,
 		ConfigName:      config.Name,
 		Namespace:       config.Namespace,
+		SecretSalt:      SecretSalt(c.SecretKey, config.UID),
 		Capabilities:    caps,
 		ResolvedSecrets: resolvedSecrets,
 	}
@@ -175,6 +267,7 @@ func (c *Compiler) CompileLidarrConfig(ctx context.Context, config *arrv1alpha1.
 	// Naming
 	if config.Spec.Naming != nil {
 		input.NamingPreset = config.Spec.Naming.Preset
+		input.NamingOverrides = convertLidarrNaming(config.Spec.Naming)
 	}
 
 	// Download clients
@@ -203,6 +296,12 @@ func (c *Compiler) CompileLidarrConfig(ctx context.Context, config *arrv1alpha1.
 	// Notifications
 	input.Notifications = convertNotifications(config.Spec.Notifications, resolvedSecrets)
 
+	// Delay profiles
+	input.DelayProfiles = convertDelayProfiles(config.Spec.DelayProfiles)
+
+	// Release profiles
+	input.ReleaseProfiles = convertReleaseProfiles(config.Spec.ReleaseProfiles)
+
 	return c.Compile(ctx, input)
 }
 
@@ -247,27 +346,10 @@ func convertDownloadClients(clients []arrv1alpha1.DownloadClientSpec, resolvedSe
 			Priority:                 dc.Priority,
 			RemoveCompletedDownloads: dc.RemoveCompletedDownloads == nil || *dc.RemoveCompletedDownloads,
 			RemoveFailedDownloads:    dc.RemoveFailedDownloads == nil || *dc.RemoveFailedDownloads,
+			Paused:                   dc.Pause,
 		}
 
-		// Resolve credentials from secrets
-		if dc.CredentialsSecretRef != nil {
-			usernameKey := dc.CredentialsSecretRef.UsernameKey
-			if usernameKey == "" {
-				usernameKey = "username"
-			}
-			passwordKey := dc.CredentialsSecretRef.PasswordKey
-			if passwordKey == "" {
-				passwordKey = "password"
-			}
-
-			secretPrefix := dc.CredentialsSecretRef.Name + "/"
-			if username, ok := resolvedSecrets[secretPrefix+usernameKey]; ok {
-				dcInput.Username = username
-			}
-			if password, ok := resolvedSecrets[secretPrefix+passwordKey]; ok {
-				dcInput.Password = password
-			}
-		}
+		dcInput.Username, dcInput.Password, dcInput.APIKey = downloadClientCredentials(dc, resolvedSecrets)
 
 		result = append(result, dcInput)
 	}
@@ -275,6 +357,39 @@ func convertDownloadClients(clients []arrv1alpha1.DownloadClientSpec, resolvedSe
 	return result
 }
 
+// downloadClientCredentials returns the resolved username, password and API key of a download client
+func downloadClientCredentials(dc arrv1alpha1.DownloadClientSpec, resolvedSecrets map[string]string) (username, password, apiKey string) {
+	if dc.CredentialsSecretRef != nil {
+		usernameKey := dc.CredentialsSecretRef.UsernameKey
+		if usernameKey == "" {
+			usernameKey = "username"
+		}
+		passwordKey := dc.CredentialsSecretRef.PasswordKey
+		if passwordKey == "" {
+			passwordKey = "password"
+		}
+
+		secretPrefix := dc.CredentialsSecretRef.Name + "/"
+		username = resolvedSecrets[secretPrefix+usernameKey]
+		password = resolvedSecrets[secretPrefix+passwordKey]
+	}
+	if dc.PasswordSecretRef != nil && password == "" {
+		keyName := dc.PasswordSecretRef.Key
+		if keyName == "" {
+			keyName = "password"
+		}
+		password = resolvedSecrets[dc.PasswordSecretRef.Name+"/"+keyName]
+	}
+	if dc.APIKeySecretRef != nil {
+		keyName := dc.APIKeySecretRef.Key
+		if keyName == "" {
+			keyName = "apiKey"
+		}
+		apiKey = resolvedSecrets[dc.APIKeySecretRef.Name+"/"+keyName]
+	}
+	return username, password, apiKey
+}
+
 // convertIndexers converts CRD IndexersSpec to compiler input
 func convertIndexers(spec *arrv1alpha1.IndexersSpec, resolvedSecrets map[string]string) *IndexersInput {
 	if spec == nil {
@@ -322,6 +437,7 @@ func convertIndexers(spec *arrv1alpha1.IndexersSpec, resolvedSecrets map[string]
 			EnableRss:               true, // Default to enabled
 			EnableAutomaticSearch:   true,
 			EnableInteractiveSearch: true,
+			Paused:                  idx.Pause,
 		}
 
 		// Resolve API key from secret
@@ -339,9 +455,62 @@ func convertIndexers(spec *arrv1alpha1.IndexersSpec, resolvedSecrets map[string]
 		result.Direct = append(result.Direct, idxInput)
 	}
 
+	if spec.Preset != "" {
+		applyIndexerPreset(spec.Preset, spec.Direct, result.Direct)
+	}
+
 	return result
 }
 
+// convertQualityProfiles converts named quality profiles to compiler input
+func convertQualityProfiles(profiles []arrv1alpha1.NamedVideoQualitySpec) []QualityProfileInput {
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	result := make([]QualityProfileInput, 0, len(profiles))
+	for _, p := range profiles {
+		input := QualityProfileInput{Name: p.Name, Preset: p.Preset}
+		if len(p.Exclude) > 0 || len(p.PreferAdditional) > 0 || len(p.RejectAdditional) > 0 {
+			input.Overrides = &presets.QualityOverrides{
+				Exclude:          p.Exclude,
+				PreferAdditional: p.PreferAdditional,
+				RejectAdditional: p.RejectAdditional,
+			}
+		}
+		result = append(result, input)
+	}
+	return result
+}
+
+// applyIndexerPreset assigns preset priority bands and seed criteria to direct indexers.
+// Indexers within a band are numbered in declaration order, up to the next band.
+func applyIndexerPreset(presetName string, specs []arrv1alpha1.DirectIndexer, indexers []IndexerInput) {
+	preset, ok := presets.GetIndexerPreset(presetName)
+	if !ok {
+		return
+	}
+
+	bandCounts := make(map[int]int)
+	for i := range indexers {
+		band, tier, ok := preset.Tier(indexers[i].Protocol, specs[i].Tags)
+		if !ok {
+			continue
+		}
+
+		if specs[i].Priority == 0 || specs[i].Priority == presets.DefaultIndexerPriority {
+			indexers[i].Priority = preset.BandPriority(band, bandCounts[band])
+		}
+		bandCounts[band]++
+
+		if indexers[i].Protocol == irv1.ProtocolTorrent {
+			indexers[i].MinimumSeeders = tier.MinimumSeeders
+			indexers[i].SeedRatio = tier.SeedRatio
+			indexers[i].SeedTimeMinutes = tier.SeedTimeMinutes
+		}
+	}
+}
+
 // parseClientURL parses a download client URL into host, port, and TLS setting
 func parseClientURL(rawURL string) (host string, port int, useTLS bool) {
 	parsed, err := url.Parse(rawURL)
@@ -392,22 +561,7 @@ func inferImplementationFromName(name string) string {
 
 // normalizeImplementationName converts user-friendly type names to API implementation names
 func normalizeImplementationName(impl string) string {
-	switch strings.ToLower(impl) {
-	case "qbittorrent":
-		return "QBittorrent"
-	case "transmission":
-		return "Transmission"
-	case "deluge":
-		return "Deluge"
-	case "rtorrent":
-		return "RTorrent"
-	case "sabnzbd":
-		return "Sabnzbd"
-	case "nzbget":
-		return "NzbGet"
-	default:
-		return impl
-	}
+	return adapters.CanonicalImplementation(impl)
 }
 
 // inferIndexerImplementation determines the indexer implementation from URL and type
@@ -474,6 +628,26 @@ func mapCategoryName(name string) int {
 	return categories[strings.ToLower(name)]
 }
 
+// applySeriesDefaults returns the import lists with the fields they leave
+// unset taken from the Sonarr series defaults
+func applySeriesDefaults(lists []arrv1alpha1.ImportListSpec, defaults *arrv1alpha1.SeriesDefaultsSpec) []arrv1alpha1.ImportListSpec {
+	if defaults == nil || len(lists) == 0 {
+		return lists
+	}
+
+	result := make([]arrv1alpha1.ImportListSpec, len(lists))
+	for i, list := range lists {
+		list.QualityProfile = defaultString(list.QualityProfile, defaults.QualityProfile)
+		list.ShouldMonitor = defaultString(list.ShouldMonitor, defaults.Monitor)
+		list.SeriesType = defaultString(list.SeriesType, defaults.SeriesType)
+		if list.SeasonFolder == nil {
+			list.SeasonFolder = defaults.SeasonFolder
+		}
+		result[i] = list
+	}
+	return result
+}
+
 // convertImportLists converts CRD ImportListSpec to compiler input
 func convertImportLists(lists []arrv1alpha1.ImportListSpec, resolvedSecrets map[string]string) []ImportListInput {
 	if len(lists) == 0 {
@@ -484,7 +658,7 @@ func convertImportLists(lists []arrv1alpha1.ImportListSpec, resolvedSecrets map[
 	for _, list := range lists {
 		input := ImportListInput{
 			Name:               list.Name,
-			Type:               list.Type,
+			Type:               importListType(list),
 			Enabled:            ptrBoolOrDefault(list.Enabled, true),
 			EnableAuto:         ptrBoolOrDefault(list.EnableAuto, true),
 			SearchOnAdd:        ptrBoolOrDefault(list.SearchOnAdd, true),
@@ -518,12 +692,82 @@ func convertImportLists(lists []arrv1alpha1.ImportListSpec, resolvedSecrets map[
 			}
 		}
 
+		// Typed list settings (Sonarr)
+		secret := func(ref arrv1alpha1.SecretKeySelector) string {
+			return resolvedSecrets[ref.Name+"/"+defaultString(ref.Key, "apiKey")]
+		}
+		if plex := list.PlexWatchlist; plex != nil {
+			input.PlexWatchlist = &irv1.PlexWatchlistImportIR{AccessToken: secret(plex.AccessTokenSecretRef)}
+		}
+		if rss := list.PlexRSS; rss != nil {
+			input.PlexRSS = &irv1.PlexRSSImportIR{URL: rss.URL}
+		}
+		if trakt := list.TraktList; trakt != nil {
+			input.TraktList = &irv1.TraktListImportIR{
+				Username:             trakt.Username,
+				ListName:             trakt.ListName,
+				AccessToken:          secret(trakt.AccessTokenSecretRef),
+				Limit:                ptrIntOrDefault(trakt.Limit, 0),
+				AdditionalParameters: trakt.AdditionalParameters,
+			}
+			if trakt.RefreshTokenSecretRef != nil {
+				input.TraktList.RefreshToken = secret(*trakt.RefreshTokenSecretRef)
+			}
+		}
+		if custom := list.Custom; custom != nil {
+			input.Custom = &irv1.CustomImportListIR{URL: custom.URL}
+		}
+
+		// Typed list settings (Readarr)
+		if goodreads := list.GoodreadsList; goodreads != nil {
+			input.GoodreadsList = &irv1.GoodreadsListImportIR{ListID: goodreads.ListID}
+		}
+		if owned := list.GoodreadsOwnedBooks; owned != nil {
+			session := func(key string) string {
+				return resolvedSecrets[owned.SessionSecretRef.Name+"/"+key]
+			}
+			input.GoodreadsOwnedBooks = &irv1.GoodreadsSessionIR{
+				AccessToken:       session("accessToken"),
+				AccessTokenSecret: session("accessTokenSecret"),
+				UserID:            session("userId"),
+				UserName:          session("userName"),
+			}
+		}
+		if lazy := list.LazyLibrarian; lazy != nil {
+			input.LazyLibrarian = &irv1.LazyLibrarianImportIR{URL: lazy.URL, APIKey: secret(lazy.APIKeySecretRef)}
+		}
+
 		result = append(result, input)
 	}
 
 	return result
 }
 
+// importListType returns the implementation type of an import list, implied
+// by its typed settings when type is not set
+func importListType(list arrv1alpha1.ImportListSpec) string {
+	if list.Type != "" {
+		return list.Type
+	}
+	if implied := typedImportListTypes(list); len(implied) > 0 {
+		return implied[0].listType
+	}
+	return ""
+}
+
+// convertImportListOptions converts CRD ImportListOptionsSpec to compiler input
+func convertImportListOptions(spec *arrv1alpha1.ImportListOptionsSpec) *ImportListOptionsInput {
+	if spec == nil {
+		return nil
+	}
+
+	return &ImportListOptionsInput{
+		CleanLibraryLevel: spec.CleanLibraryLevel,
+		CleanLibraryTag:   spec.CleanLibraryTag,
+		SyncIntervalHours: spec.SyncIntervalHours,
+	}
+}
+
 // convertMediaManagement converts CRD MediaManagementSpec to compiler input
 func convertMediaManagement(spec *arrv1alpha1.MediaManagementSpec) *MediaManagementInput {
 	if spec == nil {
@@ -544,6 +788,22 @@ func convertMediaManagement(spec *arrv1alpha1.MediaManagementSpec) *MediaManagem
 	}
 }
 
+// convertCollections converts CRD CollectionsSpec to compiler input
+func convertCollections(spec *arrv1alpha1.CollectionsSpec) *CollectionsInput {
+	if spec == nil {
+		return nil
+	}
+
+	return &CollectionsInput{
+		Titles:              spec.Titles,
+		Monitored:           spec.Monitored,
+		SearchOnAdd:         spec.SearchOnAdd,
+		MinimumAvailability: spec.MinimumAvailability,
+		QualityProfile:      spec.QualityProfile,
+		RootFolder:          spec.RootFolder,
+	}
+}
+
 // convertAuthentication converts CRD AuthenticationSpec to compiler input
 func convertAuthentication(spec *arrv1alpha1.AuthenticationSpec, resolvedSecrets map[string]string) *AuthenticationInput {
 	if spec == nil {
@@ -571,6 +831,41 @@ func convertAuthentication(spec *arrv1alpha1.AuthenticationSpec, resolvedSecrets
 	return input
 }
 
+// uiTimeFormats maps the spec time formats to the app's format strings
+var uiTimeFormats = map[string]string{
+	"12h": "h(:mm)a",
+	"24h": "HH:mm",
+}
+
+// uiWeekDays maps the spec week days to the app's day numbers
+var uiWeekDays = map[string]int{
+	"sunday": 0,
+	"monday": 1,
+}
+
+// convertUI converts CRD UISpec to compiler input. Unknown languages are
+// rejected by validateUI, so they are left unset here.
+func convertUI(app string, spec *arrv1alpha1.UISpec) *UIInput {
+	if spec == nil {
+		return nil
+	}
+
+	input := &UIInput{
+		TimeFormat:         uiTimeFormats[spec.TimeFormat],
+		Theme:              spec.Theme,
+		MovieRuntimeFormat: spec.MovieRuntimeFormat,
+	}
+	if day, ok := uiWeekDays[spec.FirstDayOfWeek]; ok {
+		input.FirstDayOfWeek = &day
+	}
+	if spec.UILanguage != "" {
+		if lang, ok := lookupLanguage(app, spec.UILanguage); ok {
+			input.UILanguage = lang.ID
+		}
+	}
+	return input
+}
+
 // Helper functions for handling pointers and defaults
 
 func ptrBoolOrDefault(ptr *bool, def bool) bool {
@@ -667,6 +962,74 @@ func convertNotifications(notifications []arrv1alpha1.NotificationSpec, resolved
 	return result
 }
 
+// Media server hook types
+const (
+	MediaServerPlex     = "plex"
+	MediaServerJellyfin = "jellyfin"
+)
+
+// MediaServerNotificationName returns the name of the notification managed for a media server hook
+func MediaServerNotificationName(configName, server string) string {
+	return fmt.Sprintf("nebularr-%s-media-server-%s", configName, server)
+}
+
+// convertMediaServerHooks converts media server hooks to notification inputs.
+// Plex uses the PlexServer connection; Jellyfin uses MediaBrowser (Emby/Jellyfin).
+func convertMediaServerHooks(hooks *arrv1alpha1.MediaServerHooksSpec, resolvedSecrets map[string]string) []NotificationInput {
+	if hooks == nil {
+		return nil
+	}
+
+	var result []NotificationInput
+	if plex := hooks.Plex; plex != nil {
+		host, port, useSSL := parseClientURL(plex.URL)
+		input := mediaServerNotificationInput(MediaServerPlex, "PlexServer", ptrBoolOrDefault(plex.UpdateLibrary, true))
+		input.Fields["host"] = host
+		input.Fields["port"] = port
+		input.Fields["useSsl"] = useSSL
+		input.Fields["authToken"] = resolvedSecrets[plex.TokenSecretRef.Name+"/"+defaultString(plex.TokenSecretRef.Key, "apiKey")]
+		result = append(result, input)
+	}
+
+	if jellyfin := hooks.Jellyfin; jellyfin != nil {
+		host, port, useSSL := parseClientURL(jellyfin.URL)
+		input := mediaServerNotificationInput(MediaServerJellyfin, "MediaBrowser", ptrBoolOrDefault(jellyfin.UpdateLibrary, true))
+		input.Fields["host"] = host
+		input.Fields["port"] = port
+		input.Fields["useSsl"] = useSSL
+		input.Fields["apiKey"] = resolvedSecrets[jellyfin.APIKeySecretRef.Name+"/"+defaultString(jellyfin.APIKeySecretRef.Key, "apiKey")]
+		input.Fields["notify"] = ptrBoolOrDefault(jellyfin.Notify, false)
+		result = append(result, input)
+	}
+
+	return result
+}
+
+// mediaServerNotificationInput builds a notification that refreshes the library
+// whenever files are imported, upgraded, renamed or deleted
+func mediaServerNotificationInput(server, implementation string, updateLibrary bool) NotificationInput {
+	return NotificationInput{
+		Name:           "media-server-" + server,
+		Implementation: implementation,
+
+		OnDownload: true,
+		OnUpgrade:  true,
+		OnRename:   true,
+
+		// Radarr-specific events
+		OnMovieDelete:     true,
+		OnMovieFileDelete: true,
+
+		// Sonarr-specific events
+		OnSeriesDelete:      true,
+		OnEpisodeFileDelete: true,
+
+		Fields: map[string]interface{}{
+			"updateLibrary": updateLibrary,
+		},
+	}
+}
+
 // convertCustomFormats converts CRD CustomFormatSpec to compiler input
 func convertCustomFormats(customFormats []arrv1alpha1.CustomFormatSpec) []CustomFormatInput {
 	if len(customFormats) == 0 {
@@ -698,6 +1061,40 @@ func convertCustomFormats(customFormats []arrv1alpha1.CustomFormatSpec) []Custom
 	return result
 }
 
+// convertAutoTags converts CRD AutoTagSpec to compiler input
+func convertAutoTags(autoTags []arrv1alpha1.AutoTagSpec) []AutoTagInput {
+	if len(autoTags) == 0 {
+		return nil
+	}
+
+	result := make([]AutoTagInput, 0, len(autoTags))
+	for _, at := range autoTags {
+		input := AutoTagInput{
+			Name:                    at.Name,
+			Tags:                    at.Tags,
+			RemoveTagsAutomatically: ptrBoolOrDefault(at.RemoveTagsAutomatically, false),
+			Specifications:          make([]AutoTagSpecInput, 0, len(at.Specifications)),
+		}
+
+		for _, spec := range at.Specifications {
+			input.Specifications = append(input.Specifications, AutoTagSpecInput{
+				Name:     spec.Name,
+				Type:     spec.Type,
+				Negate:   ptrBoolOrDefault(spec.Negate, false),
+				Required: ptrBoolOrDefault(spec.Required, false),
+				Value:    spec.Value,
+				Values:   spec.Values,
+				Min:      spec.Min,
+				Max:      spec.Max,
+			})
+		}
+
+		result = append(result, input)
+	}
+
+	return result
+}
+
 // convertDelayProfiles converts CRD DelayProfileSpec to compiler input
 func convertDelayProfiles(profiles []arrv1alpha1.DelayProfileSpec) []DelayProfileInput {
 	if len(profiles) == 0 {
@@ -733,12 +1130,120 @@ func convertDelayProfiles(profiles []arrv1alpha1.DelayProfileSpec) []DelayProfil
 	return result
 }
 
+// convertReleaseProfiles converts CRD ReleaseProfileSpec to compiler input
+func convertReleaseProfiles(profiles []arrv1alpha1.ReleaseProfileSpec) []ReleaseProfileInput {
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	result := make([]ReleaseProfileInput, 0, len(profiles))
+	for _, p := range profiles {
+		input := ReleaseProfileInput{
+			Name:                         p.Name,
+			Enabled:                      ptrBoolOrDefault(p.Enabled, true),
+			Required:                     p.Required,
+			Ignored:                      p.Ignored,
+			IncludePreferredWhenRenaming: ptrBoolOrDefault(p.IncludePreferredWhenRenaming, false),
+			IndexerID:                    p.IndexerID,
+			Tags:                         p.Tags,
+		}
+		for _, term := range p.Preferred {
+			input.Preferred = append(input.Preferred, PreferredTermInput{Term: term.Term, Score: term.Score})
+		}
+		result = append(result, input)
+	}
+
+	return result
+}
+
+// convertQualityDefinitions converts CRD QualityDefinitionSpec to compiler input
+func convertQualityDefinitions(defs []arrv1alpha1.QualityDefinitionSpec) []QualityDefinitionInput {
+	if len(defs) == 0 {
+		return nil
+	}
+
+	result := make([]QualityDefinitionInput, 0, len(defs))
+	for _, d := range defs {
+		result = append(result, QualityDefinitionInput{
+			Quality:       d.Quality,
+			Title:         d.Title,
+			MinSize:       d.MinSize,
+			MaxSize:       d.MaxSize,
+			PreferredSize: d.PreferredSize,
+		})
+	}
+
+	return result
+}
+
+// convertLidarrNaming converts the Lidarr naming fields set in the spec.
+// The app-specific folder format wins over the generic one.
+func convertLidarrNaming(spec *arrv1alpha1.LidarrNamingSpec) *NamingOverridesInput {
+	overrides := &NamingOverridesInput{
+		Rename:            spec.RenameMedia,
+		StandardFormat:    spec.StandardFormat,
+		MultiDiscFormat:   spec.MultiDiscTrackFormat,
+		FolderFormat:      spec.FolderFormat,
+		AlbumFolderFormat: spec.AlbumFolderFormat,
+	}
+	if spec.ArtistFolderFormat != "" {
+		overrides.FolderFormat = spec.ArtistFolderFormat
+	}
+	if *overrides == (NamingOverridesInput{}) {
+		return nil
+	}
+	return overrides
+}
+
+// readarrColonReplacement maps the CRD colon replacement to Readarr's values
+var readarrColonReplacement = map[string]int{
+	"delete":         presets.ReadarrColonDelete,
+	"dash":           presets.ReadarrColonDash,
+	"spaceDash":      presets.ReadarrColonSpaceDash,
+	"spaceDashSpace": presets.ReadarrColonSpaceDashSpace,
+}
+
+// convertReadarrNaming converts the Readarr naming fields set in the spec.
+// The app-specific formats win over the generic ones.
+func convertReadarrNaming(spec *arrv1alpha1.ReadarrNamingSpec) *NamingOverridesInput {
+	overrides := &NamingOverridesInput{
+		Rename:         spec.RenameMedia,
+		StandardFormat: spec.StandardFormat,
+		FolderFormat:   spec.FolderFormat,
+	}
+	if spec.StandardBookFormat != "" {
+		overrides.StandardFormat = spec.StandardBookFormat
+	}
+	if spec.AuthorFolderFormat != "" {
+		overrides.FolderFormat = spec.AuthorFolderFormat
+	}
+	if colon, ok := readarrColonReplacement[spec.ColonReplacementFormat]; ok {
+		overrides.ColonReplacement = &colon
+	}
+	if *overrides == (NamingOverridesInput{}) {
+		return nil
+	}
+	return overrides
+}
+
 // CompileReadarrConfig compiles a ReadarrConfig CRD to IR
 func (c *Compiler) CompileReadarrConfig(ctx context.Context, config *arrv1alpha1.ReadarrConfig, resolvedSecrets map[string]string, caps *adapters.Capabilities) (*irv1.IR, error) {
+	// Reject spec values that would otherwise be silently dropped
+	var invalid FieldErrors
+	validateIndexers(&invalid, config.Spec.Indexers)
+	if config.Spec.Naming != nil {
+		validateNamingPreset(&invalid, adapters.AppReadarr, config.Spec.Naming.Preset)
+	}
+	validateImportLists(&invalid, adapters.AppReadarr, config.Spec.ImportLists)
+	if err := invalid.err(); err != nil {
+		return nil, err
+	}
+
 	input := CompileInput{
 		App:             adapters.AppReadarr,
 		ConfigName:      config.Name,
 		Namespace:       config.Namespace,
+		SecretSalt:      SecretSalt(c.SecretKey, config.UID),
 		Capabilities:    caps,
 		ResolvedSecrets: resolvedSecrets,
 	}
@@ -804,6 +1309,7 @@ func (c *Compiler) CompileReadarrConfig(ctx context.Context, config *arrv1alpha1
 	// Naming
 	if config.Spec.Naming != nil {
 		input.NamingPreset = config.Spec.Naming.Preset
+		input.NamingOverrides = convertReadarrNaming(config.Spec.Naming)
 	}
 
 	// Download clients
@@ -830,5 +1336,8 @@ func (c *Compiler) CompileReadarrConfig(ctx context.Context, config *arrv1alpha1
 	// Notifications
 	input.Notifications = convertNotifications(config.Spec.Notifications, resolvedSecrets)
 
+	// Delay profiles
+	input.DelayProfiles = convertDelayProfiles(config.Spec.DelayProfiles)
+
 	return c.Compile(ctx, input)
 }
@@ -6,13 +6,18 @@ import (
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
+// DownloadClientName returns the name of the download client managed for a spec entry
+func DownloadClientName(configName, client string) string {
+	return fmt.Sprintf("nebularr-%s-%s", configName, client)
+}
+
 // compileDownloadClients converts download client inputs to IR
 func (c *Compiler) compileDownloadClients(clients []DownloadClientInput, configName string) []irv1.DownloadClientIR {
 	result := make([]irv1.DownloadClientIR, 0, len(clients))
 
 	for _, dc := range clients {
 		ir := irv1.DownloadClientIR{
-			Name:                     fmt.Sprintf("nebularr-%s-%s", configName, dc.Name),
+			Name:                     DownloadClientName(configName, dc.Name),
 			Implementation:           dc.Implementation,
 			Protocol:                 inferProtocol(dc.Implementation),
 			Enable:                   true,
@@ -22,9 +27,11 @@ func (c *Compiler) compileDownloadClients(clients []DownloadClientInput, configN
 			UseTLS:                   dc.UseTLS,
 			Username:                 dc.Username,
 			Password:                 dc.Password,
+			APIKey:                   dc.APIKey,
 			Category:                 dc.Category,
 			RemoveCompletedDownloads: dc.RemoveCompletedDownloads,
 			RemoveFailedDownloads:    dc.RemoveFailedDownloads,
+			Paused:                   dc.Paused,
 		}
 		result = append(result, ir)
 	}
@@ -50,6 +57,11 @@ func (c *Compiler) compileRemotePathMappings(mappings []RemotePathMappingInput)
 	return result
 }
 
+// IndexerName returns the generated name of a direct indexer in the app
+func IndexerName(configName, name string) string {
+	return fmt.Sprintf("nebularr-%s-%s", configName, name)
+}
+
 // compileIndexers converts indexer inputs to IR
 func (c *Compiler) compileIndexers(input *IndexersInput, configName string) *irv1.IndexersIR {
 	if input == nil {
@@ -71,7 +83,7 @@ func (c *Compiler) compileIndexers(input *IndexersInput, configName string) *irv
 	// Handle direct indexers
 	for _, idx := range input.Direct {
 		ir := irv1.IndexerIR{
-			Name:                    fmt.Sprintf("nebularr-%s-%s", configName, idx.Name),
+			Name:                    IndexerName(configName, idx.Name),
 			Protocol:                idx.Protocol,
 			Implementation:          idx.Implementation,
 			Enable:                  true,
@@ -85,6 +97,7 @@ func (c *Compiler) compileIndexers(input *IndexersInput, configName string) *irv
 			EnableRss:               idx.EnableRss,
 			EnableAutomaticSearch:   idx.EnableAutomaticSearch,
 			EnableInteractiveSearch: idx.EnableInteractiveSearch,
+			Paused:                  idx.Paused,
 		}
 		result.Direct = append(result.Direct, ir)
 	}
@@ -0,0 +1,115 @@
+package compiler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// secretHashLength is the length of the hashes SecretHash returns. Hashes of a
+// different length were recorded by operators that salted them with the UID only.
+const secretHashLength = 32
+
+// SecretSalt derives the salt of a config's secret hashes from the operator's
+// secret key and the config's UID. The key is random and kept in a Secret, so
+// the hashes in status can't be brute-forced by anyone who can read the config;
+// the UID keeps them from being compared across configs.
+func SecretSalt(key []byte, uid types.UID) string {
+	mac := hmac.New(sha256.New, key)
+	_, _ = io.WriteString(mac, string(uid))
+	return fmt.Sprintf("%x", mac.Sum(nil))
+}
+
+// IsKeyedSecretHash reports whether hash was computed by SecretHash with a salt
+// from SecretSalt. Older hashes can't be compared and are treated as unknown.
+func IsKeyedSecretHash(hash string) bool {
+	return len(hash) == secretHashLength
+}
+
+// SecretHash returns a salted HMAC of secret values, so a credential rotation
+// can be detected without storing or exposing the values. salt should come from
+// SecretSalt. It returns "" when every value is empty.
+func SecretHash(salt string, values ...string) string {
+	empty := true
+	mac := hmac.New(sha256.New, []byte("nebularr/"+salt))
+	for _, v := range values {
+		if v != "" {
+			empty = false
+		}
+		// Length-prefix each value so ("ab","c") and ("a","bc") differ
+		_, _ = fmt.Fprintf(mac, "%d:%s", len(v), v)
+	}
+	if empty {
+		return ""
+	}
+	return fmt.Sprintf("%x", mac.Sum(nil)[:secretHashLength/2])
+}
+
+// hashSecrets sets the SecretHash of every download client, indexer and
+// Trakt import list in ir
+func hashSecrets(ir *irv1.IR, salt string) {
+	for i := range ir.ImportLists {
+		if trakt := ir.ImportLists[i].TraktList; trakt != nil {
+			trakt.SecretHash = SecretHash(salt, trakt.AccessToken, trakt.RefreshToken)
+		}
+	}
+	for i := range ir.DownloadClients {
+		dc := &ir.DownloadClients[i]
+		dc.SecretHash = downloadClientSecretHash(salt, dc)
+	}
+	if ir.Indexers != nil {
+		for i := range ir.Indexers.Direct {
+			idx := &ir.Indexers.Direct[i]
+			idx.SecretHash = SecretHash(salt, idx.APIKey)
+		}
+	}
+	if ir.Prowlarr != nil {
+		for i := range ir.Prowlarr.Indexers {
+			idx := &ir.Prowlarr.Indexers[i]
+			idx.SecretHash = SecretHash(salt, idx.APIKey)
+		}
+		for i := range ir.Prowlarr.DownloadClients {
+			dc := &ir.Prowlarr.DownloadClients[i]
+			dc.SecretHash = downloadClientSecretHash(salt, dc)
+		}
+	}
+}
+
+// downloadClientSecretHash hashes the credentials of a download client. The API
+// key is only hashed when set, so the hashes of clients without one stay the same.
+func downloadClientSecretHash(salt string, dc *irv1.DownloadClientIR) string {
+	if dc.APIKey == "" {
+		return SecretHash(salt, dc.Username, dc.Password)
+	}
+	return SecretHash(salt, dc.Username, dc.Password, dc.APIKey)
+}
+
+// redactSecrets replaces resolved credentials in a copy of input with their
+// salted HMACs, so the source hash changes on rotation without hashing the
+// plain values
+func redactSecrets(input CompileInput) CompileInput {
+	clients := make([]DownloadClientInput, len(input.DownloadClients))
+	for i, dc := range input.DownloadClients {
+		dc.Password = SecretHash(input.SecretSalt, dc.Password)
+		dc.APIKey = SecretHash(input.SecretSalt, dc.APIKey)
+		clients[i] = dc
+	}
+	input.DownloadClients = clients
+
+	if input.Indexers != nil {
+		indexers := *input.Indexers
+		indexers.Direct = make([]IndexerInput, len(input.Indexers.Direct))
+		for i, idx := range input.Indexers.Direct {
+			idx.APIKey = SecretHash(input.SecretSalt, idx.APIKey)
+			indexers.Direct[i] = idx
+		}
+		input.Indexers = &indexers
+	}
+
+	return input
+}
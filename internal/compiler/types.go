@@ -4,6 +4,7 @@ package compiler
 
 import (
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 	"github.com/poiley/nebularr-operator/internal/presets"
 )
 
@@ -18,6 +19,9 @@ type CompileInput struct {
 	// Namespace is the namespace of the config resource
 	Namespace string
 
+	// SecretSalt salts the HMACs of resolved credentials (see SecretSalt)
+	SecretSalt string
+
 	// Connection details
 	URL    string
 	APIKey string
@@ -26,9 +30,21 @@ type CompileInput struct {
 	QualityPreset    string
 	QualityOverrides *presets.QualityOverrides
 
+	// QualityCloneFrom is an existing profile the default video profile is built from (Radarr/Sonarr)
+	QualityCloneFrom string
+
+	// QualityProfiles are additional named video profiles (Radarr/Sonarr)
+	QualityProfiles []QualityProfileInput
+
+	// ProfileLanguage is the language of the video profiles (Radarr only)
+	ProfileLanguage *irv1.LanguageIR
+
 	// Naming configuration
 	NamingPreset string
 
+	// NamingOverrides are formats set in the spec on top of the preset (Lidarr/Readarr)
+	NamingOverrides *NamingOverridesInput
+
 	// Download clients
 	DownloadClients []DownloadClientInput
 
@@ -44,21 +60,39 @@ type CompileInput struct {
 	// Import lists
 	ImportLists []ImportListInput
 
+	// Import list options (Radarr/Sonarr only)
+	ImportListOptions *ImportListOptionsInput
+
 	// Media management
 	MediaManagement *MediaManagementInput
 
+	// Collections (Radarr only)
+	Collections *CollectionsInput
+
 	// Authentication
 	Authentication *AuthenticationInput
 
+	// UI settings (Radarr/Sonarr only)
+	UI *UIInput
+
 	// Notifications
 	Notifications []NotificationInput
 
 	// CustomFormats (Radarr/Sonarr only)
 	CustomFormats []CustomFormatInput
 
-	// DelayProfiles (Radarr/Sonarr only)
+	// DelayProfiles (Radarr/Sonarr/Lidarr/Readarr)
 	DelayProfiles []DelayProfileInput
 
+	// QualityDefinitions (Radarr/Sonarr only)
+	QualityDefinitions []QualityDefinitionInput
+
+	// AutoTags (Radarr/Sonarr only)
+	AutoTags []AutoTagInput
+
+	// ReleaseProfiles (Lidarr only)
+	ReleaseProfiles []ReleaseProfileInput
+
 	// MetadataProfile (Readarr only)
 	MetadataProfile *MetadataProfileInput
 
@@ -81,10 +115,12 @@ type DownloadClientInput struct {
 	UseTLS                   bool
 	Username                 string
 	Password                 string
+	APIKey                   string
 	Category                 string
 	Priority                 int
 	RemoveCompletedDownloads bool
 	RemoveFailedDownloads    bool
+	Paused                   bool
 }
 
 // RemotePathMappingInput holds remote path mapping configuration
@@ -126,6 +162,7 @@ type IndexerInput struct {
 	EnableRss               bool
 	EnableAutomaticSearch   bool
 	EnableInteractiveSearch bool
+	Paused                  bool
 }
 
 // ImportListInput holds import list configuration
@@ -137,12 +174,38 @@ type ImportListInput struct {
 	SearchOnAdd         bool
 	QualityProfileName  string
 	RootFolderPath      string
-	Monitor             string // Radarr: movieOnly, movieAndCollection, none
-	MinimumAvailability string // Radarr: tba, announced, inCinemas, released
-	SeriesType          string // Sonarr: standard, daily, anime
-	SeasonFolder        bool   // Sonarr
-	ShouldMonitor       string // Sonarr: all, future, missing, existing, firstSeason, latestSeason, pilot, none
+	Monitor             string   // Radarr: movieOnly, movieAndCollection, none
+	MinimumAvailability string   // Radarr: tba, announced, inCinemas, released
+	SeriesType          string   // Sonarr: standard, daily, anime
+	SeasonFolder        bool     // Sonarr
+	ShouldMonitor       string   // Sonarr: all, future, missing, existing, firstSeason, latestSeason, pilot, none
+	Tags                []string // Sonarr: tags given to added series
 	Settings            map[string]string
+
+	// Typed list settings (Sonarr), with secrets resolved
+	PlexWatchlist *irv1.PlexWatchlistImportIR
+	PlexRSS       *irv1.PlexRSSImportIR
+	TraktList     *irv1.TraktListImportIR
+	Custom        *irv1.CustomImportListIR
+
+	// Typed list settings (Readarr), with secrets resolved
+	GoodreadsList       *irv1.GoodreadsListImportIR
+	GoodreadsOwnedBooks *irv1.GoodreadsSessionIR
+	LazyLibrarian       *irv1.LazyLibrarianImportIR
+}
+
+// QualityProfileInput holds an additional named quality profile
+type QualityProfileInput struct {
+	Name      string
+	Preset    string
+	Overrides *presets.QualityOverrides
+}
+
+// ImportListOptionsInput holds the global import list options
+type ImportListOptionsInput struct {
+	CleanLibraryLevel string
+	CleanLibraryTag   string
+	SyncIntervalHours int
 }
 
 // MediaManagementInput holds media management configuration
@@ -159,6 +222,16 @@ type MediaManagementInput struct {
 	AllowFingerprinting    string // Lidarr: never, newFiles, always
 }
 
+// CollectionsInput holds Radarr collection settings
+type CollectionsInput struct {
+	Titles              []string
+	Monitored           *bool
+	SearchOnAdd         *bool
+	MinimumAvailability string
+	QualityProfile      string
+	RootFolder          string
+}
+
 // AuthenticationInput holds authentication configuration
 type AuthenticationInput struct {
 	Method                 string // none, forms, external
@@ -167,6 +240,15 @@ type AuthenticationInput struct {
 	AuthenticationRequired string // enabled, disabledForLocalAddresses
 }
 
+// UIInput holds the UI settings, resolved to the app's values
+type UIInput struct {
+	FirstDayOfWeek     *int
+	TimeFormat         string
+	Theme              string
+	UILanguage         int
+	MovieRuntimeFormat string
+}
+
 // NotificationInput holds notification configuration
 type NotificationInput struct {
 	Name           string
@@ -244,6 +326,45 @@ type CustomFormatSpecInput struct {
 	Value string
 }
 
+// AutoTagInput holds an auto-tagging rule
+type AutoTagInput struct {
+	Name                    string
+	Tags                    []string
+	RemoveTagsAutomatically bool
+	Specifications          []AutoTagSpecInput
+}
+
+// AutoTagSpecInput holds a single condition of an auto-tagging rule
+type AutoTagSpecInput struct {
+	Name     string
+	Type     string
+	Negate   bool
+	Required bool
+	Value    string
+	Values   []string
+	Min      *int
+	Max      *int
+}
+
+// QualityDefinitionInput holds quality definition size limits.
+// Sizes are kept as strings (MB per minute, or "unlimited") until compilation.
+type QualityDefinitionInput struct {
+	// Quality is the quality name
+	Quality string
+
+	// Title overrides the display title
+	Title string
+
+	// MinSize in MB per minute
+	MinSize string
+
+	// MaxSize in MB per minute, or "unlimited"
+	MaxSize string
+
+	// PreferredSize in MB per minute, or "unlimited"
+	PreferredSize string
+}
+
 // DelayProfileInput holds delay profile configuration
 type DelayProfileInput struct {
 	// Name is a display name for identification
@@ -280,6 +401,39 @@ type DelayProfileInput struct {
 	Order int
 }
 
+// ReleaseProfileInput holds release profile configuration
+type ReleaseProfileInput struct {
+	// Name is a display name for identification
+	Name string
+
+	// Enabled indicates if the profile is active
+	Enabled bool
+
+	// Required terms that must be present
+	Required []string
+
+	// Ignored terms that must not be present
+	Ignored []string
+
+	// Preferred terms with scores
+	Preferred []PreferredTermInput
+
+	// IncludePreferredWhenRenaming includes preferred terms in naming
+	IncludePreferredWhenRenaming bool
+
+	// IndexerID restricts the profile to one indexer (0 = all)
+	IndexerID int
+
+	// Tags restricts this profile to items with these tags
+	Tags []string
+}
+
+// PreferredTermInput is a preferred term with its score
+type PreferredTermInput struct {
+	Term  string
+	Score int
+}
+
 // MetadataProfileInput holds metadata profile configuration (Readarr only)
 type MetadataProfileInput struct {
 	// Name is the profile name
@@ -318,3 +472,24 @@ type BookQualityInput struct {
 	// AllowedFormats are the allowed book formats
 	AllowedFormats []string
 }
+
+// NamingOverridesInput holds the naming fields set in the spec. Empty fields
+// keep the preset value.
+type NamingOverridesInput struct {
+	Rename *bool
+
+	// StandardFormat is the track (Lidarr) or book (Readarr) format
+	StandardFormat string
+
+	// MultiDiscFormat is the Lidarr multi-disc track format
+	MultiDiscFormat string
+
+	// FolderFormat is the artist (Lidarr) or author (Readarr) folder format
+	FolderFormat string
+
+	// AlbumFolderFormat is the Lidarr album folder format
+	AlbumFolderFormat string
+
+	// ColonReplacement is the Readarr colon replacement (nil = preset)
+	ColonReplacement *int
+}
@@ -0,0 +1,308 @@
+package compiler
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/presets"
+)
+
+// FieldError is a spec value the compiler rejected, addressed by its JSON path
+type FieldError struct {
+	// Path is the JSON path into the CRD, e.g. "spec.quality.preset"
+	Path string
+	// Value is the rejected value as written in the spec
+	Value string
+	// Reason explains why the value was rejected
+	Reason string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s (got %q)", e.Path, e.Reason, e.Value)
+}
+
+// FieldErrors collects every rejected value of a config so they can be
+// reported together instead of one per reconcile
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+	return fmt.Sprintf("%d invalid spec field(s): %s", len(e), strings.Join(msgs, "; "))
+}
+
+// add records a rejected value
+func (e *FieldErrors) add(path, value, reason string) {
+	*e = append(*e, FieldError{Path: path, Value: value, Reason: reason})
+}
+
+// err returns nil when nothing was rejected, so callers can return it directly
+func (e FieldErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// validateVideoQuality checks the video preset names of Radarr/Sonarr configs.
+// Unknown presets would otherwise silently fall back to the default preset.
+// A profile to clone from must be one Nebularr doesn't manage, or the managed
+// profile would be rebuilt from a profile it keeps overwriting.
+func validateVideoQuality(errs *FieldErrors, quality *arrv1alpha1.VideoQualitySpec, profiles []arrv1alpha1.NamedVideoQualitySpec) {
+	if quality != nil {
+		validateVideoPreset(errs, "spec.quality.preset", quality.Preset)
+		if strings.HasPrefix(strings.ToLower(quality.CloneFrom), "nebularr-") {
+			errs.add("spec.quality.cloneFrom", quality.CloneFrom, "must name a profile not managed by Nebularr")
+		}
+	}
+	for i, p := range profiles {
+		validateVideoPreset(errs, fmt.Sprintf("spec.qualityProfiles[%d].preset", i), p.Preset)
+	}
+}
+
+func validateVideoPreset(errs *FieldErrors, path, name string) {
+	if name == "" {
+		return
+	}
+	if _, ok := presets.GetVideoPreset(name); ok {
+		return
+	}
+	known := presets.ListVideoPresets()
+	sort.Strings(known)
+	errs.add(path, name, "unknown video quality preset, expected one of "+strings.Join(known, ", "))
+}
+
+// validateLanguage checks the language name of Radarr/Sonarr configs.
+// Unknown languages would otherwise be silently ignored.
+func validateLanguage(errs *FieldErrors, app string, spec *arrv1alpha1.LanguageSpec) {
+	if spec == nil || spec.Profile == "" {
+		return
+	}
+	if _, ok := lookupLanguage(app, spec.Profile); !ok {
+		errs.add("spec.language.profile", spec.Profile, fmt.Sprintf("unknown %s language", app))
+	}
+}
+
+// validateCustomFormatLanguages checks the values of LanguageSpecifications,
+// which the apps would otherwise match as the Unknown language
+func validateCustomFormatLanguages(errs *FieldErrors, app string, formats []arrv1alpha1.CustomFormatSpec) {
+	for i, cf := range formats {
+		for j, spec := range cf.Specifications {
+			if spec.Type != "LanguageSpecification" {
+				continue
+			}
+			if _, ok := customFormatLanguageID(app, spec.Value); !ok {
+				errs.add(fmt.Sprintf("spec.customFormats[%d].specifications[%d].value", i, j), spec.Value, fmt.Sprintf("unknown %s language", app))
+			}
+		}
+	}
+}
+
+// validateUI checks the UI language and the Radarr-only fields of the UI
+// settings. Original and Any are profile languages, not UI languages.
+func validateUI(errs *FieldErrors, app string, spec *arrv1alpha1.UISpec) {
+	if spec == nil {
+		return
+	}
+	if spec.UILanguage != "" {
+		if lang, ok := lookupLanguage(app, spec.UILanguage); !ok || lang.ID == languageOriginal || lang.ID == languageAny {
+			errs.add("spec.ui.uiLanguage", spec.UILanguage, fmt.Sprintf("unknown %s language", app))
+		}
+	}
+	if spec.MovieRuntimeFormat != "" && app != adapters.AppRadarr {
+		errs.add("spec.ui.movieRuntimeFormat", spec.MovieRuntimeFormat, "only supported by Radarr")
+	}
+}
+
+// validateNamingPreset checks that the naming preset applies to the app.
+// Presets of other apps would otherwise silently fall back to the default.
+func validateNamingPreset(errs *FieldErrors, app, name string) {
+	if name == "" {
+		return
+	}
+	preset, ok := presets.GetNamingPreset(name)
+	if ok && preset.SupportsApp(app) {
+		return
+	}
+	var known []string
+	for _, name := range presets.ListNamingPresets() {
+		if p, _ := presets.GetNamingPreset(name); p.SupportsApp(app) {
+			known = append(known, name)
+		}
+	}
+	sort.Strings(known)
+	errs.add("spec.naming.preset", name, fmt.Sprintf("unknown %s naming preset, expected one of %s", app, strings.Join(known, ", ")))
+}
+
+// validateIndexers checks the category names of direct indexers.
+// Unknown names would otherwise be dropped from the indexer.
+func validateIndexers(errs *FieldErrors, spec *arrv1alpha1.IndexersSpec) {
+	if spec == nil {
+		return
+	}
+	for i, idx := range spec.Direct {
+		for j, cat := range idx.Categories {
+			if _, err := strconv.Atoi(cat); err == nil || mapCategoryName(cat) > 0 {
+				continue
+			}
+			errs.add(fmt.Sprintf("spec.indexers.direct[%d].categories[%d]", i, j), cat, "unknown indexer category, use a numeric Newznab ID or a known name such as movies-hd")
+		}
+	}
+}
+
+// validateProwlarrApplications checks the sync category names of Prowlarr applications
+func validateProwlarrApplications(errs *FieldErrors, apps []arrv1alpha1.ProwlarrApplication) {
+	for i, app := range apps {
+		for j, cat := range app.SyncCategories {
+			if _, err := strconv.Atoi(cat); err == nil || mapProwlarrCategoryName(cat) > 0 {
+				continue
+			}
+			errs.add(fmt.Sprintf("spec.applications[%d].syncCategories[%d]", i, j), cat, "unknown sync category, use a numeric Newznab ID or a known name such as movies-hd")
+		}
+	}
+}
+
+// validateImportLists checks the type of each import list against its typed
+// settings. Typed settings are Sonarr only and imply the type.
+func validateImportLists(errs *FieldErrors, app string, lists []arrv1alpha1.ImportListSpec) {
+	for i, list := range lists {
+		path := fmt.Sprintf("spec.importLists[%d]", i)
+		if list.QualityProfile == "" {
+			reason := "qualityProfile is required"
+			if app == adapters.AppSonarr {
+				reason += " unless seriesDefaults.qualityProfile is set"
+			}
+			errs.add(path+".qualityProfile", "", reason)
+		}
+		implied := typedImportListTypes(list)
+		switch {
+		case len(implied) == 0:
+			if list.Type == "" {
+				reason := "type is required"
+				if fields := typedImportListFields(app); len(fields) > 0 {
+					reason += " unless " + joinOr(fields) + " is set"
+				}
+				errs.add(path+".type", "", reason)
+			}
+		case implied[0].app != app:
+			errs.add(path, implied[0].field, fmt.Sprintf("%s is only supported by %s, use type and settings for %s", implied[0].field, implied[0].app, app))
+		case len(implied) > 1:
+			errs.add(path, implied[1].field, fmt.Sprintf("only one of %s may be set, %s is already set", joinOr(typedImportListFields(app)), implied[0].field))
+		case list.Type != "" && list.Type != implied[0].listType:
+			errs.add(path+".type", list.Type, fmt.Sprintf("%s implies type %s", implied[0].field, implied[0].listType))
+		}
+		if monitors, ok := importListMonitors[app]; ok && list.ShouldMonitor != "" && !slices.Contains(monitors, list.ShouldMonitor) {
+			errs.add(path+".shouldMonitor", list.ShouldMonitor, fmt.Sprintf("not supported by %s, expected one of %s", app, strings.Join(monitors, ", ")))
+		}
+	}
+}
+
+// importListMonitors lists the shouldMonitor values each app supports
+var importListMonitors = map[string][]string{
+	adapters.AppSonarr:  {"all", "future", "missing", "existing", "firstSeason", "latestSeason", "pilot", "none"},
+	adapters.AppReadarr: {"entireAuthor", "specificBook", "none"},
+}
+
+// importListCleanLevels lists the clean library levels each app supports
+var importListCleanLevels = map[string][]string{
+	adapters.AppRadarr: {"disabled", "logOnly", "keepAndUnmonitor", "removeAndKeep", "removeAndDelete"},
+	adapters.AppSonarr: {"disabled", "logOnly", "keepAndUnmonitor", "keepAndTag"},
+}
+
+// validateImportListOptions checks the global import list options against the
+// app, since the CRD enum covers the levels of both Radarr and Sonarr
+func validateImportListOptions(errs *FieldErrors, app string, spec *arrv1alpha1.ImportListOptionsSpec) {
+	if spec == nil {
+		return
+	}
+	if level := spec.CleanLibraryLevel; level != "" && !slices.Contains(importListCleanLevels[app], level) {
+		errs.add("spec.importListOptions.cleanLibraryLevel", level,
+			fmt.Sprintf("not supported by %s, expected one of %s", app, strings.Join(importListCleanLevels[app], ", ")))
+	}
+	switch {
+	case spec.CleanLibraryTag != "" && app != adapters.AppSonarr:
+		errs.add("spec.importListOptions.cleanLibraryTag", spec.CleanLibraryTag, "only supported by Sonarr")
+	case spec.CleanLibraryTag != "" && spec.CleanLibraryLevel != "keepAndTag":
+		errs.add("spec.importListOptions.cleanLibraryTag", spec.CleanLibraryTag, "only used with cleanLibraryLevel keepAndTag")
+	case spec.CleanLibraryTag == "" && spec.CleanLibraryLevel == "keepAndTag" && app == adapters.AppSonarr:
+		errs.add("spec.importListOptions.cleanLibraryTag", "", "required with cleanLibraryLevel keepAndTag")
+	}
+	if spec.SyncIntervalHours != 0 && app != adapters.AppRadarr {
+		errs.add("spec.importListOptions.syncIntervalHours", strconv.Itoa(spec.SyncIntervalHours), "only supported by Radarr")
+	}
+}
+
+// validateMaintenance checks the blocklist patterns compile, since a bad
+// pattern would otherwise only surface as a failed maintenance run
+func validateMaintenance(errs *FieldErrors, spec *arrv1alpha1.MaintenanceSpec) {
+	if spec == nil {
+		return
+	}
+	for i, pattern := range spec.BlocklistPatterns {
+		if _, err := regexp.Compile("(?i)" + pattern); err != nil {
+			errs.add(fmt.Sprintf("spec.maintenance.blocklistPatterns[%d]", i), pattern, "not a valid regular expression")
+		}
+	}
+}
+
+// typedImportList is a typed settings block set on an import list
+type typedImportList struct {
+	field    string
+	listType string
+	app      string
+}
+
+// typedImportListTypes lists the typed settings blocks set on an import list
+// with the app and implementation each one implies
+func typedImportListTypes(list arrv1alpha1.ImportListSpec) []typedImportList {
+	var set []typedImportList
+	if list.PlexWatchlist != nil {
+		set = append(set, typedImportList{"plexWatchlist", "PlexImport", adapters.AppSonarr})
+	}
+	if list.PlexRSS != nil {
+		set = append(set, typedImportList{"plexRss", "PlexRssImport", adapters.AppSonarr})
+	}
+	if list.TraktList != nil {
+		set = append(set, typedImportList{"traktList", "TraktListImport", adapters.AppSonarr})
+	}
+	if list.Custom != nil {
+		set = append(set, typedImportList{"custom", "CustomImport", adapters.AppSonarr})
+	}
+	if list.GoodreadsList != nil {
+		set = append(set, typedImportList{"goodreadsList", "GoodreadsListImportList", adapters.AppReadarr})
+	}
+	if list.GoodreadsOwnedBooks != nil {
+		set = append(set, typedImportList{"goodreadsOwnedBooks", "GoodreadsOwnedBooks", adapters.AppReadarr})
+	}
+	if list.LazyLibrarian != nil {
+		set = append(set, typedImportList{"lazyLibrarian", "LazyLibrarianImport", adapters.AppReadarr})
+	}
+	return set
+}
+
+// typedImportListFields lists the typed settings blocks an app supports
+func typedImportListFields(app string) []string {
+	switch app {
+	case adapters.AppSonarr:
+		return []string{"plexWatchlist", "plexRss", "traktList", "custom"}
+	case adapters.AppReadarr:
+		return []string{"goodreadsList", "goodreadsOwnedBooks", "lazyLibrarian"}
+	}
+	return nil
+}
+
+// joinOr joins names as "a, b or c"
+func joinOr(names []string) string {
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
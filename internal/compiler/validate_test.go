@@ -0,0 +1,383 @@
+package compiler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+)
+
+func TestCompileRadarrConfigInvalidFields(t *testing.T) {
+	config := &arrv1alpha1.RadarrConfig{
+		Spec: arrv1alpha1.RadarrConfigSpec{
+			Connection: arrv1alpha1.ConnectionSpec{URL: "http://radarr:7878"},
+			Quality:    &arrv1alpha1.VideoQualitySpec{Preset: "hd-1080", CloneFrom: "nebularr-movies"},
+			QualityProfiles: []arrv1alpha1.NamedVideoQualitySpec{
+				{Name: "kids", Preset: "720p"},
+				{Name: "archive", Preset: "uhd"},
+			},
+			Indexers: &arrv1alpha1.IndexersSpec{
+				Direct: []arrv1alpha1.DirectIndexer{
+					{Name: "nzbgeek", Categories: []string{"2040", "movies-hd", "films"}},
+				},
+			},
+		},
+	}
+
+	_, err := New().CompileRadarrConfig(context.Background(), config, nil, nil)
+
+	var fieldErrs FieldErrors
+	if !errors.As(err, &fieldErrs) {
+		t.Fatalf("expected FieldErrors, got %v", err)
+	}
+	var paths []string
+	for _, fe := range fieldErrs {
+		paths = append(paths, fe.Path)
+	}
+	expected := []string{
+		"spec.quality.preset",
+		"spec.quality.cloneFrom",
+		"spec.qualityProfiles[1].preset",
+		"spec.indexers.direct[0].categories[2]",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("paths = %v, want %v", paths, expected)
+	}
+	if fieldErrs[3].Value != "films" {
+		t.Errorf("value = %q, want %q", fieldErrs[3].Value, "films")
+	}
+}
+
+func TestCompileRadarrConfigValidFields(t *testing.T) {
+	config := &arrv1alpha1.RadarrConfig{
+		Spec: arrv1alpha1.RadarrConfigSpec{
+			Connection: arrv1alpha1.ConnectionSpec{URL: "http://radarr:7878"},
+			Quality:    &arrv1alpha1.VideoQualitySpec{Preset: "balanced", CloneFrom: "HD-1080p"},
+		},
+	}
+
+	ir, err := New().CompileRadarrConfig(context.Background(), config, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ir.Quality.Video.CloneFrom != "HD-1080p" {
+		t.Errorf("cloneFrom = %q, want %q", ir.Quality.Video.CloneFrom, "HD-1080p")
+	}
+}
+
+func TestValidateLanguage(t *testing.T) {
+	var errs FieldErrors
+	validateLanguage(&errs, adapters.AppSonarr, &arrv1alpha1.LanguageSpec{Profile: "Telugu"})
+	validateLanguage(&errs, adapters.AppRadarr, &arrv1alpha1.LanguageSpec{Profile: "Telugu"})
+	validateLanguage(&errs, adapters.AppRadarr, &arrv1alpha1.LanguageSpec{PreferOriginal: true})
+
+	expected := FieldErrors{{
+		Path:   "spec.language.profile",
+		Value:  "Telugu",
+		Reason: "unknown sonarr language",
+	}}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("expected %v, got %v", expected, errs)
+	}
+}
+
+func TestCustomFormatLanguages(t *testing.T) {
+	formats := []arrv1alpha1.CustomFormatSpec{{
+		Name: "languages",
+		Specifications: []arrv1alpha1.CustomFormatSpecificationSpec{
+			{Name: "German", Type: "LanguageSpecification", Value: "german"},
+			{Name: "By ID", Type: "LanguageSpecification", Value: "30"},
+			{Name: "Unknown", Type: "LanguageSpecification", Value: "klingon"},
+			{Name: "Unknown ID", Type: "LanguageSpecification", Value: "999"},
+			{Name: "Title", Type: "ReleaseTitleSpecification", Value: "klingon"},
+		},
+	}}
+
+	var errs FieldErrors
+	validateCustomFormatLanguages(&errs, adapters.AppRadarr, formats)
+	expected := FieldErrors{
+		{Path: "spec.customFormats[0].specifications[2].value", Value: "klingon", Reason: "unknown radarr language"},
+		{Path: "spec.customFormats[0].specifications[3].value", Value: "999", Reason: "unknown radarr language"},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("expected %v, got %v", expected, errs)
+	}
+
+	inputs := convertCustomFormats(formats[:1])
+	resolveCustomFormatLanguages(adapters.AppRadarr, inputs)
+	if got := inputs[0].Specifications[0].Value; got != "4" {
+		t.Errorf("expected german to resolve to 4, got %q", got)
+	}
+	if got := inputs[0].Specifications[1].Value; got != "30" {
+		t.Errorf("expected an ID to be kept, got %q", got)
+	}
+}
+
+func TestValidateProwlarrApplications(t *testing.T) {
+	var errs FieldErrors
+	validateProwlarrApplications(&errs, []arrv1alpha1.ProwlarrApplication{
+		{Name: "radarr", SyncCategories: []string{"movies", "2040"}},
+		{Name: "sonarr", SyncCategories: []string{"shows"}},
+	})
+
+	expected := FieldErrors{{
+		Path:   "spec.applications[1].syncCategories[0]",
+		Value:  "shows",
+		Reason: "unknown sync category, use a numeric Newznab ID or a known name such as movies-hd",
+	}}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("errs = %v, want %v", errs, expected)
+	}
+	if errs.err() == nil {
+		t.Error("expected err() to return the collected errors")
+	}
+	if (FieldErrors{}).err() != nil {
+		t.Error("expected err() to return nil when nothing was rejected")
+	}
+}
+
+func TestCompileLidarrConfigRejectsNamingPresetOfOtherApp(t *testing.T) {
+	config := &arrv1alpha1.LidarrConfig{
+		Spec: arrv1alpha1.LidarrConfigSpec{
+			Connection: arrv1alpha1.ConnectionSpec{URL: "http://lidarr:8686"},
+			Naming:     &arrv1alpha1.LidarrNamingSpec{NamingSpec: arrv1alpha1.NamingSpec{Preset: "audiobookshelf"}},
+		},
+	}
+
+	_, err := New().CompileLidarrConfig(context.Background(), config, nil, nil)
+
+	var fieldErrs FieldErrors
+	if !errors.As(err, &fieldErrs) || len(fieldErrs) != 1 {
+		t.Fatalf("expected one FieldError, got %v", err)
+	}
+	if fieldErrs[0].Path != "spec.naming.preset" || fieldErrs[0].Value != "audiobookshelf" {
+		t.Errorf("got %s = %q, want spec.naming.preset = %q", fieldErrs[0].Path, fieldErrs[0].Value, "audiobookshelf")
+	}
+}
+
+func TestValidateImportLists(t *testing.T) {
+	rss := &arrv1alpha1.PlexRSSImportSpec{URL: "https://rss.plex.tv/abc"}
+	custom := &arrv1alpha1.CustomImportListSpec{URL: "https://lists.example.com/shows.json"}
+	lists := []arrv1alpha1.ImportListSpec{
+		{Name: "imdb", Type: "ImdbImport", QualityProfile: "HD"},
+		{Name: "watchlist", PlexRSS: rss, QualityProfile: "HD"},
+		{Name: "untyped", QualityProfile: "HD"},
+		{Name: "both", PlexRSS: rss, Custom: custom, QualityProfile: "HD"},
+		{Name: "mismatch", Type: "PlexImport", Custom: custom, QualityProfile: "HD"},
+	}
+
+	var errs FieldErrors
+	validateImportLists(&errs, "sonarr", lists)
+	var paths []string
+	for _, fe := range errs {
+		paths = append(paths, fe.Path)
+	}
+	expected := []string{"spec.importLists[2].type", "spec.importLists[3]", "spec.importLists[4].type"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("paths = %v, want %v", paths, expected)
+	}
+
+	errs = nil
+	validateImportLists(&errs, "radarr", lists[:2])
+	if len(errs) != 1 || errs[0].Value != "plexRss" {
+		t.Errorf("errs = %v, want typed settings rejected for radarr", errs)
+	}
+}
+
+func TestValidateImportListOptions(t *testing.T) {
+	var errs FieldErrors
+	validateImportListOptions(&errs, "sonarr", &arrv1alpha1.ImportListOptionsSpec{
+		CleanLibraryLevel: "keepAndTag", CleanLibraryTag: "dropped",
+	})
+	if len(errs) != 0 {
+		t.Errorf("errs = %v, want none", errs)
+	}
+
+	validateImportListOptions(&errs, "sonarr", &arrv1alpha1.ImportListOptionsSpec{
+		CleanLibraryLevel: "keepAndTag", SyncIntervalHours: 12,
+	})
+	var paths []string
+	for _, fe := range errs {
+		paths = append(paths, fe.Path)
+	}
+	expected := []string{"spec.importListOptions.cleanLibraryTag", "spec.importListOptions.syncIntervalHours"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("paths = %v, want %v", paths, expected)
+	}
+
+	errs = nil
+	validateImportListOptions(&errs, "radarr", &arrv1alpha1.ImportListOptionsSpec{
+		CleanLibraryLevel: "keepAndTag", SyncIntervalHours: 12,
+	})
+	if len(errs) != 1 || errs[0].Path != "spec.importListOptions.cleanLibraryLevel" {
+		t.Errorf("errs = %v, want keepAndTag rejected for radarr", errs)
+	}
+}
+
+func TestValidateUI(t *testing.T) {
+	var errs FieldErrors
+	validateUI(&errs, "radarr", &arrv1alpha1.UISpec{UILanguage: "german", MovieRuntimeFormat: "minutes"})
+	if len(errs) != 0 {
+		t.Errorf("errs = %v, want none", errs)
+	}
+
+	validateUI(&errs, "sonarr", &arrv1alpha1.UISpec{UILanguage: "Original", MovieRuntimeFormat: "minutes"})
+	var paths []string
+	for _, fe := range errs {
+		paths = append(paths, fe.Path)
+	}
+	expected := []string{"spec.ui.uiLanguage", "spec.ui.movieRuntimeFormat"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("paths = %v, want %v", paths, expected)
+	}
+}
+
+func TestConvertUI(t *testing.T) {
+	input := convertUI("sonarr", &arrv1alpha1.UISpec{
+		FirstDayOfWeek: "monday", TimeFormat: "24h", Theme: "dark", UILanguage: "Portuguese (Brazil)",
+	})
+	expected := &UIInput{FirstDayOfWeek: ptr.To(1), TimeFormat: "HH:mm", Theme: "dark", UILanguage: 33}
+	if !reflect.DeepEqual(input, expected) {
+		t.Errorf("input = %+v, want %+v", input, expected)
+	}
+
+	// Radarr numbers the languages past Czech differently
+	if input := convertUI("radarr", &arrv1alpha1.UISpec{UILanguage: "Portuguese (Brazil)"}); input.UILanguage != 30 {
+		t.Errorf("uiLanguage = %d, want 30", input.UILanguage)
+	}
+}
+
+func TestApplySeriesDefaults(t *testing.T) {
+	lists := []arrv1alpha1.ImportListSpec{
+		{Name: "trakt", Type: "TraktListImport"},
+		{Name: "anime", Type: "ImdbImport", QualityProfile: "Anime", SeriesType: "anime", SeasonFolder: ptr.To(false)},
+	}
+	defaults := &arrv1alpha1.SeriesDefaultsSpec{
+		Monitor: "future", SeasonFolder: ptr.To(true), SeriesType: "standard", QualityProfile: "HD",
+	}
+
+	var errs FieldErrors
+	validateImportLists(&errs, "sonarr", lists)
+	if len(errs) != 1 || errs[0].Path != "spec.importLists[0].qualityProfile" {
+		t.Errorf("errs = %v, want the missing quality profile rejected without defaults", errs)
+	}
+
+	got := applySeriesDefaults(lists, defaults)
+	if got[0].QualityProfile != "HD" || got[0].ShouldMonitor != "future" || got[0].SeriesType != "standard" || !*got[0].SeasonFolder {
+		t.Errorf("list without settings = %+v, want the series defaults", got[0])
+	}
+	if got[1].QualityProfile != "Anime" || got[1].ShouldMonitor != "future" || got[1].SeriesType != "anime" || *got[1].SeasonFolder {
+		t.Errorf("list with settings = %+v, want its own settings kept", got[1])
+	}
+	if lists[0].QualityProfile != "" {
+		t.Error("applySeriesDefaults modified the spec")
+	}
+
+	errs = nil
+	validateImportLists(&errs, "sonarr", got)
+	if len(errs) != 0 {
+		t.Errorf("errs = %v, want none with defaults applied", errs)
+	}
+}
+
+func TestConvertTypedImportLists(t *testing.T) {
+	limit := 50
+	lists := []arrv1alpha1.ImportListSpec{{
+		Name: "trakt",
+		TraktList: &arrv1alpha1.TraktListImportSpec{
+			Username:             "alice",
+			ListName:             "shows",
+			AccessTokenSecretRef: arrv1alpha1.SecretKeySelector{Name: "trakt", Key: "accessToken"},
+			Limit:                &limit,
+		},
+	}}
+
+	inputs := convertImportLists(lists, map[string]string{"trakt/accessToken": "t0ken"})
+	if inputs[0].Type != "TraktListImport" {
+		t.Errorf("type = %q, want TraktListImport", inputs[0].Type)
+	}
+	trakt := inputs[0].TraktList
+	if trakt == nil || trakt.AccessToken != "t0ken" || trakt.Limit != 50 || trakt.ListName != "shows" {
+		t.Errorf("traktList = %+v", trakt)
+	}
+}
+
+func TestValidateReadarrImportLists(t *testing.T) {
+	goodreads := &arrv1alpha1.GoodreadsListImportSpec{ListID: 1}
+	lists := []arrv1alpha1.ImportListSpec{
+		{Name: "best", GoodreadsList: goodreads, QualityProfile: "books", ShouldMonitor: "specificBook"},
+		{Name: "watchlist", PlexRSS: &arrv1alpha1.PlexRSSImportSpec{URL: "https://rss.plex.tv/abc"}, QualityProfile: "books"},
+		{Name: "monitor", Type: "ReadarrImport", QualityProfile: "books", ShouldMonitor: "firstSeason"},
+		{Name: "both", GoodreadsList: goodreads, LazyLibrarian: &arrv1alpha1.LazyLibrarianImportSpec{URL: "http://ll:5299"}, QualityProfile: "books"},
+	}
+
+	var errs FieldErrors
+	validateImportLists(&errs, "readarr", lists)
+	var paths []string
+	for _, fe := range errs {
+		paths = append(paths, fe.Path)
+	}
+	expected := []string{"spec.importLists[1]", "spec.importLists[2].shouldMonitor", "spec.importLists[3]"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("paths = %v, want %v", paths, expected)
+	}
+
+	errs = nil
+	validateImportLists(&errs, "sonarr", lists[:1])
+	var values []string
+	for _, fe := range errs {
+		values = append(values, fe.Value)
+	}
+	if !reflect.DeepEqual(values, []string{"goodreadsList", "specificBook"}) {
+		t.Errorf("values = %v, want goodreadsList and specificBook rejected for sonarr", values)
+	}
+}
+
+func TestConvertReadarrImportLists(t *testing.T) {
+	lists := []arrv1alpha1.ImportListSpec{
+		{
+			Name: "owned",
+			GoodreadsOwnedBooks: &arrv1alpha1.GoodreadsOwnedBooksImportSpec{
+				SessionSecretRef: arrv1alpha1.LocalObjectReference{Name: "goodreads"},
+			},
+		},
+		{
+			Name: "lazylibrarian",
+			LazyLibrarian: &arrv1alpha1.LazyLibrarianImportSpec{
+				URL:             "http://lazylibrarian:5299",
+				APIKeySecretRef: arrv1alpha1.SecretKeySelector{Name: "ll", Key: "apiKey"},
+			},
+		},
+	}
+
+	inputs := convertImportLists(lists, map[string]string{
+		"goodreads/accessToken":       "token",
+		"goodreads/accessTokenSecret": "s3cret",
+		"goodreads/userId":            "42",
+		"goodreads/userName":          "reader",
+		"ll/apiKey":                   "k3y",
+	})
+	if inputs[0].Type != "GoodreadsOwnedBooks" || inputs[1].Type != "LazyLibrarianImport" {
+		t.Errorf("types = %q, %q", inputs[0].Type, inputs[1].Type)
+	}
+	session := inputs[0].GoodreadsOwnedBooks
+	if session == nil || session.AccessToken != "token" || session.AccessTokenSecret != "s3cret" || session.UserID != "42" || session.UserName != "reader" {
+		t.Errorf("goodreadsOwnedBooks = %+v", session)
+	}
+	if lazy := inputs[1].LazyLibrarian; lazy == nil || lazy.APIKey != "k3y" || lazy.URL != "http://lazylibrarian:5299" {
+		t.Errorf("lazyLibrarian = %+v", lazy)
+	}
+}
+
+func TestValidateMaintenance(t *testing.T) {
+	var errs FieldErrors
+	validateMaintenance(&errs, &arrv1alpha1.MaintenanceSpec{BlocklistPatterns: []string{`\bCAM\b`, "x265("}})
+	if len(errs) != 1 || errs[0].Path != "spec.maintenance.blocklistPatterns[1]" {
+		t.Errorf("errs = %v, want the unbalanced pattern rejected", errs)
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/discovery"
+)
+
+// API key discovery strategies
+const (
+	APIKeyDiscoveryFile = "file"
+	APIKeyDiscoveryExec = "exec"
+	APIKeyDiscoveryPVC  = "pvc"
+)
+
+// apiKeyRefreshedAtAnnotation records when the cached API key was last read from config.xml
+const apiKeyRefreshedAtAnnotation = "arr.rinzler.cloud/api-key-refreshed-at"
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods/exec,verbs=create
+// +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+
+// APIKeyCacheSecretName returns the name of the Secret caching a discovered API key
+func APIKeyCacheSecretName(configName, app string) string {
+	return fmt.Sprintf("%s-%s-api-key", configName, app)
+}
+
+// DiscoverAPIKey reads the API key from the app's config.xml and caches it in a
+// Secret owned by owner. The cached key is reused until the refresh interval has
+// passed; if config.xml cannot be read, the last cached key is used.
+// With bootstrap enabled and no cached key, a generated key is written to
+// config.xml first if the file does not exist yet.
+func (h *ReconcileHelper) DiscoverAPIKey(ctx context.Context, owner client.Object, conn *arrv1alpha1.ConnectionSpec) (string, error) {
+	log := logf.FromContext(ctx)
+
+	app, err := h.appForOwner(owner)
+	if err != nil {
+		return "", err
+	}
+
+	spec := arrv1alpha1.APIKeyDiscoverySpec{}
+	if conn.APIKeyDiscovery != nil {
+		spec = *conn.APIKeyDiscovery
+	}
+	interval := DefaultRequeueInterval
+	if spec.RefreshInterval != nil {
+		interval = spec.RefreshInterval.Duration
+	}
+
+	cache := &corev1.Secret{}
+	cacheKey := client.ObjectKey{Namespace: owner.GetNamespace(), Name: APIKeyCacheSecretName(owner.GetName(), app)}
+	var cached string
+	if err := h.Client.Get(ctx, cacheKey, cache); err == nil {
+		cached = string(cache.Data["apiKey"])
+		refreshedAt, perr := time.Parse(time.RFC3339, cache.Annotations[apiKeyRefreshedAtAnnotation])
+		if cached != "" && perr == nil && time.Since(refreshedAt) < interval {
+			return cached, nil
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("failed to get cached API key: %w", err)
+	}
+
+	// Fresh installs get a generated key; previews (no UID) must not write anything
+	var bootstrapKey string
+	if spec.Bootstrap && cached == "" && owner.GetUID() != "" {
+		if bootstrapKey, err = discovery.GenerateAPIKey(); err != nil {
+			return "", err
+		}
+	}
+
+	// config.xml is read in the app's cluster, the key is cached next to owner
+	target, err := h.clusterTarget(ctx, owner, conn)
+	if err != nil {
+		return "", err
+	}
+	apiKey, err := target.helper.readConfigXMLAPIKey(ctx, target.namespace, app, conn.ConfigPath, spec, bootstrapKey)
+	if err != nil {
+		if cached != "" {
+			log.Error(err, "API key discovery failed, using cached key", "secret", cacheKey.Name)
+			return cached, nil
+		}
+		if conn.APIKeyDiscovery == nil && errors.Is(err, fs.ErrNotExist) {
+			// Discovery was not asked for and there is no config.xml to read
+			log.V(1).Info("No API key configured and no config.xml found", "path", conn.ConfigPath)
+			return "", nil
+		}
+		return "", fmt.Errorf("API key discovery failed: %w", err)
+	}
+	if owner.GetUID() == "" {
+		// Not stored in the cluster (e.g. previewed by nebularr-plan), nothing can own the cache
+		return apiKey, nil
+	}
+	if cached != "" && apiKey != cached {
+		log.Info("API key in config.xml changed, updating cached key", "secret", cacheKey.Name)
+	}
+	if bootstrapKey != "" && apiKey == bootstrapKey {
+		log.Info("Bootstrapped API key for fresh install", "secret", cacheKey.Name)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: cacheKey.Name, Namespace: cacheKey.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.Client, secret, func() error {
+		if err := controllerutil.SetControllerReference(owner, secret, h.Client.Scheme()); err != nil {
+			return err
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[apiKeyRefreshedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		secret.Data = map[string][]byte{"apiKey": []byte(apiKey)}
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("failed to cache discovered API key: %w", err)
+	}
+
+	return apiKey, nil
+}
+
+// readConfigXMLAPIKey reads the API key from config.xml using the configured strategy.
+// A non-empty bootstrapKey is written to config.xml first if the file is missing.
+func (h *ReconcileHelper) readConfigXMLAPIKey(ctx context.Context, namespace, app, configPath string, spec arrv1alpha1.APIKeyDiscoverySpec, bootstrapKey string) (string, error) {
+	switch spec.Strategy {
+	case "", APIKeyDiscoveryFile:
+		if configPath == "" {
+			configPath = fmt.Sprintf("/%s-config/config.xml", app)
+		}
+		if bootstrapKey != "" {
+			return discovery.BootstrapAPIKeyInFile(configPath, bootstrapKey)
+		}
+		return discovery.DiscoverAPIKeyFromFile(configPath)
+
+	case APIKeyDiscoveryExec:
+		if bootstrapKey != "" {
+			// The app pod is already running, so it has written its own config.xml
+			return "", fmt.Errorf("apiKeyDiscovery.bootstrap is not supported by the exec strategy")
+		}
+		if len(spec.PodSelector) == 0 {
+			return "", fmt.Errorf("apiKeyDiscovery.podSelector is required for the exec strategy")
+		}
+		if configPath == "" {
+			configPath = "/config/config.xml"
+		}
+		clientset, err := h.clientset()
+		if err != nil {
+			return "", err
+		}
+		pod, err := discovery.FindRunningPod(ctx, h.Client, namespace, spec.PodSelector)
+		if err != nil {
+			return "", err
+		}
+		return discovery.DiscoverAPIKeyFromPod(ctx, h.RestConfig, clientset, pod, spec.Container, configPath)
+
+	case APIKeyDiscoveryPVC:
+		if spec.ClaimName == "" {
+			return "", fmt.Errorf("apiKeyDiscovery.claimName is required for the pvc strategy")
+		}
+		clientset, err := h.clientset()
+		if err != nil {
+			return "", err
+		}
+		if bootstrapKey != "" {
+			return discovery.BootstrapAPIKeyInPVC(ctx, h.Client, clientset, namespace, spec.ClaimName, configPath, bootstrapKey, nil)
+		}
+		return discovery.DiscoverAPIKeyFromPVC(ctx, h.Client, clientset, namespace, spec.ClaimName, configPath, nil)
+
+	default:
+		return "", fmt.Errorf("unknown API key discovery strategy %q", spec.Strategy)
+	}
+}
+
+// clientset builds a typed clientset for exec and log access
+func (h *ReconcileHelper) clientset() (kubernetes.Interface, error) {
+	if h.RestConfig == nil {
+		return nil, fmt.Errorf("API key discovery via the Kubernetes API requires a REST config")
+	}
+	return kubernetes.NewForConfig(h.RestConfig)
+}
+
+// appForOwner derives the app name (e.g. "radarr") from the owning config's kind
+func (h *ReconcileHelper) appForOwner(owner client.Object) (string, error) {
+	kind, err := h.kindOf(owner)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(strings.TrimSuffix(kind, "Config")), nil
+}
+
+// kindOf returns the kind of a typed object (e.g. "RadarrConfig")
+func (h *ReconcileHelper) kindOf(obj client.Object) (string, error) {
+	gvk, err := apiutil.GVKForObject(obj, h.Client.Scheme())
+	if err != nil {
+		return "", fmt.Errorf("failed to determine kind of %s: %w", obj.GetName(), err)
+	}
+	return gvk.Kind, nil
+}
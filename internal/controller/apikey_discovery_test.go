@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("API key discovery", func() {
+	ctx := context.Background()
+
+	It("caches the key from config.xml and picks up a rotated key", func() {
+		configPath := filepath.Join(GinkgoT().TempDir(), "config.xml")
+		writeConfig := func(apiKey string) {
+			content := "<Config><Port>7878</Port><ApiKey>" + apiKey + "</ApiKey></Config>"
+			Expect(os.WriteFile(configPath, []byte(content), 0o600)).To(Succeed())
+		}
+		writeConfig("first-key")
+
+		config := &arrv1alpha1.RadarrConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "discovery", Namespace: "default"},
+			Spec: arrv1alpha1.RadarrConfigSpec{
+				Connection: arrv1alpha1.ConnectionSpec{
+					URL:        "http://radarr.example.com:7878",
+					ConfigPath: configPath,
+					APIKeyDiscovery: &arrv1alpha1.APIKeyDiscoverySpec{
+						Strategy:        APIKeyDiscoveryFile,
+						RefreshInterval: &metav1.Duration{},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, config)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, config)).To(Succeed()) })
+
+		helper := NewReconcileHelper(k8sClient)
+		cacheKey := client.ObjectKey{Namespace: "default", Name: APIKeyCacheSecretName("discovery", "radarr")}
+
+		By("discovering the key and caching it in an owned Secret")
+		resolved, err := helper.ResolveConnectionSecrets(ctx, config, &config.Spec.Connection)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved["apiKey"]).To(Equal("first-key"))
+
+		cache := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, cacheKey, cache)).To(Succeed())
+		Expect(string(cache.Data["apiKey"])).To(Equal("first-key"))
+		Expect(metav1.IsControlledBy(cache, config)).To(BeTrue())
+
+		By("rotating the key in config.xml")
+		writeConfig("second-key")
+		resolved, err = helper.ResolveConnectionSecrets(ctx, config, &config.Spec.Connection)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved["apiKey"]).To(Equal("second-key"))
+
+		Expect(k8sClient.Get(ctx, cacheKey, cache)).To(Succeed())
+		Expect(string(cache.Data["apiKey"])).To(Equal("second-key"))
+
+		By("falling back to the cached key when config.xml is unreadable")
+		Expect(os.Remove(configPath)).To(Succeed())
+		resolved, err = helper.ResolveConnectionSecrets(ctx, config, &config.Spec.Connection)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved["apiKey"]).To(Equal("second-key"))
+	})
+
+	It("bootstraps a key for a fresh install without replacing an existing config.xml", func() {
+		configPath := filepath.Join(GinkgoT().TempDir(), "radarr", "config.xml")
+
+		config := &arrv1alpha1.RadarrConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "bootstrap", Namespace: "default"},
+			Spec: arrv1alpha1.RadarrConfigSpec{
+				Connection: arrv1alpha1.ConnectionSpec{
+					URL:        "http://radarr.example.com:7878",
+					ConfigPath: configPath,
+					APIKeyDiscovery: &arrv1alpha1.APIKeyDiscoverySpec{
+						Strategy:        APIKeyDiscoveryFile,
+						RefreshInterval: &metav1.Duration{},
+						Bootstrap:       true,
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, config)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, config)).To(Succeed()) })
+
+		helper := NewReconcileHelper(k8sClient)
+		cacheKey := client.ObjectKey{Namespace: "default", Name: APIKeyCacheSecretName("bootstrap", "radarr")}
+
+		By("writing a generated key into the missing config.xml")
+		resolved, err := helper.ResolveConnectionSecrets(ctx, config, &config.Spec.Connection)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved["apiKey"]).To(MatchRegexp("^[0-9a-f]{32}$"))
+
+		content, err := os.ReadFile(configPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("<ApiKey>" + resolved["apiKey"] + "</ApiKey>"))
+
+		cache := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, cacheKey, cache)).To(Succeed())
+		Expect(string(cache.Data["apiKey"])).To(Equal(resolved["apiKey"]))
+		Expect(metav1.IsControlledBy(cache, config)).To(BeTrue())
+
+		By("adopting the key the app wrote itself once the cache is gone")
+		Expect(k8sClient.Delete(ctx, cache)).To(Succeed())
+		Expect(os.WriteFile(configPath, []byte("<Config><ApiKey>app-key</ApiKey></Config>"), 0o600)).To(Succeed())
+		resolved, err = helper.ResolveConnectionSecrets(ctx, config, &config.Spec.Connection)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved["apiKey"]).To(Equal("app-key"))
+	})
+})
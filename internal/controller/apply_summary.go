@@ -0,0 +1,285 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+)
+
+// ApplySummaryPath is where the apply summary is served on the metrics server
+const ApplySummaryPath = "/api/v1/summary"
+
+// DriftedResource is a change the last diff of a config found
+type DriftedResource struct {
+	ResourceType string `json:"resourceType"`
+	Name         string `json:"name"`
+	Action       string `json:"action"` // create, update or delete
+}
+
+// applyRecord is what the operator remembers about the applies of one config
+type applyRecord struct {
+	applied   int
+	failed    int
+	lastApply *time.Time
+	drift     []DriftedResource
+	heldBack  bool
+}
+
+// ApplySummaries keeps per-config apply counts and the last drift in memory.
+// Counts start at zero when the operator starts and are not shared between replicas.
+type ApplySummaries struct {
+	mu      sync.RWMutex
+	records map[string]applyRecord
+}
+
+// NewApplySummaries creates an empty summary store
+func NewApplySummaries() *ApplySummaries {
+	return &ApplySummaries{records: make(map[string]applyRecord)}
+}
+
+// Record adds the result of a reconcile. Results without a diff (the reconcile
+// failed before it) leave the record unchanged.
+func (s *ApplySummaries) Record(kind, namespace, name string, result *adapters.ApplyResult, heldBack bool, now time.Time) {
+	if result == nil || result.Diff == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := summaryKey(kind, namespace, name)
+	rec := s.records[key]
+	rec.applied += result.Applied
+	rec.failed += result.Failed
+	if result.Applied > 0 || result.Failed > 0 {
+		rec.lastApply = &now
+	}
+	rec.drift = driftedResources(result.Diff)
+	rec.heldBack = heldBack && len(rec.drift) > 0
+	s.records[key] = rec
+}
+
+// Forget drops the record of a deleted config
+func (s *ApplySummaries) Forget(kind, namespace, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, summaryKey(kind, namespace, name))
+}
+
+// get returns the record of a config (the zero record if it has none yet)
+func (s *ApplySummaries) get(kind, namespace, name string) applyRecord {
+	if s == nil {
+		return applyRecord{}
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.records[summaryKey(kind, namespace, name)]
+}
+
+func summaryKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// driftedResources flattens a change set into the drift list
+func driftedResources(changes *adapters.ChangeSet) []DriftedResource {
+	var drift []DriftedResource
+	add := func(action string, list []adapters.Change) {
+		for _, c := range list {
+			drift = append(drift, DriftedResource{ResourceType: c.ResourceType, Name: c.Name, Action: action})
+		}
+	}
+	add("create", changes.Creates)
+	add("update", changes.Updates)
+	add("delete", changes.Deletes)
+	return drift
+}
+
+// recordApplySummary stores the outcome of a finished reconcile, if summaries are enabled
+func (o ControllerOptions) recordApplySummary(scheme *runtime.Scheme, obj client.Object, outcome *reconcileOutcome) {
+	if o.Summaries == nil {
+		return
+	}
+	o.Summaries.Record(objectKind(scheme, obj), obj.GetNamespace(), obj.GetName(), outcome.result, outcome.heldBack, time.Now())
+}
+
+// forgetApplySummary drops the summary of a deleted config
+func (o ControllerOptions) forgetApplySummary(scheme *runtime.Scheme, obj client.Object) {
+	if o.Summaries == nil {
+		return
+	}
+	o.Summaries.Forget(objectKind(scheme, obj), obj.GetNamespace(), obj.GetName())
+}
+
+// ConfigSummary is the apply summary of one *arr config
+type ConfigSummary struct {
+	Kind           string       `json:"kind"`
+	Namespace      string       `json:"namespace"`
+	Name           string       `json:"name"`
+	Connected      bool         `json:"connected"`
+	ServiceVersion string       `json:"serviceVersion,omitempty"`
+	Ready          bool         `json:"ready"`
+	Reason         string       `json:"reason,omitempty"`
+	Message        string       `json:"message,omitempty"`
+	LastReconcile  *metav1.Time `json:"lastReconcile,omitempty"`
+
+	// Applied and Failed count changes since the operator started
+	Applied   int        `json:"applied"`
+	Failed    int        `json:"failed"`
+	LastApply *time.Time `json:"lastApply,omitempty"`
+
+	// Drift is what the last reconcile found out of sync; DriftHeldBack is set
+	// while an apply window or rollout keeps it from being applied
+	Drift         []DriftedResource `json:"drift"`
+	DriftHeldBack bool              `json:"driftHeldBack,omitempty"`
+}
+
+// ApplySummaryResponse is the body served at ApplySummaryPath
+type ApplySummaryResponse struct {
+	GeneratedAt time.Time       `json:"generatedAt"`
+	Configs     []ConfigSummary `json:"configs"`
+}
+
+// ApplySummaryHandler serves a read-only JSON summary of every *arr config for
+// dashboards. ?namespace= limits it to one namespace.
+type ApplySummaryHandler struct {
+	Reader    client.Reader
+	Summaries *ApplySummaries
+}
+
+// ServeHTTP implements http.Handler
+func (h *ApplySummaryHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	configs, err := h.collect(req.Context(), req.URL.Query().Get("namespace"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(ApplySummaryResponse{GeneratedAt: time.Now().UTC(), Configs: configs})
+}
+
+// collect lists every *arr config and joins its status with the apply record
+func (h *ApplySummaryHandler) collect(ctx context.Context, namespace string) ([]ConfigSummary, error) {
+	var opts []client.ListOption
+	if namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	var configs []ConfigSummary
+	add := func(kind string, obj metav1.Object, connected bool, version string, lastReconcile *metav1.Time, conditions []metav1.Condition) {
+		rec := h.Summaries.get(kind, obj.GetNamespace(), obj.GetName())
+		summary := ConfigSummary{
+			Kind:           kind,
+			Namespace:      obj.GetNamespace(),
+			Name:           obj.GetName(),
+			Connected:      connected,
+			ServiceVersion: version,
+			LastReconcile:  lastReconcile,
+			Applied:        rec.applied,
+			Failed:         rec.failed,
+			LastApply:      rec.lastApply,
+			Drift:          rec.drift,
+			DriftHeldBack:  rec.heldBack,
+		}
+		if summary.Drift == nil {
+			summary.Drift = []DriftedResource{}
+		}
+		if ready := meta.FindStatusCondition(conditions, ConditionTypeReady); ready != nil {
+			summary.Ready = ready.Status == metav1.ConditionTrue
+			summary.Reason = ready.Reason
+			summary.Message = ready.Message
+		}
+		configs = append(configs, summary)
+	}
+
+	radarr := &arrv1alpha1.RadarrConfigList{}
+	if err := h.Reader.List(ctx, radarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list RadarrConfigs: %w", err)
+	}
+	for i := range radarr.Items {
+		c := &radarr.Items[i]
+		add("RadarrConfig", c, c.Status.Connected, c.Status.ServiceVersion, c.Status.LastReconcile, c.Status.Conditions)
+	}
+
+	sonarr := &arrv1alpha1.SonarrConfigList{}
+	if err := h.Reader.List(ctx, sonarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list SonarrConfigs: %w", err)
+	}
+	for i := range sonarr.Items {
+		c := &sonarr.Items[i]
+		add("SonarrConfig", c, c.Status.Connected, c.Status.ServiceVersion, c.Status.LastReconcile, c.Status.Conditions)
+	}
+
+	lidarr := &arrv1alpha1.LidarrConfigList{}
+	if err := h.Reader.List(ctx, lidarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list LidarrConfigs: %w", err)
+	}
+	for i := range lidarr.Items {
+		c := &lidarr.Items[i]
+		add("LidarrConfig", c, c.Status.Connected, c.Status.ServiceVersion, c.Status.LastReconcile, c.Status.Conditions)
+	}
+
+	readarr := &arrv1alpha1.ReadarrConfigList{}
+	if err := h.Reader.List(ctx, readarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list ReadarrConfigs: %w", err)
+	}
+	for i := range readarr.Items {
+		c := &readarr.Items[i]
+		add("ReadarrConfig", c, c.Status.Connected, c.Status.ServiceVersion, c.Status.LastReconcile, c.Status.Conditions)
+	}
+
+	prowlarr := &arrv1alpha1.ProwlarrConfigList{}
+	if err := h.Reader.List(ctx, prowlarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list ProwlarrConfigs: %w", err)
+	}
+	for i := range prowlarr.Items {
+		c := &prowlarr.Items[i]
+		add("ProwlarrConfig", c, c.Status.Connected, c.Status.ServiceVersion, c.Status.LastReconcile, c.Status.Conditions)
+	}
+
+	sort.Slice(configs, func(i, j int) bool {
+		if configs[i].Namespace != configs[j].Namespace {
+			return configs[i].Namespace < configs[j].Namespace
+		}
+		if configs[i].Kind != configs[j].Kind {
+			return configs[i].Kind < configs[j].Kind
+		}
+		return configs[i].Name < configs[j].Name
+	})
+	if configs == nil {
+		configs = []ConfigSummary{}
+	}
+	return configs, nil
+}
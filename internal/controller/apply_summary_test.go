@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+)
+
+var _ = Describe("Apply summary", func() {
+	It("accumulates counts and keeps the last drift", func() {
+		s := NewApplySummaries()
+		now := time.Now()
+
+		s.Record("RadarrConfig", "media", "radarr", &adapters.ApplyResult{
+			Applied: 2,
+			Failed:  1,
+			Diff: &adapters.ChangeSet{
+				Creates: []adapters.Change{{ResourceType: "Tag", Name: "4k"}},
+				Updates: []adapters.Change{{ResourceType: "QualityProfile", Name: "HD"}, {ResourceType: "Indexer", Name: "nzbgeek"}},
+			},
+		}, false, now)
+		s.Record("RadarrConfig", "media", "radarr", &adapters.ApplyResult{
+			Diff: &adapters.ChangeSet{Deletes: []adapters.Change{{ResourceType: "Tag", Name: "old"}}},
+		}, true, now.Add(time.Minute))
+
+		rec := s.get("RadarrConfig", "media", "radarr")
+		Expect(rec.applied).To(Equal(2))
+		Expect(rec.failed).To(Equal(1))
+		Expect(*rec.lastApply).To(Equal(now))
+		Expect(rec.drift).To(Equal([]DriftedResource{{ResourceType: "Tag", Name: "old", Action: "delete"}}))
+		Expect(rec.heldBack).To(BeTrue())
+
+		By("ignoring reconciles that failed before the diff")
+		s.Record("RadarrConfig", "media", "radarr", nil, false, now)
+		Expect(s.get("RadarrConfig", "media", "radarr").drift).To(HaveLen(1))
+
+		s.Forget("RadarrConfig", "media", "radarr")
+		Expect(s.get("RadarrConfig", "media", "radarr").applied).To(BeZero())
+	})
+
+	It("serves configs joined with their apply records", func() {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "apply-summary"}}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+		radarr := &arrv1alpha1.RadarrConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "radarr", Namespace: ns.Name},
+			Spec: arrv1alpha1.RadarrConfigSpec{
+				Connection: arrv1alpha1.ConnectionSpec{URL: "http://radarr.example.com:7878"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, radarr)).To(Succeed())
+		defer func() { Expect(k8sClient.Delete(ctx, radarr)).To(Succeed()) }()
+
+		radarr.Status.Connected = true
+		radarr.Status.ServiceVersion = "5.14.0"
+		radarr.Status.Conditions = []metav1.Condition{{
+			Type:               ConditionTypeReady,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Synced",
+			Message:            "Applied 1 changes",
+			LastTransitionTime: metav1.Now(),
+		}}
+		Expect(k8sClient.Status().Update(ctx, radarr)).To(Succeed())
+
+		summaries := NewApplySummaries()
+		summaries.Record("RadarrConfig", ns.Name, "radarr", &adapters.ApplyResult{
+			Applied: 1,
+			Diff:    &adapters.ChangeSet{Updates: []adapters.Change{{ResourceType: "QualityProfile", Name: "HD"}}},
+		}, false, time.Now())
+		handler := &ApplySummaryHandler{Reader: k8sClient, Summaries: summaries}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ApplySummaryPath+"?namespace="+ns.Name, nil))
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		var body ApplySummaryResponse
+		Expect(json.Unmarshal(rec.Body.Bytes(), &body)).To(Succeed())
+		Expect(body.Configs).To(HaveLen(1))
+		summary := body.Configs[0]
+		Expect(summary.Kind).To(Equal("RadarrConfig"))
+		Expect(summary.Connected).To(BeTrue())
+		Expect(summary.ServiceVersion).To(Equal("5.14.0"))
+		Expect(summary.Ready).To(BeTrue())
+		Expect(summary.Reason).To(Equal("Synced"))
+		Expect(summary.Applied).To(Equal(1))
+		Expect(summary.Drift).To(Equal([]DriftedResource{{ResourceType: "QualityProfile", Name: "HD", Action: "update"}}))
+
+		By("rejecting writes")
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, ApplySummaryPath, nil))
+		Expect(rec.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
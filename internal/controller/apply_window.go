@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/schedule"
+)
+
+// ConditionTypePendingChanges is set while changes are held back until the next apply window
+const ConditionTypePendingChanges = "PendingChanges"
+
+// ApplyWindowState is the evaluated apply window at a point in time
+type ApplyWindowState struct {
+	// Open is true when changes may be applied (always true without an apply window)
+	Open bool
+
+	// NextOpen is when the next window opens (zero when open or none within a year)
+	NextOpen time.Time
+
+	// HeldBy names the RolloutPolicy holding changes back until it releases this config
+	HeldBy string
+
+	// Observe is set for configs with spec.observe, whose changes are never applied
+	Observe bool
+}
+
+// EvaluateApplyWindow evaluates spec.reconciliation.applyWindow at now
+func EvaluateApplyWindow(spec *arrv1alpha1.ReconciliationSpec, now time.Time) (ApplyWindowState, error) {
+	if spec == nil || spec.ApplyWindow == nil || len(spec.ApplyWindow.Windows) == 0 {
+		return ApplyWindowState{Open: true}, nil
+	}
+
+	loc := time.UTC
+	if tz := spec.ApplyWindow.Timezone; tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(tz); err != nil {
+			return ApplyWindowState{}, fmt.Errorf("invalid apply window timezone %q: %w", tz, err)
+		}
+	}
+	now = now.In(loc)
+
+	state := ApplyWindowState{}
+	for _, w := range spec.ApplyWindow.Windows {
+		cron, err := schedule.ParseCron(w.Schedule)
+		if err != nil {
+			return ApplyWindowState{}, fmt.Errorf("invalid apply window: %w", err)
+		}
+
+		window := schedule.Window{Start: cron, Duration: w.Duration.Duration}
+		if window.Open(now) {
+			return ApplyWindowState{Open: true}, nil
+		}
+		if next := window.NextOpen(now); !next.IsZero() && (state.NextOpen.IsZero() || next.Before(state.NextOpen)) {
+			state.NextOpen = next
+		}
+	}
+	return state, nil
+}
+
+// PendingMessage describes held back changes for the PendingChanges condition
+func (s ApplyWindowState) PendingMessage(changes string) string {
+	if s.Observe {
+		return fmt.Sprintf("%s not applied: spec.observe is set", changes)
+	}
+	if s.HeldBy != "" {
+		return fmt.Sprintf("%s pending until rollout policy %s releases this config", changes, s.HeldBy)
+	}
+	if s.NextOpen.IsZero() {
+		return fmt.Sprintf("%s pending: no apply window scheduled within a year", changes)
+	}
+	return fmt.Sprintf("%s pending until the apply window opens at %s", changes, s.NextOpen.Format(time.RFC3339))
+}
+
+// pendingReasons returns the PendingChanges and Synced condition reasons and
+// what held back changes are waiting for
+func (s ApplyWindowState) pendingReasons() (pendingReason, syncedReason, waitingFor string) {
+	if s.Observe {
+		return "ObserveOnly", "Drifted", "spec.observe to be unset"
+	}
+	if s.HeldBy != "" {
+		return "WaitingForRollout", "PendingRollout", "rollout"
+	}
+	return "OutsideApplyWindow", "PendingApplyWindow", "apply window"
+}
+
+// RequeueAfter shortens interval so the next reconcile runs when the window opens
+func (s ApplyWindowState) RequeueAfter(interval time.Duration, now time.Time) time.Duration {
+	if s.Open || s.NextOpen.IsZero() {
+		return interval
+	}
+	if untilOpen := s.NextOpen.Sub(now); untilOpen > 0 && untilOpen < interval {
+		return untilOpen
+	}
+	return interval
+}
+
+// clearPendingChanges removes the PendingChanges condition once nothing is held back
+func (h *ReconcileHelper) clearPendingChanges(status ConfigStatus) {
+	conditions := status.GetConditions()
+	meta.RemoveStatusCondition(&conditions, ConditionTypePendingChanges)
+	status.SetConditions(conditions)
+}
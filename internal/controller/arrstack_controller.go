@@ -0,0 +1,602 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+)
+
+// ConditionTypeDownloadClientsWired reports whether every client of an ArrStack's
+// download stack is added to the apps
+const ConditionTypeDownloadClientsWired = "DownloadClientsWired"
+
+// ArrStackReconciler generates the ProwlarrConfig, RadarrConfig, SonarrConfig,
+// LidarrConfig and DownloadStackConfig declared by an ArrStack and rolls up
+// their readiness
+type ArrStackReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	Options ControllerOptions
+}
+
+// arrStackComponent is a config generated by an ArrStack
+type arrStackComponent struct {
+	kind string
+
+	// obj is the config, with only name and namespace set until it is fetched
+	obj client.Object
+
+	// apply sets the generated spec on obj; nil when the stack doesn't declare the component
+	apply func()
+
+	// conditions returns the status conditions of obj
+	conditions func() []metav1.Condition
+}
+
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=arrstacks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=arrstacks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=arrstacks/finalizers,verbs=update
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=prowlarrconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=radarrconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=sonarrconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=lidarrconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=downloadstackconfigs,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile creates, updates and deletes the configs of an ArrStack
+func (r *ArrStackReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	stack := &arrv1alpha1.ArrStack{}
+	if err := r.Get(ctx, req.NamespacedName, stack); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !stack.DeletionTimestamp.IsZero() {
+		// The generated configs are garbage collected through their owner reference
+		return ctrl.Result{}, nil
+	}
+
+	var components []arrv1alpha1.ArrStackComponentStatus
+	var notReady []string
+	for _, c := range arrStackComponents(stack) {
+		status, err := r.reconcileComponent(ctx, stack, c)
+		if err != nil {
+			log.Error(err, "Failed to reconcile ArrStack component", "kind", c.kind, "name", c.obj.GetName())
+			meta.SetStatusCondition(&stack.Status.Conditions, metav1.Condition{
+				Type:               ConditionTypeReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             "ComponentFailed",
+				Message:            fmt.Sprintf("%s %s: %v", c.kind, c.obj.GetName(), err),
+				ObservedGeneration: stack.Generation,
+			})
+			if statusErr := r.Status().Update(ctx, stack); statusErr != nil {
+				log.Error(statusErr, "Failed to update status")
+			}
+			return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+		}
+		if status == nil {
+			continue
+		}
+		components = append(components, *status)
+		if !status.Ready {
+			notReady = append(notReady, fmt.Sprintf("%s %s", status.Kind, status.Name))
+		}
+	}
+
+	cond := metav1.Condition{
+		Type:               ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ComponentsReady",
+		Message:            fmt.Sprintf("All %d components are Ready", len(components)),
+		ObservedGeneration: stack.Generation,
+	}
+	switch {
+	case len(components) == 0:
+		cond.Status, cond.Reason, cond.Message = metav1.ConditionFalse, "NoComponents", "The stack declares no apps"
+	case len(notReady) > 0:
+		cond.Status, cond.Reason = metav1.ConditionFalse, "ComponentsNotReady"
+		cond.Message = "Not Ready: " + strings.Join(notReady, ", ")
+	}
+	meta.SetStatusCondition(&stack.Status.Conditions, cond)
+	setDownloadClientsWired(stack)
+	stack.Status.Components = components
+	stack.Status.ObservedGeneration = stack.Generation
+
+	if err := r.Status().Update(ctx, stack); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The configs report their own progress through the owner watches; the
+	// periodic requeue only guards against missed events
+	return ctrl.Result{RequeueAfter: r.Options.requeueAfter(10 * time.Minute)}, nil
+}
+
+// setDownloadClientsWired reports whether every client of the stack's download
+// stack got a download client in the apps. It is removed without a download stack.
+func setDownloadClientsWired(stack *arrv1alpha1.ArrStack) {
+	if stack.Spec.DownloadStack == nil {
+		meta.RemoveStatusCondition(&stack.Status.Conditions, ConditionTypeDownloadClientsWired)
+		return
+	}
+
+	cond := metav1.Condition{
+		Type:               ConditionTypeDownloadClientsWired,
+		Status:             metav1.ConditionTrue,
+		Reason:             "AllClientsWired",
+		Message:            "Every download stack client is added to the apps",
+		ObservedGeneration: stack.Generation,
+	}
+	if skipped := arrStackSkippedClients(stack); len(skipped) > 0 {
+		cond.Status, cond.Reason = metav1.ConditionFalse, "MissingURL"
+		cond.Message = "Not added to the apps, no connection.url: " + strings.Join(skipped, ", ")
+	}
+	meta.SetStatusCondition(&stack.Status.Conditions, cond)
+}
+
+// reconcileComponent brings one generated config in line with the stack. It
+// returns nil when the component is not declared.
+func (r *ArrStackReconciler) reconcileComponent(ctx context.Context, stack *arrv1alpha1.ArrStack, c arrStackComponent) (*arrv1alpha1.ArrStackComponentStatus, error) {
+	status := &arrv1alpha1.ArrStackComponentStatus{Kind: c.kind, Name: c.obj.GetName()}
+
+	err := r.Get(ctx, client.ObjectKeyFromObject(c.obj), c.obj)
+	switch {
+	case apierrors.IsNotFound(err):
+		if c.apply == nil {
+			return nil, nil
+		}
+	case err != nil:
+		return nil, err
+	case !metav1.IsControlledBy(c.obj, stack):
+		// Never take over a config someone else created under the same name
+		if c.apply == nil {
+			return nil, nil
+		}
+		status.Message = fmt.Sprintf("%s %s already exists and is not managed by this ArrStack", c.kind, c.obj.GetName())
+		return status, nil
+	case c.apply == nil:
+		// Dropped from the stack
+		return nil, client.IgnoreNotFound(r.Delete(ctx, c.obj))
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, c.obj, func() error {
+		c.apply()
+		return controllerutil.SetControllerReference(stack, c.obj, r.Scheme)
+	}); err != nil {
+		return nil, err
+	}
+
+	status.Message = "Waiting for the first reconcile"
+	if ready := meta.FindStatusCondition(c.conditions(), ConditionTypeReady); ready != nil && ready.ObservedGeneration == c.obj.GetGeneration() {
+		status.Ready = ready.Status == metav1.ConditionTrue
+		status.Message = ready.Message
+	}
+	return status, nil
+}
+
+// arrStackChildName is the name of a config generated by stack
+func arrStackChildName(stack *arrv1alpha1.ArrStack, suffix string) string {
+	return stack.Name + "-" + suffix
+}
+
+// arrStackComponents lists every config an ArrStack can generate, in the order they are reconciled
+func arrStackComponents(stack *arrv1alpha1.ArrStack) []arrStackComponent {
+	objectMeta := func(suffix string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: arrStackChildName(stack, suffix), Namespace: stack.Namespace}
+	}
+
+	prowlarr := &arrv1alpha1.ProwlarrConfig{ObjectMeta: objectMeta("prowlarr")}
+	radarr := &arrv1alpha1.RadarrConfig{ObjectMeta: objectMeta("radarr")}
+	sonarr := &arrv1alpha1.SonarrConfig{ObjectMeta: objectMeta("sonarr")}
+	lidarr := &arrv1alpha1.LidarrConfig{ObjectMeta: objectMeta("lidarr")}
+	downloads := &arrv1alpha1.DownloadStackConfig{ObjectMeta: objectMeta("downloads")}
+
+	components := []arrStackComponent{
+		{kind: "ProwlarrConfig", obj: prowlarr, conditions: func() []metav1.Condition { return prowlarr.Status.Conditions }},
+		{kind: "RadarrConfig", obj: radarr, conditions: func() []metav1.Condition { return radarr.Status.Conditions }},
+		{kind: "SonarrConfig", obj: sonarr, conditions: func() []metav1.Condition { return sonarr.Status.Conditions }},
+		{kind: "LidarrConfig", obj: lidarr, conditions: func() []metav1.Condition { return lidarr.Status.Conditions }},
+		{kind: "DownloadStackConfig", obj: downloads, conditions: func() []metav1.Condition { return downloads.Status.Conditions }},
+	}
+	// Generated specs carry the defaults the defaulting webhook would store,
+	// so they compare equal to the stored configs and aren't rewritten
+	if stack.Spec.Prowlarr != nil {
+		components[0].apply = func() {
+			prowlarr.Spec = buildArrStackProwlarr(stack)
+			compiler.DefaultProwlarrSpec(&prowlarr.Spec)
+		}
+	}
+	if stack.Spec.Radarr != nil {
+		components[1].apply = func() {
+			radarr.Spec = buildArrStackRadarr(stack)
+			compiler.DefaultRadarrSpec(&radarr.Spec)
+		}
+	}
+	if stack.Spec.Sonarr != nil {
+		components[2].apply = func() {
+			sonarr.Spec = buildArrStackSonarr(stack)
+			compiler.DefaultSonarrSpec(&sonarr.Spec)
+		}
+	}
+	if stack.Spec.Lidarr != nil {
+		components[3].apply = func() {
+			lidarr.Spec = buildArrStackLidarr(stack)
+			compiler.DefaultLidarrSpec(&lidarr.Spec)
+		}
+	}
+	if stack.Spec.DownloadStack != nil {
+		components[4].apply = func() { downloads.Spec = buildArrStackDownloadStack(stack) }
+	}
+	return components
+}
+
+// arrStackDefaults returns the stack defaults, never nil
+func arrStackDefaults(stack *arrv1alpha1.ArrStack) arrv1alpha1.ArrStackDefaults {
+	if stack.Spec.Defaults == nil {
+		return arrv1alpha1.ArrStackDefaults{}
+	}
+	return *stack.Spec.Defaults.DeepCopy()
+}
+
+// buildArrStackProwlarr generates the ProwlarrConfig spec of a stack
+func buildArrStackProwlarr(stack *arrv1alpha1.ArrStack) arrv1alpha1.ProwlarrConfigSpec {
+	in := stack.Spec.Prowlarr.DeepCopy()
+	defaults := arrStackDefaults(stack)
+	return arrv1alpha1.ProwlarrConfigSpec{
+		Connection:     in.Connection,
+		Indexers:       in.Indexers,
+		AppProfiles:    in.AppProfiles,
+		Proxies:        in.Proxies,
+		IndexerHealth:  in.IndexerHealth,
+		Authentication: defaults.Authentication,
+		Reconciliation: defaults.Reconciliation,
+	}
+}
+
+// buildArrStackRadarr generates the RadarrConfig spec of a stack
+func buildArrStackRadarr(stack *arrv1alpha1.ArrStack) arrv1alpha1.RadarrConfigSpec {
+	in := stack.Spec.Radarr.DeepCopy()
+	defaults := arrStackDefaults(stack)
+	spec := arrv1alpha1.RadarrConfigSpec{
+		Connection:         in.Connection,
+		Quality:            in.Quality,
+		DownloadClients:    arrStackDownloadClients(stack, arrStackCategory(in.Category, "radarr")),
+		RemotePathMappings: in.RemotePathMappings,
+		Indexers:           arrStackIndexers(stack),
+		RootFolders:        in.RootFolders,
+		Authentication:     defaults.Authentication,
+		Reconciliation:     defaults.Reconciliation,
+		Notifications:      arrStackNotifications(stack, "radarr"),
+	}
+	if spec.Quality == nil {
+		spec.Quality = defaults.Quality
+	}
+	return spec
+}
+
+// buildArrStackSonarr generates the SonarrConfig spec of a stack
+func buildArrStackSonarr(stack *arrv1alpha1.ArrStack) arrv1alpha1.SonarrConfigSpec {
+	in := stack.Spec.Sonarr.DeepCopy()
+	defaults := arrStackDefaults(stack)
+	spec := arrv1alpha1.SonarrConfigSpec{
+		Connection:         in.Connection,
+		Quality:            in.Quality,
+		DownloadClients:    arrStackDownloadClients(stack, arrStackCategory(in.Category, "sonarr")),
+		RemotePathMappings: in.RemotePathMappings,
+		Indexers:           arrStackIndexers(stack),
+		RootFolders:        in.RootFolders,
+		Authentication:     defaults.Authentication,
+		Reconciliation:     defaults.Reconciliation,
+		Notifications:      arrStackNotifications(stack, "sonarr"),
+	}
+	if spec.Quality == nil {
+		spec.Quality = defaults.Quality
+	}
+	return spec
+}
+
+// buildArrStackLidarr generates the LidarrConfig spec of a stack
+func buildArrStackLidarr(stack *arrv1alpha1.ArrStack) arrv1alpha1.LidarrConfigSpec {
+	in := stack.Spec.Lidarr.DeepCopy()
+	defaults := arrStackDefaults(stack)
+	return arrv1alpha1.LidarrConfigSpec{
+		Connection:         in.Connection,
+		Quality:            in.Quality,
+		DownloadClients:    arrStackDownloadClients(stack, arrStackCategory(in.Category, "lidarr")),
+		RemotePathMappings: in.RemotePathMappings,
+		Indexers:           arrStackIndexers(stack),
+		RootFolders:        in.RootFolders,
+		Authentication:     defaults.Authentication,
+		Reconciliation:     defaults.Reconciliation,
+		Notifications:      arrStackNotifications(stack, "lidarr"),
+	}
+}
+
+// buildArrStackDownloadStack generates the DownloadStackConfig spec of a
+// stack, declaring the category of every app in each download client
+func buildArrStackDownloadStack(stack *arrv1alpha1.ArrStack) arrv1alpha1.DownloadStackConfigSpec {
+	spec := *stack.Spec.DownloadStack.DeepCopy()
+	if spec.Reconciliation == nil {
+		spec.Reconciliation = arrStackDefaults(stack).Reconciliation
+	}
+
+	for _, category := range arrStackCategories(stack) {
+		declareQBittorrent := func(qb *arrv1alpha1.QBittorrentSpec) {
+			if !slices.ContainsFunc(qb.Categories, func(c arrv1alpha1.QBittorrentCategorySpec) bool { return c.Name == category }) {
+				qb.Categories = append(qb.Categories, arrv1alpha1.QBittorrentCategorySpec{Name: category})
+			}
+		}
+		declareDeluge := func(d *arrv1alpha1.DelugeSpec) {
+			// Deluge labels are always lowercase
+			if label := strings.ToLower(category); !slices.Contains(d.Labels, label) {
+				d.Labels = append(d.Labels, label)
+			}
+		}
+		declareSABnzbd := func(sab *arrv1alpha1.SABnzbdSpec) {
+			if !slices.ContainsFunc(sab.Categories, func(c arrv1alpha1.SABnzbdCategorySpec) bool { return c.Name == category }) {
+				sab.Categories = append(sab.Categories, arrv1alpha1.SABnzbdCategorySpec{Name: category})
+			}
+		}
+		declareNZBGet := func(nzbget *arrv1alpha1.NZBGetSpec) {
+			if !slices.ContainsFunc(nzbget.Categories, func(c arrv1alpha1.NZBGetCategorySpec) bool {
+				return c.Name == category || slices.Contains(c.Aliases, category)
+			}) {
+				nzbget.Categories = append(nzbget.Categories, arrv1alpha1.NZBGetCategorySpec{Name: category})
+			}
+		}
+
+		if spec.QBittorrent != nil {
+			declareQBittorrent(spec.QBittorrent)
+		}
+		for i := range spec.QBittorrentInstances {
+			declareQBittorrent(&spec.QBittorrentInstances[i].QBittorrentSpec)
+		}
+		if spec.Deluge != nil {
+			declareDeluge(spec.Deluge)
+		}
+		for i := range spec.DelugeInstances {
+			declareDeluge(&spec.DelugeInstances[i].DelugeSpec)
+		}
+		if spec.SABnzbd != nil {
+			declareSABnzbd(spec.SABnzbd)
+		}
+		for i := range spec.SABnzbdInstances {
+			declareSABnzbd(&spec.SABnzbdInstances[i].SABnzbdSpec)
+		}
+		if spec.NZBGet != nil {
+			declareNZBGet(spec.NZBGet)
+		}
+		for i := range spec.NZBGetInstances {
+			declareNZBGet(&spec.NZBGetInstances[i].NZBGetSpec)
+		}
+	}
+	return spec
+}
+
+// arrStackCategory returns the download category of an app
+func arrStackCategory(category, appName string) string {
+	if category != "" {
+		return category
+	}
+	return appName
+}
+
+// arrStackCategories lists the download categories of the stack's apps
+func arrStackCategories(stack *arrv1alpha1.ArrStack) []string {
+	var categories []string
+	add := func(category string) {
+		if !slices.Contains(categories, category) {
+			categories = append(categories, category)
+		}
+	}
+	if stack.Spec.Radarr != nil {
+		add(arrStackCategory(stack.Spec.Radarr.Category, "radarr"))
+	}
+	if stack.Spec.Sonarr != nil {
+		add(arrStackCategory(stack.Spec.Sonarr.Category, "sonarr"))
+	}
+	if stack.Spec.Lidarr != nil {
+		add(arrStackCategory(stack.Spec.Lidarr.Category, "lidarr"))
+	}
+	return categories
+}
+
+// arrStackNotifications returns the stack's global notifications for app
+// (radarr, sonarr or lidarr), with the event preset turned into the app's
+// event flags. Flags set on a notification are kept.
+func arrStackNotifications(stack *arrv1alpha1.ArrStack, app string) []arrv1alpha1.NotificationSpec {
+	if len(stack.Spec.GlobalNotifications) == 0 {
+		return nil
+	}
+
+	notifications := make([]arrv1alpha1.NotificationSpec, 0, len(stack.Spec.GlobalNotifications))
+	for _, global := range stack.Spec.GlobalNotifications {
+		n := *global.NotificationSpec.DeepCopy()
+
+		var events []**bool
+		if global.Events != "health" {
+			events = append(events, &n.OnGrab, &n.OnUpgrade, &n.OnRename)
+			switch app {
+			case "radarr":
+				events = append(events, &n.OnDownload, &n.OnMovieAdded, &n.OnMovieDelete,
+					&n.OnMovieFileDelete, &n.OnMovieFileDeleteForUpgrade)
+			case "sonarr":
+				events = append(events, &n.OnDownload, &n.OnSeriesAdd, &n.OnSeriesDelete,
+					&n.OnEpisodeFileDelete, &n.OnEpisodeFileDeleteForUpgrade)
+			case "lidarr":
+				events = append(events, &n.OnReleaseImport, &n.OnArtistAdd, &n.OnArtistDelete,
+					&n.OnAlbumDelete, &n.OnTrackRetag)
+			}
+		}
+		if global.Events != "library" {
+			events = append(events, &n.OnHealthIssue, &n.OnHealthRestored,
+				&n.OnApplicationUpdate, &n.OnManualInteractionRequired)
+			if app == "lidarr" {
+				events = append(events, &n.OnDownloadFailure, &n.OnImportFailure)
+			}
+		}
+		for _, event := range events {
+			if *event == nil {
+				*event = ptr.To(true)
+			}
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications
+}
+
+// arrStackIndexers points an app at the stack's Prowlarr
+func arrStackIndexers(stack *arrv1alpha1.ArrStack) *arrv1alpha1.IndexersSpec {
+	if stack.Spec.Prowlarr == nil {
+		return nil
+	}
+	return &arrv1alpha1.IndexersSpec{
+		ProwlarrRef: &arrv1alpha1.ProwlarrRef{Name: arrStackChildName(stack, "prowlarr")},
+	}
+}
+
+// arrStackDownloadClients lists a download client for every client of the
+// stack's download stack, using category. Clients are held back until the
+// DownloadStackConfig is Ready and their category is checked against it.
+// The client's credential Secrets are carried over; clients without a URL the
+// apps can use (Deluge without a web UI URL) are skipped, see arrStackSkippedClients.
+func arrStackDownloadClients(stack *arrv1alpha1.ArrStack, category string) []arrv1alpha1.DownloadClientSpec {
+	ref := &arrv1alpha1.LocalObjectReference{Name: arrStackChildName(stack, "downloads")}
+	var clients []arrv1alpha1.DownloadClientSpec
+	forEachArrStackClient(stack, func(c arrStackClient) {
+		if c.url == "" {
+			return
+		}
+		clients = append(clients, arrv1alpha1.DownloadClientSpec{
+			Name:                  c.name(),
+			URL:                   c.url,
+			Type:                  c.clientType,
+			CredentialsSecretRef:  c.credentials.DeepCopy(),
+			APIKeySecretRef:       c.apiKey.DeepCopy(),
+			PasswordSecretRef:     c.password.DeepCopy(),
+			Category:              category,
+			DownloadStackRef:      ref.DeepCopy(),
+			DownloadStackInstance: c.instance,
+			DependsOn:             ref.DeepCopy(),
+		})
+	})
+	return clients
+}
+
+// arrStackSkippedClients names the download stack clients that get no download
+// client in the apps because they have no URL
+func arrStackSkippedClients(stack *arrv1alpha1.ArrStack) []string {
+	var skipped []string
+	forEachArrStackClient(stack, func(c arrStackClient) {
+		if c.url == "" {
+			skipped = append(skipped, c.name())
+		}
+	})
+	return skipped
+}
+
+// arrStackClient is a client of a stack's download stack
+type arrStackClient struct {
+	clientType  string
+	instance    string
+	url         string
+	credentials *arrv1alpha1.CredentialsSecretRef
+	apiKey      *arrv1alpha1.SecretKeySelector
+	password    *arrv1alpha1.SecretKeySelector
+}
+
+// name is the download client name: the type, suffixed with the instance name
+func (c arrStackClient) name() string {
+	if c.instance == "" {
+		return c.clientType
+	}
+	return c.clientType + "-" + c.instance
+}
+
+// forEachArrStackClient calls fn for every client of the stack's download stack
+func forEachArrStackClient(stack *arrv1alpha1.ArrStack, fn func(arrStackClient)) {
+	ds := stack.Spec.DownloadStack
+	if ds == nil {
+		return
+	}
+
+	if ds.Transmission != nil {
+		fn(arrStackClient{clientType: "transmission", url: ds.Transmission.Connection.URL, credentials: ds.Transmission.Connection.CredentialsSecretRef})
+	}
+	for _, in := range ds.TransmissionInstances {
+		fn(arrStackClient{clientType: "transmission", instance: in.Name, url: in.Connection.URL, credentials: in.Connection.CredentialsSecretRef})
+	}
+	if ds.QBittorrent != nil {
+		fn(arrStackClient{clientType: "qbittorrent", url: ds.QBittorrent.Connection.URL, credentials: ds.QBittorrent.Connection.CredentialsSecretRef})
+	}
+	for _, in := range ds.QBittorrentInstances {
+		fn(arrStackClient{clientType: "qbittorrent", instance: in.Name, url: in.Connection.URL, credentials: in.Connection.CredentialsSecretRef})
+	}
+	if ds.Deluge != nil {
+		fn(arrStackClient{clientType: "deluge", url: ds.Deluge.Connection.URL, password: ds.Deluge.Connection.PasswordSecretRef})
+	}
+	for _, in := range ds.DelugeInstances {
+		fn(arrStackClient{clientType: "deluge", instance: in.Name, url: in.Connection.URL, password: in.Connection.PasswordSecretRef})
+	}
+	if ds.RTorrent != nil {
+		fn(arrStackClient{clientType: "rtorrent", url: ds.RTorrent.Connection.URL, credentials: ds.RTorrent.Connection.CredentialsSecretRef})
+	}
+	for _, in := range ds.RTorrentInstances {
+		fn(arrStackClient{clientType: "rtorrent", instance: in.Name, url: in.Connection.URL, credentials: in.Connection.CredentialsSecretRef})
+	}
+	if ds.SABnzbd != nil {
+		fn(arrStackClient{clientType: "sabnzbd", url: ds.SABnzbd.Connection.URL, apiKey: &ds.SABnzbd.Connection.APIKeySecretRef})
+	}
+	for _, in := range ds.SABnzbdInstances {
+		fn(arrStackClient{clientType: "sabnzbd", instance: in.Name, url: in.Connection.URL, apiKey: &in.Connection.APIKeySecretRef})
+	}
+	if ds.NZBGet != nil {
+		fn(arrStackClient{clientType: "nzbget", url: ds.NZBGet.Connection.URL, credentials: ds.NZBGet.Connection.CredentialsSecretRef})
+	}
+	for _, in := range ds.NZBGetInstances {
+		fn(arrStackClient{clientType: "nzbget", instance: in.Name, url: in.Connection.URL, credentials: in.Connection.CredentialsSecretRef})
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ArrStackReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.ArrStack{}).
+		Owns(&arrv1alpha1.ProwlarrConfig{}).
+		Owns(&arrv1alpha1.RadarrConfig{}).
+		Owns(&arrv1alpha1.SonarrConfig{}).
+		Owns(&arrv1alpha1.LidarrConfig{}).
+		Owns(&arrv1alpha1.DownloadStackConfig{})
+
+	return r.Options.complete(mgr, b, "arrstack", &arrv1alpha1.ArrStackList{}, r)
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("ArrStack", func() {
+	newStack := func() *arrv1alpha1.ArrStack {
+		return &arrv1alpha1.ArrStack{
+			ObjectMeta: metav1.ObjectMeta{Name: "media", Namespace: "default"},
+			Spec: arrv1alpha1.ArrStackSpec{
+				Defaults: &arrv1alpha1.ArrStackDefaults{
+					Quality: &arrv1alpha1.VideoQualitySpec{Preset: "1080p-quality"},
+				},
+				Prowlarr: &arrv1alpha1.ArrStackProwlarrSpec{
+					Connection: arrv1alpha1.ConnectionSpec{URL: "http://prowlarr:9696"},
+				},
+				Radarr: &arrv1alpha1.ArrStackAppSpec{
+					Connection: arrv1alpha1.ConnectionSpec{URL: "http://radarr:7878"},
+					Quality:    &arrv1alpha1.VideoQualitySpec{Preset: "4k-hdr"},
+				},
+				Sonarr: &arrv1alpha1.ArrStackAppSpec{
+					Connection: arrv1alpha1.ConnectionSpec{URL: "http://sonarr:8989"},
+					Category:   "TV",
+				},
+				DownloadStack: &arrv1alpha1.DownloadStackConfigSpec{
+					QBittorrent: &arrv1alpha1.QBittorrentSpec{
+						Connection: arrv1alpha1.QBittorrentConnectionSpec{
+							URL:                  "http://downloads:8080",
+							CredentialsSecretRef: &arrv1alpha1.CredentialsSecretRef{Name: "qbit"},
+						},
+						Categories: []arrv1alpha1.QBittorrentCategorySpec{{Name: "radarr", SavePath: "/movies"}},
+					},
+					QBittorrentInstances: []arrv1alpha1.QBittorrentInstanceSpec{{
+						Name: "4k",
+						QBittorrentSpec: arrv1alpha1.QBittorrentSpec{
+							Connection: arrv1alpha1.QBittorrentConnectionSpec{URL: "http://downloads:8081"},
+						},
+					}},
+					Deluge: &arrv1alpha1.DelugeSpec{},
+					NZBGet: &arrv1alpha1.NZBGetSpec{
+						Connection: arrv1alpha1.NZBGetConnectionSpec{URL: "http://downloads:6789"},
+						Categories: []arrv1alpha1.NZBGetCategorySpec{{Name: "Movies", Aliases: []string{"radarr"}}},
+					},
+				},
+			},
+		}
+	}
+
+	It("wires Radarr and Sonarr to the stack's Prowlarr and download clients", func() {
+		stack := newStack()
+
+		radarr := buildArrStackRadarr(stack)
+		Expect(radarr.Quality.Preset).To(Equal("4k-hdr"))
+		Expect(radarr.Indexers.ProwlarrRef.Name).To(Equal("media-prowlarr"))
+		Expect(radarr.DownloadClients).To(HaveLen(3))
+
+		qb := radarr.DownloadClients[0]
+		Expect(qb.Name).To(Equal("qbittorrent"))
+		Expect(qb.Category).To(Equal("radarr"))
+		Expect(qb.CredentialsSecretRef.Name).To(Equal("qbit"))
+		Expect(qb.DownloadStackRef.Name).To(Equal("media-downloads"))
+		Expect(qb.DependsOn.Name).To(Equal("media-downloads"))
+		Expect(radarr.DownloadClients[1].Name).To(Equal("qbittorrent-4k"))
+		Expect(radarr.DownloadClients[1].DownloadStackInstance).To(Equal("4k"))
+		Expect(radarr.DownloadClients[2].Type).To(Equal("nzbget"))
+
+		sonarr := buildArrStackSonarr(stack)
+		Expect(sonarr.Quality.Preset).To(Equal("1080p-quality"))
+		Expect(sonarr.DownloadClients[0].Category).To(Equal("TV"))
+	})
+
+	It("carries Deluge and SABnzbd credentials and reports clients without a URL", func() {
+		stack := newStack()
+		ds := stack.Spec.DownloadStack
+		ds.DelugeInstances = []arrv1alpha1.DelugeInstanceSpec{{
+			Name: "seedbox",
+			DelugeSpec: arrv1alpha1.DelugeSpec{Connection: arrv1alpha1.DelugeConnectionSpec{
+				URL:               "http://seedbox:8112",
+				PasswordSecretRef: &arrv1alpha1.SecretKeySelector{Name: "deluge", Key: "password"},
+			}},
+		}}
+		ds.SABnzbd = &arrv1alpha1.SABnzbdSpec{Connection: arrv1alpha1.SABnzbdConnectionSpec{
+			URL:             "http://downloads:8085",
+			APIKeySecretRef: arrv1alpha1.SecretKeySelector{Name: "sabnzbd", Key: "apiKey"},
+		}}
+
+		clients := buildArrStackRadarr(stack).DownloadClients
+		byName := map[string]arrv1alpha1.DownloadClientSpec{}
+		for _, dc := range clients {
+			byName[dc.Name] = dc
+		}
+		Expect(byName).To(HaveKey("deluge-seedbox"))
+		Expect(byName["deluge-seedbox"].PasswordSecretRef.Name).To(Equal("deluge"))
+		Expect(byName["sabnzbd"].APIKeySecretRef.Name).To(Equal("sabnzbd"))
+		Expect(byName["sabnzbd"].CredentialsSecretRef).To(BeNil())
+		// The unnamed Deluge has no web UI URL
+		Expect(byName).NotTo(HaveKey("deluge"))
+
+		setDownloadClientsWired(stack)
+		cond := meta.FindStatusCondition(stack.Status.Conditions, ConditionTypeDownloadClientsWired)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal("MissingURL"))
+		Expect(cond.Message).To(ContainSubstring("deluge"))
+		Expect(cond.Message).NotTo(ContainSubstring("seedbox"))
+
+		ds.Deluge = nil
+		setDownloadClientsWired(stack)
+		Expect(meta.IsStatusConditionTrue(stack.Status.Conditions, ConditionTypeDownloadClientsWired)).To(BeTrue())
+
+		stack.Spec.DownloadStack = nil
+		setDownloadClientsWired(stack)
+		Expect(meta.FindStatusCondition(stack.Status.Conditions, ConditionTypeDownloadClientsWired)).To(BeNil())
+	})
+
+	It("declares every app category in the download stack", func() {
+		stack := newStack()
+		ds := buildArrStackDownloadStack(stack)
+
+		Expect(ds.QBittorrent.Categories).To(Equal([]arrv1alpha1.QBittorrentCategorySpec{
+			{Name: "radarr", SavePath: "/movies"}, {Name: "TV"},
+		}))
+		Expect(ds.QBittorrentInstances[0].Categories).To(HaveLen(2))
+		Expect(ds.Deluge.Labels).To(Equal([]string{"radarr", "tv"}))
+		// radarr is already declared as an alias of Movies
+		Expect(ds.NZBGet.Categories).To(HaveLen(2))
+		Expect(stack.Spec.DownloadStack.QBittorrent.Categories).To(HaveLen(1))
+
+		for _, dc := range buildArrStackRadarr(stack).DownloadClients {
+			Expect(checkCategoryContract(dc, &arrv1alpha1.DownloadStackConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "media-downloads"},
+				Spec:       ds,
+			})).To(BeEmpty())
+		}
+	})
+
+	It("leaves out components and wiring the stack doesn't declare", func() {
+		stack := newStack()
+		stack.Spec.Prowlarr = nil
+		stack.Spec.DownloadStack = nil
+
+		radarr := buildArrStackRadarr(stack)
+		Expect(radarr.Indexers).To(BeNil())
+		Expect(radarr.DownloadClients).To(BeEmpty())
+
+		var declared []string
+		for _, c := range arrStackComponents(stack) {
+			if c.apply != nil {
+				declared = append(declared, c.obj.GetName())
+			}
+		}
+		Expect(declared).To(Equal([]string{"media-radarr", "media-sonarr"}))
+	})
+
+	It("generates Lidarr with its own category", func() {
+		stack := newStack()
+		stack.Spec.Lidarr = &arrv1alpha1.ArrStackLidarrSpec{
+			Connection:  arrv1alpha1.ConnectionSpec{URL: "http://lidarr:8686"},
+			RootFolders: []arrv1alpha1.LidarrRootFolder{{Path: "/music"}},
+		}
+
+		lidarr := buildArrStackLidarr(stack)
+		Expect(lidarr.Indexers.ProwlarrRef.Name).To(Equal("media-prowlarr"))
+		Expect(lidarr.DownloadClients[0].Category).To(Equal("lidarr"))
+		Expect(buildArrStackDownloadStack(stack).Deluge.Labels).To(Equal([]string{"radarr", "tv", "lidarr"}))
+	})
+
+	It("fans global notifications out with each app's event flags", func() {
+		stack := newStack()
+		stack.Spec.GlobalNotifications = []arrv1alpha1.GlobalNotificationSpec{
+			{
+				NotificationSpec: arrv1alpha1.NotificationSpec{
+					Name: "apprise", Type: "Apprise", OnRename: ptr.To(false),
+					Settings: map[string]string{"serverUrl": "http://apprise:8000"},
+				},
+				Events: "all",
+			},
+			{NotificationSpec: arrv1alpha1.NotificationSpec{Name: "pager", Type: "Webhook"}, Events: "health"},
+		}
+
+		radarr := buildArrStackRadarr(stack).Notifications
+		Expect(radarr).To(HaveLen(2))
+		Expect(*radarr[0].OnMovieAdded).To(BeTrue())
+		Expect(*radarr[0].OnDownload).To(BeTrue())
+		Expect(*radarr[0].OnRename).To(BeFalse())
+		Expect(radarr[0].OnSeriesAdd).To(BeNil())
+		Expect(radarr[0].Settings).To(HaveKeyWithValue("serverUrl", "http://apprise:8000"))
+		Expect(*radarr[1].OnHealthIssue).To(BeTrue())
+		Expect(radarr[1].OnGrab).To(BeNil())
+
+		Expect(*buildArrStackSonarr(stack).Notifications[0].OnSeriesAdd).To(BeTrue())
+
+		stack.Spec.Lidarr = &arrv1alpha1.ArrStackLidarrSpec{Connection: arrv1alpha1.ConnectionSpec{URL: "http://lidarr:8686"}}
+		lidarr := buildArrStackLidarr(stack).Notifications
+		Expect(*lidarr[0].OnReleaseImport).To(BeTrue())
+		Expect(lidarr[0].OnDownload).To(BeNil())
+		Expect(*lidarr[1].OnImportFailure).To(BeTrue())
+
+		// The stack's own spec is left alone
+		Expect(stack.Spec.GlobalNotifications[0].OnGrab).To(BeNil())
+	})
+})
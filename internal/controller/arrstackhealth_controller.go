@@ -0,0 +1,252 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// ConditionTypeHealthy reports whether every app in an ArrStackHealth rollup is healthy
+const ConditionTypeHealthy = "Healthy"
+
+// ArrStackHealthReconciler rolls up the health reported in the status of every
+// *arr config in a namespace into an ArrStackHealth resource
+type ArrStackHealthReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	Options ControllerOptions
+}
+
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=arrstackhealths,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=arrstackhealths/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=radarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=sonarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=lidarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=readarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=prowlarrconfigs,verbs=get;list;watch
+
+// Reconcile recomputes the health rollup for an ArrStackHealth
+func (r *ArrStackHealthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	stack := &arrv1alpha1.ArrStackHealth{}
+	if err := r.Get(ctx, req.NamespacedName, stack); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !stack.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	selector := labels.Everything()
+	if stack.Spec.Selector != nil {
+		s, err := metav1.LabelSelectorAsSelector(stack.Spec.Selector)
+		if err != nil {
+			meta.SetStatusCondition(&stack.Status.Conditions, metav1.Condition{
+				Type:               ConditionTypeHealthy,
+				Status:             metav1.ConditionUnknown,
+				Reason:             "InvalidSelector",
+				Message:            err.Error(),
+				ObservedGeneration: stack.Generation,
+			})
+			return ctrl.Result{}, r.Status().Update(ctx, stack)
+		}
+		selector = s
+	}
+
+	apps, err := r.collectAppHealth(ctx, stack.Namespace, selector)
+	if err != nil {
+		log.Error(err, "Failed to list *arr configs")
+		return ctrl.Result{}, err
+	}
+
+	// The *arr config watches fire on every status write of every config, so
+	// the rollup is only written when it changed
+	previous := stack.Status.DeepCopy()
+	applyStackHealth(&stack.Status, apps)
+	stack.Status.ObservedGeneration = stack.Generation
+	if !equality.Semantic.DeepEqual(previous, &stack.Status) {
+		now := metav1.Now()
+		stack.Status.LastUpdated = &now
+		if err := r.Status().Update(ctx, stack); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Health checks run on each config's own reconcile interval; the watches
+	// pick those up, so the periodic requeue only guards against missed events
+	return ctrl.Result{RequeueAfter: r.Options.requeueAfter(10 * time.Minute)}, nil
+}
+
+// collectAppHealth lists every *arr config in the namespace matching the selector
+func (r *ArrStackHealthReconciler) collectAppHealth(ctx context.Context, namespace string, selector labels.Selector) ([]arrv1alpha1.AppHealthSummary, error) {
+	opts := []client.ListOption{client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}}
+	var apps []arrv1alpha1.AppHealthSummary
+
+	radarr := &arrv1alpha1.RadarrConfigList{}
+	if err := r.List(ctx, radarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list RadarrConfigs: %w", err)
+	}
+	for _, c := range radarr.Items {
+		apps = append(apps, appHealthSummary("RadarrConfig", c.Name, c.Status.Connected, c.Status.Health))
+	}
+
+	sonarr := &arrv1alpha1.SonarrConfigList{}
+	if err := r.List(ctx, sonarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list SonarrConfigs: %w", err)
+	}
+	for _, c := range sonarr.Items {
+		apps = append(apps, appHealthSummary("SonarrConfig", c.Name, c.Status.Connected, c.Status.Health))
+	}
+
+	lidarr := &arrv1alpha1.LidarrConfigList{}
+	if err := r.List(ctx, lidarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list LidarrConfigs: %w", err)
+	}
+	for _, c := range lidarr.Items {
+		apps = append(apps, appHealthSummary("LidarrConfig", c.Name, c.Status.Connected, c.Status.Health))
+	}
+
+	readarr := &arrv1alpha1.ReadarrConfigList{}
+	if err := r.List(ctx, readarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list ReadarrConfigs: %w", err)
+	}
+	for _, c := range readarr.Items {
+		apps = append(apps, appHealthSummary("ReadarrConfig", c.Name, c.Status.Connected, c.Status.Health))
+	}
+
+	prowlarr := &arrv1alpha1.ProwlarrConfigList{}
+	if err := r.List(ctx, prowlarr, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list ProwlarrConfigs: %w", err)
+	}
+	for _, c := range prowlarr.Items {
+		apps = append(apps, appHealthSummary("ProwlarrConfig", c.Name, c.Status.Connected, c.Status.Health))
+	}
+
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].Kind != apps[j].Kind {
+			return apps[i].Kind < apps[j].Kind
+		}
+		return apps[i].Name < apps[j].Name
+	})
+	return apps, nil
+}
+
+// appHealthSummary builds the rollup entry for a single config.
+// An app that has not reported health yet is healthy as long as it is connected.
+func appHealthSummary(kind, name string, connected bool, health *arrv1alpha1.HealthStatus) arrv1alpha1.AppHealthSummary {
+	summary := arrv1alpha1.AppHealthSummary{
+		Kind:      kind,
+		Name:      name,
+		Connected: connected,
+		Healthy:   connected,
+	}
+	if health != nil {
+		summary.Healthy = connected && health.ErrorCount == 0
+		summary.ErrorCount = health.ErrorCount
+		summary.WarningCount = health.WarningCount
+		summary.LastCheck = health.LastCheck
+	}
+	return summary
+}
+
+// applyStackHealth writes the totals and Healthy condition for the given apps
+func applyStackHealth(status *arrv1alpha1.ArrStackHealthStatus, apps []arrv1alpha1.AppHealthSummary) {
+	status.Apps = apps
+	status.AppCount = len(apps)
+	status.UnhealthyCount = 0
+	status.ErrorCount = 0
+	status.WarningCount = 0
+
+	var unhealthy []string
+	for _, app := range apps {
+		status.ErrorCount += app.ErrorCount
+		status.WarningCount += app.WarningCount
+		if !app.Healthy {
+			status.UnhealthyCount++
+			unhealthy = append(unhealthy, fmt.Sprintf("%s/%s", app.Kind, app.Name))
+		}
+	}
+	status.Healthy = status.UnhealthyCount == 0
+
+	condition := metav1.Condition{
+		Type:    ConditionTypeHealthy,
+		Status:  metav1.ConditionTrue,
+		Reason:  "AllAppsHealthy",
+		Message: fmt.Sprintf("%d apps healthy, %d warnings", len(apps), status.WarningCount),
+	}
+	switch {
+	case len(apps) == 0:
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "NoApps"
+		condition.Message = "No *arr configs match"
+	case !status.Healthy:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "AppsUnhealthy"
+		condition.Message = fmt.Sprintf("%d of %d apps unhealthy (%d errors, %d warnings): %v",
+			status.UnhealthyCount, len(apps), status.ErrorCount, status.WarningCount, unhealthy)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ArrStackHealthReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Any *arr config change re-evaluates every ArrStackHealth in its namespace
+	mapConfigToStacks := func(ctx context.Context, obj client.Object) []reconcile.Request {
+		stacks := &arrv1alpha1.ArrStackHealthList{}
+		if err := r.List(ctx, stacks, client.InNamespace(obj.GetNamespace())); err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(stacks.Items))
+		for _, stack := range stacks.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: stack.Name, Namespace: stack.Namespace},
+			})
+		}
+		return requests
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.ArrStackHealth{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&arrv1alpha1.RadarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapConfigToStacks)).
+		Watches(&arrv1alpha1.SonarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapConfigToStacks)).
+		Watches(&arrv1alpha1.LidarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapConfigToStacks)).
+		Watches(&arrv1alpha1.ReadarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapConfigToStacks)).
+		Watches(&arrv1alpha1.ProwlarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapConfigToStacks))
+
+	return r.Options.complete(mgr, b, "arrstackhealth", &arrv1alpha1.ArrStackHealthList{}, r)
+}
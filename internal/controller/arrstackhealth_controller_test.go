@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("ArrStackHealth Controller", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "stack", Namespace: "media"}
+
+	newReconciler := func() *ArrStackHealthReconciler {
+		s := runtime.NewScheme()
+		Expect(arrv1alpha1.AddToScheme(s)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(s).
+			WithStatusSubresource(&arrv1alpha1.ArrStackHealth{}).
+			WithObjects(
+				&arrv1alpha1.ArrStackHealth{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}},
+				&arrv1alpha1.RadarrConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "movies", Namespace: "media"},
+					Status:     arrv1alpha1.RadarrConfigStatus{Connected: true},
+				},
+				&arrv1alpha1.SonarrConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "tv", Namespace: "media"},
+					Status: arrv1alpha1.SonarrConfigStatus{
+						Connected: true,
+						Health:    &arrv1alpha1.HealthStatus{ErrorCount: 2, WarningCount: 1},
+					},
+				},
+				&arrv1alpha1.RadarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "movies", Namespace: "other"}},
+			).Build()
+		return &ArrStackHealthReconciler{Client: c, Scheme: s}
+	}
+
+	It("rolls up the health of the configs in the namespace", func() {
+		r := newReconciler()
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		stack := &arrv1alpha1.ArrStackHealth{}
+		Expect(r.Get(ctx, key, stack)).To(Succeed())
+		Expect(stack.Status.AppCount).To(Equal(2))
+		Expect(stack.Status.UnhealthyCount).To(Equal(1))
+		Expect(stack.Status.ErrorCount).To(Equal(2))
+		Expect(stack.Status.WarningCount).To(Equal(1))
+		Expect(stack.Status.Healthy).To(BeFalse())
+		Expect(stack.Status.LastUpdated).NotTo(BeNil())
+
+		cond := meta.FindStatusCondition(stack.Status.Conditions, ConditionTypeHealthy)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Message).To(ContainSubstring("SonarrConfig/tv"))
+	})
+
+	It("doesn't write an unchanged rollup", func() {
+		r := newReconciler()
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		first := &arrv1alpha1.ArrStackHealth{}
+		Expect(r.Get(ctx, key, first)).To(Succeed())
+
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		second := &arrv1alpha1.ArrStackHealth{}
+		Expect(r.Get(ctx, key, second)).To(Succeed())
+		Expect(second.ResourceVersion).To(Equal(first.ResourceVersion))
+		Expect(second.Status.LastUpdated).To(Equal(first.Status.LastUpdated))
+	})
+})
@@ -40,6 +40,8 @@ type BazarrConfigReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 	Helper *ReconcileHelper
+
+	Options ControllerOptions
 }
 
 // +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=bazarrconfigs,verbs=get;list;watch;create;update;patch;delete
@@ -221,6 +223,7 @@ func (r *BazarrConfigReconciler) reconcileFileMode(ctx context.Context, config *
 	if config.Spec.Reconciliation != nil && config.Spec.Reconciliation.Interval != nil {
 		requeueAfter = config.Spec.Reconciliation.Interval.Duration
 	}
+	requeueAfter = r.Options.requeueAfter(requeueAfter)
 
 	log.Info("Successfully reconciled BazarrConfig (file mode)", "name", config.Name)
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
@@ -328,6 +331,7 @@ func (r *BazarrConfigReconciler) reconcileAPIMode(ctx context.Context, config *a
 	if config.Spec.Reconciliation != nil && config.Spec.Reconciliation.Interval != nil {
 		requeueAfter = config.Spec.Reconciliation.Interval.Duration
 	}
+	requeueAfter = r.Options.requeueAfter(requeueAfter)
 
 	log.Info("Successfully reconciled BazarrConfig (API mode)", "name", config.Name, "version", version)
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
@@ -591,8 +595,9 @@ func (r *BazarrConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		r.Helper = NewReconcileHelper(r.Client)
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&arrv1alpha1.BazarrConfig{}).
-		Owns(&corev1.ConfigMap{}).
-		Complete(r)
+		Owns(&corev1.ConfigMap{})
+
+	return r.Options.complete(mgr, b, "bazarrconfig", &arrv1alpha1.BazarrConfigList{}, r)
 }
@@ -0,0 +1,420 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/discovery"
+)
+
+// ConditionTypeCategoryContract reports whether the categories used by download
+// clients exist in the DownloadStackConfig they reference
+const ConditionTypeCategoryContract = "CategoryContract"
+
+// CategoryContractReconciler checks the category of every download client with
+// a downloadStackRef against the categories (or Deluge labels) the referenced
+// DownloadStackConfig declares. Disagreements are reported as the
+// CategoryContract condition on both the app config and the DownloadStackConfig.
+type CategoryContractReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	Options ControllerOptions
+}
+
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=downloadstackconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=downloadstackconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=radarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=radarrconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=sonarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=sonarrconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=lidarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=lidarrconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=readarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=readarrconfigs/status,verbs=get;update;patch
+
+// Reconcile re-checks the category contract of every app config referencing
+// the DownloadStackConfig named by req. It also runs when the DownloadStackConfig
+// does not exist, so references to a missing stack are reported.
+func (r *CategoryContractReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	stacks := map[string]*arrv1alpha1.DownloadStackConfig{}
+	stack, err := r.getStack(ctx, req.Namespace, req.Name, stacks)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	configs, err := listAppConfigs(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var errs []error
+	var stackMismatches []string
+	referenced := false
+	for _, config := range configs {
+		refs := referencedStacks(config.GetDownloadClients())
+		if !slices.Contains(refs, req.Name) {
+			// Configs that dropped every reference lose the condition
+			if len(refs) == 0 && removeCondition(config.GetStatusWrapper(), ConditionTypeCategoryContract) {
+				if err := r.Status().Update(ctx, config.GetObject()); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			continue
+		}
+		referenced = true
+
+		var mismatches []string
+		for _, dc := range config.GetDownloadClients() {
+			dc = contractClient(dc)
+			if dc.DownloadStackRef == nil {
+				continue
+			}
+			s, err := r.getStack(ctx, req.Namespace, dc.DownloadStackRef.Name, stacks)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			msg := checkCategoryContract(dc, s)
+			if msg == "" {
+				continue
+			}
+			mismatches = append(mismatches, fmt.Sprintf("download client %s: %s", dc.Name, msg))
+			if dc.DownloadStackRef.Name == req.Name {
+				stackMismatches = append(stackMismatches,
+					fmt.Sprintf("%s config %s, download client %s: %s", config.GetAppType(), config.GetObject().GetName(), dc.Name, msg))
+			}
+		}
+
+		if setContractCondition(config.GetStatusWrapper(), config.GetObject().GetGeneration(), mismatches) {
+			if err := r.Status().Update(ctx, config.GetObject()); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if stack != nil {
+		status := &DownloadStackStatusWrapper{Status: &stack.Status}
+		var changed bool
+		if referenced {
+			changed = setContractCondition(status, stack.Generation, stackMismatches)
+		} else {
+			changed = removeCondition(status, ConditionTypeCategoryContract)
+		}
+		if changed {
+			if err := r.Status().Update(ctx, stack); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		log.Error(errors.Join(errs...), "Failed to update category contract status")
+		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, errors.Join(errs...)
+	}
+	return ctrl.Result{}, nil
+}
+
+// getStack fetches a DownloadStackConfig, caching lookups for the current
+// reconcile. A missing DownloadStackConfig is returned as nil.
+func (r *CategoryContractReconciler) getStack(
+	ctx context.Context, namespace, name string, cache map[string]*arrv1alpha1.DownloadStackConfig,
+) (*arrv1alpha1.DownloadStackConfig, error) {
+	if stack, ok := cache[name]; ok {
+		return stack, nil
+	}
+	stack := &arrv1alpha1.DownloadStackConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, stack); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		stack = nil
+	}
+	cache[name] = stack
+	return stack, nil
+}
+
+// listAppConfigs returns every app config in namespace that has download clients
+func listAppConfigs(ctx context.Context, c client.Reader, namespace string) ([]ArrConfigObject, error) {
+	var configs []ArrConfigObject
+
+	radarrList := &arrv1alpha1.RadarrConfigList{}
+	if err := c.List(ctx, radarrList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list RadarrConfigs: %w", err)
+	}
+	for i := range radarrList.Items {
+		configs = append(configs, &RadarrConfigAdapter{&radarrList.Items[i]})
+	}
+
+	sonarrList := &arrv1alpha1.SonarrConfigList{}
+	if err := c.List(ctx, sonarrList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list SonarrConfigs: %w", err)
+	}
+	for i := range sonarrList.Items {
+		configs = append(configs, &SonarrConfigAdapter{&sonarrList.Items[i]})
+	}
+
+	lidarrList := &arrv1alpha1.LidarrConfigList{}
+	if err := c.List(ctx, lidarrList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list LidarrConfigs: %w", err)
+	}
+	for i := range lidarrList.Items {
+		configs = append(configs, &LidarrConfigAdapter{&lidarrList.Items[i]})
+	}
+
+	readarrList := &arrv1alpha1.ReadarrConfigList{}
+	if err := c.List(ctx, readarrList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ReadarrConfigs: %w", err)
+	}
+	for i := range readarrList.Items {
+		configs = append(configs, &ReadarrConfigAdapter{&readarrList.Items[i]})
+	}
+
+	return configs, nil
+}
+
+// referencedStacks returns the distinct DownloadStackConfig names referenced by clients
+func referencedStacks(clients []arrv1alpha1.DownloadClientSpec) []string {
+	var names []string
+	for _, dc := range clients {
+		dc = contractClient(dc)
+		if dc.DownloadStackRef != nil && !slices.Contains(names, dc.DownloadStackRef.Name) {
+			names = append(names, dc.DownloadStackRef.Name)
+		}
+	}
+	return names
+}
+
+// contractClient points a client that takes its settings from a stack client
+// (downloadClientRef) at that stack, unless it names a stack for the check itself
+func contractClient(dc arrv1alpha1.DownloadClientSpec) arrv1alpha1.DownloadClientSpec {
+	if dc.DownloadStackRef != nil || dc.DownloadClientRef == nil {
+		return dc
+	}
+	dc.DownloadStackRef = &arrv1alpha1.LocalObjectReference{Name: dc.DownloadClientRef.Name}
+	dc.DownloadStackInstance = dc.DownloadClientRef.Instance
+	if dc.Type == "" {
+		dc.Type = dc.DownloadClientRef.Type
+	}
+	return dc
+}
+
+// checkCategoryContract returns why dc's category is not declared by stack,
+// or an empty string if it is. Clients without a category are not checked.
+func checkCategoryContract(dc arrv1alpha1.DownloadClientSpec, stack *arrv1alpha1.DownloadStackConfig) string {
+	if stack == nil {
+		return fmt.Sprintf("DownloadStackConfig %q not found", dc.DownloadStackRef.Name)
+	}
+
+	clientType := dc.Type
+	if clientType == "" {
+		clientType = discovery.InferDownloadClientType(dc.Name)
+	}
+	if clientType == "" {
+		clientType = "qbittorrent"
+	}
+
+	spec := stack.Spec
+	instance := dc.DownloadStackInstance
+	notConfigured := func() string {
+		if instance != "" {
+			return fmt.Sprintf("DownloadStackConfig %s does not configure %s instance %q", stack.Name, clientType, instance)
+		}
+		return fmt.Sprintf("DownloadStackConfig %s does not configure %s", stack.Name, clientType)
+	}
+
+	var declared []string
+	switch clientType {
+	case "qbittorrent":
+		qb := spec.QBittorrentInstance(instance)
+		if qb == nil {
+			return notConfigured()
+		}
+		for _, c := range qb.Categories {
+			declared = append(declared, c.Name)
+		}
+	case "deluge":
+		deluge := spec.DelugeInstance(instance)
+		if deluge == nil {
+			return notConfigured()
+		}
+		// Deluge labels are always lowercase
+		if dc.Category != "" && !slices.Contains(deluge.Labels, strings.ToLower(dc.Category)) {
+			return fmt.Sprintf("label %q is not declared in DownloadStackConfig %s", dc.Category, stack.Name)
+		}
+		return ""
+	case "sabnzbd":
+		sab := spec.SABnzbdInstance(instance)
+		if sab == nil {
+			return notConfigured()
+		}
+		for _, c := range sab.Categories {
+			declared = append(declared, c.Name)
+		}
+	case "nzbget":
+		nzbget := spec.NZBGetInstance(instance)
+		if nzbget == nil {
+			return notConfigured()
+		}
+		for _, c := range nzbget.Categories {
+			declared = append(declared, c.Name)
+			declared = append(declared, c.Aliases...)
+		}
+	case "transmission":
+		// Transmission has no categories to declare; only the client is checked
+		if spec.TransmissionInstance(instance) == nil {
+			return notConfigured()
+		}
+		return ""
+	case "rtorrent":
+		// rTorrent labels are free-form; only the client is checked
+		if spec.RTorrentInstance(instance) == nil {
+			return notConfigured()
+		}
+		return ""
+	default:
+		return ""
+	}
+
+	if dc.Category != "" && !slices.Contains(declared, dc.Category) {
+		return fmt.Sprintf("category %q is not declared in DownloadStackConfig %s", dc.Category, stack.Name)
+	}
+	return ""
+}
+
+// setContractCondition sets the CategoryContract condition from mismatches and
+// reports whether it changed
+func setContractCondition(status ConfigStatus, generation int64, mismatches []string) bool {
+	cond := metav1.Condition{
+		Type:               ConditionTypeCategoryContract,
+		Status:             metav1.ConditionTrue,
+		Reason:             "CategoriesDeclared",
+		Message:            "All download client categories are declared",
+		ObservedGeneration: generation,
+	}
+	if len(mismatches) > 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = "CategoryMismatch"
+		cond.Message = strings.Join(mismatches, "; ")
+	}
+
+	conditions := status.GetConditions()
+	if existing := meta.FindStatusCondition(conditions, cond.Type); existing != nil &&
+		existing.Status == cond.Status && existing.Reason == cond.Reason &&
+		existing.Message == cond.Message && existing.ObservedGeneration == cond.ObservedGeneration {
+		return false
+	}
+	meta.SetStatusCondition(&conditions, cond)
+	status.SetConditions(conditions)
+	return true
+}
+
+// removeCondition removes the condition of the given type and reports whether it was present
+func removeCondition(status ConfigStatus, conditionType string) bool {
+	conditions := status.GetConditions()
+	if !meta.RemoveStatusCondition(&conditions, conditionType) {
+		return false
+	}
+	status.SetConditions(conditions)
+	return true
+}
+
+// mapAppConfigToStacks enqueues every DownloadStackConfig an app config references
+func (r *CategoryContractReconciler) mapAppConfigToStacks(_ context.Context, obj client.Object) []reconcile.Request {
+	var clients []arrv1alpha1.DownloadClientSpec
+	switch config := obj.(type) {
+	case *arrv1alpha1.RadarrConfig:
+		clients = config.Spec.DownloadClients
+	case *arrv1alpha1.SonarrConfig:
+		clients = config.Spec.DownloadClients
+	case *arrv1alpha1.LidarrConfig:
+		clients = config.Spec.DownloadClients
+	case *arrv1alpha1.ReadarrConfig:
+		clients = config.Spec.DownloadClients
+	}
+
+	var requests []reconcile.Request
+	for _, name := range referencedStacks(clients) {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: name},
+		})
+	}
+	return requests
+}
+
+// appConfigHandler enqueues the DownloadStackConfigs an app config references.
+// On updates the stacks the old spec referenced are enqueued too, so a stack a
+// config stopped referencing drops the config from its condition.
+func (r *CategoryContractReconciler) appConfigHandler() handler.EventHandler {
+	enqueue := func(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request], objs ...client.Object) {
+		for _, obj := range objs {
+			for _, req := range r.mapAppConfigToStacks(ctx, obj) {
+				q.Add(req)
+			}
+		}
+	}
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q, e.Object)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q, e.ObjectOld, e.ObjectNew)
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q, e.Object)
+		},
+		GenericFunc: func(ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q, e.Object)
+		},
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CategoryContractReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	generationChanged := builder.WithPredicates(predicate.GenerationChangedPredicate{})
+	mapFn := r.appConfigHandler()
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.DownloadStackConfig{}, generationChanged).
+		Watches(&arrv1alpha1.RadarrConfig{}, mapFn, generationChanged).
+		Watches(&arrv1alpha1.SonarrConfig{}, mapFn, generationChanged).
+		Watches(&arrv1alpha1.LidarrConfig{}, mapFn, generationChanged).
+		Watches(&arrv1alpha1.ReadarrConfig{}, mapFn, generationChanged)
+	return r.Options.complete(mgr, b, "categorycontract", &arrv1alpha1.DownloadStackConfigList{}, r)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("Category contract", func() {
+	stack := &arrv1alpha1.DownloadStackConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "downloads"},
+		Spec: arrv1alpha1.DownloadStackConfigSpec{
+			QBittorrent: &arrv1alpha1.QBittorrentSpec{
+				Categories: []arrv1alpha1.QBittorrentCategorySpec{{Name: "radarr"}},
+			},
+			Deluge: &arrv1alpha1.DelugeSpec{Labels: []string{"tv-sonarr"}},
+			NZBGet: &arrv1alpha1.NZBGetSpec{
+				Categories: []arrv1alpha1.NZBGetCategorySpec{{Name: "Movies", Aliases: []string{"radarr"}}},
+			},
+		},
+	}
+	client := func(name, clientType, category string) arrv1alpha1.DownloadClientSpec {
+		return arrv1alpha1.DownloadClientSpec{
+			Name:             name,
+			Type:             clientType,
+			Category:         category,
+			DownloadStackRef: &arrv1alpha1.LocalObjectReference{Name: "downloads"},
+		}
+	}
+
+	It("accepts declared categories, labels and aliases", func() {
+		Expect(checkCategoryContract(client("qbit", "", "radarr"), stack)).To(BeEmpty())
+		Expect(checkCategoryContract(client("deluge", "", "TV-Sonarr"), stack)).To(BeEmpty())
+		Expect(checkCategoryContract(client("usenet", "nzbget", "radarr"), stack)).To(BeEmpty())
+		Expect(checkCategoryContract(client("qbit", "", ""), stack)).To(BeEmpty())
+	})
+
+	It("reports undeclared categories and unconfigured clients", func() {
+		Expect(checkCategoryContract(client("qbit", "", "movies"), stack)).To(ContainSubstring(`category "movies" is not declared`))
+		Expect(checkCategoryContract(client("deluge", "", "radarr"), stack)).To(ContainSubstring(`label "radarr" is not declared`))
+		Expect(checkCategoryContract(client("sab", "", "radarr"), stack)).To(ContainSubstring("does not configure sabnzbd"))
+		Expect(checkCategoryContract(client("qbit", "", "radarr"), nil)).To(ContainSubstring("not found"))
+	})
+
+	It("checks named instances against their own categories", func() {
+		withInstance := stack.DeepCopy()
+		withInstance.Spec.QBittorrentInstances = []arrv1alpha1.QBittorrentInstanceSpec{{
+			Name: "4k",
+			QBittorrentSpec: arrv1alpha1.QBittorrentSpec{
+				Categories: []arrv1alpha1.QBittorrentCategorySpec{{Name: "radarr-4k"}},
+			},
+		}}
+		dc := client("qbit-4k", "qbittorrent", "radarr-4k")
+		dc.DownloadStackInstance = "4k"
+		Expect(checkCategoryContract(dc, withInstance)).To(BeEmpty())
+
+		dc.Category = "radarr"
+		Expect(checkCategoryContract(dc, withInstance)).To(ContainSubstring(`category "radarr" is not declared`))
+
+		dc.DownloadStackInstance = "hd"
+		Expect(checkCategoryContract(dc, withInstance)).To(ContainSubstring(`does not configure qbittorrent instance "hd"`))
+	})
+
+	It("maps app configs to the stacks they reference", func() {
+		config := &arrv1alpha1.RadarrConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "radarr", Namespace: "media"},
+			Spec: arrv1alpha1.RadarrConfigSpec{DownloadClients: []arrv1alpha1.DownloadClientSpec{
+				client("qbit", "", "radarr"), client("deluge", "", "radarr"), {Name: "sab"},
+			}},
+		}
+		requests := (&CategoryContractReconciler{}).mapAppConfigToStacks(ctx, config)
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Namespace).To(Equal("media"))
+		Expect(requests[0].Name).To(Equal("downloads"))
+	})
+
+	It("enqueues the stacks an updated config stopped referencing", func() {
+		config := func(stacks ...string) *arrv1alpha1.RadarrConfig {
+			c := &arrv1alpha1.RadarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "movies", Namespace: "media"}}
+			for _, name := range stacks {
+				dc := client("qbit-"+name, "qbittorrent", "radarr")
+				dc.DownloadStackRef.Name = name
+				c.Spec.DownloadClients = append(c.Spec.DownloadClients, dc)
+			}
+			return c
+		}
+		queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		DeferCleanup(queue.ShutDown)
+
+		r := &CategoryContractReconciler{}
+		r.appConfigHandler().Update(context.Background(), event.UpdateEvent{ObjectOld: config("old", "kept"), ObjectNew: config("kept", "new")}, queue)
+
+		var names []string
+		for queue.Len() > 0 {
+			req, _ := queue.Get()
+			Expect(req.Namespace).To(Equal("media"))
+			names = append(names, req.Name)
+			queue.Done(req)
+		}
+		Expect(names).To(ConsistOf("old", "kept", "new"))
+	})
+})
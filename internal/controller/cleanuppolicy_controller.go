@@ -0,0 +1,319 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/cleanup"
+	"github.com/poiley/nebularr-operator/internal/metrics"
+)
+
+const (
+	// defaultCleanupInterval is the time between cleanup runs when spec.interval is unset
+	defaultCleanupInterval = 24 * time.Hour
+
+	// defaultCleanupMaxDeletions caps deletions per run when spec.maxDeletionsPerRun is unset
+	defaultCleanupMaxDeletions = 10
+
+	// maxCleanupCandidates caps the candidates listed in status
+	maxCleanupCandidates = 50
+
+	// reasonCleanupApplyFailed marks a run that evaluated the rules but failed
+	// to change some items. The run still counts, so it is not retried early.
+	reasonCleanupApplyFailed = "ApplyFailed"
+
+	// reasonCleanupApplying marks a run recorded before it changes items, so a
+	// run whose final status update fails still counts against the interval
+	reasonCleanupApplying = "Applying"
+)
+
+// errNoTautulliRef is returned when a WatchedUnmonitored rule has no watch history to use
+var errNoTautulliRef = errors.New("WatchedUnmonitored rules require spec.tautulliRef")
+
+// CleanupPolicyReconciler runs the cleanup rules of a CleanupPolicy against
+// the library of its target Radarr or Sonarr
+type CleanupPolicyReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Helper   *ReconcileHelper
+	Recorder record.EventRecorder
+
+	Options ControllerOptions
+}
+
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=cleanuppolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=cleanuppolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=cleanuppolicies/finalizers,verbs=update
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=radarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=sonarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=tautulliconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile runs the cleanup rules once the interval since the last run has
+// passed or the spec changed
+func (r *CleanupPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	policy := &arrv1alpha1.CleanupPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !policy.DeletionTimestamp.IsZero() || policy.Spec.Suspend {
+		return ctrl.Result{}, nil
+	}
+
+	interval := defaultCleanupInterval
+	if policy.Spec.Interval != nil {
+		interval = policy.Spec.Interval.Duration
+	}
+	now := time.Now()
+	if wait := cleanupWait(policy, interval, now); wait > 0 {
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	fail := func(reason string, err error) (ctrl.Result, error) {
+		setCleanupCondition(policy, metav1.ConditionFalse, reason, err.Error())
+		if statusErr := r.Status().Update(ctx, policy); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+	}
+
+	library, libraryType, err := r.library(ctx, policy)
+	if err != nil {
+		return fail("TargetUnavailable", err)
+	}
+
+	var history cleanup.WatchHistory
+	if needsWatchHistory(policy.Spec.Rules) {
+		history, err = r.watchHistory(ctx, policy, libraryType)
+		if errors.Is(err, errNoTautulliRef) {
+			// Retrying cannot help; the next spec change triggers a reconcile
+			setCleanupCondition(policy, metav1.ConditionFalse, "InvalidSpec", err.Error())
+			return ctrl.Result{}, r.Status().Update(ctx, policy)
+		}
+		if err != nil {
+			return fail("WatchHistoryUnavailable", err)
+		}
+	}
+
+	items, err := library.List(ctx)
+	if err != nil {
+		return fail("ListFailed", err)
+	}
+
+	dryRun := policy.Spec.DryRun == nil || *policy.Spec.DryRun
+	maxDeletions := defaultCleanupMaxDeletions
+	if policy.Spec.MaxDeletionsPerRun != nil {
+		maxDeletions = int(*policy.Spec.MaxDeletionsPerRun)
+	}
+
+	candidates := cleanup.Evaluate(items, policy.Spec.Rules, history, policy.Spec.ExcludeTags, now)
+
+	// Record the run before deleting anything: if the status can't be written,
+	// nothing is deleted, and a run that fails afterwards isn't repeated
+	// before the interval, which would get past maxDeletionsPerRun
+	runTime := metav1.NewTime(now)
+	if !dryRun && len(candidates) > 0 {
+		policy.Status.LastRun = &runTime
+		setCleanupCondition(policy, metav1.ConditionFalse, reasonCleanupApplying,
+			fmt.Sprintf("Applying cleanup to %d matched items", len(candidates)))
+		if err := r.Status().Update(ctx, policy); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to record cleanup run: %w", err)
+		}
+	}
+
+	result, applyErr := cleanup.Apply(ctx, library, candidates, dryRun, maxDeletions)
+
+	policyName := policy.Namespace + "/" + policy.Name
+	metrics.RecordCleanupActions(policyName, cleanup.ActionDelete, result.Deleted)
+	metrics.RecordCleanupActions(policyName, cleanup.ActionUnmonitor, result.Unmonitored)
+
+	policy.Status.LastRun = &runTime
+	policy.Status.Matched = int32(len(candidates))
+	policy.Status.Deleted = int32(result.Deleted)
+	policy.Status.Unmonitored = int32(result.Unmonitored)
+	policy.Status.Deferred = int32(result.Deferred)
+	policy.Status.Candidates = cleanupCandidateStatus(candidates)
+
+	summary := fmt.Sprintf("Matched %d of %d items: deleted %d, unmonitored %d, deferred %d",
+		len(candidates), len(items), result.Deleted, result.Unmonitored, result.Deferred)
+	if dryRun {
+		summary = fmt.Sprintf("Dry run matched %d of %d items", len(candidates), len(items))
+	}
+
+	switch {
+	case applyErr != nil:
+		log.Error(applyErr, "Failed to apply cleanup")
+		r.Recorder.Eventf(policy, corev1.EventTypeWarning, reasonCleanupApplyFailed, "%s; %v", summary, applyErr)
+		setCleanupCondition(policy, metav1.ConditionFalse, reasonCleanupApplyFailed, applyErr.Error())
+	case dryRun:
+		setCleanupCondition(policy, metav1.ConditionTrue, "DryRun", summary)
+	default:
+		if result.Deleted+result.Unmonitored > 0 {
+			log.Info("Applied cleanup", "deleted", result.Deleted, "unmonitored", result.Unmonitored, "deferred", result.Deferred)
+			r.Recorder.Event(policy, corev1.EventTypeNormal, "CleanupApplied", summary)
+		}
+		setCleanupCondition(policy, metav1.ConditionTrue, "Applied", summary)
+	}
+
+	if err := r.Status().Update(ctx, policy); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.Options.requeueAfter(interval)}, nil
+}
+
+// cleanupWait returns how long until the next run is due, or zero when a run
+// is due now: no run completed yet, the spec changed since, or the last
+// attempt failed before it evaluated the rules. A run that started changing
+// items counts even when its result was never recorded.
+func cleanupWait(policy *arrv1alpha1.CleanupPolicy, interval time.Duration, now time.Time) time.Duration {
+	if policy.Status.LastRun == nil {
+		return 0
+	}
+	ready := meta.FindStatusCondition(policy.Status.Conditions, ConditionTypeReady)
+	if ready == nil || ready.ObservedGeneration != policy.Generation {
+		return 0
+	}
+	if ready.Status != metav1.ConditionTrue && ready.Reason != reasonCleanupApplyFailed && ready.Reason != reasonCleanupApplying {
+		return 0
+	}
+	return policy.Status.LastRun.Add(interval).Sub(now)
+}
+
+// setCleanupCondition sets the Ready condition of a CleanupPolicy
+func setCleanupCondition(policy *arrv1alpha1.CleanupPolicy, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: policy.Generation,
+	})
+}
+
+// library connects to the target config's app and returns its library and
+// the matching Tautulli library type
+func (r *CleanupPolicyReconciler) library(ctx context.Context, policy *arrv1alpha1.CleanupPolicy) (cleanup.Library, string, error) {
+	ref := policy.Spec.TargetRef
+	key := types.NamespacedName{Namespace: policy.Namespace, Name: ref.Name}
+
+	var target client.Object
+	var conn arrv1alpha1.ConnectionSpec
+	var libraryType string
+	switch ref.Kind {
+	case "RadarrConfig":
+		config := &arrv1alpha1.RadarrConfig{}
+		target, libraryType = config, "movie"
+		if err := r.Get(ctx, key, config); err != nil {
+			return nil, "", fmt.Errorf("failed to get %s %s: %w", ref.Kind, ref.Name, err)
+		}
+		conn = config.Spec.Connection
+	case "SonarrConfig":
+		config := &arrv1alpha1.SonarrConfig{}
+		target, libraryType = config, "show"
+		if err := r.Get(ctx, key, config); err != nil {
+			return nil, "", fmt.Errorf("failed to get %s %s: %w", ref.Kind, ref.Name, err)
+		}
+		conn = config.Spec.Connection
+	default:
+		return nil, "", fmt.Errorf("unsupported cleanup target kind %q", ref.Kind)
+	}
+
+	resolved, err := r.Helper.ResolveConnectionSecrets(ctx, target, &conn)
+	if err != nil {
+		return nil, "", err
+	}
+	c := httpclient.New(httpclient.ConfigForConnection(connectionIR(target, &conn, resolved)))
+	if ref.Kind == "SonarrConfig" {
+		return &cleanup.SonarrLibrary{Client: c}, libraryType, nil
+	}
+	return &cleanup.RadarrLibrary{Client: c}, libraryType, nil
+}
+
+// watchHistory reads the watch history from the referenced TautulliConfig's Tautulli
+func (r *CleanupPolicyReconciler) watchHistory(ctx context.Context, policy *arrv1alpha1.CleanupPolicy, libraryType string) (cleanup.WatchHistory, error) {
+	if policy.Spec.TautulliRef == nil {
+		return nil, errNoTautulliRef
+	}
+	config := &arrv1alpha1.TautulliConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: policy.Namespace, Name: policy.Spec.TautulliRef.Name}, config); err != nil {
+		return nil, fmt.Errorf("failed to get TautulliConfig %s: %w", policy.Spec.TautulliRef.Name, err)
+	}
+	tautulliClient, err := newTautulliClient(ctx, r.Helper, config)
+	if err != nil {
+		return nil, err
+	}
+	return cleanup.TautulliWatchHistory(ctx, tautulliClient, libraryType)
+}
+
+// needsWatchHistory reports whether any rule matches on watch history
+func needsWatchHistory(rules []arrv1alpha1.CleanupRule) bool {
+	for _, rule := range rules {
+		if rule.Type == arrv1alpha1.CleanupRuleWatchedUnmonitored {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanupCandidateStatus converts the first candidates for status
+func cleanupCandidateStatus(candidates []cleanup.Candidate) []arrv1alpha1.CleanupCandidate {
+	if len(candidates) > maxCleanupCandidates {
+		candidates = candidates[:maxCleanupCandidates]
+	}
+	status := make([]arrv1alpha1.CleanupCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		status = append(status, arrv1alpha1.CleanupCandidate{
+			Rule:     c.Rule.Name,
+			ID:       c.Item.ID,
+			Title:    c.Item.Title,
+			Action:   c.Action,
+			Deferred: c.Deferred,
+		})
+	}
+	return status
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CleanupPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Helper == nil {
+		r.Helper = NewReconcileHelper(r.Client)
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.CleanupPolicy{})
+
+	return r.Options.complete(mgr, b, "cleanuppolicy", &arrv1alpha1.CleanupPolicyList{}, r)
+}
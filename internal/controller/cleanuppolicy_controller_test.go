@@ -0,0 +1,246 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("CleanupPolicy Controller", func() {
+	const namespace = "default"
+
+	var (
+		ctx        context.Context
+		server     *httptest.Server
+		mu         sync.Mutex
+		deleted    []string
+		reconciler *CleanupPolicyReconciler
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		deleted = nil
+
+		// A minimal Radarr with two movies that never got files
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case r.Method == http.MethodDelete:
+				deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/v3/movie/"))
+			case r.URL.Path == "/api/v3/tag":
+				_, _ = w.Write([]byte(`[]`))
+			case r.URL.Path == "/api/v3/movie":
+				_, _ = w.Write([]byte(`[
+					{"id": 1, "title": "Older", "year": 2001, "monitored": true, "isAvailable": true, "added": "2020-01-01T00:00:00Z"},
+					{"id": 2, "title": "Newer", "year": 2002, "monitored": true, "isAvailable": true, "added": "2021-01-01T00:00:00Z"}
+				]`))
+			}
+		}))
+
+		radarr := &arrv1alpha1.RadarrConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "cleanup-radarr", Namespace: namespace},
+			Spec: arrv1alpha1.RadarrConfigSpec{
+				Connection: arrv1alpha1.ConnectionSpec{URL: server.URL},
+			},
+		}
+		Expect(k8sClient.Create(ctx, radarr)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Delete(ctx, radarr)).To(Succeed())
+			server.Close()
+		})
+
+		reconciler = &CleanupPolicyReconciler{
+			Client:   k8sClient,
+			Scheme:   k8sClient.Scheme(),
+			Helper:   NewReconcileHelper(k8sClient),
+			Recorder: record.NewFakeRecorder(10),
+		}
+	})
+
+	createPolicy := func(name string, spec arrv1alpha1.CleanupPolicySpec) types.NamespacedName {
+		spec.TargetRef = arrv1alpha1.CleanupTargetReference{Kind: "RadarrConfig", Name: "cleanup-radarr"}
+		policy := &arrv1alpha1.CleanupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       spec,
+		}
+		Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, policy)).To(Succeed()) })
+		return types.NamespacedName{Name: name, Namespace: namespace}
+	}
+
+	unavailable := []arrv1alpha1.CleanupRule{{Name: "missing", Type: arrv1alpha1.CleanupRuleUnavailable, Days: 30}}
+
+	It("should only report matches in dry run", func() {
+		key := createPolicy("cleanup-dry-run", arrv1alpha1.CleanupPolicySpec{Rules: unavailable})
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		policy := &arrv1alpha1.CleanupPolicy{}
+		Expect(k8sClient.Get(ctx, key, policy)).To(Succeed())
+		Expect(policy.Status.Matched).To(Equal(int32(2)))
+		Expect(policy.Status.Deleted).To(BeZero())
+		Expect(policy.Status.Candidates).To(HaveLen(2))
+		Expect(meta.FindStatusCondition(policy.Status.Conditions, ConditionTypeReady).Reason).To(Equal("DryRun"))
+		Expect(deleted).To(BeEmpty())
+	})
+
+	It("should delete the oldest matches up to the cap and wait for the interval", func() {
+		key := createPolicy("cleanup-apply", arrv1alpha1.CleanupPolicySpec{
+			Rules:              unavailable,
+			DryRun:             ptr.To(false),
+			MaxDeletionsPerRun: ptr.To(int32(1)),
+		})
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(Equal([]string{"1"}))
+
+		policy := &arrv1alpha1.CleanupPolicy{}
+		Expect(k8sClient.Get(ctx, key, policy)).To(Succeed())
+		Expect(policy.Status.Deleted).To(Equal(int32(1)))
+		Expect(policy.Status.Deferred).To(Equal(int32(1)))
+		Expect(policy.Status.Candidates[1].Deferred).To(BeTrue())
+
+		By("not running again before the interval passed")
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(deleted).To(HaveLen(1))
+	})
+
+	It("should reject watch history rules without a TautulliConfig", func() {
+		key := createPolicy("cleanup-no-tautulli", arrv1alpha1.CleanupPolicySpec{
+			Rules: []arrv1alpha1.CleanupRule{{Name: "watched", Type: arrv1alpha1.CleanupRuleWatchedUnmonitored}},
+		})
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		policy := &arrv1alpha1.CleanupPolicy{}
+		Expect(k8sClient.Get(ctx, key, policy)).To(Succeed())
+		ready := meta.FindStatusCondition(policy.Status.Conditions, ConditionTypeReady)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal("InvalidSpec"))
+	})
+})
+
+var _ = Describe("CleanupPolicy run records", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "cleanup", Namespace: "media"}
+
+	var (
+		deleted       []string
+		statusUpdates int
+		failUpdate    int
+		reconciler    *CleanupPolicyReconciler
+	)
+
+	BeforeEach(func() {
+		deleted, statusUpdates, failUpdate = nil, 0, 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodDelete:
+				deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/v3/movie/"))
+			case r.URL.Path == "/api/v3/tag":
+				_, _ = w.Write([]byte(`[]`))
+			case r.URL.Path == "/api/v3/movie":
+				_, _ = w.Write([]byte(`[
+					{"id": 1, "title": "Older", "monitored": true, "isAvailable": true, "added": "2020-01-01T00:00:00Z"},
+					{"id": 2, "title": "Newer", "monitored": true, "isAvailable": true, "added": "2021-01-01T00:00:00Z"}
+				]`))
+			}
+		}))
+		DeferCleanup(server.Close)
+
+		s := runtime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(arrv1alpha1.AddToScheme(s)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(s).
+			WithStatusSubresource(&arrv1alpha1.CleanupPolicy{}).
+			WithObjects(
+				&arrv1alpha1.RadarrConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "movies", Namespace: "media"},
+					Spec:       arrv1alpha1.RadarrConfigSpec{Connection: arrv1alpha1.ConnectionSpec{URL: server.URL}},
+				},
+				&arrv1alpha1.CleanupPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+					Spec: arrv1alpha1.CleanupPolicySpec{
+						TargetRef:          arrv1alpha1.CleanupTargetReference{Kind: "RadarrConfig", Name: "movies"},
+						Rules:              []arrv1alpha1.CleanupRule{{Name: "missing", Type: arrv1alpha1.CleanupRuleUnavailable, Days: 30}},
+						DryRun:             ptr.To(false),
+						MaxDeletionsPerRun: ptr.To(int32(1)),
+					},
+				},
+			).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					statusUpdates++
+					if statusUpdates == failUpdate {
+						return errors.New("conflict")
+					}
+					return c.SubResource(subResource).Update(ctx, obj, opts...)
+				},
+			}).Build()
+		reconciler = &CleanupPolicyReconciler{Client: c, Scheme: s, Helper: NewReconcileHelper(c), Recorder: record.NewFakeRecorder(10)}
+	})
+
+	It("deletes nothing when the run can't be recorded", func() {
+		failUpdate = 1
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).To(MatchError(ContainSubstring("failed to record cleanup run")))
+		Expect(deleted).To(BeEmpty())
+	})
+
+	It("doesn't repeat a run whose result wasn't recorded", func() {
+		failUpdate = 2
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).To(MatchError("conflict"))
+		Expect(deleted).To(Equal([]string{"1"}))
+
+		policy := &arrv1alpha1.CleanupPolicy{}
+		Expect(reconciler.Get(ctx, key, policy)).To(Succeed())
+		Expect(meta.FindStatusCondition(policy.Status.Conditions, ConditionTypeReady).Reason).To(Equal(reasonCleanupApplying))
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(deleted).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,137 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// defaultKubeconfigKey is the Secret key read when kubeconfigSecretRef.key is empty
+const defaultKubeconfigKey = "kubeconfig"
+
+// clusterTarget is the cluster and namespace holding the Secrets, pods and
+// Deployment a connection refers to: the resource's own, or those of
+// connection.cluster
+type clusterTarget struct {
+	helper    *ReconcileHelper
+	namespace string
+}
+
+// ClusterClients caches one client per resource targeting another cluster.
+// Clients are direct (uncached), so nothing of the target cluster enters the
+// manager's informer cache, and each resource keeps its own client, so
+// resources pointing at different clusters never share credentials.
+type ClusterClients struct {
+	mu      sync.Mutex
+	clients map[types.UID]clusterClient
+}
+
+// clusterClient is a cached client and the hash of the kubeconfig it was built from
+type clusterClient struct {
+	hash       string
+	client     client.Client
+	restConfig *rest.Config
+}
+
+// NewClusterClients creates an empty client cache
+func NewClusterClients() *ClusterClients {
+	return &ClusterClients{clients: make(map[types.UID]clusterClient)}
+}
+
+// get returns the client of owner for kubeconfig, building a new one when the
+// kubeconfig changed. Resources without a UID (previews) are not cached.
+func (c *ClusterClients) get(owner client.Object, kubeconfig []byte, newClient func(*rest.Config) (client.Client, error)) (client.Client, *rest.Config, error) {
+	hash := fmt.Sprintf("%x", sha256.Sum256(kubeconfig))
+	uid := owner.GetUID()
+	if c != nil && uid != "" {
+		c.mu.Lock()
+		cached, ok := c.clients[uid]
+		c.mu.Unlock()
+		if ok && cached.hash == hash {
+			return cached.client, cached.restConfig, nil
+		}
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+	cl, err := newClient(restConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create client for the target cluster: %w", err)
+	}
+
+	if c != nil && uid != "" {
+		c.mu.Lock()
+		c.clients[uid] = clusterClient{hash: hash, client: cl, restConfig: restConfig}
+		c.mu.Unlock()
+	}
+	return cl, restConfig, nil
+}
+
+// Forget drops the client of a deleted resource
+func (c *ClusterClients) Forget(owner client.Object) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	delete(c.clients, owner.GetUID())
+	c.mu.Unlock()
+}
+
+// clusterTarget returns where the Kubernetes lookups of conn go. The kubeconfig
+// Secret itself is always read next to owner.
+func (h *ReconcileHelper) clusterTarget(ctx context.Context, owner client.Object, conn *arrv1alpha1.ConnectionSpec) (clusterTarget, error) {
+	if conn == nil || conn.Cluster == nil {
+		return clusterTarget{helper: h, namespace: owner.GetNamespace()}, nil
+	}
+
+	ref := conn.Cluster.KubeconfigSecretRef
+	key := ref.Key
+	if key == "" {
+		key = defaultKubeconfigKey
+	}
+	kubeconfig, err := h.ResolveSecretValue(ctx, owner.GetNamespace(), ref.Name, key)
+	if err != nil {
+		return clusterTarget{}, fmt.Errorf("failed to resolve kubeconfig of connection.cluster: %w", err)
+	}
+	cl, restConfig, err := h.Clusters.get(owner, []byte(kubeconfig), func(cfg *rest.Config) (client.Client, error) {
+		return client.New(cfg, client.Options{Scheme: h.Client.Scheme()})
+	})
+	if err != nil {
+		return clusterTarget{}, fmt.Errorf("connection.cluster: %w", err)
+	}
+
+	namespace := conn.Cluster.Namespace
+	if namespace == "" {
+		namespace = owner.GetNamespace()
+	}
+	return clusterTarget{
+		helper:    &ReconcileHelper{Client: cl, RestConfig: restConfig},
+		namespace: namespace,
+	}, nil
+}
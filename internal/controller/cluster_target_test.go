@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// kubeconfigFor renders a kubeconfig for a REST config using client certificates
+func kubeconfigFor(cfg *rest.Config) []byte {
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.Clusters["target"] = &clientcmdapi.Cluster{Server: cfg.Host, CertificateAuthorityData: cfg.CAData}
+	kubeconfig.AuthInfos["target"] = &clientcmdapi.AuthInfo{ClientCertificateData: cfg.CertData, ClientKeyData: cfg.KeyData}
+	kubeconfig.Contexts["target"] = &clientcmdapi.Context{Cluster: "target", AuthInfo: "target"}
+	kubeconfig.CurrentContext = "target"
+	data, err := clientcmd.Write(*kubeconfig)
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+var _ = Describe("Cluster targets", func() {
+	ctx := context.Background()
+
+	It("caches one client per resource until its kubeconfig changes", func() {
+		clients := NewClusterClients()
+		owner := &arrv1alpha1.SonarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "tv", UID: "uid-1"}}
+		builds := 0
+		newClient := func(*rest.Config) (client.Client, error) {
+			builds++
+			return k8sClient, nil
+		}
+		kubeconfig := kubeconfigFor(cfg)
+
+		_, _, err := clients.get(owner, kubeconfig, newClient)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = clients.get(owner, kubeconfig, newClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(builds).To(Equal(1))
+
+		// Another resource gets its own client
+		other := &arrv1alpha1.SonarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "anime", UID: "uid-2"}}
+		_, _, err = clients.get(other, kubeconfig, newClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(builds).To(Equal(2))
+
+		// A rotated kubeconfig and a forgotten resource build new clients
+		rotated := append(kubeconfigFor(cfg), '\n')
+		_, _, err = clients.get(owner, rotated, newClient)
+		Expect(err).NotTo(HaveOccurred())
+		clients.Forget(owner)
+		_, _, err = clients.get(owner, rotated, newClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(builds).To(Equal(4))
+
+		_, _, err = clients.get(owner, []byte("not a kubeconfig"), newClient)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("resolves connection secrets in the target namespace", func() {
+		targetNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cluster-target"}}
+		Expect(k8sClient.Create(ctx, targetNamespace)).To(Succeed())
+		kubeconfigSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "spoke-kubeconfig", Namespace: "default"},
+			Data:       map[string][]byte{"kubeconfig": kubeconfigFor(cfg)},
+		}
+		apiKeySecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonarr-api-key", Namespace: targetNamespace.Name},
+			Data:       map[string][]byte{"apiKey": []byte("spoke-key")},
+		}
+		Expect(k8sClient.Create(ctx, kubeconfigSecret)).To(Succeed())
+		Expect(k8sClient.Create(ctx, apiKeySecret)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Delete(ctx, kubeconfigSecret)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, apiKeySecret)).To(Succeed())
+		})
+
+		config := &arrv1alpha1.SonarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "tv", Namespace: "default", UID: "uid-tv"}}
+		conn := &arrv1alpha1.ConnectionSpec{
+			URL:             "http://sonarr.media:8989",
+			APIKeySecretRef: &arrv1alpha1.SecretKeySelector{Name: apiKeySecret.Name, Key: "apiKey"},
+			Cluster: &arrv1alpha1.ClusterTargetSpec{
+				KubeconfigSecretRef: arrv1alpha1.KubeconfigSecretRef{Name: kubeconfigSecret.Name},
+				Namespace:           targetNamespace.Name,
+			},
+		}
+		helper := NewReconcileHelper(k8sClient)
+
+		resolved, err := helper.ResolveConnectionSecrets(ctx, config, conn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved["apiKey"]).To(Equal("spoke-key"))
+
+		// Without the cluster the Secret is looked up next to the config
+		conn.Cluster = nil
+		_, err = helper.ResolveConnectionSecrets(ctx, config, conn)
+		Expect(err).To(HaveOccurred())
+	})
+})
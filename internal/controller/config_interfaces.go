@@ -47,10 +47,18 @@ func (a *SonarrConfigAdapter) GetReconciliationSpec() *arrv1alpha1.Reconciliatio
 	return a.Spec.Reconciliation
 }
 
+func (a *SonarrConfigAdapter) GetObserve() bool {
+	return a.Spec.Observe
+}
+
 func (a *SonarrConfigAdapter) GetDownloadClients() []arrv1alpha1.DownloadClientSpec {
 	return a.Spec.DownloadClients
 }
 
+func (a *SonarrConfigAdapter) SetDownloadClients(clients []arrv1alpha1.DownloadClientSpec) {
+	a.Spec.DownloadClients = clients
+}
+
 func (a *SonarrConfigAdapter) GetIndexersSpec() *arrv1alpha1.IndexersSpec {
 	return a.Spec.Indexers
 }
@@ -63,7 +71,47 @@ func (a *SonarrConfigAdapter) GetAuthenticationSpec() *arrv1alpha1.Authenticatio
 	return a.Spec.Authentication
 }
 
-func (a *SonarrConfigAdapter) GetStatusWrapper() ConfigStatus {
+func (a *SonarrConfigAdapter) GetRawRequests() []arrv1alpha1.RawRequestSpec {
+	return a.Spec.Raw
+}
+
+func (a *SonarrConfigAdapter) GetRawRequestStatusPtr() *[]arrv1alpha1.RawRequestStatus {
+	return &a.Status.RawRequests
+}
+
+func (a *SonarrConfigAdapter) GetMediaServerHooks() *arrv1alpha1.MediaServerHooksSpec {
+	return a.Spec.MediaServerHooks
+}
+
+func (a *SonarrConfigAdapter) GetMediaServerStatusPtr() *[]arrv1alpha1.MediaServerStatus {
+	return &a.Status.MediaServers
+}
+
+func (a *SonarrConfigAdapter) GetNotifications() []arrv1alpha1.NotificationSpec {
+	return a.Spec.Notifications
+}
+
+func (a *SonarrConfigAdapter) GetNotificationStatusPtr() *[]arrv1alpha1.NotificationStatus {
+	return &a.Status.Notifications
+}
+
+func (a *SonarrConfigAdapter) GetIndexerStatusPtr() *[]arrv1alpha1.IndexerStatus {
+	return &a.Status.Indexers
+}
+
+func (a *SonarrConfigAdapter) GetPausedResourcesPtr() *[]string {
+	return &a.Status.PausedResources
+}
+
+func (a *SonarrConfigAdapter) GetMaintenanceSpec() *arrv1alpha1.MaintenanceSpec {
+	return a.Spec.Maintenance
+}
+
+func (a *SonarrConfigAdapter) GetMaintenanceStatusPtr() **arrv1alpha1.MaintenanceStatus {
+	return &a.Status.Maintenance
+}
+
+func (a *SonarrConfigAdapter) GetStatusWrapper() SyncedConfigStatus {
 	return &SonarrStatusWrapper{Status: &a.Status}
 }
 
@@ -115,10 +163,18 @@ func (a *RadarrConfigAdapter) GetReconciliationSpec() *arrv1alpha1.Reconciliatio
 	return a.Spec.Reconciliation
 }
 
+func (a *RadarrConfigAdapter) GetObserve() bool {
+	return a.Spec.Observe
+}
+
 func (a *RadarrConfigAdapter) GetDownloadClients() []arrv1alpha1.DownloadClientSpec {
 	return a.Spec.DownloadClients
 }
 
+func (a *RadarrConfigAdapter) SetDownloadClients(clients []arrv1alpha1.DownloadClientSpec) {
+	a.Spec.DownloadClients = clients
+}
+
 func (a *RadarrConfigAdapter) GetIndexersSpec() *arrv1alpha1.IndexersSpec {
 	return a.Spec.Indexers
 }
@@ -131,7 +187,47 @@ func (a *RadarrConfigAdapter) GetAuthenticationSpec() *arrv1alpha1.Authenticatio
 	return a.Spec.Authentication
 }
 
-func (a *RadarrConfigAdapter) GetStatusWrapper() ConfigStatus {
+func (a *RadarrConfigAdapter) GetRawRequests() []arrv1alpha1.RawRequestSpec {
+	return a.Spec.Raw
+}
+
+func (a *RadarrConfigAdapter) GetRawRequestStatusPtr() *[]arrv1alpha1.RawRequestStatus {
+	return &a.Status.RawRequests
+}
+
+func (a *RadarrConfigAdapter) GetMediaServerHooks() *arrv1alpha1.MediaServerHooksSpec {
+	return a.Spec.MediaServerHooks
+}
+
+func (a *RadarrConfigAdapter) GetMediaServerStatusPtr() *[]arrv1alpha1.MediaServerStatus {
+	return &a.Status.MediaServers
+}
+
+func (a *RadarrConfigAdapter) GetNotifications() []arrv1alpha1.NotificationSpec {
+	return a.Spec.Notifications
+}
+
+func (a *RadarrConfigAdapter) GetNotificationStatusPtr() *[]arrv1alpha1.NotificationStatus {
+	return &a.Status.Notifications
+}
+
+func (a *RadarrConfigAdapter) GetIndexerStatusPtr() *[]arrv1alpha1.IndexerStatus {
+	return &a.Status.Indexers
+}
+
+func (a *RadarrConfigAdapter) GetPausedResourcesPtr() *[]string {
+	return &a.Status.PausedResources
+}
+
+func (a *RadarrConfigAdapter) GetMaintenanceSpec() *arrv1alpha1.MaintenanceSpec {
+	return a.Spec.Maintenance
+}
+
+func (a *RadarrConfigAdapter) GetMaintenanceStatusPtr() **arrv1alpha1.MaintenanceStatus {
+	return &a.Status.Maintenance
+}
+
+func (a *RadarrConfigAdapter) GetStatusWrapper() SyncedConfigStatus {
 	return &RadarrStatusWrapper{Status: &a.Status}
 }
 
@@ -183,10 +279,18 @@ func (a *LidarrConfigAdapter) GetReconciliationSpec() *arrv1alpha1.Reconciliatio
 	return a.Spec.Reconciliation
 }
 
+func (a *LidarrConfigAdapter) GetObserve() bool {
+	return a.Spec.Observe
+}
+
 func (a *LidarrConfigAdapter) GetDownloadClients() []arrv1alpha1.DownloadClientSpec {
 	return a.Spec.DownloadClients
 }
 
+func (a *LidarrConfigAdapter) SetDownloadClients(clients []arrv1alpha1.DownloadClientSpec) {
+	a.Spec.DownloadClients = clients
+}
+
 func (a *LidarrConfigAdapter) GetIndexersSpec() *arrv1alpha1.IndexersSpec {
 	return a.Spec.Indexers
 }
@@ -199,7 +303,47 @@ func (a *LidarrConfigAdapter) GetAuthenticationSpec() *arrv1alpha1.Authenticatio
 	return a.Spec.Authentication
 }
 
-func (a *LidarrConfigAdapter) GetStatusWrapper() ConfigStatus {
+func (a *LidarrConfigAdapter) GetRawRequests() []arrv1alpha1.RawRequestSpec {
+	return a.Spec.Raw
+}
+
+func (a *LidarrConfigAdapter) GetRawRequestStatusPtr() *[]arrv1alpha1.RawRequestStatus {
+	return &a.Status.RawRequests
+}
+
+func (a *LidarrConfigAdapter) GetMediaServerHooks() *arrv1alpha1.MediaServerHooksSpec {
+	return nil // Lidarr doesn't support media server hooks
+}
+
+func (a *LidarrConfigAdapter) GetMediaServerStatusPtr() *[]arrv1alpha1.MediaServerStatus {
+	return nil
+}
+
+func (a *LidarrConfigAdapter) GetNotifications() []arrv1alpha1.NotificationSpec {
+	return a.Spec.Notifications
+}
+
+func (a *LidarrConfigAdapter) GetNotificationStatusPtr() *[]arrv1alpha1.NotificationStatus {
+	return nil // Lidarr adapter doesn't support notification tests
+}
+
+func (a *LidarrConfigAdapter) GetIndexerStatusPtr() *[]arrv1alpha1.IndexerStatus {
+	return nil // Lidarr adapter doesn't support indexer tests
+}
+
+func (a *LidarrConfigAdapter) GetPausedResourcesPtr() *[]string {
+	return &a.Status.PausedResources
+}
+
+func (a *LidarrConfigAdapter) GetMaintenanceSpec() *arrv1alpha1.MaintenanceSpec {
+	return nil
+}
+
+func (a *LidarrConfigAdapter) GetMaintenanceStatusPtr() **arrv1alpha1.MaintenanceStatus {
+	return nil // Lidarr adapter doesn't support blocklist maintenance
+}
+
+func (a *LidarrConfigAdapter) GetStatusWrapper() SyncedConfigStatus {
 	return &LidarrStatusWrapper{Status: &a.Status}
 }
 
@@ -252,10 +396,18 @@ func (a *ReadarrConfigAdapter) GetReconciliationSpec() *arrv1alpha1.Reconciliati
 	return a.Spec.Reconciliation
 }
 
+func (a *ReadarrConfigAdapter) GetObserve() bool {
+	return a.Spec.Observe
+}
+
 func (a *ReadarrConfigAdapter) GetDownloadClients() []arrv1alpha1.DownloadClientSpec {
 	return a.Spec.DownloadClients
 }
 
+func (a *ReadarrConfigAdapter) SetDownloadClients(clients []arrv1alpha1.DownloadClientSpec) {
+	a.Spec.DownloadClients = clients
+}
+
 func (a *ReadarrConfigAdapter) GetIndexersSpec() *arrv1alpha1.IndexersSpec {
 	return a.Spec.Indexers
 }
@@ -268,7 +420,47 @@ func (a *ReadarrConfigAdapter) GetAuthenticationSpec() *arrv1alpha1.Authenticati
 	return a.Spec.Authentication
 }
 
-func (a *ReadarrConfigAdapter) GetStatusWrapper() ConfigStatus {
+func (a *ReadarrConfigAdapter) GetRawRequests() []arrv1alpha1.RawRequestSpec {
+	return a.Spec.Raw
+}
+
+func (a *ReadarrConfigAdapter) GetRawRequestStatusPtr() *[]arrv1alpha1.RawRequestStatus {
+	return &a.Status.RawRequests
+}
+
+func (a *ReadarrConfigAdapter) GetMediaServerHooks() *arrv1alpha1.MediaServerHooksSpec {
+	return nil // Readarr doesn't support media server hooks
+}
+
+func (a *ReadarrConfigAdapter) GetMediaServerStatusPtr() *[]arrv1alpha1.MediaServerStatus {
+	return nil
+}
+
+func (a *ReadarrConfigAdapter) GetNotifications() []arrv1alpha1.NotificationSpec {
+	return a.Spec.Notifications
+}
+
+func (a *ReadarrConfigAdapter) GetNotificationStatusPtr() *[]arrv1alpha1.NotificationStatus {
+	return nil // Readarr adapter doesn't support notification tests
+}
+
+func (a *ReadarrConfigAdapter) GetIndexerStatusPtr() *[]arrv1alpha1.IndexerStatus {
+	return nil // Readarr adapter doesn't support indexer tests
+}
+
+func (a *ReadarrConfigAdapter) GetPausedResourcesPtr() *[]string {
+	return &a.Status.PausedResources
+}
+
+func (a *ReadarrConfigAdapter) GetMaintenanceSpec() *arrv1alpha1.MaintenanceSpec {
+	return nil
+}
+
+func (a *ReadarrConfigAdapter) GetMaintenanceStatusPtr() **arrv1alpha1.MaintenanceStatus {
+	return nil // Readarr adapter doesn't support blocklist maintenance
+}
+
+func (a *ReadarrConfigAdapter) GetStatusWrapper() SyncedConfigStatus {
 	return &ReadarrStatusWrapper{Status: &a.Status}
 }
 
@@ -0,0 +1,240 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch
+
+const (
+	// ReasonConnectionFailed is the Connected reason for errors that are not classified further
+	ReasonConnectionFailed = "ConnectionFailed"
+
+	// ReasonAppUnavailable is the Connected reason when the service refuses or drops
+	// connections, or a proxy in front of it reports it down
+	ReasonAppUnavailable = "AppUnavailable"
+
+	// ReasonUnauthorized is the Connected reason when the service rejects the API key
+	ReasonUnauthorized = "Unauthorized"
+
+	// ReasonPending is the Ready reason while the service's Deployment rolls out
+	ReasonPending = "Pending"
+
+	// ReasonValidationFailed is the Ready reason when the service rejects a request as invalid
+	ReasonValidationFailed = "ValidationFailed"
+
+	// ReasonNotFound is the Ready reason when the service lacks a resource a request refers to
+	ReasonNotFound = "NotFound"
+
+	// AuthRetryInterval is the requeue interval while the service rejects the API key.
+	// A new key only takes effect once its Secret changes, so retrying fast won't help.
+	AuthRetryInterval = 15 * time.Minute
+)
+
+// connectionFailureReason classifies a connection error by its adapter error
+// category as Unauthorized, AppUnavailable (transient) or ConnectionFailed
+func connectionFailureReason(err error) string {
+	switch adapters.CategoryOf(err) {
+	case adapters.ErrorCategoryAuth:
+		return ReasonUnauthorized
+	case adapters.ErrorCategoryTransient:
+		return ReasonAppUnavailable
+	}
+	return ReasonConnectionFailed
+}
+
+// errorReason returns the Ready reason for an error in a reconcile stage: that of
+// its adapter error category, or stageReason for an error not classified further
+func errorReason(err error, stageReason string) string {
+	switch adapters.CategoryOf(err) {
+	case adapters.ErrorCategoryAuth:
+		return ReasonUnauthorized
+	case adapters.ErrorCategoryTransient:
+		return ReasonAppUnavailable
+	case adapters.ErrorCategoryValidation:
+		return ReasonValidationFailed
+	case adapters.ErrorCategoryNotFound:
+		return ReasonNotFound
+	}
+	return stageReason
+}
+
+// errorRequeue returns the result of a reconcile that failed with err. Errors
+// that fail the same way on every retry are not returned, which would back off
+// from ErrorRequeueInterval; they requeue at a fixed interval instead.
+// Transient errors are handled by HandleUnavailable before this.
+func errorRequeue(err error) (ctrl.Result, error) {
+	switch adapters.CategoryOf(err) {
+	case adapters.ErrorCategoryAuth:
+		return ctrl.Result{RequeueAfter: AuthRetryInterval}, nil
+	case adapters.ErrorCategoryValidation, adapters.ErrorCategoryNotFound:
+		return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
+	}
+	return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+}
+
+// HandleUnavailable reports a service that can't be reached. While its
+// Deployment rolls out, Ready is Unknown with reason Pending; otherwise Ready
+// is False. The returned interval grows with the time the service has been
+// unavailable, from ErrorRequeueInterval up to MaxConnectionBackoff.
+// ok is false for any other error, which the caller handles as before.
+func (h *ReconcileHelper) HandleUnavailable(
+	ctx context.Context,
+	obj client.Object,
+	conn *arrv1alpha1.ConnectionSpec,
+	appType string,
+	status ConfigStatus,
+	generation int64,
+	err error,
+) (requeueAfter time.Duration, ok bool) {
+	if connectionFailureReason(err) != ReasonAppUnavailable {
+		return 0, false
+	}
+	log := logf.FromContext(ctx)
+	now := time.Now()
+
+	// Measure from when the service became unavailable, not from an earlier failure of another kind
+	since := now
+	if connected := meta.FindStatusCondition(status.GetConditions(), ConditionTypeConnected); connected != nil &&
+		connected.Status == metav1.ConditionFalse && connected.Reason == ReasonAppUnavailable {
+		since = connected.LastTransitionTime.Time
+	}
+
+	status.SetConnected(false)
+	h.SetCondition(status, generation, ConditionTypeConnected, metav1.ConditionFalse, ReasonAppUnavailable, err.Error())
+
+	rollout, rolloutErr := h.deploymentRollout(ctx, obj, conn)
+	if rolloutErr != nil {
+		log.Error(rolloutErr, "Failed to check the service's Deployment (non-fatal)")
+	}
+	if rollout != "" {
+		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionUnknown, ReasonPending, rollout)
+	} else {
+		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, ReasonAppUnavailable,
+			fmt.Sprintf("Cannot reach %s", appType))
+	}
+
+	requeueAfter = connectionBackoff(since, now)
+	log.Info("Service unavailable, backing off", "app", appType, "pending", rollout != "", "requeueAfter", requeueAfter, "error", err.Error())
+	return requeueAfter, true
+}
+
+// connectionBackoff returns the time unavailable so far, clamped to
+// [ErrorRequeueInterval, MaxConnectionBackoff], so retries double in spacing
+func connectionBackoff(since, now time.Time) time.Duration {
+	backoff := now.Sub(since)
+	if backoff < ErrorRequeueInterval {
+		return ErrorRequeueInterval
+	}
+	if backoff > MaxConnectionBackoff {
+		return MaxConnectionBackoff
+	}
+	return backoff
+}
+
+// deploymentRollout describes the rollout of the service's Deployment, or
+// returns "" when it is complete or no Deployment is known
+func (h *ReconcileHelper) deploymentRollout(ctx context.Context, obj client.Object, conn *arrv1alpha1.ConnectionSpec) (string, error) {
+	target, err := h.clusterTarget(ctx, obj, conn)
+	if err != nil {
+		return "", err
+	}
+	deployment, err := target.helper.serviceDeployment(ctx, target.namespace, obj, conn)
+	if err != nil || deployment == nil {
+		return "", err
+	}
+	return deploymentRolloutMessage(deployment), nil
+}
+
+// serviceDeployment returns the Deployment in namespace selected by
+// connection.deployment, otherwise the Deployment owning obj, or nil. Owner
+// references can't span clusters, so with connection.cluster only
+// connection.deployment is looked up.
+func (h *ReconcileHelper) serviceDeployment(ctx context.Context, namespace string, obj client.Object, conn *arrv1alpha1.ConnectionSpec) (*appsv1.Deployment, error) {
+	var name string
+	switch {
+	case conn != nil && conn.Deployment != nil && conn.Deployment.Name != "":
+		name = conn.Deployment.Name
+	case conn != nil && conn.Deployment != nil && len(conn.Deployment.MatchLabels) > 0:
+		var list appsv1.DeploymentList
+		if err := h.Client.List(ctx, &list, client.InNamespace(namespace), client.MatchingLabels(conn.Deployment.MatchLabels)); err != nil {
+			return nil, fmt.Errorf("failed to list Deployments: %w", err)
+		}
+		if len(list.Items) != 1 {
+			return nil, fmt.Errorf("connection.deployment.matchLabels matches %d Deployments, expected 1", len(list.Items))
+		}
+		return &list.Items[0], nil
+	case conn != nil && conn.Cluster != nil:
+		return nil, nil
+	default:
+		for _, ref := range obj.GetOwnerReferences() {
+			if ref.Kind == "Deployment" && strings.HasPrefix(ref.APIVersion, "apps/") {
+				name = ref.Name
+				break
+			}
+		}
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := h.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, deployment); err != nil {
+		return nil, fmt.Errorf("failed to get Deployment %s: %w", name, err)
+	}
+	return deployment, nil
+}
+
+// deploymentRolloutMessage describes what a Deployment is waiting for, like
+// kubectl rollout status, or returns "" once every replica is updated and available
+func deploymentRolloutMessage(d *appsv1.Deployment) string {
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+
+	switch {
+	case d.Generation > d.Status.ObservedGeneration:
+		return fmt.Sprintf("Waiting for Deployment %s to observe its new spec", d.Name)
+	case d.Status.UpdatedReplicas < replicas:
+		return fmt.Sprintf("Waiting for Deployment %s rollout: %d of %d replicas updated",
+			d.Name, d.Status.UpdatedReplicas, replicas)
+	case d.Status.Replicas > d.Status.UpdatedReplicas:
+		return fmt.Sprintf("Waiting for Deployment %s rollout: %d old replicas pending termination",
+			d.Name, d.Status.Replicas-d.Status.UpdatedReplicas)
+	case d.Status.AvailableReplicas < d.Status.UpdatedReplicas:
+		return fmt.Sprintf("Waiting for Deployment %s: %d of %d replicas available",
+			d.Name, d.Status.AvailableReplicas, d.Status.UpdatedReplicas)
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+)
+
+var _ = Describe("Connection health", func() {
+	ctx := context.Background()
+
+	refused := fmt.Errorf("failed to connect to Sonarr: %w", &net.OpError{
+		Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED),
+	})
+
+	It("classifies connection errors", func() {
+		Expect(connectionFailureReason(refused)).To(Equal(ReasonAppUnavailable))
+		Expect(connectionFailureReason(&httpclient.StatusError{Code: 503})).To(Equal(ReasonAppUnavailable))
+		Expect(connectionFailureReason(fmt.Errorf("failed to connect: %w", &httpclient.StatusError{Code: 401}))).To(Equal(ReasonUnauthorized))
+		Expect(connectionFailureReason(&httpclient.StatusError{Code: 500})).To(Equal(ReasonConnectionFailed))
+		Expect(connectionFailureReason(errors.New("tls: bad certificate"))).To(Equal(ReasonConnectionFailed))
+	})
+
+	It("derives condition reasons and requeues from error categories", func() {
+		invalid := fmt.Errorf("failed to create indexer: %w", &httpclient.StatusError{Code: 400})
+		Expect(errorReason(invalid, "ApplyFailed")).To(Equal(ReasonValidationFailed))
+		Expect(errorReason(&httpclient.StatusError{Code: 404}, "StateFetchFailed")).To(Equal(ReasonNotFound))
+		Expect(errorReason(adapters.NewError(adapters.ErrorCategoryAuth, errors.New("bad key")), "DiscoveryFailed")).To(Equal(ReasonUnauthorized))
+		Expect(errorReason(errors.New("boom"), "DiffFailed")).To(Equal("DiffFailed"))
+
+		result, err := errorRequeue(invalid)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultRequeueInterval))
+
+		result, err = errorRequeue(&httpclient.StatusError{Code: 401})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(AuthRetryInterval))
+
+		result, err = errorRequeue(errors.New("boom"))
+		Expect(err).To(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(ErrorRequeueInterval))
+	})
+
+	It("backs off progressively", func() {
+		now := time.Now()
+		Expect(connectionBackoff(now, now)).To(Equal(ErrorRequeueInterval))
+		Expect(connectionBackoff(now.Add(-2*time.Minute), now)).To(Equal(2 * time.Minute))
+		Expect(connectionBackoff(now.Add(-time.Hour), now)).To(Equal(MaxConnectionBackoff))
+	})
+
+	It("describes Deployment rollouts", func() {
+		d := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonarr", Generation: 2},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+			Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 1},
+		}
+		Expect(deploymentRolloutMessage(d)).To(ContainSubstring("1 old replicas pending termination"))
+
+		d.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 1, UpdatedReplicas: 1}
+		Expect(deploymentRolloutMessage(d)).To(ContainSubstring("0 of 1 replicas available"))
+
+		d.Status.AvailableReplicas = 1
+		Expect(deploymentRolloutMessage(d)).To(BeEmpty())
+	})
+
+	It("reports Pending while the owning Deployment rolls out", func() {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "health-sonarr", Namespace: "default"},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "health-sonarr"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "health-sonarr"}},
+					Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "sonarr", Image: "sonarr"}}},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, deployment)).To(Succeed()) })
+
+		config := &arrv1alpha1.SonarrConfig{ObjectMeta: metav1.ObjectMeta{
+			Name: "tv", Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: deployment.Name, UID: deployment.UID}},
+		}}
+		helper := NewReconcileHelper(k8sClient)
+		status := &SonarrStatusWrapper{Status: &config.Status}
+
+		requeueAfter, ok := helper.HandleUnavailable(ctx, config, &config.Spec.Connection, "sonarr", status, 1, refused)
+		Expect(ok).To(BeTrue())
+		Expect(requeueAfter).To(Equal(ErrorRequeueInterval))
+		ready := meta.FindStatusCondition(status.GetConditions(), ConditionTypeReady)
+		Expect(ready.Status).To(Equal(metav1.ConditionUnknown))
+		Expect(ready.Reason).To(Equal(ReasonPending))
+		Expect(ready.Message).To(ContainSubstring("health-sonarr"))
+		Expect(meta.FindStatusCondition(status.GetConditions(), ConditionTypeConnected).Reason).To(Equal(ReasonAppUnavailable))
+
+		// Without a Deployment the service is reported unavailable
+		config.OwnerReferences = nil
+		_, ok = helper.HandleUnavailable(ctx, config, &config.Spec.Connection, "sonarr", status, 1, refused)
+		Expect(ok).To(BeTrue())
+		ready = meta.FindStatusCondition(status.GetConditions(), ConditionTypeReady)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(ReasonAppUnavailable))
+
+		_, ok = helper.HandleUnavailable(ctx, config, &config.Spec.Connection, "sonarr", status, 1, &httpclient.StatusError{Code: 401})
+		Expect(ok).To(BeFalse())
+	})
+})
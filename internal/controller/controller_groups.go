@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Controller groups, enabled with --controllers. A group's controllers only
+// need the CRDs and RBAC of that group, so the operator can be installed for
+// a single group.
+const (
+	// ControllerGroupArrConfigs covers the *arr, Bazarr and Tautulli configs,
+	// ArrStackHealth and the Prowlarr coordinator
+	ControllerGroupArrConfigs = "arr-configs"
+
+	// ControllerGroupDownloadStack covers DownloadStackConfig
+	ControllerGroupDownloadStack = "download-stack"
+
+	// ControllerGroupPolicies covers CleanupPolicy and RolloutPolicy, which act on
+	// *arr configs and so need arr-configs
+	ControllerGroupPolicies = "policies"
+)
+
+// controllerGroupNames lists every controller group
+var controllerGroupNames = []string{ControllerGroupArrConfigs, ControllerGroupDownloadStack, ControllerGroupPolicies}
+
+// ControllerGroups is the set of enabled controller groups. A nil set enables every group.
+type ControllerGroups map[string]bool
+
+// ParseControllerGroups parses a comma-separated list of controller groups.
+// An empty value or "all" enables every group.
+func ParseControllerGroups(value string) (ControllerGroups, error) {
+	if strings.TrimSpace(value) == "" || strings.TrimSpace(value) == "all" {
+		return nil, nil
+	}
+
+	groups := make(ControllerGroups)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		known := false
+		for _, name := range controllerGroupNames {
+			known = known || entry == name
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown controller group %q: expected one of %s", entry, strings.Join(controllerGroupNames, ", "))
+		}
+		groups[entry] = true
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("no controller group enabled")
+	}
+	if groups[ControllerGroupPolicies] && !groups[ControllerGroupArrConfigs] {
+		return nil, fmt.Errorf("controller group %s requires %s", ControllerGroupPolicies, ControllerGroupArrConfigs)
+	}
+	return groups, nil
+}
+
+// Enabled reports whether every given group is enabled
+func (g ControllerGroups) Enabled(groups ...string) bool {
+	if g == nil {
+		return true
+	}
+	for _, group := range groups {
+		if !g[group] {
+			return false
+		}
+	}
+	return true
+}
+
+// String lists the enabled groups
+func (g ControllerGroups) String() string {
+	if g == nil {
+		return "all"
+	}
+	names := make([]string, 0, len(g))
+	for name := range g {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
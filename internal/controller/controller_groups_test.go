@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Controller groups", func() {
+	It("enables every group by default", func() {
+		for _, value := range []string{"", "all"} {
+			groups, err := ParseControllerGroups(value)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(BeNil())
+			Expect(groups.Enabled(ControllerGroupArrConfigs, ControllerGroupDownloadStack, ControllerGroupPolicies)).To(BeTrue())
+			Expect(groups.String()).To(Equal("all"))
+		}
+	})
+
+	It("enables only the listed groups", func() {
+		groups, err := ParseControllerGroups(" download-stack ")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groups.Enabled(ControllerGroupDownloadStack)).To(BeTrue())
+		Expect(groups.Enabled(ControllerGroupArrConfigs)).To(BeFalse())
+		Expect(groups.Enabled(ControllerGroupArrConfigs, ControllerGroupDownloadStack)).To(BeFalse())
+		Expect(groups.Enabled()).To(BeTrue())
+
+		groups, err = ParseControllerGroups("policies,arr-configs")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groups.String()).To(Equal("arr-configs,policies"))
+	})
+
+	It("rejects unknown groups and policies without arr-configs", func() {
+		_, err := ParseControllerGroups("arr-configs,sabnzbd")
+		Expect(err).To(MatchError(ContainSubstring(`unknown controller group "sabnzbd"`)))
+
+		_, err = ParseControllerGroups("policies")
+		Expect(err).To(MatchError(ContainSubstring("requires arr-configs")))
+
+		_, err = ParseControllerGroups(",")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,84 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// maxResidualChanges caps the residual changes recorded in status
+const maxResidualChanges = 20
+
+// verifyConvergence reads the app back right after an apply and diffs it
+// against the desired state again. Whatever is left didn't stick: usually a
+// value the app accepted but stored differently from what the adapter sent.
+// secretHashes are the hashes the applied credentials are recorded under.
+// The state read back is returned with the residual changes.
+func verifyConvergence(
+	ctx context.Context,
+	adapter adapters.Adapter,
+	connIR *irv1.ConnectionIR,
+	desiredIR *irv1.IR,
+	caps *adapters.Capabilities,
+	scope ManageScope,
+	holds DependencyHolds,
+	secretHashes map[string]string,
+) (*adapters.ChangeSet, *irv1.IR, error) {
+	currentIR, err := adapter.CurrentState(ctx, connIR)
+	if err != nil {
+		return nil, nil, err
+	}
+	scope.Restrict(currentIR)
+	holds.Hold(currentIR)
+	RestoreSecretHashes(currentIR, secretHashes)
+	residual, err := adapter.Diff(currentIR, desiredIR, caps)
+	if err != nil {
+		return nil, nil, err
+	}
+	return residual, currentIR, nil
+}
+
+// setConvergence records whether changes is empty, listing its changes as residual
+func setConvergence(status SyncedConfigStatus, changes *adapters.ChangeSet) {
+	converged := changes.IsEmpty()
+	status.SetConvergence(&converged, residualChanges(changes))
+}
+
+// residualChanges lists the first maxResidualChanges changes of a change set
+func residualChanges(changes *adapters.ChangeSet) []arrv1alpha1.ResidualChange {
+	var residual []arrv1alpha1.ResidualChange
+	add := func(action string, list []adapters.Change) {
+		for _, change := range list {
+			if len(residual) == maxResidualChanges {
+				return
+			}
+			residual = append(residual, arrv1alpha1.ResidualChange{
+				Action:       action,
+				ResourceType: change.ResourceType,
+				Name:         change.Name,
+			})
+		}
+	}
+	add("create", changes.Creates)
+	add("update", changes.Updates)
+	add("delete", changes.Deletes)
+	return residual
+}
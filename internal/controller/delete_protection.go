@@ -0,0 +1,42 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// ReasonDeleteProtected is the event reason when a protected config is deleted
+const ReasonDeleteProtected = "DeleteProtected"
+
+// warnDeleteProtected logs and records a warning that a protected config was
+// deleted without cleaning up the service. The recorder may be nil.
+func warnDeleteProtected(ctx context.Context, recorder record.EventRecorder, obj client.Object) {
+	logf.FromContext(ctx).Info("Config is protected, leaving its managed resources in the service",
+		"annotation", arrv1alpha1.ProtectAnnotation)
+	if recorder != nil {
+		recorder.Eventf(obj, corev1.EventTypeWarning, ReasonDeleteProtected,
+			"Deleted with %s; managed resources were left in the service", arrv1alpha1.ProtectAnnotation)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+var _ = Describe("Delete protection", func() {
+	ctx := context.Background()
+
+	AfterEach(func() {
+		CleanupAdapters()
+	})
+
+	It("removes the finalizer of a protected config without touching the service", func() {
+		cleanups := 0
+		adapter := SetupMockAdapter(adapters.AppRadarr)
+		adapter.CurrentStateFunc = func(ctx context.Context, conn *irv1.ConnectionIR) (*irv1.IR, error) {
+			cleanups++
+			return &irv1.IR{App: adapters.AppRadarr}, nil
+		}
+
+		config := &arrv1alpha1.RadarrConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "protected-radarr",
+				Namespace:   "default",
+				Annotations: map[string]string{arrv1alpha1.ProtectAnnotation: "true"},
+				Finalizers:  []string{RadarrFinalizer},
+			},
+			Spec: arrv1alpha1.RadarrConfigSpec{
+				Connection: arrv1alpha1.ConnectionSpec{URL: "http://radarr.example.com:7878"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, config)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, config)).To(Succeed())
+
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &RadarrConfigReconciler{
+			Client:   k8sClient,
+			Scheme:   k8sClient.Scheme(),
+			Recorder: recorder,
+		}
+		key := types.NamespacedName{Name: config.Name, Namespace: "default"}
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, key, &arrv1alpha1.RadarrConfig{}))).To(BeTrue())
+		Expect(cleanups).To(BeZero())
+		Expect(recorder.Events).To(Receive(ContainSubstring(ReasonDeleteProtected)))
+	})
+
+	It("only honors a true value", func() {
+		obj := &arrv1alpha1.SonarrConfig{}
+		Expect(arrv1alpha1.IsDeleteProtected(obj)).To(BeFalse())
+
+		obj.Annotations = map[string]string{arrv1alpha1.ProtectAnnotation: "yes"}
+		Expect(arrv1alpha1.IsDeleteProtected(obj)).To(BeFalse())
+
+		obj.Annotations[arrv1alpha1.ProtectAnnotation] = "True"
+		Expect(arrv1alpha1.IsDeleteProtected(obj)).To(BeTrue())
+	})
+})
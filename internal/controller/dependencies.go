@@ -0,0 +1,156 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+const (
+	// ConditionTypeDependenciesReady reports whether every DownloadStackConfig a
+	// download client depends on is Ready
+	ConditionTypeDependenciesReady = "DependenciesReady"
+
+	// DependencyRequeueInterval is how often a config with held resources checks its dependencies again
+	DependencyRequeueInterval = 30 * time.Second
+)
+
+// DependencyHolds lists resources held back until the objects they depend on are Ready
+type DependencyHolds struct {
+	// DownloadClients are the compiled names of download clients waiting for a DownloadStackConfig
+	DownloadClients []string
+
+	// Waiting describes each DownloadStackConfig that is not Ready yet
+	Waiting []string
+}
+
+// Pending reports whether anything is held back
+func (d DependencyHolds) Pending() bool {
+	return len(d.DownloadClients) > 0
+}
+
+// RequeueAfter shortens interval so held resources are applied soon after their
+// dependencies become Ready
+func (d DependencyHolds) RequeueAfter(interval time.Duration) time.Duration {
+	if d.Pending() && interval > DependencyRequeueInterval {
+		return DependencyRequeueInterval
+	}
+	return interval
+}
+
+// Hold removes held resources from the IR. Like ManageScope.Restrict it is
+// applied to both the desired and the current state, so a held client is
+// neither created, updated nor deleted.
+func (d DependencyHolds) Hold(ir *irv1.IR) {
+	if ir == nil || !d.Pending() {
+		return
+	}
+	held := func(dc irv1.DownloadClientIR) bool {
+		return slices.Contains(d.DownloadClients, dc.Name)
+	}
+	ir.DownloadClients = slices.DeleteFunc(ir.DownloadClients, held)
+	if ir.Prowlarr != nil {
+		ir.Prowlarr.DownloadClients = slices.DeleteFunc(ir.Prowlarr.DownloadClients, held)
+	}
+}
+
+// CheckDownloadClientDependencies looks up the DownloadStackConfig each download
+// client depends on and holds back the clients whose stack is not Ready. The
+// result is reported as the DependenciesReady condition, which is removed when
+// no client declares a dependency.
+func (h *ReconcileHelper) CheckDownloadClientDependencies(
+	ctx context.Context,
+	namespace, configName string,
+	clients []arrv1alpha1.DownloadClientSpec,
+	status ConfigStatus,
+	generation int64,
+) DependencyHolds {
+	var holds DependencyHolds
+	var declared bool
+	checked := make(map[string]string) // stack name -> reason it is not ready ("" = ready)
+
+	for _, dc := range clients {
+		if dc.DependsOn == nil {
+			continue
+		}
+		declared = true
+
+		stackName := dc.DependsOn.Name
+		reason, ok := checked[stackName]
+		if !ok {
+			reason = h.downloadStackNotReadyReason(ctx, namespace, stackName)
+			checked[stackName] = reason
+			if reason != "" {
+				holds.Waiting = append(holds.Waiting, fmt.Sprintf("DownloadStackConfig %q %s", stackName, reason))
+			}
+		}
+		if reason != "" {
+			holds.DownloadClients = append(holds.DownloadClients, compiler.DownloadClientName(configName, dc.Name))
+		}
+	}
+
+	switch {
+	case !declared:
+		conditions := status.GetConditions()
+		if meta.RemoveStatusCondition(&conditions, ConditionTypeDependenciesReady) {
+			status.SetConditions(conditions)
+		}
+	case holds.Pending():
+		h.SetCondition(status, generation, ConditionTypeDependenciesReady, metav1.ConditionFalse, "WaitingForDownloadStack",
+			fmt.Sprintf("Holding back %d download client(s): %s", len(holds.DownloadClients), strings.Join(holds.Waiting, "; ")))
+	default:
+		h.SetCondition(status, generation, ConditionTypeDependenciesReady, metav1.ConditionTrue, "DependenciesReady",
+			"All referenced DownloadStackConfigs are Ready")
+	}
+
+	return holds
+}
+
+// downloadStackNotReadyReason returns why a DownloadStackConfig is not Ready ("" when it is)
+func (h *ReconcileHelper) downloadStackNotReadyReason(ctx context.Context, namespace, name string) string {
+	stack := &arrv1alpha1.DownloadStackConfig{}
+	if err := h.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, stack); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "not found"
+		}
+		return fmt.Sprintf("could not be read: %v", err)
+	}
+
+	ready := meta.FindStatusCondition(stack.Status.Conditions, ConditionTypeReady)
+	switch {
+	case ready == nil:
+		return "has not reported Ready yet"
+	case ready.ObservedGeneration < stack.Generation:
+		return "has not reconciled its latest spec yet"
+	case ready.Status != metav1.ConditionTrue:
+		return fmt.Sprintf("is not Ready (%s)", ready.Reason)
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+var _ = Describe("Download client dependencies", func() {
+	ctx := context.Background()
+
+	clients := []arrv1alpha1.DownloadClientSpec{
+		{Name: "transmission", URL: "http://transmission:9091", DependsOn: &arrv1alpha1.LocalObjectReference{Name: "deps-stack"}},
+		{Name: "sabnzbd", URL: "http://sabnzbd:8080"},
+	}
+
+	It("holds back clients until the DownloadStackConfig is Ready", func() {
+		helper := NewReconcileHelper(k8sClient)
+		status := &RadarrStatusWrapper{Status: &arrv1alpha1.RadarrConfigStatus{}}
+
+		holds := helper.CheckDownloadClientDependencies(ctx, "default", "movies", clients, status, 1)
+		Expect(holds.DownloadClients).To(Equal([]string{"nebularr-movies-transmission"}))
+		Expect(holds.RequeueAfter(DefaultRequeueInterval)).To(Equal(DependencyRequeueInterval))
+		cond := meta.FindStatusCondition(status.GetConditions(), ConditionTypeDependenciesReady)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Message).To(ContainSubstring("not found"))
+
+		stack := &arrv1alpha1.DownloadStackConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "deps-stack", Namespace: "default"},
+			Spec: arrv1alpha1.DownloadStackConfigSpec{
+				DeploymentRef: arrv1alpha1.LocalObjectReference{Name: "downloads"},
+				Gluetun: arrv1alpha1.GluetunSpec{
+					Provider: arrv1alpha1.GluetunProviderSpec{Name: "mullvad"},
+					VPNType:  "openvpn",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, stack)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, stack)).To(Succeed()) })
+
+		meta.SetStatusCondition(&stack.Status.Conditions, metav1.Condition{
+			Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: "Ready", ObservedGeneration: stack.Generation,
+		})
+		Expect(k8sClient.Status().Update(ctx, stack)).To(Succeed())
+
+		holds = helper.CheckDownloadClientDependencies(ctx, "default", "movies", clients, status, 1)
+		Expect(holds.Pending()).To(BeFalse())
+		Expect(meta.IsStatusConditionTrue(status.GetConditions(), ConditionTypeDependenciesReady)).To(BeTrue())
+
+		Expect(helper.CheckDownloadClientDependencies(ctx, "default", "movies", clients[1:], status, 1).Pending()).To(BeFalse())
+		Expect(meta.FindStatusCondition(status.GetConditions(), ConditionTypeDependenciesReady)).To(BeNil())
+	})
+
+	It("removes held clients from the IR", func() {
+		ir := &irv1.IR{DownloadClients: []irv1.DownloadClientIR{
+			{Name: "nebularr-movies-transmission"},
+			{Name: "nebularr-movies-sabnzbd"},
+		}}
+		DependencyHolds{DownloadClients: []string{"nebularr-movies-transmission"}}.Hold(ir)
+		Expect(ir.DownloadClients).To(HaveLen(1))
+		Expect(ir.DownloadClients[0].Name).To(Equal("nebularr-movies-sabnzbd"))
+	})
+})
@@ -0,0 +1,251 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// Placeholders in logged diffs
+const (
+	diffRedacted = "<redacted>"
+	diffUnset    = "<unset>"
+)
+
+// redactedDiffFields are the field names whose values are never logged.
+// Fields containing them (e.g. nextApiKey) are redacted as well.
+var redactedDiffFields = []string{"apikey", "password", "token", "secret"}
+
+// redactedDiffMaps are the generic key/value maps passed through to the apps
+// (indexer and import list settings, notification fields, headers). Their keys
+// are app defined, e.g. cookie, passkey or webHookUrl, so none of their values
+// are logged, only which keys changed.
+var redactedDiffMaps = []string{"fields", "settings", "extraheaders"}
+
+// FieldDiff is one field that differs between the current and desired state of a resource
+type FieldDiff struct {
+	Path    string `json:"path"`
+	Current string `json:"current"`
+	Desired string `json:"desired"`
+}
+
+// logUpdateDiffs logs the fields each update in changes would change, with
+// credentials redacted. Updates whose current state can't be found in current
+// are logged without fields.
+func logUpdateDiffs(ctx context.Context, current *irv1.IR, changes *adapters.ChangeSet) {
+	log := logf.FromContext(ctx)
+	for _, change := range changes.Updates {
+		existing, ok := findCurrentResource(current, change)
+		if !ok {
+			log.Info("Update diff", "resourceType", change.ResourceType, "name", change.Name, "diff", "current state not found")
+			continue
+		}
+		log.Info("Update diff", "resourceType", change.ResourceType, "name", change.Name,
+			"diff", diffFields(existing, change.Payload))
+	}
+}
+
+// findCurrentResource finds the value in current an update change was computed
+// against: the value of the payload's type with the change's name, or the
+// only value of that type when it has no name (e.g. naming settings).
+func findCurrentResource(current *irv1.IR, change adapters.Change) (interface{}, bool) {
+	if current == nil || change.Payload == nil {
+		return nil, false
+	}
+	want := indirectType(reflect.TypeOf(change.Payload))
+
+	var candidates []reflect.Value
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Pointer, reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		case reflect.Struct:
+			if v.Type() == want {
+				candidates = append(candidates, v)
+				return
+			}
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					walk(v.Field(i))
+				}
+			}
+		}
+	}
+	walk(reflect.ValueOf(current))
+
+	for _, c := range candidates {
+		if name := c.FieldByName("Name"); name.IsValid() && name.Kind() == reflect.String && name.String() == change.Name {
+			return c.Interface(), true
+		}
+	}
+	if len(candidates) == 1 && !candidates[0].FieldByName("Name").IsValid() {
+		return candidates[0].Interface(), true
+	}
+	return nil, false
+}
+
+// indirectType strips pointers from t
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// diffFields lists the fields whose JSON values differ between current and
+// desired, by dotted path. Lists are compared as a whole.
+func diffFields(current, desired interface{}) []FieldDiff {
+	currentFields := flattenJSON(current)
+	desiredFields := flattenJSON(desired)
+
+	paths := make(map[string]bool, len(currentFields)+len(desiredFields))
+	for p := range currentFields {
+		paths[p] = true
+	}
+	for p := range desiredFields {
+		paths[p] = true
+	}
+
+	var diffs []FieldDiff
+	for p := range paths {
+		got, hasGot := currentFields[p]
+		want, hasWant := desiredFields[p]
+		if hasGot && hasWant && renderJSON(got) == renderJSON(want) {
+			continue
+		}
+		diff := FieldDiff{Path: p, Current: diffUnset, Desired: diffUnset}
+		if hasGot {
+			diff.Current = renderDiffValue(p, got)
+		}
+		if hasWant {
+			diff.Desired = renderDiffValue(p, want)
+		}
+		diffs = append(diffs, diff)
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+	return diffs
+}
+
+// flattenJSON renders v as JSON and returns its leaf values by dotted path
+func flattenJSON(v interface{}) map[string]interface{} {
+	fields := make(map[string]interface{})
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fields
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fields
+	}
+
+	var flatten func(prefix string, value interface{})
+	flatten = func(prefix string, value interface{}) {
+		if obj, ok := value.(map[string]interface{}); ok && (prefix == "" || len(obj) > 0) {
+			for k, child := range obj {
+				path := k
+				if prefix != "" {
+					path = prefix + "." + k
+				}
+				flatten(path, child)
+			}
+			return
+		}
+		fields[prefix] = value
+	}
+	flatten("", decoded)
+	return fields
+}
+
+// renderDiffValue renders the value at path for the log, with credentials
+// redacted, including those nested in lists
+func renderDiffValue(path string, value interface{}) string {
+	if isRedactedField(path) {
+		return diffRedacted
+	}
+	return renderJSON(redactJSON(value))
+}
+
+// redactJSON returns a copy of a decoded JSON value with credential fields redacted
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for k, child := range v {
+			if isRedactedField(k) || isRedactedMap(k) {
+				redacted[k] = diffRedacted
+			} else {
+				redacted[k] = redactJSON(child)
+			}
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, child := range v {
+			redacted[i] = redactJSON(child)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// renderJSON renders a decoded JSON value compactly, without escaping <, > and &
+func renderJSON(value interface{}) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// isRedactedField reports whether the last segment of path names a credential,
+// or path lies in one of the generic maps
+func isRedactedField(path string) bool {
+	segments := strings.Split(path, ".")
+	name := strings.ToLower(segments[len(segments)-1])
+	for _, secret := range redactedDiffFields {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return slices.ContainsFunc(segments, isRedactedMap)
+}
+
+// isRedactedMap reports whether name is one of the generic maps whose values are never logged
+func isRedactedMap(name string) bool {
+	return slices.Contains(redactedDiffMaps, strings.ToLower(name))
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+var _ = Describe("Update diff logging", func() {
+	current := &irv1.IR{Prowlarr: &irv1.ProwlarrIR{Indexers: []irv1.ProwlarrIndexerIR{
+		{Name: "nebularr-p-nyaa", Definition: "nyaa", Priority: 25},
+		{Name: "nebularr-p-tracker", Definition: "tracker", Priority: 25, APIKey: "old-key",
+			Settings: map[string]string{"cookie": "a", "passkey": "x"}},
+	}}}
+
+	It("finds the current resource of an update by name", func() {
+		desired := irv1.ProwlarrIndexerIR{Name: "nebularr-p-tracker", Definition: "tracker"}
+		found, ok := findCurrentResource(current, adapters.Change{Name: "nebularr-p-tracker", Payload: desired})
+		Expect(ok).To(BeTrue())
+		Expect(found.(irv1.ProwlarrIndexerIR).APIKey).To(Equal("old-key"))
+
+		_, ok = findCurrentResource(current, adapters.Change{Name: "nebularr-p-missing", Payload: &desired})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("lists changed fields with credentials redacted", func() {
+		desired := irv1.ProwlarrIndexerIR{Name: "nebularr-p-tracker", Definition: "tracker", Priority: 10, APIKey: "new-key",
+			Settings: map[string]string{"cookie": "b", "passkey": "x"}}
+		diffs := diffFields(current.Prowlarr.Indexers[1], desired)
+		Expect(diffs).To(Equal([]FieldDiff{
+			{Path: "apiKey", Current: diffRedacted, Desired: diffRedacted},
+			{Path: "priority", Current: "25", Desired: "10"},
+			{Path: "settings.cookie", Current: diffRedacted, Desired: diffRedacted},
+		}))
+	})
+
+	It("redacts every value of the generic settings and fields maps", func() {
+		indexer := func(settings map[string]string) irv1.ProwlarrIndexerIR {
+			return irv1.ProwlarrIndexerIR{Name: "nebularr-p-tracker", Settings: settings}
+		}
+		diffs := diffFields(
+			indexer(map[string]string{"passkey": "p1", "rsskey": "r1", "pid": "1"}),
+			indexer(map[string]string{"passkey": "p2", "rsskey": "r2", "pid": "2", "cookie": "c"}))
+		Expect(diffs).To(HaveLen(4))
+		for _, diff := range diffs {
+			Expect(diff.Path).To(HavePrefix("settings."))
+			Expect(diff.Desired).To(Equal(diffRedacted))
+		}
+
+		notification := func(fields map[string]interface{}) irv1.NotificationIR {
+			return irv1.NotificationIR{Name: "nebularr-discord", Fields: fields}
+		}
+		diffs = diffFields(
+			notification(map[string]interface{}{"webHookUrl": "https://discord.com/api/webhooks/1/old", "userKey": "u1"}),
+			notification(map[string]interface{}{"webHookUrl": "https://discord.com/api/webhooks/1/new", "userKey": "u2"}))
+		Expect(diffs).To(Equal([]FieldDiff{
+			{Path: "fields.userKey", Current: diffRedacted, Desired: diffRedacted},
+			{Path: "fields.webHookUrl", Current: diffRedacted, Desired: diffRedacted},
+		}))
+
+		diffs = diffFields(notification(nil), notification(map[string]interface{}{"accessTokenSecret": "s"}))
+		Expect(diffs).To(Equal([]FieldDiff{{Path: "fields.accessTokenSecret", Current: diffUnset, Desired: diffRedacted}}))
+	})
+
+	It("redacts generic maps nested in lists", func() {
+		type lists struct {
+			Notifications []irv1.NotificationIR `json:"notifications"`
+		}
+		current := lists{[]irv1.NotificationIR{{Name: "a", Fields: map[string]interface{}{"webHookUrl": "https://hooks.slack.com/x"}}}}
+		desired := lists{[]irv1.NotificationIR{{Name: "b", Fields: map[string]interface{}{"webHookUrl": "https://hooks.slack.com/x"}}}}
+		diffs := diffFields(current, desired)
+		Expect(diffs).To(HaveLen(1))
+		Expect(diffs[0].Current).NotTo(ContainSubstring("hooks.slack.com"))
+		Expect(diffs[0].Desired).To(ContainSubstring(`"fields":"<redacted>"`))
+	})
+
+	It("redacts credentials nested in lists", func() {
+		type server struct {
+			Host     string `json:"host"`
+			Password string `json:"password"`
+		}
+		type servers struct {
+			Servers []server `json:"servers"`
+		}
+		diffs := diffFields(servers{[]server{{"a", "secret"}}}, servers{[]server{{"b", "secret"}}})
+		Expect(diffs).To(HaveLen(1))
+		Expect(diffs[0].Current).To(Equal(`[{"host":"a","password":"<redacted>"}]`))
+		Expect(diffs[0].Desired).NotTo(ContainSubstring("secret"))
+	})
+})
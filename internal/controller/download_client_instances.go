@@ -0,0 +1,217 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// downloadClientInstance points at the status of one download client instance:
+// the unnamed spec.<client> or a named entry of spec.<client>Instances
+type downloadClientInstance struct {
+	client string
+	name   string
+
+	connected   *bool
+	version     *string
+	categories  *[]string
+	newsServers *[]string
+}
+
+// label identifies the instance in logs, unrealized features and effective settings
+func (i downloadClientInstance) label() string {
+	if i.name == "" {
+		return i.client
+	}
+	return i.client + "/" + i.name
+}
+
+// message prefixes err with the instance label for named instances, keeping
+// the messages of the unnamed clients unchanged
+func (i downloadClientInstance) message(err error) string {
+	if i.name == "" {
+		return err.Error()
+	}
+	return i.label() + ": " + err.Error()
+}
+
+// relabel rewrites the "<client>:" prefix of unrealized features for named instances
+func (i downloadClientInstance) relabel(features []arrv1alpha1.UnrealizedFeature) []arrv1alpha1.UnrealizedFeature {
+	if i.name == "" {
+		return features
+	}
+	for j := range features {
+		if rest, ok := strings.CutPrefix(features[j].Feature, i.client+":"); ok {
+			features[j].Feature = i.label() + ":" + rest
+		}
+	}
+	return features
+}
+
+// downloadClientInstanceStatuses lists a status entry for every named instance
+// in spec, keeping what was recorded for instances that still exist
+func downloadClientInstanceStatuses(spec *arrv1alpha1.DownloadStackConfigSpec, previous []arrv1alpha1.DownloadClientInstanceStatus) []arrv1alpha1.DownloadClientInstanceStatus {
+	var statuses []arrv1alpha1.DownloadClientInstanceStatus
+	add := func(client, name string) {
+		for _, p := range previous {
+			if p.Client == client && p.Name == name {
+				statuses = append(statuses, p)
+				return
+			}
+		}
+		statuses = append(statuses, arrv1alpha1.DownloadClientInstanceStatus{Client: client, Name: name})
+	}
+	for _, in := range spec.TransmissionInstances {
+		add("transmission", in.Name)
+	}
+	for _, in := range spec.QBittorrentInstances {
+		add("qbittorrent", in.Name)
+	}
+	for _, in := range spec.DelugeInstances {
+		add("deluge", in.Name)
+	}
+	for _, in := range spec.RTorrentInstances {
+		add("rtorrent", in.Name)
+	}
+	for _, in := range spec.SABnzbdInstances {
+		add("sabnzbd", in.Name)
+	}
+	for _, in := range spec.NZBGetInstances {
+		add("nzbget", in.Name)
+	}
+	return statuses
+}
+
+// hasDownloadClient reports whether spec configures at least one download client
+func hasDownloadClient(spec *arrv1alpha1.DownloadStackConfigSpec) bool {
+	return spec.Transmission != nil || spec.QBittorrent != nil || spec.Deluge != nil ||
+		spec.RTorrent != nil || spec.SABnzbd != nil || spec.NZBGet != nil ||
+		len(spec.TransmissionInstances)+len(spec.QBittorrentInstances)+len(spec.DelugeInstances)+
+			len(spec.RTorrentInstances)+len(spec.SABnzbdInstances)+len(spec.NZBGetInstances) > 0
+}
+
+// downloadClientSync syncs one download client instance
+type downloadClientSync struct {
+	label string
+	run   func(ctx context.Context) error
+}
+
+// downloadClientSyncs returns the syncs of every configured download client
+// instance, unnamed clients first. It resets status.instances to the named
+// instances in spec, which the syncs then fill in.
+func (r *DownloadStackConfigReconciler) downloadClientSyncs(config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper, window ApplyWindowState) []downloadClientSync {
+	spec := &config.Spec
+	status := &config.Status
+	status.Instances = downloadClientInstanceStatuses(spec, status.Instances)
+
+	named := func(client, name string) downloadClientInstance {
+		for i := range status.Instances {
+			if s := &status.Instances[i]; s.Client == client && s.Name == name {
+				return downloadClientInstance{client: client, name: name, connected: &s.Connected, version: &s.Version, categories: &s.Categories, newsServers: &s.NewsServers}
+			}
+		}
+		return downloadClientInstance{client: client, name: name}
+	}
+
+	var syncs []downloadClientSync
+	add := func(inst downloadClientInstance, run func(context.Context, downloadClientInstance) error) {
+		syncs = append(syncs, downloadClientSync{label: inst.label(), run: func(ctx context.Context) error { return run(ctx, inst) }})
+	}
+
+	if spec.Transmission != nil {
+		inst := downloadClientInstance{client: "transmission", connected: &status.TransmissionConnected, version: &status.TransmissionVersion}
+		add(inst, func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileTransmission(ctx, config, statusWrapper, spec.Transmission, inst, window)
+		})
+	}
+	for i := range spec.TransmissionInstances {
+		in := &spec.TransmissionInstances[i]
+		add(named("transmission", in.Name), func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileTransmission(ctx, config, statusWrapper, &in.TransmissionSpec, inst, window)
+		})
+	}
+
+	if spec.QBittorrent != nil {
+		inst := downloadClientInstance{client: "qbittorrent", connected: &status.QBittorrentConnected, version: &status.QBittorrentVersion}
+		add(inst, func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileQBittorrent(ctx, config, statusWrapper, spec.QBittorrent, inst)
+		})
+	}
+	for i := range spec.QBittorrentInstances {
+		in := &spec.QBittorrentInstances[i]
+		add(named("qbittorrent", in.Name), func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileQBittorrent(ctx, config, statusWrapper, &in.QBittorrentSpec, inst)
+		})
+	}
+
+	if spec.Deluge != nil {
+		inst := downloadClientInstance{client: "deluge", connected: &status.DelugeConnected, version: &status.DelugeVersion}
+		add(inst, func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileDeluge(ctx, config, statusWrapper, spec.Deluge, inst)
+		})
+	}
+	for i := range spec.DelugeInstances {
+		in := &spec.DelugeInstances[i]
+		add(named("deluge", in.Name), func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileDeluge(ctx, config, statusWrapper, &in.DelugeSpec, inst)
+		})
+	}
+
+	if spec.RTorrent != nil {
+		inst := downloadClientInstance{client: "rtorrent", connected: &status.RTorrentConnected, version: &status.RTorrentVersion}
+		add(inst, func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileRTorrent(ctx, config, statusWrapper, spec.RTorrent, inst)
+		})
+	}
+	for i := range spec.RTorrentInstances {
+		in := &spec.RTorrentInstances[i]
+		add(named("rtorrent", in.Name), func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileRTorrent(ctx, config, statusWrapper, &in.RTorrentSpec, inst)
+		})
+	}
+
+	if spec.SABnzbd != nil {
+		inst := downloadClientInstance{client: "sabnzbd", connected: &status.SABnzbdConnected, version: &status.SABnzbdVersion, categories: &status.SABnzbdCategories, newsServers: &status.SABnzbdNewsServers}
+		add(inst, func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileSABnzbd(ctx, config, statusWrapper, spec.SABnzbd, inst)
+		})
+	}
+	for i := range spec.SABnzbdInstances {
+		in := &spec.SABnzbdInstances[i]
+		add(named("sabnzbd", in.Name), func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileSABnzbd(ctx, config, statusWrapper, &in.SABnzbdSpec, inst)
+		})
+	}
+
+	if spec.NZBGet != nil {
+		inst := downloadClientInstance{client: "nzbget", connected: &status.NZBGetConnected, version: &status.NZBGetVersion, categories: &status.NZBGetCategories, newsServers: &status.NZBGetNewsServers}
+		add(inst, func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileNZBGet(ctx, config, statusWrapper, spec.NZBGet, inst)
+		})
+	}
+	for i := range spec.NZBGetInstances {
+		in := &spec.NZBGetInstances[i]
+		add(named("nzbget", in.Name), func(ctx context.Context, inst downloadClientInstance) error {
+			return r.reconcileNZBGet(ctx, config, statusWrapper, &in.NZBGetSpec, inst)
+		})
+	}
+
+	return syncs
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters/downloadstack"
+)
+
+// fakeQBittorrent serves canned GET responses and records the forms POSTed to
+// the qBittorrent Web API
+type fakeQBittorrent struct {
+	mu        sync.Mutex
+	responses map[string]string
+	posts     map[string][]url.Values
+}
+
+func newFakeQBittorrent(responses map[string]string) (*fakeQBittorrent, *downloadstack.QBittorrentClient) {
+	fake := &fakeQBittorrent{responses: responses, posts: map[string][]url.Values{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/auth/login" {
+			_, _ = w.Write([]byte("Ok."))
+			return
+		}
+		if r.Method == http.MethodPost {
+			_ = r.ParseForm()
+			fake.mu.Lock()
+			fake.posts[r.URL.Path] = append(fake.posts[r.URL.Path], r.PostForm)
+			fake.mu.Unlock()
+			return
+		}
+		_, _ = w.Write([]byte(fake.responses[r.URL.Path]))
+	}))
+	DeferCleanup(server.Close)
+	return fake, downloadstack.NewQBittorrentClient(server.URL, "admin", "adminadmin")
+}
+
+// posted returns the forms POSTed to path
+func (f *fakeQBittorrent) posted(path string) []url.Values {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.posts[path]
+}
+
+// fakeDeluge answers Deluge Web UI JSON-RPC calls from results and records every method called
+type fakeDeluge struct {
+	mu      sync.Mutex
+	results map[string]any
+	calls   []string
+	params  map[string][]any
+}
+
+func newFakeDeluge(results map[string]any) (*fakeDeluge, *downloadstack.DelugeClient) {
+	fake := &fakeDeluge{results: results, params: map[string][]any{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int64  `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		fake.mu.Lock()
+		fake.calls = append(fake.calls, req.Method)
+		fake.params[req.Method] = req.Params
+		result, ok := fake.results[req.Method]
+		fake.mu.Unlock()
+		if !ok {
+			result = true
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": req.ID, "result": result, "error": nil})
+	}))
+	DeferCleanup(server.Close)
+	return fake, downloadstack.NewDelugeClient(server.URL, "deluge")
+}
+
+// called returns the methods called, in order
+func (f *fakeDeluge) called() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.calls...)
+}
+
+// fakeSABnzbdCategories serves a category list and records the categories set and deleted
+type fakeSABnzbdCategories struct {
+	downloadstack.SABnzbdClientInterface
+	categories []downloadstack.SABnzbdCategory
+	set        []downloadstack.SABnzbdCategory
+	deleted    []string
+	setErr     error
+}
+
+func (f *fakeSABnzbdCategories) GetCategories(context.Context) ([]downloadstack.SABnzbdCategory, error) {
+	return f.categories, nil
+}
+
+func (f *fakeSABnzbdCategories) SetCategory(_ context.Context, category downloadstack.SABnzbdCategory) error {
+	if f.setErr != nil {
+		return f.setErr
+	}
+	f.set = append(f.set, category)
+	return nil
+}
+
+func (f *fakeSABnzbdCategories) DeleteCategory(_ context.Context, name string) error {
+	f.deleted = append(f.deleted, name)
+	return nil
+}
+
+var _ = Describe("Download client categories", func() {
+	It("creates missing qBittorrent categories and updates changed save paths", func() {
+		fake, client := newFakeQBittorrent(map[string]string{
+			"/api/v2/torrents/categories": `{"radarr":{"name":"radarr","savePath":"/old"},"tv":{"name":"tv","savePath":"/tv"},"manual":{"name":"manual","savePath":""}}`,
+		})
+
+		Expect(syncQBittorrentCategories(context.Background(), client, []arrv1alpha1.QBittorrentCategorySpec{
+			{Name: "radarr", SavePath: "/movies"},
+			{Name: "tv", SavePath: "/tv"},
+			{Name: "lidarr", SavePath: "/music"},
+		})).To(Succeed())
+
+		created := fake.posted("/api/v2/torrents/createCategory")
+		Expect(created).To(HaveLen(1))
+		Expect(created[0].Get("category")).To(Equal("lidarr"))
+		Expect(created[0].Get("savePath")).To(Equal("/music"))
+
+		edited := fake.posted("/api/v2/torrents/editCategory")
+		Expect(edited).To(HaveLen(1))
+		Expect(edited[0].Get("category")).To(Equal("radarr"))
+		Expect(edited[0].Get("savePath")).To(Equal("/movies"))
+	})
+
+	It("creates missing qBittorrent tags in one request", func() {
+		fake, client := newFakeQBittorrent(map[string]string{
+			"/api/v2/torrents/tags": `["radarr","manual"]`,
+		})
+
+		Expect(syncQBittorrentTags(context.Background(), client, []string{"radarr", "sonarr", "4k", "sonarr"})).To(Succeed())
+
+		created := fake.posted("/api/v2/torrents/createTags")
+		Expect(created).To(HaveLen(1))
+		Expect(created[0].Get("tags")).To(Equal("sonarr,4k"))
+	})
+
+	It("doesn't create qBittorrent tags that exist", func() {
+		fake, client := newFakeQBittorrent(map[string]string{
+			"/api/v2/torrents/tags": `["radarr","sonarr"]`,
+		})
+
+		Expect(syncQBittorrentTags(context.Background(), client, []string{"sonarr", "radarr"})).To(Succeed())
+		Expect(fake.posted("/api/v2/torrents/createTags")).To(BeEmpty())
+	})
+
+	It("enables the Deluge Label plugin and creates missing labels", func() {
+		fake, client := newFakeDeluge(map[string]any{
+			"web.get_hosts":            []any{},
+			"core.get_enabled_plugins": []string{"Blocklist"},
+			"label.get_labels":         []string{"tv-sonarr"},
+		})
+
+		Expect(syncDelugeLabels(context.Background(), client, []string{"tv-sonarr", "radarr"})).To(Succeed())
+
+		calls := fake.called()
+		Expect(calls).To(ContainElement("core.enable_plugin"))
+		Expect(fake.params["core.enable_plugin"]).To(Equal([]any{"Label"}))
+		Expect(fake.params["label.add"]).To(Equal([]any{"radarr"}))
+	})
+
+	It("leaves an enabled Label plugin alone", func() {
+		fake, client := newFakeDeluge(map[string]any{
+			"web.get_hosts":            []any{},
+			"core.get_enabled_plugins": []string{"Label"},
+			"label.get_labels":         []string{"radarr"},
+		})
+
+		Expect(syncDelugeLabels(context.Background(), client, []string{"radarr"})).To(Succeed())
+		Expect(fake.called()).NotTo(ContainElement("core.enable_plugin"))
+		Expect(fake.called()).NotTo(ContainElement("label.add"))
+	})
+
+	It("sets changed SABnzbd categories and prunes the ones removed from spec", func() {
+		client := &fakeSABnzbdCategories{categories: []downloadstack.SABnzbdCategory{
+			{Name: "*", Dir: "", Script: "Default", Priority: -100},
+			{Name: "movies", Dir: "/movies", Script: "Default", Priority: -100},
+			{Name: "TV", Dir: "/old", Script: "Default", Priority: -100},
+			{Name: "music", Dir: "/music", Script: "Default"},
+			{Name: "manual", Dir: "/manual", Script: "Default"},
+		}}
+
+		managed, err := syncSABnzbdCategories(context.Background(), client, []arrv1alpha1.SABnzbdCategorySpec{
+			{Name: "movies", Dir: "/movies", Priority: -100},
+			{Name: "tv", Dir: "/tv", Priority: -100},
+			{Name: "books", Dir: "/books", Script: "notify.py"},
+		}, []string{"movies", "tv", "music", "*", "gone"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(managed).To(Equal([]string{"movies", "tv", "books"}))
+
+		// Matched case-insensitively; an unchanged category isn't sent
+		Expect(client.set).To(Equal([]downloadstack.SABnzbdCategory{
+			{Name: "tv", Dir: "/tv", Script: "Default", Priority: -100},
+			{Name: "books", Dir: "/books", Script: "notify.py"},
+		}))
+		// Only previously managed categories still in SABnzbd are deleted, never "*"
+		Expect(client.deleted).To(Equal([]string{"music"}))
+	})
+
+	It("keeps tracking previously managed SABnzbd categories when a sync fails", func() {
+		client := &fakeSABnzbdCategories{setErr: errors.New("boom")}
+
+		managed, err := syncSABnzbdCategories(context.Background(), client, []arrv1alpha1.SABnzbdCategorySpec{
+			{Name: "movies", Dir: "/movies"},
+		}, []string{"tv"})
+		Expect(err).To(MatchError(ContainSubstring("failed to set category movies")))
+		Expect(managed).To(Equal([]string{"tv"}))
+		Expect(client.deleted).To(BeEmpty())
+	})
+
+	It("leaves SABnzbd alone without categories", func() {
+		managed, err := syncSABnzbdCategories(context.Background(), &fakeSABnzbdCategories{}, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(managed).To(BeNil())
+	})
+})
@@ -0,0 +1,153 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters/downloadstack"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+)
+
+// reconcileFlood provisions the Flood users and their connection to the
+// torrent client. The password hashes recorded per user tell which passwords
+// were rotated since they were last written. Once spec.flood is removed, the
+// users are deleted through the connection recorded in status.
+func (r *DownloadStackConfigReconciler) reconcileFlood(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper) error {
+	status := &config.Status
+	spec := config.Spec.Flood
+	log := logf.FromContext(ctx).WithValues("client", "flood")
+
+	fail := func(reason string, err error) error {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, reason, "flood: "+err.Error())
+		return err
+	}
+
+	if spec == nil {
+		if err := r.removeFloodUsers(ctx, config); err != nil {
+			log.Error(err, "Failed to delete Flood users")
+			return fail("FloodCleanupFailed", err)
+		}
+		status.FloodConnected = false
+		status.FloodUsers = nil
+		status.FloodUserSecretHashes = nil
+		status.FloodConnection = nil
+		return nil
+	}
+	salt := compiler.SecretSalt(r.Options.SecretKey, config.UID)
+
+	adminName, adminPassword, err := r.resolveCredentialsSecret(ctx, config.Namespace, &spec.Connection.CredentialsSecretRef)
+	if err != nil {
+		return fail("FloodCredentialsFailed", err)
+	}
+	admin := downloadstack.FloodUser{Username: adminName, Password: adminPassword}
+	if admin.Client, err = r.resolveFloodConnection(ctx, config.Namespace, &spec.Client); err != nil {
+		return fail("FloodCredentialsFailed", err)
+	}
+
+	hashes := map[string]string{adminName: compiler.SecretHash(salt, admin.Client.Password)}
+	desired := make([]downloadstack.FloodUser, 0, len(spec.Users))
+	for _, u := range spec.Users {
+		if u.Name == adminName {
+			return fail("FloodSyncFailed", fmt.Errorf("users[%s] is the administrator of connection.credentialsSecretRef", u.Name))
+		}
+		key := u.PasswordSecretRef.Key
+		if key == "" {
+			key = "password"
+		}
+		password, err := r.Helper.ResolveSecretValue(ctx, config.Namespace, u.PasswordSecretRef.Name, key)
+		if err != nil {
+			return fail("FloodCredentialsFailed", fmt.Errorf("users[%s]: %w", u.Name, err))
+		}
+		user := downloadstack.FloodUser{Username: u.Name, Password: password, Level: downloadstack.FloodLevelUser}
+		if u.Admin {
+			user.Level = downloadstack.FloodLevelAdministrator
+		}
+		user.Client = admin.Client
+		if u.Client != nil {
+			if user.Client, err = r.resolveFloodConnection(ctx, config.Namespace, u.Client); err != nil {
+				return fail("FloodCredentialsFailed", fmt.Errorf("users[%s]: %w", u.Name, err))
+			}
+		}
+		hashes[u.Name] = compiler.SecretHash(salt, user.Password, user.Client.Password)
+		desired = append(desired, user)
+	}
+
+	rotated := make(map[string]bool, len(hashes))
+	for name, hash := range hashes {
+		recorded, ok := status.FloodUserSecretHashes[name]
+		rotated[name] = !ok || recorded != hash
+	}
+
+	floodClient := downloadstack.NewFloodClient(spec.Connection.URL, adminName, adminPassword)
+	if err := downloadstack.EnsureFloodAdmin(ctx, floodClient, admin); err != nil {
+		log.Error(err, "Failed to connect to Flood")
+		status.FloodConnected = false
+		return fail("FloodConnectionFailed", err)
+	}
+	status.FloodConnected = true
+	status.FloodConnection = spec.Connection.DeepCopy()
+
+	managed, err := downloadstack.SyncFloodUsers(ctx, floodClient, admin, desired, status.FloodUsers, rotated)
+	status.FloodUsers = managed
+	if err != nil {
+		log.Error(err, "Failed to sync Flood users")
+		return fail("FloodSyncFailed", err)
+	}
+	status.FloodUserSecretHashes = hashes
+
+	if connected, err := floodClient.TestClientConnection(ctx); err != nil || !connected {
+		log.Info("Flood is not connected to the torrent client", "type", spec.Client.Type)
+	}
+
+	log.Info("Flood users synced successfully", "users", len(managed))
+	return nil
+}
+
+// removeFloodUsers deletes the users the operator provisioned in Flood, logging
+// in with the connection they were provisioned through
+func (r *DownloadStackConfigReconciler) removeFloodUsers(ctx context.Context, config *arrv1alpha1.DownloadStackConfig) error {
+	status := &config.Status
+	conn := status.FloodConnection
+	if conn == nil || len(status.FloodUsers) == 0 {
+		return nil
+	}
+	adminName, adminPassword, err := r.resolveCredentialsSecret(ctx, config.Namespace, &conn.CredentialsSecretRef)
+	if err != nil {
+		return err
+	}
+	floodClient := downloadstack.NewFloodClient(conn.URL, adminName, adminPassword)
+	status.FloodUsers, err = downloadstack.DeleteFloodUsers(ctx, floodClient, status.FloodUsers)
+	return err
+}
+
+// resolveFloodConnection renders a Flood client spec with its resolved credentials
+func (r *DownloadStackConfigReconciler) resolveFloodConnection(ctx context.Context, namespace string, spec *arrv1alpha1.FloodClientSpec) (downloadstack.FloodConnectionSettings, error) {
+	var username, password string
+	if spec.CredentialsSecretRef != nil {
+		var err error
+		if username, password, err = r.resolveCredentialsSecret(ctx, namespace, spec.CredentialsSecretRef); err != nil {
+			return downloadstack.FloodConnectionSettings{}, err
+		}
+	}
+	return downloadstack.FloodConnection(spec, username, password), nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+)
+
+// torrentImports reads the queues of the app configs that use config's download
+// clients. queued holds the download IDs the apps haven't imported yet, and
+// observe is true when one of those apps is observed, in which case the stack
+// must not delete or move their downloads either.
+func (r *DownloadStackConfigReconciler) torrentImports(ctx context.Context, config *arrv1alpha1.DownloadStackConfig) (map[string]bool, bool, error) {
+	if !r.Options.Groups.Enabled(ControllerGroupArrConfigs) {
+		return nil, false, nil
+	}
+
+	apps, err := listAppConfigs(ctx, r.Client, config.Namespace)
+	if err != nil {
+		return nil, false, err
+	}
+
+	queued := make(map[string]bool)
+	observe := false
+	for _, app := range apps {
+		if !slices.Contains(referencedStacks(app.GetDownloadClients()), config.Name) {
+			continue
+		}
+		observe = observe || app.GetObserve()
+
+		obj := app.GetObject()
+		adapter, ok := adapters.Get(app.GetAppType())
+		if !ok {
+			continue
+		}
+		reader, ok := adapter.(adapters.QueueReader)
+		if !ok {
+			continue
+		}
+		resolved, err := r.Helper.ResolveConnectionSecrets(ctx, obj, app.GetConnectionSpec())
+		if err != nil {
+			return nil, observe, fmt.Errorf("%s %s: %w", app.GetAppType(), obj.GetName(), err)
+		}
+		ids, err := reader.QueuedDownloadIDs(ctx, connectionIR(obj, app.GetConnectionSpec(), resolved))
+		if err != nil {
+			return nil, observe, fmt.Errorf("failed to read the %s %s queue: %w", app.GetAppType(), obj.GetName(), err)
+		}
+		maps.Copy(queued, ids)
+	}
+	return queued, observe, nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters/downloadstack"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+)
+
+// resolveNewsServers gets the referenced NewsServerPolicies and their credentials
+func (r *DownloadStackConfigReconciler) resolveNewsServers(ctx context.Context, namespace string, refs []arrv1alpha1.NewsServerRef) ([]downloadstack.NewsServer, error) {
+	servers := make([]downloadstack.NewsServer, 0, len(refs))
+	for _, ref := range refs {
+		policy := &arrv1alpha1.NewsServerPolicy{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, policy); err != nil {
+			return nil, fmt.Errorf("failed to get NewsServerPolicy %s: %w", ref.Name, err)
+		}
+		spec := &policy.Spec
+
+		server := downloadstack.NewsServer{
+			Name:        policy.Name,
+			Host:        spec.Host,
+			Port:        spec.Port,
+			UseTLS:      spec.UseTLS == nil || *spec.UseTLS,
+			Connections: spec.Connections,
+			Tier:        spec.Tier,
+			Retention:   spec.Retention,
+			Enabled:     spec.Enabled == nil || *spec.Enabled,
+		}
+		if server.Port == 0 {
+			server.Port = 563
+		}
+		if server.Connections == 0 {
+			server.Connections = 8
+		}
+
+		if creds := spec.CredentialsSecretRef; creds != nil {
+			usernameKey := creds.UsernameKey
+			if usernameKey == "" {
+				usernameKey = "username"
+			}
+			passwordKey := creds.PasswordKey
+			if passwordKey == "" {
+				passwordKey = "password"
+			}
+
+			var err error
+			server.Username, err = r.Helper.ResolveSecretValue(ctx, namespace, creds.Name, usernameKey)
+			if err != nil {
+				return nil, fmt.Errorf("NewsServerPolicy %s: %w", ref.Name, err)
+			}
+			server.Password, err = r.Helper.ResolveSecretValue(ctx, namespace, creds.Name, passwordKey)
+			if err != nil {
+				return nil, fmt.Errorf("NewsServerPolicy %s: %w", ref.Name, err)
+			}
+		}
+
+		servers = append(servers, server)
+	}
+	return servers, nil
+}
+
+// syncSABnzbdNewsServers syncs the SABnzbd servers of one instance. The credential
+// hashes recorded per "<instance>/<server>" tell which passwords were rotated
+// since they were last written; a server without a recorded hash is rewritten once.
+// salt keys the hashes (see compiler.SecretSalt).
+func syncSABnzbdNewsServers(ctx context.Context, sabClient downloadstack.SABnzbdClientInterface, config *arrv1alpha1.DownloadStackConfig, salt string, inst downloadClientInstance, desired []downloadstack.NewsServer) error {
+	status := &config.Status
+	key := func(name string) string { return inst.label() + "/" + name }
+
+	hashes := make(map[string]string, len(desired))
+	rotated := make(map[string]bool, len(desired))
+	for _, server := range desired {
+		hashes[server.Name] = compiler.SecretHash(salt, server.Username, server.Password)
+		recorded, ok := status.NewsServerSecretHashes[key(server.Name)]
+		rotated[server.Name] = !ok || recorded != hashes[server.Name]
+	}
+
+	managed, err := downloadstack.SyncSABnzbdServers(ctx, sabClient, desired, *inst.newsServers, rotated)
+	*inst.newsServers = managed
+	if err != nil {
+		return err
+	}
+
+	// Record the applied hashes and forget those of servers no longer managed
+	for k := range status.NewsServerSecretHashes {
+		if i := strings.LastIndex(k, "/"); i >= 0 && k[:i] == inst.label() {
+			delete(status.NewsServerSecretHashes, k)
+		}
+	}
+	for name, hash := range hashes {
+		if status.NewsServerSecretHashes == nil {
+			status.NewsServerSecretHashes = make(map[string]string, len(hashes))
+		}
+		status.NewsServerSecretHashes[key(name)] = hash
+	}
+	return nil
+}
+
+// referencesNewsServer reports whether any SABnzbd or NZBGet client of spec
+// references the named NewsServerPolicy
+func referencesNewsServer(spec *arrv1alpha1.DownloadStackConfigSpec, name string) bool {
+	var lists [][]arrv1alpha1.NewsServerRef
+	if spec.SABnzbd != nil {
+		lists = append(lists, spec.SABnzbd.NewsServers)
+	}
+	for i := range spec.SABnzbdInstances {
+		lists = append(lists, spec.SABnzbdInstances[i].NewsServers)
+	}
+	if spec.NZBGet != nil {
+		lists = append(lists, spec.NZBGet.NewsServers)
+	}
+	for i := range spec.NZBGetInstances {
+		lists = append(lists, spec.NZBGetInstances[i].NewsServers)
+	}
+	for _, refs := range lists {
+		for _, ref := range refs {
+			if ref.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mapNewsServerPolicyToConfigs enqueues the DownloadStackConfigs that reference a NewsServerPolicy
+func (r *DownloadStackConfigReconciler) mapNewsServerPolicyToConfigs(ctx context.Context, obj client.Object) []reconcile.Request {
+	configs := &arrv1alpha1.DownloadStackConfigList{}
+	if err := r.List(ctx, configs, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, config := range configs.Items {
+		if referencesNewsServer(&config.Spec, obj.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: config.Name, Namespace: config.Namespace},
+			})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// postedPreferences decodes the single setPreferences call a fake qBittorrent received
+func postedPreferences(fake *fakeQBittorrent) map[string]any {
+	posts := fake.posted("/api/v2/app/setPreferences")
+	Expect(posts).To(HaveLen(1))
+	prefs := map[string]any{}
+	Expect(json.Unmarshal([]byte(posts[0].Get("json")), &prefs)).To(Succeed())
+	return prefs
+}
+
+var _ = Describe("qBittorrent settings by version", func() {
+	ctx := context.Background()
+	spec := &arrv1alpha1.QBittorrentSpec{
+		Directories: &arrv1alpha1.QBittorrentDirectoriesSpec{CreateSubfolder: ptr.To(false)},
+		Torrents: &arrv1alpha1.QBittorrentTorrentDefaultsSpec{
+			StartPausedEnabled: ptr.To(true),
+		},
+	}
+
+	It("uses the preference keys of the detected version", func() {
+		for _, tc := range []struct {
+			version string
+			want    []string
+			dropped []string
+		}{
+			{"v4.3.1", []string{"create_subfolder_enabled", "start_paused_enabled"}, []string{"torrent_content_layout", "add_stopped_enabled"}},
+			{"v4.6.2", []string{"torrent_content_layout", "start_paused_enabled"}, []string{"create_subfolder_enabled", "add_stopped_enabled"}},
+			{"v5.0.1", []string{"torrent_content_layout", "add_stopped_enabled"}, []string{"create_subfolder_enabled", "start_paused_enabled"}},
+			// An unreadable version sends the keys of every version
+			{"", []string{"create_subfolder_enabled", "torrent_content_layout", "start_paused_enabled", "add_stopped_enabled"}, nil},
+		} {
+			fake, client := newFakeQBittorrent(nil)
+			unrealized, err := syncQBittorrentSettings(ctx, client, spec, tc.version, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unrealized).To(BeEmpty())
+
+			prefs := postedPreferences(fake)
+			for _, key := range tc.want {
+				Expect(prefs).To(HaveKey(key), "qBittorrent %q", tc.version)
+			}
+			for _, key := range tc.dropped {
+				Expect(prefs).NotTo(HaveKey(key), "qBittorrent %q", tc.version)
+			}
+		}
+	})
+
+	It("maps createSubfolder onto the content layout", func() {
+		fake, client := newFakeQBittorrent(nil)
+		_, err := syncQBittorrentSettings(ctx, client, spec, "v4.6.2", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(postedPreferences(fake)).To(HaveKeyWithValue("torrent_content_layout", "NoSubfolder"))
+	})
+
+	It("reports a content layout the version can't honour", func() {
+		layout := &arrv1alpha1.QBittorrentSpec{
+			Torrents: &arrv1alpha1.QBittorrentTorrentDefaultsSpec{TorrentContentLayout: "Subfolder"},
+		}
+
+		fake, client := newFakeQBittorrent(nil)
+		unrealized, err := syncQBittorrentSettings(ctx, client, layout, "v4.2.5", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unrealized).To(ConsistOf(HaveField("Feature", "qbittorrent:torrentContentLayout")))
+		Expect(unrealized[0].Reason).To(ContainSubstring("4.2.5"))
+		Expect(fake.posted("/api/v2/app/setPreferences")).To(BeEmpty())
+
+		fake, client = newFakeQBittorrent(nil)
+		unrealized, err = syncQBittorrentSettings(ctx, client, layout, "v4.3.2", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unrealized).To(BeEmpty())
+		Expect(postedPreferences(fake)).To(HaveKeyWithValue("torrent_content_layout", "Subfolder"))
+	})
+})
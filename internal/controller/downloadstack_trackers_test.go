@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("Tracker lists", func() {
+	ctx := context.Background()
+
+	trackerList := func(name string) *arrv1alpha1.TrackerListSpec {
+		return &arrv1alpha1.TrackerListSpec{ConfigMapRef: &arrv1alpha1.ConfigMapKeySelector{Name: name, Key: "trackers.txt"}}
+	}
+
+	newReconciler := func() *DownloadStackConfigReconciler {
+		s := runtime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(arrv1alpha1.AddToScheme(s)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(s).
+			WithIndex(&arrv1alpha1.DownloadStackConfig{}, trackerListConfigMapIndex, trackerListConfigMaps).
+			WithObjects(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "public-trackers", Namespace: "media"},
+					Data:       map[string]string{"trackers.txt": "udp://a:1337/announce\n\n\nudp://b:80/announce\n"},
+				},
+				&arrv1alpha1.DownloadStackConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "torrents", Namespace: "media"},
+					Spec: arrv1alpha1.DownloadStackConfigSpec{
+						QBittorrentInstances: []arrv1alpha1.QBittorrentInstanceSpec{{
+							Name: "4k",
+							QBittorrentSpec: arrv1alpha1.QBittorrentSpec{
+								Trackers: &arrv1alpha1.QBittorrentTrackersSpec{AddTrackers: trackerList("public-trackers")},
+							},
+						}},
+					},
+				},
+				&arrv1alpha1.DownloadStackConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "media"},
+					Spec: arrv1alpha1.DownloadStackConfigSpec{
+						Transmission: &arrv1alpha1.TransmissionSpec{
+							Trackers: &arrv1alpha1.TransmissionTrackersSpec{DefaultTrackerList: trackerList("private-trackers")},
+						},
+					},
+				},
+			).Build()
+		return &DownloadStackConfigReconciler{Client: c, Scheme: s}
+	}
+
+	It("renders the inline trackers followed by the ConfigMap's", func() {
+		r := newReconciler()
+		list := trackerList("public-trackers")
+		list.Trackers = []string{"udp://inline:6969/announce"}
+
+		trackers, err := r.resolveTrackerList(ctx, "media", list)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*trackers).To(Equal("udp://inline:6969/announce\nudp://a:1337/announce\n\nudp://b:80/announce"))
+
+		_, err = r.resolveTrackerList(ctx, "media", trackerList("missing"))
+		Expect(err).To(MatchError(ContainSubstring("missing")))
+	})
+
+	It("requeues the stacks whose tracker lists read an edited ConfigMap", func() {
+		r := newReconciler()
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "public-trackers", Namespace: "media"}}
+		Expect(r.mapConfigMapToConfigs(ctx, cm)).To(ConsistOf(
+			HaveField("NamespacedName", types.NamespacedName{Name: "torrents", Namespace: "media"}),
+		))
+
+		cm.Namespace = "other"
+		Expect(r.mapConfigMapToConfigs(ctx, cm)).To(BeEmpty())
+	})
+
+	It("sets qBittorrent's add_trackers preferences", func() {
+		fake, client := newFakeQBittorrent(nil)
+		trackers := "udp://a:1337/announce\n\nudp://b:80/announce"
+		_, err := syncQBittorrentSettings(ctx, client, &arrv1alpha1.QBittorrentSpec{}, "v4.6.2", &trackers)
+		Expect(err).NotTo(HaveOccurred())
+		prefs := postedPreferences(fake)
+		Expect(prefs).To(HaveKeyWithValue("add_trackers_enabled", true))
+		Expect(prefs).To(HaveKeyWithValue("add_trackers", trackers))
+
+		// An empty list turns the option off
+		fake, client = newFakeQBittorrent(nil)
+		empty := ""
+		_, err = syncQBittorrentSettings(ctx, client, &arrv1alpha1.QBittorrentSpec{}, "v4.6.2", &empty)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(postedPreferences(fake)).To(HaveKeyWithValue("add_trackers_enabled", false))
+
+		// Without a list the preferences are left alone
+		fake, client = newFakeQBittorrent(nil)
+		_, err = syncQBittorrentSettings(ctx, client, &arrv1alpha1.QBittorrentSpec{}, "v4.6.2", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.posted("/api/v2/app/setPreferences")).To(BeEmpty())
+	})
+})
@@ -0,0 +1,106 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters/downloadstack"
+)
+
+var _ = Describe("Transmission cluster whitelist", func() {
+	ctx := context.Background()
+
+	var (
+		r      *DownloadStackConfigReconciler
+		config *arrv1alpha1.DownloadStackConfig
+	)
+
+	node := func(name, podCIDR string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: corev1.NodeSpec{PodCIDRs: []string{podCIDR}}}
+	}
+
+	// render reconciles the settings file and returns the rendered rpc-whitelist
+	render := func() string {
+		wrapper := &DownloadStackStatusWrapper{Status: &config.Status}
+		Expect(r.reconcileTransmissionSettingsFile(ctx, config, wrapper, ApplyWindowState{Open: true})).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(r.Get(ctx, client.ObjectKey{Namespace: "media", Name: "torrents-transmission-settings"}, secret)).To(Succeed())
+		settings := map[string]any{}
+		Expect(json.Unmarshal(secret.Data[downloadstack.TransmissionSettingsFileKey], &settings)).To(Succeed())
+		whitelist, _ := settings["rpc-whitelist"].(string)
+		return whitelist
+	}
+
+	BeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(arrv1alpha1.AddToScheme(s)).To(Succeed())
+		config = &arrv1alpha1.DownloadStackConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "torrents", Namespace: "media", UID: "uid-1"},
+			Spec: arrv1alpha1.DownloadStackConfigSpec{
+				Transmission: &arrv1alpha1.TransmissionSpec{
+					Connection:   arrv1alpha1.TransmissionConnectionSpec{URL: "http://transmission:9091"},
+					SettingsFile: &arrv1alpha1.TransmissionSettingsFileSpec{ClusterWhitelist: true},
+				},
+			},
+		}
+		c := fake.NewClientBuilder().WithScheme(s).
+			WithObjects(node("b", "10.244.2.0/24"), node("a", "10.244.1.0/24")).Build()
+		r = &DownloadStackConfigReconciler{Client: c, Scheme: s, Helper: &ReconcileHelper{Client: c}}
+	})
+
+	It("keeps the settings hash when Nodes are replaced", func() {
+		Expect(render()).To(Equal("127.0.0.1,::1,10.244.1.*,10.244.2.*"))
+		hash := config.Status.TransmissionSettingsHash
+		Expect(config.Status.TransmissionClusterWhitelist).To(Equal([]string{"10.244.1.*", "10.244.2.*"}))
+
+		// Node a is replaced by c with a new range, then b leaves
+		Expect(r.Delete(ctx, node("a", ""))).To(Succeed())
+		Expect(r.Create(ctx, node("c", "10.244.3.0/24"))).To(Succeed())
+		Expect(render()).To(Equal("127.0.0.1,::1,10.244.1.*,10.244.2.*,10.244.3.*"))
+		Expect(config.Status.TransmissionSettingsHash).NotTo(Equal(hash))
+		hash = config.Status.TransmissionSettingsHash
+
+		Expect(r.Delete(ctx, node("b", ""))).To(Succeed())
+		Expect(render()).To(Equal("127.0.0.1,::1,10.244.1.*,10.244.2.*,10.244.3.*"))
+		Expect(config.Status.TransmissionSettingsHash).To(Equal(hash))
+
+		// A new Node reusing a freed range changes nothing either
+		Expect(r.Create(ctx, node("d", "10.244.1.0/24"))).To(Succeed())
+		render()
+		Expect(config.Status.TransmissionSettingsHash).To(Equal(hash))
+	})
+
+	It("forgets the ranges when clusterWhitelist is turned off", func() {
+		render()
+		config.Spec.Transmission.SettingsFile.ClusterWhitelist = false
+		Expect(render()).To(BeEmpty())
+		Expect(config.Status.TransmissionClusterWhitelist).To(BeNil())
+	})
+})
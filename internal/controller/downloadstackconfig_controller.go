@@ -19,6 +19,12 @@ package controller
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -26,21 +32,31 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
 	"github.com/poiley/nebularr-operator/internal/adapters/downloadstack"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+	"github.com/poiley/nebularr-operator/internal/metrics"
 )
 
 const (
 	downloadStackFinalizer = "downloadstackconfig.arr.rinzler.cloud/finalizer"
 
 	// Annotation keys for Deployment restart
-	restartAnnotationKey    = "downloadstack.arr.rinzler.cloud/restartedAt"
-	configHashAnnotationKey = "downloadstack.arr.rinzler.cloud/gluetun-hash"
+	restartAnnotationKey                  = "downloadstack.arr.rinzler.cloud/restartedAt"
+	configHashAnnotationKey               = "downloadstack.arr.rinzler.cloud/gluetun-hash"
+	transmissionSettingsHashAnnotationKey = "downloadstack.arr.rinzler.cloud/transmission-settings-hash"
+	rtorrentConfigHashAnnotationKey       = "downloadstack.arr.rinzler.cloud/rtorrent-config-hash"
 )
 
 // TransmissionClientFactory creates Transmission clients.
@@ -56,13 +72,22 @@ type DownloadStackConfigReconciler struct {
 	// TransmissionClientFactory creates Transmission clients.
 	// If nil, uses the default downloadstack.NewTransmissionClient.
 	TransmissionClientFactory TransmissionClientFactory
+
+	// GluetunServers caches Gluetun's server list for validating server
+	// selections. If nil, selections are not validated.
+	GluetunServers *downloadstack.GluetunServerCache
+
+	Options ControllerOptions
 }
 
 // +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=downloadstackconfigs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=downloadstackconfigs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=downloadstackconfigs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=newsserverpolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop
 func (r *DownloadStackConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -110,6 +135,22 @@ func (r *DownloadStackConfigReconciler) reconcileNormal(ctx context.Context, con
 
 	statusWrapper := &DownloadStackStatusWrapper{Status: &config.Status}
 	now := metav1.Now()
+	// Each download client reports the spec fields its version cannot honor
+	// and the settings it ended up with
+	config.Status.Unrealized = nil
+	config.Status.EffectiveSettings = nil
+	config.Status.TrackerRules = nil
+	config.Status.TorrentPolicies = nil
+
+	// Evaluate the apply window (Gluetun changes and restarts are held back while it is closed)
+	window, err := EvaluateApplyWindow(config.Spec.Reconciliation, now.Time)
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "InvalidApplyWindow", err.Error())
+		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+	}
 
 	// =========================================================================
 	// PHASE 1: Gluetun Configuration
@@ -170,50 +211,65 @@ func (r *DownloadStackConfigReconciler) reconcileNormal(ctx context.Context, con
 		gluetunInput.PrivateKey = privateKey
 	}
 
-	// Generate Gluetun env vars
-	gluetunEnv := downloadstack.GenerateGluetunEnv(gluetunInput)
-	newHash := downloadstack.HashGluetunEnv(gluetunEnv)
-
-	// Create/update Gluetun env Secret
-	secretName := config.Name + "-gluetun-env"
-	gluetunSecret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretName,
-			Namespace: config.Namespace,
-		},
-	}
-
-	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, gluetunSecret, func() error {
-		// Set owner reference
-		if err := controllerutil.SetControllerReference(config, gluetunSecret, r.Scheme); err != nil {
-			return err
+	// For WireGuard preshared key
+	if wg := config.Spec.Gluetun.WireGuard; wg != nil && wg.PresharedKeySecretRef != nil {
+		keyRef := wg.PresharedKeySecretRef
+		keyName := keyRef.Key
+		if keyName == "" {
+			keyName = "presharedKey"
 		}
-
-		// Convert env map to StringData
-		gluetunSecret.StringData = gluetunEnv
-		return nil
-	})
-	if err != nil {
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "GluetunSecretFailed", err.Error())
-		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
-			log.Error(statusErr, "Failed to update status")
+		presharedKey, err := r.Helper.ResolveSecretValue(ctx, config.Namespace, keyRef.Name, keyName)
+		if err != nil {
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "GluetunPresharedKeyFailed", err.Error())
+			if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+				log.Error(statusErr, "Failed to update status")
+			}
+			return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 		}
-		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+		gluetunInput.PresharedKey = presharedKey
 	}
 
-	config.Status.GluetunSecretGenerated = true
+	// Warn about server selections that match no server of the provider
+	r.checkGluetunServers(ctx, config, statusWrapper)
+
+	// Generate Gluetun env vars
+	gluetunEnv := downloadstack.GenerateGluetunEnv(gluetunInput)
+	newHash := downloadstack.HashGluetunEnv(gluetunEnv)
 
-	// Check if Gluetun config changed and needs restart
+	// Check if Gluetun config changed and needs restart.
+	// Changes to an already applied config wait for the apply window, since the
+	// restart interrupts all downloads; the initial Secret is always created.
 	configChanged := newHash != config.Status.GluetunConfigHash
-	config.Status.GluetunConfigHash = newHash
+	if configChanged && config.Status.GluetunConfigHash != "" && !window.Open {
+		message := window.PendingMessage("Gluetun configuration change")
+		log.Info("Outside apply window, deferring Gluetun change", "nextWindow", window.NextOpen)
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypePendingChanges, metav1.ConditionTrue, "OutsideApplyWindow", message)
+	} else {
+		r.Helper.clearPendingChanges(statusWrapper)
+		if err := r.applyGluetunSecret(ctx, config, gluetunEnv); err != nil {
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "GluetunSecretFailed", err.Error())
+			if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+				log.Error(statusErr, "Failed to update status")
+			}
+			return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+		}
 
-	// Trigger Deployment restart if config changed
-	if configChanged && config.Spec.RestartOnGluetunChange {
-		if err := r.restartDeployment(ctx, config); err != nil {
-			log.Error(err, "Failed to trigger Deployment restart", "deployment", config.Spec.DeploymentRef.Name)
-			// Don't fail reconciliation for this
-		} else {
-			log.Info("Triggered Deployment restart due to Gluetun config change", "deployment", config.Spec.DeploymentRef.Name)
+		config.Status.GluetunSecretGenerated = true
+		config.Status.GluetunConfigHash = newHash
+
+		// Trigger Deployment restart if config changed
+		if configChanged && config.Spec.RestartOnGluetunChange {
+			if err := r.restartDeployment(ctx, config); err != nil {
+				log.Error(err, "Failed to trigger Deployment restart", "deployment", config.Spec.DeploymentRef.Name)
+				// Don't fail reconciliation for this
+			} else {
+				log.Info("Triggered Deployment restart due to Gluetun config change", "deployment", config.Spec.DeploymentRef.Name)
+			}
+		} else if config.Spec.RestartOnGluetunChange {
+			// A recreated Deployment (e.g., helm upgrade) has lost the hash annotation
+			if err := r.ensureDeploymentHashes(ctx, config); err != nil {
+				log.Error(err, "Failed to restore Gluetun hash annotation", "deployment", config.Spec.DeploymentRef.Name)
+			}
 		}
 	}
 
@@ -222,7 +278,7 @@ func (r *DownloadStackConfigReconciler) reconcileNormal(ctx context.Context, con
 	// =========================================================================
 
 	// Validate at least one download client is configured
-	if config.Spec.Transmission == nil && config.Spec.QBittorrent == nil && config.Spec.Deluge == nil && config.Spec.RTorrent == nil && config.Spec.SABnzbd == nil && config.Spec.NZBGet == nil {
+	if !hasDownloadClient(&config.Spec) {
 		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NoDownloadClient", "At least one download client (Transmission, qBittorrent, Deluge, rTorrent, SABnzbd, or NZBGet) must be configured")
 		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
@@ -230,82 +286,51 @@ func (r *DownloadStackConfigReconciler) reconcileNormal(ctx context.Context, con
 		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, fmt.Errorf("no download client configured")
 	}
 
-	// -------------------------------------------------------------------------
-	// Transmission Configuration (if specified)
-	// -------------------------------------------------------------------------
-	if config.Spec.Transmission != nil {
-		if err := r.reconcileTransmission(ctx, config, statusWrapper); err != nil {
-			// Update status before returning error so conditions are persisted
-			if statusErr := r.Status().Update(ctx, config); statusErr != nil {
-				log.Error(statusErr, "Failed to update status after Transmission error")
-			}
-			return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
-		}
-	}
-
-	// -------------------------------------------------------------------------
-	// qBittorrent Configuration (if specified)
-	// -------------------------------------------------------------------------
-	if config.Spec.QBittorrent != nil {
-		if err := r.reconcileQBittorrent(ctx, config, statusWrapper); err != nil {
-			// Update status before returning error so conditions are persisted
-			if statusErr := r.Status().Update(ctx, config); statusErr != nil {
-				log.Error(statusErr, "Failed to update status after qBittorrent error")
-			}
-			return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+	// Reject values the client settings would otherwise silently skip
+	if invalid := validateDownloadStackSpec(&config.Spec); len(invalid) > 0 {
+		config.Status.InvalidFields = invalidFieldsStatus(invalid)
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "InvalidFields", invalid.Error())
+		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
 		}
+		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, invalid
 	}
+	config.Status.InvalidFields = nil
 
-	// -------------------------------------------------------------------------
-	// Deluge Configuration (if specified)
-	// -------------------------------------------------------------------------
-	if config.Spec.Deluge != nil {
-		if err := r.reconcileDeluge(ctx, config, statusWrapper); err != nil {
-			// Update status before returning error so conditions are persisted
-			if statusErr := r.Status().Update(ctx, config); statusErr != nil {
-				log.Error(statusErr, "Failed to update status after Deluge error")
-			}
-			return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+	// Transmission settings.json (rendered even while Transmission is down, since
+	// it may need the file to start)
+	if err := r.reconcileTransmissionSettingsFile(ctx, config, statusWrapper, window); err != nil {
+		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status after Transmission settings file error")
 		}
+		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 	}
 
-	// -------------------------------------------------------------------------
-	// rTorrent Configuration (if specified)
-	// -------------------------------------------------------------------------
-	if config.Spec.RTorrent != nil {
-		if err := r.reconcileRTorrent(ctx, config, statusWrapper); err != nil {
-			// Update status before returning error so conditions are persisted
-			if statusErr := r.Status().Update(ctx, config); statusErr != nil {
-				log.Error(statusErr, "Failed to update status after rTorrent error")
-			}
-			return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+	// rTorrent rtorrent.rc, for the directories XML-RPC can't set
+	if err := r.reconcileRTorrentConfigFile(ctx, config, statusWrapper, window); err != nil {
+		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status after rTorrent config file error")
 		}
+		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 	}
 
-	// -------------------------------------------------------------------------
-	// SABnzbd Configuration (if specified)
-	// -------------------------------------------------------------------------
-	if config.Spec.SABnzbd != nil {
-		if err := r.reconcileSABnzbd(ctx, config, statusWrapper); err != nil {
+	// Sync every download client instance; the first failure stops the reconcile
+	for _, sync := range r.downloadClientSyncs(config, statusWrapper, window) {
+		if err := sync.run(ctx); err != nil {
 			// Update status before returning error so conditions are persisted
 			if statusErr := r.Status().Update(ctx, config); statusErr != nil {
-				log.Error(statusErr, "Failed to update status after SABnzbd error")
+				log.Error(statusErr, "Failed to update status after download client error", "client", sync.label)
 			}
 			return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 		}
 	}
 
-	// -------------------------------------------------------------------------
-	// NZBGet Configuration (if specified)
-	// -------------------------------------------------------------------------
-	if config.Spec.NZBGet != nil {
-		if err := r.reconcileNZBGet(ctx, config, statusWrapper); err != nil {
-			// Update status before returning error so conditions are persisted
-			if statusErr := r.Status().Update(ctx, config); statusErr != nil {
-				log.Error(statusErr, "Failed to update status after NZBGet error")
-			}
-			return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+	// Flood users, once the clients they connect to are synced
+	if err := r.reconcileFlood(ctx, config, statusWrapper); err != nil {
+		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status after Flood error")
 		}
+		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 	}
 
 	// =========================================================================
@@ -321,58 +346,250 @@ func (r *DownloadStackConfigReconciler) reconcileNormal(ctx context.Context, con
 		return ctrl.Result{}, err
 	}
 
-	// Determine requeue interval
-	requeueAfter := DefaultRequeueInterval
-	if config.Spec.Reconciliation != nil && config.Spec.Reconciliation.Interval != nil {
-		requeueAfter = config.Spec.Reconciliation.Interval.Duration
-	}
+	requeueAfter := r.Options.requeueAfter(r.requeueInterval(&config.Spec))
+	requeueAfter = window.RequeueAfter(requeueAfter, now.Time)
 
 	log.Info("Successfully reconciled DownloadStackConfig", "name", config.Name)
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
-// reconcileTransmission handles Transmission configuration
-func (r *DownloadStackConfigReconciler) reconcileTransmission(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper) error {
-	log := logf.FromContext(ctx)
+// requeueInterval is the periodic requeue interval of spec before jitter.
+// Download client settings rarely drift, so the default is longer than for
+// the *arr configs.
+func (r *DownloadStackConfigReconciler) requeueInterval(spec *arrv1alpha1.DownloadStackConfigSpec) time.Duration {
+	interval := DefaultDownloadStackRequeueInterval
+	if r.Options.DownloadStackInterval > 0 {
+		interval = r.Options.DownloadStackInterval
+	}
+	if spec.Reconciliation != nil && spec.Reconciliation.Interval != nil {
+		interval = spec.Reconciliation.Interval.Duration
+	}
+	// Tracker rules and torrent policies also cover torrents added since the last sync
+	if hasTorrentRules(spec) && interval > DefaultTrackerRulesInterval {
+		interval = DefaultTrackerRulesInterval
+	}
+	return interval
+}
 
-	// Resolve Transmission credentials (optional)
-	var transmissionUsername, transmissionPassword string
-	if config.Spec.Transmission.Connection.CredentialsSecretRef != nil {
-		creds := config.Spec.Transmission.Connection.CredentialsSecretRef
-		usernameKey := creds.UsernameKey
-		if usernameKey == "" {
-			usernameKey = "username"
+// hasTorrentRules reports whether any qBittorrent declares tracker rules or
+// any Transmission a torrent policy
+func hasTorrentRules(spec *arrv1alpha1.DownloadStackConfigSpec) bool {
+	if spec.QBittorrent != nil && len(spec.QBittorrent.TrackerRules) > 0 {
+		return true
+	}
+	for i := range spec.QBittorrentInstances {
+		if len(spec.QBittorrentInstances[i].TrackerRules) > 0 {
+			return true
 		}
-		passwordKey := creds.PasswordKey
-		if passwordKey == "" {
-			passwordKey = "password"
+	}
+	if spec.Transmission != nil && spec.Transmission.TorrentPolicy != nil {
+		return true
+	}
+	for i := range spec.TransmissionInstances {
+		if spec.TransmissionInstances[i].TorrentPolicy != nil {
+			return true
 		}
+	}
+	return false
+}
 
-		var err error
-		transmissionUsername, err = r.Helper.ResolveSecretValue(ctx, config.Namespace, creds.Name, usernameKey)
-		if err != nil {
-			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionCredentialsFailed", err.Error())
-			return err
+// validateDownloadStackSpec checks the ratio strings and tracker patterns of the
+// download clients, which are free-form strings in the CRD and would be skipped
+// if unparsable, as well as Transmission removal rules and news server references.
+func validateDownloadStackSpec(spec *arrv1alpha1.DownloadStackConfigSpec) compiler.FieldErrors {
+	var invalid compiler.FieldErrors
+	checkRatio := func(path, value string) {
+		if value == "" {
+			return
 		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			invalid = append(invalid, compiler.FieldError{Path: path, Value: value, Reason: "not a number, expected a ratio such as 2.0"})
+		}
+	}
 
-		transmissionPassword, err = r.Helper.ResolveSecretValue(ctx, config.Namespace, creds.Name, passwordKey)
-		if err != nil {
-			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionCredentialsFailed", err.Error())
-			return err
+	checkTransmission := func(path string, t *arrv1alpha1.TransmissionSpec) {
+		if t.Seeding != nil {
+			checkRatio(path+".seeding.ratioLimit", t.Seeding.RatioLimit)
+		}
+		if t.TorrentPolicy == nil {
+			return
+		}
+		categories := make(map[string]bool, len(t.TorrentPolicy.Categories))
+		for _, category := range t.TorrentPolicy.Categories {
+			categories[strings.ToLower(category.Name)] = true
+		}
+		for _, rule := range t.TorrentPolicy.RemovalRules {
+			rulePath := fmt.Sprintf("%s.torrentPolicy.removalRules[%s]", path, rule.Name)
+			if _, err := regexp.Compile(rule.Tracker); err != nil {
+				invalid = append(invalid, compiler.FieldError{Path: rulePath + ".tracker", Value: rule.Tracker, Reason: "not a valid regular expression"})
+			}
+			checkRatio(rulePath+".ratioLimit", rule.RatioLimit)
+			// Without a limit the rule would remove every finished torrent
+			if rule.RatioLimit == "" && rule.SeedingTimeLimit == nil {
+				invalid = append(invalid, compiler.FieldError{Path: rulePath, Reason: "needs ratioLimit or seedingTimeLimit"})
+			}
+			if rule.Category != "" && !categories[strings.ToLower(rule.Category)] {
+				invalid = append(invalid, compiler.FieldError{Path: rulePath + ".category", Value: rule.Category, Reason: "not a category of torrentPolicy.categories"})
+			}
+		}
+	}
+	checkQBittorrent := func(path string, qb *arrv1alpha1.QBittorrentSpec) {
+		if qb.Seeding != nil {
+			checkRatio(path+".seeding.maxRatio", qb.Seeding.MaxRatio)
+		}
+		for _, rule := range qb.TrackerRules {
+			rulePath := fmt.Sprintf("%s.trackerRules[%s]", path, rule.Name)
+			if _, err := regexp.Compile(rule.Tracker); err != nil {
+				invalid = append(invalid, compiler.FieldError{Path: rulePath + ".tracker", Value: rule.Tracker, Reason: "not a valid regular expression"})
+			}
+			checkRatio(rulePath+".ratioLimit", rule.RatioLimit)
+		}
+	}
+	// Server names are matched case-insensitively in the clients
+	checkNewsServers := func(path string, refs []arrv1alpha1.NewsServerRef) {
+		seen := make(map[string]bool, len(refs))
+		for _, ref := range refs {
+			if seen[strings.ToLower(ref.Name)] {
+				invalid = append(invalid, compiler.FieldError{Path: path + ".newsServers", Value: ref.Name, Reason: "duplicate news server"})
+			}
+			seen[strings.ToLower(ref.Name)] = true
+		}
+	}
+	checkDeluge := func(path string, d *arrv1alpha1.DelugeSpec) {
+		if d.Seeding != nil {
+			checkRatio(path+".seeding.stopSeedRatio", d.Seeding.StopSeedRatio)
+			checkRatio(path+".seeding.shareRatioLimit", d.Seeding.ShareRatioLimit)
 		}
 	}
 
+	if spec.Transmission != nil {
+		checkTransmission("spec.transmission", spec.Transmission)
+		if sf := spec.Transmission.SettingsFile; sf != nil && sf.RPCAuthenticationRequired != nil &&
+			*sf.RPCAuthenticationRequired && spec.Transmission.Connection.CredentialsSecretRef == nil {
+			invalid = append(invalid, compiler.FieldError{Path: "spec.transmission.settingsFile.rpcAuthenticationRequired",
+				Value: "true", Reason: "requires spec.transmission.connection.credentialsSecretRef"})
+		}
+	}
+	for i := range spec.TransmissionInstances {
+		in := &spec.TransmissionInstances[i]
+		path := fmt.Sprintf("spec.transmissionInstances[%s]", in.Name)
+		checkTransmission(path, &in.TransmissionSpec)
+		// The rendered settings.json Secret is only managed for spec.transmission
+		if in.SettingsFile != nil {
+			invalid = append(invalid, compiler.FieldError{Path: path + ".settingsFile",
+				Reason: "only supported on spec.transmission"})
+		}
+	}
+	// The watch directory is only applied through the rendered rtorrent.rc,
+	// which is only managed for spec.rtorrent
+	if rt := spec.RTorrent; rt != nil && rt.ConfigFile == nil && rt.Directories != nil && rt.Directories.WatchDirectory != "" {
+		invalid = append(invalid, compiler.FieldError{Path: "spec.rtorrent.directories.watchDirectory",
+			Value: rt.Directories.WatchDirectory, Reason: "requires spec.rtorrent.configFile"})
+	}
+	for i := range spec.RTorrentInstances {
+		in := &spec.RTorrentInstances[i]
+		path := fmt.Sprintf("spec.rtorrentInstances[%s]", in.Name)
+		if in.ConfigFile != nil {
+			invalid = append(invalid, compiler.FieldError{Path: path + ".configFile", Reason: "only supported on spec.rtorrent"})
+		}
+		if in.Directories != nil && in.Directories.WatchDirectory != "" {
+			invalid = append(invalid, compiler.FieldError{Path: path + ".directories.watchDirectory",
+				Value: in.Directories.WatchDirectory, Reason: "requires spec.rtorrent.configFile"})
+		}
+	}
+	if spec.QBittorrent != nil {
+		checkQBittorrent("spec.qbittorrent", spec.QBittorrent)
+	}
+	for i := range spec.QBittorrentInstances {
+		in := &spec.QBittorrentInstances[i]
+		checkQBittorrent(fmt.Sprintf("spec.qbittorrentInstances[%s]", in.Name), &in.QBittorrentSpec)
+	}
+	if spec.Deluge != nil {
+		checkDeluge("spec.deluge", spec.Deluge)
+	}
+	for i := range spec.DelugeInstances {
+		in := &spec.DelugeInstances[i]
+		checkDeluge(fmt.Sprintf("spec.delugeInstances[%s]", in.Name), &in.DelugeSpec)
+	}
+	if spec.SABnzbd != nil {
+		checkNewsServers("spec.sabnzbd", spec.SABnzbd.NewsServers)
+	}
+	for i := range spec.SABnzbdInstances {
+		in := &spec.SABnzbdInstances[i]
+		checkNewsServers(fmt.Sprintf("spec.sabnzbdInstances[%s]", in.Name), in.NewsServers)
+	}
+	if spec.NZBGet != nil {
+		checkNewsServers("spec.nzbget", spec.NZBGet.NewsServers)
+	}
+	for i := range spec.NZBGetInstances {
+		in := &spec.NZBGetInstances[i]
+		checkNewsServers(fmt.Sprintf("spec.nzbgetInstances[%s]", in.Name), in.NewsServers)
+	}
+	// Flood needs the address fields of the client type it connects to
+	checkFloodClient := func(path string, c *arrv1alpha1.FloodClientSpec) {
+		switch c.Type {
+		case "rtorrent":
+			if c.Socket == "" && (c.Host == "" || c.Port == 0) {
+				invalid = append(invalid, compiler.FieldError{Path: path, Value: c.Type, Reason: "needs socket, or host and port"})
+			}
+		case "deluge":
+			if c.Host == "" || c.Port == 0 {
+				invalid = append(invalid, compiler.FieldError{Path: path, Value: c.Type, Reason: "needs host and port"})
+			}
+		default:
+			if c.URL == "" {
+				invalid = append(invalid, compiler.FieldError{Path: path, Value: c.Type, Reason: "needs url"})
+			}
+		}
+	}
+	if fl := spec.Flood; fl != nil {
+		checkFloodClient("spec.flood.client", &fl.Client)
+		for _, user := range fl.Users {
+			if user.Client != nil {
+				checkFloodClient(fmt.Sprintf("spec.flood.users[%s].client", user.Name), user.Client)
+			}
+		}
+	}
+	// Gluetun resolves either through DoT providers or through plain upstream servers
+	if dns := spec.Gluetun.DNS; dns != nil && len(dns.UpstreamAddresses) > 0 {
+		if dns.OverTLS || len(dns.Providers) > 0 {
+			invalid = append(invalid, compiler.FieldError{Path: "spec.gluetun.dns.upstreamAddresses",
+				Reason: "cannot be combined with overTls or providers"})
+		}
+		for i, address := range dns.UpstreamAddresses {
+			_, addrErr := netip.ParseAddr(address)
+			_, addrPortErr := netip.ParseAddrPort(address)
+			if addrErr != nil && addrPortErr != nil {
+				invalid = append(invalid, compiler.FieldError{Path: fmt.Sprintf("spec.gluetun.dns.upstreamAddresses[%d]", i),
+					Value: address, Reason: "not an IP address or IP:port"})
+			}
+		}
+	}
+	return invalid
+}
+
+// reconcileTransmission handles Transmission configuration
+func (r *DownloadStackConfigReconciler) reconcileTransmission(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper, spec *arrv1alpha1.TransmissionSpec, inst downloadClientInstance, window ApplyWindowState) error {
+	log := logf.FromContext(ctx).WithValues("client", inst.label())
+
+	// Resolve Transmission credentials (optional)
+	transmissionUsername, transmissionPassword, err := r.resolveTransmissionCredentials(ctx, config.Namespace, spec)
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionCredentialsFailed", inst.message(err))
+		return err
+	}
+
 	// Create Transmission client and sync settings
 	var transmissionClient downloadstack.TransmissionClientInterface
 	if r.TransmissionClientFactory != nil {
 		transmissionClient = r.TransmissionClientFactory(
-			config.Spec.Transmission.Connection.URL,
+			spec.Connection.URL,
 			transmissionUsername,
 			transmissionPassword,
 		)
 	} else {
 		transmissionClient = downloadstack.NewTransmissionClient(
-			config.Spec.Transmission.Connection.URL,
+			spec.Connection.URL,
 			transmissionUsername,
 			transmissionPassword,
 		)
@@ -381,44 +598,340 @@ func (r *DownloadStackConfigReconciler) reconcileTransmission(ctx context.Contex
 	// Test connection
 	if err := transmissionClient.TestConnection(ctx); err != nil {
 		log.Error(err, "Failed to connect to Transmission")
-		config.Status.TransmissionConnected = false
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionConnectionFailed", err.Error())
+		*inst.connected = false
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionConnectionFailed", inst.message(err))
 		return err
 	}
 
-	config.Status.TransmissionConnected = true
+	*inst.connected = true
 
 	// Get Transmission version
 	version, err := downloadstack.GetTransmissionVersion(ctx, transmissionClient)
 	if err == nil {
-		config.Status.TransmissionVersion = version
+		*inst.version = version
 	}
 
 	// Sync Transmission settings
 	settingsInput := &downloadstack.TransmissionSettingsInput{
-		Spec:     config.Spec.Transmission,
+		Spec:     spec,
 		Username: transmissionUsername,
 		Password: transmissionPassword,
 	}
+	if spec.Trackers != nil {
+		settingsInput.DefaultTrackers, err = r.resolveTrackerList(ctx, config.Namespace, spec.Trackers.DefaultTrackerList)
+		if err != nil {
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionTrackerListFailed", inst.message(err))
+			return err
+		}
+	}
 
-	if err := downloadstack.SyncTransmissionSettings(ctx, transmissionClient, settingsInput); err != nil {
+	result, err := downloadstack.SyncTransmissionSettings(ctx, transmissionClient, settingsInput)
+	if err != nil {
 		log.Error(err, "Failed to sync Transmission settings")
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionSyncFailed", err.Error())
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionSyncFailed", inst.message(err))
 		return err
 	}
+	for _, group := range result.Drifted {
+		metrics.RecordConfigDrift("transmission", group)
+	}
+	if len(result.Drifted) > 0 {
+		log.Info("Transmission settings drift corrected", "groups", result.Drifted)
+	}
+	config.Status.Unrealized = append(config.Status.Unrealized, inst.relabel(result.Unrealized)...)
+
+	// Enforce the torrent policy on existing torrents. Outside the apply window,
+	// or while an app using this stack is observed or its queue can't be read,
+	// the policy only reports what it would do.
+	if spec.TorrentPolicy != nil {
+		torrentPolicy := *spec.TorrentPolicy
+		queued, observe, err := r.torrentImports(ctx, config)
+		if err != nil {
+			log.Error(err, "Failed to read app queues, running the Transmission torrent policy as a dry run")
+			torrentPolicy.DryRun = true
+		}
+		torrentPolicy.DryRun = torrentPolicy.DryRun || observe || !window.Open
+
+		policy, err := downloadstack.EnforceTransmissionTorrentPolicy(ctx, transmissionClient, &torrentPolicy, queued)
+		if err != nil {
+			log.Error(err, "Failed to enforce Transmission torrent policy")
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionTorrentPolicyFailed", inst.message(err))
+			return err
+		}
+		changes := policy.Labeled + policy.Moved + policy.Removed
+		if changes > 0 {
+			log.Info("Transmission torrent policy applied", "labeled", policy.Labeled, "moved", policy.Moved,
+				"removed", policy.Removed, "dryRun", torrentPolicy.DryRun)
+		}
+		if changes > 0 && !window.Open && !spec.TorrentPolicy.DryRun {
+			message := window.PendingMessage("Transmission torrent policy")
+			log.Info("Outside apply window, deferring Transmission torrent policy", "nextWindow", window.NextOpen)
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypePendingChanges, metav1.ConditionTrue, "OutsideApplyWindow", message)
+		}
+		config.Status.Unrealized = append(config.Status.Unrealized, inst.relabel(policy.Unrealized)...)
+		config.Status.TorrentPolicies = append(config.Status.TorrentPolicies, arrv1alpha1.TorrentPolicyStatus{
+			Client:         inst.label(),
+			Torrents:       policy.Torrents,
+			Labeled:        policy.Labeled,
+			Moved:          policy.Moved,
+			Removed:        policy.Removed,
+			AwaitingImport: policy.AwaitingImport,
+			DryRun:         torrentPolicy.DryRun,
+		})
+	}
+
+	// Read back the effective settings (non-fatal)
+	if session, err := transmissionClient.GetSession(ctx); err != nil {
+		log.Error(err, "Failed to read back Transmission settings")
+	} else {
+		effective := downloadstack.TransmissionEffectiveSettings(session)
+		effective.Client = inst.label()
+		config.Status.EffectiveSettings = append(config.Status.EffectiveSettings, effective)
+	}
 
 	log.Info("Transmission configuration synced successfully")
 	return nil
 }
 
-// reconcileQBittorrent handles qBittorrent configuration
-func (r *DownloadStackConfigReconciler) reconcileQBittorrent(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper) error {
+// resolveTransmissionCredentials reads the optional RPC credentials of a
+// Transmission client. Both are empty without a credentialsSecretRef.
+func (r *DownloadStackConfigReconciler) resolveTransmissionCredentials(ctx context.Context, namespace string, spec *arrv1alpha1.TransmissionSpec) (string, string, error) {
+	if spec.Connection.CredentialsSecretRef == nil {
+		return "", "", nil
+	}
+	return r.resolveCredentialsSecret(ctx, namespace, spec.Connection.CredentialsSecretRef)
+}
+
+// resolveCredentialsSecret reads the username and password of a credentials Secret
+func (r *DownloadStackConfigReconciler) resolveCredentialsSecret(ctx context.Context, namespace string, creds *arrv1alpha1.CredentialsSecretRef) (string, string, error) {
+	usernameKey := creds.UsernameKey
+	if usernameKey == "" {
+		usernameKey = "username"
+	}
+	passwordKey := creds.PasswordKey
+	if passwordKey == "" {
+		passwordKey = "password"
+	}
+
+	username, err := r.Helper.ResolveSecretValue(ctx, namespace, creds.Name, usernameKey)
+	if err != nil {
+		return "", "", err
+	}
+	password, err := r.Helper.ResolveSecretValue(ctx, namespace, creds.Name, passwordKey)
+	if err != nil {
+		return "", "", err
+	}
+	return username, password, nil
+}
+
+// resolveTrackerList renders a tracker list with the trackers of its ConfigMap.
+// It returns nil without a list.
+func (r *DownloadStackConfigReconciler) resolveTrackerList(ctx context.Context, namespace string, list *arrv1alpha1.TrackerListSpec) (*string, error) {
+	if list == nil {
+		return nil, nil
+	}
+	var data string
+	if ref := list.ConfigMapRef; ref != nil {
+		cm := &corev1.ConfigMap{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, cm); err != nil {
+			return nil, fmt.Errorf("failed to get tracker list ConfigMap %s: %w", ref.Name, err)
+		}
+		var ok bool
+		if data, ok = cm.Data[ref.Key]; !ok {
+			return nil, fmt.Errorf("tracker list ConfigMap %s has no key %s", ref.Name, ref.Key)
+		}
+	}
+	trackers := downloadstack.TrackerList(list.Trackers, data)
+	return &trackers, nil
+}
+
+// clusterPodCIDRs lists the pod CIDRs assigned to the cluster's Nodes. CNIs that
+// allocate addresses themselves leave them empty; rpcWhitelist covers those.
+func (r *DownloadStackConfigReconciler) clusterPodCIDRs(ctx context.Context) ([]string, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list Nodes for the Transmission cluster whitelist: %w", err)
+	}
+	var cidrs []string
+	for _, node := range nodes.Items {
+		if len(node.Spec.PodCIDRs) > 0 {
+			cidrs = append(cidrs, node.Spec.PodCIDRs...)
+		} else if node.Spec.PodCIDR != "" {
+			cidrs = append(cidrs, node.Spec.PodCIDR)
+		}
+	}
+	return cidrs, nil
+}
+
+// reconcileTransmissionSettingsFile renders the Transmission settings.json Secret.
+// Like Gluetun changes, a changed file waits for the apply window when it
+// restarts the Deployment; the initial Secret is always created. Removing
+// settingsFile deletes the Secret.
+func (r *DownloadStackConfigReconciler) reconcileTransmissionSettingsFile(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper, window ApplyWindowState) error {
 	log := logf.FromContext(ctx)
+	secretName := config.Name + "-transmission-settings"
+
+	if config.Spec.Transmission == nil || config.Spec.Transmission.SettingsFile == nil {
+		if config.Status.TransmissionSettingsHash == "" {
+			return nil
+		}
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: config.Namespace}}
+		if err := r.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete Transmission settings Secret: %w", err)
+		}
+		config.Status.TransmissionSettingsHash = ""
+		config.Status.TransmissionClusterWhitelist = nil
+		return nil
+	}
+	settingsFile := config.Spec.Transmission.SettingsFile
+
+	input := &downloadstack.TransmissionSettingsFileInput{Spec: config.Spec.Transmission}
+	if settingsFile.RPCAuthenticationRequired != nil && *settingsFile.RPCAuthenticationRequired {
+		var err error
+		input.Username, input.Password, err = r.resolveTransmissionCredentials(ctx, config.Namespace, config.Spec.Transmission)
+		if err != nil {
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionCredentialsFailed", err.Error())
+			return err
+		}
+	}
+	if settingsFile.ClusterWhitelist {
+		podCIDRs, err := r.clusterPodCIDRs(ctx)
+		if err != nil {
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionSettingsFileFailed", err.Error())
+			return err
+		}
+		// Keep the ranges already whitelisted, so replaced Nodes don't change the file
+		input.ClusterWhitelist = downloadstack.MergeTransmissionWhitelist(
+			config.Status.TransmissionClusterWhitelist, downloadstack.TransmissionWhitelistFromCIDRs(podCIDRs))
+		input.ClusterHostWhitelist = downloadstack.TransmissionHostWhitelist(config.Spec.Transmission.Connection.URL, config.Namespace)
+	}
+
+	data, err := downloadstack.RenderTransmissionSettingsFile(input)
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionSettingsFileFailed", err.Error())
+		return err
+	}
+	newHash := downloadstack.HashTransmissionSettingsFile(data)
+	previousHash := config.Status.TransmissionSettingsHash
+	changed := newHash != previousHash
+
+	if changed && previousHash != "" && settingsFile.RestartOnChange && !window.Open {
+		message := window.PendingMessage("Transmission settings.json change")
+		log.Info("Outside apply window, deferring Transmission settings.json change", "nextWindow", window.NextOpen)
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypePendingChanges, metav1.ConditionTrue, "OutsideApplyWindow", message)
+		return nil
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: config.Namespace}}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := controllerutil.SetControllerReference(config, secret, r.Scheme); err != nil {
+			return err
+		}
+		secret.Data = map[string][]byte{downloadstack.TransmissionSettingsFileKey: data}
+		return nil
+	})
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "TransmissionSettingsFileFailed", err.Error())
+		return fmt.Errorf("failed to apply Transmission settings Secret: %w", err)
+	}
+	if result != controllerutil.OperationResultNone {
+		metrics.RecordSecretWrite("transmission-settings", string(result))
+	}
+	config.Status.TransmissionSettingsHash = newHash
+	config.Status.TransmissionClusterWhitelist = input.ClusterWhitelist
+
+	if !settingsFile.RestartOnChange {
+		return nil
+	}
+	// The first render restarts too: Transmission has not read the file yet
+	if changed {
+		if err := r.restartDeployment(ctx, config); err != nil {
+			log.Error(err, "Failed to trigger Deployment restart", "deployment", config.Spec.DeploymentRef.Name)
+		} else {
+			log.Info("Triggered Deployment restart due to Transmission settings.json change", "deployment", config.Spec.DeploymentRef.Name)
+		}
+	} else if err := r.ensureDeploymentHashes(ctx, config); err != nil {
+		log.Error(err, "Failed to restore Transmission settings hash annotation", "deployment", config.Spec.DeploymentRef.Name)
+	}
+	return nil
+}
+
+// reconcileRTorrentConfigFile renders the rTorrent rtorrent.rc Secret. It
+// follows reconcileTransmissionSettingsFile: restarting changes wait for the
+// apply window, and removing configFile deletes the Secret.
+func (r *DownloadStackConfigReconciler) reconcileRTorrentConfigFile(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper, window ApplyWindowState) error {
+	log := logf.FromContext(ctx)
+	secretName := config.Name + "-rtorrent-config"
+
+	if config.Spec.RTorrent == nil || config.Spec.RTorrent.ConfigFile == nil {
+		if config.Status.RTorrentConfigHash == "" {
+			return nil
+		}
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: config.Namespace}}
+		if err := r.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete rTorrent config Secret: %w", err)
+		}
+		config.Status.RTorrentConfigHash = ""
+		return nil
+	}
+	configFile := config.Spec.RTorrent.ConfigFile
+
+	data, err := downloadstack.RenderRTorrentConfigFile(config.Spec.RTorrent)
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentConfigFileFailed", err.Error())
+		return err
+	}
+	newHash := downloadstack.HashRTorrentConfigFile(data)
+	previousHash := config.Status.RTorrentConfigHash
+	changed := newHash != previousHash
+
+	if changed && previousHash != "" && configFile.RestartOnChange && !window.Open {
+		message := window.PendingMessage("rTorrent rtorrent.rc change")
+		log.Info("Outside apply window, deferring rTorrent rtorrent.rc change", "nextWindow", window.NextOpen)
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypePendingChanges, metav1.ConditionTrue, "OutsideApplyWindow", message)
+		return nil
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: config.Namespace}}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := controllerutil.SetControllerReference(config, secret, r.Scheme); err != nil {
+			return err
+		}
+		secret.Data = map[string][]byte{downloadstack.RTorrentConfigFileKey: data}
+		return nil
+	})
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentConfigFileFailed", err.Error())
+		return fmt.Errorf("failed to apply rTorrent config Secret: %w", err)
+	}
+	if result != controllerutil.OperationResultNone {
+		metrics.RecordSecretWrite("rtorrent-config", string(result))
+	}
+	config.Status.RTorrentConfigHash = newHash
+
+	if !configFile.RestartOnChange {
+		return nil
+	}
+	// The first render restarts too: rTorrent has not read the file yet
+	if changed {
+		if err := r.restartDeployment(ctx, config); err != nil {
+			log.Error(err, "Failed to trigger Deployment restart", "deployment", config.Spec.DeploymentRef.Name)
+		} else {
+			log.Info("Triggered Deployment restart due to rTorrent rtorrent.rc change", "deployment", config.Spec.DeploymentRef.Name)
+		}
+	} else if err := r.ensureDeploymentHashes(ctx, config); err != nil {
+		log.Error(err, "Failed to restore rTorrent config hash annotation", "deployment", config.Spec.DeploymentRef.Name)
+	}
+	return nil
+}
+
+// reconcileQBittorrent handles qBittorrent configuration
+func (r *DownloadStackConfigReconciler) reconcileQBittorrent(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper, spec *arrv1alpha1.QBittorrentSpec, inst downloadClientInstance) error {
+	log := logf.FromContext(ctx).WithValues("client", inst.label())
 
 	// Resolve qBittorrent credentials (optional)
 	var qbtUsername, qbtPassword string
-	if config.Spec.QBittorrent.Connection.CredentialsSecretRef != nil {
-		creds := config.Spec.QBittorrent.Connection.CredentialsSecretRef
+	if spec.Connection.CredentialsSecretRef != nil {
+		creds := spec.Connection.CredentialsSecretRef
 		usernameKey := creds.UsernameKey
 		if usernameKey == "" {
 			usernameKey = "username"
@@ -431,20 +944,20 @@ func (r *DownloadStackConfigReconciler) reconcileQBittorrent(ctx context.Context
 		var err error
 		qbtUsername, err = r.Helper.ResolveSecretValue(ctx, config.Namespace, creds.Name, usernameKey)
 		if err != nil {
-			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentCredentialsFailed", err.Error())
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentCredentialsFailed", inst.message(err))
 			return err
 		}
 
 		qbtPassword, err = r.Helper.ResolveSecretValue(ctx, config.Namespace, creds.Name, passwordKey)
 		if err != nil {
-			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentCredentialsFailed", err.Error())
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentCredentialsFailed", inst.message(err))
 			return err
 		}
 	}
 
 	// Create qBittorrent client
 	qbtClient := downloadstack.NewQBittorrentClient(
-		config.Spec.QBittorrent.Connection.URL,
+		spec.Connection.URL,
 		qbtUsername,
 		qbtPassword,
 	)
@@ -452,33 +965,81 @@ func (r *DownloadStackConfigReconciler) reconcileQBittorrent(ctx context.Context
 	// Test connection
 	if err := qbtClient.TestConnection(ctx); err != nil {
 		log.Error(err, "Failed to connect to qBittorrent")
-		config.Status.QBittorrentConnected = false
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentConnectionFailed", err.Error())
+		*inst.connected = false
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentConnectionFailed", inst.message(err))
 		return err
 	}
 
-	config.Status.QBittorrentConnected = true
+	*inst.connected = true
 
 	// Get qBittorrent version
 	version, err := qbtClient.GetVersion(ctx)
 	if err == nil {
-		config.Status.QBittorrentVersion = version
+		*inst.version = version
+	}
+
+	var addTrackers *string
+	if spec.Trackers != nil {
+		addTrackers, err = r.resolveTrackerList(ctx, config.Namespace, spec.Trackers.AddTrackers)
+		if err != nil {
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentTrackerListFailed", inst.message(err))
+			return err
+		}
 	}
 
-	// Sync qBittorrent settings
-	if err := syncQBittorrentSettings(ctx, qbtClient, config.Spec.QBittorrent); err != nil {
+	// Sync qBittorrent settings using the preference keys of the detected version
+	unrealized, err := syncQBittorrentSettings(ctx, qbtClient, spec, version, addTrackers)
+	if err != nil {
 		log.Error(err, "Failed to sync qBittorrent settings")
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentSyncFailed", err.Error())
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentSyncFailed", inst.message(err))
 		return err
 	}
+	config.Status.Unrealized = append(config.Status.Unrealized, inst.relabel(unrealized)...)
+
+	// Enforce the per-tracker limits on existing torrents
+	if len(spec.TrackerRules) > 0 {
+		results, err := downloadstack.EnforceQBittorrentTrackerRules(ctx, qbtClient, spec.TrackerRules)
+		if err != nil {
+			log.Error(err, "Failed to enforce qBittorrent tracker rules")
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "QBittorrentTrackerRulesFailed", inst.message(err))
+			return err
+		}
+		for _, res := range results {
+			if res.Applied > 0 {
+				log.Info("qBittorrent tracker rule applied", "rule", res.Name, "torrents", res.Applied)
+			}
+			config.Status.TrackerRules = append(config.Status.TrackerRules, arrv1alpha1.TrackerRuleStatus{
+				Client:  inst.label(),
+				Name:    res.Name,
+				Matched: res.Matched,
+				Applied: res.Applied,
+			})
+		}
+	}
+
+	// Read back the effective settings (non-fatal)
+	if prefs, err := qbtClient.GetPreferences(ctx); err != nil {
+		log.Error(err, "Failed to read back qBittorrent settings")
+	} else {
+		effective := downloadstack.QBittorrentEffectiveSettings(prefs)
+		effective.Client = inst.label()
+		config.Status.EffectiveSettings = append(config.Status.EffectiveSettings, effective)
+	}
 
 	log.Info("qBittorrent configuration synced successfully")
 	return nil
 }
 
-// syncQBittorrentSettings syncs qBittorrent preferences from spec
-func syncQBittorrentSettings(ctx context.Context, client *downloadstack.QBittorrentClient, spec *arrv1alpha1.QBittorrentSpec) error {
+// syncQBittorrentSettings syncs qBittorrent preferences from spec. Preference keys
+// follow the detected version; spec fields the version cannot honor are skipped and
+// returned as unrealized. An unparseable version sends the keys of every version.
+// addTrackers is the resolved trackers.addTrackers list (nil = unmanaged).
+func syncQBittorrentSettings(ctx context.Context, client *downloadstack.QBittorrentClient, spec *arrv1alpha1.QBittorrentSpec, version string, addTrackers *string) ([]arrv1alpha1.UnrealizedFeature, error) {
 	prefs := make(map[string]interface{})
+	var unrealized []arrv1alpha1.UnrealizedFeature
+	parsed, known := downloadstack.ParseClientVersion(version)
+	// Content layout replaced create_subfolder_enabled in 4.3.2
+	hasContentLayout := !known || parsed.AtLeast(4, 3, 2)
 
 	// Speed settings
 	if spec.Speed != nil {
@@ -520,7 +1081,15 @@ func syncQBittorrentSettings(ctx context.Context, client *downloadstack.QBittorr
 		}
 		prefs["temp_path_enabled"] = spec.Directories.TempPathEnabled
 		if spec.Directories.CreateSubfolder != nil {
-			prefs["create_subfolder_enabled"] = *spec.Directories.CreateSubfolder
+			if !known || !hasContentLayout {
+				prefs["create_subfolder_enabled"] = *spec.Directories.CreateSubfolder
+			}
+			if hasContentLayout {
+				prefs["torrent_content_layout"] = "NoSubfolder"
+				if *spec.Directories.CreateSubfolder {
+					prefs["torrent_content_layout"] = "Original"
+				}
+			}
 		}
 		if spec.Directories.AppendExtension != nil {
 			prefs["incomplete_files_ext"] = *spec.Directories.AppendExtension
@@ -602,22 +1171,119 @@ func syncQBittorrentSettings(ctx context.Context, client *downloadstack.QBittorr
 		prefs["anonymous_mode"] = spec.BitTorrent.AnonymousMode
 	}
 
+	// Per-torrent defaults
+	if spec.Torrents != nil {
+		if spec.Torrents.AutoTMMEnabled != nil {
+			prefs["auto_tmm_enabled"] = *spec.Torrents.AutoTMMEnabled
+		}
+		if spec.Torrents.TorrentContentLayout != "" {
+			if hasContentLayout {
+				prefs["torrent_content_layout"] = spec.Torrents.TorrentContentLayout
+			} else {
+				unrealized = append(unrealized, arrv1alpha1.UnrealizedFeature{
+					Feature: "qbittorrent:torrentContentLayout",
+					Reason:  fmt.Sprintf("requires qBittorrent 4.3.2 or newer, found %s", version),
+				})
+			}
+		}
+		if spec.Torrents.StartPausedEnabled != nil {
+			// qBittorrent 5 renamed "paused" to "stopped"
+			if !known || parsed.AtLeast(5, 0, 0) {
+				prefs["add_stopped_enabled"] = *spec.Torrents.StartPausedEnabled
+			}
+			if !known || !parsed.AtLeast(5, 0, 0) {
+				prefs["start_paused_enabled"] = *spec.Torrents.StartPausedEnabled
+			}
+		}
+	}
+
+	// Trackers added to new downloads; an empty list turns the option off
+	if addTrackers != nil {
+		prefs["add_trackers_enabled"] = *addTrackers != ""
+		prefs["add_trackers"] = *addTrackers
+	}
+
 	// Only set preferences if there are any
 	if len(prefs) > 0 {
-		return client.SetPreferences(ctx, prefs)
+		if err := client.SetPreferences(ctx, prefs); err != nil {
+			return nil, err
+		}
+	}
+
+	if spec.Torrents != nil && len(spec.Torrents.Tags) > 0 {
+		if err := syncQBittorrentTags(ctx, client, spec.Torrents.Tags); err != nil {
+			return unrealized, err
+		}
+	}
+
+	if len(spec.Categories) > 0 {
+		return unrealized, syncQBittorrentCategories(ctx, client, spec.Categories)
+	}
+
+	return unrealized, nil
+}
+
+// syncQBittorrentCategories creates declared categories missing from qBittorrent and
+// updates the save path of existing ones. Undeclared categories are left alone.
+func syncQBittorrentCategories(ctx context.Context, client *downloadstack.QBittorrentClient, categories []arrv1alpha1.QBittorrentCategorySpec) error {
+	existing, err := client.GetCategories(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get qBittorrent categories: %w", err)
+	}
+
+	for _, cat := range categories {
+		current, ok := existing[cat.Name]
+		switch {
+		case !ok:
+			if err := client.CreateCategory(ctx, cat.Name, cat.SavePath); err != nil {
+				return fmt.Errorf("failed to create qBittorrent category %s: %w", cat.Name, err)
+			}
+		case current.SavePath != cat.SavePath:
+			if err := client.EditCategory(ctx, cat.Name, cat.SavePath); err != nil {
+				return fmt.Errorf("failed to update qBittorrent category %s: %w", cat.Name, err)
+			}
+		}
 	}
+	return nil
+}
 
+// syncQBittorrentTags creates any declared tags missing from qBittorrent
+func syncQBittorrentTags(ctx context.Context, client *downloadstack.QBittorrentClient, tags []string) error {
+	existing, err := client.GetTags(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get qBittorrent tags: %w", err)
+	}
+
+	have := make(map[string]bool, len(existing))
+	for _, tag := range existing {
+		have[tag] = true
+	}
+
+	var missing []string
+	for _, tag := range tags {
+		if !have[tag] {
+			missing = append(missing, tag)
+			have[tag] = true
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if err := client.CreateTags(ctx, missing); err != nil {
+		return fmt.Errorf("failed to create qBittorrent tags: %w", err)
+	}
 	return nil
 }
 
 // reconcileDeluge handles Deluge configuration
-func (r *DownloadStackConfigReconciler) reconcileDeluge(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper) error {
-	log := logf.FromContext(ctx)
+func (r *DownloadStackConfigReconciler) reconcileDeluge(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper, spec *arrv1alpha1.DelugeSpec, inst downloadClientInstance) error {
+	log := logf.FromContext(ctx).WithValues("client", inst.label())
 
 	// Resolve Deluge password (optional, defaults to "deluge")
 	var delugePassword string = "deluge"
-	if config.Spec.Deluge.Connection.PasswordSecretRef != nil {
-		keyRef := config.Spec.Deluge.Connection.PasswordSecretRef
+	if spec.Connection.PasswordSecretRef != nil {
+		keyRef := spec.Connection.PasswordSecretRef
 		keyName := keyRef.Key
 		if keyName == "" {
 			keyName = "password"
@@ -626,44 +1292,89 @@ func (r *DownloadStackConfigReconciler) reconcileDeluge(ctx context.Context, con
 		var err error
 		delugePassword, err = r.Helper.ResolveSecretValue(ctx, config.Namespace, keyRef.Name, keyName)
 		if err != nil {
-			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "DelugeCredentialsFailed", err.Error())
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "DelugeCredentialsFailed", inst.message(err))
 			return err
 		}
 	}
 
 	// Create Deluge client
-	delugeClient := downloadstack.NewDelugeClient(
-		config.Spec.Deluge.Connection.URL,
-		delugePassword,
-	)
+	delugeClient, err := r.newDelugeClient(ctx, config.Namespace, spec.Connection, delugePassword)
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "DelugeCredentialsFailed", inst.message(err))
+		return err
+	}
+	defer delugeClient.Close()
 
 	// Test connection
 	if err := delugeClient.TestConnection(ctx); err != nil {
 		log.Error(err, "Failed to connect to Deluge")
-		config.Status.DelugeConnected = false
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "DelugeConnectionFailed", err.Error())
+		*inst.connected = false
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "DelugeConnectionFailed", inst.message(err))
 		return err
 	}
 
-	config.Status.DelugeConnected = true
+	*inst.connected = true
 
 	// Get Deluge version
 	version, err := delugeClient.GetVersion(ctx)
 	if err == nil {
-		config.Status.DelugeVersion = version
+		*inst.version = version
 	}
 
 	// Sync Deluge settings
-	if err := syncDelugeSettings(ctx, delugeClient, config.Spec.Deluge); err != nil {
+	if err := syncDelugeSettings(ctx, delugeClient, spec); err != nil {
 		log.Error(err, "Failed to sync Deluge settings")
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "DelugeSyncFailed", err.Error())
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "DelugeSyncFailed", inst.message(err))
 		return err
 	}
 
+	// Read back the effective settings (non-fatal)
+	if delugeConfig, err := delugeClient.GetConfig(ctx); err != nil {
+		log.Error(err, "Failed to read back Deluge settings")
+	} else {
+		effective := downloadstack.DelugeEffectiveSettings(delugeConfig)
+		effective.Client = inst.label()
+		config.Status.EffectiveSettings = append(config.Status.EffectiveSettings, effective)
+	}
+
 	log.Info("Deluge configuration synced successfully")
 	return nil
 }
 
+// newDelugeClient creates a Deluge client for the Web UI, the daemon, or the Web UI
+// managing the daemon, depending on which connection fields are set
+func (r *DownloadStackConfigReconciler) newDelugeClient(ctx context.Context, namespace string, conn arrv1alpha1.DelugeConnectionSpec, webPassword string) (*downloadstack.DelugeClient, error) {
+	if conn.Daemon == nil {
+		url := conn.URL
+		if url == "" {
+			url = "http://localhost:8112"
+		}
+		return downloadstack.NewDelugeClient(url, webPassword), nil
+	}
+
+	// Resolve daemon credentials from the auth file
+	keyName := conn.Daemon.AuthSecretRef.Key
+	if keyName == "" {
+		keyName = "auth"
+	}
+	authFile, err := r.Helper.ResolveSecretValue(ctx, namespace, conn.Daemon.AuthSecretRef.Name, keyName)
+	if err != nil {
+		return nil, err
+	}
+	username, password, err := downloadstack.ParseDelugeAuthFile(authFile, conn.Daemon.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if conn.URL == "" {
+		return downloadstack.NewDelugeDaemonClient(conn.Daemon.Host, conn.Daemon.Port, username, password), nil
+	}
+
+	client := downloadstack.NewDelugeClient(conn.URL, webPassword)
+	client.SetDaemonHost(conn.Daemon.Host, conn.Daemon.Port, username, password)
+	return client, nil
+}
+
 // syncDelugeSettings syncs Deluge configuration from spec
 func syncDelugeSettings(ctx context.Context, client *downloadstack.DelugeClient, spec *arrv1alpha1.DelugeSpec) error {
 	config := make(map[string]interface{})
@@ -778,20 +1489,57 @@ func syncDelugeSettings(ctx context.Context, client *downloadstack.DelugeClient,
 
 	// Only set config if there are any changes
 	if len(config) > 0 {
-		return client.SetConfig(ctx, config)
+		if err := client.SetConfig(ctx, config); err != nil {
+			return err
+		}
+	}
+
+	if len(spec.Labels) > 0 {
+		return syncDelugeLabels(ctx, client, spec.Labels)
+	}
+
+	return nil
+}
+
+// delugeLabelPlugin is the Deluge plugin providing labels
+const delugeLabelPlugin = "Label"
+
+// syncDelugeLabels enables the Label plugin if needed and creates declared labels
+// missing from Deluge. Undeclared labels are left alone.
+func syncDelugeLabels(ctx context.Context, client *downloadstack.DelugeClient, labels []string) error {
+	plugins, err := client.GetEnabledPlugins(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Deluge plugins: %w", err)
+	}
+	if !slices.Contains(plugins, delugeLabelPlugin) {
+		if err := client.EnablePlugin(ctx, delugeLabelPlugin); err != nil {
+			return fmt.Errorf("failed to enable Deluge Label plugin: %w", err)
+		}
 	}
 
+	existing, err := client.GetLabels(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Deluge labels: %w", err)
+	}
+	for _, label := range labels {
+		if slices.Contains(existing, label) {
+			continue
+		}
+		if err := client.AddLabel(ctx, label); err != nil {
+			return fmt.Errorf("failed to create Deluge label %s: %w", label, err)
+		}
+	}
 	return nil
 }
 
 // reconcileRTorrent handles rTorrent configuration
-func (r *DownloadStackConfigReconciler) reconcileRTorrent(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper) error {
-	log := logf.FromContext(ctx)
+func (r *DownloadStackConfigReconciler) reconcileRTorrent(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper, spec *arrv1alpha1.RTorrentSpec, inst downloadClientInstance) error {
+	log := logf.FromContext(ctx).WithValues("client", inst.label())
 
 	// Resolve rTorrent credentials (optional - for HTTP basic auth)
 	var rtUsername, rtPassword string
-	if config.Spec.RTorrent.Connection.CredentialsSecretRef != nil {
-		creds := config.Spec.RTorrent.Connection.CredentialsSecretRef
+	if spec.Connection.CredentialsSecretRef != nil {
+		creds := spec.Connection.CredentialsSecretRef
 		usernameKey := creds.UsernameKey
 		if usernameKey == "" {
 			usernameKey = "username"
@@ -804,20 +1552,20 @@ func (r *DownloadStackConfigReconciler) reconcileRTorrent(ctx context.Context, c
 		var err error
 		rtUsername, err = r.Helper.ResolveSecretValue(ctx, config.Namespace, creds.Name, usernameKey)
 		if err != nil {
-			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentCredentialsFailed", err.Error())
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentCredentialsFailed", inst.message(err))
 			return err
 		}
 
 		rtPassword, err = r.Helper.ResolveSecretValue(ctx, config.Namespace, creds.Name, passwordKey)
 		if err != nil {
-			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentCredentialsFailed", err.Error())
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentCredentialsFailed", inst.message(err))
 			return err
 		}
 	}
 
 	// Create rTorrent client
 	rtClient := downloadstack.NewRTorrentClient(
-		config.Spec.RTorrent.Connection.URL,
+		spec.Connection.URL,
 		rtUsername,
 		rtPassword,
 	)
@@ -825,23 +1573,23 @@ func (r *DownloadStackConfigReconciler) reconcileRTorrent(ctx context.Context, c
 	// Test connection
 	if err := rtClient.TestConnection(ctx); err != nil {
 		log.Error(err, "Failed to connect to rTorrent")
-		config.Status.RTorrentConnected = false
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentConnectionFailed", err.Error())
+		*inst.connected = false
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentConnectionFailed", inst.message(err))
 		return err
 	}
 
-	config.Status.RTorrentConnected = true
+	*inst.connected = true
 
 	// Get rTorrent version
 	version, err := rtClient.GetVersion(ctx)
 	if err == nil {
-		config.Status.RTorrentVersion = version
+		*inst.version = version
 	}
 
 	// Sync rTorrent settings
-	if err := syncRTorrentSettings(ctx, rtClient, config.Spec.RTorrent); err != nil {
+	if err := syncRTorrentSettings(ctx, rtClient, spec); err != nil {
 		log.Error(err, "Failed to sync rTorrent settings")
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentSyncFailed", err.Error())
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "RTorrentSyncFailed", inst.message(err))
 		return err
 	}
 
@@ -873,7 +1621,7 @@ func syncRTorrentSettings(ctx context.Context, client *downloadstack.RTorrentCli
 				return fmt.Errorf("failed to set directory: %w", err)
 			}
 		}
-		// Session directory is typically set in config file, not via RPC
+		// Session and watch directories are only read at start; see configFile
 	}
 
 	// Connection settings
@@ -912,11 +1660,11 @@ func syncRTorrentSettings(ctx context.Context, client *downloadstack.RTorrentCli
 }
 
 // reconcileSABnzbd handles SABnzbd configuration
-func (r *DownloadStackConfigReconciler) reconcileSABnzbd(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper) error {
-	log := logf.FromContext(ctx)
+func (r *DownloadStackConfigReconciler) reconcileSABnzbd(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper, spec *arrv1alpha1.SABnzbdSpec, inst downloadClientInstance) error {
+	log := logf.FromContext(ctx).WithValues("client", inst.label())
 
 	// Resolve SABnzbd API key (required)
-	keyRef := &config.Spec.SABnzbd.Connection.APIKeySecretRef
+	keyRef := &spec.Connection.APIKeySecretRef
 	keyName := keyRef.Key
 	if keyName == "" {
 		keyName = "apiKey"
@@ -924,36 +1672,64 @@ func (r *DownloadStackConfigReconciler) reconcileSABnzbd(ctx context.Context, co
 
 	apiKey, err := r.Helper.ResolveSecretValue(ctx, config.Namespace, keyRef.Name, keyName)
 	if err != nil {
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "SABnzbdCredentialsFailed", err.Error())
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "SABnzbdCredentialsFailed", inst.message(err))
 		return err
 	}
 
 	// Create SABnzbd client
 	sabClient := downloadstack.NewSABnzbdClient(
-		config.Spec.SABnzbd.Connection.URL,
+		spec.Connection.URL,
 		apiKey,
 	)
 
 	// Test connection
 	if err := sabClient.TestConnection(ctx); err != nil {
 		log.Error(err, "Failed to connect to SABnzbd")
-		config.Status.SABnzbdConnected = false
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "SABnzbdConnectionFailed", err.Error())
+		*inst.connected = false
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "SABnzbdConnectionFailed", inst.message(err))
 		return err
 	}
 
-	config.Status.SABnzbdConnected = true
+	*inst.connected = true
 
 	// Get SABnzbd version
 	version, err := sabClient.GetVersion(ctx)
 	if err == nil {
-		config.Status.SABnzbdVersion = version
+		*inst.version = version
 	}
 
 	// Sync SABnzbd settings
-	if err := syncSABnzbdSettings(ctx, sabClient, config.Spec.SABnzbd); err != nil {
+	result, err := downloadstack.SyncSABnzbdSettings(ctx, sabClient, spec)
+	if err != nil {
 		log.Error(err, "Failed to sync SABnzbd settings")
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "SABnzbdSyncFailed", err.Error())
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "SABnzbdSyncFailed", inst.message(err))
+		return err
+	}
+	for _, setting := range result.Drifted {
+		metrics.RecordConfigDrift("sabnzbd", setting)
+	}
+	if len(result.Drifted) > 0 {
+		log.Info("SABnzbd settings drift corrected", "settings", result.Drifted)
+	}
+
+	// Sync SABnzbd categories
+	managed, err := syncSABnzbdCategories(ctx, sabClient, spec.Categories, *inst.categories)
+	*inst.categories = managed
+	if err != nil {
+		log.Error(err, "Failed to sync SABnzbd categories")
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "SABnzbdSyncFailed", inst.message(err))
+		return err
+	}
+
+	// Sync SABnzbd news servers
+	servers, err := r.resolveNewsServers(ctx, config.Namespace, spec.NewsServers)
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NewsServerResolveFailed", inst.message(err))
+		return err
+	}
+	if err := syncSABnzbdNewsServers(ctx, sabClient, config, compiler.SecretSalt(r.Options.SecretKey, config.UID), inst, servers); err != nil {
+		log.Error(err, "Failed to sync SABnzbd news servers")
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "SABnzbdSyncFailed", inst.message(err))
 		return err
 	}
 
@@ -961,90 +1737,92 @@ func (r *DownloadStackConfigReconciler) reconcileSABnzbd(ctx context.Context, co
 	return nil
 }
 
-// syncSABnzbdSettings syncs SABnzbd configuration from spec
-func syncSABnzbdSettings(ctx context.Context, client *downloadstack.SABnzbdClient, spec *arrv1alpha1.SABnzbdSpec) error {
-	// Speed settings
-	if spec.Speed != nil {
-		if spec.Speed.SpeedLimit > 0 {
-			if err := client.SetSpeedLimit(ctx, spec.Speed.SpeedLimit); err != nil {
-				return fmt.Errorf("failed to set speed limit: %w", err)
-			}
-		}
-		if spec.Speed.PauseDownloads {
-			if err := client.Pause(ctx); err != nil {
-				return fmt.Errorf("failed to pause downloads: %w", err)
-			}
-		}
+// syncSABnzbdCategories creates/updates categories from spec and deletes categories
+// previously managed by the operator that were removed from spec.
+// Returns the names of the categories now managed by the operator.
+func syncSABnzbdCategories(ctx context.Context, client downloadstack.SABnzbdClientInterface, desired []arrv1alpha1.SABnzbdCategorySpec, previous []string) ([]string, error) {
+	if len(desired) == 0 && len(previous) == 0 {
+		return nil, nil
 	}
 
-	// Directory settings
-	if spec.Directories != nil {
-		if spec.Directories.DownloadDir != "" {
-			if err := client.SetConfig(ctx, "misc", "download_dir", spec.Directories.DownloadDir); err != nil {
-				return fmt.Errorf("failed to set download_dir: %w", err)
-			}
-		}
-		if spec.Directories.CompleteDir != "" {
-			if err := client.SetConfig(ctx, "misc", "complete_dir", spec.Directories.CompleteDir); err != nil {
-				return fmt.Errorf("failed to set complete_dir: %w", err)
-			}
-		}
-		if spec.Directories.IncompleteDir != "" {
-			if err := client.SetConfig(ctx, "misc", "incomplete_dir", spec.Directories.IncompleteDir); err != nil {
-				return fmt.Errorf("failed to set incomplete_dir: %w", err)
-			}
+	current, err := client.GetCategories(ctx)
+	if err != nil {
+		return previous, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	currentByName := make(map[string]downloadstack.SABnzbdCategory, len(current))
+	for _, cat := range current {
+		currentByName[strings.ToLower(cat.Name)] = cat
+	}
+
+	managed := make([]string, 0, len(desired))
+	desiredNames := make(map[string]bool, len(desired))
+	for _, spec := range desired {
+		desiredNames[strings.ToLower(spec.Name)] = true
+
+		script := spec.Script
+		if script == "" {
+			script = "Default"
 		}
-		if spec.Directories.ScriptDir != "" {
-			if err := client.SetConfig(ctx, "misc", "script_dir", spec.Directories.ScriptDir); err != nil {
-				return fmt.Errorf("failed to set script_dir: %w", err)
-			}
+		want := downloadstack.SABnzbdCategory{
+			Name:     spec.Name,
+			Dir:      spec.Dir,
+			Priority: spec.Priority,
+			Script:   script,
 		}
-		if spec.Directories.NzbBackupDir != "" {
-			if err := client.SetConfig(ctx, "misc", "nzb_backup_dir", spec.Directories.NzbBackupDir); err != nil {
-				return fmt.Errorf("failed to set nzb_backup_dir: %w", err)
+
+		existing, ok := currentByName[strings.ToLower(spec.Name)]
+		if !ok || existing.Dir != want.Dir || existing.Priority != want.Priority || existing.Script != want.Script {
+			if err := client.SetCategory(ctx, want); err != nil {
+				// Keep tracking everything we may have created so it can still be pruned later
+				return unionCategoryNames(managed, previous), fmt.Errorf("failed to set category %s: %w", spec.Name, err)
 			}
 		}
+		managed = append(managed, spec.Name)
 	}
 
-	// Queue settings
-	if spec.Queue != nil {
-		if spec.Queue.PreCheck {
-			if err := client.SetConfig(ctx, "misc", "pre_check", "1"); err != nil {
-				return fmt.Errorf("failed to set pre_check: %w", err)
-			}
+	// Prune categories we created earlier that are no longer in spec.
+	// The default "*" category can never be deleted.
+	for _, name := range previous {
+		lower := strings.ToLower(name)
+		if desiredNames[lower] || name == "*" {
+			continue
 		}
-		if spec.Queue.MaxRetries > 0 {
-			if err := client.SetConfig(ctx, "misc", "max_art_tries", fmt.Sprintf("%d", spec.Queue.MaxRetries)); err != nil {
-				return fmt.Errorf("failed to set max_art_tries: %w", err)
-			}
+		if _, ok := currentByName[lower]; !ok {
+			continue
+		}
+		if err := client.DeleteCategory(ctx, name); err != nil {
+			return unionCategoryNames(managed, previous), fmt.Errorf("failed to delete category %s: %w", name, err)
 		}
 	}
 
-	// Post-processing settings
-	if spec.PostProcessing != nil {
-		if spec.PostProcessing.UnpackEnabled {
-			if err := client.SetConfig(ctx, "misc", "unpack", "1"); err != nil {
-				return fmt.Errorf("failed to set unpack: %w", err)
-			}
-		}
-		if spec.PostProcessing.CleanupEnabled {
-			if err := client.SetConfig(ctx, "misc", "cleanup_list", "1"); err != nil {
-				return fmt.Errorf("failed to set cleanup_list: %w", err)
-			}
-		}
+	if len(managed) == 0 {
+		return nil, nil
 	}
+	return managed, nil
+}
 
-	return nil
+// unionCategoryNames merges category name lists, dropping case-insensitive duplicates
+func unionCategoryNames(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var result []string
+	for _, name := range append(append([]string{}, a...), b...) {
+		if !seen[strings.ToLower(name)] {
+			seen[strings.ToLower(name)] = true
+			result = append(result, name)
+		}
+	}
+	return result
 }
 
 // reconcileNZBGet handles NZBGet configuration
-func (r *DownloadStackConfigReconciler) reconcileNZBGet(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper) error {
-	log := logf.FromContext(ctx)
+func (r *DownloadStackConfigReconciler) reconcileNZBGet(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper, spec *arrv1alpha1.NZBGetSpec, inst downloadClientInstance) error {
+	log := logf.FromContext(ctx).WithValues("client", inst.label())
 
 	// Resolve NZBGet credentials (optional - defaults to nzbget:tegbzn6789)
 	var nzbgetUsername, nzbgetPassword string = "nzbget", "tegbzn6789"
-	if config.Spec.NZBGet.Connection.CredentialsSecretRef != nil {
-		creds := config.Spec.NZBGet.Connection.CredentialsSecretRef
+	if spec.Connection.CredentialsSecretRef != nil {
+		creds := spec.Connection.CredentialsSecretRef
 		usernameKey := creds.UsernameKey
 		if usernameKey == "" {
 			usernameKey = "username"
@@ -1057,20 +1835,20 @@ func (r *DownloadStackConfigReconciler) reconcileNZBGet(ctx context.Context, con
 		var err error
 		nzbgetUsername, err = r.Helper.ResolveSecretValue(ctx, config.Namespace, creds.Name, usernameKey)
 		if err != nil {
-			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetCredentialsFailed", err.Error())
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetCredentialsFailed", inst.message(err))
 			return err
 		}
 
 		nzbgetPassword, err = r.Helper.ResolveSecretValue(ctx, config.Namespace, creds.Name, passwordKey)
 		if err != nil {
-			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetCredentialsFailed", err.Error())
+			r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetCredentialsFailed", inst.message(err))
 			return err
 		}
 	}
 
 	// Create NZBGet client
 	nzbgetClient := downloadstack.NewNZBGetClient(
-		config.Spec.NZBGet.Connection.URL,
+		spec.Connection.URL,
 		nzbgetUsername,
 		nzbgetPassword,
 	)
@@ -1078,23 +1856,53 @@ func (r *DownloadStackConfigReconciler) reconcileNZBGet(ctx context.Context, con
 	// Test connection
 	if err := nzbgetClient.TestConnection(ctx); err != nil {
 		log.Error(err, "Failed to connect to NZBGet")
-		config.Status.NZBGetConnected = false
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetConnectionFailed", err.Error())
+		*inst.connected = false
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetConnectionFailed", inst.message(err))
 		return err
 	}
 
-	config.Status.NZBGetConnected = true
+	*inst.connected = true
 
 	// Get NZBGet version
 	version, err := nzbgetClient.GetVersion(ctx)
 	if err == nil {
-		config.Status.NZBGetVersion = version
+		*inst.version = version
 	}
 
 	// Sync NZBGet settings
-	if err := syncNZBGetSettings(ctx, nzbgetClient, config.Spec.NZBGet); err != nil {
+	result, err := downloadstack.SyncNZBGetSettings(ctx, nzbgetClient, spec)
+	if err != nil {
 		log.Error(err, "Failed to sync NZBGet settings")
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetSyncFailed", err.Error())
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetSyncFailed", inst.message(err))
+		return err
+	}
+	for _, option := range result.Drifted {
+		metrics.RecordConfigDrift("nzbget", option)
+	}
+	if len(result.Drifted) > 0 {
+		log.Info("NZBGet settings drift corrected", "options", result.Drifted)
+	}
+
+	// Sync NZBGet categories
+	managed, err := syncNZBGetCategories(ctx, nzbgetClient, spec.Categories, *inst.categories)
+	*inst.categories = managed
+	if err != nil {
+		log.Error(err, "Failed to sync NZBGet categories")
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetSyncFailed", inst.message(err))
+		return err
+	}
+
+	// Sync NZBGet news servers
+	servers, err := r.resolveNewsServers(ctx, config.Namespace, spec.NewsServers)
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NewsServerResolveFailed", inst.message(err))
+		return err
+	}
+	managed, err = downloadstack.SyncNZBGetServers(ctx, nzbgetClient, servers, *inst.newsServers)
+	*inst.newsServers = managed
+	if err != nil {
+		log.Error(err, "Failed to sync NZBGet news servers")
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "NZBGetSyncFailed", inst.message(err))
 		return err
 	}
 
@@ -1102,154 +1910,98 @@ func (r *DownloadStackConfigReconciler) reconcileNZBGet(ctx context.Context, con
 	return nil
 }
 
-// syncNZBGetSettings syncs NZBGet configuration from spec
-func syncNZBGetSettings(ctx context.Context, client *downloadstack.NZBGetClient, spec *arrv1alpha1.NZBGetSpec) error {
-	// Speed settings
-	if spec.Speed != nil {
-		if spec.Speed.DownloadRate > 0 {
-			if err := client.SetDownloadRate(ctx, spec.Speed.DownloadRate); err != nil {
-				return fmt.Errorf("failed to set download rate: %w", err)
-			}
-		}
-		if spec.Speed.ArticleTimeout > 0 {
-			if err := client.SetConfig(ctx, "ArticleTimeout", fmt.Sprintf("%d", spec.Speed.ArticleTimeout)); err != nil {
-				return fmt.Errorf("failed to set ArticleTimeout: %w", err)
-			}
-		}
-		if spec.Speed.WriteBuffer > 0 {
-			if err := client.SetConfig(ctx, "WriteBuffer", fmt.Sprintf("%d", spec.Speed.WriteBuffer)); err != nil {
-				return fmt.Errorf("failed to set WriteBuffer: %w", err)
-			}
-		}
+// syncNZBGetCategories rewrites the CategoryN.* options from spec, deleting categories
+// previously managed by the operator that were removed from spec and keeping
+// categories created outside the operator. Indexes are renumbered contiguously.
+// Returns the names of the categories now managed by the operator.
+func syncNZBGetCategories(ctx context.Context, client downloadstack.NZBGetClientInterface, desired []arrv1alpha1.NZBGetCategorySpec, previous []string) ([]string, error) {
+	if len(desired) == 0 && len(previous) == 0 {
+		return nil, nil
 	}
 
-	// Directory settings
-	if spec.Directories != nil {
-		if spec.Directories.MainDir != "" {
-			if err := client.SetConfig(ctx, "MainDir", spec.Directories.MainDir); err != nil {
-				return fmt.Errorf("failed to set MainDir: %w", err)
-			}
-		}
-		if spec.Directories.DestDir != "" {
-			if err := client.SetConfig(ctx, "DestDir", spec.Directories.DestDir); err != nil {
-				return fmt.Errorf("failed to set DestDir: %w", err)
-			}
-		}
-		if spec.Directories.InterDir != "" {
-			if err := client.SetConfig(ctx, "InterDir", spec.Directories.InterDir); err != nil {
-				return fmt.Errorf("failed to set InterDir: %w", err)
-			}
-		}
-		if spec.Directories.NzbDir != "" {
-			if err := client.SetConfig(ctx, "NzbDir", spec.Directories.NzbDir); err != nil {
-				return fmt.Errorf("failed to set NzbDir: %w", err)
-			}
-		}
-		if spec.Directories.TempDir != "" {
-			if err := client.SetConfig(ctx, "TempDir", spec.Directories.TempDir); err != nil {
-				return fmt.Errorf("failed to set TempDir: %w", err)
-			}
-		}
-		if spec.Directories.ScriptDir != "" {
-			if err := client.SetConfig(ctx, "ScriptDir", spec.Directories.ScriptDir); err != nil {
-				return fmt.Errorf("failed to set ScriptDir: %w", err)
-			}
-		}
+	items, err := client.LoadConfig(ctx)
+	if err != nil {
+		return previous, fmt.Errorf("failed to load config: %w", err)
 	}
+	current := downloadstack.ParseNZBGetCategories(items)
 
-	// Queue settings
-	if spec.Queue != nil {
-		if spec.Queue.DupeCheck {
-			if err := client.SetConfig(ctx, "DupeCheck", "yes"); err != nil {
-				return fmt.Errorf("failed to set DupeCheck: %w", err)
-			}
-		}
-		if spec.Queue.PropagationDelay > 0 {
-			if err := client.SetConfig(ctx, "PropagationDelay", fmt.Sprintf("%d", spec.Queue.PropagationDelay)); err != nil {
-				return fmt.Errorf("failed to set PropagationDelay: %w", err)
-			}
-		}
-		if spec.Queue.HealthCheck != "" {
-			if err := client.SetConfig(ctx, "HealthCheck", spec.Queue.HealthCheck); err != nil {
-				return fmt.Errorf("failed to set HealthCheck: %w", err)
-			}
-		}
+	desiredByName := make(map[string]arrv1alpha1.NZBGetCategorySpec, len(desired))
+	for _, spec := range desired {
+		desiredByName[strings.ToLower(spec.Name)] = spec
+	}
+	previousNames := make(map[string]bool, len(previous))
+	for _, name := range previous {
+		previousNames[strings.ToLower(name)] = true
 	}
 
-	// Post-processing settings
-	if spec.PostProcessing != nil {
-		if spec.PostProcessing.ParCheck != "" {
-			if err := client.SetConfig(ctx, "ParCheck", spec.PostProcessing.ParCheck); err != nil {
-				return fmt.Errorf("failed to set ParCheck: %w", err)
-			}
-		}
-		if spec.PostProcessing.ParRepair != nil {
-			value := "no"
-			if *spec.PostProcessing.ParRepair {
-				value = "yes"
-			}
-			if err := client.SetConfig(ctx, "ParRepair", value); err != nil {
-				return fmt.Errorf("failed to set ParRepair: %w", err)
-			}
-		}
-		if spec.PostProcessing.Unpack != nil {
-			value := "no"
-			if *spec.PostProcessing.Unpack {
-				value = "yes"
-			}
-			if err := client.SetConfig(ctx, "Unpack", value); err != nil {
-				return fmt.Errorf("failed to set Unpack: %w", err)
-			}
+	// Keep existing order; update desired categories and drop pruned ones
+	var categories []downloadstack.NZBGetCategory
+	seen := make(map[string]bool, len(desired))
+	for _, cat := range current {
+		lower := strings.ToLower(cat.Name)
+		if spec, ok := desiredByName[lower]; ok {
+			categories = append(categories, applyNZBGetCategorySpec(cat, spec))
+			seen[lower] = true
+			continue
 		}
-		if spec.PostProcessing.UnpackCleanupDisk != nil {
-			value := "no"
-			if *spec.PostProcessing.UnpackCleanupDisk {
-				value = "yes"
-			}
-			if err := client.SetConfig(ctx, "UnpackCleanupDisk", value); err != nil {
-				return fmt.Errorf("failed to set UnpackCleanupDisk: %w", err)
-			}
-		}
-		if spec.PostProcessing.DirectUnpack != nil {
-			value := "no"
-			if *spec.PostProcessing.DirectUnpack {
-				value = "yes"
-			}
-			if err := client.SetConfig(ctx, "DirectUnpack", value); err != nil {
-				return fmt.Errorf("failed to set DirectUnpack: %w", err)
-			}
+		if previousNames[lower] {
+			continue
 		}
+		categories = append(categories, cat)
 	}
 
-	// Connection settings
-	if spec.Connections != nil {
-		if spec.Connections.ArticleConnections > 0 {
-			if err := client.SetConfig(ctx, "ArticleConnections", fmt.Sprintf("%d", spec.Connections.ArticleConnections)); err != nil {
-				return fmt.Errorf("failed to set ArticleConnections: %w", err)
-			}
+	// Append new categories in spec order
+	for _, spec := range desired {
+		if seen[strings.ToLower(spec.Name)] {
+			continue
 		}
-		if spec.Connections.RetryInterval > 0 {
-			if err := client.SetConfig(ctx, "RetryInterval", fmt.Sprintf("%d", spec.Connections.RetryInterval)); err != nil {
-				return fmt.Errorf("failed to set RetryInterval: %w", err)
-			}
-		}
-		if spec.Connections.TerminateTimeout > 0 {
-			if err := client.SetConfig(ctx, "TerminateTimeout", fmt.Sprintf("%d", spec.Connections.TerminateTimeout)); err != nil {
-				return fmt.Errorf("failed to set TerminateTimeout: %w", err)
-			}
+		newCat := downloadstack.NZBGetCategory{
+			Name:    spec.Name,
+			Options: map[string]string{"Unpack": "yes"},
 		}
-		if spec.Connections.Decode != nil {
-			value := "no"
-			if *spec.Connections.Decode {
-				value = "yes"
-			}
-			if err := client.SetConfig(ctx, "Decode", value); err != nil {
-				return fmt.Errorf("failed to set Decode: %w", err)
-			}
+		categories = append(categories, applyNZBGetCategorySpec(newCat, spec))
+	}
+
+	managed := make([]string, 0, len(desired))
+	for _, spec := range desired {
+		managed = append(managed, spec.Name)
+	}
+	if len(managed) == 0 {
+		managed = nil
+	}
+
+	updated := downloadstack.ReplaceNZBGetCategories(items, categories)
+	if reflect.DeepEqual(updated, downloadstack.ReplaceNZBGetCategories(items, current)) {
+		return managed, nil
+	}
+
+	if err := client.SaveConfig(ctx, updated); err != nil {
+		return unionCategoryNames(managed, previous), fmt.Errorf("failed to save categories: %w", err)
+	}
+	if err := client.Reload(ctx); err != nil {
+		return managed, fmt.Errorf("failed to reload after saving categories: %w", err)
+	}
+
+	return managed, nil
+}
+
+// applyNZBGetCategorySpec sets the spec-managed options on a category
+func applyNZBGetCategorySpec(cat downloadstack.NZBGetCategory, spec arrv1alpha1.NZBGetCategorySpec) downloadstack.NZBGetCategory {
+	options := make(map[string]string, len(cat.Options)+3)
+	for k, v := range cat.Options {
+		options[k] = v
+	}
+
+	options["DestDir"] = spec.DestDir
+	if spec.Unpack != nil {
+		options["Unpack"] = "no"
+		if *spec.Unpack {
+			options["Unpack"] = "yes"
 		}
 	}
+	options["Aliases"] = strings.Join(spec.Aliases, ", ")
 
-	return nil
+	return downloadstack.NZBGetCategory{Name: spec.Name, Options: options}
 }
 
 // reconcileDelete handles cleanup when the resource is being deleted
@@ -1269,6 +2021,42 @@ func (r *DownloadStackConfigReconciler) reconcileDelete(ctx context.Context, con
 	return ctrl.Result{}, nil
 }
 
+// applyGluetunSecret creates or updates the Secret holding the Gluetun env vars.
+// Data is replaced as a whole, so removed variables are dropped, and the
+// Secret is only written when the rendered env differs from what it holds.
+func (r *DownloadStackConfigReconciler) applyGluetunSecret(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, gluetunEnv map[string]string) error {
+	gluetunSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.Name + "-gluetun-env",
+			Namespace: config.Namespace,
+		},
+	}
+
+	data := make(map[string][]byte, len(gluetunEnv))
+	for key, value := range gluetunEnv {
+		data[key] = []byte(value)
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, gluetunSecret, func() error {
+		// Set owner reference
+		if err := controllerutil.SetControllerReference(config, gluetunSecret, r.Scheme); err != nil {
+			return err
+		}
+
+		// StringData is never read back, so setting it would make every reconcile an update
+		gluetunSecret.StringData = nil
+		gluetunSecret.Data = data
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if result != controllerutil.OperationResultNone {
+		metrics.RecordSecretWrite("gluetun-env", string(result))
+	}
+	return nil
+}
+
 // restartDeployment annotates the Deployment to trigger a restart
 func (r *DownloadStackConfigReconciler) restartDeployment(ctx context.Context, config *arrv1alpha1.DownloadStackConfig) error {
 	deployment := &appsv1.Deployment{}
@@ -1284,15 +2072,167 @@ func (r *DownloadStackConfigReconciler) restartDeployment(ctx context.Context, c
 		deployment.Spec.Template.Annotations = make(map[string]string)
 	}
 	deployment.Spec.Template.Annotations[restartAnnotationKey] = time.Now().Format(time.RFC3339)
-	deployment.Spec.Template.Annotations[configHashAnnotationKey] = config.Status.GluetunConfigHash
+	for key, hash := range deploymentHashes(config) {
+		deployment.Spec.Template.Annotations[key] = hash
+	}
+
+	if err := r.Update(ctx, deployment); err != nil {
+		return fmt.Errorf("failed to update deployment: %w", err)
+	}
+
+	return nil
+}
+
+// deploymentHashes returns the pod template hash annotations of the configs
+// whose changes restart the Deployment
+func deploymentHashes(config *arrv1alpha1.DownloadStackConfig) map[string]string {
+	hashes := make(map[string]string)
+	if config.Spec.RestartOnGluetunChange {
+		hashes[configHashAnnotationKey] = config.Status.GluetunConfigHash
+	}
+	if t := config.Spec.Transmission; t != nil && t.SettingsFile != nil && t.SettingsFile.RestartOnChange &&
+		config.Status.TransmissionSettingsHash != "" {
+		hashes[transmissionSettingsHashAnnotationKey] = config.Status.TransmissionSettingsHash
+	}
+	if rt := config.Spec.RTorrent; rt != nil && rt.ConfigFile != nil && rt.ConfigFile.RestartOnChange &&
+		config.Status.RTorrentConfigHash != "" {
+		hashes[rtorrentConfigHashAnnotationKey] = config.Status.RTorrentConfigHash
+	}
+	return hashes
+}
+
+// ensureDeploymentHashes restores the hash annotations on a Deployment whose
+// pod template no longer carries the applied hashes. The pods of a recreated
+// Deployment already read the current Secrets, so only the hashes are set.
+func (r *DownloadStackConfigReconciler) ensureDeploymentHashes(ctx context.Context, config *arrv1alpha1.DownloadStackConfig) error {
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKey{
+		Namespace: config.Namespace,
+		Name:      config.Spec.DeploymentRef.Name,
+	}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	missing := false
+	for key, hash := range deploymentHashes(config) {
+		if deployment.Spec.Template.Annotations[key] != hash {
+			missing = true
+		}
+	}
+	if !missing {
+		return nil
+	}
+
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = make(map[string]string)
+	}
+	for key, hash := range deploymentHashes(config) {
+		deployment.Spec.Template.Annotations[key] = hash
+	}
 
 	if err := r.Update(ctx, deployment); err != nil {
 		return fmt.Errorf("failed to update deployment: %w", err)
 	}
 
+	logf.FromContext(ctx).Info("Restored hash annotations on Deployment", "deployment", deployment.Name)
 	return nil
 }
 
+// mapDeploymentToConfigs enqueues the DownloadStackConfigs that reference a Deployment
+func (r *DownloadStackConfigReconciler) mapDeploymentToConfigs(ctx context.Context, obj client.Object) []reconcile.Request {
+	configs := &arrv1alpha1.DownloadStackConfigList{}
+	if err := r.List(ctx, configs, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, config := range configs.Items {
+		if config.Spec.DeploymentRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: config.Name, Namespace: config.Namespace},
+			})
+		}
+	}
+	return requests
+}
+
+// trackerListConfigMapIndex indexes DownloadStackConfigs by the ConfigMaps of their tracker lists
+const trackerListConfigMapIndex = "spec.trackerListConfigMaps"
+
+// trackerListConfigMaps returns the names of the ConfigMaps the tracker lists of a DownloadStackConfig read
+func trackerListConfigMaps(obj client.Object) []string {
+	config, ok := obj.(*arrv1alpha1.DownloadStackConfig)
+	if !ok {
+		return nil
+	}
+	var names []string
+	add := func(list *arrv1alpha1.TrackerListSpec) {
+		if list != nil && list.ConfigMapRef != nil {
+			names = append(names, list.ConfigMapRef.Name)
+		}
+	}
+	transmission := []*arrv1alpha1.TransmissionSpec{config.Spec.Transmission}
+	for i := range config.Spec.TransmissionInstances {
+		transmission = append(transmission, &config.Spec.TransmissionInstances[i].TransmissionSpec)
+	}
+	for _, spec := range transmission {
+		if spec != nil && spec.Trackers != nil {
+			add(spec.Trackers.DefaultTrackerList)
+		}
+	}
+	qbittorrent := []*arrv1alpha1.QBittorrentSpec{config.Spec.QBittorrent}
+	for i := range config.Spec.QBittorrentInstances {
+		qbittorrent = append(qbittorrent, &config.Spec.QBittorrentInstances[i].QBittorrentSpec)
+	}
+	for _, spec := range qbittorrent {
+		if spec != nil && spec.Trackers != nil {
+			add(spec.Trackers.AddTrackers)
+		}
+	}
+	return names
+}
+
+// mapConfigMapToConfigs enqueues the DownloadStackConfigs whose tracker lists read a ConfigMap
+func (r *DownloadStackConfigReconciler) mapConfigMapToConfigs(ctx context.Context, obj client.Object) []reconcile.Request {
+	configs := &arrv1alpha1.DownloadStackConfigList{}
+	if err := r.List(ctx, configs, client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{trackerListConfigMapIndex: obj.GetName()}); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(configs.Items))
+	for _, config := range configs.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: config.Name, Namespace: config.Namespace},
+		})
+	}
+	return requests
+}
+
+// deploymentHashChanged admits Deployment creations and pod template hash
+// annotation changes, ignoring the frequent status-only updates
+var deploymentHashChanged = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool { return true },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldDep, okOld := e.ObjectOld.(*appsv1.Deployment)
+		newDep, okNew := e.ObjectNew.(*appsv1.Deployment)
+		if !okOld || !okNew {
+			return false
+		}
+		for _, key := range []string{configHashAnnotationKey, transmissionSettingsHashAnnotationKey, rtorrentConfigHashAnnotationKey} {
+			if oldDep.Spec.Template.Annotations[key] != newDep.Spec.Template.Annotations[key] {
+				return true
+			}
+		}
+		return false
+	},
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
 // SetupWithManager sets up the controller with the Manager
 func (r *DownloadStackConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// Initialize helper if not set
@@ -1300,8 +2240,22 @@ func (r *DownloadStackConfigReconciler) SetupWithManager(mgr ctrl.Manager) error
 		r.Helper = NewReconcileHelper(r.Client)
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	// Tracker list ConfigMaps are read on every sync; the index finds the configs to requeue on an edit
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &arrv1alpha1.DownloadStackConfig{},
+		trackerListConfigMapIndex, trackerListConfigMaps); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&arrv1alpha1.DownloadStackConfig{}).
 		Owns(&corev1.Secret{}).
-		Complete(r)
+		Watches(&appsv1.Deployment{},
+			handler.EnqueueRequestsFromMapFunc(r.mapDeploymentToConfigs),
+			builder.WithPredicates(deploymentHashChanged)).
+		Watches(&arrv1alpha1.NewsServerPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.mapNewsServerPolicyToConfigs)).
+		Watches(&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.mapConfigMapToConfigs))
+
+	return r.Options.complete(mgr, b, "downloadstackconfig", &arrv1alpha1.DownloadStackConfigList{}, r)
 }
@@ -28,6 +28,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
@@ -176,6 +177,12 @@ var _ = Describe("DownloadStackConfig Controller", func() {
 				Namespace: namespace,
 			}, generatedSecret)
 			_ = k8sClient.Delete(ctx, generatedSecret)
+
+			settingsSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName + "-transmission-settings",
+				Namespace: namespace,
+			}}
+			_ = k8sClient.Delete(ctx, settingsSecret)
 		})
 
 		It("should add finalizer on creation", func() {
@@ -232,6 +239,20 @@ var _ = Describe("DownloadStackConfig Controller", func() {
 			By("Checking Secret contains expected env vars")
 			Expect(gluetunEnvSecret.Data).To(HaveKey("VPN_SERVICE_PROVIDER"))
 			Expect(string(gluetunEnvSecret.Data["VPN_SERVICE_PROVIDER"])).To(Equal("mullvad"))
+
+			By("Reconciling again without changes")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespaceName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Checking the Secret was not rewritten")
+			unchanged := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      resourceName + "-gluetun-env",
+				Namespace: namespace,
+			}, unchanged)).To(Succeed())
+			Expect(unchanged.ResourceVersion).To(Equal(gluetunEnvSecret.ResourceVersion))
 		})
 
 		It("should set Ready=False when Gluetun credentials secret is missing", func() {
@@ -404,6 +425,44 @@ var _ = Describe("DownloadStackConfig Controller", func() {
 			Expect(dep.Spec.Template.Annotations).To(HaveKey(restartAnnotationKey))
 		})
 
+		It("should restore the Gluetun hash annotation on a recreated Deployment", func() {
+			By("Creating the DownloadStackConfig resource")
+			Expect(k8sClient.Create(ctx, dsConfig)).To(Succeed())
+
+			By("Reconciling to add finalizer and apply the initial config")
+			for i := 0; i < 2; i++ {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespaceName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			By("Recreating the Deployment without annotations")
+			Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+			recreated := deployment.DeepCopy()
+			recreated.ResourceVersion = ""
+			recreated.UID = ""
+			recreated.Spec.Template.Annotations = nil
+			Expect(k8sClient.Create(ctx, recreated)).To(Succeed())
+
+			By("Reconciling again")
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespaceName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Checking that the hash annotation was restored")
+			updatedConfig := &arrv1alpha1.DownloadStackConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updatedConfig)).To(Succeed())
+			dep := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      deploymentName,
+				Namespace: namespace,
+			}, dep)).To(Succeed())
+			Expect(dep.Spec.Template.Annotations).To(HaveKeyWithValue(configHashAnnotationKey, updatedConfig.Status.GluetunConfigHash))
+			Expect(dep.Spec.Template.Annotations).NotTo(HaveKey(restartAnnotationKey))
+		})
+
 		It("should set GluetunSecretGenerated in status", func() {
 			By("Creating the DownloadStackConfig resource")
 			Expect(k8sClient.Create(ctx, dsConfig)).To(Succeed())
@@ -478,6 +537,54 @@ var _ = Describe("DownloadStackConfig Controller", func() {
 			Expect(settings["speed-limit-down-enabled"]).To(BeTrue())
 		})
 
+		It("should render the Transmission settings.json Secret and restart on change", func() {
+			By("Creating DownloadStackConfig with a settings file")
+			dsConfig.Spec.Transmission.SettingsFile = &arrv1alpha1.TransmissionSettingsFileSpec{
+				RPCWhitelist:    []string{"127.0.0.1", "10.*.*.*"},
+				RestartOnChange: true,
+			}
+			Expect(k8sClient.Create(ctx, dsConfig)).To(Succeed())
+
+			for i := 0; i < 2; i++ {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespaceName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			By("Checking the rendered Secret")
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      resourceName + "-transmission-settings",
+				Namespace: namespace,
+			}, secret)).To(Succeed())
+			Expect(string(secret.Data["settings.json"])).To(ContainSubstring(`"rpc-whitelist": "127.0.0.1,10.*.*.*"`))
+
+			updatedConfig := &arrv1alpha1.DownloadStackConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updatedConfig)).To(Succeed())
+			initialHash := updatedConfig.Status.TransmissionSettingsHash
+			Expect(initialHash).NotTo(BeEmpty())
+
+			By("Changing the whitelist")
+			updatedConfig.Spec.Transmission.SettingsFile.RPCWhitelist = []string{"127.0.0.1"}
+			Expect(k8sClient.Update(ctx, updatedConfig)).To(Succeed())
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespaceName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Checking that the Deployment carries the new hash")
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updatedConfig)).To(Succeed())
+			Expect(updatedConfig.Status.TransmissionSettingsHash).NotTo(Equal(initialHash))
+			dep := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      deploymentName,
+				Namespace: namespace,
+			}, dep)).To(Succeed())
+			Expect(dep.Spec.Template.Annotations).To(HaveKey(restartAnnotationKey))
+			Expect(dep.Spec.Template.Annotations).To(HaveKeyWithValue(transmissionSettingsHashAnnotationKey, updatedConfig.Status.TransmissionSettingsHash))
+		})
+
 		It("should handle resource not found gracefully", func() {
 			By("Reconciling a non-existent resource")
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{
@@ -491,3 +598,159 @@ var _ = Describe("DownloadStackConfig Controller", func() {
 		})
 	})
 })
+
+var _ = Describe("validateDownloadStackSpec", func() {
+	It("should accept numeric ratios", func() {
+		spec := &arrv1alpha1.DownloadStackConfigSpec{
+			Transmission: &arrv1alpha1.TransmissionSpec{
+				Seeding: &arrv1alpha1.TransmissionSeedingSpec{RatioLimit: "2.0"},
+			},
+			Deluge: &arrv1alpha1.DelugeSpec{
+				Seeding: &arrv1alpha1.DelugeSeedingSpec{ShareRatioLimit: "-1"},
+			},
+		}
+		Expect(validateDownloadStackSpec(spec)).To(BeEmpty())
+	})
+
+	It("should report unparsable ratios by JSON path", func() {
+		spec := &arrv1alpha1.DownloadStackConfigSpec{
+			QBittorrent: &arrv1alpha1.QBittorrentSpec{
+				Seeding: &arrv1alpha1.QBittorrentSeedingSpec{MaxRatio: "2:1"},
+			},
+			Deluge: &arrv1alpha1.DelugeSpec{
+				Seeding: &arrv1alpha1.DelugeSeedingSpec{StopSeedRatio: "two"},
+			},
+		}
+		invalid := validateDownloadStackSpec(spec)
+		Expect(invalid).To(HaveLen(2))
+		Expect(invalid[0].Path).To(Equal("spec.qbittorrent.seeding.maxRatio"))
+		Expect(invalid[0].Value).To(Equal("2:1"))
+		Expect(invalid[1].Path).To(Equal("spec.deluge.seeding.stopSeedRatio"))
+
+		fields := invalidFieldsStatus(invalid)
+		Expect(fields).To(HaveLen(2))
+		Expect(fields[1].Message).To(Equal(invalid[1].Reason))
+	})
+
+	It("should check named instances by name", func() {
+		spec := &arrv1alpha1.DownloadStackConfigSpec{
+			QBittorrentInstances: []arrv1alpha1.QBittorrentInstanceSpec{{
+				Name: "4k",
+				QBittorrentSpec: arrv1alpha1.QBittorrentSpec{
+					Seeding: &arrv1alpha1.QBittorrentSeedingSpec{MaxRatio: "2:1"},
+				},
+			}},
+			TransmissionInstances: []arrv1alpha1.TransmissionInstanceSpec{{
+				Name: "private",
+				TransmissionSpec: arrv1alpha1.TransmissionSpec{
+					SettingsFile: &arrv1alpha1.TransmissionSettingsFileSpec{},
+				},
+			}},
+		}
+		invalid := validateDownloadStackSpec(spec)
+		Expect(invalid).To(HaveLen(2))
+		Expect(invalid[0].Path).To(Equal("spec.transmissionInstances[private].settingsFile"))
+		Expect(invalid[1].Path).To(Equal("spec.qbittorrentInstances[4k].seeding.maxRatio"))
+	})
+
+	It("should require the rTorrent config file for watch directories", func() {
+		spec := &arrv1alpha1.DownloadStackConfigSpec{
+			RTorrent: &arrv1alpha1.RTorrentSpec{
+				Directories: &arrv1alpha1.RTorrentDirectoriesSpec{WatchDirectory: "/watch"},
+			},
+			RTorrentInstances: []arrv1alpha1.RTorrentInstanceSpec{{
+				Name: "seedbox",
+				RTorrentSpec: arrv1alpha1.RTorrentSpec{
+					ConfigFile: &arrv1alpha1.RTorrentConfigFileSpec{},
+				},
+			}},
+		}
+		invalid := validateDownloadStackSpec(spec)
+		Expect(invalid).To(HaveLen(2))
+		Expect(invalid[0].Path).To(Equal("spec.rtorrent.directories.watchDirectory"))
+		Expect(invalid[1].Path).To(Equal("spec.rtorrentInstances[seedbox].configFile"))
+
+		spec.RTorrent.ConfigFile = &arrv1alpha1.RTorrentConfigFileSpec{}
+		spec.RTorrentInstances = nil
+		Expect(validateDownloadStackSpec(spec)).To(BeEmpty())
+	})
+
+	It("should check Transmission removal rules", func() {
+		spec := &arrv1alpha1.DownloadStackConfigSpec{
+			Transmission: &arrv1alpha1.TransmissionSpec{
+				TorrentPolicy: &arrv1alpha1.TransmissionTorrentPolicySpec{
+					Categories: []arrv1alpha1.TransmissionTorrentCategorySpec{{Name: "tv"}},
+					RemovalRules: []arrv1alpha1.TransmissionRemovalRule{
+						{Name: "ok", Tracker: `private\.example`, Category: "TV", RatioLimit: "2.0"},
+						{Name: "unbounded", Tracker: "("},
+						{Name: "unknown", Category: "movies", SeedingTimeLimit: ptr.To(60)},
+					},
+				},
+			},
+		}
+		invalid := validateDownloadStackSpec(spec)
+		Expect(invalid).To(HaveLen(3))
+		Expect(invalid[0].Path).To(Equal("spec.transmission.torrentPolicy.removalRules[unbounded].tracker"))
+		Expect(invalid[1].Path).To(Equal("spec.transmission.torrentPolicy.removalRules[unbounded]"))
+		Expect(invalid[2].Path).To(Equal("spec.transmission.torrentPolicy.removalRules[unknown].category"))
+	})
+
+	It("should report duplicate news servers", func() {
+		spec := &arrv1alpha1.DownloadStackConfigSpec{
+			SABnzbd: &arrv1alpha1.SABnzbdSpec{
+				NewsServers: []arrv1alpha1.NewsServerRef{{Name: "primary"}, {Name: "fill"}},
+			},
+			NZBGetInstances: []arrv1alpha1.NZBGetInstanceSpec{{
+				Name: "backup",
+				NZBGetSpec: arrv1alpha1.NZBGetSpec{
+					NewsServers: []arrv1alpha1.NewsServerRef{{Name: "primary"}, {Name: "primary"}},
+				},
+			}},
+		}
+		invalid := validateDownloadStackSpec(spec)
+		Expect(invalid).To(HaveLen(1))
+		Expect(invalid[0].Path).To(Equal("spec.nzbgetInstances[backup].newsServers"))
+		Expect(invalid[0].Value).To(Equal("primary"))
+		Expect(referencesNewsServer(spec, "fill")).To(BeTrue())
+		Expect(referencesNewsServer(spec, "other")).To(BeFalse())
+	})
+
+	It("should check Gluetun upstream DNS addresses", func() {
+		spec := &arrv1alpha1.DownloadStackConfigSpec{
+			Gluetun: arrv1alpha1.GluetunSpec{
+				DNS: &arrv1alpha1.GluetunDNSSpec{
+					OverTLS:           true,
+					UpstreamAddresses: []string{"10.96.0.10", "[fd00::10]:5353", "dns.example.com"},
+				},
+			},
+		}
+		invalid := validateDownloadStackSpec(spec)
+		Expect(invalid).To(HaveLen(2))
+		Expect(invalid[0].Path).To(Equal("spec.gluetun.dns.upstreamAddresses"))
+		Expect(invalid[1].Path).To(Equal("spec.gluetun.dns.upstreamAddresses[2]"))
+
+		spec.Gluetun.DNS.OverTLS = false
+		spec.Gluetun.DNS.UpstreamAddresses = spec.Gluetun.DNS.UpstreamAddresses[:2]
+		Expect(validateDownloadStackSpec(spec)).To(BeEmpty())
+	})
+
+	It("should check the Flood client addresses", func() {
+		spec := &arrv1alpha1.DownloadStackConfigSpec{
+			Flood: &arrv1alpha1.FloodSpec{
+				Client: arrv1alpha1.FloodClientSpec{Type: "rtorrent", Host: "localhost"},
+				Users: []arrv1alpha1.FloodUserSpec{
+					{Name: "alice", Client: &arrv1alpha1.FloodClientSpec{Type: "qbittorrent"}},
+					{Name: "bob", Client: &arrv1alpha1.FloodClientSpec{Type: "deluge", Host: "localhost", Port: 58846}},
+				},
+			},
+		}
+		invalid := validateDownloadStackSpec(spec)
+		Expect(invalid).To(HaveLen(2))
+		Expect(invalid[0].Path).To(Equal("spec.flood.client"))
+		Expect(invalid[1].Path).To(Equal("spec.flood.users[alice].client"))
+
+		spec.Flood.Client = arrv1alpha1.FloodClientSpec{Type: "rtorrent", Socket: "/run/rtorrent/scgi.sock"}
+		spec.Flood.Users[0].Client.URL = "http://localhost:8080"
+		Expect(validateDownloadStackSpec(spec)).To(BeEmpty())
+	})
+})
@@ -19,7 +19,9 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -44,9 +46,15 @@ type ArrConfigObject interface {
 	// GetReconciliationSpec returns the reconciliation configuration (may be nil)
 	GetReconciliationSpec() *arrv1alpha1.ReconciliationSpec
 
+	// GetObserve returns true when the operator must never write to the app
+	GetObserve() bool
+
 	// GetDownloadClients returns the download client specs
 	GetDownloadClients() []arrv1alpha1.DownloadClientSpec
 
+	// SetDownloadClients replaces the download client specs in memory
+	SetDownloadClients(clients []arrv1alpha1.DownloadClientSpec)
+
 	// GetIndexersSpec returns the indexers specification (may be nil)
 	GetIndexersSpec() *arrv1alpha1.IndexersSpec
 
@@ -56,8 +64,42 @@ type ArrConfigObject interface {
 	// GetAuthenticationSpec returns the authentication specification (may be nil)
 	GetAuthenticationSpec() *arrv1alpha1.AuthenticationSpec
 
-	// GetStatusWrapper returns a ConfigStatus wrapper for updating status
-	GetStatusWrapper() ConfigStatus
+	// GetRawRequests returns the raw API request specs
+	GetRawRequests() []arrv1alpha1.RawRequestSpec
+
+	// GetRawRequestStatusPtr returns a pointer to the RawRequests field in the status
+	GetRawRequestStatusPtr() *[]arrv1alpha1.RawRequestStatus
+
+	// GetMediaServerHooks returns the media server hooks specification (may be nil)
+	GetMediaServerHooks() *arrv1alpha1.MediaServerHooksSpec
+
+	// GetMediaServerStatusPtr returns a pointer to the MediaServers field in the status
+	// (nil for apps that don't support media server hooks)
+	GetMediaServerStatusPtr() *[]arrv1alpha1.MediaServerStatus
+
+	// GetNotifications returns the notification specs
+	GetNotifications() []arrv1alpha1.NotificationSpec
+
+	// GetNotificationStatusPtr returns a pointer to the Notifications field in the status
+	// (nil for apps that don't support notification tests)
+	GetNotificationStatusPtr() *[]arrv1alpha1.NotificationStatus
+
+	// GetIndexerStatusPtr returns a pointer to the Indexers field in the status
+	// (nil for apps that don't support indexer tests)
+	GetIndexerStatusPtr() *[]arrv1alpha1.IndexerStatus
+
+	// GetPausedResourcesPtr returns a pointer to the PausedResources field in the status
+	GetPausedResourcesPtr() *[]string
+
+	// GetMaintenanceSpec returns the maintenance specification (may be nil)
+	GetMaintenanceSpec() *arrv1alpha1.MaintenanceSpec
+
+	// GetMaintenanceStatusPtr returns a pointer to the Maintenance field in the status
+	// (nil for apps that don't support maintenance)
+	GetMaintenanceStatusPtr() **arrv1alpha1.MaintenanceStatus
+
+	// GetStatusWrapper returns a status wrapper for updating status
+	GetStatusWrapper() SyncedConfigStatus
 
 	// GetHealthStatusPtr returns a pointer to the Health field in the status
 	GetHealthStatusPtr() **arrv1alpha1.HealthStatus
@@ -91,6 +133,9 @@ type GenericArrReconciler struct {
 
 	// CompileConfig is the type-specific compile function
 	CompileConfig ArrConfigCompiler
+
+	// Options supplies the requeue jitter and IR snapshot mode
+	Options ControllerOptions
 }
 
 // Reconcile handles the main reconciliation loop for any *arr config
@@ -106,6 +151,23 @@ func (r *GenericArrReconciler) Reconcile(ctx context.Context, config ArrConfigOb
 
 	finalizerName := config.GetFinalizerName()
 
+	// Observed configs never write to the app, so there is nothing to clean up on
+	// deletion. A finalizer added before spec.observe was set is dropped.
+	if config.GetObserve() {
+		if controllerutil.RemoveFinalizer(obj, finalizerName) {
+			if err := r.Update(ctx, obj); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if !obj.GetDeletionTimestamp().IsZero() {
+			r.Options.forgetNotifications(r.Scheme, obj)
+			r.Options.forgetApplySummary(r.Scheme, obj)
+			r.Helper.Clusters.Forget(obj)
+			return ctrl.Result{}, nil
+		}
+		return r.reconcileNormal(ctx, config)
+	}
+
 	// Handle deletion
 	if !obj.GetDeletionTimestamp().IsZero() {
 		return r.reconcileDelete(ctx, config, finalizerName)
@@ -136,45 +198,27 @@ func (r *GenericArrReconciler) reconcileNormal(ctx context.Context, config ArrCo
 	namespace := obj.GetNamespace()
 	connSpec := config.GetConnectionSpec()
 
-	// Resolve connection secrets
-	resolvedSecrets, err := r.Helper.ResolveConnectionSecrets(ctx, namespace, connSpec)
+	// Fill in download clients from the DownloadStackConfig clients they reference.
+	// The spec is only changed in memory, before the status comparison copy is taken.
+	clients, err := r.Helper.ResolveDownloadClientRefs(ctx, namespace, config.GetDownloadClients())
 	if err != nil {
-		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, "SecretResolutionFailed", err.Error())
-		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
-			log.Error(statusErr, "Failed to update status")
-		}
-		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
-	}
-
-	// Resolve download client secrets
-	if err := r.Helper.ResolveDownloadClientSecrets(ctx, namespace, config.GetDownloadClients(), resolvedSecrets); err != nil {
-		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, "SecretResolutionFailed", err.Error())
-		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
-			log.Error(statusErr, "Failed to update status")
-		}
-		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
-	}
-
-	// Resolve indexer secrets
-	if err := r.Helper.ResolveIndexerSecrets(ctx, namespace, config.GetIndexersSpec(), resolvedSecrets); err != nil {
-		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, "SecretResolutionFailed", err.Error())
+		r.Helper.SetCompileFailure(statusWrapper, generation, err)
 		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
 		}
 		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 	}
+	config.SetDownloadClients(clients)
+	original := obj.DeepCopyObject()
 
-	// Resolve import list secrets
-	if err := r.Helper.ResolveImportListSecrets(ctx, namespace, config.GetImportLists(), resolvedSecrets); err != nil {
-		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, "SecretResolutionFailed", err.Error())
-		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
-			log.Error(statusErr, "Failed to update status")
-		}
-		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
-	}
+	// Report Ready transitions, repeated drift and failing applies once the reconcile finishes
+	outcome := newReconcileOutcome(statusWrapper)
+	defer r.Options.notifyOutcome(ctx, r.Scheme, obj, statusWrapper, outcome)
+	defer r.Options.recordApplySummary(r.Scheme, obj, outcome)
 
-	// Resolve authentication secrets
-	if err := r.Helper.ResolveAuthenticationSecrets(ctx, namespace, config.GetAuthenticationSpec(), resolvedSecrets); err != nil {
+	// Resolve all secrets referenced by the spec
+	resolvedSecrets, err := r.Helper.ResolveConfigSecrets(ctx, config)
+	if err != nil {
 		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, "SecretResolutionFailed", err.Error())
 		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
@@ -183,10 +227,7 @@ func (r *GenericArrReconciler) reconcileNormal(ctx context.Context, config ArrCo
 	}
 
 	// Create connection IR
-	connIR := &irv1.ConnectionIR{
-		URL:    connSpec.URL,
-		APIKey: resolvedSecrets["apiKey"],
-	}
+	connIR := connectionIR(obj, connSpec, resolvedSecrets)
 
 	// Get adapter and capabilities
 	adapter, ok := adapters.Get(appType)
@@ -200,7 +241,21 @@ func (r *GenericArrReconciler) reconcileNormal(ctx context.Context, config ArrCo
 
 	caps, err := adapter.Discover(ctx, connIR)
 	if err != nil {
-		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, "DiscoveryFailed", err.Error())
+		if requeueAfter, ok := r.Helper.HandleUnavailable(ctx, obj, connSpec, appType, statusWrapper, generation, err); ok {
+			r.updateStatusIfChanged(ctx, config, original)
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, errorReason(err, "DiscoveryFailed"), err.Error())
+		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return errorRequeue(err)
+	}
+
+	// Limit reconciliation to the subsystems listed in the manage annotation
+	scope, err := ParseManageScope(obj)
+	if err != nil {
+		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, "InvalidManageAnnotation", err.Error())
 		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
 		}
@@ -211,30 +266,130 @@ func (r *GenericArrReconciler) reconcileNormal(ctx context.Context, config ArrCo
 	desiredIR, err := r.CompileConfig(ctx, r.Compiler, config, resolvedSecrets, caps)
 	if err != nil {
 		log.Error(err, fmt.Sprintf("Failed to compile %sConfig to IR", appType))
-		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, "CompilationFailed", err.Error())
+		r.Helper.SetCompileFailure(statusWrapper, generation, err)
 		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
 		}
 		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 	}
+	statusWrapper.SetInvalidFields(nil)
 
-	// Reconcile using helper
-	_, err = r.Helper.ReconcileConfig(ctx, appType, connIR, desiredIR, statusWrapper, generation)
+	// Compare the IR with the stored snapshot to catch compiler changes across upgrades
+	if IRSnapshotsEnabled(r.Options, obj) {
+		if err := r.Helper.CheckIRSnapshot(ctx, obj, desiredIR, statusWrapper, generation); err != nil {
+			log.Error(err, "Failed to check IR snapshot (non-fatal)")
+		}
+	} else {
+		r.Helper.clearIRSnapshotCondition(statusWrapper)
+	}
+
+	// Evaluate the apply window (changes are held back while it is closed)
+	window, err := EvaluateApplyWindow(config.GetReconciliationSpec(), time.Now())
 	if err != nil {
+		r.Helper.SetCondition(statusWrapper, generation, ConditionTypeReady, metav1.ConditionFalse, "InvalidApplyWindow", err.Error())
 		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
 		}
 		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 	}
 
-	// Apply direct configuration (import lists, media management, authentication)
-	_, err = r.Helper.ApplyDirectConfig(ctx, appType, connIR, desiredIR, statusWrapper, generation)
+	// Observed configs report drift but never apply it (spec.observe)
+	if config.GetObserve() {
+		window = ApplyWindowState{Observe: true}
+	}
+
+	// Hold back spec changes a RolloutPolicy has not released yet
+	if window.Open {
+		if policy := r.Helper.RolloutHold(ctx, obj); policy != "" {
+			window = ApplyWindowState{HeldBy: policy}
+		}
+	}
+
+	// Hold back download clients whose DownloadStackConfig is not Ready yet
+	var holds DependencyHolds
+	if scope.Manages(SubsystemDownloadClients) {
+		holds = r.Helper.CheckDownloadClientDependencies(ctx, namespace, obj.GetName(), config.GetDownloadClients(), statusWrapper, generation)
+	}
+
+	// Keep indexers that failed their test turned off (spec.indexers.verifyOnApply)
+	if indexers := config.GetIndexerStatusPtr(); indexers != nil {
+		holdFailedIndexers(desiredIR, obj.GetName(), *indexers, generation)
+	}
+
+	// Skip root folders the app can't see (spec.reconciliation.preflightPaths)
+	if scope.Manages(SubsystemRootFolders) {
+		r.Helper.PreflightPaths(ctx, appType, connIR, config.GetReconciliationSpec(), desiredIR, statusWrapper, generation)
+	}
+
+	// Paused entries are left out of the diff; list them so they aren't forgotten
+	*config.GetPausedResourcesPtr() = arrPausedResources(config.GetDownloadClients(), config.GetIndexersSpec())
+
+	// Reconcile using helper
+	result, err := r.Helper.ReconcileConfig(ctx, appType, connIR, desiredIR, statusWrapper, generation, window, scope, holds)
+	outcome.recordSync(result, err, window)
 	if err != nil {
-		log.Error(err, "Failed to apply direct configuration (non-fatal)")
+		if requeueAfter, ok := r.Helper.HandleUnavailable(ctx, obj, connSpec, appType, statusWrapper, generation, err); ok {
+			r.updateStatusIfChanged(ctx, config, original)
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+		if statusErr := r.updateStatus(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return errorRequeue(err)
+	}
+
+	// Keep the state of a clean sync as the last known good one
+	if window.Open {
+		if err := r.Helper.RecordLastKnownGood(ctx, obj, appType, connIR, result); err != nil {
+			log.Error(err, "Failed to record last known good state (non-fatal)")
+		}
 	}
 
-	// Handle Prowlarr auto-registration if enabled for this type
-	if config.ShouldRegisterWithProwlarr() {
+	// Test direct indexers and disable the ones that fail (spec.indexers.verifyOnApply)
+	if indexers := config.GetIndexerStatusPtr(); indexers != nil && window.Open && scope.Manages(SubsystemIndexers) {
+		*indexers = r.Helper.VerifyIndexers(ctx, appType, connIR, obj, config.GetIndexersSpec(), *indexers, r.Recorder)
+	}
+
+	// Apply direct configuration (import lists, media management, authentication)
+	// and raw requests for settings the operator doesn't model
+	if window.Open {
+		_, err = r.Helper.ApplyDirectConfig(ctx, appType, connIR, desiredIR, statusWrapper, generation)
+		if err != nil {
+			log.Error(err, "Failed to apply direct configuration (non-fatal)")
+		}
+
+		rawStatus := config.GetRawRequestStatusPtr()
+		*rawStatus = r.Helper.ApplyRawRequests(ctx, appType, connIR, config.GetRawRequests(), *rawStatus, statusWrapper, generation)
+
+		// Tell dependent workloads the configuration is in place (spec.reconciliation.readinessConfigMap)
+		if err == nil && result.Success() && !holds.Pending() {
+			if err := r.Helper.PublishReadiness(ctx, obj, config.GetReconciliationSpec()); err != nil {
+				log.Error(err, "Failed to publish readiness (non-fatal)")
+			}
+		}
+	}
+
+	// Verify media server hooks through the app. The tests send messages, so
+	// observed configs skip them.
+	if mediaServers := config.GetMediaServerStatusPtr(); mediaServers != nil && !config.GetObserve() && scope.Manages(SubsystemNotifications) {
+		*mediaServers = r.Helper.VerifyMediaServerHooks(ctx, appType, connIR, obj.GetName(), config.GetMediaServerHooks())
+	}
+
+	// Test notifications through the app so broken webhooks surface in status.
+	// Outside the apply window the app may still hold the previous settings.
+	if notifications := config.GetNotificationStatusPtr(); notifications != nil && window.Open && scope.Manages(SubsystemNotifications) {
+		*notifications = r.Helper.VerifyNotifications(ctx, appType, connIR, obj.GetName(), config.GetNotifications(), *notifications, statusWrapper, generation)
+	}
+
+	// Prune the blocklist (spec.maintenance); it writes to the app, so it waits
+	// for the apply window
+	if maintenance := config.GetMaintenanceStatusPtr(); maintenance != nil && window.Open {
+		*maintenance = r.Helper.RunMaintenance(ctx, appType, connIR, obj, config.GetMaintenanceSpec(), *maintenance, r.Recorder, time.Now())
+	}
+
+	// Handle Prowlarr auto-registration if enabled for this type. Prowlarr pushes
+	// indexers to registered apps, so observed configs are not registered.
+	if config.ShouldRegisterWithProwlarr() && !config.GetObserve() && scope.Manages(SubsystemIndexers) {
 		if indexersSpec := config.GetIndexersSpec(); indexersSpec != nil && indexersSpec.ProwlarrRef != nil {
 			reg := ProwlarrAutoRegistration{
 				ProwlarrRef: indexersSpec.ProwlarrRef,
@@ -268,10 +423,31 @@ func (r *GenericArrReconciler) reconcileNormal(ctx context.Context, config ArrCo
 	if spec := config.GetReconciliationSpec(); spec != nil && spec.Interval != nil {
 		requeueAfter = spec.Interval.Duration
 	}
+	requeueAfter = r.Options.requeueAfter(requeueAfter)
+	requeueAfter = window.RequeueAfter(requeueAfter, time.Now())
+	requeueAfter = holds.RequeueAfter(requeueAfter)
 
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// arrPausedResources lists the download clients and direct indexers with pause set
+func arrPausedResources(clients []arrv1alpha1.DownloadClientSpec, indexers *arrv1alpha1.IndexersSpec) []string {
+	var paused []string
+	for _, dc := range clients {
+		if dc.Pause {
+			paused = append(paused, "downloadclient/"+dc.Name)
+		}
+	}
+	if indexers != nil {
+		for _, idx := range indexers.Direct {
+			if idx.Pause {
+				paused = append(paused, "indexer/"+idx.Name)
+			}
+		}
+	}
+	return paused
+}
+
 // reconcileDelete handles deletion
 func (r *GenericArrReconciler) reconcileDelete(ctx context.Context, config ArrConfigObject, finalizerName string) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
@@ -279,39 +455,54 @@ func (r *GenericArrReconciler) reconcileDelete(ctx context.Context, config ArrCo
 	appType := config.GetAppType()
 	log.Info(fmt.Sprintf("Handling deletion of %sConfig", appType), "name", obj.GetName())
 
-	namespace := obj.GetNamespace()
+	// A protected config only drops its finalizer; the service is left as it is
+	if arrv1alpha1.IsDeleteProtected(obj) {
+		warnDeleteProtected(ctx, r.Recorder, obj)
+	} else {
+		r.cleanupService(ctx, config)
+	}
+
+	// Remove finalizer
+	controllerutil.RemoveFinalizer(obj, finalizerName)
+	if err := r.Update(ctx, obj); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.Options.forgetNotifications(r.Scheme, obj)
+	r.Options.forgetApplySummary(r.Scheme, obj)
+	r.Helper.Clusters.Forget(obj)
+
+	log.Info(fmt.Sprintf("Successfully deleted %sConfig", appType), "name", obj.GetName())
+	return ctrl.Result{}, nil
+}
+
+// cleanupService unregisters a deleted config from Prowlarr and removes its managed
+// resources from the service. Failures are logged; deletion proceeds regardless.
+func (r *GenericArrReconciler) cleanupService(ctx context.Context, config ArrConfigObject) {
+	log := logf.FromContext(ctx)
+	obj := config.GetObject()
+	appType := config.GetAppType()
 
 	// Unregister from Prowlarr if prowlarrRef was set
 	if indexersSpec := config.GetIndexersSpec(); indexersSpec != nil && indexersSpec.ProwlarrRef != nil {
 		appName := fmt.Sprintf("nebularr-%s-%s", appType, obj.GetName())
-		if err := r.Helper.HandleProwlarrUnregistration(ctx, namespace, indexersSpec.ProwlarrRef.Name, appName); err != nil {
+		if err := r.Helper.HandleProwlarrUnregistration(ctx, obj.GetNamespace(), indexersSpec.ProwlarrRef.Name, appName); err != nil {
 			log.Error(err, "Failed to unregister from Prowlarr (non-fatal)")
 		}
 	}
 
 	// Try to resolve secrets for cleanup
 	connSpec := config.GetConnectionSpec()
-	resolvedSecrets, err := r.Helper.ResolveConnectionSecrets(ctx, namespace, connSpec)
+	resolvedSecrets, err := r.Helper.ResolveConnectionSecrets(ctx, obj, connSpec)
 	if err != nil {
 		log.Error(err, "Failed to resolve secrets for cleanup, proceeding anyway")
-	} else {
-		connIR := &irv1.ConnectionIR{
-			URL:    connSpec.URL,
-			APIKey: resolvedSecrets["apiKey"],
-		}
-		if err := r.Helper.CleanupManagedResources(ctx, appType, connIR); err != nil {
-			log.Error(err, "Failed to cleanup managed resources")
-		}
+		return
 	}
-
-	// Remove finalizer
-	controllerutil.RemoveFinalizer(obj, finalizerName)
-	if err := r.Update(ctx, obj); err != nil {
-		return ctrl.Result{}, err
+	connIR := connectionIR(obj, connSpec, resolvedSecrets)
+	if scope, err := ParseManageScope(obj); err != nil {
+		log.Error(err, "Invalid manage annotation, skipping cleanup of managed resources")
+	} else if err := r.Helper.CleanupManagedResources(ctx, appType, connIR, scope, compiler.DefaultQualityProfileName(obj.GetName())); err != nil {
+		log.Error(err, "Failed to cleanup managed resources")
 	}
-
-	log.Info(fmt.Sprintf("Successfully deleted %sConfig", appType), "name", obj.GetName())
-	return ctrl.Result{}, nil
 }
 
 // updateStatus updates the status subresource of the config
@@ -319,6 +510,17 @@ func (r *GenericArrReconciler) updateStatus(ctx context.Context, config ArrConfi
 	return r.Status().Update(ctx, config.GetObject())
 }
 
+// updateStatusIfChanged updates the status unless the reconcile left the object as it was,
+// so retries against an unavailable service don't write the same status again
+func (r *GenericArrReconciler) updateStatusIfChanged(ctx context.Context, config ArrConfigObject, original runtime.Object) {
+	if equality.Semantic.DeepEqual(original, config.GetObject()) {
+		return
+	}
+	if err := r.updateStatus(ctx, config); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to update status")
+	}
+}
+
 // ConfigFetcher provides type-specific fetch and wrap functionality.
 // This allows the generic reconciler to work with the concrete CRD types
 // while keeping the reconciliation logic generic.
@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters/downloadstack"
+)
+
+// ConditionTypeGluetunServersValid reports whether the Gluetun server selection
+// matches at least one server in Gluetun's published server list
+const ConditionTypeGluetunServersValid = "GluetunServersValid"
+
+// checkGluetunServers validates the server selection against Gluetun's server
+// list. A selection matching no server makes Gluetun exit on start, so this
+// surfaces typos as a condition instead of a crash-looping VPN container. It
+// only warns: the list may lag behind the provider, so nothing is held back.
+// The condition is removed when no servers are selected or the check is disabled.
+func (r *DownloadStackConfigReconciler) checkGluetunServers(ctx context.Context, config *arrv1alpha1.DownloadStackConfig, statusWrapper *DownloadStackStatusWrapper) {
+	spec := &config.Spec.Gluetun
+	if r.GluetunServers == nil || spec.Server == nil ||
+		len(spec.Server.Regions)+len(spec.Server.Countries)+len(spec.Server.Cities)+len(spec.Server.Hostnames) == 0 {
+		removeCondition(statusWrapper, ConditionTypeGluetunServersValid)
+		return
+	}
+
+	catalog, err := r.GluetunServers.Get(ctx)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to load Gluetun server list")
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeGluetunServersValid, metav1.ConditionUnknown, "ServerListUnavailable", err.Error())
+		return
+	}
+
+	check := downloadstack.CheckGluetunServers(catalog, spec)
+	switch {
+	case !check.Known:
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeGluetunServersValid, metav1.ConditionUnknown, "UnknownProvider",
+			fmt.Sprintf("Provider %q is not in Gluetun's server list", spec.Provider.Name))
+	case len(check.Problems) > 0:
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeGluetunServersValid, metav1.ConditionFalse, "UnknownServerSelection",
+			strings.Join(check.Problems, "; "))
+	case check.Matches == 0:
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeGluetunServersValid, metav1.ConditionFalse, "NoMatchingServers",
+			fmt.Sprintf("No %s server matches all of the selected regions, countries, cities and hostnames", spec.Provider.Name))
+	default:
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeGluetunServersValid, metav1.ConditionTrue, "ServersMatched",
+			fmt.Sprintf("%d servers match the selection", check.Matches))
+	}
+}
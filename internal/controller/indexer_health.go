@@ -0,0 +1,248 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// Defaults for spec.indexerHealth
+const (
+	DefaultIndexerFailureThreshold = 50
+	DefaultIndexerMinRequests      = 10
+	DefaultIndexerHealthWindow     = 24 * time.Hour
+	DefaultIndexerCooldown         = 6 * time.Hour
+)
+
+// indexerStatsFunc returns indexer request counts since a point in time, keyed by compiled name
+type indexerStatsFunc func(since time.Time) (map[string]adapters.IndexerStats, error)
+
+// CheckIndexerHealth reads Prowlarr's indexer statistics and returns the new
+// indexer health status. Indexers whose failure rate reaches the threshold are
+// reported and, with autoDisable, disabled until their cooldown ends. When the
+// statistics cannot be read, the previous status is kept so disabled indexers
+// stay disabled.
+func (h *ReconcileHelper) CheckIndexerHealth(
+	ctx context.Context,
+	connIR *irv1.ConnectionIR,
+	obj client.Object,
+	spec *arrv1alpha1.ProwlarrConfigSpec,
+	previous []arrv1alpha1.IndexerHealthStatus,
+	recorder record.EventRecorder,
+) []arrv1alpha1.IndexerHealthStatus {
+	if spec.IndexerHealth == nil {
+		return nil
+	}
+
+	log := logf.FromContext(ctx)
+
+	adapter, ok := adapters.Get(adapters.AppProwlarr)
+	if !ok {
+		return previous
+	}
+	reader, ok := adapter.(adapters.IndexerStatsReader)
+	if !ok {
+		log.V(1).Info("Adapter does not support IndexerStatsReader", "app", adapters.AppProwlarr)
+		return previous
+	}
+
+	// Indexers re-enabled at different times need their own lookback
+	fetched := make(map[time.Time]map[string]adapters.IndexerStats)
+	stats := func(since time.Time) (map[string]adapters.IndexerStats, error) {
+		if s, ok := fetched[since]; ok {
+			return s, nil
+		}
+		s, err := reader.IndexerStats(ctx, connIR, since)
+		if err != nil {
+			return nil, err
+		}
+		fetched[since] = s
+		return s, nil
+	}
+
+	results, events, err := evaluateIndexerHealth(spec.IndexerHealth, spec.Indexers, previous, obj.GetName(), time.Now(), stats)
+	if err != nil {
+		log.Error(err, "Failed to read indexer statistics")
+		return previous
+	}
+
+	if recorder != nil {
+		for _, e := range events {
+			recorder.Event(obj, e.eventType, e.reason, e.message)
+		}
+	}
+	return results
+}
+
+// indexerHealthEvent is a Kubernetes event raised while evaluating indexer health
+type indexerHealthEvent struct {
+	eventType string
+	reason    string
+	message   string
+}
+
+// evaluateIndexerHealth decides the health status of each enabled indexer in the spec.
+// Only requests since an indexer was last re-enabled count towards its failure rate,
+// so an indexer isn't disabled again for failures that led to its previous cooldown.
+func evaluateIndexerHealth(
+	spec *arrv1alpha1.IndexerHealthSpec,
+	indexers []arrv1alpha1.ProwlarrIndexer,
+	previous []arrv1alpha1.IndexerHealthStatus,
+	configName string,
+	now time.Time,
+	stats indexerStatsFunc,
+) ([]arrv1alpha1.IndexerHealthStatus, []indexerHealthEvent, error) {
+	threshold := spec.FailureThreshold
+	if threshold <= 0 {
+		threshold = DefaultIndexerFailureThreshold
+	}
+	minRequests := spec.MinRequests
+	if minRequests <= 0 {
+		minRequests = DefaultIndexerMinRequests
+	}
+	window := DefaultIndexerHealthWindow
+	if spec.Window != nil && spec.Window.Duration > 0 {
+		window = spec.Window.Duration
+	}
+	cooldown := DefaultIndexerCooldown
+	if spec.Cooldown != nil && spec.Cooldown.Duration > 0 {
+		cooldown = spec.Cooldown.Duration
+	}
+
+	last := make(map[string]arrv1alpha1.IndexerHealthStatus, len(previous))
+	for _, p := range previous {
+		last[p.Name] = p
+	}
+
+	windowStart := now.Add(-window).Truncate(time.Minute)
+	metaNow := metav1.NewTime(now)
+	var results []arrv1alpha1.IndexerHealthStatus
+	var events []indexerHealthEvent
+	for _, idx := range indexers {
+		if idx.Enabled != nil && !*idx.Enabled {
+			continue
+		}
+
+		result, known := last[idx.Name]
+		if result.Disabled {
+			if spec.AutoDisable && result.ReenableAt != nil && now.Before(result.ReenableAt.Time) {
+				results = append(results, result)
+				continue
+			}
+			result.Disabled = false
+			result.DisabledAt = nil
+			result.ReenableAt = nil
+			result.ReenabledAt = &metaNow
+			events = append(events, indexerHealthEvent{corev1.EventTypeNormal, "IndexerReenabled",
+				fmt.Sprintf("Indexer %s re-enabled after its cooldown", idx.Name)})
+			results = append(results, result)
+			continue
+		}
+
+		since := windowStart
+		if known && result.ReenabledAt != nil && result.ReenabledAt.After(since) {
+			since = result.ReenabledAt.Time
+		}
+		s, err := stats(since)
+		if err != nil {
+			return nil, nil, err
+		}
+		counts := s[compiler.IndexerName(configName, idx.Name)]
+
+		if counts.Requests < minRequests || counts.Failures*100 < threshold*counts.Requests {
+			// Healthy: keep recently re-enabled indexers listed until the window passes them
+			if known && result.ReenabledAt != nil && result.ReenabledAt.After(windowStart) {
+				result.FailureRate, result.Requests = failureRate(counts), counts.Requests
+				results = append(results, result)
+			}
+			continue
+		}
+
+		result = arrv1alpha1.IndexerHealthStatus{
+			Name:        idx.Name,
+			FailureRate: failureRate(counts),
+			Requests:    counts.Requests,
+			ReenabledAt: result.ReenabledAt,
+		}
+		if spec.AutoDisable {
+			reenableAt := metav1.NewTime(now.Add(cooldown))
+			result.Disabled = true
+			result.DisabledAt = &metaNow
+			result.ReenableAt = &reenableAt
+			events = append(events, indexerHealthEvent{corev1.EventTypeWarning, "IndexerDisabled",
+				fmt.Sprintf("Indexer %s disabled until %s: %d%% of %d requests failed",
+					idx.Name, reenableAt.Format(time.RFC3339), result.FailureRate, result.Requests)})
+		}
+		results = append(results, result)
+	}
+
+	return results, events, nil
+}
+
+// failureRate returns the share of failed requests in percent
+func failureRate(counts adapters.IndexerStats) int {
+	if counts.Requests == 0 {
+		return 0
+	}
+	return counts.Failures * 100 / counts.Requests
+}
+
+// holdDisabledIndexers keeps the indexers the operator disabled turned off in
+// the desired state, so the sync doesn't re-enable them before their cooldown ends
+func holdDisabledIndexers(ir *irv1.IR, configName string, health []arrv1alpha1.IndexerHealthStatus) {
+	if ir == nil || ir.Prowlarr == nil {
+		return
+	}
+	disabled := make(map[string]bool, len(health))
+	for _, h := range health {
+		if h.Disabled {
+			disabled[compiler.IndexerName(configName, h.Name)] = true
+		}
+	}
+	for i := range ir.Prowlarr.Indexers {
+		if disabled[ir.Prowlarr.Indexers[i].Name] {
+			ir.Prowlarr.Indexers[i].Enable = false
+		}
+	}
+}
+
+// indexerHealthRequeueAfter shortens interval so a disabled indexer is
+// re-enabled soon after its cooldown ends
+func indexerHealthRequeueAfter(interval time.Duration, health []arrv1alpha1.IndexerHealthStatus, now time.Time) time.Duration {
+	for _, h := range health {
+		if !h.Disabled || h.ReenableAt == nil {
+			continue
+		}
+		if until := h.ReenableAt.Sub(now); until < interval {
+			interval = max(until, time.Second)
+		}
+	}
+	return interval
+}
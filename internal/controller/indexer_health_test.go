@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+var _ = Describe("Indexer health", func() {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	indexers := []arrv1alpha1.ProwlarrIndexer{{Name: "nyaa"}, {Name: "1337x"}}
+	spec := &arrv1alpha1.IndexerHealthSpec{AutoDisable: true, FailureThreshold: 50, MinRequests: 10}
+
+	statsSince := func(seen *[]time.Time, stats map[string]adapters.IndexerStats) indexerStatsFunc {
+		return func(since time.Time) (map[string]adapters.IndexerStats, error) {
+			*seen = append(*seen, since)
+			return stats, nil
+		}
+	}
+
+	It("disables indexers whose failure rate reaches the threshold", func() {
+		var seen []time.Time
+		stats := statsSince(&seen, map[string]adapters.IndexerStats{
+			"nebularr-p-nyaa":  {Requests: 20, Failures: 12},
+			"nebularr-p-1337x": {Requests: 4, Failures: 4},
+		})
+
+		results, events, err := evaluateIndexerHealth(spec, indexers, nil, "p", now, stats)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Name).To(Equal("nyaa"))
+		Expect(results[0].Disabled).To(BeTrue())
+		Expect(results[0].FailureRate).To(Equal(60))
+		Expect(results[0].ReenableAt.Time).To(Equal(now.Add(DefaultIndexerCooldown)))
+		Expect(events).To(HaveLen(1))
+
+		desired := &irv1.IR{Prowlarr: &irv1.ProwlarrIR{Indexers: []irv1.ProwlarrIndexerIR{
+			{Name: "nebularr-p-nyaa", Enable: true}, {Name: "nebularr-p-1337x", Enable: true},
+		}}}
+		holdDisabledIndexers(desired, "p", results)
+		Expect(desired.Prowlarr.Indexers[0].Enable).To(BeFalse())
+		Expect(desired.Prowlarr.Indexers[1].Enable).To(BeTrue())
+	})
+
+	It("only reports failing indexers without autoDisable", func() {
+		var seen []time.Time
+		stats := statsSince(&seen, map[string]adapters.IndexerStats{"nebularr-p-nyaa": {Requests: 20, Failures: 20}})
+
+		reportOnly := &arrv1alpha1.IndexerHealthSpec{FailureThreshold: 50, MinRequests: 10}
+		results, events, err := evaluateIndexerHealth(reportOnly, indexers, nil, "p", now, stats)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Disabled).To(BeFalse())
+		Expect(results[0].FailureRate).To(Equal(100))
+		Expect(events).To(BeEmpty())
+	})
+
+	It("re-enables after the cooldown and only counts later requests", func() {
+		disabledAt := metav1.NewTime(now.Add(-7 * time.Hour))
+		reenableAt := metav1.NewTime(now.Add(-time.Hour))
+		previous := []arrv1alpha1.IndexerHealthStatus{{
+			Name: "nyaa", Disabled: true, FailureRate: 80, Requests: 30, DisabledAt: &disabledAt, ReenableAt: &reenableAt,
+		}}
+		var seen []time.Time
+		stats := statsSince(&seen, map[string]adapters.IndexerStats{"nebularr-p-nyaa": {Requests: 30, Failures: 24}})
+
+		results, events, err := evaluateIndexerHealth(spec, indexers, previous, "p", now, stats)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Disabled).To(BeFalse())
+		Expect(results[0].ReenabledAt.Time).To(Equal(now))
+		Expect(events[0].reason).To(Equal("IndexerReenabled"))
+
+		later := now.Add(30 * time.Minute)
+		seen = nil
+		stats = statsSince(&seen, map[string]adapters.IndexerStats{"nebularr-p-nyaa": {Requests: 2}})
+		results, _, err = evaluateIndexerHealth(spec, indexers, results, "p", later, stats)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(seen).To(ContainElement(now))
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Disabled).To(BeFalse())
+	})
+
+	It("requeues when the next indexer is due to be re-enabled", func() {
+		reenableAt := metav1.NewTime(now.Add(10 * time.Minute))
+		health := []arrv1alpha1.IndexerHealthStatus{{Name: "nyaa", Disabled: true, ReenableAt: &reenableAt}}
+		Expect(indexerHealthRequeueAfter(time.Hour, health, now)).To(Equal(10 * time.Minute))
+		Expect(indexerHealthRequeueAfter(5*time.Minute, health, now)).To(Equal(5 * time.Minute))
+	})
+})
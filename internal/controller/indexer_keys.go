@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// indexerKeyTestInterval is how long an accepted current key goes untested,
+// so trackers aren't queried on every sync
+const indexerKeyTestInterval = 6 * time.Hour
+
+// indexerKeyTestFunc tests an indexer with the given API key
+type indexerKeyTestFunc func(idx irv1.ProwlarrIndexerIR, apiKey string) error
+
+// SelectIndexerKeys tests the indexers that have a next API key and returns
+// which key each one should use. An indexer keeps its current key until the
+// test rejects it, then switches to the next key if that one is accepted.
+// When the adapter can't test keys, the previous status is kept.
+func (h *ReconcileHelper) SelectIndexerKeys(
+	ctx context.Context,
+	connIR *irv1.ConnectionIR,
+	obj client.Object,
+	desired *irv1.IR,
+	previous []arrv1alpha1.IndexerKeyStatus,
+	salt string,
+	recorder record.EventRecorder,
+) []arrv1alpha1.IndexerKeyStatus {
+	log := logf.FromContext(ctx)
+
+	adapter, ok := adapters.Get(adapters.AppProwlarr)
+	if !ok {
+		return previous
+	}
+	tester, ok := adapter.(adapters.IndexerKeyTester)
+	if !ok {
+		log.V(1).Info("Adapter does not support IndexerKeyTester", "app", adapters.AppProwlarr)
+		return previous
+	}
+	test := func(idx irv1.ProwlarrIndexerIR, apiKey string) error {
+		return tester.TestIndexerKey(ctx, connIR, idx, apiKey)
+	}
+
+	results, events := evaluateIndexerKeys(desired, previous, salt, time.Now(), test)
+	if recorder != nil {
+		for _, e := range events {
+			recorder.Event(obj, e.eventType, e.reason, e.message)
+		}
+	}
+	return results
+}
+
+// evaluateIndexerKeys decides the active key of each indexer in desired that has a next key.
+// Status names are the indexer names in Prowlarr. An indexer already on its next key stays
+// on it without a test until that key changes. The current key is tested again when it
+// changed, when its last test failed or once indexerKeyTestInterval has passed.
+func evaluateIndexerKeys(
+	desired *irv1.IR,
+	previous []arrv1alpha1.IndexerKeyStatus,
+	salt string,
+	now time.Time,
+	test indexerKeyTestFunc,
+) ([]arrv1alpha1.IndexerKeyStatus, []indexerHealthEvent) {
+	if desired == nil || desired.Prowlarr == nil {
+		return nil, nil
+	}
+
+	last := make(map[string]arrv1alpha1.IndexerKeyStatus, len(previous))
+	for _, p := range previous {
+		last[p.Name] = p
+	}
+
+	var results []arrv1alpha1.IndexerKeyStatus
+	var events []indexerHealthEvent
+	for _, idx := range desired.Prowlarr.Indexers {
+		if idx.NextAPIKey == "" || idx.Paused {
+			continue
+		}
+		currentHash := compiler.SecretHash(salt, idx.APIKey)
+		nextHash := compiler.SecretHash(salt, idx.NextAPIKey)
+
+		prev, known := last[idx.Name]
+		if known && prev.Active == arrv1alpha1.IndexerKeyNext && !compiler.IsKeyedSecretHash(prev.KeyHash) {
+			// Recorded before hashes were keyed: keep the switch rather than test again
+			prev.KeyHash = nextHash
+		}
+		if known && prev.Active == arrv1alpha1.IndexerKeyNext && prev.KeyHash == nextHash {
+			prev.Message = ""
+			results = append(results, prev)
+			continue
+		}
+		if known && prev.Active == arrv1alpha1.IndexerKeyCurrent && prev.KeyHash == currentHash &&
+			prev.Message == "" && prev.TestedAt != nil && now.Sub(prev.TestedAt.Time) < indexerKeyTestInterval {
+			results = append(results, prev)
+			continue
+		}
+
+		testedAt := metav1.NewTime(now)
+		result := arrv1alpha1.IndexerKeyStatus{
+			Name:     idx.Name,
+			Active:   arrv1alpha1.IndexerKeyCurrent,
+			KeyHash:  currentHash,
+			TestedAt: &testedAt,
+		}
+		err := test(idx, idx.APIKey)
+		switch {
+		case err == nil:
+		case !errors.Is(err, adapters.ErrUnauthorized):
+			// Not a key problem: keep the current key and test again on the next sync
+			result.Message = err.Error()
+		default:
+			if nextErr := test(idx, idx.NextAPIKey); nextErr != nil {
+				result.Message = fmt.Sprintf("current key rejected, next key failed: %v", nextErr)
+				events = append(events, indexerHealthEvent{corev1.EventTypeWarning, "IndexerKeyRejected",
+					fmt.Sprintf("Indexer %s rejected its current key and the next key failed its test", idx.Name)})
+				break
+			}
+			switchedAt := metav1.NewTime(now)
+			result.Active = arrv1alpha1.IndexerKeyNext
+			result.KeyHash = nextHash
+			result.SwitchedAt = &switchedAt
+			events = append(events, indexerHealthEvent{corev1.EventTypeNormal, "IndexerKeyRotated",
+				fmt.Sprintf("Indexer %s rejected its current key and now uses the next key", idx.Name)})
+		}
+		results = append(results, result)
+	}
+
+	return results, events
+}
+
+// useIndexerKeys puts the next key in place of the current one for indexers that
+// switched to it, so the change is applied like any other key rotation
+func useIndexerKeys(desired *irv1.IR, keys []arrv1alpha1.IndexerKeyStatus, salt string) {
+	if desired == nil || desired.Prowlarr == nil {
+		return
+	}
+	next := make(map[string]string, len(keys))
+	for _, k := range keys {
+		if k.Active == arrv1alpha1.IndexerKeyNext {
+			next[k.Name] = k.KeyHash
+		}
+	}
+	for i := range desired.Prowlarr.Indexers {
+		idx := &desired.Prowlarr.Indexers[i]
+		if hash, ok := next[idx.Name]; ok && idx.NextAPIKey != "" && hash == compiler.SecretHash(salt, idx.NextAPIKey) {
+			idx.APIKey = idx.NextAPIKey
+			idx.SecretHash = hash
+		}
+	}
+}
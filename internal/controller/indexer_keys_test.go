@@ -0,0 +1,151 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+var _ = Describe("Indexer key rotation", func() {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	salt := "uid"
+
+	desiredIR := func() *irv1.IR {
+		return &irv1.IR{Prowlarr: &irv1.ProwlarrIR{Indexers: []irv1.ProwlarrIndexerIR{
+			{Name: "nebularr-p-tracker", APIKey: "old", NextAPIKey: "new", SecretHash: compiler.SecretHash(salt, "old")},
+			{Name: "nebularr-p-nyaa"},
+		}}}
+	}
+	accepting := func(keys ...string) (indexerKeyTestFunc, *[]string) {
+		var tested []string
+		return func(_ irv1.ProwlarrIndexerIR, apiKey string) error {
+			tested = append(tested, apiKey)
+			for _, k := range keys {
+				if k == apiKey {
+					return nil
+				}
+			}
+			return fmt.Errorf("rejected: %w", adapters.ErrUnauthorized)
+		}, &tested
+	}
+
+	It("keeps the current key while it is accepted", func() {
+		test, tested := accepting("old", "new")
+		results, events := evaluateIndexerKeys(desiredIR(), nil, salt, now, test)
+		Expect(*tested).To(Equal([]string{"old"}))
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Name).To(Equal("nebularr-p-tracker"))
+		Expect(results[0].Active).To(Equal(arrv1alpha1.IndexerKeyCurrent))
+		Expect(events).To(BeEmpty())
+	})
+
+	It("switches to the next key when the current one is rejected", func() {
+		test, tested := accepting("new")
+		results, events := evaluateIndexerKeys(desiredIR(), nil, salt, now, test)
+		Expect(*tested).To(Equal([]string{"old", "new"}))
+		Expect(results[0].Active).To(Equal(arrv1alpha1.IndexerKeyNext))
+		Expect(results[0].SwitchedAt.Time).To(Equal(now))
+		Expect(events[0].reason).To(Equal("IndexerKeyRotated"))
+
+		desired := desiredIR()
+		useIndexerKeys(desired, results, salt)
+		Expect(desired.Prowlarr.Indexers[0].APIKey).To(Equal("new"))
+		Expect(desired.Prowlarr.Indexers[0].SecretHash).To(Equal(compiler.SecretHash(salt, "new")))
+
+		// Stays on the next key without testing again
+		test, tested = accepting()
+		results, _ = evaluateIndexerKeys(desiredIR(), results, salt, now.Add(time.Hour), test)
+		Expect(*tested).To(BeEmpty())
+		Expect(results[0].Active).To(Equal(arrv1alpha1.IndexerKeyNext))
+		Expect(results[0].SwitchedAt.Time).To(Equal(now))
+	})
+
+	It("doesn't test the keys of a paused indexer", func() {
+		desired := desiredIR()
+		desired.Prowlarr.Indexers[0].Paused = true
+		test, tested := accepting("new")
+		results, _ := evaluateIndexerKeys(desired, nil, salt, now, test)
+		Expect(*tested).To(BeEmpty())
+		Expect(results).To(BeEmpty())
+	})
+
+	It("keeps the current key when both keys fail", func() {
+		test, _ := accepting()
+		results, events := evaluateIndexerKeys(desiredIR(), nil, salt, now, test)
+		Expect(results[0].Active).To(Equal(arrv1alpha1.IndexerKeyCurrent))
+		Expect(results[0].Message).NotTo(BeEmpty())
+		Expect(events[0].reason).To(Equal("IndexerKeyRejected"))
+	})
+
+	It("does not switch on failures unrelated to the key", func() {
+		var tested []string
+		test := func(_ irv1.ProwlarrIndexerIR, apiKey string) error {
+			tested = append(tested, apiKey)
+			return errors.New("connection refused")
+		}
+		results, events := evaluateIndexerKeys(desiredIR(), nil, salt, now, test)
+		Expect(tested).To(Equal([]string{"old"}))
+		Expect(results[0].Active).To(Equal(arrv1alpha1.IndexerKeyCurrent))
+		Expect(results[0].Message).To(ContainSubstring("connection refused"))
+		Expect(events).To(BeEmpty())
+	})
+
+	It("tests an accepted current key again only after a change or the test interval", func() {
+		test, tested := accepting("old", "new")
+		results, _ := evaluateIndexerKeys(desiredIR(), nil, salt, now, test)
+		Expect(*tested).To(Equal([]string{"old"}))
+		Expect(results[0].TestedAt.Time).To(Equal(now))
+
+		*tested = nil
+		results, _ = evaluateIndexerKeys(desiredIR(), results, salt, now.Add(time.Hour), test)
+		Expect(*tested).To(BeEmpty())
+		Expect(results[0].TestedAt.Time).To(Equal(now))
+
+		rotated := desiredIR()
+		rotated.Prowlarr.Indexers[0].APIKey = "new"
+		rotated.Prowlarr.Indexers[0].NextAPIKey = "newer"
+		_, _ = evaluateIndexerKeys(rotated, results, salt, now.Add(time.Hour), test)
+		Expect(*tested).To(Equal([]string{"new"}))
+
+		*tested = nil
+		_, _ = evaluateIndexerKeys(desiredIR(), results, salt, now.Add(indexerKeyTestInterval), test)
+		Expect(*tested).To(Equal([]string{"old"}))
+	})
+
+	It("keeps a switch recorded before key hashes were keyed", func() {
+		test, tested := accepting("new")
+		previous := []arrv1alpha1.IndexerKeyStatus{{
+			Name:    "nebularr-p-tracker",
+			Active:  arrv1alpha1.IndexerKeyNext,
+			KeyHash: "0123456789abcdef",
+		}}
+		results, _ := evaluateIndexerKeys(desiredIR(), previous, salt, now, test)
+		Expect(*tested).To(BeEmpty())
+		Expect(results[0].Active).To(Equal(arrv1alpha1.IndexerKeyNext))
+		Expect(results[0].KeyHash).To(Equal(compiler.SecretHash(salt, "new")))
+	})
+})
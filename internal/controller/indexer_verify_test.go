@@ -0,0 +1,129 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/mock"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// indexerTesterAdapter fails the test of every indexer in failing
+type indexerTesterAdapter struct {
+	*mock.Adapter
+	failing  map[string]bool
+	tested   []string
+	disabled []string
+}
+
+func (a *indexerTesterAdapter) TestIndexer(_ context.Context, _ *irv1.ConnectionIR, name string) error {
+	a.tested = append(a.tested, name)
+	if a.failing[name] {
+		return errors.New("401 unauthorized")
+	}
+	return nil
+}
+
+func (a *indexerTesterAdapter) DisableIndexer(_ context.Context, _ *irv1.ConnectionIR, name string) error {
+	a.disabled = append(a.disabled, name)
+	return nil
+}
+
+var _ = Describe("Indexer verification", func() {
+	const appType = "indexer-verify-test"
+
+	var (
+		tester   *indexerTesterAdapter
+		config   *arrv1alpha1.RadarrConfig
+		spec     *arrv1alpha1.IndexersSpec
+		recorder *record.FakeRecorder
+		helper   *ReconcileHelper
+	)
+
+	BeforeEach(func() {
+		tester = &indexerTesterAdapter{
+			Adapter: mock.NewAdapter(appType),
+			failing: map[string]bool{"nebularr-movies-dead": true},
+		}
+		adapters.RegisterOrReplace(tester)
+		DeferCleanup(func() { adapters.Unregister(appType) })
+
+		config = &arrv1alpha1.RadarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "movies", Generation: 1}}
+		spec = &arrv1alpha1.IndexersSpec{
+			VerifyOnApply: true,
+			Direct:        []arrv1alpha1.DirectIndexer{{Name: "dead"}, {Name: "live"}},
+		}
+		recorder = record.NewFakeRecorder(10)
+		helper = &ReconcileHelper{}
+	})
+
+	It("disables failing indexers and doesn't test them again within a generation", func() {
+		results := helper.VerifyIndexers(context.Background(), appType, &irv1.ConnectionIR{}, config, spec, nil, recorder)
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Disabled).To(BeTrue())
+		Expect(results[1].Verified).To(BeTrue())
+		Expect(tester.disabled).To(Equal([]string{"nebularr-movies-dead"}))
+		Expect(recorder.Events).To(HaveLen(1))
+
+		results = helper.VerifyIndexers(context.Background(), appType, &irv1.ConnectionIR{}, config, spec, results, recorder)
+		Expect(results[0].Disabled).To(BeTrue())
+		Expect(tester.tested).To(HaveLen(2))
+		Expect(recorder.Events).To(HaveLen(1))
+
+		By("testing again after the spec changes")
+		config.Generation = 2
+		helper.VerifyIndexers(context.Background(), appType, &irv1.ConnectionIR{}, config, spec, results, recorder)
+		Expect(tester.tested).To(HaveLen(4))
+	})
+
+	It("keeps disabled indexers turned off in the desired state until the generation changes", func() {
+		statuses := []arrv1alpha1.IndexerStatus{
+			{Name: "dead", Disabled: true, ObservedGeneration: 1},
+			{Name: "live", Verified: true, ObservedGeneration: 1},
+		}
+		desired := func() *irv1.IR {
+			return &irv1.IR{Indexers: &irv1.IndexersIR{Direct: []irv1.IndexerIR{
+				{Name: "nebularr-movies-dead", EnableRss: true, EnableAutomaticSearch: true, EnableInteractiveSearch: true},
+				{Name: "nebularr-movies-live", EnableRss: true, EnableAutomaticSearch: true, EnableInteractiveSearch: true},
+			}}}
+		}
+
+		ir := desired()
+		holdFailedIndexers(ir, "movies", statuses, 1)
+		dead, live := ir.Indexers.Direct[0], ir.Indexers.Direct[1]
+		Expect(dead.EnableRss || dead.EnableAutomaticSearch || dead.EnableInteractiveSearch).To(BeFalse())
+		Expect(live.EnableRss && live.EnableAutomaticSearch && live.EnableInteractiveSearch).To(BeTrue())
+
+		By("matching what the app reports once disabled, so the sync leaves it alone")
+		current := dead
+		Expect(adapters.IndexersEqual(current, ir.Indexers.Direct[0])).To(BeTrue())
+
+		By("re-enabling it once the spec changes")
+		ir = desired()
+		holdFailedIndexers(ir, "movies", statuses, 2)
+		Expect(ir.Indexers.Direct[0].EnableRss).To(BeTrue())
+	})
+})
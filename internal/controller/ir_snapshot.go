@@ -0,0 +1,205 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/poiley/nebularr-operator/internal/ir/conversion"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+	"github.com/poiley/nebularr-operator/internal/version"
+)
+
+// ConditionTypeIRSnapshot reports whether the compiled IR matches the stored snapshot
+const ConditionTypeIRSnapshot = "IRSnapshot"
+
+// IR snapshot annotations
+const (
+	// IRSnapshotAnnotation set to "true" on a config enables IR snapshots for it
+	IRSnapshotAnnotation = "arr.rinzler.cloud/ir-snapshot"
+
+	// irSnapshotVersionAnnotation records the operator version that wrote a snapshot
+	irSnapshotVersionAnnotation = "arr.rinzler.cloud/operator-version"
+
+	// irSnapshotSpecAnnotation records the hash of the spec a snapshot was compiled from
+	irSnapshotSpecAnnotation = "arr.rinzler.cloud/spec-hash"
+)
+
+// IRSnapshotSecretName returns the name of the Secret holding a config's IR snapshot.
+// A Secret is used because the IR carries download client and indexer credentials.
+func IRSnapshotSecretName(configName, app string) string {
+	return fmt.Sprintf("%s-%s-ir-snapshot", configName, app)
+}
+
+// IRSnapshotsEnabled reports whether IR snapshots are on for the config
+func IRSnapshotsEnabled(opts ControllerOptions, obj client.Object) bool {
+	return opts.IRSnapshots || obj.GetAnnotations()[IRSnapshotAnnotation] == "true"
+}
+
+// CheckIRSnapshot compares the compiled IR with the snapshot stored for owner.
+// When a different operator version compiles the unchanged spec into a different IR,
+// the IRSnapshot condition turns False and the old snapshot is kept until its Secret
+// is deleted. Otherwise the snapshot is refreshed: a changed spec, or changed inputs
+// outside the spec (defaults, templates, app capabilities), are expected to change the IR.
+func (h *ReconcileHelper) CheckIRSnapshot(ctx context.Context, owner client.Object, desired *irv1.IR, status ConfigStatus, generation int64) error {
+	current, err := normalizeIR(desired)
+	if err != nil {
+		return err
+	}
+	specHash, err := specHash(owner)
+	if err != nil {
+		return err
+	}
+	operatorVersion := version.Get()
+
+	name := IRSnapshotSecretName(owner.GetName(), desired.App)
+	stored := &corev1.Secret{}
+	err = h.Client.Get(ctx, client.ObjectKey{Namespace: owner.GetNamespace(), Name: name}, stored)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get IR snapshot: %w", err)
+	}
+
+	reason, message := "SnapshotRecorded", fmt.Sprintf("IR snapshot recorded in Secret %s", name)
+	// A snapshot of another spec, or an unreadable one, is replaced rather than compared.
+	// Snapshots of an older IR schema are converted first, so the schema change
+	// itself isn't reported as a changed IR.
+	var storedIR []byte
+	if err == nil && stored.Annotations[irSnapshotSpecAnnotation] == specHash && json.Valid(stored.Data["ir.json"]) {
+		storedIR, err = conversion.ConvertIR(stored.Data["ir.json"])
+		if err != nil {
+			logf.FromContext(ctx).Info("Replacing IR snapshot", "secret", name, "reason", err.Error())
+			storedIR = nil
+		}
+	}
+	if storedIR != nil {
+		storedVersion := stored.Annotations[irSnapshotVersionAnnotation]
+		changed, err := changedIRSections(storedIR, current)
+		if err != nil {
+			return err
+		}
+		switch {
+		case len(changed) == 0:
+			reason, message = "SnapshotMatches", fmt.Sprintf("IR matches the snapshot from operator %s", storedVersion)
+		case storedVersion != operatorVersion:
+			h.SetCondition(status, generation, ConditionTypeIRSnapshot, metav1.ConditionFalse, "IRChangedByUpgrade",
+				fmt.Sprintf("Operator %s compiles the unchanged spec differently than %s (%v); delete Secret %s to accept the new IR",
+					operatorVersion, storedVersion, changed, name))
+			return nil
+		}
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: owner.GetNamespace()}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.Client, secret, func() error {
+		if err := controllerutil.SetControllerReference(owner, secret, h.Client.Scheme()); err != nil {
+			return err
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[irSnapshotVersionAnnotation] = operatorVersion
+		secret.Annotations[irSnapshotSpecAnnotation] = specHash
+		secret.Data = map[string][]byte{"ir.json": current}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to write IR snapshot: %w", err)
+	}
+
+	h.SetCondition(status, generation, ConditionTypeIRSnapshot, metav1.ConditionTrue, reason, message)
+	return nil
+}
+
+// clearIRSnapshotCondition removes the IRSnapshot condition once snapshots are turned off
+func (h *ReconcileHelper) clearIRSnapshotCondition(status ConfigStatus) {
+	conditions := status.GetConditions()
+	meta.RemoveStatusCondition(&conditions, ConditionTypeIRSnapshot)
+	status.SetConditions(conditions)
+}
+
+// normalizeIR encodes the parts of the IR that derive from the spec, leaving out
+// compile metadata and the connection
+func normalizeIR(ir *irv1.IR) ([]byte, error) {
+	normalized := *ir
+	normalized.GeneratedAt = time.Time{}
+	normalized.SourceHash = ""
+	normalized.Connection = nil
+
+	data, err := json.MarshalIndent(&normalized, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode IR: %w", err)
+	}
+	return data, nil
+}
+
+// changedIRSections returns the sorted top-level IR sections that differ
+func changedIRSections(stored, current []byte) ([]string, error) {
+	if bytes.Equal(stored, current) {
+		return nil, nil
+	}
+
+	var storedMap, currentMap map[string]interface{}
+	if err := json.Unmarshal(stored, &storedMap); err != nil {
+		return nil, fmt.Errorf("failed to decode IR snapshot: %w", err)
+	}
+	if err := json.Unmarshal(current, &currentMap); err != nil {
+		return nil, fmt.Errorf("failed to decode IR: %w", err)
+	}
+
+	var changed []string
+	for key, value := range currentMap {
+		if !reflect.DeepEqual(storedMap[key], value) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range storedMap {
+		if _, ok := currentMap[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
+
+// specHash hashes an object's spec
+func specHash(obj client.Object) (string, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to read spec: %w", err)
+	}
+	data, err := json.Marshal(content["spec"])
+	if err != nil {
+		return "", fmt.Errorf("failed to encode spec: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
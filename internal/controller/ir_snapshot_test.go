@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+	"github.com/poiley/nebularr-operator/internal/version"
+)
+
+var _ = Describe("IR snapshots", func() {
+	ctx := context.Background()
+
+	It("flags an IR change for an unchanged spec after an operator upgrade", func() {
+		config := &arrv1alpha1.RadarrConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: "default"},
+			Spec: arrv1alpha1.RadarrConfigSpec{
+				Connection: arrv1alpha1.ConnectionSpec{URL: "http://radarr.example.com:7878"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, config)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, config)).To(Succeed()) })
+
+		previous := version.Version
+		DeferCleanup(func() { version.Version = previous })
+
+		helper := NewReconcileHelper(k8sClient)
+		status := &RadarrStatusWrapper{Status: &config.Status}
+		compiled := func(rename bool) *irv1.IR {
+			return &irv1.IR{
+				App:    "radarr",
+				Naming: &irv1.NamingIR{Radarr: &irv1.RadarrNamingIR{RenameMovies: rename}},
+			}
+		}
+
+		By("recording the first snapshot")
+		version.Version = "v1.0.0"
+		Expect(helper.CheckIRSnapshot(ctx, config, compiled(true), status, config.Generation)).To(Succeed())
+		condition := meta.FindStatusCondition(config.Status.Conditions, ConditionTypeIRSnapshot)
+		Expect(condition.Reason).To(Equal("SnapshotRecorded"))
+
+		By("matching the snapshot after an upgrade that compiles the same IR")
+		version.Version = "v1.1.0"
+		Expect(helper.CheckIRSnapshot(ctx, config, compiled(true), status, config.Generation)).To(Succeed())
+		condition = meta.FindStatusCondition(config.Status.Conditions, ConditionTypeIRSnapshot)
+		Expect(condition.Reason).To(Equal("SnapshotMatches"))
+
+		By("flagging an upgrade that compiles a different IR")
+		version.Version = "v2.0.0"
+		Expect(helper.CheckIRSnapshot(ctx, config, compiled(false), status, config.Generation)).To(Succeed())
+		condition = meta.FindStatusCondition(config.Status.Conditions, ConditionTypeIRSnapshot)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("IRChangedByUpgrade"))
+		Expect(condition.Message).To(ContainSubstring("[naming]"))
+	})
+})
@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+	"github.com/poiley/nebularr-operator/internal/version"
+)
+
+// lastKnownGoodTimeAnnotation records when the last known good snapshot was taken
+const lastKnownGoodTimeAnnotation = "arr.rinzler.cloud/snapshot-time"
+
+// lastKnownGoodKeys are the Secret keys of the snapshotted resource types
+var lastKnownGoodKeys = map[string]string{
+	adapters.ResourceQualityProfile: "qualityProfiles.json",
+	adapters.ResourceCustomFormat:   "customFormats.json",
+}
+
+// LastKnownGoodSecretName returns the name of the Secret holding the raw bodies of a
+// config's managed quality profiles and custom formats after its last clean sync
+func LastKnownGoodSecretName(configName, app string) string {
+	return fmt.Sprintf("%s-%s-last-known-good", configName, app)
+}
+
+// RecordLastKnownGood snapshots the managed resources of apps that support it
+// (Radarr, Sonarr) into the owner's last known good Secret. The snapshot is taken
+// after a sync that applied changes without failures, and once when the Secret is
+// missing, so an unchanged app isn't read on every reconcile.
+func (h *ReconcileHelper) RecordLastKnownGood(ctx context.Context, owner client.Object, appType string, connIR *irv1.ConnectionIR, result *adapters.ApplyResult) error {
+	adapter, ok := adapters.Get(appType)
+	if !ok {
+		return nil
+	}
+	snapshotter, ok := adapter.(adapters.Snapshotter)
+	if !ok || result == nil || !result.Success() {
+		return nil
+	}
+
+	name := LastKnownGoodSecretName(owner.GetName(), appType)
+	if result.Applied == 0 {
+		existing := &corev1.Secret{}
+		err := h.Client.Get(ctx, client.ObjectKey{Namespace: owner.GetNamespace(), Name: name}, existing)
+		if err == nil {
+			return nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get last known good snapshot: %w", err)
+		}
+	}
+
+	snapshot, err := snapshotter.Snapshot(ctx, connIR)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot %s: %w", appType, err)
+	}
+	data := make(map[string][]byte, len(snapshot))
+	for resourceType, resources := range snapshot {
+		encoded, err := json.MarshalIndent(resources, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode %s snapshot: %w", resourceType, err)
+		}
+		key, ok := lastKnownGoodKeys[resourceType]
+		if !ok {
+			key = resourceType + ".json"
+		}
+		data[key] = encoded
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: owner.GetNamespace()}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.Client, secret, func() error {
+		if err := controllerutil.SetControllerReference(owner, secret, h.Client.Scheme()); err != nil {
+			return err
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[irSnapshotVersionAnnotation] = version.Get()
+		secret.Annotations[lastKnownGoodTimeAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		secret.Data = data
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to write last known good snapshot: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/mock"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// snapshotAdapter adds the Snapshotter interface to the mock adapter
+type snapshotAdapter struct {
+	*mock.Adapter
+	snapshots int
+}
+
+func (a *snapshotAdapter) Snapshot(context.Context, *irv1.ConnectionIR) (map[string]map[string]json.RawMessage, error) {
+	a.snapshots++
+	return map[string]map[string]json.RawMessage{
+		adapters.ResourceQualityProfile: {"nebularr-movies": json.RawMessage(`{"id":4,"name":"nebularr-movies"}`)},
+		adapters.ResourceCustomFormat:   {},
+	}, nil
+}
+
+var _ = Describe("Last known good snapshots", func() {
+	const appType = "last-known-good-test"
+	ctx := context.Background()
+
+	var (
+		snapshotter *snapshotAdapter
+		helper      *ReconcileHelper
+		owner       *arrv1alpha1.RadarrConfig
+		key         client.ObjectKey
+	)
+
+	BeforeEach(func() {
+		snapshotter = &snapshotAdapter{Adapter: mock.NewAdapter(appType)}
+		adapters.RegisterOrReplace(snapshotter)
+		DeferCleanup(func() { adapters.Unregister(appType) })
+
+		s := runtime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(arrv1alpha1.AddToScheme(s)).To(Succeed())
+		helper = &ReconcileHelper{Client: fake.NewClientBuilder().WithScheme(s).Build()}
+		owner = &arrv1alpha1.RadarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "movies", Namespace: "media", UID: "uid-1"}}
+		key = client.ObjectKey{Namespace: "media", Name: LastKnownGoodSecretName("movies", appType)}
+	})
+
+	It("snapshots the managed resources after a clean sync with changes", func() {
+		Expect(helper.RecordLastKnownGood(ctx, owner, appType, &irv1.ConnectionIR{}, &adapters.ApplyResult{Applied: 2})).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(helper.Client.Get(ctx, key, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKey("qualityProfiles.json"))
+		Expect(secret.Data).To(HaveKey("customFormats.json"))
+		Expect(string(secret.Data["qualityProfiles.json"])).To(ContainSubstring(`"nebularr-movies"`))
+		Expect(secret.Annotations).To(HaveKey(lastKnownGoodTimeAnnotation))
+		Expect(secret.OwnerReferences).To(HaveLen(1))
+		Expect(secret.OwnerReferences[0].UID).To(Equal(owner.UID))
+	})
+
+	It("only snapshots an unchanged app when the Secret is missing", func() {
+		Expect(helper.RecordLastKnownGood(ctx, owner, appType, &irv1.ConnectionIR{}, &adapters.ApplyResult{})).To(Succeed())
+		Expect(snapshotter.snapshots).To(Equal(1))
+
+		Expect(helper.RecordLastKnownGood(ctx, owner, appType, &irv1.ConnectionIR{}, &adapters.ApplyResult{})).To(Succeed())
+		Expect(snapshotter.snapshots).To(Equal(1))
+	})
+
+	It("keeps the previous snapshot after a failed sync", func() {
+		result := &adapters.ApplyResult{Applied: 1, Failed: 1}
+		Expect(helper.RecordLastKnownGood(ctx, owner, appType, &irv1.ConnectionIR{}, result)).To(Succeed())
+		Expect(snapshotter.snapshots).To(BeZero())
+		Expect(apierrors.IsNotFound(helper.Client.Get(ctx, key, &corev1.Secret{}))).To(BeTrue())
+	})
+})
@@ -41,6 +41,8 @@ type LidarrConfigReconciler struct {
 
 	// generic holds the shared reconciliation logic
 	generic *GenericArrReconciler
+
+	Options ControllerOptions
 }
 
 // +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=lidarrconfigs,verbs=get;list;watch;create;update;patch;delete
@@ -62,6 +64,7 @@ func (r *LidarrConfigReconciler) ensureInitialized() {
 	}
 	if r.Compiler == nil {
 		r.Compiler = compiler.New()
+		r.Compiler.SecretKey = r.Options.SecretKey
 	}
 	if r.Helper == nil {
 		r.Helper = NewReconcileHelper(r.Client)
@@ -72,6 +75,7 @@ func (r *LidarrConfigReconciler) ensureInitialized() {
 		Compiler: r.Compiler,
 		Helper:   r.Helper,
 		Recorder: r.Recorder,
+		Options:  r.Options,
 		CompileConfig: func(ctx context.Context, c *compiler.Compiler, config ArrConfigObject, secrets map[string]string, caps *adapters.Capabilities) (*irv1.IR, error) {
 			lidarrConfig := config.(*LidarrConfigAdapter).LidarrConfig
 			return c.CompileLidarrConfig(ctx, lidarrConfig, secrets, caps)
@@ -82,9 +86,11 @@ func (r *LidarrConfigReconciler) ensureInitialized() {
 // SetupWithManager sets up the controller with the Manager.
 func (r *LidarrConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ensureInitialized()
+	r.Helper.RestConfig = mgr.GetConfig()
+	r.Helper.LogDiffs = r.Options.LogDiffs
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.LidarrConfig{})
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&arrv1alpha1.LidarrConfig{}).
-		Named("lidarrconfig").
-		Complete(r)
+	return r.Options.complete(mgr, b, "lidarrconfig", &arrv1alpha1.LidarrConfigList{}, r)
 }
@@ -0,0 +1,160 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// DefaultMaintenanceInterval is the time between maintenance runs without spec.maintenance.interval
+const DefaultMaintenanceInterval = 6 * time.Hour
+
+// RunMaintenance prunes the app's blocklist per spec.maintenance once the interval
+// since the last run has passed, and returns the new maintenance status. Entries
+// older than clearBlocklistOlderThan or matching a blocklist pattern are removed.
+// A failed run keeps the previous counts and is retried on the next reconcile.
+func (h *ReconcileHelper) RunMaintenance(
+	ctx context.Context,
+	appType string,
+	connIR *irv1.ConnectionIR,
+	obj client.Object,
+	spec *arrv1alpha1.MaintenanceSpec,
+	previous *arrv1alpha1.MaintenanceStatus,
+	recorder record.EventRecorder,
+	now time.Time,
+) *arrv1alpha1.MaintenanceStatus {
+	if spec == nil {
+		return nil
+	}
+
+	interval := DefaultMaintenanceInterval
+	if spec.Interval != nil {
+		interval = spec.Interval.Duration
+	}
+	if previous != nil && previous.Error == "" && previous.LastRun != nil && now.Before(previous.LastRun.Add(interval)) {
+		return previous
+	}
+
+	log := logf.FromContext(ctx)
+
+	adapter, ok := adapters.Get(appType)
+	if !ok {
+		return previous
+	}
+	manager, ok := adapter.(adapters.BlocklistManager)
+	if !ok {
+		log.V(1).Info("Adapter does not support BlocklistManager", "app", appType)
+		return previous
+	}
+
+	status, err := pruneBlocklist(ctx, manager, connIR, spec, previous, now)
+	if err != nil {
+		log.Error(err, "Maintenance failed", "app", appType)
+		return status
+	}
+	if status.BlocklistRemoved > 0 {
+		log.Info("Pruned blocklist", "app", appType, "removed", status.BlocklistRemoved, "remaining", status.BlocklistEntries)
+		if recorder != nil {
+			recorder.Event(obj, corev1.EventTypeNormal, "BlocklistPruned",
+				fmt.Sprintf("Removed %d blocklist entries, %d remain", status.BlocklistRemoved, status.BlocklistEntries))
+		}
+	}
+	return status
+}
+
+// pruneBlocklist removes the expired blocklist entries and returns the new status.
+// On failure the returned status keeps the previous run and records the error,
+// counting the entries removed before it in the total.
+func pruneBlocklist(
+	ctx context.Context,
+	manager adapters.BlocklistManager,
+	connIR *irv1.ConnectionIR,
+	spec *arrv1alpha1.MaintenanceSpec,
+	previous *arrv1alpha1.MaintenanceStatus,
+	now time.Time,
+) (*arrv1alpha1.MaintenanceStatus, error) {
+	status := &arrv1alpha1.MaintenanceStatus{}
+	if previous != nil {
+		*status = *previous
+	}
+	fail := func(err error) (*arrv1alpha1.MaintenanceStatus, error) {
+		status.Error = err.Error()
+		return status, err
+	}
+
+	// Patterns are validated by the compiler
+	patterns := make([]*regexp.Regexp, 0, len(spec.BlocklistPatterns))
+	for _, p := range spec.BlocklistPatterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			return fail(fmt.Errorf("invalid blocklist pattern %q: %w", p, err))
+		}
+		patterns = append(patterns, re)
+	}
+
+	entries, err := manager.GetBlocklist(ctx, connIR)
+	if err != nil {
+		return fail(err)
+	}
+
+	removed := int32(0)
+	for _, entry := range entries {
+		if !blocklistEntryExpired(entry, spec.ClearBlocklistOlderThan, patterns, now) {
+			continue
+		}
+		if err := manager.RemoveBlocklistEntry(ctx, connIR, entry.ID); err != nil {
+			status.BlocklistRemovedTotal += int64(removed)
+			status.BlocklistEntries = int32(len(entries)) - removed
+			return fail(err)
+		}
+		removed++
+	}
+
+	status.LastRun = &metav1.Time{Time: now}
+	status.BlocklistEntries = int32(len(entries)) - removed
+	status.BlocklistRemoved = removed
+	status.BlocklistRemovedTotal += int64(removed)
+	status.Error = ""
+	return status, nil
+}
+
+// blocklistEntryExpired reports whether an entry is older than the maximum age or
+// its release title matches one of the patterns
+func blocklistEntryExpired(entry adapters.BlocklistEntry, olderThan *metav1.Duration, patterns []*regexp.Regexp, now time.Time) bool {
+	if olderThan != nil && !entry.Date.IsZero() && now.Sub(entry.Date) > olderThan.Duration {
+		return true
+	}
+	for _, re := range patterns {
+		if re.MatchString(entry.SourceTitle) {
+			return true
+		}
+	}
+	return false
+}
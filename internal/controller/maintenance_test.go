@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// fakeBlocklist is an in-memory BlocklistManager
+type fakeBlocklist struct {
+	entries   []adapters.BlocklistEntry
+	removed   []int
+	removeErr error
+}
+
+func (f *fakeBlocklist) GetBlocklist(ctx context.Context, conn *irv1.ConnectionIR) ([]adapters.BlocklistEntry, error) {
+	return f.entries, nil
+}
+
+func (f *fakeBlocklist) RemoveBlocklistEntry(ctx context.Context, conn *irv1.ConnectionIR, id int) error {
+	if f.removeErr != nil && len(f.removed) > 0 {
+		return f.removeErr
+	}
+	f.removed = append(f.removed, id)
+	return nil
+}
+
+var _ = Describe("Blocklist maintenance", func() {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := []adapters.BlocklistEntry{
+		{ID: 1, SourceTitle: "Movie.2020.1080p.WEB-DL", Date: now.Add(-40 * 24 * time.Hour)},
+		{ID: 2, SourceTitle: "Movie.2021.CAM.x264", Date: now.Add(-time.Hour)},
+		{ID: 3, SourceTitle: "Movie.2022.2160p.BluRay", Date: now.Add(-2 * 24 * time.Hour)},
+	}
+	spec := &arrv1alpha1.MaintenanceSpec{
+		ClearBlocklistOlderThan: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+		BlocklistPatterns:       []string{`\bcam\b`},
+	}
+
+	It("removes entries past the maximum age or matching a pattern", func() {
+		manager := &fakeBlocklist{entries: entries}
+		previous := &arrv1alpha1.MaintenanceStatus{BlocklistRemovedTotal: 5}
+
+		status, err := pruneBlocklist(context.Background(), manager, nil, spec, previous, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manager.removed).To(Equal([]int{1, 2}))
+		Expect(status.LastRun.Time).To(Equal(now))
+		Expect(status.BlocklistRemoved).To(Equal(int32(2)))
+		Expect(status.BlocklistEntries).To(Equal(int32(1)))
+		Expect(status.BlocklistRemovedTotal).To(Equal(int64(7)))
+		Expect(status.Error).To(BeEmpty())
+	})
+
+	It("keeps the previous run and counts partial removals on failure", func() {
+		manager := &fakeBlocklist{entries: entries, removeErr: errors.New("boom")}
+		lastRun := metav1.NewTime(now.Add(-7 * time.Hour))
+		previous := &arrv1alpha1.MaintenanceStatus{LastRun: &lastRun, BlocklistRemoved: 3}
+
+		status, err := pruneBlocklist(context.Background(), manager, nil, spec, previous, now)
+		Expect(err).To(HaveOccurred())
+		Expect(status.Error).To(ContainSubstring("boom"))
+		Expect(status.LastRun).To(Equal(&lastRun))
+		Expect(status.BlocklistRemoved).To(Equal(int32(3)))
+		Expect(status.BlocklistRemovedTotal).To(Equal(int64(1)))
+		Expect(status.BlocklistEntries).To(Equal(int32(2)))
+	})
+
+	It("waits for the interval between runs", func() {
+		lastRun := metav1.NewTime(now.Add(-time.Hour))
+		previous := &arrv1alpha1.MaintenanceStatus{LastRun: &lastRun}
+		helper := &ReconcileHelper{}
+
+		status := helper.RunMaintenance(context.Background(), adapters.AppRadarr, nil, nil, spec, previous, nil, now)
+		Expect(status).To(BeIdenticalTo(previous))
+		Expect(helper.RunMaintenance(context.Background(), adapters.AppRadarr, nil, nil, nil, previous, nil, now)).To(BeNil())
+	})
+})
@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// ManageAnnotation limits which subsystems of an app the operator manages.
+// The value is a comma-separated list of subsystem names, e.g. "indexers,downloadClients".
+// Without the annotation every subsystem in the spec is managed.
+const ManageAnnotation = "arr.rinzler.cloud/manage"
+
+// Subsystem names accepted by the manage annotation
+const (
+	SubsystemQualityProfiles    = "qualityProfiles"
+	SubsystemCustomFormats      = "customFormats"
+	SubsystemDownloadClients    = "downloadClients"
+	SubsystemRemotePathMappings = "remotePathMappings"
+	SubsystemIndexers           = "indexers"
+	SubsystemNaming             = "naming"
+	SubsystemRootFolders        = "rootFolders"
+	SubsystemImportLists        = "importLists"
+	SubsystemNotifications      = "notifications"
+	SubsystemDelayProfiles      = "delayProfiles"
+	SubsystemAutoTags           = "autoTags"
+	SubsystemQualityDefinitions = "qualityDefinitions"
+	SubsystemReleaseProfiles    = "releaseProfiles"
+	SubsystemMetadataProfiles   = "metadataProfiles"
+	SubsystemMediaManagement    = "mediaManagement"
+	SubsystemCollections        = "collections"
+	SubsystemAuthentication     = "authentication"
+	SubsystemUI                 = "ui"
+)
+
+// subsystemSections clears the IR sections belonging to each subsystem
+var subsystemSections = map[string]func(ir *irv1.IR){
+	SubsystemQualityProfiles:    func(ir *irv1.IR) { ir.Quality = nil },
+	SubsystemCustomFormats:      func(ir *irv1.IR) { ir.CustomFormats = nil },
+	SubsystemDownloadClients:    func(ir *irv1.IR) { ir.DownloadClients = nil },
+	SubsystemRemotePathMappings: func(ir *irv1.IR) { ir.RemotePathMappings = nil },
+	SubsystemIndexers:           func(ir *irv1.IR) { ir.Indexers = nil },
+	SubsystemNaming:             func(ir *irv1.IR) { ir.Naming = nil },
+	SubsystemRootFolders:        func(ir *irv1.IR) { ir.RootFolders = nil },
+	SubsystemImportLists:        func(ir *irv1.IR) { ir.ImportLists, ir.ImportListOptions = nil, nil },
+	SubsystemNotifications:      func(ir *irv1.IR) { ir.Notifications = nil },
+	SubsystemDelayProfiles:      func(ir *irv1.IR) { ir.DelayProfiles = nil },
+	SubsystemAutoTags:           func(ir *irv1.IR) { ir.AutoTags = nil },
+	SubsystemQualityDefinitions: func(ir *irv1.IR) { ir.QualityDefinitions = nil },
+	SubsystemReleaseProfiles:    func(ir *irv1.IR) { ir.ReleaseProfiles = nil },
+	SubsystemMetadataProfiles:   func(ir *irv1.IR) { ir.MetadataProfiles = nil },
+	SubsystemMediaManagement:    func(ir *irv1.IR) { ir.MediaManagement = nil },
+	SubsystemCollections:        func(ir *irv1.IR) { ir.Collections = nil },
+	SubsystemAuthentication:     func(ir *irv1.IR) { ir.Authentication = nil },
+	SubsystemUI:                 func(ir *irv1.IR) { ir.UI = nil },
+}
+
+// ManageScope is the set of subsystems the operator manages for a config.
+// A nil scope manages everything.
+type ManageScope map[string]bool
+
+// ParseManageScope reads the manage annotation of a config
+func ParseManageScope(obj client.Object) (ManageScope, error) {
+	value, ok := obj.GetAnnotations()[ManageAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	scope := ManageScope{}
+	var unknown []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, known := subsystemSections[name]; !known {
+			unknown = append(unknown, name)
+			continue
+		}
+		scope[name] = true
+	}
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("%s lists unknown subsystems %s (valid: %s)",
+			ManageAnnotation, strings.Join(unknown, ", "), strings.Join(knownSubsystems(), ", "))
+	}
+	if len(scope) == 0 {
+		return nil, fmt.Errorf("%s lists no subsystems", ManageAnnotation)
+	}
+	return scope, nil
+}
+
+// Manages reports whether the subsystem is managed
+func (s ManageScope) Manages(subsystem string) bool {
+	return s == nil || s[subsystem]
+}
+
+// Restrict clears the IR sections of unmanaged subsystems, so diffing and applying
+// skip them entirely. It is applied to both the desired and the current state.
+func (s ManageScope) Restrict(ir *irv1.IR) {
+	if s == nil || ir == nil {
+		return
+	}
+	for name, clearSection := range subsystemSections {
+		if !s[name] {
+			clearSection(ir)
+		}
+	}
+}
+
+// String lists the managed subsystems
+func (s ManageScope) String() string {
+	if s == nil {
+		return "all"
+	}
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// knownSubsystems returns the sorted subsystem names
+func knownSubsystems() []string {
+	names := make([]string, 0, len(subsystemSections))
+	for name := range subsystemSections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+var _ = Describe("Manage annotation", func() {
+	configWith := func(annotations map[string]string) *arrv1alpha1.RadarrConfig {
+		return &arrv1alpha1.RadarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "scoped", Annotations: annotations}}
+	}
+
+	It("manages everything without the annotation", func() {
+		scope, err := ParseManageScope(configWith(nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(scope).To(BeNil())
+		Expect(scope.Manages(SubsystemQualityProfiles)).To(BeTrue())
+	})
+
+	It("restricts the IR to the listed subsystems", func() {
+		scope, err := ParseManageScope(configWith(map[string]string{ManageAnnotation: "indexers, downloadClients"}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(scope.String()).To(Equal("downloadClients,indexers"))
+
+		ir := &irv1.IR{
+			Quality:         &irv1.QualityIR{},
+			DownloadClients: []irv1.DownloadClientIR{{Name: "qbit"}},
+			Indexers:        &irv1.IndexersIR{},
+			Naming:          &irv1.NamingIR{},
+			CustomFormats:   []irv1.CustomFormatIR{{Name: "x265"}},
+		}
+		scope.Restrict(ir)
+		Expect(ir.Quality).To(BeNil())
+		Expect(ir.Naming).To(BeNil())
+		Expect(ir.CustomFormats).To(BeEmpty())
+		Expect(ir.DownloadClients).To(HaveLen(1))
+		Expect(ir.Indexers).NotTo(BeNil())
+	})
+
+	It("rejects unknown or empty subsystem lists", func() {
+		_, err := ParseManageScope(configWith(map[string]string{ManageAnnotation: "indexers,profiles"}))
+		Expect(err).To(MatchError(ContainSubstring("profiles")))
+
+		_, err = ParseManageScope(configWith(map[string]string{ManageAnnotation: " , "}))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,370 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// NebularrStatusReconciler maintains the singleton NebularrStatus: config counts by
+// kind, managed resource totals, failing configs and the operator version
+type NebularrStatusReconciler struct {
+	client.Client
+	Scheme *k8sruntime.Scheme
+
+	// Version is the operator version reported in the status
+	Version string
+
+	Options ControllerOptions
+}
+
+// fleetConfig is the part of a config's status the overview needs
+type fleetConfig struct {
+	kind       string
+	namespace  string
+	name       string
+	conditions []metav1.Condition
+
+	// summary is the compiled summary of *arr configs (nil for other kinds)
+	summary *arrv1alpha1.CompiledSummary
+}
+
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=nebularrstatuses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=nebularrstatuses/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=radarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=sonarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=lidarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=readarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=prowlarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=bazarrconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=tautulliconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=downloadstackconfigs,verbs=get;list;watch
+
+// Reconcile recomputes the fleet overview, creating the NebularrStatus if it is missing
+func (r *NebularrStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if req.Name != arrv1alpha1.NebularrStatusName {
+		// The CRD rejects other names; nothing to maintain
+		return ctrl.Result{}, nil
+	}
+
+	status := &arrv1alpha1.NebularrStatus{}
+	if err := r.Get(ctx, req.NamespacedName, status); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		if err := r.ensureNebularrStatus(ctx); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.Get(ctx, req.NamespacedName, status); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if !status.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	configs, err := r.collectFleet(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list configs")
+		return ctrl.Result{}, err
+	}
+
+	applyFleetStatus(&status.Status, configs)
+	status.Status.OperatorVersion = r.Version
+	status.Status.GoVersion = runtime.Version()
+	now := metav1.Now()
+	status.Status.LastUpdated = &now
+
+	if err := r.Status().Update(ctx, status); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Config changes trigger a recompute through the watches; the periodic
+	// requeue only guards against missed events
+	return ctrl.Result{RequeueAfter: r.Options.requeueAfter(10 * time.Minute)}, nil
+}
+
+// ensureNebularrStatus creates the singleton NebularrStatus unless it exists
+func (r *NebularrStatusReconciler) ensureNebularrStatus(ctx context.Context) error {
+	status := &arrv1alpha1.NebularrStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arrv1alpha1.NebularrStatusName,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": "nebularr",
+			},
+		},
+	}
+	if err := r.Create(ctx, status); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create NebularrStatus: %w", err)
+	}
+	return nil
+}
+
+// collectFleet lists every config the operator manages, across all namespaces.
+// Kinds of disabled controller groups are left out.
+func (r *NebularrStatusReconciler) collectFleet(ctx context.Context) ([]fleetConfig, error) {
+	var configs []fleetConfig
+	add := func(kind string, obj metav1.Object, conditions []metav1.Condition, summary *arrv1alpha1.CompiledSummary) {
+		configs = append(configs, fleetConfig{
+			kind:       kind,
+			namespace:  obj.GetNamespace(),
+			name:       obj.GetName(),
+			conditions: conditions,
+			summary:    summary,
+		})
+	}
+
+	if r.Options.Groups.Enabled(ControllerGroupArrConfigs) {
+		radarr := &arrv1alpha1.RadarrConfigList{}
+		if err := r.List(ctx, radarr); err != nil {
+			return nil, fmt.Errorf("failed to list RadarrConfigs: %w", err)
+		}
+		for i := range radarr.Items {
+			c := &radarr.Items[i]
+			add("RadarrConfig", c, c.Status.Conditions, c.Status.CompiledSummary)
+		}
+
+		sonarr := &arrv1alpha1.SonarrConfigList{}
+		if err := r.List(ctx, sonarr); err != nil {
+			return nil, fmt.Errorf("failed to list SonarrConfigs: %w", err)
+		}
+		for i := range sonarr.Items {
+			c := &sonarr.Items[i]
+			add("SonarrConfig", c, c.Status.Conditions, c.Status.CompiledSummary)
+		}
+
+		lidarr := &arrv1alpha1.LidarrConfigList{}
+		if err := r.List(ctx, lidarr); err != nil {
+			return nil, fmt.Errorf("failed to list LidarrConfigs: %w", err)
+		}
+		for i := range lidarr.Items {
+			c := &lidarr.Items[i]
+			add("LidarrConfig", c, c.Status.Conditions, c.Status.CompiledSummary)
+		}
+
+		readarr := &arrv1alpha1.ReadarrConfigList{}
+		if err := r.List(ctx, readarr); err != nil {
+			return nil, fmt.Errorf("failed to list ReadarrConfigs: %w", err)
+		}
+		for i := range readarr.Items {
+			c := &readarr.Items[i]
+			add("ReadarrConfig", c, c.Status.Conditions, c.Status.CompiledSummary)
+		}
+
+		prowlarr := &arrv1alpha1.ProwlarrConfigList{}
+		if err := r.List(ctx, prowlarr); err != nil {
+			return nil, fmt.Errorf("failed to list ProwlarrConfigs: %w", err)
+		}
+		for i := range prowlarr.Items {
+			c := &prowlarr.Items[i]
+			add("ProwlarrConfig", c, c.Status.Conditions, c.Status.CompiledSummary)
+		}
+
+		bazarr := &arrv1alpha1.BazarrConfigList{}
+		if err := r.List(ctx, bazarr); err != nil {
+			return nil, fmt.Errorf("failed to list BazarrConfigs: %w", err)
+		}
+		for i := range bazarr.Items {
+			c := &bazarr.Items[i]
+			add("BazarrConfig", c, c.Status.Conditions, nil)
+		}
+
+		tautulli := &arrv1alpha1.TautulliConfigList{}
+		if err := r.List(ctx, tautulli); err != nil {
+			return nil, fmt.Errorf("failed to list TautulliConfigs: %w", err)
+		}
+		for i := range tautulli.Items {
+			c := &tautulli.Items[i]
+			add("TautulliConfig", c, c.Status.Conditions, nil)
+		}
+	}
+
+	if r.Options.Groups.Enabled(ControllerGroupDownloadStack) {
+		downloadStacks := &arrv1alpha1.DownloadStackConfigList{}
+		if err := r.List(ctx, downloadStacks); err != nil {
+			return nil, fmt.Errorf("failed to list DownloadStackConfigs: %w", err)
+		}
+		for i := range downloadStacks.Items {
+			c := &downloadStacks.Items[i]
+			add("DownloadStackConfig", c, c.Status.Conditions, nil)
+		}
+	}
+
+	return configs, nil
+}
+
+// applyFleetStatus writes the counts, totals, failing configs and Healthy condition.
+// A config is failing when its Ready condition is False; one that has not reported
+// Ready yet counts toward neither ready nor failing.
+func applyFleetStatus(status *arrv1alpha1.NebularrStatusStatus, configs []fleetConfig) {
+	counts := make(map[string]*arrv1alpha1.ConfigKindCount)
+	var failing []arrv1alpha1.FailingConfig
+	var managed *arrv1alpha1.CompiledSummary
+
+	for _, c := range configs {
+		count, ok := counts[c.kind]
+		if !ok {
+			count = &arrv1alpha1.ConfigKindCount{Kind: c.kind}
+			counts[c.kind] = count
+		}
+		count.Count++
+
+		if ready := meta.FindStatusCondition(c.conditions, ConditionTypeReady); ready != nil {
+			switch ready.Status {
+			case metav1.ConditionTrue:
+				count.Ready++
+			case metav1.ConditionFalse:
+				count.Failing++
+				since := ready.LastTransitionTime
+				failing = append(failing, arrv1alpha1.FailingConfig{
+					Kind:      c.kind,
+					Namespace: c.namespace,
+					Name:      c.name,
+					Reason:    ready.Reason,
+					Message:   ready.Message,
+					Since:     &since,
+				})
+			}
+		}
+
+		if c.summary != nil {
+			if managed == nil {
+				managed = &arrv1alpha1.CompiledSummary{}
+			}
+			addCompiledSummary(managed, c.summary)
+		}
+	}
+
+	status.Configs = make([]arrv1alpha1.ConfigKindCount, 0, len(counts))
+	for _, count := range counts {
+		status.Configs = append(status.Configs, *count)
+	}
+	sort.Slice(status.Configs, func(i, j int) bool { return status.Configs[i].Kind < status.Configs[j].Kind })
+
+	sort.Slice(failing, func(i, j int) bool {
+		if failing[i].Kind != failing[j].Kind {
+			return failing[i].Kind < failing[j].Kind
+		}
+		if failing[i].Namespace != failing[j].Namespace {
+			return failing[i].Namespace < failing[j].Namespace
+		}
+		return failing[i].Name < failing[j].Name
+	})
+	status.FailingConfigs = failing
+	status.ConfigCount = len(configs)
+	status.FailingCount = len(failing)
+	status.ManagedResources = managed
+
+	condition := metav1.Condition{
+		Type:    ConditionTypeHealthy,
+		Status:  metav1.ConditionTrue,
+		Reason:  "NoFailingConfigs",
+		Message: fmt.Sprintf("%d configs, none failing", len(configs)),
+	}
+	switch {
+	case len(configs) == 0:
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "NoConfigs"
+		condition.Message = "No configs found"
+	case len(failing) > 0:
+		names := make([]string, 0, len(failing))
+		for _, f := range failing {
+			names = append(names, fmt.Sprintf("%s %s/%s", f.Kind, f.Namespace, f.Name))
+		}
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ConfigsFailing"
+		condition.Message = fmt.Sprintf("%d of %d configs failing: %v", len(failing), len(configs), names)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// addCompiledSummary adds the counts of s to total
+func addCompiledSummary(total, s *arrv1alpha1.CompiledSummary) {
+	total.QualityProfiles += s.QualityProfiles
+	total.CustomFormats += s.CustomFormats
+	total.DownloadClients += s.DownloadClients
+	total.Indexers += s.Indexers
+	total.RootFolders += s.RootFolders
+	total.ImportLists += s.ImportLists
+	total.Notifications += s.Notifications
+	total.DelayProfiles += s.DelayProfiles
+	total.AutoTags += s.AutoTags
+	total.ReleaseProfiles += s.ReleaseProfiles
+	total.Applications += s.Applications
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NebularrStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Every config change recomputes the singleton
+	singleton := types.NamespacedName{Name: arrv1alpha1.NebularrStatusName}
+	mapToSingleton := func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: singleton}}
+	}
+
+	// Create the singleton once this replica leads, so the overview exists
+	// before any config changes
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		if err := r.ensureNebularrStatus(ctx); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to create NebularrStatus")
+		}
+		return nil
+	})); err != nil {
+		return err
+	}
+
+	// Only creation and deletion of the singleton matter; reacting to its own
+	// status updates would recompute it in a loop
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.NebularrStatus{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+	// Kinds of disabled controller groups may not even have their CRD installed
+	if r.Options.Groups.Enabled(ControllerGroupArrConfigs) {
+		b = b.Watches(&arrv1alpha1.RadarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapToSingleton)).
+			Watches(&arrv1alpha1.SonarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapToSingleton)).
+			Watches(&arrv1alpha1.LidarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapToSingleton)).
+			Watches(&arrv1alpha1.ReadarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapToSingleton)).
+			Watches(&arrv1alpha1.ProwlarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapToSingleton)).
+			Watches(&arrv1alpha1.BazarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapToSingleton)).
+			Watches(&arrv1alpha1.TautulliConfig{}, handler.EnqueueRequestsFromMapFunc(mapToSingleton))
+	}
+	if r.Options.Groups.Enabled(ControllerGroupDownloadStack) {
+		b = b.Watches(&arrv1alpha1.DownloadStackConfig{}, handler.EnqueueRequestsFromMapFunc(mapToSingleton))
+	}
+
+	return r.Options.complete(mgr, b, "nebularrstatus", nil, r)
+}
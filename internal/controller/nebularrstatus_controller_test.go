@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("NebularrStatus Controller", func() {
+	ctx := context.Background()
+
+	It("creates the singleton and lists failing configs", func() {
+		config := &arrv1alpha1.SonarrConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet-sonarr", Namespace: "default"},
+			Spec: arrv1alpha1.SonarrConfigSpec{
+				Connection: arrv1alpha1.ConnectionSpec{URL: "http://sonarr.example.com:8989"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, config)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, config)).To(Succeed()) })
+
+		config.Status.Conditions = []metav1.Condition{{
+			Type:               ConditionTypeReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             ReasonConnectionFailed,
+			Message:            "connection refused",
+			LastTransitionTime: metav1.Now(),
+		}}
+		config.Status.CompiledSummary = &arrv1alpha1.CompiledSummary{QualityProfiles: 2, Indexers: 3}
+		Expect(k8sClient.Status().Update(ctx, config)).To(Succeed())
+
+		reconciler := &NebularrStatusReconciler{
+			Client:  k8sClient,
+			Scheme:  k8sClient.Scheme(),
+			Version: "v1.2.3",
+		}
+		key := types.NamespacedName{Name: arrv1alpha1.NebularrStatusName}
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		status := &arrv1alpha1.NebularrStatus{}
+		Expect(k8sClient.Get(ctx, key, status)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, status)).To(Succeed()) })
+
+		Expect(status.Status.OperatorVersion).To(Equal("v1.2.3"))
+		Expect(status.Status.GoVersion).NotTo(BeEmpty())
+		Expect(status.Status.FailingConfigs).To(ContainElement(SatisfyAll(
+			HaveField("Kind", "SonarrConfig"),
+			HaveField("Name", "fleet-sonarr"),
+			HaveField("Reason", ReasonConnectionFailed),
+		)))
+		Expect(status.Status.ManagedResources).NotTo(BeNil())
+		Expect(status.Status.ManagedResources.Indexers).To(BeNumerically(">=", 3))
+		Expect(meta.IsStatusConditionFalse(status.Status.Conditions, ConditionTypeHealthy)).To(BeTrue())
+
+		By("ignoring any other NebularrStatus name")
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "other"}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("counts configs by kind and totals the managed resources", func() {
+		ready := metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: "Synced"}
+		failed := metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: ReasonUnauthorized, Message: "401"}
+
+		status := &arrv1alpha1.NebularrStatusStatus{}
+		applyFleetStatus(status, []fleetConfig{
+			{kind: "RadarrConfig", namespace: "media", name: "radarr", conditions: []metav1.Condition{ready},
+				summary: &arrv1alpha1.CompiledSummary{QualityProfiles: 1, DownloadClients: 2}},
+			{kind: "RadarrConfig", namespace: "media", name: "radarr-4k", conditions: []metav1.Condition{failed},
+				summary: &arrv1alpha1.CompiledSummary{QualityProfiles: 1}},
+			{kind: "BazarrConfig", namespace: "media", name: "bazarr"},
+		})
+
+		Expect(status.ConfigCount).To(Equal(3))
+		Expect(status.FailingCount).To(Equal(1))
+		Expect(status.Configs).To(Equal([]arrv1alpha1.ConfigKindCount{
+			{Kind: "BazarrConfig", Count: 1},
+			{Kind: "RadarrConfig", Count: 2, Ready: 1, Failing: 1},
+		}))
+		Expect(status.ManagedResources).To(Equal(&arrv1alpha1.CompiledSummary{QualityProfiles: 2, DownloadClients: 2}))
+		Expect(status.FailingConfigs).To(HaveLen(1))
+		Expect(status.FailingConfigs[0].Name).To(Equal("radarr-4k"))
+		Expect(status.FailingConfigs[0].Reason).To(Equal(ReasonUnauthorized))
+
+		condition := meta.FindStatusCondition(status.Conditions, ConditionTypeHealthy)
+		Expect(condition.Reason).To(Equal("ConfigsFailing"))
+
+		By("reporting Unknown when there are no configs")
+		applyFleetStatus(status, nil)
+		Expect(status.ManagedResources).To(BeNil())
+		Expect(meta.FindStatusCondition(status.Conditions, ConditionTypeHealthy).Reason).To(Equal("NoConfigs"))
+	})
+})
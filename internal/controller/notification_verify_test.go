@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/mock"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+var _ = Describe("Notification verification", func() {
+	const appType = "notification-verify-test"
+
+	var (
+		tester *mock.Adapter
+		tested []string
+		helper *ReconcileHelper
+	)
+
+	BeforeEach(func() {
+		tested = nil
+		tester = mock.NewAdapter(appType)
+		tester.TestNotificationFunc = func(_ context.Context, _ *irv1.ConnectionIR, name string) error {
+			tested = append(tested, name)
+			if name == "nebularr-movies-discord" || name == "nebularr-movies-media-server-jellyfin" {
+				return errors.New("unable to post to webhook")
+			}
+			return nil
+		}
+		adapters.RegisterOrReplace(tester)
+		DeferCleanup(func() { adapters.Unregister(appType) })
+		helper = &ReconcileHelper{}
+	})
+
+	It("reports each media server hook's test result", func() {
+		hooks := &arrv1alpha1.MediaServerHooksSpec{
+			Plex:     &arrv1alpha1.PlexHookSpec{URL: "http://plex:32400"},
+			Jellyfin: &arrv1alpha1.JellyfinHookSpec{URL: "http://jellyfin:8096"},
+		}
+
+		servers := helper.VerifyMediaServerHooks(context.Background(), appType, &irv1.ConnectionIR{}, "movies", hooks)
+		Expect(servers).To(HaveLen(2))
+		Expect(servers[0].Connected).To(BeTrue())
+		Expect(servers[0].LastChecked).NotTo(BeNil())
+		Expect(servers[1].Connected).To(BeFalse())
+		Expect(servers[1].Message).To(ContainSubstring("unable to post"))
+	})
+
+	It("tests notifications once per generation until they pass", func() {
+		status := &RadarrStatusWrapper{Status: &arrv1alpha1.RadarrConfigStatus{}}
+		notifications := []arrv1alpha1.NotificationSpec{{Name: "discord"}, {Name: "email"}}
+
+		results := helper.VerifyNotifications(context.Background(), appType, &irv1.ConnectionIR{}, "movies", notifications, nil, status, 1)
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Verified).To(BeFalse())
+		Expect(results[1].Verified).To(BeTrue())
+		cond := meta.FindStatusCondition(status.GetConditions(), ConditionTypeNotificationsVerified)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Message).To(ContainSubstring("discord"))
+
+		By("retrying only the failed notification")
+		results = helper.VerifyNotifications(context.Background(), appType, &irv1.ConnectionIR{}, "movies", notifications, results, status, 1)
+		Expect(tested).To(Equal([]string{"nebularr-movies-discord", "nebularr-movies-email", "nebularr-movies-discord"}))
+		Expect(results[1].Verified).To(BeTrue())
+
+		By("testing every notification again after the spec changes")
+		helper.VerifyNotifications(context.Background(), appType, &irv1.ConnectionIR{}, "movies", notifications, results, status, 2)
+		Expect(tested).To(HaveLen(5))
+
+		By("removing the condition once no notifications are configured")
+		Expect(helper.VerifyNotifications(context.Background(), appType, &irv1.ConnectionIR{}, "movies", nil, results, status, 3)).To(BeNil())
+		Expect(meta.FindStatusCondition(status.GetConditions(), ConditionTypeNotificationsVerified)).To(BeNil())
+	})
+})
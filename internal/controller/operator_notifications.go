@@ -0,0 +1,113 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/notify"
+)
+
+// reconcileOutcome records what a reconcile did to a config, for operator notifications
+type reconcileOutcome struct {
+	wasReady    bool
+	drifted     bool
+	applyFailed bool
+	settled     bool
+
+	// result and heldBack are kept for the apply summary endpoint
+	result   *adapters.ApplyResult
+	heldBack bool
+}
+
+// newReconcileOutcome captures the Ready condition before the reconcile changes it
+func newReconcileOutcome(status ConfigStatus) *reconcileOutcome {
+	return &reconcileOutcome{wasReady: meta.IsStatusConditionTrue(status.GetConditions(), ConditionTypeReady)}
+}
+
+// recordSync classifies the result of ReconcileConfig. Changes held back by the
+// apply window neither count as drift nor end a streak.
+func (o *reconcileOutcome) recordSync(result *adapters.ApplyResult, err error, window ApplyWindowState) {
+	o.result = result
+	o.heldBack = !window.Open
+	if !window.Open || result == nil {
+		// Nothing was applied: the reconcile failed before the diff or changes are held back
+		return
+	}
+	switch {
+	case err != nil || result.Failed > 0:
+		o.applyFailed = true
+	case result.Applied > 0:
+		o.drifted = true
+	default:
+		o.settled = true
+	}
+}
+
+// notifyOutcome reports a finished reconcile to the operator notifier, if configured.
+// Notifications are sent in the background; failures are logged and never fail the reconcile.
+func (o ControllerOptions) notifyOutcome(ctx context.Context, scheme *runtime.Scheme, obj client.Object, status ConfigStatus, outcome *reconcileOutcome) {
+	if o.Notifier == nil {
+		return
+	}
+	log := logf.FromContext(ctx)
+
+	obs := notify.Observation{
+		Kind:        objectKind(scheme, obj),
+		Namespace:   obj.GetNamespace(),
+		Name:        obj.GetName(),
+		WasReady:    outcome.wasReady,
+		Drifted:     outcome.drifted,
+		ApplyFailed: outcome.applyFailed,
+		Settled:     outcome.settled,
+	}
+	if ready := meta.FindStatusCondition(status.GetConditions(), ConditionTypeReady); ready != nil {
+		obs.Ready = ready.Status == metav1.ConditionTrue
+		obs.Reason = ready.Reason
+		obs.Message = ready.Message
+	}
+
+	if err := o.Notifier.Observe(obs); err != nil {
+		log.Error(err, "Failed to queue operator notification (non-fatal)")
+	}
+}
+
+// forgetNotifications drops the notifier's counters for a deleted config
+func (o ControllerOptions) forgetNotifications(scheme *runtime.Scheme, obj client.Object) {
+	if o.Notifier == nil {
+		return
+	}
+	o.Notifier.Forget(objectKind(scheme, obj), obj.GetNamespace(), obj.GetName())
+}
+
+// objectKind returns the kind of a typed object (falling back to its Go type name)
+func objectKind(scheme *runtime.Scheme, obj client.Object) string {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return fmt.Sprintf("%T", obj)
+	}
+	return gvk.Kind
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/poiley/nebularr-operator/internal/notify"
+)
+
+// ControllerOptions tunes reconcile concurrency, namespace sharding and requeue
+// jitter for all controllers; each reconciler takes it as its Options field.
+// The zero value keeps controller-runtime defaults (one worker, all namespaces).
+type ControllerOptions struct {
+	// MaxConcurrentReconciles is the worker count for every controller (0 = controller-runtime default)
+	MaxConcurrentReconciles int
+
+	// PerController overrides MaxConcurrentReconciles by controller name (e.g., "radarrconfig")
+	PerController map[string]int
+
+	// WorkerPool caps concurrent reconciles across all controllers (nil = no global cap)
+	WorkerPool *WorkerPool
+
+	// ShardSelector restricts reconciliation to objects in namespaces whose labels match
+	// (nil = all namespaces). Each shard should run with its own leader election ID.
+	ShardSelector labels.Selector
+
+	// RequeueJitter stretches every periodic requeue by a random fraction of its
+	// interval, up to this factor (e.g. 0.1 = up to 10%). 0 disables jitter.
+	RequeueJitter float64
+
+	// DownloadStackInterval is the DownloadStackConfig requeue interval when the
+	// resource sets none (0 = DefaultDownloadStackRequeueInterval)
+	DownloadStackInterval time.Duration
+
+	// IRSnapshots records IR snapshots for every *arr config, not only those
+	// annotated with IRSnapshotAnnotation
+	IRSnapshots bool
+
+	// LogDiffs logs the per-field diff of every update, with credentials redacted
+	LogDiffs bool
+
+	// Notifier sends operator-level notifications about config resources (nil disables them)
+	Notifier *notify.Dispatcher
+
+	// Summaries records per-config apply results for the summary endpoint (nil disables it)
+	Summaries *ApplySummaries
+
+	// Groups are the enabled controller groups (nil = all). Controllers that
+	// look at other kinds leave out those of disabled groups.
+	Groups ControllerGroups
+
+	// SecretKey keys the credential hashes recorded in status (see LoadSecretKey).
+	// Hashes keyed with an empty key are still salted per config.
+	SecretKey []byte
+}
+
+// requeueAfter jitters a periodic requeue interval so resources that reconciled
+// together, e.g. after an operator restart, spread out over later reconciles
+func (o ControllerOptions) requeueAfter(interval time.Duration) time.Duration {
+	if o.RequeueJitter <= 0 || interval <= 0 {
+		return interval
+	}
+	return wait.Jitter(interval, o.RequeueJitter)
+}
+
+// ParseControllerConcurrency parses "name=N,name=N" into per-controller worker counts
+func ParseControllerConcurrency(value string) (map[string]int, error) {
+	result := make(map[string]int)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, count, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid controller concurrency %q: expected name=N", entry)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid controller concurrency %q: N must be a positive integer", entry)
+		}
+		result[strings.ToLower(strings.TrimSpace(name))] = n
+	}
+	return result, nil
+}
+
+// complete finishes a controller builder with the concurrency, sharding and
+// worker pool settings for the named controller. list is the list type of the
+// controller's primary kind, used to re-enqueue a namespace's objects when its
+// labels change (nil for cluster-scoped kinds).
+func (o ControllerOptions) complete(mgr ctrl.Manager, b *builder.Builder, name string, list client.ObjectList, r reconcile.Reconciler) error {
+	opts := ctrlcontroller.Options{}
+	if n, ok := o.PerController[name]; ok {
+		opts.MaxConcurrentReconciles = n
+	} else if o.MaxConcurrentReconciles > 0 {
+		opts.MaxConcurrentReconciles = o.MaxConcurrentReconciles
+	}
+
+	b = b.Named(name).WithOptions(opts)
+	if o.ShardSelector != nil && !o.ShardSelector.Empty() {
+		r = shardFilter(mgr.GetClient(), o.ShardSelector, r)
+		if list != nil {
+			b = b.Watches(&corev1.Namespace{},
+				handler.EnqueueRequestsFromMapFunc(namespaceObjects(mgr.GetClient(), list)),
+				builder.WithPredicates(predicate.LabelChangedPredicate{}))
+		}
+	}
+
+	if o.WorkerPool != nil {
+		r = o.WorkerPool.Wrap(r)
+	}
+	return b.Complete(r)
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// shardFilter skips requests for objects whose namespace labels don't match the
+// selector. The labels are read when the request is reconciled, so an object is
+// picked up as soon as its namespace joins the shard. Requests for cluster-scoped
+// objects always pass.
+func shardFilter(c client.Reader, selector labels.Selector, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if req.Namespace != "" {
+			ns := &corev1.Namespace{}
+			if err := c.Get(ctx, types.NamespacedName{Name: req.Namespace}, ns); err != nil {
+				if apierrors.IsNotFound(err) {
+					return reconcile.Result{}, nil
+				}
+				return reconcile.Result{}, err
+			}
+			if !selector.Matches(labels.Set(ns.Labels)) {
+				return reconcile.Result{}, nil
+			}
+		}
+		return r.Reconcile(ctx, req)
+	})
+}
+
+// namespaceObjects maps a namespace to requests for every object of the list's
+// kind in it, so a label change moving the namespace into a shard reconciles them
+func namespaceObjects(c client.Reader, list client.ObjectList) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		items := list.DeepCopyObject().(client.ObjectList)
+		if err := c.List(ctx, items, client.InNamespace(obj.GetName())); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to list objects for namespace", "namespace", obj.GetName())
+			return nil
+		}
+
+		var requests []reconcile.Request
+		_ = meta.EachListItem(items, func(item runtime.Object) error {
+			if o, ok := item.(client.Object); ok {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+			}
+			return nil
+		})
+		return requests
+	}
+}
+
+// WorkerPool bounds the number of reconciles running at once across controllers.
+// Per-controller MaxConcurrentReconciles decides how work is spread; the pool keeps
+// the total load on the API server and *arr instances bounded.
+type WorkerPool struct {
+	slots chan struct{}
+}
+
+// NewWorkerPool creates a pool allowing size concurrent reconciles (size must be > 0)
+func NewWorkerPool(size int) *WorkerPool {
+	return &WorkerPool{slots: make(chan struct{}, size)}
+}
+
+// Wrap returns a reconciler that waits for a free pool slot before reconciling
+func (p *WorkerPool) Wrap(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Done():
+			return reconcile.Result{}, ctx.Err()
+		}
+		defer func() { <-p.slots }()
+
+		return r.Reconcile(ctx, req)
+	})
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("Controller options", func() {
+	ctx := context.Background()
+
+	newFakeClient := func(objs ...client.Object) client.Client {
+		s := runtime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(arrv1alpha1.AddToScheme(s)).To(Succeed())
+		return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+	}
+	namespace := func(name string, lbls map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: lbls}}
+	}
+
+	It("parses per-controller concurrency", func() {
+		counts, err := ParseControllerConcurrency(" RadarrConfig=4, sonarrconfig = 2,,")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts).To(Equal(map[string]int{"radarrconfig": 4, "sonarrconfig": 2}))
+
+		counts, err = ParseControllerConcurrency("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts).To(BeEmpty())
+
+		for _, value := range []string{"radarrconfig", "radarrconfig=0", "radarrconfig=x"} {
+			_, err = ParseControllerConcurrency(value)
+			Expect(err).To(HaveOccurred(), value)
+		}
+	})
+
+	It("bounds concurrent reconciles with the worker pool", func() {
+		pool := NewWorkerPool(2)
+		var running, peak int32
+		release := make(chan struct{})
+		r := pool.Wrap(reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			<-release
+			atomic.AddInt32(&running, -1)
+			return reconcile.Result{}, nil
+		}))
+
+		done := make(chan struct{}, 3)
+		for range 3 {
+			go func() {
+				defer GinkgoRecover()
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).NotTo(HaveOccurred())
+				done <- struct{}{}
+			}()
+		}
+		Eventually(func() int32 { return atomic.LoadInt32(&running) }).Should(Equal(int32(2)))
+		Consistently(func() int32 { return atomic.LoadInt32(&running) }, 50*time.Millisecond).Should(Equal(int32(2)))
+
+		close(release)
+		for range 3 {
+			Eventually(done).Should(Receive())
+		}
+		Expect(atomic.LoadInt32(&peak)).To(Equal(int32(2)))
+
+		// A reconcile waiting for a slot gives up with its context
+		full := NewWorkerPool(1)
+		full.slots <- struct{}{}
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := full.Wrap(reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			Fail("reconciled without a slot")
+			return reconcile.Result{}, nil
+		})).Reconcile(cancelled, reconcile.Request{})
+		Expect(err).To(MatchError(context.Canceled))
+	})
+
+	It("only reconciles objects in namespaces of the shard", func() {
+		c := newFakeClient(namespace("media-a", map[string]string{"shard": "a"}), namespace("media-b", map[string]string{"shard": "b"}))
+		selector, err := labels.Parse("shard=a")
+		Expect(err).NotTo(HaveOccurred())
+
+		var reconciled []string
+		r := shardFilter(c, selector, reconcile.Func(func(_ context.Context, req reconcile.Request) (reconcile.Result, error) {
+			reconciled = append(reconciled, req.String())
+			return reconcile.Result{}, nil
+		}))
+		for _, key := range []types.NamespacedName{
+			{Namespace: "media-a", Name: "movies"},
+			{Namespace: "media-b", Name: "movies"},
+			{Namespace: "missing", Name: "movies"},
+			{Name: "cluster"},
+		} {
+			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(reconciled).To(Equal([]string{"media-a/movies", "/cluster"}))
+	})
+
+	It("maps a namespace to the objects of the controller's kind in it", func() {
+		c := newFakeClient(
+			&arrv1alpha1.RadarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "movies", Namespace: "media-a"}},
+			&arrv1alpha1.RadarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "movies-4k", Namespace: "media-a"}},
+			&arrv1alpha1.RadarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "movies", Namespace: "media-b"}},
+			&arrv1alpha1.SonarrConfig{ObjectMeta: metav1.ObjectMeta{Name: "tv", Namespace: "media-a"}},
+		)
+
+		requests := namespaceObjects(c, &arrv1alpha1.RadarrConfigList{})(ctx, namespace("media-a", nil))
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "media-a", Name: "movies"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "media-a", Name: "movies-4k"}},
+		))
+	})
+
+	It("stretches requeues by up to the jitter factor", func() {
+		Expect(ControllerOptions{}.requeueAfter(5 * time.Minute)).To(Equal(5 * time.Minute))
+		Expect(ControllerOptions{RequeueJitter: 0.1}.requeueAfter(0)).To(BeZero())
+
+		opts := ControllerOptions{RequeueJitter: 0.1}
+		seen := map[time.Duration]bool{}
+		for range 20 {
+			d := opts.requeueAfter(5 * time.Minute)
+			Expect(d).To(BeNumerically(">=", 5*time.Minute))
+			Expect(d).To(BeNumerically("<=", 5*time.Minute+30*time.Second))
+			seen[d] = true
+		}
+		Expect(len(seen)).To(BeNumerically(">", 1))
+	})
+
+	It("picks the DownloadStackConfig requeue interval", func() {
+		r := &DownloadStackConfigReconciler{}
+		spec := &arrv1alpha1.DownloadStackConfigSpec{}
+		Expect(r.requeueInterval(spec)).To(Equal(DefaultDownloadStackRequeueInterval))
+
+		r.Options.DownloadStackInterval = time.Hour
+		Expect(r.requeueInterval(spec)).To(Equal(time.Hour))
+
+		spec.Reconciliation = &arrv1alpha1.ReconciliationSpec{Interval: &metav1.Duration{Duration: 15 * time.Minute}}
+		Expect(r.requeueInterval(spec)).To(Equal(15 * time.Minute))
+
+		// Torrent policies cap the interval so new torrents are covered
+		spec.Transmission = &arrv1alpha1.TransmissionSpec{TorrentPolicy: &arrv1alpha1.TransmissionTorrentPolicySpec{}}
+		Expect(r.requeueInterval(spec)).To(Equal(DefaultTrackerRulesInterval))
+	})
+})
@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// ConditionTypePathsVerified reports whether the app can see every root folder
+// (spec.reconciliation.preflightPaths)
+const ConditionTypePathsVerified = "PathsVerified"
+
+// PreflightPaths looks the desired root folders up through the app before they
+// are applied, and drops the ones the app can't see from the desired IR so their
+// creation doesn't fail with a validation error. Root folders are never deleted,
+// so dropping one leaves an existing root folder alone.
+func (h *ReconcileHelper) PreflightPaths(
+	ctx context.Context,
+	appType string,
+	connIR *irv1.ConnectionIR,
+	spec *arrv1alpha1.ReconciliationSpec,
+	desired *irv1.IR,
+	status ConfigStatus,
+	generation int64,
+) {
+	log := logf.FromContext(ctx)
+
+	if spec == nil || !spec.PreflightPaths || len(desired.RootFolders) == 0 {
+		conditions := status.GetConditions()
+		meta.RemoveStatusCondition(&conditions, ConditionTypePathsVerified)
+		status.SetConditions(conditions)
+		return
+	}
+
+	adapter, ok := adapters.Get(appType)
+	if !ok {
+		return
+	}
+	checker, ok := adapter.(adapters.PathChecker)
+	if !ok {
+		h.SetCondition(status, generation, ConditionTypePathsVerified, metav1.ConditionFalse, "NotSupported",
+			fmt.Sprintf("%s adapter does not support path preflight", appType))
+		return
+	}
+
+	var missing, failed []string
+	visible := make([]irv1.RootFolderIR, 0, len(desired.RootFolders))
+	for _, folder := range desired.RootFolders {
+		err := checker.CheckPath(ctx, connIR, folder.Path)
+		switch {
+		case err == nil:
+			visible = append(visible, folder)
+		case errors.Is(err, adapters.ErrPathNotFound):
+			log.Info("Root folder not found by the app, skipping", "app", appType, "path", folder.Path, "error", err.Error())
+			missing = append(missing, err.Error())
+		default:
+			// Leave the root folder to the app's own validation
+			log.Error(err, "Failed to check root folder", "app", appType, "path", folder.Path)
+			failed = append(failed, fmt.Sprintf("%s: %v", folder.Path, err))
+			visible = append(visible, folder)
+		}
+	}
+	desired.RootFolders = visible
+
+	switch {
+	case len(missing) > 0:
+		h.SetCondition(status, generation, ConditionTypePathsVerified, metav1.ConditionFalse, "PathNotFound",
+			fmt.Sprintf("%s can't see %d root folders: %s", appType, len(missing), strings.Join(missing, "; ")))
+	case len(failed) > 0:
+		h.SetCondition(status, generation, ConditionTypePathsVerified, metav1.ConditionFalse, "CheckFailed",
+			strings.Join(failed, "; "))
+	default:
+		h.SetCondition(status, generation, ConditionTypePathsVerified, metav1.ConditionTrue, "Verified",
+			fmt.Sprintf("%d root folders found", len(visible)))
+	}
+}
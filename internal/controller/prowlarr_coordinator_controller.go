@@ -43,6 +43,8 @@ type ProwlarrCoordinatorReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 	Helper *ReconcileHelper
+
+	Options ControllerOptions
 }
 
 // +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=prowlarrconfigs,verbs=get;list;watch
@@ -75,6 +77,12 @@ func (r *ProwlarrCoordinatorReconciler) Reconcile(ctx context.Context, req ctrl.
 		return ctrl.Result{}, nil
 	}
 
+	// Registering apps writes to Prowlarr, which an observed config never does
+	if prowlarrConfig.Spec.Observe {
+		log.Info("ProwlarrConfig is observed, skipping coordination")
+		return ctrl.Result{}, nil
+	}
+
 	// Skip if not connected
 	if !prowlarrConfig.Status.Connected {
 		log.Info("ProwlarrConfig not connected, skipping coordination")
@@ -82,7 +90,7 @@ func (r *ProwlarrCoordinatorReconciler) Reconcile(ctx context.Context, req ctrl.
 	}
 
 	// Get Prowlarr connection info
-	prowlarrSecrets, err := r.Helper.ResolveConnectionSecrets(ctx, prowlarrConfig.Namespace, &prowlarrConfig.Spec.Connection)
+	prowlarrSecrets, err := r.Helper.ResolveConnectionSecrets(ctx, prowlarrConfig, &prowlarrConfig.Spec.Connection)
 	if err != nil {
 		log.Error(err, "Failed to resolve Prowlarr secrets")
 		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
@@ -112,8 +120,8 @@ func (r *ProwlarrCoordinatorReconciler) Reconcile(ctx context.Context, req ctrl.
 		for i := range radarrList.Items {
 			config := &radarrList.Items[i]
 			if err := r.processAppConfig(ctx, prowlarrConfig, prowlarrConn, pushModelApps,
-				config.Name, config.Namespace, irv1.AppTypeRadarr,
-				config.Spec.Indexers, config.Spec.Connection.URL,
+				config, irv1.AppTypeRadarr,
+				config.Spec.Indexers, &config.Spec.Connection,
 				&config.Status.ProwlarrRegistration); err != nil {
 				errs = append(errs, err)
 			}
@@ -132,8 +140,8 @@ func (r *ProwlarrCoordinatorReconciler) Reconcile(ctx context.Context, req ctrl.
 		for i := range sonarrList.Items {
 			config := &sonarrList.Items[i]
 			if err := r.processAppConfig(ctx, prowlarrConfig, prowlarrConn, pushModelApps,
-				config.Name, config.Namespace, irv1.AppTypeSonarr,
-				config.Spec.Indexers, config.Spec.Connection.URL,
+				config, irv1.AppTypeSonarr,
+				config.Spec.Indexers, &config.Spec.Connection,
 				&config.Status.ProwlarrRegistration); err != nil {
 				errs = append(errs, err)
 			}
@@ -152,8 +160,8 @@ func (r *ProwlarrCoordinatorReconciler) Reconcile(ctx context.Context, req ctrl.
 		for i := range lidarrList.Items {
 			config := &lidarrList.Items[i]
 			if err := r.processAppConfig(ctx, prowlarrConfig, prowlarrConn, pushModelApps,
-				config.Name, config.Namespace, irv1.AppTypeLidarr,
-				config.Spec.Indexers, config.Spec.Connection.URL,
+				config, irv1.AppTypeLidarr,
+				config.Spec.Indexers, &config.Spec.Connection,
 				&config.Status.ProwlarrRegistration); err != nil {
 				errs = append(errs, err)
 			}
@@ -172,8 +180,8 @@ func (r *ProwlarrCoordinatorReconciler) Reconcile(ctx context.Context, req ctrl.
 		for i := range readarrList.Items {
 			config := &readarrList.Items[i]
 			if err := r.processAppConfig(ctx, prowlarrConfig, prowlarrConn, pushModelApps,
-				config.Name, config.Namespace, irv1.AppTypeReadarr,
-				config.Spec.Indexers, config.Spec.Connection.URL,
+				config, irv1.AppTypeReadarr,
+				config.Spec.Indexers, &config.Spec.Connection,
 				&config.Status.ProwlarrRegistration); err != nil {
 				errs = append(errs, err)
 			}
@@ -188,7 +196,7 @@ func (r *ProwlarrCoordinatorReconciler) Reconcile(ctx context.Context, req ctrl.
 		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, fmt.Errorf("encountered %d errors during coordination", len(errs))
 	}
 
-	return ctrl.Result{RequeueAfter: DefaultRequeueInterval}, nil
+	return ctrl.Result{RequeueAfter: r.Options.requeueAfter(DefaultRequeueInterval)}, nil
 }
 
 // processAppConfig handles registration for a single app config
@@ -197,12 +205,13 @@ func (r *ProwlarrCoordinatorReconciler) processAppConfig(
 	prowlarrConfig *arrv1alpha1.ProwlarrConfig,
 	prowlarrConn prowlarr.ProwlarrConnection,
 	pushModelApps map[string]bool,
-	configName, namespace, appType string,
+	owner client.Object, appType string,
 	indexers *arrv1alpha1.IndexersSpec,
-	appURL string,
+	conn *arrv1alpha1.ConnectionSpec,
 	registration **arrv1alpha1.ProwlarrRegistration,
 ) error {
 	log := logf.FromContext(ctx)
+	configName, namespace, appURL := owner.GetName(), owner.GetNamespace(), conn.URL
 
 	// Check if this app has a prowlarrRef pointing to the current ProwlarrConfig
 	if indexers == nil || indexers.ProwlarrRef == nil {
@@ -322,15 +331,69 @@ func (r *ProwlarrCoordinatorReconciler) processAppConfig(
 	log.Info("Successfully registered app with Prowlarr (Pull Model)",
 		"app", configName, "type", appType, "prowlarr", prowlarrConfig.Name)
 
+	// Enforce include/exclude filters on indexers Prowlarr has pushed into the app
+	r.syncIndexerPolicy(ctx, prowlarrConfig, prowlarrRef, owner, appType, conn, *registration)
+
 	_ = appSecrets // silence unused variable
 	return nil
 }
 
+// syncIndexerPolicy validates the indexers Prowlarr synced into an app against the
+// prowlarrRef include/exclude lists and records the outcome in the registration status.
+// Failures are reported in the status message but never fail the reconcile.
+func (r *ProwlarrCoordinatorReconciler) syncIndexerPolicy(
+	ctx context.Context,
+	prowlarrConfig *arrv1alpha1.ProwlarrConfig,
+	prowlarrRef *arrv1alpha1.ProwlarrRef,
+	owner client.Object, appType string,
+	conn *arrv1alpha1.ConnectionSpec,
+	registration *arrv1alpha1.ProwlarrRegistration,
+) {
+	log := logf.FromContext(ctx)
+
+	// Policy enforcement talks to the app itself, so it needs the app's full
+	// connection: extra headers, client certificate, CA bundle and timeout
+	resolved, err := r.Helper.ResolveConnectionSecrets(ctx, owner, conn)
+	if err != nil {
+		log.Error(err, "Failed to resolve app connection for indexer policy", "app", appType, "url", conn.URL)
+		registration.Message = fmt.Sprintf("%s; indexer policy check failed: %v", registration.Message, err)
+		return
+	}
+
+	policy := prowlarr.IndexerPolicy{
+		Include: prowlarrRef.Include,
+		Exclude: prowlarrRef.Exclude,
+		Action:  prowlarrRef.OutOfPolicyAction,
+	}
+
+	result, err := prowlarr.EnforceIndexerPolicy(ctx, appType, connectionIR(owner, conn, resolved), prowlarrConfig.Spec.Connection.URL, policy)
+	if err != nil {
+		log.Error(err, "Failed to enforce Prowlarr indexer policy", "app", appType, "url", conn.URL)
+		registration.Message = fmt.Sprintf("%s; indexer policy check failed: %v", registration.Message, err)
+		return
+	}
+
+	registration.SyncedIndexers = result.Synced
+	registration.OutOfPolicyIndexers = result.OutOfPolicy
+
+	if len(result.OutOfPolicy) > 0 {
+		log.Info("Found Prowlarr indexers outside prowlarrRef policy",
+			"app", appType, "indexers", result.OutOfPolicy,
+			"disabled", result.Disabled, "removed", result.Removed)
+		registration.Message = fmt.Sprintf("%s; %d indexer(s) out of policy", registration.Message, len(result.OutOfPolicy))
+	}
+
+	for _, enforceErr := range result.Errors {
+		log.Error(enforceErr, "Failed to enforce indexer policy", "app", appType)
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ProwlarrCoordinatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.Helper == nil {
 		r.Helper = NewReconcileHelper(r.Client)
 	}
+	r.Helper.RestConfig = mgr.GetConfig()
 
 	// Map function to trigger reconcile of ProwlarrConfig when an app config changes
 	mapAppConfigToProwlarr := func(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -370,7 +433,7 @@ func (r *ProwlarrCoordinatorReconciler) SetupWithManager(mgr ctrl.Manager) error
 		return requests
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&arrv1alpha1.ProwlarrConfig{}).
 		Watches(
 			&arrv1alpha1.RadarrConfig{},
@@ -387,7 +450,7 @@ func (r *ProwlarrCoordinatorReconciler) SetupWithManager(mgr ctrl.Manager) error
 		Watches(
 			&arrv1alpha1.ReadarrConfig{},
 			handler.EnqueueRequestsFromMapFunc(mapAppConfigToProwlarr),
-		).
-		Named("prowlarrcoordinator").
-		Complete(r)
+		)
+
+	return r.Options.complete(mgr, b, "prowlarrcoordinator", &arrv1alpha1.ProwlarrConfigList{}, r)
 }
@@ -18,7 +18,9 @@ package controller
 
 import (
 	"context"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,7 +34,6 @@ import (
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	_ "github.com/poiley/nebularr-operator/internal/adapters/prowlarr" // Register prowlarr adapter
 	"github.com/poiley/nebularr-operator/internal/compiler"
-	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
 const prowlarrFinalizer = "prowlarrconfig.arr.rinzler.cloud/finalizer"
@@ -44,6 +45,8 @@ type ProwlarrConfigReconciler struct {
 	Compiler *compiler.Compiler
 	Helper   *ReconcileHelper
 	Recorder record.EventRecorder
+
+	Options ControllerOptions
 }
 
 // +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=prowlarrconfigs,verbs=get;list;watch;create;update;patch;delete
@@ -71,6 +74,23 @@ func (r *ProwlarrConfigReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, nil
 	}
 
+	// Observed configs never write to Prowlarr, so there is nothing to clean up on
+	// deletion. A finalizer added before spec.observe was set is dropped.
+	if config.Spec.Observe {
+		if controllerutil.RemoveFinalizer(config, prowlarrFinalizer) {
+			if err := r.Update(ctx, config); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if !config.DeletionTimestamp.IsZero() {
+			r.Options.forgetNotifications(r.Scheme, config)
+			r.Options.forgetApplySummary(r.Scheme, config)
+			r.Helper.Clusters.Forget(config)
+			return ctrl.Result{}, nil
+		}
+		return r.reconcileNormal(ctx, config)
+	}
+
 	// Handle deletion
 	if !config.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, config)
@@ -96,8 +116,26 @@ func (r *ProwlarrConfigReconciler) reconcileNormal(ctx context.Context, config *
 
 	statusWrapper := &ProwlarrStatusWrapper{Status: &config.Status}
 
+	// Fill in download clients from the DownloadStackConfig clients they reference.
+	// The spec is only changed in memory, before the status comparison copy is taken.
+	clients, err := r.Helper.ResolveDownloadClientRefs(ctx, config.Namespace, config.Spec.DownloadClients)
+	if err != nil {
+		r.Helper.SetCompileFailure(statusWrapper, config.Generation, err)
+		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+	}
+	config.Spec.DownloadClients = clients
+	original := config.DeepCopy()
+
+	// Report Ready transitions, repeated drift and failing applies once the reconcile finishes
+	outcome := newReconcileOutcome(statusWrapper)
+	defer r.Options.notifyOutcome(ctx, r.Scheme, config, statusWrapper, outcome)
+	defer r.Options.recordApplySummary(r.Scheme, config, outcome)
+
 	// Resolve secrets
-	resolvedSecrets, err := r.Helper.ResolveConnectionSecrets(ctx, config.Namespace, &config.Spec.Connection)
+	resolvedSecrets, err := r.Helper.ResolveConnectionSecrets(ctx, config, &config.Spec.Connection)
 	if err != nil {
 		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "SecretResolutionFailed", err.Error())
 		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
@@ -143,10 +181,7 @@ func (r *ProwlarrConfigReconciler) reconcileNormal(ctx context.Context, config *
 	}
 
 	// Create connection IR
-	connIR := &irv1.ConnectionIR{
-		URL:    config.Spec.Connection.URL,
-		APIKey: resolvedSecrets["apiKey"],
-	}
+	connIR := connectionIR(config, &config.Spec.Connection, resolvedSecrets)
 
 	// Get capabilities for compilation
 	adapter, ok := adapters.Get(adapters.AppProwlarr)
@@ -160,33 +195,89 @@ func (r *ProwlarrConfigReconciler) reconcileNormal(ctx context.Context, config *
 
 	caps, err := adapter.Discover(ctx, connIR)
 	if err != nil {
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "DiscoveryFailed", err.Error())
+		if requeueAfter, ok := r.Helper.HandleUnavailable(ctx, config, &config.Spec.Connection, adapters.AppProwlarr, statusWrapper, config.Generation, err); ok {
+			r.updateStatusIfChanged(ctx, config, original)
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, errorReason(err, "DiscoveryFailed"), err.Error())
 		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
 		}
-		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+		return errorRequeue(err)
 	}
 
 	// Compile CRD to IR
 	desiredIR, err := r.Compiler.CompileProwlarrConfig(ctx, config, resolvedSecrets, caps)
 	if err != nil {
 		log.Error(err, "Failed to compile ProwlarrConfig to IR")
-		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "CompilationFailed", err.Error())
+		r.Helper.SetCompileFailure(statusWrapper, config.Generation, err)
 		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
 		}
 		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 	}
+	statusWrapper.SetInvalidFields(nil)
 
-	// Reconcile using helper
-	_, err = r.Helper.ReconcileConfig(ctx, adapters.AppProwlarr, connIR, desiredIR, statusWrapper, config.Generation)
+	// Evaluate the apply window (changes are held back while it is closed)
+	window, err := EvaluateApplyWindow(config.Spec.Reconciliation, time.Now())
 	if err != nil {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, "InvalidApplyWindow", err.Error())
 		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
 			log.Error(statusErr, "Failed to update status")
 		}
 		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
 	}
 
+	// Observed configs report drift but never apply it (spec.observe)
+	if config.Spec.Observe {
+		window = ApplyWindowState{Observe: true}
+	}
+
+	// Hold back download clients whose DownloadStackConfig is not Ready yet
+	holds := r.Helper.CheckDownloadClientDependencies(ctx, config.Namespace, config.Name, config.Spec.DownloadClients, statusWrapper, config.Generation)
+
+	// Disable indexers that fail too often and keep them disabled until their cooldown ends
+	if window.Open {
+		config.Status.IndexerHealth = r.Helper.CheckIndexerHealth(ctx, connIR, config, &config.Spec, config.Status.IndexerHealth, r.Recorder)
+	}
+	holdDisabledIndexers(desiredIR, config.Name, config.Status.IndexerHealth)
+
+	// Switch indexers whose current API key is rejected to their next key
+	secretSalt := compiler.SecretSalt(r.Options.SecretKey, config.UID)
+	if window.Open {
+		config.Status.IndexerKeys = r.Helper.SelectIndexerKeys(ctx, connIR, config, desiredIR, config.Status.IndexerKeys, secretSalt, r.Recorder)
+	}
+	useIndexerKeys(desiredIR, config.Status.IndexerKeys, secretSalt)
+
+	// Paused entries are left out of the diff; list them so they aren't forgotten
+	config.Status.PausedResources = prowlarrPausedResources(&config.Spec)
+
+	// Reconcile using helper
+	result, err := r.Helper.ReconcileConfig(ctx, adapters.AppProwlarr, connIR, desiredIR, statusWrapper, config.Generation, window, nil, holds)
+	outcome.recordSync(result, err, window)
+	if err != nil {
+		if requeueAfter, ok := r.Helper.HandleUnavailable(ctx, config, &config.Spec.Connection, adapters.AppProwlarr, statusWrapper, config.Generation, err); ok {
+			r.updateStatusIfChanged(ctx, config, original)
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return errorRequeue(err)
+	}
+
+	// Send raw requests for settings the operator doesn't model
+	if window.Open {
+		config.Status.RawRequests = r.Helper.ApplyRawRequests(ctx, adapters.AppProwlarr, connIR, config.Spec.Raw, config.Status.RawRequests, statusWrapper, config.Generation)
+
+		// Tell dependent workloads the configuration is in place (spec.reconciliation.readinessConfigMap)
+		if result.Success() && !holds.Pending() {
+			if err := r.Helper.PublishReadiness(ctx, config, config.Spec.Reconciliation); err != nil {
+				log.Error(err, "Failed to publish readiness (non-fatal)")
+			}
+		}
+	}
+
 	// Check health and emit events for any issues
 	healthStatus := r.Helper.CheckAndReportHealth(ctx, adapters.AppProwlarr, connIR, config, r.Recorder)
 	if healthStatus != nil {
@@ -204,25 +295,63 @@ func (r *ProwlarrConfigReconciler) reconcileNormal(ctx context.Context, config *
 	if config.Spec.Reconciliation != nil && config.Spec.Reconciliation.Interval != nil {
 		requeueAfter = config.Spec.Reconciliation.Interval.Duration
 	}
+	requeueAfter = r.Options.requeueAfter(requeueAfter)
+	requeueAfter = window.RequeueAfter(requeueAfter, time.Now())
+	requeueAfter = holds.RequeueAfter(requeueAfter)
+	requeueAfter = indexerHealthRequeueAfter(requeueAfter, config.Status.IndexerHealth, time.Now())
 
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// prowlarrPausedResources lists the indexers, proxies, applications and download clients of spec with pause set
+func prowlarrPausedResources(spec *arrv1alpha1.ProwlarrConfigSpec) []string {
+	var paused []string
+	for _, idx := range spec.Indexers {
+		if idx.Pause {
+			paused = append(paused, "indexer/"+idx.Name)
+		}
+	}
+	for _, proxy := range spec.Proxies {
+		if proxy.Pause {
+			paused = append(paused, "proxy/"+proxy.Name)
+		}
+	}
+	for _, app := range spec.Applications {
+		if app.Pause {
+			paused = append(paused, "application/"+app.Name)
+		}
+	}
+	for _, dc := range spec.DownloadClients {
+		if dc.Pause {
+			paused = append(paused, "downloadclient/"+dc.Name)
+		}
+	}
+	return paused
+}
+
+// updateStatusIfChanged updates the status unless the reconcile left the config as it was
+func (r *ProwlarrConfigReconciler) updateStatusIfChanged(ctx context.Context, config, original *arrv1alpha1.ProwlarrConfig) {
+	if equality.Semantic.DeepEqual(original, config) {
+		return
+	}
+	if err := r.Status().Update(ctx, config); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to update status")
+	}
+}
+
 // reconcileDelete handles deletion of the ProwlarrConfig
 func (r *ProwlarrConfigReconciler) reconcileDelete(ctx context.Context, config *arrv1alpha1.ProwlarrConfig) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 	log.Info("Handling deletion of ProwlarrConfig", "name", config.Name)
 
-	// Try to resolve secrets for cleanup
-	resolvedSecrets, err := r.Helper.ResolveConnectionSecrets(ctx, config.Namespace, &config.Spec.Connection)
-	if err != nil {
+	// A protected config only drops its finalizer; the service is left as it is
+	if arrv1alpha1.IsDeleteProtected(config) {
+		warnDeleteProtected(ctx, r.Recorder, config)
+	} else if resolvedSecrets, err := r.Helper.ResolveConnectionSecrets(ctx, config, &config.Spec.Connection); err != nil {
 		log.Error(err, "Failed to resolve secrets for cleanup, proceeding anyway")
 	} else {
-		connIR := &irv1.ConnectionIR{
-			URL:    config.Spec.Connection.URL,
-			APIKey: resolvedSecrets["apiKey"],
-		}
-		if err := r.Helper.CleanupManagedResources(ctx, adapters.AppProwlarr, connIR); err != nil {
+		connIR := connectionIR(config, &config.Spec.Connection, resolvedSecrets)
+		if err := r.Helper.CleanupManagedResources(ctx, adapters.AppProwlarr, connIR, nil, ""); err != nil {
 			log.Error(err, "Failed to cleanup managed resources")
 		}
 	}
@@ -232,6 +361,9 @@ func (r *ProwlarrConfigReconciler) reconcileDelete(ctx context.Context, config *
 	if err := r.Update(ctx, config); err != nil {
 		return ctrl.Result{}, err
 	}
+	r.Options.forgetNotifications(r.Scheme, config)
+	r.Options.forgetApplySummary(r.Scheme, config)
+	r.Helper.Clusters.Forget(config)
 
 	log.Info("Successfully deleted ProwlarrConfig", "name", config.Name)
 	return ctrl.Result{}, nil
@@ -242,14 +374,17 @@ func (r *ProwlarrConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// Initialize compiler if not set
 	if r.Compiler == nil {
 		r.Compiler = compiler.New()
+		r.Compiler.SecretKey = r.Options.SecretKey
 	}
 	// Initialize helper if not set
 	if r.Helper == nil {
 		r.Helper = NewReconcileHelper(r.Client)
 	}
+	r.Helper.RestConfig = mgr.GetConfig()
+	r.Helper.LogDiffs = r.Options.LogDiffs
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.ProwlarrConfig{})
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&arrv1alpha1.ProwlarrConfig{}).
-		Named("prowlarrconfig").
-		Complete(r)
+	return r.Options.complete(mgr, b, "prowlarrconfig", &arrv1alpha1.ProwlarrConfigList{}, r)
 }
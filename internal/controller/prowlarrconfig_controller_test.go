@@ -21,6 +21,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -28,6 +29,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/compiler"
 )
 
 var _ = Describe("ProwlarrConfig Controller", func() {
@@ -85,4 +88,51 @@ var _ = Describe("ProwlarrConfig Controller", func() {
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
 	})
+
+	It("reports drift without a finalizer or writes when observe is set", func() {
+		ctx := context.Background()
+		mockAdapter := SetupMockAdapter(adapters.AppProwlarr)
+		DeferCleanup(CleanupAdapters)
+		mockAdapter.WithChanges(&adapters.ChangeSet{
+			Creates: []adapters.Change{{ResourceType: adapters.ResourceIndexer, Name: "nebularr-observed-nyaa"}},
+		})
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "observed-prowlarr-api-key", Namespace: "default"},
+			StringData: map[string]string{"apiKey": "test-api-key"},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, secret) })
+
+		config := &arrv1alpha1.ProwlarrConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "observed-prowlarr", Namespace: "default"},
+			Spec: arrv1alpha1.ProwlarrConfigSpec{
+				Observe: true,
+				Connection: arrv1alpha1.ConnectionSpec{
+					URL:             "http://prowlarr.example.com:9696",
+					APIKeySecretRef: &arrv1alpha1.SecretKeySelector{Name: secret.Name, Key: "apiKey"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, config)).To(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, config) })
+
+		reconciler := &ProwlarrConfigReconciler{
+			Client:   k8sClient,
+			Scheme:   k8sClient.Scheme(),
+			Helper:   NewReconcileHelper(k8sClient),
+			Compiler: compiler.New(),
+		}
+		key := types.NamespacedName{Name: config.Name, Namespace: "default"}
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockAdapter.CallCounts()["Apply"]).To(BeZero())
+		Expect(mockAdapter.ApplyDirectCalls).To(BeEmpty())
+
+		updated := &arrv1alpha1.ProwlarrConfig{}
+		Expect(k8sClient.Get(ctx, key, updated)).To(Succeed())
+		Expect(updated.Finalizers).To(BeEmpty())
+		Expect(HasCondition(updated.Status.Conditions, ConditionTypePendingChanges, metav1.ConditionTrue)).To(BeTrue())
+	})
 })
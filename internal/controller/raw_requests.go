@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// ConditionTypeRawApplied reports whether all spec.raw requests were sent successfully
+const ConditionTypeRawApplied = "RawApplied"
+
+// ApplyRawRequests sends the spec.raw entries that changed since they were last
+// sent successfully, and returns the updated raw request status.
+// Failed entries keep their previous status so they are retried on the next reconcile.
+func (h *ReconcileHelper) ApplyRawRequests(
+	ctx context.Context,
+	appType string,
+	connIR *irv1.ConnectionIR,
+	raw []arrv1alpha1.RawRequestSpec,
+	previous []arrv1alpha1.RawRequestStatus,
+	status ConfigStatus,
+	generation int64,
+) []arrv1alpha1.RawRequestStatus {
+	log := logf.FromContext(ctx)
+
+	if len(raw) == 0 {
+		conditions := status.GetConditions()
+		meta.RemoveStatusCondition(&conditions, ConditionTypeRawApplied)
+		status.SetConditions(conditions)
+		return nil
+	}
+
+	adapter, ok := adapters.Get(appType)
+	if !ok {
+		return previous
+	}
+	requester, ok := adapter.(adapters.RawRequester)
+	if !ok {
+		h.SetCondition(status, generation, ConditionTypeRawApplied, metav1.ConditionFalse, "NotSupported",
+			fmt.Sprintf("%s adapter does not support raw requests", appType))
+		return previous
+	}
+
+	previousByName := make(map[string]arrv1alpha1.RawRequestStatus, len(previous))
+	for _, p := range previous {
+		previousByName[p.Name] = p
+	}
+
+	var result []arrv1alpha1.RawRequestStatus
+	var failures []string
+	sent := 0
+	for _, req := range raw {
+		hash := rawRequestHash(req)
+		prev, hasPrev := previousByName[req.Name]
+		if hasPrev && prev.Hash == hash {
+			result = append(result, prev)
+			continue
+		}
+
+		method := defaultRawMethod(req.Method)
+		if err := requester.SendRaw(ctx, connIR, method, req.Path, []byte(req.Body)); err != nil {
+			log.Error(err, "Raw request failed", "name", req.Name, "method", method, "path", req.Path)
+			failures = append(failures, fmt.Sprintf("%s: %v", req.Name, err))
+			if hasPrev {
+				result = append(result, prev)
+			}
+			continue
+		}
+
+		log.Info("Sent raw request", "name", req.Name, "method", method, "path", req.Path)
+		now := metav1.Now()
+		result = append(result, arrv1alpha1.RawRequestStatus{Name: req.Name, Hash: hash, LastApplied: &now})
+		sent++
+	}
+
+	if len(failures) > 0 {
+		h.SetCondition(status, generation, ConditionTypeRawApplied, metav1.ConditionFalse, "RequestFailed", strings.Join(failures, "; "))
+	} else {
+		h.SetCondition(status, generation, ConditionTypeRawApplied, metav1.ConditionTrue, "Applied",
+			fmt.Sprintf("%d raw requests applied (%d sent this reconcile)", len(raw), sent))
+	}
+
+	return result
+}
+
+// rawRequestHash identifies the content of a raw request
+func rawRequestHash(req arrv1alpha1.RawRequestSpec) string {
+	data := strings.Join([]string{defaultRawMethod(req.Method), req.Path, req.Body, req.Revision}, "\n")
+	hash := sha256.Sum256([]byte(data))
+	return fmt.Sprintf("%x", hash[:8])
+}
+
+// defaultRawMethod returns the request method, defaulting to PUT
+func defaultRawMethod(method string) string {
+	if method == "" {
+		return "PUT"
+	}
+	return strings.ToUpper(method)
+}
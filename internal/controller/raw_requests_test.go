@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/mock"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// rawAdapter adds the RawRequester interface to the mock adapter
+type rawAdapter struct {
+	*mock.Adapter
+	sent []string
+	fail map[string]bool
+}
+
+func (a *rawAdapter) SendRaw(_ context.Context, _ *irv1.ConnectionIR, method, path string, _ []byte) error {
+	a.sent = append(a.sent, method+" "+path)
+	if a.fail[path] {
+		return errors.New("400 Bad Request")
+	}
+	return nil
+}
+
+var _ = Describe("Raw requests", func() {
+	const appType = "raw-requests-test"
+	ctx := context.Background()
+
+	var (
+		requester *rawAdapter
+		helper    *ReconcileHelper
+		status    *RadarrStatusWrapper
+	)
+
+	BeforeEach(func() {
+		requester = &rawAdapter{Adapter: mock.NewAdapter(appType), fail: map[string]bool{}}
+		adapters.RegisterOrReplace(requester)
+		DeferCleanup(func() { adapters.Unregister(appType) })
+		helper = &ReconcileHelper{}
+		status = &RadarrStatusWrapper{Status: &arrv1alpha1.RadarrConfigStatus{}}
+	})
+
+	raw := []arrv1alpha1.RawRequestSpec{
+		{Name: "ui", Path: "/api/v3/config/ui/1", Body: `{"theme":"dark"}`},
+		{Name: "restart", Method: "post", Path: "/api/v3/system/restart"},
+	}
+
+	It("sends each request once until it changes", func() {
+		results := helper.ApplyRawRequests(ctx, appType, &irv1.ConnectionIR{}, raw, nil, status, 1)
+		Expect(requester.sent).To(Equal([]string{"PUT /api/v3/config/ui/1", "POST /api/v3/system/restart"}))
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Hash).To(Equal(rawRequestHash(raw[0])))
+		Expect(results[0].LastApplied).NotTo(BeNil())
+		cond := meta.FindStatusCondition(status.GetConditions(), ConditionTypeRawApplied)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+
+		By("skipping unchanged requests")
+		results = helper.ApplyRawRequests(ctx, appType, &irv1.ConnectionIR{}, raw, results, status, 2)
+		Expect(requester.sent).To(HaveLen(2))
+
+		By("sending a request again when its revision changes")
+		changed := append([]arrv1alpha1.RawRequestSpec{}, raw...)
+		changed[1].Revision = "2"
+		helper.ApplyRawRequests(ctx, appType, &irv1.ConnectionIR{}, changed, results, status, 3)
+		Expect(requester.sent).To(HaveLen(3))
+		Expect(requester.sent[2]).To(Equal("POST /api/v3/system/restart"))
+	})
+
+	It("keeps the previous status of a failed request so it is retried", func() {
+		results := helper.ApplyRawRequests(ctx, appType, &irv1.ConnectionIR{}, raw, nil, status, 1)
+
+		requester.fail["/api/v3/config/ui/1"] = true
+		changed := append([]arrv1alpha1.RawRequestSpec{}, raw...)
+		changed[0].Body = `{"theme":"light"}`
+		retried := helper.ApplyRawRequests(ctx, appType, &irv1.ConnectionIR{}, changed, results, status, 2)
+		Expect(retried[0]).To(Equal(results[0]))
+
+		cond := meta.FindStatusCondition(status.GetConditions(), ConditionTypeRawApplied)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal("RequestFailed"))
+		Expect(cond.Message).To(ContainSubstring("ui: 400 Bad Request"))
+
+		By("removing the condition once spec.raw is empty")
+		Expect(helper.ApplyRawRequests(ctx, appType, &irv1.ConnectionIR{}, nil, retried, status, 3)).To(BeNil())
+		Expect(meta.FindStatusCondition(status.GetConditions(), ConditionTypeRawApplied)).To(BeNil())
+	})
+
+	It("reports adapters that can't send raw requests", func() {
+		const plainApp = "raw-requests-plain-test"
+		adapters.RegisterOrReplace(mock.NewAdapter(plainApp))
+		DeferCleanup(func() { adapters.Unregister(plainApp) })
+
+		previous := []arrv1alpha1.RawRequestStatus{{Name: "ui", Hash: "abc"}}
+		Expect(helper.ApplyRawRequests(ctx, plainApp, &irv1.ConnectionIR{}, raw, previous, status, 1)).To(Equal(previous))
+		cond := meta.FindStatusCondition(status.GetConditions(), ConditionTypeRawApplied)
+		Expect(cond.Reason).To(Equal("NotSupported"))
+	})
+
+	It("hashes the method, path, body and revision", func() {
+		base := arrv1alpha1.RawRequestSpec{Name: "ui", Path: "/api/v3/config/ui/1", Body: "{}"}
+		Expect(rawRequestHash(base)).To(HaveLen(16))
+
+		put := base
+		put.Method = "put"
+		Expect(rawRequestHash(put)).To(Equal(rawRequestHash(base)), "PUT is the default method")
+
+		renamed := base
+		renamed.Name = "other"
+		Expect(rawRequestHash(renamed)).To(Equal(rawRequestHash(base)), "the name isn't part of the content")
+
+		for _, change := range []func(*arrv1alpha1.RawRequestSpec){
+			func(r *arrv1alpha1.RawRequestSpec) { r.Method = "POST" },
+			func(r *arrv1alpha1.RawRequestSpec) { r.Path = "/api/v3/config/ui/2" },
+			func(r *arrv1alpha1.RawRequestSpec) { r.Body = `{"theme":"dark"}` },
+			func(r *arrv1alpha1.RawRequestSpec) { r.Revision = "2" },
+		} {
+			changed := base
+			change(&changed)
+			Expect(rawRequestHash(changed)).NotTo(Equal(rawRequestHash(base)))
+		}
+	})
+})
@@ -41,6 +41,8 @@ type ReadarrConfigReconciler struct {
 
 	// generic holds the shared reconciliation logic
 	generic *GenericArrReconciler
+
+	Options ControllerOptions
 }
 
 // +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=readarrconfigs,verbs=get;list;watch;create;update;patch;delete
@@ -60,6 +62,7 @@ func (r *ReadarrConfigReconciler) ensureInitialized() {
 	}
 	if r.Compiler == nil {
 		r.Compiler = compiler.New()
+		r.Compiler.SecretKey = r.Options.SecretKey
 	}
 	if r.Helper == nil {
 		r.Helper = NewReconcileHelper(r.Client)
@@ -70,6 +73,7 @@ func (r *ReadarrConfigReconciler) ensureInitialized() {
 		Compiler: r.Compiler,
 		Helper:   r.Helper,
 		Recorder: r.Recorder,
+		Options:  r.Options,
 		CompileConfig: func(ctx context.Context, c *compiler.Compiler, config ArrConfigObject, secrets map[string]string, caps *adapters.Capabilities) (*irv1.IR, error) {
 			readarrConfig := config.(*ReadarrConfigAdapter).ReadarrConfig
 			return c.CompileReadarrConfig(ctx, readarrConfig, secrets, caps)
@@ -80,9 +84,11 @@ func (r *ReadarrConfigReconciler) ensureInitialized() {
 // SetupWithManager sets up the controller with the Manager.
 func (r *ReadarrConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ensureInitialized()
+	r.Helper.RestConfig = mgr.GetConfig()
+	r.Helper.LogDiffs = r.Options.LogDiffs
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.ReadarrConfig{})
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&arrv1alpha1.ReadarrConfig{}).
-		Named("readarrconfig").
-		Complete(r)
+	return r.Options.complete(mgr, b, "readarrconfig", &arrv1alpha1.ReadarrConfigList{}, r)
 }
@@ -307,6 +307,12 @@ var _ = Describe("ReadarrConfig Controller", func() {
 					{ResourceType: adapters.ResourceQualityProfile, Name: "Standard"},
 				},
 			})
+			profileID := 4
+			mockAdapter.WithManagedRefs(&adapters.ManagedRefs{
+				QualityProfileID:  &profileID,
+				QualityProfileIDs: []int{profileID},
+				DownloadClientIDs: []int{2, 7},
+			})
 
 			By("Creating the ReadarrConfig resource")
 			Expect(k8sClient.Create(ctx, readarrConfig)).To(Succeed())
@@ -325,6 +331,13 @@ var _ = Describe("ReadarrConfig Controller", func() {
 
 			By("Checking that Apply was called")
 			Expect(mockAdapter.CallCounts()["Apply"]).To(BeNumerically(">=", 1))
+
+			By("Checking the remote IDs were recorded")
+			updatedConfig := &arrv1alpha1.ReadarrConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updatedConfig)).To(Succeed())
+			Expect(updatedConfig.Status.ManagedResources.QualityProfileID).To(HaveValue(Equal(profileID)))
+			Expect(updatedConfig.Status.ManagedResources.QualityProfileIDs).To(Equal([]int{profileID}))
+			Expect(updatedConfig.Status.ManagedResources.DownloadClientIDs).To(Equal([]int{2, 7}))
 		})
 
 		It("should respect reconciliation suspend flag", func() {
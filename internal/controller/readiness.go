@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
+
+// Keys of the readiness ConfigMap (spec.reconciliation.readinessConfigMap)
+const (
+	readinessKeyApplied    = "applied"
+	readinessKeyGeneration = "generation"
+	readinessKeyAppliedAt  = "appliedAt"
+)
+
+// PublishReadiness writes the readiness ConfigMap of a config whose
+// configuration was just applied in full. The ConfigMap is owned by the config
+// and only rewritten when a new generation is applied, so later failures never
+// withdraw it: it records that the configuration was applied at least once.
+func (h *ReconcileHelper) PublishReadiness(ctx context.Context, owner client.Object, spec *arrv1alpha1.ReconciliationSpec) error {
+	if spec == nil || spec.ReadinessConfigMap == "" || owner.GetUID() == "" {
+		return nil
+	}
+
+	generation := strconv.FormatInt(owner.GetGeneration(), 10)
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: spec.ReadinessConfigMap, Namespace: owner.GetNamespace()}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.Client, cm, func() error {
+		if err := controllerutil.SetControllerReference(owner, cm, h.Client.Scheme()); err != nil {
+			return err
+		}
+		if cm.Data[readinessKeyApplied] == "true" && cm.Data[readinessKeyGeneration] == generation {
+			return nil
+		}
+		cm.Data = map[string]string{
+			readinessKeyApplied:    "true",
+			readinessKeyGeneration: generation,
+			readinessKeyAppliedAt:  time.Now().UTC().Format(time.RFC3339),
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to publish readiness ConfigMap %s: %w", spec.ReadinessConfigMap, err)
+	}
+	return nil
+}
@@ -18,20 +18,30 @@ package controller
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"maps"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
 	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+	"github.com/poiley/nebularr-operator/internal/ir/conversion"
 	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 	"github.com/poiley/nebularr-operator/internal/metrics"
 	"github.com/poiley/nebularr-operator/internal/prowlarr"
@@ -43,9 +53,24 @@ const (
 	ConditionTypeConnected = "Connected"
 	ConditionTypeSynced    = "Synced"
 
+	// ConditionTypeNotificationsVerified reports whether every notification passed its connection test
+	ConditionTypeNotificationsVerified = "NotificationsVerified"
+
 	// Default requeue intervals
 	DefaultRequeueInterval = 5 * time.Minute
 	ErrorRequeueInterval   = 30 * time.Second
+
+	// MaxConnectionBackoff caps the requeue interval while a service is unavailable
+	MaxConnectionBackoff = 5 * time.Minute
+
+	// DefaultDownloadStackRequeueInterval is the DownloadStackConfig default;
+	// download client settings rarely drift
+	DefaultDownloadStackRequeueInterval = 30 * time.Minute
+
+	// DefaultTrackerRulesInterval caps the DownloadStackConfig interval while
+	// qBittorrent tracker rules or Transmission torrent policies are declared,
+	// so new torrents are handled soon
+	DefaultTrackerRulesInterval = 10 * time.Minute
 )
 
 // ConfigStatus is an interface for updating status on *arr config resources
@@ -56,30 +81,74 @@ type ConfigStatus interface {
 	SetServiceVersion(version string)
 	SetLastReconcile(t *metav1.Time)
 	SetLastAppliedHash(hash string)
+	SetCompileResult(summary *arrv1alpha1.CompiledSummary, unrealized []arrv1alpha1.UnrealizedFeature)
+	SetInvalidFields(fields []arrv1alpha1.InvalidField)
+	GetSecretHashes() map[string]string
+	SetSecretHashes(hashes map[string]string)
+	GetIRSchemaVersion() string
+	SetIRSchemaVersion(version string)
+}
+
+// SyncedConfigStatus is the status of a config synced through ReconcileConfig
+type SyncedConfigStatus interface {
+	ConfigStatus
+	SetConvergence(converged *bool, residual []arrv1alpha1.ResidualChange)
+	SetManagedRefs(refs *adapters.ManagedRefs)
 }
 
 // ReconcileHelper provides shared reconciliation logic for all *arr controllers
 type ReconcileHelper struct {
 	Client client.Client
+
+	// RestConfig enables API key discovery through pod exec or PVC Jobs (nil disables them)
+	RestConfig *rest.Config
+
+	// Clusters caches the clients of connections targeting another cluster
+	// (nil builds a new client for every lookup)
+	Clusters *ClusterClients
+
+	// LogDiffs logs the fields each update changes, with credentials redacted
+	LogDiffs bool
 }
 
 // NewReconcileHelper creates a new ReconcileHelper
 func NewReconcileHelper(c client.Client) *ReconcileHelper {
-	return &ReconcileHelper{Client: c}
+	return &ReconcileHelper{Client: c, Clusters: NewClusterClients()}
 }
 
-// ReconcileConfig performs the common reconciliation flow for any *arr config
+// ReconcileConfig performs the common reconciliation flow for any *arr config.
+// Drift is always detected; changes are only applied while the apply window is open
+// and no RolloutPolicy holds them back. Subsystems outside scope and resources
+// waiting for their dependencies are not diffed. Applied changes are verified by
+// diffing again, and status.converged reports whether anything is left.
 func (h *ReconcileHelper) ReconcileConfig(
 	ctx context.Context,
 	appType string,
 	connIR *irv1.ConnectionIR,
 	desiredIR *irv1.IR,
-	status ConfigStatus,
+	status SyncedConfigStatus,
 	generation int64,
+	window ApplyWindowState,
+	scope ManageScope,
+	holds DependencyHolds,
 ) (*adapters.ApplyResult, error) {
 	log := logf.FromContext(ctx)
 	startTime := time.Now()
 
+	// Remember every credential in the spec, including those not applied below
+	specSecretHashes := collectSecretHashes(desiredIR)
+	maps.Copy(specSecretHashes, collectImportListSecretHashes(desiredIR))
+
+	// Leave subsystems the operator doesn't manage alone
+	scope.Restrict(desiredIR)
+
+	// Surface what was compiled (and what was pruned) regardless of the sync outcome
+	summary, unrealized := summarizeIR(desiredIR)
+	status.SetCompileResult(summary, unrealized)
+
+	// Leave resources alone until the objects they depend on are Ready
+	holds.Hold(desiredIR)
+
 	// Get the adapter
 	adapter, ok := adapters.Get(appType)
 	if !ok {
@@ -93,9 +162,10 @@ func (h *ReconcileHelper) ReconcileConfig(
 	serviceInfo, err := adapter.Connect(ctx, connIR)
 	if err != nil {
 		log.Error(err, "Failed to connect to service", "app", appType)
+		reason := connectionFailureReason(err)
 		status.SetConnected(false)
-		h.SetCondition(status, generation, ConditionTypeConnected, metav1.ConditionFalse, "ConnectionFailed", err.Error())
-		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, "ConnectionFailed", fmt.Sprintf("Cannot connect to %s", appType))
+		h.SetCondition(status, generation, ConditionTypeConnected, metav1.ConditionFalse, reason, err.Error())
+		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, reason, fmt.Sprintf("Cannot connect to %s", appType))
 		metrics.RecordConnectionStatus(appType, connIR.URL, false)
 		metrics.RecordSyncFailure(appType, "connection_failed", time.Since(startTime).Seconds())
 		return nil, err
@@ -113,7 +183,7 @@ func (h *ReconcileHelper) ReconcileConfig(
 	caps, err := adapter.Discover(ctx, connIR)
 	if err != nil {
 		log.Error(err, "Failed to discover capabilities", "app", appType)
-		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, "DiscoveryFailed", err.Error())
+		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, errorReason(err, "DiscoveryFailed"), err.Error())
 		return nil, err
 	}
 
@@ -121,17 +191,59 @@ func (h *ReconcileHelper) ReconcileConfig(
 	currentIR, err := adapter.CurrentState(ctx, connIR)
 	if err != nil {
 		log.Error(err, "Failed to get current state", "app", appType)
-		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, "StateFetchFailed", err.Error())
+		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, errorReason(err, "StateFetchFailed"), err.Error())
 		return nil, err
 	}
 
+	scope.Restrict(currentIR)
+	holds.Hold(currentIR)
+	recordedHashes, err := conversion.SecretHashes(status.GetIRSchemaVersion(), status.GetSecretHashes())
+	if err != nil {
+		// Hashes from a newer operator can't be compared; resources take new ones on their next apply
+		log.Info("Ignoring recorded secret hashes", "reason", err.Error())
+		recordedHashes = nil
+	}
+	recordedHashes = dropUnkeyedSecretHashes(recordedHashes)
+	RestoreSecretHashes(currentIR, recordedHashes)
+
 	// Compute diff
 	changes, err := adapter.Diff(currentIR, desiredIR, caps)
 	if err != nil {
 		log.Error(err, "Failed to compute diff", "app", appType)
-		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, "DiffFailed", err.Error())
+		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, errorReason(err, "DiffFailed"), err.Error())
 		return nil, err
 	}
+	if h.LogDiffs {
+		logUpdateDiffs(ctx, currentIR, changes)
+	}
+
+	// Hold back changes outside the apply window or until a rollout releases them
+	if !changes.IsEmpty() && !window.Open {
+		message := window.PendingMessage(fmt.Sprintf("%d changes", changes.TotalChanges()))
+		pendingReason, syncedReason, waitingFor := window.pendingReasons()
+		log.Info("Deferring changes", "changes", changes.TotalChanges(), "waitingFor", waitingFor, "nextWindow", window.NextOpen)
+		for _, change := range changes.Creates {
+			metrics.RecordConfigDrift(appType, change.ResourceType)
+		}
+		for _, change := range changes.Updates {
+			metrics.RecordConfigDrift(appType, change.ResourceType)
+		}
+
+		recordManagedResourceCounts(appType, connIR.URL, currentIR)
+
+		h.SetCondition(status, generation, ConditionTypePendingChanges, metav1.ConditionTrue, pendingReason, message)
+		h.SetCondition(status, generation, ConditionTypeSynced, metav1.ConditionFalse, syncedReason, message)
+		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionTrue, syncedReason, "Configuration drift detected, waiting for "+waitingFor)
+		setConvergence(status, changes)
+		now := metav1.Now()
+		status.SetLastReconcile(&now)
+		return &adapters.ApplyResult{Diff: changes}, nil
+	}
+	// Outside the window direct settings, raw requests and maintenance are held
+	// back without being diffed, so an empty diff doesn't clear the condition
+	if window.Open {
+		h.clearPendingChanges(status)
+	}
 
 	// Apply changes if needed
 	var result *adapters.ApplyResult
@@ -147,11 +259,24 @@ func (h *ReconcileHelper) ReconcileConfig(
 		}
 
 		result, err = adapter.Apply(ctx, connIR, changes)
+		if result != nil {
+			result.Diff = changes
+			for _, d := range result.Durations {
+				metrics.RecordApplyDuration(appType, d.Action, d.ResourceType, d.Duration.Seconds())
+			}
+		}
 		if err != nil {
 			log.Error(err, "Failed to apply changes")
-			h.SetCondition(status, generation, ConditionTypeSynced, metav1.ConditionFalse, "ApplyFailed", err.Error())
-			h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, "ApplyFailed",
-				fmt.Sprintf("Applied %d/%d changes", result.Applied, changes.TotalChanges()))
+			// Adapters return no result when they fail before applying anything
+			applied := 0
+			if result != nil {
+				applied = result.Applied
+			}
+			reason := errorReason(err, "ApplyFailed")
+			h.SetCondition(status, generation, ConditionTypeSynced, metav1.ConditionFalse, reason, err.Error())
+			h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, reason,
+				fmt.Sprintf("Applied %d/%d changes", applied, changes.TotalChanges()))
+			setConvergence(status, changes)
 			metrics.RecordSyncFailure(appType, "apply_failed", time.Since(startTime).Seconds())
 			return result, err
 		}
@@ -185,23 +310,58 @@ func (h *ReconcileHelper) ReconcileConfig(
 	} else {
 		log.Info("No changes to apply, state is in sync")
 		h.SetCondition(status, generation, ConditionTypeSynced, metav1.ConditionTrue, "InSync", "Configuration is in sync")
-		result = &adapters.ApplyResult{Applied: 0}
+		result = &adapters.ApplyResult{Applied: 0, Diff: changes}
+	}
+
+	// Failed updates keep the old hashes, so they are retried
+	secretHashes := recordedHashes
+	if result.Success() {
+		secretHashes = nextSecretHashes(recordedHashes, collectSecretHashes(desiredIR), specSecretHashes)
+	}
+
+	// Check that the applied changes stuck. The state read back replaces the one
+	// read before the apply, so it has the IDs of anything just created.
+	appliedIR := currentIR
+	if changes.IsEmpty() {
+		setConvergence(status, changes)
+	} else if residual, verifiedIR, err := verifyConvergence(ctx, adapter, connIR, desiredIR, caps, scope, holds, secretHashes); err != nil {
+		log.Error(err, "Failed to verify convergence", "app", appType)
+		status.SetConvergence(nil, nil)
+		appliedIR = nil
+	} else {
+		if !residual.IsEmpty() {
+			log.Info("Changes remain after apply", "creates", len(residual.Creates), "updates", len(residual.Updates), "deletes", len(residual.Deletes))
+		}
+		setConvergence(status, residual)
+		appliedIR = verifiedIR
+	}
+
+	// Record the IDs the declared resources have in the app. They are unknown
+	// when the state couldn't be read back, so the recorded ones are kept.
+	if reader, ok := adapter.(adapters.RefReader); ok && appliedIR != nil {
+		status.SetManagedRefs(reader.ManagedRefs(appliedIR, desiredIR))
 	}
 
 	// Update timestamps and hash
 	now := metav1.Now()
 	status.SetLastReconcile(&now)
 	status.SetLastAppliedHash(desiredIR.SourceHash)
+	if result.Success() {
+		status.SetSecretHashes(secretHashes)
+		status.SetIRSchemaVersion(desiredIR.Version)
+	}
 	h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionTrue, "Ready", "Configuration reconciled successfully")
 
 	// Record successful sync
 	metrics.RecordSyncSuccess(appType, time.Since(startTime).Seconds())
+	recordManagedResourceCounts(appType, connIR.URL, desiredIR)
 
 	return result, nil
 }
 
-// CleanupManagedResources removes all managed resources from the service
-func (h *ReconcileHelper) CleanupManagedResources(ctx context.Context, appType string, connIR *irv1.ConnectionIR) error {
+// CleanupManagedResources removes all managed resources of the subsystems in scope from the service.
+// qualityProfile is the config's default video quality profile, the only one removed.
+func (h *ReconcileHelper) CleanupManagedResources(ctx context.Context, appType string, connIR *irv1.ConnectionIR, scope ManageScope, qualityProfile string) error {
 	log := logf.FromContext(ctx)
 
 	adapter, ok := adapters.Get(appType)
@@ -219,6 +379,8 @@ func (h *ReconcileHelper) CleanupManagedResources(ctx context.Context, appType s
 	if currentIR == nil {
 		return nil
 	}
+	scope.Restrict(currentIR)
+	ownVideoProfile(currentIR.Quality, qualityProfile)
 
 	// Create a changeset to delete all managed resources
 	caps, _ := adapter.Discover(ctx, connIR)
@@ -237,10 +399,91 @@ func (h *ReconcileHelper) CleanupManagedResources(ctx context.Context, appType s
 		}
 		log.Info("Cleaned up managed resources", "deleted", result.Applied)
 	}
+	metrics.DeleteManagedResourceCounts(appType, connIR.URL)
 
 	return nil
 }
 
+// ownVideoProfile narrows the current video quality profiles to a config's default
+// profile. Every nebularr- profile reads as managed, but the others belong to other
+// configs or are additional profiles that items may still be assigned to.
+func ownVideoProfile(quality *irv1.QualityIR, name string) {
+	if quality == nil || quality.Video == nil {
+		return
+	}
+	own := &irv1.VideoQualityIR{}
+	for _, profile := range quality.AllVideoProfiles() {
+		if profile.ProfileName == name {
+			p := *profile
+			own = &p
+		}
+	}
+	// Custom formats are read into the default profile's slot
+	own.CustomFormats = quality.Video.CustomFormats
+	quality.Video, quality.VideoProfiles = own, nil
+}
+
+// recordManagedResourceCounts publishes the per-instance resource gauges for an IR
+// holding the resources Nebularr manages
+func recordManagedResourceCounts(appType, instance string, ir *irv1.IR) {
+	if ir == nil {
+		return
+	}
+	summary, _ := summarizeIR(ir)
+	metrics.SetManagedResourceCounts(appType, instance, summary.Indexers, summary.DownloadClients, summary.CustomFormats)
+}
+
+// managedResourcesStatus converts the remote IDs read from an app into status
+func managedResourcesStatus(refs *adapters.ManagedRefs) arrv1alpha1.ManagedResources {
+	return arrv1alpha1.ManagedResources{
+		QualityProfileID:     refs.QualityProfileID,
+		QualityProfileIDs:    refs.QualityProfileIDs,
+		CustomFormatIDs:      refs.CustomFormatIDs,
+		DownloadClientIDs:    refs.DownloadClientIDs,
+		IndexerIDs:           refs.IndexerIDs,
+		RootFolderIDs:        refs.RootFolderIDs,
+		RemotePathMappingIDs: refs.RemotePathMappingIDs,
+		NotificationIDs:      refs.NotificationIDs,
+	}
+}
+
+// summarizeIR counts the managed resources in a compiled IR and collects
+// the features that were pruned for lack of capabilities
+func summarizeIR(ir *irv1.IR) (*arrv1alpha1.CompiledSummary, []arrv1alpha1.UnrealizedFeature) {
+	summary := &arrv1alpha1.CompiledSummary{
+		CustomFormats:   len(ir.CustomFormats),
+		DownloadClients: len(ir.DownloadClients),
+		RootFolders:     len(ir.RootFolders),
+		ImportLists:     len(ir.ImportLists),
+		Notifications:   len(ir.Notifications),
+		DelayProfiles:   len(ir.DelayProfiles),
+		AutoTags:        len(ir.AutoTags),
+		ReleaseProfiles: len(ir.ReleaseProfiles),
+	}
+	if ir.Quality != nil && (ir.Quality.Video != nil || ir.Quality.Audio != nil || ir.Quality.Book != nil) {
+		summary.QualityProfiles = 1 + len(ir.Quality.VideoProfiles)
+	}
+	if ir.Indexers != nil {
+		summary.Indexers = len(ir.Indexers.Direct)
+	}
+
+	var features []arrv1alpha1.UnrealizedFeature
+	for _, u := range ir.Unrealized {
+		features = append(features, arrv1alpha1.UnrealizedFeature{Feature: u.Feature, Reason: u.Reason})
+	}
+
+	if ir.Prowlarr != nil {
+		summary.Indexers = len(ir.Prowlarr.Indexers)
+		summary.DownloadClients = len(ir.Prowlarr.DownloadClients)
+		summary.Applications = len(ir.Prowlarr.Applications)
+		for _, u := range ir.Prowlarr.Unrealized {
+			features = append(features, arrv1alpha1.UnrealizedFeature{Feature: u.Feature, Reason: u.Reason})
+		}
+	}
+
+	return summary, features
+}
+
 // SetCondition sets a condition on the config status
 func (h *ReconcileHelper) SetCondition(status ConfigStatus, generation int64, condType string, condStatus metav1.ConditionStatus, reason, message string) {
 	conditions := status.GetConditions()
@@ -255,6 +498,32 @@ func (h *ReconcileHelper) SetCondition(status ConfigStatus, generation int64, co
 	status.SetConditions(conditions)
 }
 
+// SetCompileFailure records why compilation failed. Rejected spec values are
+// listed in status.invalidFields with their JSON paths; any other error only
+// sets the Ready condition.
+func (h *ReconcileHelper) SetCompileFailure(status ConfigStatus, generation int64, err error) {
+	var fieldErrs compiler.FieldErrors
+	if errors.As(err, &fieldErrs) {
+		status.SetInvalidFields(invalidFieldsStatus(fieldErrs))
+		h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, "InvalidFields", err.Error())
+		return
+	}
+	status.SetInvalidFields(nil)
+	h.SetCondition(status, generation, ConditionTypeReady, metav1.ConditionFalse, "CompilationFailed", err.Error())
+}
+
+// invalidFieldsStatus converts compiler field errors to their status representation
+func invalidFieldsStatus(errs compiler.FieldErrors) []arrv1alpha1.InvalidField {
+	if len(errs) == 0 {
+		return nil
+	}
+	fields := make([]arrv1alpha1.InvalidField, 0, len(errs))
+	for _, e := range errs {
+		fields = append(fields, arrv1alpha1.InvalidField{Path: e.Path, Value: e.Value, Message: e.Reason})
+	}
+	return fields
+}
+
 // ResolveSecretValue retrieves a value from a Kubernetes Secret
 func (h *ReconcileHelper) ResolveSecretValue(ctx context.Context, namespace, name, key string) (string, error) {
 	secret := &corev1.Secret{}
@@ -270,8 +539,58 @@ func (h *ReconcileHelper) ResolveSecretValue(ctx context.Context, namespace, nam
 	return string(value), nil
 }
 
-// ResolveConnectionSecrets resolves secrets for a ConnectionSpec
-func (h *ReconcileHelper) ResolveConnectionSecrets(ctx context.Context, namespace string, conn *arrv1alpha1.ConnectionSpec) (map[string]string, error) {
+// ResolveConfigSecrets resolves every secret referenced by an *arr config spec
+// (connection, download clients, indexers, import lists, authentication and
+// media server hooks) into a single map keyed as the compiler expects
+func (h *ReconcileHelper) ResolveConfigSecrets(ctx context.Context, config ArrConfigObject) (map[string]string, error) {
+	target, err := h.clusterTarget(ctx, config.GetObject(), config.GetConnectionSpec())
+	if err != nil {
+		return nil, err
+	}
+	namespace := target.namespace
+
+	resolved, err := h.resolveConnectionSecrets(ctx, config.GetObject(), config.GetConnectionSpec(), target)
+	if err != nil {
+		return nil, err
+	}
+	if err := target.helper.ResolveDownloadClientSecrets(ctx, namespace, config.GetDownloadClients(), resolved); err != nil {
+		return nil, err
+	}
+	if err := target.helper.ResolveIndexerSecrets(ctx, namespace, config.GetIndexersSpec(), resolved); err != nil {
+		return nil, err
+	}
+	if err := target.helper.ResolveImportListSecrets(ctx, namespace, config.GetImportLists(), resolved); err != nil {
+		return nil, err
+	}
+	if err := target.helper.ResolveAuthenticationSecrets(ctx, namespace, config.GetAuthenticationSpec(), resolved); err != nil {
+		return nil, err
+	}
+	if err := target.helper.ResolveMediaServerSecrets(ctx, namespace, config.GetMediaServerHooks(), resolved); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+// Keys of connection secrets in the resolved secrets map, next to "apiKey"
+const (
+	resolvedHeaderPrefix = "header/"
+	resolvedClientCert   = "clientCert"
+	resolvedClientKey    = "clientKey"
+	resolvedCACert       = "caCert"
+)
+
+// ResolveConnectionSecrets resolves secrets for the ConnectionSpec of owner.
+// Without an APIKeySecretRef, the API key is discovered from the app's config.xml.
+func (h *ReconcileHelper) ResolveConnectionSecrets(ctx context.Context, owner client.Object, conn *arrv1alpha1.ConnectionSpec) (map[string]string, error) {
+	target, err := h.clusterTarget(ctx, owner, conn)
+	if err != nil {
+		return nil, err
+	}
+	return h.resolveConnectionSecrets(ctx, owner, conn, target)
+}
+
+// resolveConnectionSecrets resolves the secrets of conn in target
+func (h *ReconcileHelper) resolveConnectionSecrets(ctx context.Context, owner client.Object, conn *arrv1alpha1.ConnectionSpec, target clusterTarget) (map[string]string, error) {
 	resolved := make(map[string]string)
 
 	if conn.APIKeySecretRef != nil {
@@ -279,16 +598,121 @@ func (h *ReconcileHelper) ResolveConnectionSecrets(ctx context.Context, namespac
 		if key == "" {
 			key = "apiKey"
 		}
-		apiKey, err := h.ResolveSecretValue(ctx, namespace, conn.APIKeySecretRef.Name, key)
+		apiKey, err := target.helper.ResolveSecretValue(ctx, target.namespace, conn.APIKeySecretRef.Name, key)
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve API key secret: %w", err)
 		}
 		resolved["apiKey"] = apiKey
+	} else {
+		apiKey, err := h.DiscoverAPIKey(ctx, owner, conn)
+		if err != nil {
+			return nil, err
+		}
+		resolved["apiKey"] = apiKey
+	}
+
+	if err := target.helper.resolveTransportSecrets(ctx, target.namespace, conn, resolved); err != nil {
+		return nil, err
 	}
 
 	return resolved, nil
 }
 
+// resolveTransportSecrets resolves the extra headers and client certificate of a
+// ConnectionSpec. The certificate is parsed here so a broken Secret is reported
+// as a resolution failure rather than as failing requests.
+func (h *ReconcileHelper) resolveTransportSecrets(ctx context.Context, namespace string, conn *arrv1alpha1.ConnectionSpec, resolved map[string]string) error {
+	for _, header := range conn.ExtraHeaders {
+		key := header.SecretKeyRef.Key
+		if key == "" {
+			key = "apiKey"
+		}
+		value, err := h.ResolveSecretValue(ctx, namespace, header.SecretKeyRef.Name, key)
+		if err != nil {
+			return fmt.Errorf("failed to resolve header %s: %w", header.Name, err)
+		}
+		resolved[resolvedHeaderPrefix+header.Name] = value
+	}
+
+	ref := conn.ClientCertSecretRef
+	if ref == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := h.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return fmt.Errorf("failed to get client certificate secret %s/%s: %w", namespace, ref.Name, err)
+	}
+	certKey, keyKey, caKey := ref.CertKey, ref.KeyKey, ref.CAKey
+	if certKey == "" {
+		certKey = corev1.TLSCertKey
+	}
+	if keyKey == "" {
+		keyKey = corev1.TLSPrivateKeyKey
+	}
+	if caKey == "" {
+		caKey = "ca.crt"
+	}
+
+	cert, key := secret.Data[certKey], secret.Data[keyKey]
+	if _, err := tls.X509KeyPair(cert, key); err != nil {
+		return fmt.Errorf("invalid client certificate in secret %s/%s: %w", namespace, ref.Name, err)
+	}
+	resolved[resolvedClientCert] = string(cert)
+	resolved[resolvedClientKey] = string(key)
+
+	if ca, ok := secret.Data[caKey]; ok {
+		if !x509.NewCertPool().AppendCertsFromPEM(ca) {
+			return fmt.Errorf("invalid CA certificate in secret %s/%s: no PEM certificates found", namespace, ref.Name)
+		}
+		resolved[resolvedCACert] = string(ca)
+	}
+	return nil
+}
+
+// connectionIR builds the ConnectionIR for conn from secrets resolved by ResolveConnectionSecrets.
+// Resources the adapters create are tagged with the owner's ownership tag.
+func connectionIR(owner client.Object, conn *arrv1alpha1.ConnectionSpec, resolved map[string]string) *irv1.ConnectionIR {
+	ir := &irv1.ConnectionIR{
+		OwnerTag:           shared.ConfigOwnershipTag(owner.GetNamespace(), owner.GetName()),
+		URL:                conn.URL,
+		APIKey:             resolved["apiKey"],
+		InsecureSkipVerify: conn.InsecureSkipVerify,
+		ClientCert:         resolved[resolvedClientCert],
+		ClientKey:          resolved[resolvedClientKey],
+		CACert:             resolved[resolvedCACert],
+	}
+	if conn.Timeout != nil {
+		ir.Timeout = conn.Timeout.Duration
+	}
+	for _, header := range conn.ExtraHeaders {
+		if value, ok := resolved[resolvedHeaderPrefix+header.Name]; ok {
+			if ir.ExtraHeaders == nil {
+				ir.ExtraHeaders = make(map[string]string, len(conn.ExtraHeaders))
+			}
+			ir.ExtraHeaders[header.Name] = value
+		}
+	}
+	return ir
+}
+
+// ResolveDownloadClientRefs returns clients with the entries that set
+// downloadClientRef filled in from the DownloadStackConfigs they reference
+func (h *ReconcileHelper) ResolveDownloadClientRefs(ctx context.Context, namespace string, clients []arrv1alpha1.DownloadClientSpec) ([]arrv1alpha1.DownloadClientSpec, error) {
+	stacks := make(map[string]*arrv1alpha1.DownloadStackConfig)
+	for _, name := range compiler.ReferencedDownloadStacks(clients) {
+		stack := &arrv1alpha1.DownloadStackConfig{}
+		if err := h.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, stack); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get DownloadStackConfig %s: %w", name, err)
+		}
+		stacks[name] = stack
+	}
+	return compiler.ResolveDownloadClientRefs(clients, stacks)
+}
+
 // ResolveDownloadClientSecrets resolves credentials for download clients
 func (h *ReconcileHelper) ResolveDownloadClientSecrets(ctx context.Context, namespace string, clients []arrv1alpha1.DownloadClientSpec, resolved map[string]string) error {
 	for _, dc := range clients {
@@ -315,6 +739,28 @@ func (h *ReconcileHelper) ResolveDownloadClientSecrets(ctx context.Context, name
 			}
 			resolved[secretName+"/"+passwordKey] = password
 		}
+		if dc.APIKeySecretRef != nil {
+			keyName := dc.APIKeySecretRef.Key
+			if keyName == "" {
+				keyName = "apiKey"
+			}
+			apiKey, err := h.ResolveSecretValue(ctx, namespace, dc.APIKeySecretRef.Name, keyName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve download client API key: %w", err)
+			}
+			resolved[dc.APIKeySecretRef.Name+"/"+keyName] = apiKey
+		}
+		if dc.PasswordSecretRef != nil {
+			keyName := dc.PasswordSecretRef.Key
+			if keyName == "" {
+				keyName = "password"
+			}
+			password, err := h.ResolveSecretValue(ctx, namespace, dc.PasswordSecretRef.Name, keyName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve download client password: %w", err)
+			}
+			resolved[dc.PasswordSecretRef.Name+"/"+keyName] = password
+		}
 	}
 	return nil
 }
@@ -355,6 +801,17 @@ func (h *ReconcileHelper) ResolveProwlarrIndexerSecrets(ctx context.Context, nam
 			}
 			resolved[idx.APIKeySecretRef.Name+"/"+keyName] = apiKey
 		}
+		if idx.NextAPIKeySecretRef != nil {
+			keyName := idx.NextAPIKeySecretRef.Key
+			if keyName == "" {
+				keyName = "apiKey"
+			}
+			apiKey, err := h.ResolveSecretValue(ctx, namespace, idx.NextAPIKeySecretRef.Name, keyName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve Prowlarr indexer next API key: %w", err)
+			}
+			resolved[idx.NextAPIKeySecretRef.Name+"/"+keyName] = apiKey
+		}
 	}
 	return nil
 }
@@ -407,9 +864,14 @@ func (h *ReconcileHelper) ResolveApplicationSecrets(ctx context.Context, namespa
 	return nil
 }
 
+// goodreadsSessionKeys are the Secret keys of a signed-in Goodreads session
+var goodreadsSessionKeys = []string{"accessToken", "accessTokenSecret", "userId", "userName"}
+
 // ResolveImportListSecrets resolves secrets for import lists
 // Each import list may have a SettingsSecretRef that contains sensitive settings.
 // All keys from the referenced secret are loaded with format "secretName/key".
+// Tokens of typed list settings are resolved the same way, one key each, and
+// a Goodreads session takes one key for each of its values.
 func (h *ReconcileHelper) ResolveImportListSecrets(ctx context.Context, namespace string, lists []arrv1alpha1.ImportListSpec, resolved map[string]string) error {
 	for _, list := range lists {
 		if list.SettingsSecretRef != nil {
@@ -422,6 +884,36 @@ func (h *ReconcileHelper) ResolveImportListSecrets(ctx context.Context, namespac
 				resolved[list.SettingsSecretRef.Name+"/"+key] = string(value)
 			}
 		}
+
+		var refs []arrv1alpha1.SecretKeySelector
+		if list.PlexWatchlist != nil {
+			refs = append(refs, list.PlexWatchlist.AccessTokenSecretRef)
+		}
+		if list.TraktList != nil {
+			refs = append(refs, list.TraktList.AccessTokenSecretRef)
+			if list.TraktList.RefreshTokenSecretRef != nil {
+				refs = append(refs, *list.TraktList.RefreshTokenSecretRef)
+			}
+		}
+		if list.LazyLibrarian != nil {
+			refs = append(refs, list.LazyLibrarian.APIKeySecretRef)
+		}
+		if list.GoodreadsOwnedBooks != nil {
+			for _, key := range goodreadsSessionKeys {
+				refs = append(refs, arrv1alpha1.SecretKeySelector{Name: list.GoodreadsOwnedBooks.SessionSecretRef.Name, Key: key})
+			}
+		}
+		for _, ref := range refs {
+			keyName := ref.Key
+			if keyName == "" {
+				keyName = "apiKey"
+			}
+			value, err := h.ResolveSecretValue(ctx, namespace, ref.Name, keyName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve import list %s token: %w", list.Name, err)
+			}
+			resolved[ref.Name+"/"+keyName] = value
+		}
 	}
 	return nil
 }
@@ -445,6 +937,269 @@ func (h *ReconcileHelper) ResolveAuthenticationSecrets(ctx context.Context, name
 	return nil
 }
 
+// ResolveMediaServerSecrets resolves the Plex token and Jellyfin API key for media server hooks
+func (h *ReconcileHelper) ResolveMediaServerSecrets(ctx context.Context, namespace string, hooks *arrv1alpha1.MediaServerHooksSpec, resolved map[string]string) error {
+	if hooks == nil {
+		return nil
+	}
+
+	var refs []arrv1alpha1.SecretKeySelector
+	if hooks.Plex != nil {
+		refs = append(refs, hooks.Plex.TokenSecretRef)
+	}
+	if hooks.Jellyfin != nil {
+		refs = append(refs, hooks.Jellyfin.APIKeySecretRef)
+	}
+
+	for _, ref := range refs {
+		keyName := ref.Key
+		if keyName == "" {
+			keyName = "apiKey"
+		}
+		value, err := h.ResolveSecretValue(ctx, namespace, ref.Name, keyName)
+		if err != nil {
+			return fmt.Errorf("failed to resolve media server secret: %w", err)
+		}
+		resolved[ref.Name+"/"+keyName] = value
+	}
+	return nil
+}
+
+// VerifyMediaServerHooks asks the app to test each media server notification,
+// so a Connected status means the app itself can reach the media server
+func (h *ReconcileHelper) VerifyMediaServerHooks(
+	ctx context.Context,
+	appType string,
+	connIR *irv1.ConnectionIR,
+	configName string,
+	hooks *arrv1alpha1.MediaServerHooksSpec,
+) []arrv1alpha1.MediaServerStatus {
+	if hooks == nil {
+		return nil
+	}
+
+	var servers []arrv1alpha1.MediaServerStatus
+	if hooks.Plex != nil {
+		servers = append(servers, arrv1alpha1.MediaServerStatus{Type: compiler.MediaServerPlex, URL: hooks.Plex.URL})
+	}
+	if hooks.Jellyfin != nil {
+		servers = append(servers, arrv1alpha1.MediaServerStatus{Type: compiler.MediaServerJellyfin, URL: hooks.Jellyfin.URL})
+	}
+
+	names := make([]string, len(servers))
+	for i := range servers {
+		names[i] = compiler.MediaServerNotificationName(configName, servers[i].Type)
+	}
+	results, ok := h.testNotifications(ctx, appType, connIR, names)
+	if !ok {
+		return servers
+	}
+
+	now := metav1.Now()
+	for i := range servers {
+		servers[i].LastChecked = &now
+		if err := results[names[i]]; err != nil {
+			servers[i].Message = err.Error()
+			continue
+		}
+		servers[i].Connected = true
+	}
+
+	return servers
+}
+
+// testNotifications asks the app to test the named notifications and returns
+// each test's error, nil for the ones that passed. ok is false when the adapter
+// can't test notifications.
+func (h *ReconcileHelper) testNotifications(
+	ctx context.Context,
+	appType string,
+	connIR *irv1.ConnectionIR,
+	names []string,
+) (results map[string]error, ok bool) {
+	log := logf.FromContext(ctx)
+
+	adapter, ok := adapters.Get(appType)
+	if !ok {
+		return nil, false
+	}
+	tester, ok := adapter.(adapters.NotificationTester)
+	if !ok {
+		log.V(1).Info("Adapter does not support NotificationTester", "app", appType)
+		return nil, false
+	}
+
+	results = make(map[string]error, len(names))
+	for _, name := range names {
+		err := tester.TestNotification(ctx, connIR, name)
+		if err != nil {
+			log.Info("Notification test failed", "app", appType, "notification", name, "error", err.Error())
+		}
+		results[name] = err
+	}
+	return results, true
+}
+
+// VerifyNotifications asks the app to test each configured notification and
+// sets the NotificationsVerified condition. Tests can send a real message, so a
+// notification that passed is only re-tested when the config generation changes.
+func (h *ReconcileHelper) VerifyNotifications(
+	ctx context.Context,
+	appType string,
+	connIR *irv1.ConnectionIR,
+	configName string,
+	notifications []arrv1alpha1.NotificationSpec,
+	previous []arrv1alpha1.NotificationStatus,
+	status ConfigStatus,
+	generation int64,
+) []arrv1alpha1.NotificationStatus {
+	if len(notifications) == 0 {
+		conditions := status.GetConditions()
+		meta.RemoveStatusCondition(&conditions, ConditionTypeNotificationsVerified)
+		status.SetConditions(conditions)
+		return nil
+	}
+
+	last := make(map[string]arrv1alpha1.NotificationStatus, len(previous))
+	for _, p := range previous {
+		last[p.Name] = p
+	}
+
+	var stale []string
+	for _, n := range notifications {
+		if result, ok := last[n.Name]; !ok || !result.Verified || result.ObservedGeneration != generation {
+			stale = append(stale, compiler.NotificationName(configName, n.Name))
+		}
+	}
+	tested, ok := h.testNotifications(ctx, appType, connIR, stale)
+	if !ok {
+		return previous
+	}
+
+	now := metav1.Now()
+	results := make([]arrv1alpha1.NotificationStatus, 0, len(notifications))
+	var failed []string
+	for _, n := range notifications {
+		result := last[n.Name]
+		if err, ok := tested[compiler.NotificationName(configName, n.Name)]; ok {
+			result = arrv1alpha1.NotificationStatus{Name: n.Name, LastChecked: &now, ObservedGeneration: generation}
+			if err != nil {
+				result.Message = err.Error()
+			} else {
+				result.Verified = true
+			}
+		}
+
+		if !result.Verified {
+			failed = append(failed, n.Name)
+		}
+		results = append(results, result)
+	}
+
+	if len(failed) > 0 {
+		h.SetCondition(status, generation, ConditionTypeNotificationsVerified, metav1.ConditionFalse, "TestFailed",
+			fmt.Sprintf("Notification test failed: %s", strings.Join(failed, ", ")))
+	} else {
+		h.SetCondition(status, generation, ConditionTypeNotificationsVerified, metav1.ConditionTrue, "Verified",
+			fmt.Sprintf("%d notifications verified", len(results)))
+	}
+
+	return results
+}
+
+// VerifyIndexers asks the app to test each direct indexer when verifyOnApply is set
+// and disables the ones that fail, so a dead indexer does not silently block RSS sync.
+// Indexers are only re-tested when the config generation changes. Until then a
+// disabled indexer stays off (see holdFailedIndexers).
+func (h *ReconcileHelper) VerifyIndexers(
+	ctx context.Context,
+	appType string,
+	connIR *irv1.ConnectionIR,
+	obj client.Object,
+	spec *arrv1alpha1.IndexersSpec,
+	previous []arrv1alpha1.IndexerStatus,
+	recorder record.EventRecorder,
+) []arrv1alpha1.IndexerStatus {
+	if spec == nil || !spec.VerifyOnApply || len(spec.Direct) == 0 {
+		return nil
+	}
+
+	log := logf.FromContext(ctx)
+
+	adapter, ok := adapters.Get(appType)
+	if !ok {
+		return previous
+	}
+	tester, ok := adapter.(adapters.IndexerTester)
+	if !ok {
+		log.V(1).Info("Adapter does not support IndexerTester", "app", appType)
+		return previous
+	}
+
+	last := make(map[string]arrv1alpha1.IndexerStatus, len(previous))
+	for _, p := range previous {
+		last[p.Name] = p
+	}
+
+	generation := obj.GetGeneration()
+	now := metav1.Now()
+	results := make([]arrv1alpha1.IndexerStatus, 0, len(spec.Direct))
+	for _, idx := range spec.Direct {
+		if result, ok := last[idx.Name]; ok && (result.Verified || result.Disabled) && result.ObservedGeneration == generation {
+			results = append(results, result)
+			continue
+		}
+
+		result := arrv1alpha1.IndexerStatus{Name: idx.Name, LastChecked: &now, ObservedGeneration: generation}
+		name := compiler.IndexerName(obj.GetName(), idx.Name)
+		testErr := tester.TestIndexer(ctx, connIR, name)
+		if testErr == nil {
+			result.Verified = true
+			results = append(results, result)
+			continue
+		}
+
+		log.Info("Indexer test failed, disabling", "app", appType, "indexer", idx.Name, "error", testErr.Error())
+		result.Message = testErr.Error()
+		if err := tester.DisableIndexer(ctx, connIR, name); err != nil {
+			log.Error(err, "Failed to disable indexer", "app", appType, "indexer", idx.Name)
+			result.Message = fmt.Sprintf("%s (disable failed: %v)", testErr.Error(), err)
+		} else {
+			result.Disabled = true
+		}
+		if recorder != nil {
+			recorder.Event(obj, corev1.EventTypeWarning, "IndexerTestFailed",
+				fmt.Sprintf("Indexer %s failed its test: %s", idx.Name, result.Message))
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// holdFailedIndexers keeps the direct indexers VerifyIndexers disabled turned off
+// in the desired state until the config generation changes, so the sync doesn't
+// re-enable them only for them to fail their test again
+func holdFailedIndexers(ir *irv1.IR, configName string, statuses []arrv1alpha1.IndexerStatus, generation int64) {
+	if ir == nil || ir.Indexers == nil {
+		return
+	}
+	failed := make(map[string]bool, len(statuses))
+	for _, s := range statuses {
+		if s.Disabled && s.ObservedGeneration == generation {
+			failed[compiler.IndexerName(configName, s.Name)] = true
+		}
+	}
+	for i := range ir.Indexers.Direct {
+		idx := &ir.Indexers.Direct[i]
+		if failed[idx.Name] {
+			idx.EnableRss = false
+			idx.EnableAutomaticSearch = false
+			idx.EnableInteractiveSearch = false
+		}
+	}
+}
+
 // ProwlarrAutoRegistration holds info for auto-registering with Prowlarr
 type ProwlarrAutoRegistration struct {
 	// ProwlarrRef is the reference to the ProwlarrConfig
@@ -492,7 +1247,7 @@ func (h *ReconcileHelper) HandleProwlarrRegistration(ctx context.Context, namesp
 	}
 
 	// Resolve Prowlarr's API key
-	prowlarrSecrets, err := h.ResolveConnectionSecrets(ctx, namespace, &prowlarrConfig.Spec.Connection)
+	prowlarrSecrets, err := h.ResolveConnectionSecrets(ctx, prowlarrConfig, &prowlarrConfig.Spec.Connection)
 	if err != nil {
 		return fmt.Errorf("failed to resolve Prowlarr secrets: %w", err)
 	}
@@ -549,8 +1304,12 @@ func (h *ReconcileHelper) ApplyDirectConfig(
 
 	// Check if there's anything to apply directly
 	hasDirectApplyWork := len(desiredIR.ImportLists) > 0 ||
+		desiredIR.ImportListOptions != nil ||
 		desiredIR.MediaManagement != nil ||
-		desiredIR.Authentication != nil
+		desiredIR.Collections != nil ||
+		desiredIR.Authentication != nil ||
+		desiredIR.UI != nil ||
+		len(desiredIR.QualityDefinitions) > 0
 
 	if !hasDirectApplyWork {
 		log.V(1).Info("No direct apply work to do", "app", appType)
@@ -561,13 +1320,23 @@ func (h *ReconcileHelper) ApplyDirectConfig(
 		"app", appType,
 		"importLists", len(desiredIR.ImportLists),
 		"hasMediaManagement", desiredIR.MediaManagement != nil,
-		"hasAuthentication", desiredIR.Authentication != nil)
+		"hasCollections", desiredIR.Collections != nil,
+		"hasAuthentication", desiredIR.Authentication != nil,
+		"hasUI", desiredIR.UI != nil,
+		"qualityDefinitions", len(desiredIR.QualityDefinitions))
+
+	// Tokens the app refreshes on its own are only written when their Secret changes
+	keepUnchangedImportListTokens(desiredIR, status.GetSecretHashes())
 
 	result, err := directApplier.ApplyDirect(ctx, connIR, desiredIR)
 	if err != nil {
 		log.Error(err, "Failed to apply direct configuration", "app", appType)
 		return result, err
 	}
+	// Hashes recorded under an older IR schema are converted by the next successful sync first
+	if result != nil && !hasImportListErrors(result) && status.GetIRSchemaVersion() == desiredIR.Version {
+		status.SetSecretHashes(recordImportListSecretHashes(status.GetSecretHashes(), desiredIR))
+	}
 
 	if result != nil && !result.Success() {
 		log.Info("Some direct configuration changes failed",
@@ -604,7 +1373,7 @@ func (h *ReconcileHelper) HandleProwlarrUnregistration(ctx context.Context, name
 	}
 
 	// Resolve Prowlarr's API key
-	prowlarrSecrets, err := h.ResolveConnectionSecrets(ctx, namespace, &prowlarrConfig.Spec.Connection)
+	prowlarrSecrets, err := h.ResolveConnectionSecrets(ctx, prowlarrConfig, &prowlarrConfig.Spec.Connection)
 	if err != nil {
 		log.Error(err, "Failed to resolve Prowlarr secrets for unregistration")
 		return nil // Don't block deletion
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+var _ = Describe("Compiled summary", func() {
+	It("counts the managed resources of an *arr IR", func() {
+		summary, features := summarizeIR(&irv1.IR{
+			Quality: &irv1.QualityIR{
+				Video:         &irv1.VideoQualityIR{ProfileName: "nebularr-movies"},
+				VideoProfiles: []*irv1.VideoQualityIR{{ProfileName: "nebularr-4k"}, {ProfileName: "nebularr-anime"}},
+			},
+			CustomFormats:   make([]irv1.CustomFormatIR, 3),
+			DownloadClients: make([]irv1.DownloadClientIR, 2),
+			Indexers:        &irv1.IndexersIR{Direct: make([]irv1.IndexerIR, 4)},
+			RootFolders:     make([]irv1.RootFolderIR, 1),
+			DelayProfiles:   make([]irv1.DelayProfileIR, 2),
+			Unrealized: []irv1.UnrealizedFeature{
+				{Feature: "importListOptions:syncIntervalHours", Reason: "not supported by Radarr 5.14.0"},
+			},
+		})
+
+		Expect(*summary).To(Equal(arrv1alpha1.CompiledSummary{
+			QualityProfiles: 3,
+			CustomFormats:   3,
+			DownloadClients: 2,
+			Indexers:        4,
+			RootFolders:     1,
+			DelayProfiles:   2,
+		}))
+		Expect(features).To(Equal([]arrv1alpha1.UnrealizedFeature{
+			{Feature: "importListOptions:syncIntervalHours", Reason: "not supported by Radarr 5.14.0"},
+		}))
+	})
+
+	It("counts no quality profile without a main profile", func() {
+		summary, features := summarizeIR(&irv1.IR{Quality: &irv1.QualityIR{}})
+		Expect(summary.QualityProfiles).To(BeZero())
+		Expect(features).To(BeNil())
+	})
+
+	It("counts Prowlarr's indexers, download clients and applications", func() {
+		summary, features := summarizeIR(&irv1.IR{
+			Prowlarr: &irv1.ProwlarrIR{
+				Indexers:        make([]irv1.ProwlarrIndexerIR, 5),
+				DownloadClients: make([]irv1.DownloadClientIR, 1),
+				Applications:    make([]irv1.ProwlarrApplicationIR, 2),
+				Unrealized:      []irv1.UnrealizedFeature{{Feature: "proxy:socks5", Reason: "unsupported"}},
+			},
+		})
+
+		Expect(*summary).To(Equal(arrv1alpha1.CompiledSummary{Indexers: 5, DownloadClients: 1, Applications: 2}))
+		Expect(features).To(Equal([]arrv1alpha1.UnrealizedFeature{{Feature: "proxy:socks5", Reason: "unsupported"}}))
+	})
+})
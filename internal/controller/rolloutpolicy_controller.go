@@ -0,0 +1,440 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// Annotations the RolloutPolicy controller manages on its target configs.
+// They only touch metadata, so patching them never bumps the config generation.
+const (
+	// rolloutPolicyAnnotation names the RolloutPolicy rolling out the config
+	rolloutPolicyAnnotation = "arr.rinzler.cloud/rollout-policy"
+
+	// rolloutReleasedAnnotation is the latest config generation allowed to apply
+	rolloutReleasedAnnotation = "arr.rinzler.cloud/rollout-released-generation"
+
+	// rolloutVerifyAnnotation is bumped to trigger a fresh health check once the verify delay passed
+	rolloutVerifyAnnotation = "arr.rinzler.cloud/rollout-verify-requested"
+)
+
+// DefaultRolloutVerifyDelay is how long a wave must stay healthy when spec.verifyDelay is unset
+const DefaultRolloutVerifyDelay = 5 * time.Minute
+
+// rolloutTargetFetchers maps the config kinds a RolloutPolicy can target to their fetchers
+var rolloutTargetFetchers = map[string]ConfigFetcher{
+	"RadarrConfig":  RadarrConfigFetcher{},
+	"SonarrConfig":  SonarrConfigFetcher{},
+	"LidarrConfig":  LidarrConfigFetcher{},
+	"ReadarrConfig": ReadarrConfigFetcher{},
+}
+
+// RolloutPolicyReconciler releases spec changes of *arr configs in waves,
+// verifying the health of each wave before releasing the next
+type RolloutPolicyReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	Options ControllerOptions
+}
+
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=rolloutpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=rolloutpolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=radarrconfigs,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=sonarrconfigs,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=lidarrconfigs,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=readarrconfigs,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile advances the rollout of a RolloutPolicy
+func (r *RolloutPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	policy := &arrv1alpha1.RolloutPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !policy.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	verifyDelay := DefaultRolloutVerifyDelay
+	if policy.Spec.VerifyDelay != nil {
+		verifyDelay = policy.Spec.VerifyDelay.Duration
+	}
+	now := time.Now()
+
+	previousPhase, previousWave := policy.Status.Phase, policy.Status.CurrentWave
+	phase, currentWave, message := arrv1alpha1.RolloutPhaseComplete, "", "All waves applied and healthy"
+	var targets []arrv1alpha1.RolloutTargetStatus
+	var nextCheck time.Time
+	blocked := false
+
+	for i, wave := range policy.Spec.Waves {
+		waveName := rolloutWaveName(wave, i)
+
+		// pending maps changed configs to their index in waveTargets
+		pending := map[int]client.Object{}
+		var waveTargets []arrv1alpha1.RolloutTargetStatus
+		var unhealthy []string
+		progressing := false
+
+		for _, ref := range wave.Targets {
+			target := arrv1alpha1.RolloutTargetStatus{Kind: ref.Kind, Name: ref.Name, Wave: waveName}
+
+			obj, observation, err := r.getRolloutTarget(ctx, policy.Namespace, ref)
+			if apierrors.IsNotFound(err) {
+				target.Phase = arrv1alpha1.RolloutTargetMissing
+				target.Message = fmt.Sprintf("%s %s not found", ref.Kind, ref.Name)
+				waveTargets = append(waveTargets, target)
+				continue
+			}
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			// Adopt the config; whatever it declares when first seen counts as rolled out
+			released, owner := rolloutReleasedGeneration(obj)
+			if owner != policy.Name {
+				if owner != "" && r.policyTargets(ctx, policy.Namespace, owner, ref) {
+					target.Phase = arrv1alpha1.RolloutTargetConflict
+					target.Message = fmt.Sprintf("Already rolled out by RolloutPolicy %s", owner)
+					waveTargets = append(waveTargets, target)
+					continue
+				}
+				released = obj.GetGeneration()
+				if err := r.releaseTarget(ctx, policy.Name, obj, released); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			target.ReleasedGeneration = released
+
+			if obj.GetGeneration() > released {
+				target.Phase = arrv1alpha1.RolloutTargetPending
+				target.Message = fmt.Sprintf("Generation %d waiting for wave %s", obj.GetGeneration(), waveName)
+				pending[len(waveTargets)] = obj
+				waveTargets = append(waveTargets, target)
+				continue
+			}
+
+			var verifyAt time.Time
+			target.Phase, target.Message, verifyAt = evaluateRolloutTarget(observation, released, verifyDelay, now)
+			switch target.Phase {
+			case arrv1alpha1.RolloutTargetUnhealthy:
+				unhealthy = append(unhealthy, fmt.Sprintf("%s/%s", ref.Kind, ref.Name))
+			case arrv1alpha1.RolloutTargetApplying:
+				progressing = true
+			case arrv1alpha1.RolloutTargetVerifying:
+				progressing = true
+				if verifyAt.After(now) {
+					if nextCheck.IsZero() || verifyAt.Before(nextCheck) {
+						nextCheck = verifyAt
+					}
+				} else if err := r.requestVerification(ctx, obj, verifyAt); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			waveTargets = append(waveTargets, target)
+		}
+
+		switch {
+		case blocked:
+			// An earlier wave holds the rollout back
+		case len(unhealthy) > 0:
+			blocked = true
+			phase, currentWave = arrv1alpha1.RolloutPhaseHalted, waveName
+			message = fmt.Sprintf("Wave %s is unhealthy: %v", waveName, unhealthy)
+		case len(pending) > 0 && policy.Spec.Paused:
+			blocked = true
+			phase, currentWave = arrv1alpha1.RolloutPhasePaused, waveName
+			message = fmt.Sprintf("Paused before releasing %d changed configs in wave %s", len(pending), waveName)
+		case len(pending) > 0:
+			blocked = true
+			for j, obj := range pending {
+				if err := r.releaseTarget(ctx, policy.Name, obj, obj.GetGeneration()); err != nil {
+					return ctrl.Result{}, err
+				}
+				waveTargets[j].Phase = arrv1alpha1.RolloutTargetApplying
+				waveTargets[j].Message = fmt.Sprintf("Released generation %d", obj.GetGeneration())
+				waveTargets[j].ReleasedGeneration = obj.GetGeneration()
+			}
+			log.Info("Released rollout wave", "wave", waveName, "configs", len(pending))
+			r.Recorder.Eventf(policy, corev1.EventTypeNormal, "WaveReleased",
+				"Released %d changed configs in wave %s", len(pending), waveName)
+			phase, currentWave = arrv1alpha1.RolloutPhaseProgressing, waveName
+			message = fmt.Sprintf("Applying wave %s", waveName)
+		case progressing:
+			blocked = true
+			phase, currentWave = arrv1alpha1.RolloutPhaseProgressing, waveName
+			message = fmt.Sprintf("Verifying wave %s", waveName)
+		}
+
+		targets = append(targets, waveTargets...)
+	}
+
+	if phase != previousPhase || currentWave != previousWave {
+		switch phase {
+		case arrv1alpha1.RolloutPhaseHalted:
+			r.Recorder.Event(policy, corev1.EventTypeWarning, "RolloutHalted", message)
+		case arrv1alpha1.RolloutPhaseComplete:
+			if previousPhase != "" {
+				r.Recorder.Event(policy, corev1.EventTypeNormal, "RolloutComplete", message)
+			}
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Rollout" + phase,
+		Message:            message,
+		ObservedGeneration: policy.Generation,
+	}
+	if phase == arrv1alpha1.RolloutPhaseHalted {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "WaveUnhealthy"
+	}
+	meta.SetStatusCondition(&policy.Status.Conditions, condition)
+	policy.Status.Phase = phase
+	policy.Status.CurrentWave = currentWave
+	policy.Status.Targets = targets
+	policy.Status.ObservedGeneration = policy.Generation
+
+	if err := r.Status().Update(ctx, policy); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Config status changes trigger a reconcile through the watches; the
+	// requeue covers the end of a verify delay and missed events
+	requeueAfter := r.Options.requeueAfter(DefaultRequeueInterval)
+	if !nextCheck.IsZero() {
+		if untilCheck := nextCheck.Sub(now); untilCheck < requeueAfter {
+			requeueAfter = untilCheck
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// rolloutWaveName returns the wave name, defaulting to wave-<index>
+func rolloutWaveName(wave arrv1alpha1.RolloutWave, index int) string {
+	if wave.Name != "" {
+		return wave.Name
+	}
+	return fmt.Sprintf("wave-%d", index)
+}
+
+// rolloutTargetObservation is the part of a config a rollout decides on
+type rolloutTargetObservation struct {
+	Conditions    []metav1.Condition
+	Connected     bool
+	Health        *arrv1alpha1.HealthStatus
+	LastReconcile *metav1.Time
+}
+
+// getRolloutTarget fetches a target config and the status the rollout decides on
+func (r *RolloutPolicyReconciler) getRolloutTarget(ctx context.Context, namespace string, ref arrv1alpha1.RolloutTargetRef) (client.Object, rolloutTargetObservation, error) {
+	fetcher, ok := rolloutTargetFetchers[ref.Kind]
+	if !ok {
+		return nil, rolloutTargetObservation{}, fmt.Errorf("unsupported rollout target kind %q", ref.Kind)
+	}
+	obj := fetcher.NewEmpty()
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, obj); err != nil {
+		return nil, rolloutTargetObservation{}, err
+	}
+
+	var observation rolloutTargetObservation
+	switch c := obj.(type) {
+	case *arrv1alpha1.RadarrConfig:
+		observation = rolloutTargetObservation{c.Status.Conditions, c.Status.Connected, c.Status.Health, c.Status.LastReconcile}
+	case *arrv1alpha1.SonarrConfig:
+		observation = rolloutTargetObservation{c.Status.Conditions, c.Status.Connected, c.Status.Health, c.Status.LastReconcile}
+	case *arrv1alpha1.LidarrConfig:
+		observation = rolloutTargetObservation{c.Status.Conditions, c.Status.Connected, c.Status.Health, c.Status.LastReconcile}
+	case *arrv1alpha1.ReadarrConfig:
+		observation = rolloutTargetObservation{c.Status.Conditions, c.Status.Connected, c.Status.Health, c.Status.LastReconcile}
+	}
+	return obj, observation, nil
+}
+
+// evaluateRolloutTarget returns the phase of a config whose released generation
+// is current, and when its verify delay ends (zero until the change is applied)
+func evaluateRolloutTarget(obs rolloutTargetObservation, released int64, verifyDelay time.Duration, now time.Time) (phase, message string, verifyAt time.Time) {
+	if ready := meta.FindStatusCondition(obs.Conditions, ConditionTypeReady); ready != nil &&
+		ready.Status == metav1.ConditionFalse && ready.ObservedGeneration >= released {
+		return arrv1alpha1.RolloutTargetUnhealthy, ready.Message, time.Time{}
+	}
+
+	synced := meta.FindStatusCondition(obs.Conditions, ConditionTypeSynced)
+	if synced == nil || synced.Status != metav1.ConditionTrue || synced.ObservedGeneration < released {
+		return arrv1alpha1.RolloutTargetApplying, fmt.Sprintf("Waiting for generation %d to be applied", released), time.Time{}
+	}
+
+	verifyAt = synced.LastTransitionTime.Add(verifyDelay)
+	if now.Before(verifyAt) {
+		return arrv1alpha1.RolloutTargetVerifying, fmt.Sprintf("Verifying health until %s", verifyAt.Format(time.RFC3339)), verifyAt
+	}
+	if obs.LastReconcile == nil || obs.LastReconcile.Before(&metav1.Time{Time: verifyAt}) {
+		return arrv1alpha1.RolloutTargetVerifying, "Waiting for a health check", verifyAt
+	}
+
+	if !obs.Connected {
+		return arrv1alpha1.RolloutTargetUnhealthy, "Not connected", verifyAt
+	}
+	if obs.Health != nil && obs.Health.ErrorCount > 0 {
+		return arrv1alpha1.RolloutTargetUnhealthy, fmt.Sprintf("App reports %d health errors", obs.Health.ErrorCount), verifyAt
+	}
+	return arrv1alpha1.RolloutTargetHealthy, "", verifyAt
+}
+
+// rolloutReleasedGeneration reads the rollout annotations of a config
+func rolloutReleasedGeneration(obj client.Object) (released int64, policy string) {
+	annotations := obj.GetAnnotations()
+	released, _ = strconv.ParseInt(annotations[rolloutReleasedAnnotation], 10, 64)
+	return released, annotations[rolloutPolicyAnnotation]
+}
+
+// releaseTarget allows a config to apply everything up to generation
+func (r *RolloutPolicyReconciler) releaseTarget(ctx context.Context, policyName string, obj client.Object, generation int64) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[rolloutPolicyAnnotation] = policyName
+	annotations[rolloutReleasedAnnotation] = strconv.FormatInt(generation, 10)
+	obj.SetAnnotations(annotations)
+	if err := r.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("failed to release %s: %w", obj.GetName(), err)
+	}
+	return nil
+}
+
+// requestVerification triggers a config reconcile so its health is checked after verifyAt
+func (r *RolloutPolicyReconciler) requestVerification(ctx context.Context, obj client.Object, verifyAt time.Time) error {
+	requested := verifyAt.UTC().Format(time.RFC3339)
+	if obj.GetAnnotations()[rolloutVerifyAnnotation] == requested {
+		return nil
+	}
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	annotations[rolloutVerifyAnnotation] = requested
+	obj.SetAnnotations(annotations)
+	if err := r.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("failed to request health check of %s: %w", obj.GetName(), err)
+	}
+	return nil
+}
+
+// policyTargets reports whether the named RolloutPolicy exists and lists ref
+func (r *RolloutPolicyReconciler) policyTargets(ctx context.Context, namespace, name string, ref arrv1alpha1.RolloutTargetRef) bool {
+	policy := &arrv1alpha1.RolloutPolicy{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, policy); err != nil {
+		return false
+	}
+	return rolloutPolicyTargets(policy, ref.Kind, ref.Name)
+}
+
+// rolloutPolicyTargets reports whether a RolloutPolicy lists the given config
+func rolloutPolicyTargets(policy *arrv1alpha1.RolloutPolicy, kind, name string) bool {
+	for _, wave := range policy.Spec.Waves {
+		for _, ref := range wave.Targets {
+			if ref.Kind == kind && ref.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RolloutHold returns the RolloutPolicy holding back obj's current generation,
+// or "" when the generation was released or no policy rolls out the config.
+// A deleted policy, or one that no longer lists the config, releases it.
+func (h *ReconcileHelper) RolloutHold(ctx context.Context, obj client.Object) string {
+	released, name := rolloutReleasedGeneration(obj)
+	if name == "" || obj.GetGeneration() <= released {
+		return ""
+	}
+
+	policy := &arrv1alpha1.RolloutPolicy{}
+	if err := h.Client.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: name}, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ""
+		}
+		logf.FromContext(ctx).Error(err, "Failed to get RolloutPolicy, holding back changes", "policy", name)
+		return name
+	}
+	kind, err := h.kindOf(obj)
+	if err != nil {
+		return name
+	}
+	if !rolloutPolicyTargets(policy, kind, obj.GetName()) {
+		return ""
+	}
+	return name
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RolloutPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// A config change re-evaluates the RolloutPolicies listing it
+	mapConfigToPolicies := func(kind string) handler.MapFunc {
+		return func(ctx context.Context, obj client.Object) []reconcile.Request {
+			policies := &arrv1alpha1.RolloutPolicyList{}
+			if err := r.List(ctx, policies, client.InNamespace(obj.GetNamespace())); err != nil {
+				return nil
+			}
+
+			var requests []reconcile.Request
+			for i := range policies.Items {
+				if rolloutPolicyTargets(&policies.Items[i], kind, obj.GetName()) {
+					requests = append(requests, reconcile.Request{
+						NamespacedName: types.NamespacedName{Name: policies.Items[i].Name, Namespace: obj.GetNamespace()},
+					})
+				}
+			}
+			return requests
+		}
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.RolloutPolicy{}).
+		Watches(&arrv1alpha1.RadarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapConfigToPolicies("RadarrConfig"))).
+		Watches(&arrv1alpha1.SonarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapConfigToPolicies("SonarrConfig"))).
+		Watches(&arrv1alpha1.LidarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapConfigToPolicies("LidarrConfig"))).
+		Watches(&arrv1alpha1.ReadarrConfig{}, handler.EnqueueRequestsFromMapFunc(mapConfigToPolicies("ReadarrConfig")))
+
+	return r.Options.complete(mgr, b, "rolloutpolicy", &arrv1alpha1.RolloutPolicyList{}, r)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("RolloutPolicy Controller", func() {
+	ctx := context.Background()
+
+	newRadarrConfig := func(name string) *arrv1alpha1.RadarrConfig {
+		config := &arrv1alpha1.RadarrConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: arrv1alpha1.RadarrConfigSpec{
+				Connection: arrv1alpha1.ConnectionSpec{URL: "http://" + name + ".example.com:7878"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, config)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, config)).To(Succeed()) })
+		return config
+	}
+
+	changeSpec := func(config *arrv1alpha1.RadarrConfig) {
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: config.Name, Namespace: "default"}, config)).To(Succeed())
+		config.Spec.Connection.URL += "/"
+		Expect(k8sClient.Update(ctx, config)).To(Succeed())
+	}
+
+	It("releases the next wave once the previous one applied and stayed healthy", func() {
+		canary := newRadarrConfig("rollout-canary")
+		rest := newRadarrConfig("rollout-rest")
+
+		policy := &arrv1alpha1.RolloutPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "rollout", Namespace: "default"},
+			Spec: arrv1alpha1.RolloutPolicySpec{
+				VerifyDelay: &metav1.Duration{Duration: time.Minute},
+				Waves: []arrv1alpha1.RolloutWave{
+					{Name: "canary", Targets: []arrv1alpha1.RolloutTargetRef{{Kind: "RadarrConfig", Name: canary.Name}}},
+					{Name: "rest", Targets: []arrv1alpha1.RolloutTargetRef{{Kind: "RadarrConfig", Name: rest.Name}}},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, policy)).To(Succeed()) })
+
+		reconciler := &RolloutPolicyReconciler{
+			Client:   k8sClient,
+			Scheme:   k8sClient.Scheme(),
+			Recorder: record.NewFakeRecorder(10),
+		}
+		helper := NewReconcileHelper(k8sClient)
+		policyKey := types.NamespacedName{Name: policy.Name, Namespace: "default"}
+		reconcilePolicy := func() *arrv1alpha1.RolloutPolicy {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: policyKey})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, policyKey, policy)).To(Succeed())
+			return policy
+		}
+
+		By("adopting the configs as they are")
+		reconcilePolicy()
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: rest.Name, Namespace: "default"}, rest)).To(Succeed())
+		Expect(rest.Annotations).To(HaveKeyWithValue(rolloutPolicyAnnotation, policy.Name))
+		Expect(helper.RolloutHold(ctx, rest)).To(BeEmpty())
+
+		By("releasing a spec change to the canary wave only")
+		changeSpec(canary)
+		changeSpec(rest)
+		status := reconcilePolicy().Status
+		Expect(status.Phase).To(Equal(arrv1alpha1.RolloutPhaseProgressing))
+		Expect(status.CurrentWave).To(Equal("canary"))
+		Expect(status.Targets[0].Phase).To(Equal(arrv1alpha1.RolloutTargetApplying))
+		Expect(status.Targets[1].Phase).To(Equal(arrv1alpha1.RolloutTargetPending))
+
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: canary.Name, Namespace: "default"}, canary)).To(Succeed())
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: rest.Name, Namespace: "default"}, rest)).To(Succeed())
+		Expect(helper.RolloutHold(ctx, canary)).To(BeEmpty())
+		Expect(helper.RolloutHold(ctx, rest)).To(Equal(policy.Name))
+
+		By("releasing the next wave once the canary is healthy past the verify delay")
+		applied := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		now := metav1.Now()
+		canary.Status.Connected = true
+		canary.Status.LastReconcile = &now
+		canary.Status.Conditions = []metav1.Condition{
+			{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: "Synced", ObservedGeneration: canary.Generation, LastTransitionTime: applied},
+			{Type: ConditionTypeSynced, Status: metav1.ConditionTrue, Reason: "Applied", ObservedGeneration: canary.Generation, LastTransitionTime: applied},
+		}
+		Expect(k8sClient.Status().Update(ctx, canary)).To(Succeed())
+
+		status = reconcilePolicy().Status
+		Expect(status.Targets[0].Phase).To(Equal(arrv1alpha1.RolloutTargetHealthy))
+		Expect(status.CurrentWave).To(Equal("rest"))
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: rest.Name, Namespace: "default"}, rest)).To(Succeed())
+		Expect(helper.RolloutHold(ctx, rest)).To(BeEmpty())
+	})
+
+	It("halts when a released config is unhealthy after applying", func() {
+		synced := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+		checked := metav1.Now()
+		observation := rolloutTargetObservation{
+			Conditions: []metav1.Condition{
+				{Type: ConditionTypeSynced, Status: metav1.ConditionTrue, ObservedGeneration: 2, LastTransitionTime: synced},
+			},
+			Connected:     true,
+			Health:        &arrv1alpha1.HealthStatus{ErrorCount: 1},
+			LastReconcile: &checked,
+		}
+
+		phase, _, _ := evaluateRolloutTarget(observation, 2, 5*time.Minute, time.Now())
+		Expect(phase).To(Equal(arrv1alpha1.RolloutTargetUnhealthy))
+
+		By("waiting for a fresh health check when the last one predates the verify delay")
+		stale := metav1.NewTime(synced.Add(time.Minute))
+		observation.LastReconcile = &stale
+		phase, _, _ = evaluateRolloutTarget(observation, 2, 5*time.Minute, time.Now())
+		Expect(phase).To(Equal(arrv1alpha1.RolloutTargetVerifying))
+
+		By("waiting for the released generation to sync")
+		phase, _, _ = evaluateRolloutTarget(observation, 3, 5*time.Minute, time.Now())
+		Expect(phase).To(Equal(arrv1alpha1.RolloutTargetApplying))
+	})
+})
@@ -0,0 +1,172 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"maps"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// Secret hash keys identify a resource in status.secretHashes
+const (
+	secretKeyDownloadClient = "downloadClient/"
+	secretKeyIndexer        = "indexer/"
+	secretKeyImportList     = "importList/"
+)
+
+// RestoreSecretHashes copies the hashes recorded in status onto the current
+// state. The apps never return credentials, so this is what the compiled
+// hashes are compared against to detect rotations.
+func RestoreSecretHashes(ir *irv1.IR, hashes map[string]string) {
+	if ir == nil || len(hashes) == 0 {
+		return
+	}
+	for i := range ir.DownloadClients {
+		ir.DownloadClients[i].SecretHash = hashes[secretKeyDownloadClient+ir.DownloadClients[i].Name]
+	}
+	if ir.Indexers != nil {
+		for i := range ir.Indexers.Direct {
+			ir.Indexers.Direct[i].SecretHash = hashes[secretKeyIndexer+ir.Indexers.Direct[i].Name]
+		}
+	}
+	if ir.Prowlarr != nil {
+		for i := range ir.Prowlarr.DownloadClients {
+			ir.Prowlarr.DownloadClients[i].SecretHash = hashes[secretKeyDownloadClient+ir.Prowlarr.DownloadClients[i].Name]
+		}
+		for i := range ir.Prowlarr.Indexers {
+			ir.Prowlarr.Indexers[i].SecretHash = hashes[secretKeyIndexer+ir.Prowlarr.Indexers[i].Name]
+		}
+	}
+}
+
+// collectSecretHashes returns the secret hashes of the resources in ir
+func collectSecretHashes(ir *irv1.IR) map[string]string {
+	hashes := make(map[string]string)
+	if ir == nil {
+		return hashes
+	}
+	add := func(key, hash string) {
+		if hash != "" {
+			hashes[key] = hash
+		}
+	}
+	for _, dc := range ir.DownloadClients {
+		add(secretKeyDownloadClient+dc.Name, dc.SecretHash)
+	}
+	if ir.Indexers != nil {
+		for _, idx := range ir.Indexers.Direct {
+			add(secretKeyIndexer+idx.Name, idx.SecretHash)
+		}
+	}
+	if ir.Prowlarr != nil {
+		for _, dc := range ir.Prowlarr.DownloadClients {
+			add(secretKeyDownloadClient+dc.Name, dc.SecretHash)
+		}
+		for _, idx := range ir.Prowlarr.Indexers {
+			add(secretKeyIndexer+idx.Name, idx.SecretHash)
+		}
+	}
+	return hashes
+}
+
+// collectImportListSecretHashes returns the secret hashes of the import lists
+// in ir. Import lists are applied directly, after the diffed resources, so
+// their hashes are recorded by recordImportListSecretHashes.
+func collectImportListSecretHashes(ir *irv1.IR) map[string]string {
+	hashes := make(map[string]string)
+	if ir == nil {
+		return hashes
+	}
+	for _, list := range ir.ImportLists {
+		if list.TraktList != nil && list.TraktList.SecretHash != "" {
+			hashes[secretKeyImportList+list.Name] = list.TraktList.SecretHash
+		}
+	}
+	return hashes
+}
+
+// keepUnchangedImportListTokens marks the Trakt lists whose tokens are the ones
+// last written, so the adapter leaves the tokens the app has refreshed since.
+func keepUnchangedImportListTokens(ir *irv1.IR, recorded map[string]string) {
+	for i := range ir.ImportLists {
+		trakt := ir.ImportLists[i].TraktList
+		if trakt != nil && trakt.SecretHash != "" {
+			trakt.KeepTokens = recorded[secretKeyImportList+ir.ImportLists[i].Name] == trakt.SecretHash
+		}
+	}
+}
+
+// recordImportListSecretHashes returns recorded with the hashes of the import
+// lists in ir, once they have been written
+func recordImportListSecretHashes(recorded map[string]string, ir *irv1.IR) map[string]string {
+	applied := collectImportListSecretHashes(ir)
+	if len(applied) == 0 {
+		return recorded
+	}
+	next := make(map[string]string, len(recorded)+len(applied))
+	maps.Copy(next, recorded)
+	maps.Copy(next, applied)
+	return next
+}
+
+// hasImportListErrors reports whether applying an import list failed
+func hasImportListErrors(result *adapters.ApplyResult) bool {
+	for _, applyErr := range result.Errors {
+		if applyErr.Change.ResourceType == adapters.ResourceImportList {
+			return true
+		}
+	}
+	return false
+}
+
+// nextSecretHashes returns the hashes to record after a successful apply.
+// Applied resources take the hashes just applied; resources in the spec that
+// were held back or out of scope keep what was recorded before; resources no
+// longer in the spec are dropped.
+func nextSecretHashes(recorded, applied, spec map[string]string) map[string]string {
+	next := make(map[string]string, len(spec))
+	for key := range spec {
+		if hash, ok := applied[key]; ok {
+			next[key] = hash
+		} else if hash, ok := recorded[key]; ok {
+			next[key] = hash
+		}
+	}
+	if len(next) == 0 {
+		return nil
+	}
+	return next
+}
+
+// dropUnkeyedSecretHashes removes hashes recorded before they were keyed with the
+// operator's secret key. Like hashes reset by a schema conversion, they can't be
+// compared: the resources take keyed hashes on their next apply.
+func dropUnkeyedSecretHashes(hashes map[string]string) map[string]string {
+	var keyed map[string]string
+	for key, hash := range hashes {
+		if compiler.IsKeyedSecretHash(hash) {
+			if keyed == nil {
+				keyed = make(map[string]string, len(hashes))
+			}
+			keyed[key] = hash
+		}
+	}
+	return keyed
+}
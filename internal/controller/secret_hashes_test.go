@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+var _ = Describe("Secret hashes", func() {
+	It("restores recorded hashes onto the current state", func() {
+		current := &irv1.IR{
+			DownloadClients: []irv1.DownloadClientIR{{Name: "qbit"}, {Name: "sab"}},
+			Indexers:        &irv1.IndexersIR{Direct: []irv1.IndexerIR{{Name: "nzbgeek"}}},
+		}
+		RestoreSecretHashes(current, map[string]string{
+			"downloadClient/qbit": "aaaa",
+			"indexer/nzbgeek":     "bbbb",
+		})
+		Expect(current.DownloadClients[0].SecretHash).To(Equal("aaaa"))
+		Expect(current.DownloadClients[1].SecretHash).To(BeEmpty())
+		Expect(current.Indexers.Direct[0].SecretHash).To(Equal("bbbb"))
+	})
+
+	It("detects rotations only against a recorded hash", func() {
+		desired := irv1.DownloadClientIR{Name: "qbit", SecretHash: "cccc"}
+		Expect(adapters.DownloadClientsEqual(irv1.DownloadClientIR{Name: "qbit", SecretHash: "aaaa"}, desired)).To(BeFalse())
+		Expect(adapters.DownloadClientsEqual(irv1.DownloadClientIR{Name: "qbit", SecretHash: "cccc"}, desired)).To(BeTrue())
+		Expect(adapters.DownloadClientsEqual(irv1.DownloadClientIR{Name: "qbit"}, desired)).To(BeTrue())
+	})
+
+	It("keeps the hashes of resources that were not applied", func() {
+		recorded := map[string]string{
+			"downloadClient/qbit":    "aaaa",
+			"downloadClient/held":    "dddd",
+			"downloadClient/removed": "eeee",
+		}
+		spec := map[string]string{
+			"downloadClient/qbit": "cccc",
+			"downloadClient/held": "ffff",
+		}
+		applied := map[string]string{"downloadClient/qbit": "cccc"}
+
+		Expect(nextSecretHashes(recorded, applied, spec)).To(Equal(map[string]string{
+			"downloadClient/qbit": "cccc",
+			"downloadClient/held": "dddd",
+		}))
+		Expect(nextSecretHashes(recorded, nil, nil)).To(BeNil())
+	})
+
+	It("drops hashes recorded before they were keyed", func() {
+		keyed := compiler.SecretHash(compiler.SecretSalt([]byte("key"), "uid"), "hunter2")
+		Expect(dropUnkeyedSecretHashes(map[string]string{
+			"downloadClient/qbit": keyed,
+			"downloadClient/sab":  "0123456789abcdef",
+		})).To(Equal(map[string]string{"downloadClient/qbit": keyed}))
+		Expect(dropUnkeyedSecretHashes(map[string]string{"indexer/nzbgeek": "0123456789abcdef"})).To(BeNil())
+	})
+
+	It("keeps Trakt tokens until their Secret changes", func() {
+		ir := &irv1.IR{ImportLists: []irv1.ImportListIR{
+			{Name: "favorites", TraktList: &irv1.TraktListImportIR{AccessToken: "t0ken", SecretHash: "aaaa"}},
+			{Name: "popular", TraktList: &irv1.TraktListImportIR{AccessToken: "rotated", SecretHash: "bbbb"}},
+			{Name: "plex", PlexRSS: &irv1.PlexRSSImportIR{URL: "https://rss.plex.tv/abc"}},
+		}}
+		recorded := map[string]string{
+			"downloadClient/qbit":  "cccc",
+			"importList/favorites": "aaaa",
+			"importList/popular":   "dddd",
+		}
+
+		keepUnchangedImportListTokens(ir, recorded)
+		Expect(ir.ImportLists[0].TraktList.KeepTokens).To(BeTrue())
+		Expect(ir.ImportLists[1].TraktList.KeepTokens).To(BeFalse())
+
+		Expect(recordImportListSecretHashes(recorded, ir)).To(Equal(map[string]string{
+			"downloadClient/qbit":  "cccc",
+			"importList/favorites": "aaaa",
+			"importList/popular":   "bbbb",
+		}))
+		Expect(recorded).To(HaveKeyWithValue("importList/popular", "dddd"))
+	})
+
+	It("detects failed import lists", func() {
+		Expect(hasImportListErrors(&adapters.ApplyResult{})).To(BeFalse())
+		Expect(hasImportListErrors(&adapters.ApplyResult{Errors: []adapters.ApplyError{
+			{Change: adapters.Change{ResourceType: adapters.ResourceImportList}},
+		}})).To(BeTrue())
+	})
+})
+
+var _ = Describe("Secret key", func() {
+	It("creates the key Secret once and reads it afterwards", func() {
+		ref := types.NamespacedName{Namespace: "default", Name: "nebularr-secret-key-test"}
+		DeferCleanup(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name},
+			}))).To(Succeed())
+		})
+
+		key, err := LoadSecretKey(ctx, k8sClient, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(key).To(HaveLen(secretKeyLength))
+
+		again, err := LoadSecretKey(ctx, k8sClient, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(key))
+	})
+})
@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SecretKeyDataKey is the key of the operator's secret key in its Secret
+const SecretKeyDataKey = "key"
+
+// secretKeyLength is the size of a generated secret key in bytes
+const secretKeyLength = 32
+
+// LoadSecretKey returns the secret key the operator keys credential hashes with
+// (see compiler.SecretSalt). The Secret is created with a random key the first
+// time; replicas racing to create it all end up with the one that was stored.
+func LoadSecretKey(ctx context.Context, c client.Client, ref types.NamespacedName) ([]byte, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		secret := &corev1.Secret{}
+		err := c.Get(ctx, ref, secret)
+		if err == nil {
+			key := secret.Data[SecretKeyDataKey]
+			if len(key) == 0 {
+				return nil, fmt.Errorf("secret %s has no %q key", ref, SecretKeyDataKey)
+			}
+			return key, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get secret %s: %w", ref, err)
+		}
+
+		key, err := NewSecretKey()
+		if err != nil {
+			return nil, err
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ref.Name,
+				Namespace: ref.Namespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "nebularr"},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{SecretKeyDataKey: key},
+		}
+		err = c.Create(ctx, secret)
+		if err == nil {
+			return key, nil
+		}
+		if !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create secret %s: %w", ref, err)
+		}
+	}
+	return nil, fmt.Errorf("secret %s was created concurrently but can't be read", ref)
+}
+
+// NewSecretKey generates a random secret key. Hashes keyed with it can only be
+// compared within the process, so it is meant for tools and operators run
+// without a key Secret.
+func NewSecretKey() ([]byte, error) {
+	key := make([]byte, secretKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate secret key: %w", err)
+	}
+	return key, nil
+}
@@ -41,6 +41,8 @@ type SonarrConfigReconciler struct {
 
 	// generic holds the shared reconciliation logic
 	generic *GenericArrReconciler
+
+	Options ControllerOptions
 }
 
 // +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=sonarrconfigs,verbs=get;list;watch;create;update;patch;delete
@@ -61,6 +63,7 @@ func (r *SonarrConfigReconciler) ensureInitialized() {
 	}
 	if r.Compiler == nil {
 		r.Compiler = compiler.New()
+		r.Compiler.SecretKey = r.Options.SecretKey
 	}
 	if r.Helper == nil {
 		r.Helper = NewReconcileHelper(r.Client)
@@ -71,6 +74,7 @@ func (r *SonarrConfigReconciler) ensureInitialized() {
 		Compiler: r.Compiler,
 		Helper:   r.Helper,
 		Recorder: r.Recorder,
+		Options:  r.Options,
 		CompileConfig: func(ctx context.Context, c *compiler.Compiler, config ArrConfigObject, secrets map[string]string, caps *adapters.Capabilities) (*irv1.IR, error) {
 			sonarrConfig := config.(*SonarrConfigAdapter).SonarrConfig
 			return c.CompileSonarrConfig(ctx, sonarrConfig, secrets, caps)
@@ -81,9 +85,11 @@ func (r *SonarrConfigReconciler) ensureInitialized() {
 // SetupWithManager sets up the controller with the Manager.
 func (r *SonarrConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ensureInitialized()
+	r.Helper.RestConfig = mgr.GetConfig()
+	r.Helper.LogDiffs = r.Options.LogDiffs
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.SonarrConfig{})
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&arrv1alpha1.SonarrConfig{}).
-		Named("sonarrconfig").
-		Complete(r)
+	return r.Options.complete(mgr, b, "sonarrconfig", &arrv1alpha1.SonarrConfigList{}, r)
 }
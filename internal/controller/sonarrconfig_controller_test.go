@@ -32,6 +32,7 @@ import (
 	"github.com/poiley/nebularr-operator/internal/adapters"
 	"github.com/poiley/nebularr-operator/internal/adapters/mock"
 	"github.com/poiley/nebularr-operator/internal/compiler"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
 )
 
 var _ = Describe("SonarrConfig Controller", func() {
@@ -311,6 +312,12 @@ var _ = Describe("SonarrConfig Controller", func() {
 					{ResourceType: adapters.ResourceQualityProfile, Name: "HD-1080p"},
 				},
 			})
+			profileID := 4
+			mockAdapter.WithManagedRefs(&adapters.ManagedRefs{
+				QualityProfileID:  &profileID,
+				QualityProfileIDs: []int{profileID},
+				DownloadClientIDs: []int{2, 7},
+			})
 
 			By("Creating the SonarrConfig resource")
 			Expect(k8sClient.Create(ctx, sonarrConfig)).To(Succeed())
@@ -329,6 +336,132 @@ var _ = Describe("SonarrConfig Controller", func() {
 
 			By("Checking that Apply was called")
 			Expect(mockAdapter.CallCounts()["Apply"]).To(BeNumerically(">=", 1))
+
+			By("Checking the remote IDs were recorded")
+			updatedConfig := &arrv1alpha1.SonarrConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updatedConfig)).To(Succeed())
+			Expect(updatedConfig.Status.ManagedResources.QualityProfileID).To(HaveValue(Equal(profileID)))
+			Expect(updatedConfig.Status.ManagedResources.QualityProfileIDs).To(Equal([]int{profileID}))
+			Expect(updatedConfig.Status.ManagedResources.DownloadClientIDs).To(Equal([]int{2, 7}))
+		})
+
+		It("should report changes that remain after apply as not converged", func() {
+			By("Configuring mock to keep finding an update after the create is applied")
+			diffs := 0
+			mockAdapter.DiffFunc = func(current, desired *irv1.IR, caps *adapters.Capabilities) (*adapters.ChangeSet, error) {
+				diffs++
+				if diffs == 1 {
+					return &adapters.ChangeSet{Creates: []adapters.Change{
+						{ResourceType: adapters.ResourceQualityProfile, Name: "HD-1080p"},
+					}}, nil
+				}
+				return &adapters.ChangeSet{Updates: []adapters.Change{
+					{ResourceType: adapters.ResourceQualityProfile, Name: "HD-1080p"},
+				}}, nil
+			}
+
+			By("Creating the SonarrConfig resource")
+			Expect(k8sClient.Create(ctx, sonarrConfig)).To(Succeed())
+
+			for i := 0; i < 2; i++ {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespaceName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			By("Checking the residual change in status")
+			Expect(mockAdapter.CallCounts()["Apply"]).To(Equal(1))
+			updatedConfig := &arrv1alpha1.SonarrConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updatedConfig)).To(Succeed())
+			Expect(updatedConfig.Status.Converged).NotTo(BeNil())
+			Expect(*updatedConfig.Status.Converged).To(BeFalse())
+			Expect(updatedConfig.Status.ResidualChanges).To(Equal([]arrv1alpha1.ResidualChange{
+				{Action: "update", ResourceType: adapters.ResourceQualityProfile, Name: "HD-1080p"},
+			}))
+		})
+
+		It("should publish the readiness ConfigMap once the configuration is applied", func() {
+			sonarrConfig.Spec.Reconciliation = &arrv1alpha1.ReconciliationSpec{ReadinessConfigMap: "sonarr-ready"}
+
+			By("Creating the SonarrConfig resource")
+			Expect(k8sClient.Create(ctx, sonarrConfig)).To(Succeed())
+
+			for i := 0; i < 2; i++ {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespaceName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			By("Checking the ConfigMap is owned by the config and marked applied")
+			cm := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "sonarr-ready", Namespace: namespace}, cm)).To(Succeed())
+			DeferCleanup(func() { _ = k8sClient.Delete(ctx, cm) })
+			Expect(cm.Data).To(HaveKeyWithValue("applied", "true"))
+			Expect(cm.Data).To(HaveKey("appliedAt"))
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+			Expect(cm.OwnerReferences[0].Name).To(Equal(resourceName))
+		})
+
+		It("should skip root folders the app can't see when preflightPaths is set", func() {
+			By("Configuring mock to miss one root folder")
+			mockAdapter.WithMissingPaths("/media/anime")
+			sonarrConfig.Spec.RootFolders = []string{"/data/tv", "/media/anime"}
+			sonarrConfig.Spec.Reconciliation = &arrv1alpha1.ReconciliationSpec{PreflightPaths: true}
+
+			By("Creating the SonarrConfig resource")
+			Expect(k8sClient.Create(ctx, sonarrConfig)).To(Succeed())
+
+			for i := 0; i < 2; i++ {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespaceName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			By("Checking that only the visible root folder reached the diff")
+			diffs := mockAdapter.DiffCalls
+			Expect(diffs).NotTo(BeEmpty())
+			Expect(diffs[len(diffs)-1].Desired.RootFolders).To(HaveLen(1))
+			Expect(diffs[len(diffs)-1].Desired.RootFolders[0].Path).To(Equal("/data/tv"))
+
+			By("Checking the PathsVerified condition")
+			updatedConfig := &arrv1alpha1.SonarrConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updatedConfig)).To(Succeed())
+			Expect(HasCondition(updatedConfig.Status.Conditions, ConditionTypePathsVerified, metav1.ConditionFalse)).To(BeTrue())
+		})
+
+		It("should report drift without a finalizer or writes when observe is set", func() {
+			By("Configuring mock to return changes")
+			mockAdapter.WithChanges(&adapters.ChangeSet{
+				Creates: []adapters.Change{
+					{ResourceType: adapters.ResourceQualityProfile, Name: "HD-1080p"},
+				},
+			})
+			sonarrConfig.Spec.Observe = true
+			sonarrConfig.Spec.MediaManagement = &arrv1alpha1.MediaManagementSpec{RecycleBin: "/recycle"}
+
+			By("Creating the SonarrConfig resource")
+			Expect(k8sClient.Create(ctx, sonarrConfig)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespaceName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Checking that nothing was written to the app")
+			Expect(mockAdapter.CallCounts()["Diff"]).To(Equal(1))
+			Expect(mockAdapter.CallCounts()["Apply"]).To(BeZero())
+			Expect(mockAdapter.ApplyDirectCalls).To(BeEmpty())
+
+			By("Checking the drift is reported and no finalizer was added")
+			updatedConfig := &arrv1alpha1.SonarrConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updatedConfig)).To(Succeed())
+			Expect(updatedConfig.Finalizers).To(BeEmpty())
+			Expect(HasCondition(updatedConfig.Status.Conditions, ConditionTypePendingChanges, metav1.ConditionTrue)).To(BeTrue())
+			Expect(HasCondition(updatedConfig.Status.Conditions, ConditionTypeSynced, metav1.ConditionFalse)).To(BeTrue())
+			Expect(updatedConfig.Status.Connected).To(BeTrue())
 		})
 	})
 })
@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters"
 )
 
 // RadarrStatusWrapper wraps RadarrConfigStatus to implement ConfigStatus
@@ -51,6 +52,40 @@ func (w *RadarrStatusWrapper) SetLastAppliedHash(hash string) {
 	w.Status.LastAppliedHash = hash
 }
 
+func (w *RadarrStatusWrapper) GetSecretHashes() map[string]string {
+	return w.Status.SecretHashes
+}
+
+func (w *RadarrStatusWrapper) SetSecretHashes(hashes map[string]string) {
+	w.Status.SecretHashes = hashes
+}
+
+func (w *RadarrStatusWrapper) GetIRSchemaVersion() string {
+	return w.Status.IRSchemaVersion
+}
+
+func (w *RadarrStatusWrapper) SetIRSchemaVersion(version string) {
+	w.Status.IRSchemaVersion = version
+}
+
+func (w *RadarrStatusWrapper) SetCompileResult(summary *arrv1alpha1.CompiledSummary, unrealized []arrv1alpha1.UnrealizedFeature) {
+	w.Status.CompiledSummary = summary
+	w.Status.UnrealizedFeatures = unrealized
+}
+
+func (w *RadarrStatusWrapper) SetInvalidFields(fields []arrv1alpha1.InvalidField) {
+	w.Status.InvalidFields = fields
+}
+
+func (w *RadarrStatusWrapper) SetConvergence(converged *bool, residual []arrv1alpha1.ResidualChange) {
+	w.Status.Converged = converged
+	w.Status.ResidualChanges = residual
+}
+
+func (w *RadarrStatusWrapper) SetManagedRefs(refs *adapters.ManagedRefs) {
+	w.Status.ManagedResources = managedResourcesStatus(refs)
+}
+
 // SonarrStatusWrapper wraps SonarrConfigStatus to implement ConfigStatus
 type SonarrStatusWrapper struct {
 	Status *arrv1alpha1.SonarrConfigStatus
@@ -80,6 +115,40 @@ func (w *SonarrStatusWrapper) SetLastAppliedHash(hash string) {
 	w.Status.LastAppliedHash = hash
 }
 
+func (w *SonarrStatusWrapper) GetSecretHashes() map[string]string {
+	return w.Status.SecretHashes
+}
+
+func (w *SonarrStatusWrapper) SetSecretHashes(hashes map[string]string) {
+	w.Status.SecretHashes = hashes
+}
+
+func (w *SonarrStatusWrapper) GetIRSchemaVersion() string {
+	return w.Status.IRSchemaVersion
+}
+
+func (w *SonarrStatusWrapper) SetIRSchemaVersion(version string) {
+	w.Status.IRSchemaVersion = version
+}
+
+func (w *SonarrStatusWrapper) SetCompileResult(summary *arrv1alpha1.CompiledSummary, unrealized []arrv1alpha1.UnrealizedFeature) {
+	w.Status.CompiledSummary = summary
+	w.Status.UnrealizedFeatures = unrealized
+}
+
+func (w *SonarrStatusWrapper) SetInvalidFields(fields []arrv1alpha1.InvalidField) {
+	w.Status.InvalidFields = fields
+}
+
+func (w *SonarrStatusWrapper) SetConvergence(converged *bool, residual []arrv1alpha1.ResidualChange) {
+	w.Status.Converged = converged
+	w.Status.ResidualChanges = residual
+}
+
+func (w *SonarrStatusWrapper) SetManagedRefs(refs *adapters.ManagedRefs) {
+	w.Status.ManagedResources = managedResourcesStatus(refs)
+}
+
 // LidarrStatusWrapper wraps LidarrConfigStatus to implement ConfigStatus
 type LidarrStatusWrapper struct {
 	Status *arrv1alpha1.LidarrConfigStatus
@@ -109,6 +178,40 @@ func (w *LidarrStatusWrapper) SetLastAppliedHash(hash string) {
 	w.Status.LastAppliedHash = hash
 }
 
+func (w *LidarrStatusWrapper) GetSecretHashes() map[string]string {
+	return w.Status.SecretHashes
+}
+
+func (w *LidarrStatusWrapper) SetSecretHashes(hashes map[string]string) {
+	w.Status.SecretHashes = hashes
+}
+
+func (w *LidarrStatusWrapper) GetIRSchemaVersion() string {
+	return w.Status.IRSchemaVersion
+}
+
+func (w *LidarrStatusWrapper) SetIRSchemaVersion(version string) {
+	w.Status.IRSchemaVersion = version
+}
+
+func (w *LidarrStatusWrapper) SetCompileResult(summary *arrv1alpha1.CompiledSummary, unrealized []arrv1alpha1.UnrealizedFeature) {
+	w.Status.CompiledSummary = summary
+	w.Status.UnrealizedFeatures = unrealized
+}
+
+func (w *LidarrStatusWrapper) SetInvalidFields(fields []arrv1alpha1.InvalidField) {
+	w.Status.InvalidFields = fields
+}
+
+func (w *LidarrStatusWrapper) SetConvergence(converged *bool, residual []arrv1alpha1.ResidualChange) {
+	w.Status.Converged = converged
+	w.Status.ResidualChanges = residual
+}
+
+func (w *LidarrStatusWrapper) SetManagedRefs(refs *adapters.ManagedRefs) {
+	w.Status.ManagedResources = managedResourcesStatus(refs)
+}
+
 // ProwlarrStatusWrapper wraps ProwlarrConfigStatus to implement ConfigStatus
 type ProwlarrStatusWrapper struct {
 	Status *arrv1alpha1.ProwlarrConfigStatus
@@ -138,6 +241,43 @@ func (w *ProwlarrStatusWrapper) SetLastAppliedHash(hash string) {
 	w.Status.LastAppliedHash = hash
 }
 
+func (w *ProwlarrStatusWrapper) GetSecretHashes() map[string]string {
+	return w.Status.SecretHashes
+}
+
+func (w *ProwlarrStatusWrapper) SetSecretHashes(hashes map[string]string) {
+	w.Status.SecretHashes = hashes
+}
+
+func (w *ProwlarrStatusWrapper) GetIRSchemaVersion() string {
+	return w.Status.IRSchemaVersion
+}
+
+func (w *ProwlarrStatusWrapper) SetIRSchemaVersion(version string) {
+	w.Status.IRSchemaVersion = version
+}
+
+func (w *ProwlarrStatusWrapper) SetCompileResult(summary *arrv1alpha1.CompiledSummary, unrealized []arrv1alpha1.UnrealizedFeature) {
+	w.Status.CompiledSummary = summary
+	w.Status.UnrealizedFeatures = unrealized
+}
+
+func (w *ProwlarrStatusWrapper) SetInvalidFields(fields []arrv1alpha1.InvalidField) {
+	w.Status.InvalidFields = fields
+}
+
+func (w *ProwlarrStatusWrapper) SetConvergence(converged *bool, residual []arrv1alpha1.ResidualChange) {
+	w.Status.Converged = converged
+	w.Status.ResidualChanges = residual
+}
+
+func (w *ProwlarrStatusWrapper) SetManagedRefs(refs *adapters.ManagedRefs) {
+	w.Status.ManagedIndexers = refs.IndexerIDs
+	w.Status.ManagedProxies = refs.ProxyIDs
+	w.Status.ManagedApplications = refs.ApplicationIDs
+	w.Status.ManagedDownloadClients = refs.DownloadClientIDs
+}
+
 // BazarrStatusWrapper wraps BazarrConfigStatus to implement ConfigStatus
 // Note: Bazarr has a different status structure (no Connected/ServiceVersion)
 type BazarrStatusWrapper struct {
@@ -169,6 +309,87 @@ func (w *BazarrStatusWrapper) SetLastAppliedHash(hash string) {
 	w.Status.LastAppliedHash = hash
 }
 
+func (w *BazarrStatusWrapper) GetSecretHashes() map[string]string {
+	// Bazarr manages no download clients or indexers
+	return nil
+}
+
+func (w *BazarrStatusWrapper) SetSecretHashes(hashes map[string]string) {
+	// Bazarr manages no download clients or indexers
+}
+
+func (w *BazarrStatusWrapper) GetIRSchemaVersion() string {
+	// Bazarr manages no download clients or indexers
+	return ""
+}
+
+func (w *BazarrStatusWrapper) SetIRSchemaVersion(version string) {
+	// Bazarr manages no download clients or indexers
+}
+
+func (w *BazarrStatusWrapper) SetCompileResult(summary *arrv1alpha1.CompiledSummary, unrealized []arrv1alpha1.UnrealizedFeature) {
+	// Bazarr doesn't compile IR, so there is nothing to summarize
+}
+
+func (w *BazarrStatusWrapper) SetInvalidFields(fields []arrv1alpha1.InvalidField) {
+	// Bazarr doesn't compile IR, so no spec values are rejected
+}
+
+// TautulliStatusWrapper wraps TautulliConfigStatus to implement ConfigStatus
+type TautulliStatusWrapper struct {
+	Status *arrv1alpha1.TautulliConfigStatus
+}
+
+func (w *TautulliStatusWrapper) GetConditions() []metav1.Condition {
+	return w.Status.Conditions
+}
+
+func (w *TautulliStatusWrapper) SetConditions(conditions []metav1.Condition) {
+	w.Status.Conditions = conditions
+}
+
+func (w *TautulliStatusWrapper) SetConnected(connected bool) {
+	w.Status.Connected = connected
+}
+
+func (w *TautulliStatusWrapper) SetServiceVersion(version string) {
+	w.Status.TautulliVersion = version
+}
+
+func (w *TautulliStatusWrapper) SetLastReconcile(t *metav1.Time) {
+	w.Status.LastReconcile = t
+}
+
+func (w *TautulliStatusWrapper) SetLastAppliedHash(hash string) {
+	// Tautulli records a hash per notifier and newsletter instead
+}
+
+func (w *TautulliStatusWrapper) GetSecretHashes() map[string]string {
+	// Tautulli manages no download clients or indexers
+	return nil
+}
+
+func (w *TautulliStatusWrapper) SetSecretHashes(hashes map[string]string) {
+	// Tautulli manages no download clients or indexers
+}
+
+func (w *TautulliStatusWrapper) GetIRSchemaVersion() string {
+	// Tautulli doesn't compile IR
+	return ""
+}
+
+func (w *TautulliStatusWrapper) SetIRSchemaVersion(version string) {
+	// Tautulli doesn't compile IR
+}
+
+func (w *TautulliStatusWrapper) SetCompileResult(summary *arrv1alpha1.CompiledSummary, unrealized []arrv1alpha1.UnrealizedFeature) {
+	// Tautulli doesn't compile IR, so there is nothing to summarize
+}
+
+func (w *TautulliStatusWrapper) SetInvalidFields(fields []arrv1alpha1.InvalidField) {
+	// Tautulli doesn't compile IR, so no spec values are rejected
+}
+
 // DownloadStackStatusWrapper implements ConfigStatus for DownloadStackConfig
 type DownloadStackStatusWrapper struct {
 	Status *arrv1alpha1.DownloadStackConfigStatus
@@ -199,6 +420,32 @@ func (w *DownloadStackStatusWrapper) SetLastAppliedHash(hash string) {
 	w.Status.GluetunConfigHash = hash
 }
 
+func (w *DownloadStackStatusWrapper) GetSecretHashes() map[string]string {
+	// A download stack manages no download clients or indexers
+	return nil
+}
+
+func (w *DownloadStackStatusWrapper) SetSecretHashes(hashes map[string]string) {
+	// A download stack manages no download clients or indexers
+}
+
+func (w *DownloadStackStatusWrapper) GetIRSchemaVersion() string {
+	// A download stack manages no download clients or indexers
+	return ""
+}
+
+func (w *DownloadStackStatusWrapper) SetIRSchemaVersion(version string) {
+	// A download stack manages no download clients or indexers
+}
+
+func (w *DownloadStackStatusWrapper) SetCompileResult(summary *arrv1alpha1.CompiledSummary, unrealized []arrv1alpha1.UnrealizedFeature) {
+	// DownloadStack doesn't compile IR, so there is nothing to summarize
+}
+
+func (w *DownloadStackStatusWrapper) SetInvalidFields(fields []arrv1alpha1.InvalidField) {
+	w.Status.InvalidFields = fields
+}
+
 // ReadarrStatusWrapper wraps ReadarrConfigStatus to implement ConfigStatus
 type ReadarrStatusWrapper struct {
 	Status *arrv1alpha1.ReadarrConfigStatus
@@ -227,3 +474,39 @@ func (w *ReadarrStatusWrapper) SetLastReconcile(t *metav1.Time) {
 func (w *ReadarrStatusWrapper) SetLastAppliedHash(hash string) {
 	w.Status.LastAppliedHash = hash
 }
+
+func (w *ReadarrStatusWrapper) GetSecretHashes() map[string]string {
+	// Readarr records no secret hashes, so credential rotations are not detected
+	return nil
+}
+
+func (w *ReadarrStatusWrapper) SetSecretHashes(hashes map[string]string) {
+	// Readarr records no secret hashes, so credential rotations are not detected
+}
+
+func (w *ReadarrStatusWrapper) GetIRSchemaVersion() string {
+	// Readarr records no secret hashes
+	return ""
+}
+
+func (w *ReadarrStatusWrapper) SetIRSchemaVersion(version string) {
+	// Readarr records no secret hashes
+}
+
+func (w *ReadarrStatusWrapper) SetCompileResult(summary *arrv1alpha1.CompiledSummary, unrealized []arrv1alpha1.UnrealizedFeature) {
+	w.Status.CompiledSummary = summary
+	w.Status.UnrealizedFeatures = unrealized
+}
+
+func (w *ReadarrStatusWrapper) SetInvalidFields(fields []arrv1alpha1.InvalidField) {
+	w.Status.InvalidFields = fields
+}
+
+func (w *ReadarrStatusWrapper) SetConvergence(converged *bool, residual []arrv1alpha1.ResidualChange) {
+	w.Status.Converged = converged
+	w.Status.ResidualChanges = residual
+}
+
+func (w *ReadarrStatusWrapper) SetManagedRefs(refs *adapters.ManagedRefs) {
+	w.Status.ManagedResources = managedResourcesStatus(refs)
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/adapters/tautulli"
+)
+
+const tautulliFinalizer = "tautulliconfig.arr.rinzler.cloud/finalizer"
+
+// ConditionTypeWatchStatistics reports whether watch statistics could be collected
+const ConditionTypeWatchStatistics = "WatchStatistics"
+
+// defaultWatchStatisticsRefresh is how often watch statistics are collected by default
+const defaultWatchStatisticsRefresh = 6 * time.Hour
+
+// TautulliConfigReconciler reconciles a TautulliConfig object
+type TautulliConfigReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Helper *ReconcileHelper
+
+	Options ControllerOptions
+}
+
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=tautulliconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=tautulliconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=arr.rinzler.cloud,resources=tautulliconfigs/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop
+func (r *TautulliConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	config := &arrv1alpha1.TautulliConfig{}
+	if err := r.Get(ctx, req.NamespacedName, config); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("TautulliConfig resource not found, ignoring")
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get TautulliConfig")
+		return ctrl.Result{}, err
+	}
+
+	// Check if reconciliation is suspended
+	if config.Spec.Reconciliation != nil && config.Spec.Reconciliation.Suspend {
+		log.Info("Reconciliation is suspended")
+		return ctrl.Result{}, nil
+	}
+
+	// Handle deletion
+	if !config.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, config)
+	}
+
+	// Ensure finalizer
+	if !controllerutil.ContainsFinalizer(config, tautulliFinalizer) {
+		controllerutil.AddFinalizer(config, tautulliFinalizer)
+		if err := r.Update(ctx, config); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	statusWrapper := &TautulliStatusWrapper{Status: &config.Status}
+	fail := func(reason string, err error) (ctrl.Result, error) {
+		r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionFalse, reason, err.Error())
+		if statusErr := r.Status().Update(ctx, config); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: ErrorRequeueInterval}, err
+	}
+
+	tautulliClient, err := newTautulliClient(ctx, r.Helper, config)
+	if err != nil {
+		config.Status.Connected = false
+		return fail("SecretResolutionFailed", err)
+	}
+
+	info, err := tautulliClient.GetInfo(ctx)
+	if err != nil {
+		log.Error(err, "Failed to connect to Tautulli")
+		config.Status.Connected = false
+		return fail("ConnectionFailed", err)
+	}
+	statusWrapper.SetConnected(true)
+	statusWrapper.SetServiceVersion(info.TautulliVersion)
+
+	// Sync notifiers
+	desiredNotifiers, err := r.desiredNotifiers(ctx, config)
+	if err != nil {
+		return fail("SecretResolutionFailed", err)
+	}
+	notifiers, err := tautulli.SyncNotifiers(ctx, tautulliClient, desiredNotifiers, config.Status.Notifiers)
+	if err != nil {
+		log.Error(err, "Failed to sync notifiers")
+		return fail("NotifierSyncFailed", err)
+	}
+	config.Status.Notifiers = notifiers.Items
+
+	// Sync newsletters, which are sent through the notifiers synced above
+	desiredNewsletters, err := desiredNewsletters(ctx, tautulliClient, config)
+	if err != nil {
+		return fail("InvalidNewsletter", err)
+	}
+	newsletters, err := tautulli.SyncNewsletters(ctx, tautulliClient, desiredNewsletters, config.Status.Newsletters)
+	if err != nil {
+		log.Error(err, "Failed to sync newsletters")
+		return fail("NewsletterSyncFailed", err)
+	}
+	config.Status.Newsletters = newsletters.Items
+
+	if notifiers.Created+notifiers.Updated+notifiers.Deleted+newsletters.Created+newsletters.Updated+newsletters.Deleted > 0 {
+		log.Info("Synced Tautulli",
+			"notifiersCreated", notifiers.Created, "notifiersUpdated", notifiers.Updated, "notifiersDeleted", notifiers.Deleted,
+			"newslettersCreated", newsletters.Created, "newslettersUpdated", newsletters.Updated, "newslettersDeleted", newsletters.Deleted)
+	}
+
+	r.updateWatchStatistics(ctx, tautulliClient, config, statusWrapper)
+
+	now := metav1.Now()
+	config.Status.LastReconcile = &now
+	r.Helper.SetCondition(statusWrapper, config.Generation, ConditionTypeReady, metav1.ConditionTrue, "Ready",
+		fmt.Sprintf("Tautulli %s configured: %d notifiers, %d newsletters",
+			info.TautulliVersion, len(config.Status.Notifiers), len(config.Status.Newsletters)))
+
+	if err := r.Status().Update(ctx, config); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+
+	requeueAfter := DefaultRequeueInterval
+	if config.Spec.Reconciliation != nil && config.Spec.Reconciliation.Interval != nil {
+		requeueAfter = config.Spec.Reconciliation.Interval.Duration
+	}
+	return ctrl.Result{RequeueAfter: r.Options.requeueAfter(requeueAfter)}, nil
+}
+
+// newTautulliClient resolves the API key and creates a Tautulli client
+func newTautulliClient(ctx context.Context, helper *ReconcileHelper, config *arrv1alpha1.TautulliConfig) (*tautulli.Client, error) {
+	conn := config.Spec.Connection
+	key := conn.APIKeySecretRef.Key
+	if key == "" {
+		key = "apiKey"
+	}
+	apiKey, err := helper.ResolveSecretValue(ctx, config.Namespace, conn.APIKeySecretRef.Name, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var timeout time.Duration
+	if conn.Timeout != nil {
+		timeout = conn.Timeout.Duration
+	}
+	return tautulli.NewClient(conn.URL, apiKey, timeout), nil
+}
+
+// desiredNotifiers merges each notifier's settings with the values of its settings Secret
+func (r *TautulliConfigReconciler) desiredNotifiers(ctx context.Context, config *arrv1alpha1.TautulliConfig) ([]tautulli.DesiredNotifier, error) {
+	desired := make([]tautulli.DesiredNotifier, 0, len(config.Spec.Notifiers))
+	for _, spec := range config.Spec.Notifiers {
+		settings := make(map[string]string, len(spec.Settings))
+		for key, value := range spec.Settings {
+			settings[key] = value
+		}
+		if spec.SettingsSecretRef != nil {
+			secret := &corev1.Secret{}
+			if err := r.Get(ctx, client.ObjectKey{Namespace: config.Namespace, Name: spec.SettingsSecretRef.Name}, secret); err != nil {
+				return nil, fmt.Errorf("failed to get notifier secret %s: %w", spec.SettingsSecretRef.Name, err)
+			}
+			for key, value := range secret.Data {
+				settings[key] = string(value)
+			}
+		}
+		desired = append(desired, tautulli.DesiredNotifier{Spec: spec, Settings: settings})
+	}
+	return desired, nil
+}
+
+// desiredNewsletters resolves the notifier and library names of each newsletter to IDs
+func desiredNewsletters(ctx context.Context, tautulliClient *tautulli.Client, config *arrv1alpha1.TautulliConfig) ([]tautulli.DesiredNewsletter, error) {
+	if len(config.Spec.Newsletters) == 0 {
+		return nil, nil
+	}
+
+	notifierIDs := make(map[string]int, len(config.Status.Notifiers))
+	for _, n := range config.Status.Notifiers {
+		notifierIDs[n.Name] = n.ID
+	}
+	libraries, err := tautulliClient.GetLibraries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := make([]tautulli.DesiredNewsletter, 0, len(config.Spec.Newsletters))
+	for _, spec := range config.Spec.Newsletters {
+		notifierID, ok := notifierIDs[spec.Notifier]
+		if !ok {
+			return nil, fmt.Errorf("newsletter %s: notifier %q is not a notifier of this TautulliConfig", spec.Name, spec.Notifier)
+		}
+		selected, err := tautulli.ResolveLibraries(libraries, spec.Libraries, "movie", "show", "artist")
+		if err != nil {
+			return nil, fmt.Errorf("newsletter %s: %w", spec.Name, err)
+		}
+		ids := make([]int, len(selected))
+		for i, lib := range selected {
+			ids[i] = int(lib.SectionID)
+		}
+		desired = append(desired, tautulli.DesiredNewsletter{Spec: spec, NotifierID: notifierID, LibraryIDs: ids})
+	}
+	return desired, nil
+}
+
+// updateWatchStatistics collects watch statistics once their refresh interval
+// has passed. Failures are reported through the WatchStatistics condition and
+// keep the previous statistics.
+func (r *TautulliConfigReconciler) updateWatchStatistics(ctx context.Context, tautulliClient *tautulli.Client, config *arrv1alpha1.TautulliConfig, status *TautulliStatusWrapper) {
+	log := logf.FromContext(ctx)
+
+	spec := config.Spec.WatchStatistics
+	if spec == nil {
+		config.Status.WatchStatistics = nil
+		config.Status.WatchStatisticsTime = nil
+		meta.RemoveStatusCondition(&config.Status.Conditions, ConditionTypeWatchStatistics)
+		return
+	}
+
+	refresh := defaultWatchStatisticsRefresh
+	if spec.RefreshInterval != nil {
+		refresh = spec.RefreshInterval.Duration
+	}
+	last := config.Status.WatchStatisticsTime
+	if last != nil && time.Since(last.Time) < refresh &&
+		meta.IsStatusConditionTrue(config.Status.Conditions, ConditionTypeWatchStatistics) {
+		return
+	}
+
+	now := metav1.Now()
+	stats, err := tautulli.CollectWatchStatistics(ctx, tautulliClient, spec, now.Time)
+	if err != nil {
+		log.Error(err, "Failed to collect watch statistics")
+		r.Helper.SetCondition(status, config.Generation, ConditionTypeWatchStatistics, metav1.ConditionFalse, "CollectionFailed", err.Error())
+		return
+	}
+	config.Status.WatchStatistics = stats
+	config.Status.WatchStatisticsTime = &now
+	r.Helper.SetCondition(status, config.Generation, ConditionTypeWatchStatistics, metav1.ConditionTrue, "Collected",
+		fmt.Sprintf("Watch statistics collected for %d libraries", len(stats)))
+}
+
+// reconcileDelete removes the notifiers and newsletters this resource created.
+// If Tautulli cannot be reached they are left in place.
+func (r *TautulliConfigReconciler) reconcileDelete(ctx context.Context, config *arrv1alpha1.TautulliConfig) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	log.Info("Handling deletion of TautulliConfig", "name", config.Name)
+
+	if tautulliClient, err := newTautulliClient(ctx, r.Helper, config); err != nil {
+		log.Error(err, "Failed to create Tautulli client, leaving notifiers and newsletters in place")
+	} else {
+		if _, err := tautulli.SyncNewsletters(ctx, tautulliClient, nil, config.Status.Newsletters); err != nil {
+			log.Error(err, "Failed to delete newsletters")
+		}
+		if _, err := tautulli.SyncNotifiers(ctx, tautulliClient, nil, config.Status.Notifiers); err != nil {
+			log.Error(err, "Failed to delete notifiers")
+		}
+	}
+
+	controllerutil.RemoveFinalizer(config, tautulliFinalizer)
+	if err := r.Update(ctx, config); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Successfully deleted TautulliConfig", "name", config.Name)
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TautulliConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Helper == nil {
+		r.Helper = NewReconcileHelper(r.Client)
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&arrv1alpha1.TautulliConfig{})
+
+	return r.Options.complete(mgr, b, "tautulliconfig", &arrv1alpha1.TautulliConfigList{}, r)
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+var _ = Describe("TautulliConfig Controller", func() {
+	Context("When reconciling a TautulliConfig resource", func() {
+		const (
+			resourceName = "test-tautulli"
+			namespace    = "default"
+			secretName   = "tautulli-credentials"
+		)
+
+		var (
+			ctx               context.Context
+			typeNamespaceName types.NamespacedName
+			tautulliConfig    *arrv1alpha1.TautulliConfig
+			secret            *corev1.Secret
+			reconciler        *TautulliConfigReconciler
+			server            *httptest.Server
+			mu                sync.Mutex
+			notifiers         map[int]map[string]string
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			typeNamespaceName = types.NamespacedName{Name: resourceName, Namespace: namespace}
+
+			// A minimal Tautulli API keeping notifiers in memory
+			notifiers = map[int]map[string]string{}
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				_ = r.ParseForm()
+				id, _ := strconv.Atoi(r.Form.Get("notifier_id"))
+
+				var data interface{}
+				switch r.Form.Get("cmd") {
+				case "get_tautulli_info":
+					data = map[string]string{"tautulli_version": "v2.14.0"}
+				case "get_notifiers":
+					list := []map[string]interface{}{}
+					for id, n := range notifiers {
+						list = append(list, map[string]interface{}{"id": id, "agent_name": "discord", "friendly_name": n["friendly_name"]})
+					}
+					data = list
+				case "get_notifier_config":
+					data = map[string]interface{}{"id": id, "agent_name": "discord", "friendly_name": notifiers[id]["friendly_name"]}
+				case "add_notifier_config":
+					id = len(notifiers) + 1
+					notifiers[id] = map[string]string{}
+					data = map[string]int{"notifier_id": id}
+				case "set_notifier_config":
+					for key := range r.PostForm {
+						notifiers[id][key] = r.PostForm.Get(key)
+					}
+				case "delete_notifier":
+					delete(notifiers, id)
+				}
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"response": map[string]interface{}{"result": "success", "data": data},
+				})
+			}))
+
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+				StringData: map[string]string{"apiKey": "test-tautulli-api-key"},
+			}
+			err := k8sClient.Create(ctx, secret)
+			if err != nil && !apierrors.IsAlreadyExists(err) {
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			tautulliConfig = &arrv1alpha1.TautulliConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: namespace},
+				Spec: arrv1alpha1.TautulliConfigSpec{
+					Connection: arrv1alpha1.TautulliConnectionSpec{
+						URL:             server.URL,
+						APIKeySecretRef: arrv1alpha1.SecretKeySelector{Name: secretName, Key: "apiKey"},
+					},
+					Notifiers: []arrv1alpha1.TautulliNotifierSpec{{
+						Name:     "discord-plays",
+						Agent:    "discord",
+						Triggers: []arrv1alpha1.TautulliTrigger{"play"},
+						Settings: map[string]string{"hook": "https://discord.example/hook"},
+					}},
+				},
+			}
+
+			reconciler = &TautulliConfigReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+				Helper: NewReconcileHelper(k8sClient),
+			}
+		})
+
+		AfterEach(func() {
+			resource := &arrv1alpha1.TautulliConfig{}
+			if err := k8sClient.Get(ctx, typeNamespaceName, resource); err == nil {
+				resource.Finalizers = nil
+				_ = k8sClient.Update(ctx, resource)
+				_ = k8sClient.Delete(ctx, resource)
+			}
+			_ = k8sClient.Delete(ctx, secret)
+			server.Close()
+		})
+
+		It("should create notifiers and remove them on deletion", func() {
+			Expect(k8sClient.Create(ctx, tautulliConfig)).To(Succeed())
+
+			By("Reconciling twice to add the finalizer and sync")
+			for i := 0; i < 2; i++ {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespaceName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			updated := &arrv1alpha1.TautulliConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updated)).To(Succeed())
+			Expect(updated.Status.Connected).To(BeTrue())
+			Expect(updated.Status.TautulliVersion).To(Equal("v2.14.0"))
+			Expect(updated.Status.Notifiers).To(HaveLen(1))
+			Expect(HasCondition(updated.Status.Conditions, ConditionTypeReady, metav1.ConditionTrue)).To(BeTrue())
+
+			mu.Lock()
+			notifier := notifiers[updated.Status.Notifiers[0].ID]
+			Expect(notifier["friendly_name"]).To(Equal("discord-plays"))
+			Expect(notifier["discord_hook"]).To(Equal("https://discord.example/hook"))
+			Expect(notifier["on_play"]).To(Equal("1"))
+			mu.Unlock()
+
+			By("Deleting the resource")
+			Expect(k8sClient.Delete(ctx, updated)).To(Succeed())
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespaceName})
+			Expect(err).NotTo(HaveOccurred())
+
+			mu.Lock()
+			Expect(notifiers).To(BeEmpty())
+			mu.Unlock()
+		})
+
+		It("should set Ready=False when the API key secret is missing", func() {
+			tautulliConfig.Spec.Connection.APIKeySecretRef.Name = "non-existent-secret"
+			Expect(k8sClient.Create(ctx, tautulliConfig)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespaceName})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespaceName})
+			Expect(err).To(HaveOccurred())
+
+			updated := &arrv1alpha1.TautulliConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updated)).To(Succeed())
+			Expect(updated.Status.Connected).To(BeFalse())
+			Expect(HasCondition(updated.Status.Conditions, ConditionTypeReady, metav1.ConditionFalse)).To(BeTrue())
+		})
+
+		It("should reject a newsletter whose notifier is not managed", func() {
+			tautulliConfig.Spec.Newsletters = []arrv1alpha1.TautulliNewsletterSpec{{Name: "weekly", Notifier: "email"}}
+			Expect(k8sClient.Create(ctx, tautulliConfig)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespaceName})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespaceName})
+			Expect(err).To(HaveOccurred())
+
+			updated := &arrv1alpha1.TautulliConfig{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, updated)).To(Succeed())
+			Expect(HasConditionWithReason(updated.Status.Conditions, ConditionTypeReady, metav1.ConditionFalse, "InvalidNewsletter")).To(BeTrue())
+		})
+	})
+})
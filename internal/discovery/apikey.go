@@ -106,8 +106,13 @@ func DiscoverAPIKeyFromPVC(
 	// Generate a unique job name
 	jobName := fmt.Sprintf("nebularr-apikey-discovery-%s", uuid.New().String()[:8])
 
-	// Create the Job
 	job := buildDiscoveryJob(jobName, namespace, pvcName, configPath, config)
+	return runConfigXMLJob(ctx, k8sClient, clientset, job, pvcName, config.Timeout)
+}
+
+// runConfigXMLJob runs a Job that prints config.xml and parses the API key from its logs.
+func runConfigXMLJob(ctx context.Context, k8sClient client.Client, clientset kubernetes.Interface, job *batchv1.Job, pvcName string, timeout time.Duration) (string, error) {
+	namespace, jobName := job.Namespace, job.Name
 
 	if err := k8sClient.Create(ctx, job); err != nil {
 		return "", fmt.Errorf("failed to create discovery job: %w", err)
@@ -122,7 +127,7 @@ func DiscoverAPIKeyFromPVC(
 	}()
 
 	// Wait for Job completion
-	if err := waitForJobCompletion(ctx, k8sClient, namespace, jobName, config.Timeout); err != nil {
+	if err := waitForJobCompletion(ctx, k8sClient, namespace, jobName, timeout); err != nil {
 		return "", fmt.Errorf("discovery job failed: %w", err)
 	}
 
@@ -0,0 +1,150 @@
+package discovery
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// bootstrapScript writes $CONTENT to $CONFIG_PATH unless a non-empty file already
+// exists there, then prints the file so the key it holds can be read back.
+const bootstrapScript = `if [ ! -s "$CONFIG_PATH" ]; then
+  mkdir -p "$(dirname "$CONFIG_PATH")" && printf '%s' "$CONTENT" > "$CONFIG_PATH"
+fi
+cat "$CONFIG_PATH"`
+
+// bootstrapSecretKey is the key of the config.xml in a bootstrap Secret
+const bootstrapSecretKey = "config.xml"
+
+// GenerateAPIKey returns a random API key in the format the *arr apps use
+// (32 lowercase hex characters).
+func GenerateAPIKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// BootstrapConfigXML returns a minimal config.xml holding only the API key.
+// The app fills in every other setting with its defaults on first start.
+func BootstrapConfigXML(apiKey string) []byte {
+	return []byte("<Config>\n  <ApiKey>" + apiKey + "</ApiKey>\n</Config>\n")
+}
+
+// BootstrapAPIKeyInFile writes a config.xml holding apiKey unless one already
+// exists at path, then returns the key the file holds. An existing file wins,
+// so a key the app generated itself is never replaced.
+func BootstrapAPIKeyInFile(path, apiKey string) (string, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	switch {
+	case err == nil:
+		_, werr := f.Write(BootstrapConfigXML(apiKey))
+		if cerr := f.Close(); werr == nil {
+			werr = cerr
+		}
+		if werr != nil {
+			return "", fmt.Errorf("failed to write config.xml: %w", werr)
+		}
+	case !errors.Is(err, fs.ErrExist):
+		return "", fmt.Errorf("failed to create config.xml: %w", err)
+	}
+
+	return DiscoverAPIKeyFromFile(path)
+}
+
+// BootstrapAPIKeyInPVC writes a config.xml holding apiKey into a
+// PersistentVolumeClaim unless one already exists, using a temporary Job, and
+// returns the key the file holds.
+func BootstrapAPIKeyInPVC(
+	ctx context.Context,
+	k8sClient client.Client,
+	clientset kubernetes.Interface,
+	namespace, pvcName, configPath, apiKey string,
+	config *PVCDiscoveryConfig,
+) (string, error) {
+	if config == nil {
+		config = DefaultPVCDiscoveryConfig()
+	}
+
+	if configPath == "" {
+		configPath = "config.xml"
+	}
+
+	jobName := fmt.Sprintf("nebularr-apikey-bootstrap-%s", uuid.New().String()[:8])
+
+	// The Job reads config.xml from a Secret, so the key isn't visible in the Job or Pod spec
+	secret := buildBootstrapSecret(jobName, namespace, apiKey)
+	if err := k8sClient.Create(ctx, secret); err != nil {
+		return "", fmt.Errorf("failed to create bootstrap secret: %w", err)
+	}
+	defer func() {
+		// Best-effort cleanup, like the Job's
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = k8sClient.Delete(cleanupCtx, secret)
+	}()
+
+	job := buildBootstrapJob(jobName, namespace, pvcName, configPath, secret.Name, config)
+	return runConfigXMLJob(ctx, k8sClient, clientset, job, pvcName, config.Timeout)
+}
+
+// buildBootstrapSecret creates the Secret holding the config.xml a bootstrap Job writes
+func buildBootstrapSecret(name, namespace, apiKey string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "nebularr",
+				"app.kubernetes.io/component":  "apikey-bootstrap",
+				"app.kubernetes.io/managed-by": "nebularr-operator",
+			},
+		},
+		Data: map[string][]byte{bootstrapSecretKey: BootstrapConfigXML(apiKey)},
+	}
+}
+
+// buildBootstrapJob creates a Job spec that writes config.xml to a PVC if it is missing.
+// It is the discovery Job with a writable mount and the bootstrap script; the
+// content comes from the Secret secretName.
+func buildBootstrapJob(name, namespace, pvcName, configPath, secretName string, config *PVCDiscoveryConfig) *batchv1.Job {
+	job := buildDiscoveryJob(name, namespace, pvcName, configPath, config)
+	job.Labels["app.kubernetes.io/component"] = "apikey-bootstrap"
+
+	pod := &job.Spec.Template.Spec
+	pod.Volumes[0].PersistentVolumeClaim.ReadOnly = false
+
+	container := &pod.Containers[0]
+	container.Name = "bootstrap"
+	container.Command = []string{"sh", "-c", bootstrapScript}
+	container.Env = []corev1.EnvVar{
+		{Name: "CONFIG_PATH", Value: "/config/" + configPath},
+		{Name: "CONTENT", ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  bootstrapSecretKey,
+			},
+		}},
+	}
+	container.VolumeMounts[0].ReadOnly = false
+
+	return job
+}
@@ -0,0 +1,109 @@
+package discovery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DiscoverAPIKeyFromFile reads the API key from a config.xml the operator can see
+// directly, e.g. the app's config volume mounted read-only into the operator pod.
+func DiscoverAPIKeyFromFile(path string) (string, error) {
+	config, err := ParseConfigXMLFromFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	if config.ApiKey == "" {
+		return "", fmt.Errorf("ApiKey is empty in %s", path)
+	}
+
+	return config.ApiKey, nil
+}
+
+// FindRunningPod returns a running pod matching the labels, preferring ready pods.
+func FindRunningPod(ctx context.Context, k8sClient client.Client, namespace string, labels map[string]string) (*corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := k8sClient.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabels(labels)); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	var running *corev1.Pod
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+				return pod, nil
+			}
+		}
+		if running == nil {
+			running = pod
+		}
+	}
+
+	if running == nil {
+		return nil, fmt.Errorf("no running pod matches %v in namespace %s", labels, namespace)
+	}
+	return running, nil
+}
+
+// DiscoverAPIKeyFromPod reads config.xml by running cat inside the app's container.
+// An empty container name selects the pod's first container.
+func DiscoverAPIKeyFromPod(
+	ctx context.Context,
+	restConfig *rest.Config,
+	clientset kubernetes.Interface,
+	pod *corev1.Pod,
+	container, configPath string,
+) (string, error) {
+	if container == "" && len(pod.Spec.Containers) > 0 {
+		container = pod.Spec.Containers[0].Name
+	}
+
+	req := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   []string{"cat", configPath},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(restConfig, "POST", req.URL())
+	if err != nil {
+		return "", fmt.Errorf("failed to create exec session: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}); err != nil {
+		return "", fmt.Errorf("failed to read %s in pod %s/%s: %w (%s)",
+			configPath, pod.Namespace, pod.Name, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	arrConfig, err := ParseConfigXML(&stdout)
+	if err != nil {
+		return "", err
+	}
+
+	if arrConfig.ApiKey == "" {
+		return "", fmt.Errorf("ApiKey is empty in config.xml from pod %s/%s", pod.Namespace, pod.Name)
+	}
+
+	return arrConfig.ApiKey, nil
+}
@@ -0,0 +1,360 @@
+// Package instancediff compares the configuration of two live instances of the
+// same *arr app, e.g. staging and production, for nebularr-diff.
+package instancediff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// collection is an API endpoint listing taggable resources
+type collection struct {
+	resourceType string
+	path         string
+}
+
+// collections lists the taggable resources of each app. Only these can be told
+// apart as unmanaged, since Nebularr marks what it owns with a tag.
+var collections = map[string][]collection{
+	adapters.AppRadarr: {
+		{adapters.ResourceDownloadClient, "/api/v3/downloadclient"},
+		{adapters.ResourceIndexer, "/api/v3/indexer"},
+		{adapters.ResourceImportList, "/api/v3/importlist"},
+		{adapters.ResourceNotification, "/api/v3/notification"},
+	},
+	adapters.AppSonarr: {
+		{adapters.ResourceDownloadClient, "/api/v3/downloadclient"},
+		{adapters.ResourceIndexer, "/api/v3/indexer"},
+		{adapters.ResourceImportList, "/api/v3/importlist"},
+		{adapters.ResourceNotification, "/api/v3/notification"},
+	},
+	adapters.AppLidarr: {
+		{adapters.ResourceDownloadClient, "/api/v1/downloadclient"},
+		{adapters.ResourceIndexer, "/api/v1/indexer"},
+		{adapters.ResourceImportList, "/api/v1/importlist"},
+		{adapters.ResourceNotification, "/api/v1/notification"},
+	},
+	adapters.AppReadarr: {
+		{adapters.ResourceDownloadClient, "/api/v1/downloadclient"},
+		{adapters.ResourceIndexer, "/api/v1/indexer"},
+		{adapters.ResourceImportList, "/api/v1/importlist"},
+		{adapters.ResourceNotification, "/api/v1/notification"},
+	},
+	adapters.AppProwlarr: {
+		{adapters.ResourceApplication, "/api/v1/applications"},
+		{adapters.ResourceDownloadClient, "/api/v1/downloadclient"},
+		{adapters.ResourceIndexer, "/api/v1/indexer"},
+		{adapters.ResourceNotification, "/api/v1/notification"},
+	},
+}
+
+// ignoredKeys are resource keys that differ between instances without being
+// configuration: IDs, tag IDs and UI hints
+var ignoredKeys = map[string]bool{
+	"id":       true,
+	"tags":     true,
+	"infoLink": true,
+	"message":  true,
+	"presets":  true,
+}
+
+// Resource is an unmanaged resource, normalized for comparison
+type Resource struct {
+	// Type is the resource type, e.g. DownloadClient
+	Type string
+
+	// Name is the resource name, which identifies it across instances
+	Name string
+
+	// Settings maps top-level keys and provider fields (as fields.<name>) to values
+	Settings map[string]interface{}
+}
+
+// Snapshot is the configuration of one instance
+type Snapshot struct {
+	// Managed is the state owned by the connection's ownership tag
+	Managed *irv1.IR
+
+	// Unmanaged lists the taggable resources carrying no Nebularr tag
+	Unmanaged []Resource
+
+	// Capabilities are the instance's discovered capabilities
+	Capabilities *adapters.Capabilities
+}
+
+// Take reads the managed state of an instance through its adapter, and the
+// resources no Nebularr config owns straight from its API
+func Take(ctx context.Context, adapter adapters.Adapter, conn *irv1.ConnectionIR) (*Snapshot, error) {
+	if _, err := adapter.Connect(ctx, conn); err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", conn.URL, err)
+	}
+	caps, err := adapter.Discover(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover capabilities: %w", err)
+	}
+	managed, err := adapter.CurrentState(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current state: %w", err)
+	}
+	unmanaged, err := unmanagedResources(ctx, httpclient.New(httpclient.ConfigForConnection(conn)), adapter.SupportedApp())
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{Managed: managed, Unmanaged: unmanaged, Capabilities: caps}, nil
+}
+
+// unmanagedResources lists the taggable resources that carry no nebularr-* tag
+func unmanagedResources(ctx context.Context, c *httpclient.Client, app string) ([]Resource, error) {
+	apiVersion := "v1"
+	if app == adapters.AppRadarr || app == adapters.AppSonarr {
+		apiVersion = "v3"
+	}
+	var tags []struct {
+		ID    int    `json:"id"`
+		Label string `json:"label"`
+	}
+	if err := c.Get(ctx, fmt.Sprintf("/api/%s/tag", apiVersion), &tags); err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	nebularrTags := make(map[float64]bool)
+	for _, tag := range tags {
+		if strings.HasPrefix(strings.ToLower(tag.Label), "nebularr-") {
+			nebularrTags[float64(tag.ID)] = true
+		}
+	}
+
+	var resources []Resource
+	for _, coll := range collections[app] {
+		var items []map[string]interface{}
+		if err := c.Get(ctx, coll.path, &items); err != nil {
+			return nil, fmt.Errorf("failed to get %s: %w", coll.path, err)
+		}
+		for _, item := range items {
+			if !hasTag(item, nebularrTags) {
+				resources = append(resources, normalize(coll.resourceType, item))
+			}
+		}
+	}
+	return resources, nil
+}
+
+// hasTag reports whether a decoded resource carries one of the given tag IDs
+func hasTag(item map[string]interface{}, tagIDs map[float64]bool) bool {
+	tags, _ := item["tags"].([]interface{})
+	for _, tag := range tags {
+		if id, ok := tag.(float64); ok && tagIDs[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize flattens a decoded resource into comparable settings. Provider
+// fields become fields.<name>, so they compare by name rather than position.
+func normalize(resourceType string, item map[string]interface{}) Resource {
+	name, _ := item["name"].(string)
+	settings := make(map[string]interface{})
+	for key, value := range item {
+		if ignoredKeys[key] || key == "name" {
+			continue
+		}
+		if key == "fields" {
+			fields, _ := value.([]interface{})
+			for _, f := range fields {
+				field, ok := f.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if fieldName, ok := field["name"].(string); ok {
+					settings["fields."+fieldName] = field["value"]
+				}
+			}
+			continue
+		}
+		settings[key] = value
+	}
+	return Resource{Type: resourceType, Name: name, Settings: settings}
+}
+
+// Difference is an unmanaged resource missing on one side or set differently
+type Difference struct {
+	Type string
+	Name string
+
+	// OnlyIn is "from" or "to" when the resource exists on one side only
+	OnlyIn string
+
+	// Keys lists the settings that differ, sorted
+	Keys []string
+}
+
+// Report is the comparison of two snapshots
+type Report struct {
+	// Managed holds the changes that would make the managed state of the "to"
+	// instance match the "from" instance
+	Managed *adapters.ChangeSet
+
+	// Unmanaged lists the differences between unmanaged resources
+	Unmanaged []Difference
+}
+
+// IsEmpty reports whether the instances match
+func (r *Report) IsEmpty() bool {
+	return (r.Managed == nil || r.Managed.IsEmpty()) && len(r.Unmanaged) == 0
+}
+
+// Compare diffs two snapshots of the same app. The managed state is diffed by
+// the adapter with "from" as the desired state, so the changes read as what
+// promoting "from" to "to" would do.
+func Compare(adapter adapters.Adapter, from, to *Snapshot) (*Report, error) {
+	changes, err := adapter.Diff(to.Managed, from.Managed, to.Capabilities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute diff: %w", err)
+	}
+	return &Report{Managed: changes, Unmanaged: compareUnmanaged(from.Unmanaged, to.Unmanaged)}, nil
+}
+
+// compareUnmanaged matches resources by type and case-insensitive name
+func compareUnmanaged(from, to []Resource) []Difference {
+	key := func(r Resource) string { return r.Type + "/" + strings.ToLower(r.Name) }
+	toByKey := make(map[string]Resource, len(to))
+	for _, r := range to {
+		toByKey[key(r)] = r
+	}
+
+	var diffs []Difference
+	seen := make(map[string]bool, len(from))
+	for _, f := range from {
+		k := key(f)
+		seen[k] = true
+		t, ok := toByKey[k]
+		if !ok {
+			diffs = append(diffs, Difference{Type: f.Type, Name: f.Name, OnlyIn: "from"})
+			continue
+		}
+		if keys := differingKeys(f.Settings, t.Settings); len(keys) > 0 {
+			diffs = append(diffs, Difference{Type: f.Type, Name: f.Name, Keys: keys})
+		}
+	}
+	for _, t := range to {
+		if !seen[key(t)] {
+			diffs = append(diffs, Difference{Type: t.Type, Name: t.Name, OnlyIn: "to"})
+		}
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		if diffs[i].Type != diffs[j].Type {
+			return diffs[i].Type < diffs[j].Type
+		}
+		return diffs[i].Name < diffs[j].Name
+	})
+	return diffs
+}
+
+// differingKeys returns the sorted keys set differently on either side
+func differingKeys(a, b map[string]interface{}) []string {
+	var keys []string
+	for k, v := range a {
+		if w, ok := b[k]; !ok || !reflect.DeepEqual(v, w) {
+			keys = append(keys, k)
+		}
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Render writes a report, naming the instances by their labels
+func Render(w io.Writer, title, fromLabel, toLabel string, r *Report) error {
+	p := &printer{w: w}
+	p.printf("%s\n\n", title)
+
+	if r.IsEmpty() {
+		p.printf("No differences. Both instances have the same configuration.\n")
+		return p.err
+	}
+
+	p.printf("Managed resources (promoting %s to %s would):\n", fromLabel, toLabel)
+	if r.Managed == nil || r.Managed.IsEmpty() {
+		p.printf("  no changes\n")
+	} else {
+		for _, line := range changeLines(r.Managed) {
+			p.printf("  %s %s %q\n", line.symbol, line.change.ResourceType, line.change.Name)
+		}
+	}
+
+	p.printf("\nUnmanaged resources:\n")
+	if len(r.Unmanaged) == 0 {
+		p.printf("  no differences\n")
+	}
+	for _, d := range r.Unmanaged {
+		switch d.OnlyIn {
+		case "from":
+			p.printf("  < %s %q only on %s\n", d.Type, d.Name, fromLabel)
+		case "to":
+			p.printf("  > %s %q only on %s\n", d.Type, d.Name, toLabel)
+		default:
+			p.printf("  ~ %s %q differs: %s\n", d.Type, d.Name, strings.Join(d.Keys, ", "))
+		}
+	}
+
+	managed := 0
+	if r.Managed != nil {
+		managed = r.Managed.TotalChanges()
+	}
+	p.printf("\nDifferences: %d managed, %d unmanaged.\n", managed, len(r.Unmanaged))
+	return p.err
+}
+
+// changeLine is a single managed change with its action symbol
+type changeLine struct {
+	symbol string
+	change adapters.Change
+}
+
+// changeLines flattens a change set into lines sorted by resource type, then name
+func changeLines(changes *adapters.ChangeSet) []changeLine {
+	lines := make([]changeLine, 0, changes.TotalChanges())
+	for _, c := range changes.Creates {
+		lines = append(lines, changeLine{symbol: "+", change: c})
+	}
+	for _, c := range changes.Updates {
+		lines = append(lines, changeLine{symbol: "~", change: c})
+	}
+	for _, c := range changes.Deletes {
+		lines = append(lines, changeLine{symbol: "-", change: c})
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		if lines[i].change.ResourceType != lines[j].change.ResourceType {
+			return lines[i].change.ResourceType < lines[j].change.ResourceType
+		}
+		return lines[i].change.Name < lines[j].change.Name
+	})
+	return lines
+}
+
+// printer remembers the first write error so callers check it once
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err != nil {
+		return
+	}
+	_, p.err = fmt.Fprintf(p.w, format, args...)
+}
@@ -0,0 +1,136 @@
+package instancediff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	"github.com/poiley/nebularr-operator/internal/adapters/mock"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// fakeInstance serves the tag and collection endpoints of a Prowlarr instance
+func fakeInstance(t *testing.T, responses map[string]interface{}) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			body = []interface{}{}
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTake(t *testing.T) {
+	server := fakeInstance(t, map[string]interface{}{
+		"/api/v1/tag": []map[string]interface{}{
+			{"id": 1, "label": "nebularr-media-prowlarr"},
+			{"id": 2, "label": "4k"},
+		},
+		"/api/v1/indexer": []map[string]interface{}{
+			{"id": 10, "name": "Managed", "tags": []int{1}},
+			{"id": 11, "name": "Manual", "tags": []int{2}, "enable": true, "infoLink": "https://example.com",
+				"fields": []map[string]interface{}{{"name": "baseUrl", "value": "https://indexer"}}},
+		},
+	})
+
+	adapter := mock.NewAdapter(adapters.AppProwlarr)
+	adapter.CurrentStateFunc = func(ctx context.Context, conn *irv1.ConnectionIR) (*irv1.IR, error) {
+		return &irv1.IR{App: adapters.AppProwlarr}, nil
+	}
+
+	snapshot, err := Take(context.Background(), adapter, &irv1.ConnectionIR{URL: server.URL, APIKey: "key"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []Resource{{
+		Type: adapters.ResourceIndexer,
+		Name: "Manual",
+		Settings: map[string]interface{}{
+			"enable":         true,
+			"fields.baseUrl": "https://indexer",
+		},
+	}}
+	if !reflect.DeepEqual(snapshot.Unmanaged, want) {
+		t.Errorf("expected unmanaged %+v, got %+v", want, snapshot.Unmanaged)
+	}
+	if snapshot.Managed == nil || snapshot.Managed.App != adapters.AppProwlarr {
+		t.Errorf("expected the adapter's current state, got %+v", snapshot.Managed)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	adapter := mock.NewAdapter(adapters.AppRadarr)
+	var gotCurrent, gotDesired *irv1.IR
+	adapter.DiffFunc = func(current, desired *irv1.IR, caps *adapters.Capabilities) (*adapters.ChangeSet, error) {
+		gotCurrent, gotDesired = current, desired
+		return &adapters.ChangeSet{Creates: []adapters.Change{
+			{ResourceType: adapters.ResourceDownloadClient, Name: "qbittorrent"},
+		}}, nil
+	}
+
+	from := &Snapshot{
+		Managed: &irv1.IR{SourceHash: "from"},
+		Unmanaged: []Resource{
+			{Type: adapters.ResourceNotification, Name: "Discord", Settings: map[string]interface{}{"onGrab": true}},
+			{Type: adapters.ResourceIndexer, Name: "NZBgeek", Settings: map[string]interface{}{"priority": 25.0}},
+		},
+	}
+	to := &Snapshot{
+		Managed: &irv1.IR{SourceHash: "to"},
+		Unmanaged: []Resource{
+			{Type: adapters.ResourceIndexer, Name: "nzbgeek", Settings: map[string]interface{}{"priority": 10.0, "fields.apiPath": "/api"}},
+			{Type: adapters.ResourceDownloadClient, Name: "SABnzbd", Settings: map[string]interface{}{}},
+		},
+	}
+
+	report, err := Compare(adapter, from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotCurrent.SourceHash != "to" || gotDesired.SourceHash != "from" {
+		t.Error("expected the target instance diffed against the source instance")
+	}
+
+	wantUnmanaged := []Difference{
+		{Type: adapters.ResourceDownloadClient, Name: "SABnzbd", OnlyIn: "to"},
+		{Type: adapters.ResourceIndexer, Name: "NZBgeek", Keys: []string{"fields.apiPath", "priority"}},
+		{Type: adapters.ResourceNotification, Name: "Discord", OnlyIn: "from"},
+	}
+	if !reflect.DeepEqual(report.Unmanaged, wantUnmanaged) {
+		t.Errorf("expected %+v, got %+v", wantUnmanaged, report.Unmanaged)
+	}
+
+	var out bytes.Buffer
+	if err := Render(&out, "radarr: staging → prod", "staging", "prod", report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "radarr: staging → prod\n\n" +
+		"Managed resources (promoting staging to prod would):\n" +
+		"  + DownloadClient \"qbittorrent\"\n\n" +
+		"Unmanaged resources:\n" +
+		"  > DownloadClient \"SABnzbd\" only on prod\n" +
+		"  ~ Indexer \"NZBgeek\" differs: fields.apiPath, priority\n" +
+		"  < Notification \"Discord\" only on staging\n\n" +
+		"Differences: 1 managed, 3 unmanaged.\n"
+	if out.String() != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestRenderNoDifferences(t *testing.T) {
+	var out bytes.Buffer
+	if err := Render(&out, "sonarr: a → b", "a", "b", &Report{Managed: &adapters.ChangeSet{}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "sonarr: a → b\n\nNo differences. Both instances have the same configuration.\n"
+	if out.String() != want {
+		t.Errorf("expected %q, got %q", want, out.String())
+	}
+}
@@ -0,0 +1,144 @@
+// Package conversion upgrades IR, and state derived from it, that was recorded
+// by older operator versions to the schema this operator compiles.
+//
+// The operator records compiled state in config status (secretHashes) and in IR
+// snapshots. When the IR schema changes, that state is converted before it is
+// compared with a freshly compiled IR, so an upgrade alone doesn't make every
+// resource look changed and trigger a mass re-apply.
+package conversion
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// Current is the IR schema version this operator compiles
+const Current = irv1.IRVersion
+
+// legacyVersion is assumed for state recorded before the schema version was
+// stored; every such operator compiled v1
+const legacyVersion = "v1"
+
+// ErrUnknownVersion is returned for state written by a newer operator, which
+// can't be converted back
+var ErrUnknownVersion = errors.New("unknown IR schema version")
+
+// Step upgrades state of one IR schema version to the next
+type Step struct {
+	// From and To are the schema versions the step converts between
+	From, To string
+
+	// IR rewrites a decoded IR document in place; nil leaves it unchanged
+	IR func(doc map[string]interface{}) error
+
+	// SecretHashKey maps a status.secretHashes key to its new form; nil keeps the key
+	SecretHashKey func(key string) string
+
+	// ResetSecretHashes marks a change of the secret hash scheme. Hashes recorded
+	// before it can't be compared and are dropped: a resource without a recorded
+	// hash is not treated as rotated, and takes the new hash on its next apply.
+	ResetSecretHashes bool
+}
+
+// steps is the ordered upgrade chain ending at Current. It is empty while v1 is
+// the only schema; introducing irv2 adds a {From: "v1", To: "v2"} step here that
+// renames or restructures the v1 sections.
+var steps []Step
+
+// path returns the steps that upgrade from to Current
+func path(from string) ([]Step, error) {
+	if from == "" {
+		from = legacyVersion
+	}
+	var chain []Step
+	for version := from; version != Current; {
+		next := -1
+		for i := range steps {
+			if steps[i].From == version {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil, fmt.Errorf("%w %q (operator compiles %s)", ErrUnknownVersion, from, Current)
+		}
+		chain = append(chain, steps[next])
+		version = steps[next].To
+	}
+	return chain, nil
+}
+
+// NeedsConversion reports whether state recorded at version differs from Current
+func NeedsConversion(version string) bool {
+	if version == "" {
+		version = legacyVersion
+	}
+	return version != Current
+}
+
+// ConvertIR upgrades an encoded IR to Current. The result is indented like the
+// IR snapshots; a document already at Current is returned unchanged.
+func ConvertIR(data []byte) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode IR: %w", err)
+	}
+	from, _ := doc["version"].(string)
+	if !NeedsConversion(from) {
+		return data, nil
+	}
+
+	chain, err := path(from)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range chain {
+		if step.IR != nil {
+			if err := step.IR(doc); err != nil {
+				return nil, fmt.Errorf("failed to convert IR from %s to %s: %w", step.From, step.To, err)
+			}
+		}
+		doc["version"] = step.To
+	}
+
+	converted, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode IR: %w", err)
+	}
+	return converted, nil
+}
+
+// SecretHashes upgrades the secret hashes recorded in status at version to
+// Current. Hashes that can't be converted are dropped rather than compared, so
+// they never cause a re-apply on their own.
+func SecretHashes(version string, hashes map[string]string) (map[string]string, error) {
+	if !NeedsConversion(version) || len(hashes) == 0 {
+		return hashes, nil
+	}
+
+	chain, err := path(version)
+	if err != nil {
+		return nil, err
+	}
+	converted := make(map[string]string, len(hashes))
+	for key, hash := range hashes {
+		converted[key] = hash
+	}
+	for _, step := range chain {
+		if step.ResetSecretHashes {
+			return nil, nil
+		}
+		if step.SecretHashKey == nil {
+			continue
+		}
+		renamed := make(map[string]string, len(converted))
+		for key, hash := range converted {
+			renamed[step.SecretHashKey(key)] = hash
+		}
+		converted = renamed
+	}
+	return converted, nil
+}
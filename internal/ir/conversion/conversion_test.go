@@ -0,0 +1,101 @@
+package conversion
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// withSteps swaps in a test upgrade chain ending at Current
+func withSteps(t *testing.T, chain []Step) {
+	t.Helper()
+	saved := steps
+	steps = chain
+	t.Cleanup(func() { steps = saved })
+}
+
+func TestConvertIRCurrentUnchanged(t *testing.T) {
+	data := []byte(`{"version":"v1","app":"radarr"}`)
+	got, err := ConvertIR(data)
+	if err != nil {
+		t.Fatalf("ConvertIR() error = %v", err)
+	}
+	if string(got) != string(data) {
+		t.Errorf("ConvertIR() = %s, want the document unchanged", got)
+	}
+
+	// Snapshots written before the version was recorded are v1
+	if NeedsConversion("") {
+		t.Error("NeedsConversion(\"\") = true, want legacy state treated as v1")
+	}
+}
+
+func TestConvertIRAppliesChain(t *testing.T) {
+	withSteps(t, []Step{
+		{From: "v0", To: Current, IR: func(doc map[string]interface{}) error {
+			doc["downloadClients"] = doc["clients"]
+			delete(doc, "clients")
+			return nil
+		}},
+	})
+
+	got, err := ConvertIR([]byte(`{"version":"v0","clients":[{"name":"qbit"}]}`))
+	if err != nil {
+		t.Fatalf("ConvertIR() error = %v", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatalf("ConvertIR() returned invalid JSON: %v", err)
+	}
+	if doc["version"] != Current {
+		t.Errorf("version = %v, want %s", doc["version"], Current)
+	}
+	if _, ok := doc["clients"]; ok {
+		t.Error("clients kept, want it renamed")
+	}
+	if doc["downloadClients"] == nil {
+		t.Error("downloadClients missing after conversion")
+	}
+}
+
+func TestConvertIRUnknownVersion(t *testing.T) {
+	_, err := ConvertIR([]byte(`{"version":"v9"}`))
+	if !errors.Is(err, ErrUnknownVersion) {
+		t.Errorf("ConvertIR() error = %v, want ErrUnknownVersion", err)
+	}
+}
+
+func TestSecretHashes(t *testing.T) {
+	hashes := map[string]string{"downloadClient/qbit": "abc", "indexer/nzbgeek": "def"}
+
+	got, err := SecretHashes(Current, hashes)
+	if err != nil || !reflect.DeepEqual(got, hashes) {
+		t.Errorf("SecretHashes(current) = %v, %v, want unchanged", got, err)
+	}
+
+	withSteps(t, []Step{{From: "v0", To: Current, SecretHashKey: func(key string) string {
+		return strings.Replace(key, "downloadClient/", "client/", 1)
+	}}})
+	got, err = SecretHashes("v0", hashes)
+	if err != nil {
+		t.Fatalf("SecretHashes() error = %v", err)
+	}
+	want := map[string]string{"client/qbit": "abc", "indexer/nzbgeek": "def"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SecretHashes() = %v, want %v", got, want)
+	}
+	if hashes["downloadClient/qbit"] != "abc" {
+		t.Error("SecretHashes() modified the recorded hashes")
+	}
+
+	withSteps(t, []Step{{From: "v0", To: Current, ResetSecretHashes: true}})
+	if got, err := SecretHashes("v0", hashes); err != nil || got != nil {
+		t.Errorf("SecretHashes() = %v, %v, want hashes dropped after a scheme change", got, err)
+	}
+
+	if _, err := SecretHashes("v9", hashes); !errors.Is(err, ErrUnknownVersion) {
+		t.Errorf("SecretHashes(v9) error = %v, want ErrUnknownVersion", err)
+	}
+}
@@ -0,0 +1,37 @@
+package v1
+
+// AutoTagIR represents an auto-tagging rule (Radarr/Sonarr)
+type AutoTagIR struct {
+	// ID is the rule ID (set by the service, used for updates/deletes)
+	ID int `json:"id,omitempty"`
+
+	// Name is the rule name, including the nebularr prefix
+	Name string `json:"name"`
+
+	// RemoveTagsAutomatically removes the tags from items that stop matching
+	RemoveTagsAutomatically bool `json:"removeTagsAutomatically,omitempty"`
+
+	// TagNames are the labels of the tags applied to matching items
+	TagNames []string `json:"tagNames"`
+
+	// Specifications are the conditions of the rule
+	Specifications []AutoTagSpecIR `json:"specifications"`
+}
+
+// AutoTagSpecIR represents a single condition of an auto-tagging rule
+type AutoTagSpecIR struct {
+	Type     string `json:"type"` // GenreSpecification, RootFolderSpecification, etc.
+	Name     string `json:"name"`
+	Negate   bool   `json:"negate,omitempty"`
+	Required bool   `json:"required,omitempty"`
+
+	// Value is the value of single-value specifications
+	Value string `json:"value,omitempty"`
+
+	// Values are the values of list specifications
+	Values []string `json:"values,omitempty"`
+
+	// Min and Max bound range specifications
+	Min *int `json:"min,omitempty"`
+	Max *int `json:"max,omitempty"`
+}
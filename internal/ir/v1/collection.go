@@ -0,0 +1,24 @@
+package v1
+
+// CollectionsIR represents the settings applied to Radarr movie collections.
+// Nil or empty fields leave the current value of each collection unchanged.
+type CollectionsIR struct {
+	// Titles limits the settings to these collections (case-insensitive)
+	// Empty means every collection
+	Titles []string `json:"titles,omitempty"`
+
+	// Monitored adds new movies of the collection automatically
+	Monitored *bool `json:"monitored,omitempty"`
+
+	// SearchOnAdd searches for movies when they are added
+	SearchOnAdd *bool `json:"searchOnAdd,omitempty"`
+
+	// MinimumAvailability: tba, announced, inCinemas, released
+	MinimumAvailability string `json:"minimumAvailability,omitempty"`
+
+	// QualityProfileName is resolved to an ID by the adapter
+	QualityProfileName string `json:"qualityProfileName,omitempty"`
+
+	// RootFolderPath for movies added from the collection
+	RootFolderPath string `json:"rootFolderPath,omitempty"`
+}
@@ -1,8 +1,27 @@
 package v1
 
+import "time"
+
 // ConnectionIR holds resolved connection details
 type ConnectionIR struct {
 	URL                string `json:"url"`
 	APIKey             string `json:"apiKey"` // Resolved from secret or auto-discovery
 	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+
+	// ExtraHeaders are sent with every request (resolved from secrets)
+	ExtraHeaders map[string]string `json:"extraHeaders,omitempty"`
+
+	// ClientCert and ClientKey are the PEM-encoded client certificate for mutual TLS
+	ClientCert string `json:"clientCert,omitempty"`
+	ClientKey  string `json:"clientKey,omitempty"`
+
+	// CACert is a PEM-encoded CA bundle used instead of the system roots
+	CACert string `json:"caCert,omitempty"`
+
+	// Timeout bounds each request to the service (0 uses the client default)
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// OwnerTag is the tag marking the resources of one config (nebularr-<namespace>-<name>).
+	// Empty uses the shared nebularr-managed tag.
+	OwnerTag string `json:"ownerTag,omitempty"`
 }
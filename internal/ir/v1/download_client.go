@@ -32,6 +32,11 @@ type DownloadClientIR struct {
 	UseTLS   bool   `json:"useTls,omitempty"`
 	Username string `json:"username,omitempty"`
 	Password string `json:"password,omitempty"` // Resolved from K8s Secret
+	APIKey   string `json:"apiKey,omitempty"`   // SABnzbd, resolved from K8s Secret
+
+	// SecretHash is a salted HMAC of the credentials, so rotations can be detected
+	// although the app never returns them. On current state it is the hash last applied.
+	SecretHash string `json:"secretHash,omitempty"`
 
 	// Category for downloads (app-specific field names at adapter level)
 	// For Radarr: movieCategory, for Sonarr: tvCategory, for Lidarr: musicCategory
@@ -39,6 +44,9 @@ type DownloadClientIR struct {
 
 	// Directory override
 	Directory string `json:"directory,omitempty"`
+
+	// Paused keeps the client as it is in the app
+	Paused bool `json:"paused,omitempty"`
 }
 
 // Protocol constants
@@ -45,9 +45,112 @@ type ImportListIR struct {
 	// ShouldMonitor: all, future, missing, existing, firstSeason, latestSeason, pilot, none
 	ShouldMonitor string `json:"shouldMonitor,omitempty"`
 
+	// Tags are given to the series the list adds, next to the ownership tag
+	Tags []string `json:"tags,omitempty"`
+
 	// --- Type-specific settings ---
 
 	// Settings contains type-specific field values
 	// Keys are the API field names (camelCase)
 	Settings map[string]string `json:"settings,omitempty"`
+
+	// --- Typed list settings (Sonarr) ---
+
+	// PlexWatchlist holds the settings of a PlexImport list
+	PlexWatchlist *PlexWatchlistImportIR `json:"plexWatchlist,omitempty"`
+
+	// PlexRSS holds the settings of a PlexRssImport list
+	PlexRSS *PlexRSSImportIR `json:"plexRss,omitempty"`
+
+	// TraktList holds the settings of a TraktListImport list
+	TraktList *TraktListImportIR `json:"traktList,omitempty"`
+
+	// Custom holds the settings of a CustomImport list
+	Custom *CustomImportListIR `json:"custom,omitempty"`
+
+	// --- Typed list settings (Readarr) ---
+
+	// GoodreadsList holds the settings of a GoodreadsListImportList list
+	GoodreadsList *GoodreadsListImportIR `json:"goodreadsList,omitempty"`
+
+	// GoodreadsOwnedBooks holds the settings of a GoodreadsOwnedBooks list
+	GoodreadsOwnedBooks *GoodreadsSessionIR `json:"goodreadsOwnedBooks,omitempty"`
+
+	// LazyLibrarian holds the settings of a LazyLibrarianImport list
+	LazyLibrarian *LazyLibrarianImportIR `json:"lazyLibrarian,omitempty"`
+}
+
+// PlexWatchlistImportIR holds the settings of a Plex Watchlist import list
+type PlexWatchlistImportIR struct {
+	// AccessToken is the resolved Plex token
+	AccessToken string `json:"accessToken,omitempty"`
+}
+
+// PlexRSSImportIR holds the settings of a Plex Watchlist RSS import list
+type PlexRSSImportIR struct {
+	// URL is the RSS feed URL
+	URL string `json:"url"`
+}
+
+// TraktListImportIR holds the settings of a Trakt user list import
+type TraktListImportIR struct {
+	Username string `json:"username"`
+	ListName string `json:"listName"`
+
+	// AccessToken and RefreshToken are the resolved OAuth tokens
+	AccessToken  string `json:"accessToken,omitempty"`
+	RefreshToken string `json:"refreshToken,omitempty"`
+
+	// Limit is the maximum number of series to import (0 keeps the app default)
+	Limit int `json:"limit,omitempty"`
+
+	AdditionalParameters string `json:"additionalParameters,omitempty"`
+
+	// SecretHash is a salted HMAC of the tokens, so a change to the Secret can be detected
+	SecretHash string `json:"secretHash,omitempty"`
+
+	// KeepTokens is set when the tokens are the ones last written. Sonarr refreshes
+	// them on its own, so an existing list keeps the tokens it holds.
+	KeepTokens bool `json:"-"`
+}
+
+// CustomImportListIR holds the settings of a custom import list
+type CustomImportListIR struct {
+	// URL returns the list
+	URL string `json:"url"`
+}
+
+// GoodreadsListImportIR holds the settings of a Goodreads list import
+type GoodreadsListImportIR struct {
+	ListID int `json:"listId"`
+}
+
+// GoodreadsSessionIR holds a signed-in Goodreads OAuth session, resolved from its Secret
+type GoodreadsSessionIR struct {
+	AccessToken       string `json:"accessToken,omitempty"`
+	AccessTokenSecret string `json:"accessTokenSecret,omitempty"`
+	UserID            string `json:"userId,omitempty"`
+	UserName          string `json:"userName,omitempty"`
+}
+
+// LazyLibrarianImportIR holds the settings of a LazyLibrarian import list
+type LazyLibrarianImportIR struct {
+	URL string `json:"url"`
+
+	// APIKey is the resolved LazyLibrarian API key
+	APIKey string `json:"apiKey,omitempty"`
+}
+
+// ImportListOptionsIR represents the global import list options of Radarr and
+// Sonarr. Empty fields leave the current value unchanged.
+type ImportListOptionsIR struct {
+	// CleanLibraryLevel is the app's listSyncLevel: disabled, logOnly,
+	// keepAndUnmonitor, keepAndTag (Sonarr), removeAndKeep, removeAndDelete (Radarr)
+	CleanLibraryLevel string `json:"cleanLibraryLevel,omitempty"`
+
+	// CleanLibraryTag is resolved to the listSyncTag ID by the adapter (Sonarr)
+	CleanLibraryTag string `json:"cleanLibraryTag,omitempty"`
+
+	// SyncIntervalHours is the app's importListSyncInterval (Radarr before v5)
+	SyncIntervalHours int `json:"syncIntervalHours,omitempty"`
 }
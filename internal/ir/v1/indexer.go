@@ -51,6 +51,10 @@ type IndexerIR struct {
 	// APIKey for authentication (resolved from K8s Secret)
 	APIKey string `json:"apiKey,omitempty"`
 
+	// SecretHash is a salted HMAC of the API key, so rotations can be detected
+	// although the app never returns it. On current state it is the hash last applied.
+	SecretHash string `json:"secretHash,omitempty"`
+
 	// Categories to search (numeric IDs)
 	Categories []int `json:"categories,omitempty"`
 
@@ -67,6 +71,9 @@ type IndexerIR struct {
 	EnableRss               bool `json:"enableRss"`
 	EnableAutomaticSearch   bool `json:"enableAutomaticSearch"`
 	EnableInteractiveSearch bool `json:"enableInteractiveSearch"`
+
+	// Paused keeps the indexer as it is in the app
+	Paused bool `json:"paused,omitempty"`
 }
 
 // Indexer implementation constants
@@ -44,6 +44,9 @@ type IR struct {
 	// ImportLists configuration - for Radarr/Sonarr/Lidarr
 	ImportLists []ImportListIR `json:"importLists,omitempty"`
 
+	// ImportListOptions configuration - for Radarr/Sonarr
+	ImportListOptions *ImportListOptionsIR `json:"importListOptions,omitempty"`
+
 	// Notifications configuration - for Radarr/Sonarr/Lidarr
 	Notifications []NotificationIR `json:"notifications,omitempty"`
 
@@ -53,18 +56,30 @@ type IR struct {
 	// DelayProfiles configuration - for Radarr/Sonarr only
 	DelayProfiles []DelayProfileIR `json:"delayProfiles,omitempty"`
 
-	// ReleaseProfiles configuration - for Sonarr only
+	// AutoTags configuration - for Radarr/Sonarr only
+	AutoTags []AutoTagIR `json:"autoTags,omitempty"`
+
+	// QualityDefinitions configuration - for Radarr/Sonarr only
+	QualityDefinitions []QualityDefinitionIR `json:"qualityDefinitions,omitempty"`
+
+	// ReleaseProfiles configuration - for Sonarr/Lidarr
 	ReleaseProfiles []ReleaseProfileIR `json:"releaseProfiles,omitempty"`
 
 	// MetadataProfiles configuration - for Readarr only
 	MetadataProfiles []*MetadataProfileIR `json:"metadataProfiles,omitempty"`
 
+	// Collections configuration - for Radarr only
+	Collections *CollectionsIR `json:"collections,omitempty"`
+
 	// MediaManagement configuration
 	MediaManagement *MediaManagementIR `json:"mediaManagement,omitempty"`
 
 	// Authentication configuration
 	Authentication *AuthenticationIR `json:"authentication,omitempty"`
 
+	// UI configuration - for Radarr/Sonarr
+	UI *UIConfigIR `json:"ui,omitempty"`
+
 	// Prowlarr-specific configuration (only populated when App == "prowlarr")
 	Prowlarr *ProwlarrIR `json:"prowlarr,omitempty"`
 
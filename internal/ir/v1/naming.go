@@ -10,6 +10,9 @@ type NamingIR struct {
 
 	// Lidarr naming
 	Lidarr *LidarrNamingIR `json:"lidarr,omitempty"`
+
+	// Readarr naming
+	Readarr *ReadarrNamingIR `json:"readarr,omitempty"`
 }
 
 // RadarrNamingIR for Radarr naming config
@@ -46,6 +49,15 @@ type LidarrNamingIR struct {
 	AlbumFolderFormat        string `json:"albumFolderFormat"`
 }
 
+// ReadarrNamingIR for Readarr naming config
+type ReadarrNamingIR struct {
+	RenameBooks              bool   `json:"renameBooks"`
+	ReplaceIllegalCharacters bool   `json:"replaceIllegalCharacters"`
+	ColonReplacementFormat   int    `json:"colonReplacementFormat"` // 0=delete, 1=dash, 2=space dash, 3=space dash space
+	StandardBookFormat       string `json:"standardBookFormat"`
+	AuthorFolderFormat       string `json:"authorFolderFormat"`
+}
+
 // Colon replacement format constants
 const (
 	ColonReplacementDelete = 0
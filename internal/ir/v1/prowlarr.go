@@ -10,6 +10,9 @@ type ProwlarrIR struct {
 	// Indexers configured in Prowlarr
 	Indexers []ProwlarrIndexerIR `json:"indexers,omitempty"`
 
+	// AppProfiles declared in the spec. On current state, every app profile in Prowlarr.
+	AppProfiles []ProwlarrAppProfileIR `json:"appProfiles,omitempty"`
+
 	// Proxies for indexer requests (FlareSolverr, HTTP, SOCKS)
 	Proxies []IndexerProxyIR `json:"proxies,omitempty"`
 
@@ -26,6 +29,9 @@ type ProwlarrIR struct {
 // ProwlarrIndexerIR represents a native indexer in Prowlarr.
 // Unlike IndexerIR used by other apps, this uses definitions (schemas).
 type ProwlarrIndexerIR struct {
+	// ID is the service-side ID (populated from CurrentState)
+	ID int `json:"id,omitempty"`
+
 	// Name is the display name
 	Name string `json:"name"`
 
@@ -44,15 +50,58 @@ type ProwlarrIndexerIR struct {
 	// APIKey for private trackers (resolved from K8s Secret)
 	APIKey string `json:"apiKey,omitempty"`
 
+	// NextAPIKey is the key to fall back to when APIKey is rejected
+	NextAPIKey string `json:"nextApiKey,omitempty"`
+
+	// SecretHash is a salted HMAC of the API key, so rotations can be detected
+	// although Prowlarr never returns it. On current state it is the hash last applied.
+	SecretHash string `json:"secretHash,omitempty"`
+
 	// Settings are definition-specific key-value settings
 	Settings map[string]string `json:"settings,omitempty"`
 
 	// Tags associate this indexer with proxies and applications
 	Tags []string `json:"tags,omitempty"`
+
+	// AppProfile is the name of the indexer's app profile (empty = leave unchanged)
+	AppProfile string `json:"appProfile,omitempty"`
+
+	// QueryLimit caps searches per LimitsUnit (nil = leave unchanged, 0 = unlimited)
+	QueryLimit *int `json:"queryLimit,omitempty"`
+
+	// GrabLimit caps grabs per LimitsUnit (nil = leave unchanged, 0 = unlimited)
+	GrabLimit *int `json:"grabLimit,omitempty"`
+
+	// LimitsUnit is "day" or "hour" (empty = leave unchanged)
+	LimitsUnit string `json:"limitsUnit,omitempty"`
+
+	// Paused keeps the indexer as it is in Prowlarr
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ProwlarrAppProfileIR represents an app profile, deciding which searches use an indexer
+type ProwlarrAppProfileIR struct {
+	// Name is the profile name
+	Name string `json:"name"`
+
+	// EnableRss uses the indexer for RSS sync
+	EnableRss bool `json:"enableRss"`
+
+	// EnableAutomaticSearch uses the indexer for automatic searches
+	EnableAutomaticSearch bool `json:"enableAutomaticSearch"`
+
+	// EnableInteractiveSearch uses the indexer for interactive searches
+	EnableInteractiveSearch bool `json:"enableInteractiveSearch"`
+
+	// MinimumSeeders for torrent releases
+	MinimumSeeders int `json:"minimumSeeders"`
 }
 
 // IndexerProxyIR represents a proxy for indexer requests
 type IndexerProxyIR struct {
+	// ID is the service-side ID (populated from CurrentState)
+	ID int `json:"id,omitempty"`
+
 	// Name is the display name
 	Name string `json:"name"`
 
@@ -78,10 +127,16 @@ type IndexerProxyIR struct {
 
 	// Tags to associate with indexers that should use this proxy
 	Tags []string `json:"tags,omitempty"`
+
+	// Paused keeps the proxy as it is in Prowlarr
+	Paused bool `json:"paused,omitempty"`
 }
 
 // ProwlarrApplicationIR represents a downstream app to sync indexers to
 type ProwlarrApplicationIR struct {
+	// ID is the service-side ID (populated from CurrentState)
+	ID int `json:"id,omitempty"`
+
 	// Name is the display name
 	Name string `json:"name"`
 
@@ -106,6 +161,12 @@ type ProwlarrApplicationIR struct {
 
 	// Tags to filter which indexers sync to this app
 	Tags []string `json:"tags,omitempty"`
+
+	// DownloadClient names the app's download client the synced indexers send grabs to
+	DownloadClient string `json:"downloadClient,omitempty"`
+
+	// Paused keeps the application as it is in Prowlarr
+	Paused bool `json:"paused,omitempty"`
 }
 
 // Proxy type constants
@@ -5,6 +5,9 @@ type QualityIR struct {
 	// Video quality for Radarr/Sonarr
 	Video *VideoQualityIR `json:"video,omitempty"`
 
+	// VideoProfiles are additional named video profiles for Radarr/Sonarr
+	VideoProfiles []*VideoQualityIR `json:"videoProfiles,omitempty"`
+
 	// Audio quality for Lidarr
 	Audio *AudioQualityIR `json:"audio,omitempty"`
 
@@ -12,8 +15,23 @@ type QualityIR struct {
 	Book *BookQualityIR `json:"book,omitempty"`
 }
 
+// AllVideoProfiles returns the default video profile followed by any additional ones
+func (q *QualityIR) AllVideoProfiles() []*VideoQualityIR {
+	if q == nil {
+		return nil
+	}
+	var profiles []*VideoQualityIR
+	if q.Video != nil {
+		profiles = append(profiles, q.Video)
+	}
+	return append(profiles, q.VideoProfiles...)
+}
+
 // VideoQualityIR represents video quality configuration (from preset or manual)
 type VideoQualityIR struct {
+	// ID is the service-side ID (populated from CurrentState)
+	ID int `json:"id,omitempty"`
+
 	// ProfileName is the quality profile name (generated: "nebularr-{config-name}")
 	ProfileName string `json:"profileName"`
 
@@ -37,6 +55,22 @@ type VideoQualityIR struct {
 
 	// UpgradeUntilCustomFormatScore stops upgrades at this score
 	UpgradeUntilCustomFormatScore int `json:"upgradeUntilCustomFormatScore,omitempty"`
+
+	// Language is the profile language (Radarr only; nil keeps "Original")
+	Language *LanguageIR `json:"language,omitempty"`
+
+	// CloneFrom is an existing profile to use as the base instead of the schema
+	CloneFrom string `json:"cloneFrom,omitempty"`
+}
+
+// LanguageOriginal is the ID of the "Original" pseudo language, the language a
+// Radarr profile gets when the spec chooses none
+const LanguageOriginal = -2
+
+// LanguageIR identifies a language by its app-specific ID
+type LanguageIR struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
 }
 
 // VideoQualityTierIR represents an abstract quality level
@@ -65,6 +99,9 @@ type FormatSpecIR struct {
 
 // AudioQualityIR represents audio quality configuration for Lidarr
 type AudioQualityIR struct {
+	// ID is the service-side ID (populated from CurrentState)
+	ID int `json:"id,omitempty"`
+
 	// ProfileName is the quality profile name
 	ProfileName string `json:"profileName"`
 
@@ -116,6 +153,8 @@ type ReleaseProfileIR struct {
 	IndexerID int `json:"indexerId,omitempty"`
 	// Tags restricts to specific tags
 	Tags []int `json:"tags,omitempty"`
+	// TagNames are the tag names (used by compiler, resolved to IDs by adapter)
+	TagNames []string `json:"tagNames,omitempty"`
 }
 
 // PreferredTermIR represents a preferred term with score
@@ -126,6 +165,9 @@ type PreferredTermIR struct {
 
 // BookQualityIR represents book quality configuration for Readarr
 type BookQualityIR struct {
+	// ID is the service-side ID (populated from CurrentState)
+	ID int `json:"id,omitempty"`
+
 	// ProfileName is the quality profile name
 	ProfileName string `json:"profileName"`
 
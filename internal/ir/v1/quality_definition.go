@@ -0,0 +1,24 @@
+package v1
+
+// SizeLimitUnlimited marks a quality definition size limit as unbounded.
+// Adapters send it to the service as null.
+const SizeLimitUnlimited = -1.0
+
+// QualityDefinitionIR represents the size limits for a single quality.
+// Sizes are in megabytes per minute of runtime.
+type QualityDefinitionIR struct {
+	// Quality is the quality name (e.g., "Bluray-1080p"), matched case-insensitively
+	Quality string `json:"quality"`
+
+	// Title overrides the display title (empty = leave unchanged)
+	Title string `json:"title,omitempty"`
+
+	// MinSize in MB per minute (nil = leave unchanged)
+	MinSize *float64 `json:"minSize,omitempty"`
+
+	// MaxSize in MB per minute (nil = leave unchanged, SizeLimitUnlimited = no limit)
+	MaxSize *float64 `json:"maxSize,omitempty"`
+
+	// PreferredSize in MB per minute (nil = leave unchanged, SizeLimitUnlimited = no limit)
+	PreferredSize *float64 `json:"preferredSize,omitempty"`
+}
@@ -2,6 +2,9 @@ package v1
 
 // RootFolderIR represents a root folder
 type RootFolderIR struct {
+	// ID is the service-side ID (populated from CurrentState)
+	ID int `json:"id,omitempty"`
+
 	Path string `json:"path"`
 
 	// Lidarr-specific fields
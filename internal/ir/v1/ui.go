@@ -0,0 +1,20 @@
+package v1
+
+// UIConfigIR represents the UI settings of Radarr and Sonarr in the apps' own
+// values. Nil or empty fields leave the current value unchanged.
+type UIConfigIR struct {
+	// FirstDayOfWeek of the calendar: 0 = Sunday, 1 = Monday
+	FirstDayOfWeek *int `json:"firstDayOfWeek,omitempty"`
+
+	// TimeFormat is the app's time format string, e.g. "HH:mm"
+	TimeFormat string `json:"timeFormat,omitempty"`
+
+	// Theme: auto, light, dark
+	Theme string `json:"theme,omitempty"`
+
+	// UILanguage is the app's language ID (0 = unset)
+	UILanguage int `json:"uiLanguage,omitempty"`
+
+	// MovieRuntimeFormat: hoursMinutes, minutes (Radarr)
+	MovieRuntimeFormat string `json:"movieRuntimeFormat,omitempty"`
+}
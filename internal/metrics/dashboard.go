@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// DashboardConfigMapName is the name of the ConfigMap holding the Grafana dashboard
+	DashboardConfigMapName = "nebularr-grafana-dashboard"
+
+	// dashboardLabel is the label the Grafana dashboard sidecar watches for
+	dashboardLabel = "grafana_dashboard"
+
+	// dashboardKey is the ConfigMap key holding the dashboard JSON
+	dashboardKey = "nebularr.json"
+)
+
+// Dashboard is the packaged Grafana dashboard for the metrics in this package
+//
+//go:embed grafana/nebularr-dashboard.json
+var Dashboard []byte
+
+// EnsureDashboardConfigMap creates or updates the ConfigMap holding the Grafana
+// dashboard in namespace. It carries the grafana_dashboard=1 label so the Grafana
+// sidecar (as shipped by kube-prometheus-stack) picks it up.
+func EnsureDashboardConfigMap(ctx context.Context, c client.Client, namespace string) error {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: DashboardConfigMapName, Namespace: namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, c, cm, func() error {
+		if cm.Labels == nil {
+			cm.Labels = make(map[string]string)
+		}
+		cm.Labels[dashboardLabel] = "1"
+		cm.Labels["app.kubernetes.io/managed-by"] = "nebularr-operator"
+		cm.Data = map[string]string{dashboardKey: string(Dashboard)}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to apply Grafana dashboard ConfigMap: %w", err)
+	}
+	return nil
+}
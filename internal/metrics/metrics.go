@@ -4,6 +4,8 @@
 package metrics
 
 import (
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
@@ -106,6 +108,77 @@ var (
 		[]string{"app", "action", "resource_type"},
 	)
 
+	// ApplyDuration tracks how long applying a single change takes
+	ApplyDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "apply_duration_seconds",
+			Help:      "Duration of applying a single change to an *arr service in seconds",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		},
+		[]string{"app", "action", "resource_type"},
+	)
+
+	// ManagedIndexers tracks the indexers managed per instance
+	ManagedIndexers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "managed_indexers",
+			Help:      "Number of indexers managed by Nebularr per *arr instance",
+		},
+		[]string{"app", "instance"},
+	)
+
+	// ManagedDownloadClients tracks the download clients managed per instance
+	ManagedDownloadClients = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "managed_download_clients",
+			Help:      "Number of download clients managed by Nebularr per *arr instance",
+		},
+		[]string{"app", "instance"},
+	)
+
+	// ManagedCustomFormats tracks the custom formats managed per instance
+	ManagedCustomFormats = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "managed_custom_formats",
+			Help:      "Number of custom formats managed by Nebularr per *arr instance",
+		},
+		[]string{"app", "instance"},
+	)
+
+	// SecretWrites tracks creates and updates of Secrets rendered by the operator
+	SecretWrites = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "secret_writes_total",
+			Help:      "Total number of creates and updates of Secrets rendered by the operator",
+		},
+		[]string{"secret", "operation"},
+	)
+
+	// CleanupActions tracks items deleted or unmonitored by CleanupPolicies
+	CleanupActions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cleanup_actions_total",
+			Help:      "Total number of library items deleted or unmonitored by cleanup policies",
+		},
+		[]string{"policy", "action"},
+	)
+
+	// HTTPConnections tracks connections used by adapter HTTP clients and whether they were reused
+	HTTPConnections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_connections_total",
+			Help:      "Total number of connections used by adapter HTTP requests, by whether a pooled connection was reused",
+		},
+		[]string{"host", "reused"},
+	)
+
 	// ServiceVersion tracks the version of connected *arr services
 	ServiceVersion = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -129,7 +202,14 @@ func init() {
 		ConfigDrift,
 		ConnectionStatus,
 		ApplyChangesTotal,
+		ApplyDuration,
+		ManagedIndexers,
+		ManagedDownloadClients,
+		ManagedCustomFormats,
 		ServiceVersion,
+		SecretWrites,
+		CleanupActions,
+		HTTPConnections,
 	)
 }
 
@@ -176,6 +256,26 @@ func RecordApplyChange(app, action, resourceType string) {
 	ApplyChangesTotal.WithLabelValues(app, action, resourceType).Inc()
 }
 
+// RecordApplyDuration records how long applying a single change took
+func RecordApplyDuration(app, action, resourceType string, duration float64) {
+	ApplyDuration.WithLabelValues(app, action, resourceType).Observe(duration)
+}
+
+// SetManagedResourceCounts sets the per-instance counts of managed indexers,
+// download clients and custom formats
+func SetManagedResourceCounts(app, instance string, indexers, downloadClients, customFormats int) {
+	ManagedIndexers.WithLabelValues(app, instance).Set(float64(indexers))
+	ManagedDownloadClients.WithLabelValues(app, instance).Set(float64(downloadClients))
+	ManagedCustomFormats.WithLabelValues(app, instance).Set(float64(customFormats))
+}
+
+// DeleteManagedResourceCounts removes the per-instance counts once an instance is no longer managed
+func DeleteManagedResourceCounts(app, instance string) {
+	ManagedIndexers.DeleteLabelValues(app, instance)
+	ManagedDownloadClients.DeleteLabelValues(app, instance)
+	ManagedCustomFormats.DeleteLabelValues(app, instance)
+}
+
 // RecordServiceVersion records the version of a connected *arr service
 func RecordServiceVersion(app, instance, version string) {
 	// Reset previous version labels by setting to 0
@@ -183,6 +283,25 @@ func RecordServiceVersion(app, instance, version string) {
 	ServiceVersion.WithLabelValues(app, instance, version).Set(1)
 }
 
+// RecordSecretWrite records a create or update of a rendered Secret
+// (secret is its kind, e.g. gluetun-env)
+func RecordSecretWrite(secret, operation string) {
+	SecretWrites.WithLabelValues(secret, operation).Inc()
+}
+
+// RecordCleanupActions records count items deleted or unmonitored by a cleanup policy
+// (policy is namespace/name, action is delete or unmonitor)
+func RecordCleanupActions(policy, action string, count int) {
+	if count > 0 {
+		CleanupActions.WithLabelValues(policy, action).Add(float64(count))
+	}
+}
+
+// RecordHTTPConnection records a connection used by an adapter request to host
+func RecordHTTPConnection(host string, reused bool) {
+	HTTPConnections.WithLabelValues(host, strconv.FormatBool(reused)).Inc()
+}
+
 // SetResourcesManaged sets the count of managed resources
 func SetResourcesManaged(controller, resourceType string, count int) {
 	ResourcesManaged.WithLabelValues(controller, resourceType).Set(float64(count))
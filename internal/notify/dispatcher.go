@@ -0,0 +1,258 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultDriftThreshold is how many reconciles in a row must correct drift
+	// before EventRepeatedDrift is sent
+	DefaultDriftThreshold = 3
+
+	// DefaultFailureThreshold is how many reconciles in a row must fail to apply
+	// before EventApplyFailing is sent
+	DefaultFailureThreshold = 3
+
+	// queueSize bounds the notifications waiting to be sent
+	queueSize = 100
+)
+
+// Observation is the outcome of one reconcile of a config resource
+type Observation struct {
+	Kind      string
+	Namespace string
+	Name      string
+
+	// WasReady and Ready are the Ready condition before and after the reconcile
+	WasReady bool
+	Ready    bool
+
+	// Reason and Message describe the Ready condition after the reconcile
+	Reason  string
+	Message string
+
+	// Drifted is true when the reconcile applied changes to correct drift
+	Drifted bool
+
+	// ApplyFailed is true when some or all changes failed to apply
+	ApplyFailed bool
+
+	// Settled is true when the app was found in sync; it resets the drift count
+	Settled bool
+}
+
+// objectState counts consecutive reconcile outcomes of one config resource
+type objectState struct {
+	drifts   int
+	failures int
+}
+
+// Dispatcher turns reconcile observations into notifications. Webhook URLs are
+// read from a Secret on every send, so changes apply without a restart.
+// Counters are kept in memory and start over when the operator restarts.
+// Notifications are queued and sent by Start, which the manager runs.
+type Dispatcher struct {
+	// Client reads the Secret holding the webhook URLs
+	Client client.Reader
+
+	// Secret references the Secret holding the webhook URLs
+	Secret types.NamespacedName
+
+	// DriftThreshold overrides DefaultDriftThreshold (0 = default)
+	DriftThreshold int
+
+	// FailureThreshold overrides DefaultFailureThreshold (0 = default)
+	FailureThreshold int
+
+	// HTTPClient sends the notifications (nil = client with a 10s timeout)
+	HTTPClient *http.Client
+
+	mu     sync.Mutex
+	states map[string]*objectState
+	queue  chan Message
+}
+
+// NewDispatcher creates a Dispatcher reading webhook URLs from secret
+func NewDispatcher(c client.Reader, secret types.NamespacedName) *Dispatcher {
+	return &Dispatcher{
+		Client:     c,
+		Secret:     secret,
+		HTTPClient: &http.Client{Timeout: sendTimeout},
+	}
+}
+
+// Observe records the outcome of a reconcile and queues the notifications it
+// triggers, so a slow webhook never holds up the reconcile. Notifications that
+// don't fit in the queue are dropped and reported in the error.
+func (d *Dispatcher) Observe(obs Observation) error {
+	queue := d.messageQueue()
+	dropped := 0
+	for _, msg := range d.evaluate(obs, time.Now()) {
+		select {
+		case queue <- msg:
+		default:
+			dropped++
+		}
+	}
+	if dropped > 0 {
+		return fmt.Errorf("notification queue full, dropped %d notification(s) for %s %s/%s", dropped, obs.Kind, obs.Namespace, obs.Name)
+	}
+	return nil
+}
+
+// Start sends the queued notifications until ctx is done. It implements
+// manager.Runnable; send failures are logged.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("notify")
+	queue := d.messageQueue()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg := <-queue:
+			if err := d.send(ctx, msg); err != nil {
+				log.Error(err, "Failed to send operator notification", "event", msg.Event,
+					"kind", msg.Kind, "namespace", msg.Namespace, "name", msg.Name)
+			}
+		}
+	}
+}
+
+// send delivers a message to every configured webhook
+func (d *Dispatcher) send(ctx context.Context, msg Message) error {
+	senders, err := d.senders(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, s := range senders {
+		if err := s.Send(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// messageQueue returns the queue, creating it on first use
+func (d *Dispatcher) messageQueue() chan Message {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.queue == nil {
+		d.queue = make(chan Message, queueSize)
+	}
+	return d.queue
+}
+
+// Forget drops the counters of a deleted config resource
+func (d *Dispatcher) Forget(kind, namespace, name string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.states, stateKey(kind, namespace, name))
+}
+
+// evaluate updates the counters and returns the messages obs triggers.
+// Each threshold notifies once, when it is reached, and re-arms once the
+// streak ends.
+func (d *Dispatcher) evaluate(obs Observation, now time.Time) []Message {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.states == nil {
+		d.states = make(map[string]*objectState)
+	}
+	key := stateKey(obs.Kind, obs.Namespace, obs.Name)
+	state, ok := d.states[key]
+	if !ok {
+		state = &objectState{}
+		d.states[key] = state
+	}
+
+	message := func(event Event, reason, text string) Message {
+		return Message{
+			Event:     event,
+			Kind:      obs.Kind,
+			Namespace: obs.Namespace,
+			Name:      obs.Name,
+			Reason:    reason,
+			Text:      text,
+			Time:      now,
+		}
+	}
+
+	var messages []Message
+	if obs.WasReady && !obs.Ready {
+		text := "no longer ready"
+		if obs.Message != "" {
+			text += ": " + obs.Message
+		}
+		messages = append(messages, message(EventNotReady, obs.Reason, text))
+	}
+
+	switch {
+	case obs.Drifted:
+		state.drifts++
+		if state.drifts == threshold(d.DriftThreshold, DefaultDriftThreshold) {
+			messages = append(messages, message(EventRepeatedDrift, "",
+				fmt.Sprintf("drift corrected on %d reconciles in a row; something keeps changing the app", state.drifts)))
+		}
+	case obs.Settled:
+		state.drifts = 0
+	}
+
+	if obs.ApplyFailed {
+		state.failures++
+		if state.failures == threshold(d.FailureThreshold, DefaultFailureThreshold) {
+			messages = append(messages, message(EventApplyFailing, obs.Reason,
+				fmt.Sprintf("applying changes failed on %d reconciles in a row", state.failures)))
+		}
+	} else if obs.Drifted || obs.Settled {
+		state.failures = 0
+	}
+
+	return messages
+}
+
+// senders reads the webhook URLs from the Secret
+func (d *Dispatcher) senders(ctx context.Context) ([]Sender, error) {
+	secret := &corev1.Secret{}
+	if err := d.Client.Get(ctx, d.Secret, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("notification secret %s not found", d.Secret)
+		}
+		return nil, fmt.Errorf("failed to get notification secret %s: %w", d.Secret, err)
+	}
+	return SendersFromSecret(secret.Data, d.HTTPClient), nil
+}
+
+func stateKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+func threshold(value, def int) int {
+	if value > 0 {
+		return value
+	}
+	return def
+}
+
+// ParseSecretRef parses a "namespace/name" Secret reference
+func ParseSecretRef(value string) (types.NamespacedName, error) {
+	namespace, name, ok := strings.Cut(value, "/")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return types.NamespacedName{}, fmt.Errorf("expected namespace/name, got %q", value)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
@@ -0,0 +1,157 @@
+// Package notify sends operator-level notifications about config resources
+// (Slack, Discord or a generic webhook), independent of the notification
+// settings of the *arr apps themselves.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// KeyWebhookURL is the Secret key holding a generic webhook URL (receives Message as JSON)
+	KeyWebhookURL = "webhook-url"
+
+	// KeySlackWebhookURL is the Secret key holding a Slack incoming webhook URL
+	KeySlackWebhookURL = "slack-webhook-url"
+
+	// KeyDiscordWebhookURL is the Secret key holding a Discord webhook URL
+	KeyDiscordWebhookURL = "discord-webhook-url"
+
+	// sendTimeout bounds each notification request
+	sendTimeout = 10 * time.Second
+)
+
+// Event identifies why a notification was sent
+type Event string
+
+const (
+	// EventNotReady is sent when a config transitions to Ready=False
+	EventNotReady Event = "NotReady"
+
+	// EventRepeatedDrift is sent when drift is corrected on several reconciles in a row
+	EventRepeatedDrift Event = "RepeatedDrift"
+
+	// EventApplyFailing is sent when applying changes fails on several reconciles in a row
+	EventApplyFailing Event = "ApplyFailing"
+)
+
+// Message is a single notification about a config resource
+type Message struct {
+	Event     Event     `json:"event"`
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Reason    string    `json:"reason,omitempty"`
+	Text      string    `json:"text"`
+	Time      time.Time `json:"time"`
+}
+
+// Summary renders the message as a single line of chat text
+func (m Message) Summary() string {
+	summary := fmt.Sprintf("[nebularr] %s %s/%s: %s", m.Kind, m.Namespace, m.Name, m.Text)
+	if m.Reason != "" {
+		summary += fmt.Sprintf(" (%s)", m.Reason)
+	}
+	return summary
+}
+
+// Sender delivers messages to one destination
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// WebhookSender posts the message as JSON to a generic webhook
+type WebhookSender struct {
+	URL    string
+	Client *http.Client
+}
+
+// Send posts msg as JSON
+func (s *WebhookSender) Send(ctx context.Context, msg Message) error {
+	return postJSON(ctx, s.Client, s.URL, msg)
+}
+
+// SlackSender posts the message to a Slack incoming webhook
+type SlackSender struct {
+	URL    string
+	Client *http.Client
+}
+
+// Send posts msg as Slack text
+func (s *SlackSender) Send(ctx context.Context, msg Message) error {
+	return postJSON(ctx, s.Client, s.URL, map[string]string{"text": msg.Summary()})
+}
+
+// DiscordSender posts the message to a Discord webhook
+type DiscordSender struct {
+	URL    string
+	Client *http.Client
+}
+
+// Send posts msg as Discord message content
+func (s *DiscordSender) Send(ctx context.Context, msg Message) error {
+	return postJSON(ctx, s.Client, s.URL, map[string]string{"content": msg.Summary()})
+}
+
+// SendersFromSecret builds a sender for every webhook URL set in the Secret data
+func SendersFromSecret(data map[string][]byte, client *http.Client) []Sender {
+	value := func(key string) string {
+		return strings.TrimSpace(string(data[key]))
+	}
+
+	var senders []Sender
+	if u := value(KeySlackWebhookURL); u != "" {
+		senders = append(senders, &SlackSender{URL: u, Client: client})
+	}
+	if u := value(KeyDiscordWebhookURL); u != "" {
+		senders = append(senders, &DiscordSender{URL: u, Client: client})
+	}
+	if u := value(KeyWebhookURL); u != "" {
+		senders = append(senders, &WebhookSender{URL: u, Client: client})
+	}
+	return senders
+}
+
+// postJSON posts body as JSON and fails on non-2xx responses.
+// Errors never include the URL, which usually embeds a token.
+func postJSON(ctx context.Context, client *http.Client, endpoint string, body interface{}) error {
+	if client == nil {
+		client = &http.Client{Timeout: sendTimeout}
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return errors.New("failed to create notification request: invalid webhook URL")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("notification webhook returned %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
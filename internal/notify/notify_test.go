@@ -0,0 +1,210 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDispatcherEvaluate(t *testing.T) {
+	ready := Observation{Kind: "RadarrConfig", Namespace: "media", Name: "radarr", WasReady: true, Ready: true}
+	notReady := ready
+	notReady.Ready = false
+	notReady.Reason = "ConnectionFailed"
+	stillNotReady := notReady
+	stillNotReady.WasReady = false
+	drifted := ready
+	drifted.Drifted = true
+	settled := ready
+	settled.Settled = true
+	failed := ready
+	failed.ApplyFailed = true
+
+	tests := []struct {
+		name         string
+		observations []Observation
+		want         []Event
+	}{
+		{
+			name:         "ready transition notifies once",
+			observations: []Observation{ready, notReady, stillNotReady, stillNotReady},
+			want:         []Event{EventNotReady},
+		},
+		{
+			name:         "drift below threshold is quiet",
+			observations: []Observation{drifted, drifted, settled, drifted, drifted},
+		},
+		{
+			name:         "repeated drift notifies at the threshold",
+			observations: []Observation{drifted, drifted, drifted, drifted},
+			want:         []Event{EventRepeatedDrift},
+		},
+		{
+			name:         "drift streak re-arms after settling",
+			observations: []Observation{drifted, drifted, drifted, settled, drifted, drifted, drifted},
+			want:         []Event{EventRepeatedDrift, EventRepeatedDrift},
+		},
+		{
+			name:         "failing applies notify at the threshold",
+			observations: []Observation{failed, failed, ready, failed, failed},
+			want:         []Event{EventApplyFailing},
+		},
+		{
+			name:         "successful apply ends the failure streak",
+			observations: []Observation{failed, failed, drifted, failed, failed},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Dispatcher{}
+			var got []Event
+			for _, obs := range tt.observations {
+				for _, msg := range d.evaluate(obs, time.Now()) {
+					got = append(got, msg.Event)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("events = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDispatcherSendsInBackground(t *testing.T) {
+	release := make(chan struct{})
+	received := make(chan map[string]interface{}, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		received <- body
+	}))
+	defer srv.Close()
+
+	secretRef := types.NamespacedName{Namespace: "nebularr-system", Name: "notifications"}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: secretRef.Namespace, Name: secretRef.Name},
+		Data:       map[string][]byte{KeyWebhookURL: []byte(srv.URL)},
+	}).Build()
+	d := NewDispatcher(c, secretRef)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = d.Start(ctx) }()
+
+	// Observe returns while the webhook is still blocked
+	obs := Observation{Kind: "RadarrConfig", Namespace: "media", Name: "radarr", WasReady: true}
+	done := make(chan error)
+	go func() { done <- d.Observe(obs) }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Observe() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Observe() waited for the webhook")
+	}
+
+	close(release)
+	select {
+	case body := <-received:
+		if body["event"] != string(EventNotReady) || body["name"] != "radarr" {
+			t.Errorf("webhook body = %v", body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the queued notification was never sent")
+	}
+}
+
+func TestDispatcherDropsWhenQueueFull(t *testing.T) {
+	d := &Dispatcher{}
+	obs := Observation{Kind: "SonarrConfig", Namespace: "media", Name: "sonarr", WasReady: true}
+	for i := 0; i < queueSize; i++ {
+		if err := d.Observe(obs); err != nil {
+			t.Fatalf("Observe() %d error = %v", i, err)
+		}
+	}
+	err := d.Observe(obs)
+	if err == nil || !strings.Contains(err.Error(), "dropped 1") {
+		t.Errorf("err = %v, want a dropped notification", err)
+	}
+}
+
+func TestSendersFromSecret(t *testing.T) {
+	var bodies []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode: %v", err)
+		}
+		bodies = append(bodies, body)
+	}))
+	defer srv.Close()
+
+	senders := SendersFromSecret(map[string][]byte{
+		KeySlackWebhookURL:   []byte(srv.URL + "/slack\n"),
+		KeyDiscordWebhookURL: []byte(srv.URL + "/discord"),
+		KeyWebhookURL:        []byte(srv.URL + "/hook"),
+	}, srv.Client())
+	if len(senders) != 3 {
+		t.Fatalf("got %d senders, want 3", len(senders))
+	}
+
+	msg := Message{Event: EventNotReady, Kind: "SonarrConfig", Namespace: "media", Name: "sonarr", Text: "no longer ready"}
+	for _, s := range senders {
+		if err := s.Send(context.Background(), msg); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+
+	want := "[nebularr] SonarrConfig media/sonarr: no longer ready"
+	if bodies[0]["text"] != want {
+		t.Errorf("slack text = %v, want %q", bodies[0]["text"], want)
+	}
+	if bodies[1]["content"] != want {
+		t.Errorf("discord content = %v, want %q", bodies[1]["content"], want)
+	}
+	if bodies[2]["event"] != string(EventNotReady) || bodies[2]["name"] != "sonarr" {
+		t.Errorf("webhook body = %v", bodies[2])
+	}
+}
+
+func TestSendReportsFailuresWithoutURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid token", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	s := &SlackSender{URL: srv.URL + "/services/secret-token", Client: srv.Client()}
+	err := s.Send(context.Background(), Message{Text: "test"})
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("err = %v, want a 403 error", err)
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error leaks the webhook URL: %v", err)
+	}
+}
+
+func TestParseSecretRef(t *testing.T) {
+	ref, err := ParseSecretRef("nebularr-system/notifications")
+	if err != nil || ref.Namespace != "nebularr-system" || ref.Name != "notifications" {
+		t.Errorf("ParseSecretRef = %v, %v", ref, err)
+	}
+	for _, bad := range []string{"notifications", "/name", "ns/", "a/b/c"} {
+		if _, err := ParseSecretRef(bad); err == nil {
+			t.Errorf("ParseSecretRef(%q) succeeded, want error", bad)
+		}
+	}
+}
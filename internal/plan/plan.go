@@ -0,0 +1,97 @@
+// Package plan renders adapter change sets as a human-readable, terraform-style plan.
+package plan
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// Action symbols used in the plan output
+const (
+	symbolCreate = "+"
+	symbolUpdate = "~"
+	symbolDelete = "-"
+)
+
+// Render writes the plan for one config to w.
+// Changes are grouped by resource type and sorted by name for stable output.
+func Render(w io.Writer, title string, changes *adapters.ChangeSet, unrealized []irv1.UnrealizedFeature) error {
+	p := &printer{w: w}
+
+	p.printf("%s\n\n", title)
+
+	if len(unrealized) > 0 {
+		p.printf("Warnings:\n")
+		for _, u := range unrealized {
+			p.printf("  ! %s: %s\n", u.Feature, u.Reason)
+		}
+		p.printf("\n")
+	}
+
+	if changes == nil || changes.IsEmpty() {
+		p.printf("No changes. The live configuration matches the spec.\n")
+		return p.err
+	}
+
+	p.printf("Nebularr will perform the following actions:\n\n")
+	for _, line := range planLines(changes) {
+		p.printf("  %s %s %q%s\n", line.symbol, line.change.ResourceType, line.change.Name, idSuffix(line.change.ID))
+	}
+
+	p.printf("\nPlan: %d to create, %d to update, %d to delete.\n",
+		len(changes.Creates), len(changes.Updates), len(changes.Deletes))
+	return p.err
+}
+
+// planLine is a single change with its action symbol
+type planLine struct {
+	symbol string
+	change adapters.Change
+}
+
+// planLines flattens a change set into lines sorted by resource type, then name
+func planLines(changes *adapters.ChangeSet) []planLine {
+	lines := make([]planLine, 0, changes.TotalChanges())
+	for _, c := range changes.Creates {
+		lines = append(lines, planLine{symbol: symbolCreate, change: c})
+	}
+	for _, c := range changes.Updates {
+		lines = append(lines, planLine{symbol: symbolUpdate, change: c})
+	}
+	for _, c := range changes.Deletes {
+		lines = append(lines, planLine{symbol: symbolDelete, change: c})
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		if lines[i].change.ResourceType != lines[j].change.ResourceType {
+			return lines[i].change.ResourceType < lines[j].change.ResourceType
+		}
+		return lines[i].change.Name < lines[j].change.Name
+	})
+	return lines
+}
+
+// idSuffix formats the service-side ID of an existing resource
+func idSuffix(id *int) string {
+	if id == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (id %d)", *id)
+}
+
+// printer remembers the first write error so callers check it once
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err != nil {
+		return
+	}
+	_, p.err = fmt.Fprintf(p.w, format, args...)
+}
@@ -0,0 +1,66 @@
+package plan
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/poiley/nebularr-operator/internal/adapters"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+func TestRender(t *testing.T) {
+	id := func(n int) *int { return &n }
+
+	tests := []struct {
+		name       string
+		changes    *adapters.ChangeSet
+		unrealized []irv1.UnrealizedFeature
+		want       string
+	}{
+		{
+			name:    "no changes",
+			changes: &adapters.ChangeSet{},
+			want: "RadarrConfig media/movies\n\n" +
+				"No changes. The live configuration matches the spec.\n",
+		},
+		{
+			name: "sorted by type then name",
+			changes: &adapters.ChangeSet{
+				Creates: []adapters.Change{
+					{ResourceType: adapters.ResourceQualityProfile, Name: "nebularr-movies"},
+					{ResourceType: adapters.ResourceDownloadClient, Name: "qbittorrent"},
+				},
+				Updates: []adapters.Change{
+					{ResourceType: adapters.ResourceDownloadClient, Name: "nzbget", ID: id(3)},
+				},
+				Deletes: []adapters.Change{
+					{ResourceType: adapters.ResourceIndexer, Name: "old", ID: id(7)},
+				},
+			},
+			unrealized: []irv1.UnrealizedFeature{
+				{Feature: "format:dolby-vision", Reason: "not supported by service version"},
+			},
+			want: "RadarrConfig media/movies\n\n" +
+				"Warnings:\n" +
+				"  ! format:dolby-vision: not supported by service version\n\n" +
+				"Nebularr will perform the following actions:\n\n" +
+				"  ~ DownloadClient \"nzbget\" (id 3)\n" +
+				"  + DownloadClient \"qbittorrent\"\n" +
+				"  - Indexer \"old\" (id 7)\n" +
+				"  + QualityProfile \"nebularr-movies\"\n" +
+				"\nPlan: 2 to create, 1 to update, 1 to delete.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Render(&buf, "RadarrConfig media/movies", tt.changes, tt.unrealized); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("unexpected output:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
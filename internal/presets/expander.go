@@ -152,6 +152,18 @@ func (e *Expander) ExpandLidarrNaming(presetName string) *irv1.LidarrNamingIR {
 	}
 }
 
+// ExpandReadarrNaming expands a naming preset to ReadarrNamingIR
+func (e *Expander) ExpandReadarrNaming(presetName string) *irv1.ReadarrNamingIR {
+	expansion := GetReadarrNaming(presetName)
+	return &irv1.ReadarrNamingIR{
+		RenameBooks:              expansion.RenameBooks,
+		ReplaceIllegalCharacters: expansion.ReplaceIllegalCharacters,
+		ColonReplacementFormat:   expansion.ColonReplacement,
+		StandardBookFormat:       expansion.StandardBookFormat,
+		AuthorFolderFormat:       expansion.AuthorFolderFormat,
+	}
+}
+
 // formatToCustomFormat converts a format name to a CustomFormatIR
 // This creates the custom format definitions that will be applied in Radarr/Sonarr
 func (e *Expander) formatToCustomFormat(format string, isReject bool) *irv1.CustomFormatIR {
@@ -0,0 +1,103 @@
+package presets
+
+// IndexerTagPrivate marks a direct indexer as a private tracker for indexer presets
+const IndexerTagPrivate = "private"
+
+// DefaultIndexerPriority is the CRD default priority; indexers left at it take the preset band
+const DefaultIndexerPriority = 25
+
+// maxIndexerPriority is the highest priority the *arr apps accept
+const maxIndexerPriority = 50
+
+// IndexerTier is a priority band and seed criteria for a class of indexers
+type IndexerTier struct {
+	// Protocol matches "torrent" or "usenet" ("" = any)
+	Protocol string
+
+	// Private matches indexers tagged "private" when true, untagged when false, any when nil
+	Private *bool
+
+	// Priority is the first priority in the band; later indexers in the band count up from it
+	Priority int
+
+	// Seed criteria applied to torrent indexers in this band
+	MinimumSeeders  int
+	SeedRatio       float64
+	SeedTimeMinutes int
+}
+
+// IndexerPreset orders classes of indexers by search priority
+type IndexerPreset struct {
+	Name        string
+	Description string
+	Tiers       []IndexerTier // First match wins
+}
+
+var (
+	privateTier = true
+	publicTier  = false
+)
+
+// IndexerPresets contains all built-in indexer presets
+var IndexerPresets = map[string]IndexerPreset{
+	"private-first": {
+		Name:        "private-first",
+		Description: "Private trackers first, then usenet, then public trackers",
+		Tiers: []IndexerTier{
+			{Protocol: "torrent", Private: &privateTier, Priority: 1, MinimumSeeders: 1, SeedRatio: 1.0, SeedTimeMinutes: 7 * 24 * 60},
+			{Protocol: "usenet", Priority: 10},
+			{Protocol: "torrent", Private: &publicTier, Priority: 30, MinimumSeeders: 5, SeedRatio: 1.0, SeedTimeMinutes: 60},
+		},
+	},
+	"usenet-first": {
+		Name:        "usenet-first",
+		Description: "Usenet first, then private trackers, then public trackers",
+		Tiers: []IndexerTier{
+			{Protocol: "usenet", Priority: 1},
+			{Protocol: "torrent", Private: &privateTier, Priority: 15, MinimumSeeders: 1, SeedRatio: 1.0, SeedTimeMinutes: 7 * 24 * 60},
+			{Protocol: "torrent", Private: &publicTier, Priority: 30, MinimumSeeders: 5, SeedRatio: 1.0, SeedTimeMinutes: 60},
+		},
+	},
+}
+
+// GetIndexerPreset returns an indexer preset by name
+func GetIndexerPreset(name string) (IndexerPreset, bool) {
+	preset, ok := IndexerPresets[name]
+	return preset, ok
+}
+
+// Tier returns the index and tier matching an indexer's protocol and tags
+func (p IndexerPreset) Tier(protocol string, tags []string) (int, IndexerTier, bool) {
+	private := false
+	for _, tag := range tags {
+		if tag == IndexerTagPrivate {
+			private = true
+			break
+		}
+	}
+
+	for i, tier := range p.Tiers {
+		if tier.Protocol != "" && tier.Protocol != protocol {
+			continue
+		}
+		if tier.Private != nil && *tier.Private != private {
+			continue
+		}
+		return i, tier, true
+	}
+	return 0, IndexerTier{}, false
+}
+
+// BandPriority returns the priority of the n-th (0-based) indexer in the band-th
+// tier. Priorities are clamped below the next band up (or the *arr maximum), so
+// a long band shares its last priority instead of running into the next one.
+func (p IndexerPreset) BandPriority(band, n int) int {
+	start := p.Tiers[band].Priority
+	last := maxIndexerPriority
+	for _, tier := range p.Tiers {
+		if tier.Priority > start && tier.Priority-1 < last {
+			last = tier.Priority - 1
+		}
+	}
+	return min(start+n, last)
+}
@@ -4,6 +4,21 @@ package presets
 type NamingPreset struct {
 	Name        string
 	Description string
+	// Apps lists the apps the preset applies to (nil = all apps)
+	Apps []string
+}
+
+// SupportsApp reports whether the preset applies to app
+func (p NamingPreset) SupportsApp(app string) bool {
+	if len(p.Apps) == 0 {
+		return true
+	}
+	for _, a := range p.Apps {
+		if a == app {
+			return true
+		}
+	}
+	return false
 }
 
 // RadarrNamingExpansion expands a preset for Radarr
@@ -40,6 +55,15 @@ type LidarrNamingExpansion struct {
 	AlbumFolderFormat        string
 }
 
+// ReadarrNamingExpansion expands a preset for Readarr
+type ReadarrNamingExpansion struct {
+	RenameBooks              bool
+	ReplaceIllegalCharacters bool
+	ColonReplacement         int // 0=delete, 1=dash, 2=space dash, 3=space dash space
+	StandardBookFormat       string
+	AuthorFolderFormat       string
+}
+
 // NamingPresets contains all built-in naming presets
 var NamingPresets = map[string]NamingPreset{
 	"plex-friendly":     {Name: "plex-friendly", Description: "Optimized for Plex metadata matching"},
@@ -48,6 +72,9 @@ var NamingPresets = map[string]NamingPreset{
 	"detailed":          {Name: "detailed", Description: "Maximum info in filename"},
 	"minimal":           {Name: "minimal", Description: "Clean, simple names"},
 	"scene":             {Name: "scene", Description: "Scene-style naming"},
+	"plex-music":        {Name: "plex-music", Description: "Plex music library layout (Artist/Album/01 - Track)", Apps: []string{"lidarr"}},
+	"jellyfin-music":    {Name: "jellyfin-music", Description: "Jellyfin music library layout with disc folders", Apps: []string{"lidarr"}},
+	"audiobookshelf":    {Name: "audiobookshelf", Description: "Audiobookshelf library layout (Author/Book/files)", Apps: []string{"readarr"}},
 }
 
 // DefaultNamingPreset is used when no preset is specified
@@ -194,11 +221,85 @@ func GetLidarrNaming(presetName string) LidarrNamingExpansion {
 			ArtistFolderFormat:       "{Artist Name}",
 			AlbumFolderFormat:        "{Album Title}",
 		}
+	case "plex-music":
+		// Plex matches on tags and the Artist/Album folders; multi-disc
+		// albums stay in one folder with the disc as track prefix (101, 201)
+		return LidarrNamingExpansion{
+			RenameTracks:             true,
+			ReplaceIllegalCharacters: true,
+			ColonReplacement:         ColonSmart,
+			StandardTrackFormat:      "{track:00} - {Track Title}",
+			MultiDiscTrackFormat:     "{medium:0}{track:00} - {Track Title}",
+			ArtistFolderFormat:       "{Artist Name}",
+			AlbumFolderFormat:        "{Album Title} ({Release Year})",
+		}
+	case "jellyfin-music":
+		// Jellyfin groups "Disc N" subfolders into one album
+		return LidarrNamingExpansion{
+			RenameTracks:             true,
+			ReplaceIllegalCharacters: true,
+			ColonReplacement:         ColonSmart,
+			StandardTrackFormat:      "{track:00} - {Track Title}",
+			MultiDiscTrackFormat:     "Disc {medium:0}/{track:00} - {Track Title}",
+			ArtistFolderFormat:       "{Artist Name}",
+			AlbumFolderFormat:        "{Album Title} ({Release Year})",
+		}
 	default:
 		return GetLidarrNaming("plex-friendly")
 	}
 }
 
+// Readarr colon replacement constants (Readarr has no smart replacement)
+const (
+	ReadarrColonDelete         = 0
+	ReadarrColonDash           = 1
+	ReadarrColonSpaceDash      = 2
+	ReadarrColonSpaceDashSpace = 3
+)
+
+// GetReadarrNaming returns the Readarr expansion for a preset.
+// The book format includes the book folder, as in Readarr's own default.
+func GetReadarrNaming(presetName string) ReadarrNamingExpansion {
+	switch presetName {
+	case "plex-friendly", "jellyfin-friendly", "kodi-friendly":
+		return ReadarrNamingExpansion{
+			RenameBooks:              true,
+			ReplaceIllegalCharacters: true,
+			ColonReplacement:         ReadarrColonSpaceDash,
+			StandardBookFormat:       "{Book Title}/{Author Name} - {Book Title}{ (PartNumber)}",
+			AuthorFolderFormat:       "{Author Name}",
+		}
+	case "detailed":
+		return ReadarrNamingExpansion{
+			RenameBooks:              true,
+			ReplaceIllegalCharacters: true,
+			ColonReplacement:         ReadarrColonSpaceDash,
+			StandardBookFormat:       "{Book Title} ({Release Year})/{Author Name} - {Book Title}{ (PartNumber)} [{Quality Full}]",
+			AuthorFolderFormat:       "{Author Name}",
+		}
+	case "minimal":
+		return ReadarrNamingExpansion{
+			RenameBooks:              true,
+			ReplaceIllegalCharacters: true,
+			ColonReplacement:         ReadarrColonDelete,
+			StandardBookFormat:       "{Book Title}/{Book Title}{ (PartNumber)}",
+			AuthorFolderFormat:       "{Author Name}",
+		}
+	case "audiobookshelf":
+		// Audiobookshelf reads Author/Book folders and treats every file
+		// in a book folder as one audiobook
+		return ReadarrNamingExpansion{
+			RenameBooks:              true,
+			ReplaceIllegalCharacters: true,
+			ColonReplacement:         ReadarrColonSpaceDash,
+			StandardBookFormat:       "{Book Title}/{Book Title}{ (PartNumber)}",
+			AuthorFolderFormat:       "{Author Name}",
+		}
+	default:
+		return GetReadarrNaming("plex-friendly")
+	}
+}
+
 // GetNamingPreset returns a naming preset by name
 func GetNamingPreset(name string) (NamingPreset, bool) {
 	preset, ok := NamingPresets[name]
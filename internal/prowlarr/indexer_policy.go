@@ -0,0 +1,163 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/poiley/nebularr-operator/internal/adapters/httpclient"
+	"github.com/poiley/nebularr-operator/internal/adapters/shared"
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+// Out-of-policy actions for Prowlarr-synced indexers
+const (
+	// OutOfPolicyActionDisable turns off RSS and search on out-of-policy indexers
+	OutOfPolicyActionDisable = "disable"
+	// OutOfPolicyActionRemove deletes out-of-policy indexers from the app
+	OutOfPolicyActionRemove = "remove"
+	// OutOfPolicyActionIgnore only reports out-of-policy indexers
+	OutOfPolicyActionIgnore = "ignore"
+)
+
+// IndexerPolicy describes which Prowlarr indexers an app is allowed to receive.
+// Names are matched case-insensitively against the Prowlarr indexer name.
+type IndexerPolicy struct {
+	// Include lists allowed indexers. Empty means all indexers are allowed.
+	Include []string
+
+	// Exclude lists indexers that are never allowed. Takes precedence over Include.
+	Exclude []string
+
+	// Action is what to do with out-of-policy indexers: disable, remove, ignore
+	Action string
+}
+
+// IsEmpty returns true if the policy has no include/exclude filters
+func (p IndexerPolicy) IsEmpty() bool {
+	return len(p.Include) == 0 && len(p.Exclude) == 0
+}
+
+// Allows returns true if the named Prowlarr indexer is permitted by the policy
+func (p IndexerPolicy) Allows(name string) bool {
+	for _, excluded := range p.Exclude {
+		if strings.EqualFold(excluded, name) {
+			return false
+		}
+	}
+
+	if len(p.Include) == 0 {
+		return true
+	}
+
+	for _, included := range p.Include {
+		if strings.EqualFold(included, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IndexerPolicyResult summarizes the outcome of enforcing an IndexerPolicy in one app
+type IndexerPolicyResult struct {
+	// Synced lists Prowlarr-synced indexers that are within policy
+	Synced []string
+
+	// OutOfPolicy lists Prowlarr-synced indexers that violate the policy
+	OutOfPolicy []string
+
+	// Disabled counts out-of-policy indexers that were disabled
+	Disabled int
+
+	// Removed counts out-of-policy indexers that were deleted
+	Removed int
+
+	// Errors collects per-indexer failures (enforcement is fail-soft)
+	Errors []error
+}
+
+// EnforceIndexerPolicy reads the indexers Prowlarr has synced into an app of type
+// appType and disables or removes the ones that fall outside the policy. Indexers
+// created by Nebularr directly (tagged) or by the user are never touched: only
+// indexers pointing back at prowlarrURL are considered.
+func EnforceIndexerPolicy(ctx context.Context, appType string, conn *irv1.ConnectionIR, prowlarrURL string, policy IndexerPolicy) (*IndexerPolicyResult, error) {
+	apiPath := indexerAPIPath(appType)
+	c := httpclient.New(httpclient.ConfigForConnection(conn))
+
+	// Use a generic representation so PUTs round-trip fields we don't model
+	var indexers []map[string]interface{}
+	if err := c.Get(ctx, apiPath, &indexers); err != nil {
+		return nil, fmt.Errorf("failed to list %s indexers: %w", appType, err)
+	}
+
+	result := &IndexerPolicyResult{}
+	for _, indexer := range indexers {
+		name, synced := shared.ProwlarrSyncedIndexer(indexer, prowlarrURL)
+		if !synced {
+			continue
+		}
+
+		if policy.Allows(name) {
+			result.Synced = append(result.Synced, name)
+			continue
+		}
+
+		result.OutOfPolicy = append(result.OutOfPolicy, name)
+
+		id, ok := indexer["id"].(float64)
+		if !ok {
+			continue
+		}
+		path := fmt.Sprintf("%s/%d", apiPath, int(id))
+
+		switch policy.Action {
+		case OutOfPolicyActionIgnore:
+			// Report only
+		case OutOfPolicyActionRemove:
+			if err := c.Delete(ctx, path); err != nil {
+				result.Errors = append(result.Errors, fmt.Errorf("failed to remove indexer %s: %w", name, err))
+			} else {
+				result.Removed++
+			}
+		default:
+			if !indexerEnabled(indexer) {
+				continue
+			}
+			indexer["enableRss"] = false
+			indexer["enableAutomaticSearch"] = false
+			indexer["enableInteractiveSearch"] = false
+			if err := c.Put(ctx, path, indexer, nil); err != nil {
+				result.Errors = append(result.Errors, fmt.Errorf("failed to disable indexer %s: %w", name, err))
+			} else {
+				result.Disabled++
+			}
+		}
+	}
+
+	sort.Strings(result.Synced)
+	sort.Strings(result.OutOfPolicy)
+
+	return result, nil
+}
+
+// indexerEnabled returns true if any of the indexer's RSS/search toggles are on
+func indexerEnabled(indexer map[string]interface{}) bool {
+	for _, key := range []string{"enableRss", "enableAutomaticSearch", "enableInteractiveSearch"} {
+		if enabled, ok := indexer[key].(bool); ok && enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// indexerAPIPath returns the indexer endpoint for the app type
+func indexerAPIPath(appType string) string {
+	switch appType {
+	case irv1.AppTypeLidarr, irv1.AppTypeReadarr:
+		return "/api/v1/indexer"
+	default:
+		return "/api/v3/indexer"
+	}
+}
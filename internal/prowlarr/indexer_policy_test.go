@@ -0,0 +1,164 @@
+package prowlarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	irv1 "github.com/poiley/nebularr-operator/internal/ir/v1"
+)
+
+const testProwlarrURL = "http://prowlarr:9696"
+
+// fakeApp serves an app's indexer list and records the PUTs and DELETEs sent to it
+type fakeApp struct {
+	mu      sync.Mutex
+	puts    map[string]map[string]interface{}
+	deletes []string
+	// forwardAuth is the X-Forward-Auth header of the last request
+	forwardAuth string
+}
+
+func newFakeApp(t *testing.T, path string) (*fakeApp, string) {
+	indexers := []map[string]interface{}{
+		// Synced by this Prowlarr, named with its suffix
+		{"id": 1, "name": "NZBgeek (Prowlarr)", "enableRss": true, "enableAutomaticSearch": true},
+		{"id": 2, "name": "1337x (Prowlarr)", "enableRss": true, "enableInteractiveSearch": true},
+		// Synced by this Prowlarr, recognised by its base URL
+		{"id": 3, "name": "Nyaa", "enableRss": true, "fields": []map[string]interface{}{
+			{"name": "baseUrl", "value": testProwlarrURL + "/3/"},
+		}},
+		// Already disabled
+		{"id": 4, "name": "Old (Prowlarr)", "enableRss": false},
+		// Created by hand or by Nebularr, never touched
+		{"id": 5, "name": "nebularr-movies-tracker", "enableRss": true, "fields": []map[string]interface{}{
+			{"name": "baseUrl", "value": "http://tracker.example"},
+		}},
+	}
+
+	app := &fakeApp{puts: map[string]map[string]interface{}{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		app.mu.Lock()
+		defer app.mu.Unlock()
+		app.forwardAuth = r.Header.Get("X-Forward-Auth")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == path:
+			_ = json.NewEncoder(w).Encode(indexers)
+		case r.Method == http.MethodPut:
+			body := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			app.puts[r.URL.Path] = body
+			_, _ = w.Write([]byte("{}"))
+		case r.Method == http.MethodDelete:
+			app.deletes = append(app.deletes, r.URL.Path)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return app, server.URL
+}
+
+func TestIndexerPolicyAllows(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   IndexerPolicy
+		indexer  string
+		expected bool
+	}{
+		{"empty policy", IndexerPolicy{}, "NZBgeek", true},
+		{"included", IndexerPolicy{Include: []string{"nzbgeek"}}, "NZBgeek", true},
+		{"not included", IndexerPolicy{Include: []string{"NZBgeek"}}, "1337x", false},
+		{"excluded", IndexerPolicy{Exclude: []string{"1337X"}}, "1337x", false},
+		{"exclude wins over include", IndexerPolicy{Include: []string{"1337x"}, Exclude: []string{"1337x"}}, "1337x", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Allows(tt.indexer); got != tt.expected {
+				t.Errorf("Allows(%q) = %v, want %v", tt.indexer, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEnforceIndexerPolicyDisable(t *testing.T) {
+	app, url := newFakeApp(t, "/api/v3/indexer")
+	policy := IndexerPolicy{Include: []string{"NZBgeek"}, Action: OutOfPolicyActionDisable}
+
+	// The app's extra headers are sent, as they are on every other call to it
+	conn := &irv1.ConnectionIR{URL: url, ExtraHeaders: map[string]string{"X-Forward-Auth": "token"}}
+	result, err := EnforceIndexerPolicy(context.Background(), irv1.AppTypeRadarr, conn, testProwlarrURL+"/", policy)
+	if err != nil {
+		t.Fatalf("EnforceIndexerPolicy() error = %v", err)
+	}
+	if app.forwardAuth != "token" {
+		t.Errorf("X-Forward-Auth = %q, want the connection's extra header", app.forwardAuth)
+	}
+
+	if !reflect.DeepEqual(result.Synced, []string{"NZBgeek"}) {
+		t.Errorf("Synced = %v, want [NZBgeek]", result.Synced)
+	}
+	if !reflect.DeepEqual(result.OutOfPolicy, []string{"1337x", "Nyaa", "Old"}) {
+		t.Errorf("OutOfPolicy = %v, want [1337x Nyaa Old]", result.OutOfPolicy)
+	}
+	// The disabled indexer is left alone
+	if result.Disabled != 2 || len(app.puts) != 2 || len(app.deletes) != 0 {
+		t.Fatalf("Disabled = %d with puts %v and deletes %v, want 2 puts", result.Disabled, app.puts, app.deletes)
+	}
+	put := app.puts["/api/v3/indexer/2"]
+	for _, key := range []string{"enableRss", "enableAutomaticSearch", "enableInteractiveSearch"} {
+		if put[key] != false {
+			t.Errorf("PUT %s = %v, want false", key, put[key])
+		}
+	}
+	if put["name"] != "1337x (Prowlarr)" {
+		t.Errorf("PUT name = %v, want the indexer sent back as read", put["name"])
+	}
+}
+
+func TestEnforceIndexerPolicyRemove(t *testing.T) {
+	app, url := newFakeApp(t, "/api/v1/indexer")
+	policy := IndexerPolicy{Exclude: []string{"1337x"}, Action: OutOfPolicyActionRemove}
+
+	result, err := EnforceIndexerPolicy(context.Background(), irv1.AppTypeLidarr, &irv1.ConnectionIR{URL: url}, testProwlarrURL, policy)
+	if err != nil {
+		t.Fatalf("EnforceIndexerPolicy() error = %v", err)
+	}
+	if result.Removed != 1 || !reflect.DeepEqual(app.deletes, []string{"/api/v1/indexer/2"}) {
+		t.Errorf("Removed = %d with deletes %v, want /api/v1/indexer/2", result.Removed, app.deletes)
+	}
+	if len(app.puts) != 0 {
+		t.Errorf("unexpected puts %v", app.puts)
+	}
+}
+
+func TestEnforceIndexerPolicyIgnore(t *testing.T) {
+	app, url := newFakeApp(t, "/api/v3/indexer")
+	policy := IndexerPolicy{Include: []string{"NZBgeek"}, Action: OutOfPolicyActionIgnore}
+
+	result, err := EnforceIndexerPolicy(context.Background(), irv1.AppTypeSonarr, &irv1.ConnectionIR{URL: url}, testProwlarrURL, policy)
+	if err != nil {
+		t.Fatalf("EnforceIndexerPolicy() error = %v", err)
+	}
+	if len(result.OutOfPolicy) != 3 {
+		t.Errorf("OutOfPolicy = %v, want 3 reported indexers", result.OutOfPolicy)
+	}
+	if result.Disabled+result.Removed != 0 || len(app.puts)+len(app.deletes) != 0 {
+		t.Errorf("ignore changed the app: puts %v, deletes %v", app.puts, app.deletes)
+	}
+}
+
+func TestEnforceIndexerPolicyListFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	if _, err := EnforceIndexerPolicy(context.Background(), irv1.AppTypeRadarr, &irv1.ConnectionIR{URL: server.URL}, testProwlarrURL, IndexerPolicy{}); err == nil {
+		t.Error("expected an error when the indexers can't be listed")
+	}
+}
@@ -0,0 +1,138 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{expr: "0 2 * * *"},
+		{expr: "*/15 1-5 * * 1-5"},
+		{expr: "0 3 1,15 * 0,7"},
+		{expr: "30 4 * 6-8/2 *"},
+		{expr: "0 2 * *", wantErr: true},
+		{expr: "60 2 * * *", wantErr: true},
+		{expr: "0 2 0 * *", wantErr: true},
+		{expr: "0 5-1 * * *", wantErr: true},
+		{expr: "*/0 * * * *", wantErr: true},
+		{expr: "a * * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseCron(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCron(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNextHalfHourZone(t *testing.T) {
+	ist := time.FixedZone("IST", 5*3600+30*60)
+	acst := time.FixedZone("ACST", 9*3600+30*60)
+
+	tests := []struct {
+		name string
+		expr string
+		at   time.Time
+		want time.Time
+	}{
+		{
+			name: "next hour",
+			expr: "0 2 * * *",
+			at:   time.Date(2026, 3, 4, 1, 10, 0, 0, ist),
+			want: time.Date(2026, 3, 4, 2, 0, 0, 0, ist),
+		},
+		{
+			name: "later hour with minutes",
+			expr: "15 3 * * *",
+			at:   time.Date(2026, 3, 4, 0, 45, 0, 0, acst),
+			want: time.Date(2026, 3, 4, 3, 15, 0, 0, acst),
+		},
+		{
+			name: "next day",
+			expr: "0 2 * * *",
+			at:   time.Date(2026, 3, 4, 2, 1, 0, 0, ist),
+			want: time.Date(2026, 3, 5, 2, 0, 0, 0, ist),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := cron.Next(tt.at); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data not available")
+	}
+
+	// Weekdays 02:00-04:00 New York time
+	cron, err := ParseCron("0 2 * * 1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := Window{Start: cron, Duration: 2 * time.Hour}
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		wantOpen bool
+		wantNext time.Time
+	}{
+		{
+			name:     "inside window",
+			at:       time.Date(2026, 3, 4, 3, 15, 0, 0, loc), // Wednesday
+			wantOpen: true,
+			wantNext: time.Date(2026, 3, 5, 2, 0, 0, 0, loc),
+		},
+		{
+			name:     "at window start",
+			at:       time.Date(2026, 3, 4, 2, 0, 0, 0, loc),
+			wantOpen: true,
+			wantNext: time.Date(2026, 3, 4, 2, 0, 0, 0, loc),
+		},
+		{
+			name:     "at window end",
+			at:       time.Date(2026, 3, 4, 4, 0, 0, 0, loc),
+			wantOpen: false,
+			wantNext: time.Date(2026, 3, 5, 2, 0, 0, 0, loc),
+		},
+		{
+			name:     "weekend skips to monday",
+			at:       time.Date(2026, 3, 7, 3, 0, 0, 0, loc), // Saturday
+			wantOpen: false,
+			wantNext: time.Date(2026, 3, 9, 2, 0, 0, 0, loc),
+		},
+		{
+			name:     "evaluated in another timezone",
+			at:       time.Date(2026, 3, 4, 7, 30, 0, 0, time.UTC).In(loc), // 02:30 EST
+			wantOpen: true,
+			wantNext: time.Date(2026, 3, 5, 2, 0, 0, 0, loc),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := w.Open(tt.at); got != tt.wantOpen {
+				t.Errorf("Open() = %v, want %v", got, tt.wantOpen)
+			}
+			if got := w.NextOpen(tt.at); !got.Equal(tt.wantNext) {
+				t.Errorf("NextOpen() = %v, want %v", got, tt.wantNext)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package schedule
+
+import "time"
+
+// Window is a recurring time window that opens on a cron schedule and stays
+// open for a fixed duration
+type Window struct {
+	// Start is when the window opens
+	Start *Cron
+
+	// Duration is how long the window stays open
+	Duration time.Duration
+}
+
+// Open reports whether the window is open at t.
+// The window is open if it started within the last Duration.
+func (w Window) Open(t time.Time) bool {
+	t = t.Truncate(time.Minute)
+	earliest := t.Add(-w.Duration)
+	for start := t; start.After(earliest); start = start.Add(-time.Minute) {
+		if w.Start.Matches(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen returns when the window next opens at or after t (zero if not within a year)
+func (w Window) NextOpen(t time.Time) time.Time {
+	return w.Start.Next(t)
+}
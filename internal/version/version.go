@@ -0,0 +1,30 @@
+// Package version reports the version of the running operator build.
+package version
+
+import "runtime/debug"
+
+// Version is set at build time:
+//
+//	go build -ldflags "-X github.com/poiley/nebularr-operator/internal/version.Version=v1.2.3"
+var Version = ""
+
+// Get returns the operator version: the linked Version, else the module version,
+// else the VCS revision embedded by go build, else "dev"
+func Get() string {
+	if Version != "" {
+		return Version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+	if v := info.Main.Version; v != "" && v != "(devel)" {
+		return v
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" && len(setting.Value) >= 12 {
+			return setting.Value[:12]
+		}
+	}
+	return "dev"
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+// DeleteProtectionValidator warns when a config carrying the protect annotation
+// is deleted. The delete is still allowed: the warning tells the user, right in
+// the kubectl output, that the managed resources are left in the service.
+type DeleteProtectionValidator struct{}
+
+var _ webhook.CustomValidator = &DeleteProtectionValidator{}
+
+// ValidateCreate implements webhook.CustomValidator
+func (v *DeleteProtectionValidator) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator
+func (v *DeleteProtectionValidator) ValidateUpdate(_ context.Context, _, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator
+func (v *DeleteProtectionValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	config, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, fmt.Errorf("expected a config object but got %T", obj)
+	}
+	if !arrv1alpha1.IsDeleteProtected(config) {
+		return nil, nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"%s/%s has %s=true: only its finalizer is removed, and its managed resources are left in the service",
+		config.GetNamespace(), config.GetName(), arrv1alpha1.ProtectAnnotation)}, nil
+}
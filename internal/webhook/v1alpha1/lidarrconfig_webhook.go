@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+	"github.com/poiley/nebularr-operator/internal/compiler"
+)
+
+var lidarrconfiglog = logf.Log.WithName("lidarrconfig-resource")
+
+// SetupLidarrConfigWebhookWithManager registers the defaulting and delete protection webhooks for LidarrConfig in the manager
+func SetupLidarrConfigWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&arrv1alpha1.LidarrConfig{}).
+		WithDefaulter(&LidarrConfigCustomDefaulter{}).
+		WithValidator(&DeleteProtectionValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-arr-rinzler-cloud-v1alpha1-lidarrconfig,mutating=true,failurePolicy=ignore,sideEffects=None,groups=arr.rinzler.cloud,resources=lidarrconfigs,verbs=create;update,versions=v1alpha1,name=mlidarrconfig-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-arr-rinzler-cloud-v1alpha1-lidarrconfig,mutating=false,failurePolicy=ignore,sideEffects=None,groups=arr.rinzler.cloud,resources=lidarrconfigs,verbs=delete,versions=v1alpha1,name=vlidarrconfig-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// LidarrConfigCustomDefaulter stores the defaults the compiler would otherwise
+// apply to a LidarrConfig, so the spec shows what the operator acts on
+type LidarrConfigCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &LidarrConfigCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter
+func (d *LidarrConfigCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	config, ok := obj.(*arrv1alpha1.LidarrConfig)
+	if !ok {
+		return fmt.Errorf("expected a LidarrConfig object but got %T", obj)
+	}
+	lidarrconfiglog.V(1).Info("defaulting", "namespace", config.Namespace, "name", config.Name)
+	compiler.DefaultLidarrSpec(&config.Spec)
+	return nil
+}
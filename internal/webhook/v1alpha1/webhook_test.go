@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	arrv1alpha1 "github.com/poiley/nebularr-operator/api/v1alpha1"
+)
+
+func TestRadarrConfigDefaulter(t *testing.T) {
+	config := &arrv1alpha1.RadarrConfig{}
+	config.Spec.DownloadClients = []arrv1alpha1.DownloadClientSpec{{Name: "qbittorrent", URL: "http://qbittorrent:8080"}}
+
+	if err := (&RadarrConfigCustomDefaulter{}).Default(context.Background(), config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Spec.Quality == nil || config.Spec.Quality.Preset == "" || config.Spec.Naming == nil || config.Spec.Naming.Preset == "" {
+		t.Errorf("expected the quality and naming presets stored, got %+v %+v", config.Spec.Quality, config.Spec.Naming)
+	}
+	if config.Spec.DownloadClients[0].Type != "qbittorrent" {
+		t.Errorf("expected the inferred client type stored, got %q", config.Spec.DownloadClients[0].Type)
+	}
+
+	if err := (&RadarrConfigCustomDefaulter{}).Default(context.Background(), &arrv1alpha1.SonarrConfig{}); err == nil {
+		t.Error("expected an error for another kind")
+	}
+}
+
+func TestDeleteProtectionValidator(t *testing.T) {
+	config := &arrv1alpha1.RadarrConfig{}
+	config.Name = "radarr"
+	config.Namespace = "media"
+
+	validator := &DeleteProtectionValidator{}
+	warnings, err := validator.ValidateDelete(context.Background(), config)
+	if err != nil || len(warnings) != 0 {
+		t.Fatalf("expected no warnings for an unprotected config, got %v, %v", warnings, err)
+	}
+
+	config.Annotations = map[string]string{arrv1alpha1.ProtectAnnotation: "true"}
+	warnings, err = validator.ValidateDelete(context.Background(), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "media/radarr") {
+		t.Errorf("expected a warning naming the config, got %v", warnings)
+	}
+}